@@ -91,6 +91,11 @@ module.exports = {
               directory: false,
               path: "/ibc/relayer.html"
             },
+            {
+              title: "Wasm Light Clients",
+              directory: false,
+              path: "/ibc/wasm-light-clients.html"
+            },
             {
               title: "Protobuf Documentation",
               directory: false,
@@ -143,6 +148,18 @@ module.exports = {
                 },
             ]
             },
+            {
+              title: "Misbehaviour Bounty",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/misbehaviour-bounty/overview.html"
+                },
+              ]
+            },
           ]
         },
         {
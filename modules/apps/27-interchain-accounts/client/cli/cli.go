@@ -1,20 +1,23 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 
 	controllercli "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/client/cli"
 	hostcli "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/client/cli"
 )
 
-// GetQueryCmd returns the query commands for the interchain-accounts submodule
+// GetQueryCmd returns the query commands for the interchain-accounts submodule, registered
+// under the ibc query namespace
 func GetQueryCmd() *cobra.Command {
 	icaQueryCmd := &cobra.Command{
-		Use:                        "interchain-accounts",
-		Aliases:                    []string{"ica"},
+		Use:                        "ica",
+		Aliases:                    []string{"interchain-accounts"},
 		Short:                      "interchain-accounts subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	icaQueryCmd.AddCommand(
@@ -24,3 +27,23 @@ func GetQueryCmd() *cobra.Command {
 
 	return icaQueryCmd
 }
+
+// GetTxCmd returns the transaction commands for the interchain-accounts submodule, registered
+// under the ibc transaction namespace
+func GetTxCmd() *cobra.Command {
+	icaTxCmd := &cobra.Command{
+		Use:                        "ica",
+		Aliases:                    []string{"interchain-accounts"},
+		Short:                      "interchain-accounts subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	icaTxCmd.AddCommand(
+		controllercli.NewTxCmd(),
+		hostcli.NewTxCmd(),
+	)
+
+	return icaTxCmd
+}
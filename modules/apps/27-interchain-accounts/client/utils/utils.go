@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// UnmarshalJSONContentOrFile unmarshals the provided argument as JSON, falling back to
+// reading it as a path to a .json file if it is not valid JSON.
+func UnmarshalJSONContentOrFile(cdc codec.JSONCodec, contentOrFileName string, ptr codec.ProtoMarshaler) error {
+	if err := cdc.UnmarshalJSON([]byte(contentOrFileName), ptr); err != nil {
+		// check for file path if JSON input is not provided
+		contents, err := ioutil.ReadFile(contentOrFileName)
+		if err != nil {
+			return fmt.Errorf("neither JSON input nor path to .json file were provided: %w", err)
+		}
+
+		if err := cdc.UnmarshalJSON(contents, ptr); err != nil {
+			return fmt.Errorf("error unmarshalling file: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -11,11 +12,34 @@ func GetQueryCmd() *cobra.Command {
 		Short:                      "interchain-accounts controller subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdInterchainAccounts(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns the transaction commands for the ICA controller submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterInterchainAccountCmd(),
+		NewSendTxCmd(),
+		NewReopenChannelCmd(),
+		NewScheduleTxCmd(),
+		NewCancelScheduledTxCmd(),
+	)
+
+	return txCmd
+}
@@ -18,7 +18,7 @@ func GetCmdParams() *cobra.Command {
 		Short:   "Query the current interchain-accounts controller submodule parameters",
 		Long:    "Query the current interchain-accounts controller submodule parameters",
 		Args:    cobra.NoArgs,
-		Example: fmt.Sprintf("%s query interchain-accounts controller params", version.AppName),
+		Example: fmt.Sprintf("%s query ibc ica controller params", version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -39,3 +39,40 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccounts returns the command handler for the controller interchain accounts querying.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query the interchain accounts registered by the interchain-accounts controller submodule",
+		Long:    "Query the interchain accounts registered by the interchain-accounts controller submodule with their connection and port identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc ica controller interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/client/utils"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	// flagRelativePacketTimeout is the flag for the relative timeout of the sent packet
+	flagRelativePacketTimeout = "relative-packet-timeout"
+	// flagInterval is the flag for the number of blocks between two sends of a scheduled transaction
+	flagInterval = "interval"
+)
+
+// defaultRelativePacketTimeout is the default packet timeout relative to the block time of the send
+var defaultRelativePacketTimeout = uint64((10 * time.Minute).Nanoseconds())
+
+// NewRegisterInterchainAccountCmd returns the command to register an interchain account for the signer on the given
+// connection without an authentication module.
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account",
+		Long: `Register an interchain account for the signer on the given connection without an authentication module.
+The channel of the interchain account is owned by the controller submodule, its transactions are sent with 'send-tx'
+once the channel handshake is completed by a relayer.`,
+		Example: fmt.Sprintf("%s tx ibc ica controller register connection-0 --from owner", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := icatypes.NewMsgRegisterInterchainAccount(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to send an interchain accounts transaction from the interchain account of
+// the signer on the given connection.
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_data.json]",
+		Short: "Send an interchain accounts transaction",
+		Long: `Send an interchain accounts transaction from the interchain account of the signer on the given connection.
+The packet data is the JSON encoded interchain accounts packet data containing the messages to execute on the host chain.
+
+The transaction may be sent on behalf of the owner by a grantee with a send-tx authorization, by generating it
+with the '--generate-only' flag and executing it with 'tx authz exec'.`,
+		Example: fmt.Sprintf("%s tx ibc ica controller send-tx connection-0 packet_data.json --from owner", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := utils.UnmarshalJSONContentOrFile(cdc, args[1], &packetData); err != nil {
+				return fmt.Errorf("invalid packet data: %w", err)
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := icatypes.NewMsgSendTx(clientCtx.GetFromAddress().String(), args[0], packetData, relativeTimeout)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, defaultRelativePacketTimeout, "Packet timeout in nanoseconds relative to the block time of the send")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReopenChannelCmd returns the command to reopen the closed active channel of the interchain account of the
+// signer on the given connection.
+func NewReopenChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen-channel [connection-id]",
+		Short: "Reopen the closed channel of an interchain account",
+		Long: `Open a new channel for the interchain account of the signer on the given connection, whose active channel has
+been closed, for instance after a packet timeout. The channel is opened with the metadata of the closed channel so that
+the same interchain account is used once the channel handshake is completed by a relayer.`,
+		Example: fmt.Sprintf("%s tx ibc ica controller reopen-channel connection-0 --from owner", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := icatypes.NewMsgReopenChannel(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewScheduleTxCmd returns the command to schedule an interchain accounts transaction from the interchain account
+// of the signer on the given connection.
+func NewScheduleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-tx [connection-id] [path/to/packet_data.json] [height]",
+		Short: "Schedule an interchain accounts transaction",
+		Long: `Schedule an interchain accounts transaction from the interchain account of the signer on the given connection.
+The packet data is sent by the controller submodule at the end of the block at the given height, and then every interval
+blocks if the interval is non-zero. The interchain account must have been registered with 'register'.`,
+		Example: fmt.Sprintf("%s tx ibc ica controller schedule-tx connection-0 packet_data.json 1000 --interval 100 --from owner", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := utils.UnmarshalJSONContentOrFile(cdc, args[1], &packetData); err != nil {
+				return fmt.Errorf("invalid packet data: %w", err)
+			}
+
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := icatypes.NewMsgScheduleTx(clientCtx.GetFromAddress().String(), args[0], packetData, height, interval, relativeTimeout)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks between two sends, the transaction is sent once if zero")
+	cmd.Flags().Uint64(flagRelativePacketTimeout, defaultRelativePacketTimeout, "Packet timeout in nanoseconds relative to the block time of the send")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelScheduledTxCmd returns the command to cancel an interchain accounts transaction scheduled by the signer.
+func NewCancelScheduledTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scheduled-tx [id]",
+		Short:   "Cancel a scheduled interchain accounts transaction",
+		Long:    "Cancel the interchain accounts transaction with the given identifier scheduled by the signer.",
+		Example: fmt.Sprintf("%s tx ibc ica controller cancel-scheduled-tx 1 --from owner", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := icatypes.NewMsgCancelScheduledTx(clientCtx.GetFromAddress().String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
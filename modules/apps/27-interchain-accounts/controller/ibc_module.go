@@ -19,7 +19,9 @@ type IBCModule struct {
 	app    porttypes.IBCModule
 }
 
-// NewIBCModule creates a new IBCModule given the associated keeper and underlying application
+// NewIBCModule creates a new IBCModule given the associated keeper and underlying application. The callbacks of
+// the channels owned by the controller submodule, opened with MsgRegisterInterchainAccount, are not passed to the
+// underlying application.
 func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
 	return IBCModule{
 		keeper: k,
@@ -51,6 +53,10 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
+	if im.keeper.OwnsChannelCapability(ctx, portID, channelID) {
+		return nil
+	}
+
 	// call underlying app's OnChanOpenInit callback with the appVersion
 	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
 		chanCap, counterparty, version)
@@ -91,6 +97,10 @@ func (im IBCModule) OnChanOpenAck(
 		return err
 	}
 
+	if im.keeper.OwnsChannelCapability(ctx, portID, channelID) {
+		return nil
+	}
+
 	// call underlying app's OnChanOpenAck callback with the counterparty app version.
 	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
 }
@@ -143,10 +153,33 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return types.ErrControllerSubModuleDisabled
 	}
 
+	acknowledgement, err := im.keeper.DecompressAcknowledgement(ctx, packet, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if im.keeper.OwnsChannelCapability(ctx, packet.SourcePort, packet.SourceChannel) {
+		return nil
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
+// OnClientFrozen implements the IBCModule interface. The callback is passed to the
+// underlying authentication module which sends the packets over the channel.
+func (im IBCModule) OnClientFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	if !im.keeper.IsControllerEnabled(ctx) || im.keeper.OwnsChannelCapability(ctx, portID, channelID) {
+		return
+	}
+
+	im.app.OnClientFrozen(ctx, portID, channelID)
+}
+
 // OnTimeoutPacket implements the IBCModule interface
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
@@ -161,5 +194,24 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	if im.keeper.OwnsChannelCapability(ctx, packet.SourcePort, packet.SourceChannel) {
+		return nil
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
+
+// StackLayerName implements the StackLayer interface
+func (im IBCModule) StackLayerName() string {
+	return types.SubModuleName
+}
+
+// UnderlyingApplication implements the ApplicationWrapper interface
+func (im IBCModule) UnderlyingApplication() porttypes.IBCModule {
+	return im.app
+}
+
+// GetICS4Wrapper implements the PacketSendingModule interface
+func (im IBCModule) GetICS4Wrapper() porttypes.PacketSender {
+	return im.keeper.GetICS4Wrapper()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -119,6 +120,39 @@ func SetupICAPath(path *ibctesting.Path, owner string) error {
 	return nil
 }
 
+// SetupControllerOwnedICAPath registers an interchain account with MsgRegisterInterchainAccount and completes the
+// channel handshake, the capability of the channel is owned by the controller submodule
+func SetupControllerOwnedICAPath(path *ibctesting.Path, owner string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	msgServer := keeper.NewMsgServerImpl(path.EndpointA.Chain.GetSimApp().ICAControllerKeeper)
+	res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(path.EndpointA.Chain.GetContext()), icatypes.NewMsgRegisterInterchainAccount(owner, path.EndpointA.ConnectionID))
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.App.Commit()
+	path.EndpointA.Chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 	var (
 		channel *channeltypes.Channel
@@ -525,6 +559,26 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 				}
 			}, false,
 		},
+		{
+			"ICA auth module callback not called for a channel owned by the controller submodule", func() {
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnAcknowledgementPacket = func(
+					ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+				) error {
+					return fmt.Errorf("mock ica auth fails")
+				}
+
+				controllerPath := NewICAPath(suite.chainA, suite.chainB)
+				controllerPath.EndpointA.ClientID = path.EndpointA.ClientID
+				controllerPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				controllerPath.EndpointB.ClientID = path.EndpointB.ClientID
+				controllerPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+				err := SetupControllerOwnedICAPath(controllerPath, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				path = controllerPath
+			}, true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -537,6 +591,8 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			tc.malleate() // malleate mutates test data
+
 			packet := channeltypes.NewPacket(
 				[]byte("empty packet data"),
 				suite.chainA.SenderAccount.GetSequence(),
@@ -548,8 +604,6 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 				0,
 			)
 
-			tc.malleate() // malleate mutates test data
-
 			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
 			suite.Require().NoError(err)
 
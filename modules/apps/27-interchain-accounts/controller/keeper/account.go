@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -14,32 +16,48 @@ import (
 // and counterparty connection identifier. It will bind to the port identifier and
 // call 04-channel 'ChanOpenInit'. An error is returned if the port identifier is
 // already in use. Gaining access to interchain accounts whose channels have closed
-// cannot be done with this function. A regular MsgChanOpenInit must be used.
+// cannot be done with this function, ReopenActiveChannel must be used.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
+	return k.RegisterInterchainAccountWithAckCompression(ctx, connectionID, owner, "")
+}
+
+// RegisterInterchainAccountWithAckCompression registers an interchain account in the same way
+// as RegisterInterchainAccount, proposing the provided acknowledgement compression in the
+// channel version. The host chain compresses acknowledgement results larger than the
+// AckCompressionThreshold, which are decompressed before being passed to the authentication
+// module. An empty acknowledgement compression disables compression.
+func (k Keeper) RegisterInterchainAccountWithAckCompression(ctx sdk.Context, connectionID, owner, ackCompression string) error {
+	_, err := k.registerInterchainAccount(ctx, connectionID, owner, ackCompression)
+	return err
+}
+
+// registerInterchainAccount binds the port of the owner if needed and initialises a channel for its interchain
+// account on the provided connection. The identifier of the new channel is returned.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, owner, ackCompression string) (string, error) {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
 	connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
@@ -51,10 +69,11 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner s
 		icatypes.EncodingProtobuf,
 		icatypes.TxTypeSDKMultiMsg,
 	)
+	metadata.AckCompression = ackCompression
 
 	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	msg := channeltypes.NewMsgChannelOpenInit(portID, string(versionBytes), channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
@@ -62,11 +81,82 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner s
 
 	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	var openInitRes channeltypes.MsgChannelOpenInitResponse
+	if err := openInitRes.Unmarshal(res.Data); err != nil {
+		return "", err
+	}
+
+	return openInitRes.ChannelId, nil
+}
+
+// ReopenActiveChannel opens a new channel for the interchain account of the owner on the given
+// connection once its active channel has been closed, for instance after a packet timeout on the
+// ordered channel. The channel is initialised on the existing port with the version metadata of
+// the closed channel, so that the host chain sets the new channel as the active channel of the
+// same interchain account once the handshake completes. The identifier of the new channel is
+// returned.
+func (k Keeper) ReopenActiveChannel(ctx sdk.Context, connectionID, owner string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, activeChannelID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "expected active channel %s for port %s to be %s, got %s", activeChannelID, portID, channeltypes.CLOSED, channel.State)
+	}
+
+	// the new channel is owned by the controller submodule if the closed channel was
+	if k.OwnsChannelCapability(ctx, portID, activeChannelID) {
+		ctx = withControllerOwnedChannel(ctx)
+	}
+
+	msg := channeltypes.NewMsgChannelOpenInit(portID, channel.Version, channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	handler := k.msgRouter.Handler(msg)
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	var openInitRes channeltypes.MsgChannelOpenInitResponse
+	if err := openInitRes.Unmarshal(res.Data); err != nil {
+		return "", err
+	}
+
+	return openInitRes.ChannelId, nil
+}
+
+// controllerOwnedChannelKey is the context key marking the channels opened without an authentication module,
+// whose capability is claimed by the controller submodule in OnChanOpenInit.
+type controllerOwnedChannelKey struct{}
+
+// withControllerOwnedChannel returns a context in which the opened channel is owned by the controller submodule.
+func withControllerOwnedChannel(ctx sdk.Context) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), controllerOwnedChannelKey{}, true))
+}
+
+// isControllerOwnedChannel returns true if the channel opened with the provided context is owned by the controller
+// submodule.
+func isControllerOwnedChannel(ctx sdk.Context) bool {
+	owned, _ := ctx.Context().Value(controllerOwnedChannelKey{}).(bool)
+	return owned
 }
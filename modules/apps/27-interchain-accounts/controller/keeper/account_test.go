@@ -100,3 +100,106 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestReopenActiveChannel() {
+	var (
+		owner string
+		path  *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"fails to generate port-id",
+			func() {
+				owner = ""
+			},
+			false,
+		},
+		{
+			"no active channel for owner",
+			func() {
+				owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"active channel is OPEN",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner = TestOwnerAddress // must be explicitly changed
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// close the channel as an ordered channel is closed on packet timeout
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+			err = path.EndpointB.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			closedChannelID := path.EndpointA.ChannelID
+			previousVersion := path.EndpointA.GetChannel().Version
+
+			tc.malleate() // malleate mutates test data
+
+			channelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ReopenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEqual(closedChannelID, channelID)
+
+				channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(previousVersion, channel.Version)
+
+				// complete the handshake of the new channel
+				suite.chainA.App.Commit()
+				suite.chainA.NextBlock()
+
+				path.EndpointA.ChannelID = channelID
+				path.EndpointA.ChannelConfig.Version = previousVersion
+				path.EndpointB.ChannelID = ""
+				suite.Require().NoError(path.EndpointB.ChanOpenTry())
+				suite.Require().NoError(path.EndpointA.ChanOpenAck())
+				suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(channelID, activeChannelID)
+
+				// the same interchain account is used over the new channel
+				var metadata icatypes.Metadata
+				suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON([]byte(previousVersion), &metadata))
+
+				address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata.Address, address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// EmitScheduledTxEvent emits an event signalling the dispatch of a scheduled transaction, including the sequence
+// of the sent packet or the error details if the packet could not be sent
+func EmitScheduledTxEvent(ctx sdk.Context, scheduledTx icatypes.ScheduledTx, sequence uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyScheduledTxID, strconv.FormatUint(scheduledTx.Id, 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, scheduledTx.PortId),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyDispatchError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeScheduledTx,
+			attributes...,
+		),
+	)
+}
@@ -28,15 +28,26 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesi
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, scheduledTx := range state.ScheduledTxs {
+		keeper.SetScheduledTx(ctx, scheduledTx)
+	}
+
+	keeper.SetNextScheduledTxID(ctx, state.NextScheduledTxId)
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.ControllerGenesisState {
-	return icatypes.NewControllerGenesisState(
+	genesisState := icatypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+
+	genesisState.ScheduledTxs = keeper.GetAllScheduledTxs(ctx)
+	genesisState.NextScheduledTxId = keeper.GetNextScheduledTxID(ctx)
+
+	return genesisState
 }
@@ -26,6 +26,17 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		ScheduledTxs: []icatypes.ScheduledTx{
+			{
+				Id:              0,
+				ConnectionId:    ibctesting.FirstConnectionID,
+				PortId:          TestPortID,
+				PacketData:      icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")},
+				NextHeight:      10,
+				RelativeTimeout: 100,
+			},
+		},
+		NextScheduledTxId: 1,
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -38,6 +49,11 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
+	scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(suite.chainA.GetContext(), TestPortID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ScheduledTxs[0], scheduledTx)
+	suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(suite.chainA.GetContext()))
+
 	expParams := types.NewParams(false)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
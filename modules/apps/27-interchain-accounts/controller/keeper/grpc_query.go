@@ -2,10 +2,17 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +26,38 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(fmt.Sprintf("%s/", icatypes.OwnerKeyPrefix)))
+
+	interchainAccounts := []types.IdentifiedInterchainAccount{}
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// keys are of the form {portID}/{connectionID}
+		i := strings.LastIndex(string(key), "/")
+		if i < 0 {
+			return status.Errorf(codes.Internal, "invalid interchain account key %s", key)
+		}
+
+		interchainAccounts = append(interchainAccounts, types.IdentifiedInterchainAccount{
+			ConnectionId:   string(key[i+1:]),
+			PortId:         string(key[:i]),
+			AccountAddress: string(value),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
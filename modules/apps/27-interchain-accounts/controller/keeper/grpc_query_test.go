@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 )
@@ -12,3 +13,44 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	keeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	keeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-0", "icacontroller-owner0", "address0")
+	keeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-1", "icacontroller-owner0", "address1")
+	keeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-0", "icacontroller-owner1", "address2")
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := keeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedInterchainAccount{
+		{ConnectionId: "connection-0", PortId: "icacontroller-owner0", AccountAddress: "address0"},
+		{ConnectionId: "connection-1", PortId: "icacontroller-owner0", AccountAddress: "address1"},
+	}, res.InterchainAccounts)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// the total is also counted for key based page requests
+	res, err = keeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedInterchainAccount{
+		{ConnectionId: "connection-0", PortId: "icacontroller-owner1", AccountAddress: "address2"},
+	}, res.InterchainAccounts)
+	suite.Require().Nil(res.Pagination.NextKey)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = keeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedInterchainAccount{
+		{ConnectionId: "connection-0", PortId: "icacontroller-owner1", AccountAddress: "address2"},
+	}, res.InterchainAccounts)
+
+	_, err = keeper.InterchainAccounts(ctx, nil)
+	suite.Require().Error(err)
+}
@@ -10,6 +10,7 @@ import (
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
@@ -18,7 +19,7 @@ import (
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
 // and the interchain accounts module must be able to claim the channel
-// capability.
+// capability of the channels opened without an authentication module.
 func (k Keeper) OnChanOpenInit(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -66,6 +67,12 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
+	if isControllerOwnedChannel(ctx) {
+		if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
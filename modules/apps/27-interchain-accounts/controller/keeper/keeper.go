@@ -62,6 +62,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
 }
 
+// GetICS4Wrapper returns the ICS4Wrapper the module sends packets with.
+func (k Keeper) GetICS4Wrapper() icatypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
 // GetAllPorts returns all ports to which the interchain accounts controller module is bound. Used in ExportGenesis
 func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
 	store := ctx.KVStore(k.storeKey)
@@ -92,6 +97,14 @@ func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	return ok
 }
 
+// OwnsChannelCapability returns true if the controller submodule owns the capability of the provided channel, which
+// is the case for the channels opened with MsgRegisterInterchainAccount. The callbacks of these channels are not
+// passed to an authentication module.
+func (k Keeper) OwnsChannelCapability(ctx sdk.Context, portID, channelID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	return ok
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
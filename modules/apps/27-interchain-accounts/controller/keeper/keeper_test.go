@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -110,6 +111,41 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 	return nil
 }
 
+// SetupControllerOwnedICAPath registers an interchain account with MsgRegisterInterchainAccount and completes the
+// channel handshake, the capability of the channel is owned by the controller submodule
+func SetupControllerOwnedICAPath(path *ibctesting.Path, owner string) error {
+	endpoint := path.EndpointA
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	msgServer := keeper.NewMsgServerImpl(endpoint.Chain.GetSimApp().ICAControllerKeeper)
+	res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(endpoint.Chain.GetContext()), icatypes.NewMsgRegisterInterchainAccount(owner, endpoint.ConnectionID))
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	endpoint.Chain.App.Commit()
+	endpoint.Chain.NextBlock()
+
+	// update port/channel ids
+	endpoint.ChannelID = res.ChannelId
+	endpoint.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
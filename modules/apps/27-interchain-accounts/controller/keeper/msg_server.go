@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ icatypes.MsgServer = msgServer{}
+
+// msgServer implements the interchain accounts controller Msg service. It is distinct from the Keeper
+// as the SendTx rpc handler would conflict with the SendTx method used by authentication modules.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts controller Msg service for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) icatypes.MsgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount. A channel is opened for the
+// interchain account of the owner without an authentication module, its capability is claimed by the controller
+// submodule. The interchain accounts registered by authentication modules cannot be taken over by their owner.
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, msg *icatypes.MsgRegisterInterchainAccount) (*icatypes.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if activeChannelID, found := k.GetActiveChannelID(ctx, msg.ConnectionId, portID); found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s, a closed channel must be reopened with MsgReopenChannel", activeChannelID, portID, msg.ConnectionId)
+	}
+
+	channelID, err := k.registerInterchainAccount(withControllerOwnedChannel(ctx), msg.ConnectionId, msg.Owner, "")
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain account registered", "port-id", portID, "connection-id", msg.ConnectionId, "channel-id", channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		),
+	)
+
+	return &icatypes.MsgRegisterInterchainAccountResponse{ChannelId: channelID}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx. The packet data is sent over the active channel of the
+// interchain account of the owner, whose capability must be owned by the controller submodule. The transactions
+// of the interchain accounts registered by authentication modules must be sent through their authentication module.
+// The message may be executed on behalf of the owner through x/authz with a SendTxAuthorization.
+func (k msgServer) SendTx(goCtx context.Context, msg *icatypes.MsgSendTx) (*icatypes.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "controller submodule does not own capability for channel %s on port %s", activeChannelID, portID)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	sequence, err := k.Keeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, msg.PacketData, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain account transaction sent", "port-id", portID, "connection-id", msg.ConnectionId, "sequence", sequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		),
+	)
+
+	return &icatypes.MsgSendTxResponse{Sequence: sequence}, nil
+}
+
+// ScheduleTx defines a rpc handler for MsgScheduleTx. The packet data is registered to be sent by the controller
+// submodule over the active channel of the interchain account of the owner, whose capability must be owned by the
+// controller submodule.
+func (k msgServer) ScheduleTx(goCtx context.Context, msg *icatypes.MsgScheduleTx) (*icatypes.MsgScheduleTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.ScheduleTx(ctx, msg.ConnectionId, portID, msg.PacketData, msg.Height, msg.Interval, msg.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain account transaction scheduled", "port-id", portID, "connection-id", msg.ConnectionId, "id", id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		),
+	)
+
+	return &icatypes.MsgScheduleTxResponse{Id: id}, nil
+}
+
+// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx. The scheduled transaction is removed from the
+// transactions scheduled by the owner.
+func (k msgServer) CancelScheduledTx(goCtx context.Context, msg *icatypes.MsgCancelScheduledTx) (*icatypes.MsgCancelScheduledTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelScheduledTx(ctx, portID, msg.Id); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain account scheduled transaction cancelled", "port-id", portID, "id", msg.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		),
+	)
+
+	return &icatypes.MsgCancelScheduledTxResponse{}, nil
+}
+
+// ReopenChannel defines a rpc handler for MsgReopenChannel. A new channel is opened for the interchain
+// account of the owner, whose active channel has been closed, with the metadata of the closed channel.
+func (k msgServer) ReopenChannel(goCtx context.Context, msg *icatypes.MsgReopenChannel) (*icatypes.MsgReopenChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	channelID, err := k.ReopenActiveChannel(ctx, msg.ConnectionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain account channel reopened", "connection-id", msg.ConnectionId, "channel-id", channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		),
+	)
+
+	return &icatypes.MsgReopenChannelResponse{ChannelId: channelID}, nil
+}
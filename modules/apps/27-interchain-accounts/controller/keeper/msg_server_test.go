@@ -0,0 +1,451 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgRegisterInterchainAccount() {
+	var (
+		path *ibctesting.Path
+		msg  *icatypes.MsgRegisterInterchainAccount
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"interchain account registered by an authentication module",
+			func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"closed channel of an interchain account registered by an authentication module",
+			func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = icatypes.NewMsgRegisterInterchainAccount(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+			tc.malleate() // malleate mutates test data
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(0), res.ChannelId)
+
+				// the channel capability is owned by the controller submodule instead of an authentication module
+				suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.OwnsChannelCapability(suite.chainA.GetContext(), TestPortID, res.ChannelId))
+
+				module, _, err := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.LookupModuleByChannel(suite.chainA.GetContext(), TestPortID, res.ChannelId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.SubModuleName, module)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSendTx() {
+	var (
+		path *ibctesting.Path
+		msg  *icatypes.MsgSendTx
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"owner does not have an interchain account",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"active channel not found on connection",
+			func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"channel closed",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"channel capability owned by an authentication module",
+			func() {
+				authPath := NewICAPath(suite.chainA, suite.chainB)
+				authPath.EndpointA.ClientID = path.EndpointA.ClientID
+				authPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				authPath.EndpointB.ClientID = path.EndpointB.ClientID
+				authPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+				owner := suite.chainA.SenderAccount.GetAddress().String()
+				err := SetupICAPath(authPath, owner)
+				suite.Require().NoError(err)
+
+				msg.Owner = owner
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			msg = icatypes.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, suite.newScheduledPacketData(path), uint64(time.Hour))
+
+			tc.malleate() // malleate mutates test data
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSendTxAuthz() {
+	testCases := []struct {
+		msg           string
+		authorization *icatypes.SendTxAuthorization
+		expPass       bool
+	}{
+		{
+			"success: message type allowed",
+			icatypes.NewSendTxAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})),
+			true,
+		},
+		{
+			"message type not allowed",
+			icatypes.NewSendTxAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})),
+			false,
+		},
+		{
+			"no grant",
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			granter, err := sdk.AccAddressFromBech32(TestOwnerAddress)
+			suite.Require().NoError(err)
+			grantee := suite.chainA.SenderAccount.GetAddress()
+
+			if tc.authorization != nil {
+				err = suite.chainA.GetSimApp().AuthzKeeper.SaveGrant(ctx, grantee, granter, tc.authorization, ctx.BlockTime().Add(time.Hour))
+				suite.Require().NoError(err)
+			}
+
+			msg := icatypes.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, suite.newScheduledPacketData(path), uint64(time.Hour))
+			_, err = suite.chainA.GetSimApp().AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+
+			nextSeq, _ := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(2), nextSeq)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(uint64(1), nextSeq)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgReopenChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *icatypes.MsgReopenChannel
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: channel owned by the controller submodule",
+			func() {
+				controllerPath := NewICAPath(suite.chainA, suite.chainB)
+				controllerPath.EndpointA.ClientID = path.EndpointA.ClientID
+				controllerPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				controllerPath.EndpointB.ClientID = path.EndpointB.ClientID
+				controllerPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+				owner := suite.chainA.SenderAccount.GetAddress().String()
+				err := SetupControllerOwnedICAPath(controllerPath, owner)
+				suite.Require().NoError(err)
+
+				err = controllerPath.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				path = controllerPath
+				msg.Owner = owner
+			},
+			true,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"owner does not have an interchain account",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"channel is not closed",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			msg = icatypes.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+			tc.malleate() // malleate mutates test data
+
+			channelSequence := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ReopenChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(channelSequence), res.ChannelId)
+
+				// the new channel is owned by the owner of the closed channel
+				controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+				closedOwned := controllerKeeper.OwnsChannelCapability(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Equal(closedOwned, controllerKeeper.OwnsChannelCapability(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, res.ChannelId))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgScheduleTx() {
+	var (
+		path *ibctesting.Path
+		msg  *icatypes.MsgScheduleTx
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"owner does not have an interchain account",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"height is not in the future",
+			func() {
+				msg.Height = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			height := uint64(suite.chainA.GetContext().BlockHeight()) + 1
+			msg = icatypes.NewMsgScheduleTx(TestOwnerAddress, ibctesting.FirstConnectionID, suite.newScheduledPacketData(path), height, 0, uint64(time.Hour))
+
+			tc.malleate() // malleate mutates test data
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ScheduleTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(suite.chainA.GetContext(), TestPortID, res.Id)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.PacketData, scheduledTx.PacketData)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelScheduledTx() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	height := uint64(suite.chainA.GetContext().BlockHeight()) + 1
+	res, err := msgServer.ScheduleTx(ctx, icatypes.NewMsgScheduleTx(TestOwnerAddress, ibctesting.FirstConnectionID, suite.newScheduledPacketData(path), height, 0, uint64(time.Hour)))
+	suite.Require().NoError(err)
+
+	// the transaction can only be cancelled by the owner which scheduled it
+	_, err = msgServer.CancelScheduledTx(ctx, icatypes.NewMsgCancelScheduledTx(suite.chainA.SenderAccount.GetAddress().String(), res.Id))
+	suite.Require().ErrorIs(err, types.ErrScheduledTxNotFound)
+
+	_, err = msgServer.CancelScheduledTx(ctx, icatypes.NewMsgCancelScheduledTx(TestOwnerAddress, res.Id))
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(suite.chainA.GetContext(), TestPortID, res.Id)
+	suite.Require().False(found)
+}
@@ -74,6 +74,43 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
+// DecompressAcknowledgement returns the provided acknowledgement with its result decompressed
+// using the acknowledgement compression negotiated in the version of the packet channel. Error
+// acknowledgements and acknowledgements of channels which did not negotiate an acknowledgement
+// compression are returned unmodified.
+func (k Keeper) DecompressAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.SourcePort, packet.SourceChannel)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &metadata); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if metadata.AckCompression == "" {
+		return acknowledgement, nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	result := ack.GetResult()
+	if result == nil {
+		return acknowledgement, nil
+	}
+
+	result, err := icatypes.DecompressAckResult(metadata.AckCompression, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return channeltypes.NewResultAcknowledgement(result).Acknowledgement(), nil
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
 // due to the semantics of ORDERED channels
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -232,3 +234,105 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDecompressAcknowledgement() {
+	var (
+		path           *ibctesting.Path
+		ack            []byte
+		expAck         []byte
+		ackCompression string
+	)
+
+	result := bytes.Repeat([]byte("result"), icatypes.AckCompressionThreshold)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: compressed result",
+			func() {
+				compressed, err := icatypes.CompressAckResult(icatypes.AckCompressionZstd, result)
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(compressed).Acknowledgement()
+				expAck = channeltypes.NewResultAcknowledgement(result).Acknowledgement()
+			},
+			true,
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				ack = channeltypes.NewErrorAcknowledgement("error").Acknowledgement()
+				expAck = ack
+			},
+			true,
+		},
+		{
+			"success: no acknowledgement compression",
+			func() {
+				ackCompression = ""
+				ack = channeltypes.NewResultAcknowledgement(result).Acknowledgement()
+				expAck = ack
+			},
+			true,
+		},
+		{
+			"invalid compressed result",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte{1, 2, 3}).Acknowledgement()
+			},
+			false,
+		},
+		{
+			"invalid acknowledgement",
+			func() {
+				ack = []byte("invalid acknowledgement")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			ackCompression = icatypes.AckCompressionZstd
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			var metadata icatypes.Metadata
+			channel := path.EndpointA.GetChannel()
+			icatypes.ModuleCdc.MustUnmarshalJSON([]byte(channel.Version), &metadata)
+			metadata.AckCompression = ackCompression
+			channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointA.SetChannel(channel)
+
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			bz, err := suite.chainA.GetSimApp().ICAControllerKeeper.DecompressAcknowledgement(suite.chainA.GetContext(), packet, ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAck, bz)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ScheduleTx registers packet data to be sent over the active channel of the provided connectionID and portID
+// at the end of the block at the provided height, and then every interval blocks if interval is non-zero. The
+// capability of the active channel must be owned by the controller submodule, the transactions of the channels
+// opened by authentication modules cannot be scheduled.
+// Each packet is sent with a timeout of relativeTimeout nanoseconds after the block time of the send.
+// The identifier of the scheduled transaction is returned as a result.
+func (k Keeper) ScheduleTx(
+	ctx sdk.Context,
+	connectionID,
+	portID string,
+	icaPacketData icatypes.InterchainAccountPacketData,
+	height,
+	interval,
+	relativeTimeout uint64,
+) (uint64, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	if !k.OwnsChannelCapability(ctx, portID, activeChannelID) {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "controller submodule does not own capability for channel %s on port %s", activeChannelID, portID)
+	}
+
+	if height <= uint64(ctx.BlockHeight()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidScheduledTx, "height %d must be greater than the current height %d", height, ctx.BlockHeight())
+	}
+
+	if count := len(k.GetPortScheduledTxs(ctx, portID)); count >= types.MaxScheduledTxsPerPort {
+		return 0, sdkerrors.Wrapf(types.ErrScheduledTxLimitReached, "%d transactions are already scheduled for port %s", count, portID)
+	}
+
+	id := k.GetNextScheduledTxID(ctx)
+	scheduledTx := icatypes.ScheduledTx{
+		Id:              id,
+		ConnectionId:    connectionID,
+		PortId:          portID,
+		PacketData:      icaPacketData,
+		NextHeight:      height,
+		Interval:        interval,
+		RelativeTimeout: relativeTimeout,
+	}
+
+	if err := scheduledTx.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	k.SetNextScheduledTxID(ctx, id+1)
+	k.SetScheduledTx(ctx, scheduledTx)
+
+	return id, nil
+}
+
+// CancelScheduledTx removes the scheduled transaction with the provided identifier registered for the provided portID.
+func (k Keeper) CancelScheduledTx(ctx sdk.Context, portID string, id uint64) error {
+	scheduledTx, found := k.GetScheduledTx(ctx, portID, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction %d for port %s", id, portID)
+	}
+
+	k.deleteScheduledTx(ctx, scheduledTx)
+	return nil
+}
+
+// DispatchScheduledTxs sends the packet data of the transactions scheduled up to and including the current height.
+// Transactions with a non-zero interval are scheduled again interval blocks later, other transactions are removed.
+// Transactions which fail to be sent are removed and their state changes are discarded. It is called at the end of
+// every block.
+func (k Keeper) DispatchScheduledTxs(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ScheduledTxQueueKeyPrefix, types.KeyScheduledTxQueueHeight(height+1))
+
+	var (
+		queueKeys [][]byte
+		portIDs   []string
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+		portIDs = append(portIDs, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, queueKey := range queueKeys {
+		store.Delete(queueKey)
+
+		// queue key is scheduledTxQueue/{height}/{id}
+		id := sdk.BigEndianToUint64(queueKey[len(queueKey)-8:])
+		scheduledTx, found := k.GetScheduledTx(ctx, portIDs[i], id)
+		if !found {
+			continue
+		}
+
+		sequence, err := k.dispatchScheduledTx(ctx, scheduledTx)
+		if err != nil {
+			k.Logger(ctx).Info("failed to dispatch scheduled transaction", "id", id, "port-id", scheduledTx.PortId, "error", err.Error())
+		}
+
+		if err != nil || scheduledTx.Interval == 0 {
+			k.deleteScheduledTx(ctx, scheduledTx)
+		} else {
+			scheduledTx.NextHeight = height + scheduledTx.Interval
+			k.SetScheduledTx(ctx, scheduledTx)
+		}
+
+		EmitScheduledTxEvent(ctx, scheduledTx, sequence, err)
+	}
+}
+
+// dispatchScheduledTx sends the packet data of the provided scheduled transaction using the capability of the
+// active channel owned by the controller submodule.
+func (k Keeper) dispatchScheduledTx(ctx sdk.Context, scheduledTx icatypes.ScheduledTx) (uint64, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, scheduledTx.ConnectionId, scheduledTx.PortId)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", scheduledTx.ConnectionId, scheduledTx.PortId)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(scheduledTx.PortId, activeChannelID))
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "controller submodule does not own capability for channel %s on port %s", activeChannelID, scheduledTx.PortId)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + scheduledTx.RelativeTimeout
+	sequence, err := k.SendTx(cacheCtx, chanCap, scheduledTx.ConnectionId, scheduledTx.PortId, scheduledTx.PacketData, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return sequence, nil
+}
+
+// GetScheduledTx returns the scheduled transaction with the provided identifier registered for the provided portID.
+func (k Keeper) GetScheduledTx(ctx sdk.Context, portID string, id uint64) (icatypes.ScheduledTx, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyScheduledTx(portID, id))
+	if bz == nil {
+		return icatypes.ScheduledTx{}, false
+	}
+
+	var scheduledTx icatypes.ScheduledTx
+	k.cdc.MustUnmarshal(bz, &scheduledTx)
+	return scheduledTx, true
+}
+
+// SetScheduledTx stores the provided scheduled transaction and queues it to be sent at its next height.
+func (k Keeper) SetScheduledTx(ctx sdk.Context, scheduledTx icatypes.ScheduledTx) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&scheduledTx)
+	store.Set(types.KeyScheduledTx(scheduledTx.PortId, scheduledTx.Id), bz)
+	store.Set(types.KeyScheduledTxQueue(scheduledTx.NextHeight, scheduledTx.Id), []byte(scheduledTx.PortId))
+}
+
+// deleteScheduledTx removes the provided scheduled transaction and its queue entry.
+func (k Keeper) deleteScheduledTx(ctx sdk.Context, scheduledTx icatypes.ScheduledTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyScheduledTx(scheduledTx.PortId, scheduledTx.Id))
+	store.Delete(types.KeyScheduledTxQueue(scheduledTx.NextHeight, scheduledTx.Id))
+}
+
+// GetPortScheduledTxs returns the scheduled transactions registered for the provided portID in ascending order of identifier.
+func (k Keeper) GetPortScheduledTxs(ctx sdk.Context, portID string) []icatypes.ScheduledTx {
+	return k.getScheduledTxs(ctx, types.KeyPortScheduledTxs(portID))
+}
+
+// GetAllScheduledTxs returns all scheduled transactions. Used in ExportGenesis
+func (k Keeper) GetAllScheduledTxs(ctx sdk.Context) []icatypes.ScheduledTx {
+	return k.getScheduledTxs(ctx, types.ScheduledTxKeyPrefix)
+}
+
+func (k Keeper) getScheduledTxs(ctx sdk.Context, prefix []byte) []icatypes.ScheduledTx {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var scheduledTxs []icatypes.ScheduledTx
+	for ; iterator.Valid(); iterator.Next() {
+		var scheduledTx icatypes.ScheduledTx
+		k.cdc.MustUnmarshal(iterator.Value(), &scheduledTx)
+
+		scheduledTxs = append(scheduledTxs, scheduledTx)
+	}
+
+	return scheduledTxs
+}
+
+// GetNextScheduledTxID returns the identifier of the next scheduled transaction.
+func (k Keeper) GetNextScheduledTxID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextScheduledTxID)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledTxID stores the identifier of the next scheduled transaction.
+func (k Keeper) SetNextScheduledTxID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextScheduledTxID, sdk.Uint64ToBigEndian(id))
+}
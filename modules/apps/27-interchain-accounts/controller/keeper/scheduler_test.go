@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) newScheduledPacketData(path *ibctesting.Path) icatypes.InterchainAccountPacketData {
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+}
+
+func (suite *KeeperTestSuite) TestScheduleTx() {
+	var (
+		path       *ibctesting.Path
+		packetData icatypes.InterchainAccountPacketData
+		height     uint64
+		timeout    uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"active channel not found",
+			func() {
+				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
+			},
+			false,
+		},
+		{
+			"channel closed",
+			func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"channel capability owned by an authentication module",
+			func() {
+				authPath := NewICAPath(suite.chainA, suite.chainB)
+				authPath.EndpointA.ClientID = path.EndpointA.ClientID
+				authPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				authPath.EndpointB.ClientID = path.EndpointB.ClientID
+				authPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+				err := SetupICAPath(authPath, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				path = authPath
+			},
+			false,
+		},
+		{
+			"height is not in the future",
+			func() {
+				height = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				timeout = 0
+			},
+			false,
+		},
+		{
+			"data is nil",
+			func() {
+				packetData.Data = nil
+			},
+			false,
+		},
+		{
+			"scheduled transaction limit reached",
+			func() {
+				for i := 0; i < types.MaxScheduledTxsPerPort; i++ {
+					_, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduleTx(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, height, 0, timeout)
+					suite.Require().NoError(err)
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData = suite.newScheduledPacketData(path)
+			height = uint64(suite.chainA.GetContext().BlockHeight()) + 10
+			timeout = uint64(time.Hour)
+
+			tc.malleate() // malleate mutates test data
+
+			id, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduleTx(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, height, 5, timeout)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, id)
+				suite.Require().True(found)
+				suite.Require().Equal(height, scheduledTx.NextHeight)
+				suite.Require().Equal(packetData, scheduledTx.PacketData)
+				suite.Require().Equal(id+1, suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(suite.chainA.GetContext()))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledTx() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	portID := path.EndpointA.ChannelConfig.PortID
+
+	id, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduleTx(ctx, ibctesting.FirstConnectionID, portID, suite.newScheduledPacketData(path), uint64(ctx.BlockHeight())+1, 0, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.CancelScheduledTx(ctx, "invalid-port-id", id)
+	suite.Require().ErrorIs(err, types.ErrScheduledTxNotFound)
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.CancelScheduledTx(ctx, portID, id)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, portID, id)
+	suite.Require().False(found)
+
+	// the queue entry is removed along with the scheduled transaction
+	suite.chainA.GetSimApp().ICAControllerKeeper.DispatchScheduledTxs(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	nextSeq, _ := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, path.EndpointA.ChannelID)
+	suite.Require().Equal(uint64(1), nextSeq)
+}
+
+func (suite *KeeperTestSuite) TestDispatchScheduledTxs() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupControllerOwnedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	portID := path.EndpointA.ChannelConfig.PortID
+
+	height := uint64(ctx.BlockHeight()) + 1
+	packetData := suite.newScheduledPacketData(path)
+
+	onceID, err := keeper.ScheduleTx(ctx, ibctesting.FirstConnectionID, portID, packetData, height, 0, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	recurringID, err := keeper.ScheduleTx(ctx, ibctesting.FirstConnectionID, portID, packetData, height, 3, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	// nothing is dispatched before the scheduled height
+	keeper.DispatchScheduledTxs(ctx)
+	nextSeq, _ := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, path.EndpointA.ChannelID)
+	suite.Require().Equal(uint64(1), nextSeq)
+
+	ctx = ctx.WithBlockHeight(int64(height)).WithEventManager(sdk.NewEventManager())
+	keeper.DispatchScheduledTxs(ctx)
+
+	nextSeq, _ = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, path.EndpointA.ChannelID)
+	suite.Require().Equal(uint64(3), nextSeq)
+
+	_, found := keeper.GetScheduledTx(ctx, portID, onceID)
+	suite.Require().False(found)
+
+	scheduledTx, found := keeper.GetScheduledTx(ctx, portID, recurringID)
+	suite.Require().True(found)
+	suite.Require().Equal(height+3, scheduledTx.NextHeight)
+
+	var dispatched int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == icatypes.EventTypeScheduledTx {
+			dispatched++
+		}
+	}
+	suite.Require().Equal(2, dispatched)
+
+	// a scheduled transaction which fails to be sent is removed
+	err = path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(int64(height + 3))
+	keeper.DispatchScheduledTxs(ctx)
+
+	_, found = keeper.GetScheduledTx(ctx, portID, recurringID)
+	suite.Require().False(found)
+	suite.Require().Empty(keeper.GetAllScheduledTxs(ctx))
+}
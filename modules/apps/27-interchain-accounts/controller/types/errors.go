@@ -7,4 +7,7 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrScheduledTxNotFound         = sdkerrors.Register(SubModuleName, 3, "scheduled transaction not found")
+	ErrInvalidScheduledTx          = sdkerrors.Register(SubModuleName, 4, "invalid scheduled transaction")
+	ErrScheduledTxLimitReached     = sdkerrors.Register(SubModuleName, 5, "scheduled transaction limit reached")
 )
@@ -1,9 +1,50 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
 
 	// StoreKey is the store key string for the interchain accounts controller module
 	StoreKey = SubModuleName
+
+	// MaxScheduledTxsPerPort defines the maximum number of scheduled transactions which may be
+	// registered for a controller port at a time
+	MaxScheduledTxsPerPort = 10
 )
+
+var (
+	// KeyNextScheduledTxID defines the key under which the identifier of the next scheduled transaction is stored
+	KeyNextScheduledTxID = []byte("nextScheduledTxID")
+
+	// ScheduledTxKeyPrefix defines the key prefix used to store scheduled transactions
+	ScheduledTxKeyPrefix = []byte("scheduledTxs/")
+
+	// ScheduledTxQueueKeyPrefix defines the key prefix used to index scheduled transactions by the height at which they are sent
+	ScheduledTxQueueKeyPrefix = []byte("scheduledTxQueue/")
+)
+
+// KeyPortScheduledTxs returns the key prefix of the scheduled transactions registered for the provided port.
+func KeyPortScheduledTxs(portID string) []byte {
+	return append(append([]byte{}, ScheduledTxKeyPrefix...), []byte(portID+"/")...)
+}
+
+// KeyScheduledTx returns the key under which the scheduled transaction with the provided port and identifier is stored.
+func KeyScheduledTx(portID string, id uint64) []byte {
+	return append(KeyPortScheduledTxs(portID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyScheduledTxQueueHeight returns the key prefix of the scheduled transactions sent at the provided height.
+// The height is big endian encoded so that the queue is ordered by height.
+func KeyScheduledTxQueueHeight(height uint64) []byte {
+	return append(append([]byte{}, ScheduledTxQueueKeyPrefix...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyScheduledTxQueue returns the key under which the scheduled transaction with the provided identifier
+// is queued to be sent at the provided height.
+func KeyScheduledTxQueue(height, id uint64) []byte {
+	return append(KeyScheduledTxQueueHeight(height), sdk.Uint64ToBigEndian(id)...)
+}
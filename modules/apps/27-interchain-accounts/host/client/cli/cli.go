@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -11,12 +12,34 @@ func GetQueryCmd() *cobra.Command {
 		Short:                      "interchain-accounts host subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdAllowedMessages(),
 		GetCmdPacketEvents(),
+		GetCmdAuditLog(),
+		GetCmdInterchainAccounts(),
+		GetCmdInterchainAccountSummary(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns the transaction commands for the ICA host submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "interchain-accounts host subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSelfRelayCmd(),
+	)
+
+	return txCmd
+}
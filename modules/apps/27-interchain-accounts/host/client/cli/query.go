@@ -25,7 +25,7 @@ func GetCmdParams() *cobra.Command {
 		Short:   "Query the current interchain-accounts host submodule parameters",
 		Long:    "Query the current interchain-accounts host submodule parameters",
 		Args:    cobra.NoArgs,
-		Example: fmt.Sprintf("%s query interchain-accounts host params", version.AppName),
+		Example: fmt.Sprintf("%s query ibc ica host params", version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -47,6 +47,78 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllowedMessages returns the command handler for the host allowed messages querying.
+func GetCmdAllowedMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-messages [connection-id]",
+		Short: "Query the messages interchain accounts are allowed to execute on the host chain",
+		Long: `Query the messages interchain accounts are allowed to execute on the host chain.
+If a connection identifier is provided, the messages allowed on that connection are returned.`,
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query ibc ica host allowed-messages connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllowedMessagesRequest{}
+			if len(args) == 1 {
+				req.ConnectionId = args[0]
+			}
+
+			res, err := queryClient.AllowedMessages(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAuditLog returns the command handler for the host audit log querying.
+func GetCmdAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "audit-log",
+		Short:   "Query the interchain-accounts host submodule audit log",
+		Long:    "Query the interchain transactions executed on the host chain which are kept in the audit log",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc ica host audit-log", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AuditLog(cmd.Context(), &types.QueryAuditLogRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "audit log")
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
@@ -54,7 +126,7 @@ func GetCmdPacketEvents() *cobra.Command {
 		Short:   "Query the interchain-accounts host submodule packet events",
 		Long:    "Query the interchain-accounts host submodule packet events for a particular channel and sequence",
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s query interchain-accounts host packet-events channel-0 100", version.AppName),
+		Example: fmt.Sprintf("%s query ibc ica host packet-events channel-0 100", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -87,7 +159,76 @@ func GetCmdPacketEvents() *cobra.Command {
 				resEvents = append(resEvents, r.Events...)
 			}
 
-			return clientCtx.PrintString(sdk.StringifyEvents(resEvents).String())
+			return clientCtx.PrintObjectLegacy(sdk.StringifyEvents(resEvents))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for the host interchain accounts querying.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query the interchain accounts registered on the interchain-accounts host chain",
+		Long:    "Query the interchain accounts registered on the interchain-accounts host chain with their connection and port identifiers",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc ica host interchain-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdInterchainAccountSummary returns the command handler for the host interchain account summary querying.
+func GetCmdInterchainAccountSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-summary [connection-id] [port-id]",
+		Short:   "Query the balances, delegations and unbonding delegations of an interchain account",
+		Long:    "Query the balances, delegations and unbonding delegations of the interchain account registered on the given connection for the given controller port",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc ica host account-summary connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccountSummary(cmd.Context(), &types.QueryInterchainAccountSummaryRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
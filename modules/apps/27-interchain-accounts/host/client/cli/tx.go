@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/client/utils"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// NewSelfRelayCmd returns the command to deliver an interchain accounts packet to the host
+// chain without a third-party relayer. The packet commitment proof is fetched off-chain from
+// the controller chain and embedded into a MsgRecvPacket signed by the submitter.
+func NewSelfRelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-relay [path/to/packet.json] [path/to/packet_commitment.json]",
+		Short: "Deliver an interchain accounts packet to the host chain with a self-fetched proof",
+		Long: `Deliver an interchain accounts packet to the host chain with a proof fetched off-chain, for setups
+where the controller and host chains are run by the same operator and no third-party relayer exists.
+
+The packet is the channel packet sent on the controller chain. The packet commitment is the output of
+'query ibc channel packet-commitment [port-id] [channel-id] [sequence]' executed against the controller
+chain, which contains the proof and the height at which it was retrieved. The host chain light client of
+the controller chain must already be updated to the proof height.`,
+		Example: fmt.Sprintf("%s tx ibc ica host self-relay packet.json packet_commitment.json --from node0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			msg, err := newSelfRelayMsg(cdc, args[0], args[1], clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newSelfRelayMsg builds the MsgRecvPacket delivering the provided interchain accounts packet
+// to the host chain, with the proof and proof height of the provided packet commitment query
+// response. Both the packet and the packet commitment may be provided as JSON or as a path to
+// a .json file.
+func newSelfRelayMsg(cdc codec.JSONCodec, packetContentOrFileName, commitmentContentOrFileName, signer string) (*channeltypes.MsgRecvPacket, error) {
+	var packet channeltypes.Packet
+	if err := utils.UnmarshalJSONContentOrFile(cdc, packetContentOrFileName, &packet); err != nil {
+		return nil, fmt.Errorf("invalid packet: %w", err)
+	}
+
+	if packet.GetDestPort() != icatypes.PortID {
+		return nil, fmt.Errorf("packet destination port must be %s, got %s", icatypes.PortID, packet.GetDestPort())
+	}
+
+	var commitment channeltypes.QueryPacketCommitmentResponse
+	if err := utils.UnmarshalJSONContentOrFile(cdc, commitmentContentOrFileName, &commitment); err != nil {
+		return nil, fmt.Errorf("invalid packet commitment: %w", err)
+	}
+
+	if len(commitment.Proof) == 0 {
+		return nil, fmt.Errorf("packet commitment does not contain a proof, query it with the '--%s' flag", flags.FlagProve)
+	}
+
+	msg := channeltypes.NewMsgRecvPacket(packet, commitment.Proof, commitment.ProofHeight, signer)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
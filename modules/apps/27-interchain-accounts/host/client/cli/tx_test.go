@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestNewSelfRelayMsg(t *testing.T) {
+	var (
+		packet     channeltypes.Packet
+		commitment channeltypes.QueryPacketCommitmentResponse
+		packetArg  string
+		commitArg  string
+	)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	signer := sdk.AccAddress(crypto.AddressHash([]byte("signer"))).String()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: JSON content", func() {}, true},
+		{"success: packet commitment query output", func() {
+			commitArg = `{"commitment":"Y29tbWl0bWVudA==","proof":"cHJvb2Y=","proof_height":{"revision_number":"1","revision_height":"42"}}`
+		}, true},
+		{"success: path to .json files", func() {
+			dir := t.TempDir()
+
+			packetFile := filepath.Join(dir, "packet.json")
+			require.NoError(t, ioutil.WriteFile(packetFile, []byte(packetArg), 0o600))
+			packetArg = packetFile
+
+			commitFile := filepath.Join(dir, "packet_commitment.json")
+			require.NoError(t, ioutil.WriteFile(commitFile, []byte(commitArg), 0o600))
+			commitArg = commitFile
+		}, true},
+		{"invalid packet", func() {
+			packetArg = "packet.json"
+		}, false},
+		{"packet destination port is not the host port", func() {
+			packet.DestinationPort = "transfer"
+			packetArg = string(cdc.MustMarshalJSON(&packet))
+		}, false},
+		{"invalid packet commitment", func() {
+			commitArg = "packet_commitment.json"
+		}, false},
+		{"packet commitment without proof", func() {
+			commitment.Proof = nil
+			commitArg = string(cdc.MustMarshalJSON(&commitment))
+		}, false},
+		{"packet commitment without proof height", func() {
+			commitment.ProofHeight = clienttypes.ZeroHeight()
+			commitArg = string(cdc.MustMarshalJSON(&commitment))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.msg, func(t *testing.T) {
+			packet = channeltypes.NewPacket(
+				[]byte("data"), 1,
+				icatypes.PortPrefix+"owner", "channel-0",
+				icatypes.PortID, "channel-1",
+				clienttypes.NewHeight(0, 100), 0,
+			)
+			commitment = channeltypes.QueryPacketCommitmentResponse{
+				Commitment:  []byte("commitment"),
+				Proof:       []byte("proof"),
+				ProofHeight: clienttypes.NewHeight(1, 42),
+			}
+			packetArg = string(cdc.MustMarshalJSON(&packet))
+			commitArg = string(cdc.MustMarshalJSON(&commitment))
+
+			tc.malleate()
+
+			msg, err := newSelfRelayMsg(cdc, packetArg, commitArg, signer)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, packet, msg.Packet)
+				require.Equal(t, commitment.Proof, msg.ProofCommitment)
+				require.Equal(t, commitment.ProofHeight, msg.ProofHeight)
+				require.Equal(t, signer, msg.Signer)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -131,6 +131,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host channel end, a host chain does not send a packet over the channel")
 }
 
+// OnClientFrozen implements the IBCModule interface. A host chain does not send packets over
+// the channel, no action is taken.
+func (im IBCModule) OnClientFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+}
+
 // OnTimeoutPacket implements the IBCModule interface
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
@@ -139,3 +148,8 @@ func (im IBCModule) OnTimeoutPacket(
 ) error {
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
+
+// StackLayerName implements the StackLayer interface
+func (im IBCModule) StackLayerName() string {
+	return types.SubModuleName
+}
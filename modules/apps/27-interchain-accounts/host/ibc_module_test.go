@@ -510,6 +510,54 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 }
 
+// TestOnRecvPacketAuditLog tests that a failed execution is acknowledged with an unsuccessful
+// error acknowledgement when the audit log is enabled, so that core IBC discards its state changes.
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketAuditLog() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, _ := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+	// the interchain account holds no funds so the execution fails
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params.AuditLogRetention = 10
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	ctx := suite.chainB.GetContext()
+	ack := cbs.OnRecvPacket(ctx, packet, nil)
+	suite.Require().False(ack.Success())
+
+	var writtenAck channeltypes.Acknowledgement
+	err = channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &writtenAck)
+	suite.Require().NoError(err)
+	suite.Require().False(writtenAck.Success())
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 
 	testCases := []struct {
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// recordExecution stores an audit log entry for the successful execution of the provided msgs
+// received in the given packet along with the packet memo. No entry is recorded if the audit log
+// is disabled. Failed executions are not audited as core IBC discards the state changes of packets
+// acknowledged with an error, the failure is reported by the error acknowledgement event instead.
+func (k Keeper) recordExecution(ctx sdk.Context, packet channeltypes.Packet, memo string, msgs []sdk.Msg, gasUsed uint64) {
+	if k.GetAuditLogRetention(ctx) == 0 {
+		return
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	entry := types.AuditLogEntry{
+		Height:           uint64(ctx.BlockHeight()),
+		ChannelId:        packet.DestinationChannel,
+		Sequence:         packet.Sequence,
+		ControllerPortId: packet.SourcePort,
+		Owner:            strings.TrimPrefix(packet.SourcePort, icatypes.PortPrefix),
+		MsgTypeUrls:      msgTypeURLs,
+		GasUsed:          gasUsed,
+		Memo:             memo,
+	}
+
+	if channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel); found {
+		entry.ConnectionId = channel.ConnectionHops[0]
+	}
+
+	k.SetAuditLogEntry(ctx, entry)
+}
+
+// SetAuditLogEntry stores the provided audit log entry.
+func (k Keeper) SetAuditLogEntry(ctx sdk.Context, entry types.AuditLogEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.KeyAuditLogEntry(entry.Height, entry.ChannelId, entry.Sequence), bz)
+}
+
+// IterateAuditLog iterates over all audit log entries in order of execution height, calling
+// the provided callback for each entry. Iteration stops if the callback returns true.
+func (k Keeper) IterateAuditLog(ctx sdk.Context, cb func(entry types.AuditLogEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AuditLogKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.AuditLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// PruneAuditLog deletes all audit log entries which have been kept for the number of blocks
+// defined by the audit log retention parameter. It is called at the end of every block.
+func (k Keeper) PruneAuditLog(ctx sdk.Context) {
+	retention := k.GetAuditLogRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height < retention {
+		return
+	}
+
+	// entries recorded at heights up to and including the cutoff height are pruned
+	cutoff := height - retention
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AuditLogKeyPrefix, types.KeyAuditLogHeight(cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
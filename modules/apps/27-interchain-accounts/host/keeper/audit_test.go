@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestAuditLog() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	buildPacket := func(sequence uint64, amount int64, memo string) channeltypes.Packet {
+		msg := &banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))),
+		}
+
+		data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+		suite.Require().NoError(err)
+
+		icaPacketData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		}
+
+		return channeltypes.NewPacket(
+			icaPacketData.GetBytes(), sequence,
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+			clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+	ctx := suite.chainB.GetContext()
+
+	// audit log is disabled by default
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	hostKeeper.SetParams(ctx, params)
+
+	_, err = hostKeeper.OnRecvPacket(ctx, buildPacket(1, 100, ""))
+	suite.Require().NoError(err)
+
+	res, err := hostKeeper.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+
+	params.AuditLogRetention = 2
+	hostKeeper.SetParams(ctx, params)
+
+	successPacket, failurePacket := buildPacket(2, 100, "correlation-id"), buildPacket(3, 1000000, "")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = hostKeeper.OnRecvPacket(ctx, successPacket)
+	suite.Require().NoError(err)
+
+	// the memo is emitted in a packet event
+	memoEvent := sdk.NewEvent(
+		icatypes.EventTypePacket,
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, path.EndpointB.ChannelID),
+		sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, "2"),
+		sdk.NewAttribute(icatypes.AttributeKeyMemo, "correlation-id"),
+	)
+	suite.Require().Contains(ctx.EventManager().Events(), memoEvent)
+
+	// failed executions are not audited
+	_, err = hostKeeper.OnRecvPacket(ctx, failurePacket)
+	suite.Require().Error(err)
+
+	res, err = hostKeeper.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+
+	success := res.Entries[0]
+	suite.Require().Equal(uint64(ctx.BlockHeight()), success.Height)
+	suite.Require().Equal(ibctesting.FirstConnectionID, success.ConnectionId)
+	suite.Require().Equal(path.EndpointB.ChannelID, success.ChannelId)
+	suite.Require().Equal(uint64(2), success.Sequence)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, success.ControllerPortId)
+	suite.Require().Equal(TestOwnerAddress, success.Owner)
+	suite.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, success.MsgTypeUrls)
+	suite.Require().NotZero(success.GasUsed)
+	suite.Require().Equal("correlation-id", success.Memo)
+
+	// entries are kept for the number of blocks defined by the retention
+	hostKeeper.PruneAuditLog(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	res, err = hostKeeper.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+
+	hostKeeper.PruneAuditLog(ctx.WithBlockHeight(ctx.BlockHeight() + 2))
+	res, err = hostKeeper.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+}
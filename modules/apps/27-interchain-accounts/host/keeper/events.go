@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -18,3 +20,17 @@ func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI
 		),
 	)
 }
+
+// EmitPacketMemoEvent emits an event including the memo of a received packet. The memo is not
+// executed, it allows controller chains to tag interchain account operations for indexers.
+func EmitPacketMemoEvent(ctx sdk.Context, packet exported.PacketI, memo string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(icatypes.AttributeKeyMemo, memo),
+		),
+	)
+}
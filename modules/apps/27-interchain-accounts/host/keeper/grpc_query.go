@@ -2,10 +2,19 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +28,159 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// AllowedMessages implements the Query/AllowedMessages gRPC method
+func (q Keeper) AllowedMessages(c context.Context, req *types.QueryAllowedMessagesRequest) (*types.QueryAllowedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	allowMsgs := q.GetAllowMessages(ctx)
+	if strings.TrimSpace(req.ConnectionId) != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		allowMsgs = q.GetEffectiveAllowMessages(ctx, req.ConnectionId)
+	}
+
+	allowAll := false
+	for _, msgType := range allowMsgs {
+		if msgType == types.AllowAllHostMsgs {
+			allowAll = true
+			break
+		}
+	}
+
+	return &types.QueryAllowedMessagesResponse{
+		AllowMessages: allowMsgs,
+		AllowAll:      allowAll,
+	}, nil
+}
+
+// AuditLog implements the Query/AuditLog gRPC method
+func (q Keeper) AuditLog(c context.Context, req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.AuditLogKeyPrefix)
+
+	var entries []types.AuditLogEntry
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.AuditLogEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuditLogResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(fmt.Sprintf("%s/", icatypes.OwnerKeyPrefix)))
+
+	interchainAccounts := []types.IdentifiedInterchainAccount{}
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// keys are of the form {portID}/{connectionID}
+		i := strings.LastIndex(string(key), "/")
+		if i < 0 {
+			return status.Errorf(codes.Internal, "invalid interchain account key %s", key)
+		}
+
+		interchainAccounts = append(interchainAccounts, types.IdentifiedInterchainAccount{
+			ConnectionId:   string(key[i+1:]),
+			PortId:         string(key[:i]),
+			AccountAddress: string(value),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// InterchainAccountSummary implements the Query/InterchainAccountSummary gRPC method
+func (q Keeper) InterchainAccountSummary(c context.Context, req *types.QueryInterchainAccountSummaryRequest) (*types.QueryInterchainAccountSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no interchain account is registered for connection %s and port %s", req.ConnectionId, req.PortId)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bondDenom := q.stakingKeeper.BondDenom(ctx)
+
+	delegations := []types.InterchainAccountDelegation{}
+	for _, delegation := range q.stakingKeeper.GetAllDelegatorDelegations(ctx, accAddr) {
+		validator, found := q.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return nil, status.Errorf(codes.Internal, "validator %s of delegation not found", delegation.ValidatorAddress)
+		}
+
+		delegations = append(delegations, types.InterchainAccountDelegation{
+			ValidatorAddress: delegation.ValidatorAddress,
+			Shares:           delegation.Shares,
+			Balance:          sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+		})
+	}
+
+	unbondingEntries := []types.InterchainAccountUnbondingEntry{}
+	for _, unbonding := range q.stakingKeeper.GetUnbondingDelegations(ctx, accAddr, math.MaxUint16) {
+		for _, entry := range unbonding.Entries {
+			unbondingEntries = append(unbondingEntries, types.InterchainAccountUnbondingEntry{
+				ValidatorAddress: unbonding.ValidatorAddress,
+				CreationHeight:   entry.CreationHeight,
+				CompletionTime:   entry.CompletionTime,
+				Balance:          sdk.NewCoin(bondDenom, entry.Balance),
+			})
+		}
+	}
+
+	return &types.QueryInterchainAccountSummaryResponse{
+		AccountAddress:   address,
+		Balances:         q.bankKeeper.GetAllBalances(ctx, accAddr),
+		Delegations:      delegations,
+		UnbondingEntries: unbondingEntries,
+	}, nil
+}
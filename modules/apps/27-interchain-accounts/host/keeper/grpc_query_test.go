@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +15,99 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryAllowedMessages() {
+	keeper := suite.chainA.GetSimApp().ICAHostKeeper
+
+	params := types.NewParams(true, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+	params.ConnectionAllowMessages = []types.ConnectionAllowMessages{
+		{ConnectionId: "connection-1", AllowMessages: []string{types.AllowAllHostMsgs}},
+	}
+	keeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := keeper.AllowedMessages(ctx, &types.QueryAllowedMessagesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, res.AllowMessages)
+	suite.Require().False(res.AllowAll)
+
+	res, err = keeper.AllowedMessages(ctx, &types.QueryAllowedMessagesRequest{ConnectionId: "connection-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, res.AllowMessages)
+	suite.Require().False(res.AllowAll)
+
+	res, err = keeper.AllowedMessages(ctx, &types.QueryAllowedMessagesRequest{ConnectionId: "connection-1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.AllowAllHostMsgs}, res.AllowMessages)
+	suite.Require().True(res.AllowAll)
+
+	_, err = keeper.AllowedMessages(ctx, &types.QueryAllowedMessagesRequest{ConnectionId: "invalid"})
+	suite.Require().Error(err)
+
+	_, err = keeper.AllowedMessages(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	keeper := suite.chainA.GetSimApp().ICAHostKeeper
+	keeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-0", "icacontroller-owner0", "address0")
+	keeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-1", "icacontroller-owner1", "address1")
+
+	res, err := keeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedInterchainAccount{
+		{ConnectionId: "connection-0", PortId: "icacontroller-owner0", AccountAddress: "address0"},
+		{ConnectionId: "connection-1", PortId: "icacontroller-owner1", AccountAddress: "address1"},
+	}, res.InterchainAccounts)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountSummary() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	accAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	portID := "icacontroller-owner"
+
+	req := &types.QueryInterchainAccountSummaryRequest{ConnectionId: ibctesting.FirstConnectionID, PortId: portID}
+
+	_, err := app.ICAHostKeeper.InterchainAccountSummary(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+
+	_, err = app.ICAHostKeeper.InterchainAccountSummary(sdk.WrapSDKContext(ctx), &types.QueryInterchainAccountSummaryRequest{ConnectionId: "(connection)", PortId: portID})
+	suite.Require().Error(err)
+
+	// no interchain account is registered
+	_, err = app.ICAHostKeeper.InterchainAccountSummary(sdk.WrapSDKContext(ctx), req)
+	suite.Require().Error(err)
+
+	app.ICAHostKeeper.SetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID, accAddr.String())
+
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), accAddr, funds))
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	shares, err := app.StakingKeeper.Delegate(ctx, accAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, accAddr, validator.GetOperator(), shares.QuoInt64(4))
+	suite.Require().NoError(err)
+
+	res, err := app.ICAHostKeeper.InterchainAccountSummary(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	suite.Require().Equal(accAddr.String(), res.AccountAddress)
+	suite.Require().Equal(funds.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000)))), res.Balances)
+
+	suite.Require().Len(res.Delegations, 1)
+	suite.Require().Equal(validator.OperatorAddress, res.Delegations[0].ValidatorAddress)
+	suite.Require().Equal(sdk.NewCoin(bondDenom, sdk.NewInt(750)), res.Delegations[0].Balance)
+
+	suite.Require().Equal([]types.InterchainAccountUnbondingEntry{{
+		ValidatorAddress: validator.OperatorAddress,
+		CreationHeight:   ctx.BlockHeight(),
+		CompletionTime:   completionTime,
+		Balance:          sdk.NewCoin(bondDenom, sdk.NewInt(250)),
+	}}, res.UnbondingEntries)
+}
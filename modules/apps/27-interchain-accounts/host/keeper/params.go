@@ -21,9 +21,41 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetAuditLogRetention retrieves the number of blocks for which host executions are kept
+// in the audit log from the paramstore. A value of 0 means the audit log is disabled.
+func (k Keeper) GetAuditLogRetention(ctx sdk.Context) uint64 {
+	var res uint64
+	// the parameter may not be set on chains which were initialised before it was introduced
+	k.paramSpace.GetIfExists(ctx, types.KeyAuditLogRetention, &res)
+	return res
+}
+
+// GetConnectionAllowMessages retrieves the connection specific msg types allowlists from the paramstore.
+func (k Keeper) GetConnectionAllowMessages(ctx sdk.Context) []types.ConnectionAllowMessages {
+	var res []types.ConnectionAllowMessages
+	// the parameter may not be set on chains which were initialised before it was introduced
+	k.paramSpace.GetIfExists(ctx, types.KeyConnectionAllowMessages, &res)
+	return res
+}
+
+// GetEffectiveAllowMessages returns the msg types allowed to be executed by the interchain accounts
+// registered on the provided connection, which are given by the allowlist of the connection if it is
+// set and by the AllowMessages param otherwise.
+func (k Keeper) GetEffectiveAllowMessages(ctx sdk.Context, connectionID string) []string {
+	params := types.Params{
+		AllowMessages:           k.GetAllowMessages(ctx),
+		ConnectionAllowMessages: k.GetConnectionAllowMessages(ctx),
+	}
+
+	return params.AllowMessagesForConnection(connectionID)
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params := types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params.AuditLogRetention = k.GetAuditLogRetention(ctx)
+	params.ConnectionAllowMessages = k.GetConnectionAllowMessages(ctx)
+	return params
 }
 
 // SetParams sets the total set of the host submodule parameters.
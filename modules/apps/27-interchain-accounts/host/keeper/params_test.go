@@ -10,7 +10,25 @@ func (suite *KeeperTestSuite) TestParams() {
 
 	expParams.HostEnabled = false
 	expParams.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	expParams.AuditLogRetention = 100
+	expParams.ConnectionAllowMessages = []types.ConnectionAllowMessages{
+		{ConnectionId: "connection-0", AllowMessages: []string{types.AllowAllHostMsgs}},
+	}
 	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
+
+func (suite *KeeperTestSuite) TestGetEffectiveAllowMessages() {
+	params := types.NewParams(true, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+	params.ConnectionAllowMessages = []types.ConnectionAllowMessages{
+		{ConnectionId: "connection-0", AllowMessages: []string{types.AllowAllHostMsgs}},
+	}
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	allowMsgs := suite.chainA.GetSimApp().ICAHostKeeper.GetEffectiveAllowMessages(suite.chainA.GetContext(), "connection-0")
+	suite.Require().Equal([]string{types.AllowAllHostMsgs}, allowMsgs)
+
+	allowMsgs = suite.chainA.GetSimApp().ICAHostKeeper.GetEffectiveAllowMessages(suite.chainA.GetContext(), "connection-1")
+	suite.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, allowMsgs)
+}
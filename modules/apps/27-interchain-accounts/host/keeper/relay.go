@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
@@ -11,7 +12,8 @@ import (
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
-// If the transaction is successfully executed, the transaction response bytes will be returned.
+// If the transaction is successfully executed, the transaction response bytes will be returned,
+// compressed with the acknowledgement compression negotiated for the channel.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
@@ -20,6 +22,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
+	if data.Memo != "" {
+		EmitPacketMemoEvent(ctx, packet, data.Memo)
+	}
+
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
 		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
@@ -27,17 +33,36 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, err
 		}
 
+		gasBefore := ctx.GasMeter().GasConsumed()
 		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
 		if err != nil {
 			return nil, err
 		}
 
-		return txResponse, nil
+		k.recordExecution(ctx, packet, data.Memo, msgs, ctx.GasMeter().GasConsumed()-gasBefore)
+
+		return k.compressAckResult(ctx, packet.DestinationPort, packet.DestinationChannel, txResponse)
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
+// compressAckResult compresses the provided acknowledgement result with the acknowledgement
+// compression negotiated in the version of the provided channel.
+func (k Keeper) compressAckResult(ctx sdk.Context, portID, channelID string, result []byte) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &metadata); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return icatypes.CompressAckResult(metadata.AckCompression, result)
+}
+
 // executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
@@ -89,17 +114,18 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier. The msgs executed by an authz MsgExec on behalf
+// of the accounts which granted permissions to the interchain account must be allowed as well.
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
+	allowMsgs := k.GetEffectiveAllowMessages(ctx, connectionID)
 	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		if err := validateMsgAllowed(allowMsgs, msg); err != nil {
+			return err
 		}
 
 		for _, signer := range msg.GetSigners() {
@@ -112,6 +138,33 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 	return nil
 }
 
+// validateMsgAllowed returns an error if the type of the provided msg, or of any msg it executes as an authz
+// MsgExec, is not allowed on the host chain. The grants of the inner msgs are checked by the authz module
+// on execution.
+func validateMsgAllowed(allowMsgs []string, msg sdk.Msg) error {
+	if !types.ContainsMsgType(allowMsgs, msg) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil
+	}
+
+	innerMsgs, err := execMsg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, innerMsg := range innerMsgs {
+		if err := validateMsgAllowed(allowMsgs, innerMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
@@ -29,7 +29,14 @@ type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	// The "*" wildcard allows all messages.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// audit_log_retention defines the number of blocks for which host executions are kept
+	// in the audit log. A value of 0 disables the audit log.
+	AuditLogRetention uint64 `protobuf:"varint,3,opt,name=audit_log_retention,json=auditLogRetention,proto3" json:"audit_log_retention,omitempty" yaml:"audit_log_retention"`
+	// connection_allow_messages defines the messages allowed to be executed by the interchain accounts
+	// registered on specific connections, which replace allow_messages for these connections.
+	ConnectionAllowMessages []ConnectionAllowMessages `protobuf:"bytes,4,rep,name=connection_allow_messages,json=connectionAllowMessages,proto3" json:"connection_allow_messages" yaml:"connection_allow_messages"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +86,199 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAuditLogRetention() uint64 {
+	if m != nil {
+		return m.AuditLogRetention
+	}
+	return 0
+}
+
+func (m *Params) GetConnectionAllowMessages() []ConnectionAllowMessages {
+	if m != nil {
+		return m.ConnectionAllowMessages
+	}
+	return nil
+}
+
+// ConnectionAllowMessages defines the sdk message typeURLs allowed to be executed by the interchain
+// accounts registered on a connection.
+type ConnectionAllowMessages struct {
+	// connection on which the interchain accounts are registered
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// list of sdk message typeURLs allowed to be executed, the "*" wildcard allows all messages
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *ConnectionAllowMessages) Reset()         { *m = ConnectionAllowMessages{} }
+func (m *ConnectionAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*ConnectionAllowMessages) ProtoMessage()    {}
+func (*ConnectionAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ConnectionAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionAllowMessages.Merge(m, src)
+}
+func (m *ConnectionAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionAllowMessages proto.InternalMessageInfo
+
+func (m *ConnectionAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+// AuditLogEntry records the successful execution of an interchain accounts transaction on the host chain.
+// Failed executions are not audited as their state changes are discarded by core IBC.
+type AuditLogEntry struct {
+	// height at which the transaction was executed
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// connection on which the interchain account is registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// host channel on which the packet was received
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// sequence of the received packet
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// controller port identifier of the interchain account
+	ControllerPortId string `protobuf:"bytes,5,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty" yaml:"controller_port_id"`
+	// owner of the interchain account on the controller chain
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// message type URLs contained in the transaction
+	MsgTypeUrls []string `protobuf:"bytes,7,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// gas consumed by the execution
+	GasUsed uint64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// memo of the received packet, recorded as provided by the controller chain
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *AuditLogEntry) Reset()         { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()    {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *AuditLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogEntry.Merge(m, src)
+}
+func (m *AuditLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogEntry proto.InternalMessageInfo
+
+func (m *AuditLogEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetControllerPortId() string {
+	if m != nil {
+		return m.ControllerPortId
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ConnectionAllowMessages)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionAllowMessages")
+	proto.RegisterType((*AuditLogEntry)(nil), "ibc.applications.interchain_accounts.host.v1.AuditLogEntry")
 }
 
 func init() {
@@ -88,26 +286,46 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x8d, 0x71, 0x08, 0xc9, 0x40, 0xda, 0x32, 0x40, 0x31, 0x48, 0xb5, 0xa3, 0x59, 0x65, 0x51,
+	0x6c, 0x01, 0x95, 0x90, 0x50, 0x2b, 0x95, 0x54, 0x2c, 0xe8, 0x4b, 0xc8, 0x2a, 0x9b, 0x6e, 0xac,
+	0xc9, 0x78, 0xe4, 0x58, 0xb2, 0x67, 0xd2, 0x99, 0x31, 0x28, 0xfb, 0x7e, 0x40, 0xd7, 0x95, 0xfa,
+	0x05, 0xfd, 0x11, 0x96, 0x2c, 0xbb, 0xb2, 0x2a, 0xf8, 0x03, 0x7f, 0x41, 0xe5, 0x71, 0x08, 0x09,
+	0x8f, 0x05, 0x52, 0x57, 0xf6, 0xb9, 0xe7, 0x9e, 0x33, 0x67, 0x1e, 0xba, 0x60, 0x2f, 0xee, 0x13,
+	0x0f, 0x0f, 0x87, 0x49, 0x4c, 0xb0, 0x8a, 0x39, 0x93, 0x5e, 0xcc, 0x14, 0x15, 0x64, 0x80, 0x63,
+	0x16, 0x60, 0x42, 0x78, 0xc6, 0x94, 0xf4, 0x06, 0x5c, 0x2a, 0xef, 0x74, 0x5b, 0x7f, 0xdd, 0xa1,
+	0xe0, 0x8a, 0xc3, 0x97, 0x71, 0x9f, 0xb8, 0xd3, 0x42, 0xf7, 0x1e, 0xa1, 0xab, 0x05, 0xa7, 0xdb,
+	0x9b, 0xab, 0x11, 0x8f, 0xb8, 0x16, 0x7a, 0xe5, 0x5f, 0xe5, 0x81, 0xbe, 0x9b, 0xa0, 0x71, 0x8c,
+	0x05, 0x4e, 0x25, 0xdc, 0x07, 0x4b, 0x65, 0x6f, 0x40, 0x19, 0xee, 0x27, 0x34, 0xb4, 0x8c, 0x8e,
+	0xd1, 0x6d, 0xf6, 0xd6, 0x8b, 0xdc, 0x59, 0x19, 0xe1, 0x34, 0xd9, 0x47, 0xd3, 0x2c, 0xf2, 0x17,
+	0x4b, 0x78, 0x58, 0x21, 0xf8, 0x16, 0x3c, 0xc1, 0x49, 0xc2, 0xcf, 0x82, 0x94, 0x4a, 0x89, 0x23,
+	0x2a, 0xad, 0xb9, 0x8e, 0xd9, 0x6d, 0xf5, 0x36, 0x8a, 0xdc, 0x59, 0xab, 0xd4, 0xb3, 0x3c, 0xf2,
+	0xdb, 0xba, 0xf0, 0x69, 0x8c, 0xe1, 0x67, 0xb0, 0x82, 0xb3, 0x30, 0x56, 0x41, 0xc2, 0xa3, 0x40,
+	0x50, 0x45, 0x59, 0xb9, 0x25, 0xcb, 0xec, 0x18, 0xdd, 0x7a, 0xcf, 0x2e, 0x72, 0x67, 0x73, 0x6c,
+	0x73, 0xb7, 0x09, 0xf9, 0xcb, 0xba, 0xfa, 0x91, 0x47, 0xfe, 0x75, 0x0d, 0xfe, 0x36, 0xc0, 0x06,
+	0xe1, 0x8c, 0x51, 0x52, 0xc2, 0xe0, 0x56, 0xba, 0x7a, 0xc7, 0xec, 0x2e, 0xee, 0x1c, 0xba, 0x8f,
+	0x39, 0x41, 0xf7, 0xdd, 0xc4, 0xee, 0x60, 0x3a, 0x7a, 0xaf, 0x7b, 0x9e, 0x3b, 0xb5, 0x22, 0x77,
+	0x3a, 0x55, 0xc2, 0x07, 0x57, 0x45, 0xfe, 0x3a, 0xb9, 0xdf, 0x02, 0xfd, 0x34, 0xc0, 0xfa, 0x03,
+	0xf6, 0xf0, 0x0d, 0x68, 0x4f, 0x59, 0xc6, 0xd5, 0xc5, 0xb4, 0x7a, 0x56, 0x91, 0x3b, 0xab, 0x77,
+	0x56, 0x8c, 0x43, 0xe4, 0x2f, 0xdd, 0xe0, 0xa3, 0xff, 0x70, 0x35, 0xe8, 0x97, 0x09, 0xda, 0x07,
+	0xe3, 0x03, 0x3e, 0x64, 0x4a, 0x8c, 0xe0, 0x73, 0xd0, 0x18, 0xd0, 0x38, 0x1a, 0x28, 0x9d, 0xa5,
+	0xee, 0x8f, 0xd1, 0xdd, 0xa8, 0x73, 0x8f, 0x8a, 0xfa, 0x0a, 0x00, 0x32, 0xc0, 0x8c, 0xd1, 0xa4,
+	0xd4, 0x9a, 0x5a, 0xbb, 0x56, 0xe4, 0xce, 0xf2, 0x58, 0x3b, 0xe1, 0x90, 0xdf, 0x1a, 0x83, 0xa3,
+	0x10, 0x6e, 0x82, 0xa6, 0xa4, 0xdf, 0x32, 0xca, 0x08, 0xb5, 0xea, 0x3a, 0xce, 0x04, 0xc3, 0x0f,
+	0x00, 0x12, 0xce, 0x94, 0xe0, 0x49, 0x42, 0x45, 0x30, 0xe4, 0x42, 0x95, 0xce, 0xf3, 0xda, 0xf9,
+	0x45, 0x91, 0x3b, 0x1b, 0x93, 0x54, 0xb7, 0x7a, 0x90, 0xff, 0xec, 0xa6, 0x78, 0xcc, 0x85, 0x3a,
+	0x0a, 0xe1, 0x2a, 0x98, 0xe7, 0x67, 0x8c, 0x0a, 0xab, 0x51, 0xea, 0xfd, 0x0a, 0xc0, 0xd7, 0xa0,
+	0x9d, 0xca, 0x28, 0x50, 0xa3, 0x21, 0x0d, 0x32, 0x91, 0x48, 0x6b, 0xa1, 0x63, 0xce, 0xee, 0x79,
+	0x86, 0x46, 0xfe, 0x62, 0x2a, 0xa3, 0x2f, 0xa3, 0x21, 0x3d, 0x11, 0x89, 0x84, 0x2e, 0x68, 0x46,
+	0x58, 0x06, 0x99, 0xa4, 0xa1, 0xd5, 0xd4, 0x6f, 0x7d, 0xa5, 0xc8, 0x9d, 0xa7, 0x95, 0xf0, 0x9a,
+	0x41, 0xfe, 0x42, 0x84, 0xe5, 0x89, 0xa4, 0x21, 0x84, 0xa0, 0x9e, 0xd2, 0x94, 0x5b, 0x2d, 0x1d,
+	0x41, 0xff, 0xf7, 0xc2, 0xf3, 0x4b, 0xdb, 0xb8, 0xb8, 0xb4, 0x8d, 0xbf, 0x97, 0xb6, 0xf1, 0xe3,
+	0xca, 0xae, 0x5d, 0x5c, 0xd9, 0xb5, 0x3f, 0x57, 0x76, 0xed, 0xeb, 0xfb, 0x28, 0x56, 0x83, 0xac,
+	0xef, 0x12, 0x9e, 0x7a, 0x84, 0xcb, 0x94, 0x4b, 0x2f, 0xee, 0x93, 0xad, 0x88, 0x7b, 0xa7, 0xbb,
+	0x5e, 0xca, 0xc3, 0x2c, 0xa1, 0xb2, 0x1c, 0x3d, 0xd2, 0xdb, 0xd9, 0xdb, 0xba, 0x79, 0xfa, 0x5b,
+	0xb3, 0x53, 0xa7, 0xcc, 0x2e, 0xfb, 0x0d, 0x3d, 0x30, 0x76, 0xff, 0x0d, 0x00, 0xb6, 0xef, 0xf7,
+	0xad, 0xaf, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +348,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionAllowMessages) > 0 {
+		for iNdEx := len(m.ConnectionAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AuditLogRetention != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.AuditLogRetention))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +389,127 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +536,78 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if m.AuditLogRetention != 0 {
+		n += 1 + sovHost(uint64(m.AuditLogRetention))
+	}
+	if len(m.ConnectionAllowMessages) > 0 {
+		for _, e := range m.ConnectionAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuditLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHost(uint64(m.Height))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovHost(uint64(m.Sequence))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovHost(uint64(m.GasUsed))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
 	return n
 }
 
@@ -268,6 +698,472 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogRetention", wireType)
+			}
+			m.AuditLogRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditLogRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAllowMessages = append(m.ConnectionAllowMessages, ConnectionAllowMessages{})
+			if err := m.ConnectionAllowMessages[len(m.ConnectionAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -12,10 +12,29 @@ const (
 	StoreKey = SubModuleName
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+var (
+	// AuditLogKeyPrefix defines the key prefix used to store audit log entries
+	AuditLogKeyPrefix = []byte("auditLog/")
+)
+
+// KeyAuditLogHeight returns the key prefix of the audit log entries recorded at the
+// provided height. The height is big endian encoded so that entries are ordered by height.
+func KeyAuditLogHeight(height uint64) []byte {
+	return append(append([]byte{}, AuditLogKeyPrefix...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyAuditLogEntry returns the key under which the audit log entry of the packet with
+// the provided host channel and sequence, executed at the provided height, is stored.
+func KeyAuditLogEntry(height uint64, channelID string, sequence uint64) []byte {
+	key := append(KeyAuditLogHeight(height), []byte(channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs or allowMsgs contains
+// the AllowAllHostMsgs wildcard, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+		if v == AllowAllHostMsgs || v == sdk.MsgTypeURL(msg) {
 			return true
 		}
 	}
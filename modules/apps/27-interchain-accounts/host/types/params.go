@@ -5,11 +5,17 @@ import (
 	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultAuditLogRetention is the default value for the audit log retention param (set to 0, disabled)
+	DefaultAuditLogRetention = uint64(0)
+	// AllowAllHostMsgs is the wildcard allowing all messages to be executed on the host chain
+	AllowAllHostMsgs = "*"
 )
 
 var (
@@ -17,6 +23,10 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyAuditLogRetention is the store key for the AuditLogRetention Params
+	KeyAuditLogRetention = []byte("AuditLogRetention")
+	// KeyConnectionAllowMessages is the store key for the ConnectionAllowMessages Params
+	KeyConnectionAllowMessages = []byte("ConnectionAllowMessages")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -47,14 +57,37 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAuditLogRetention(p.AuditLogRetention); err != nil {
+		return err
+	}
+
+	if err := validateConnectionAllowlists(p.ConnectionAllowMessages); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// AllowMessagesForConnection returns the messages allowed to be executed by the interchain accounts
+// registered on the provided connection. The allowlist of the connection is returned if it is set,
+// otherwise the allowlist shared by all connections is returned.
+func (p Params) AllowMessagesForConnection(connectionID string) []string {
+	for _, connectionAllowMsgs := range p.ConnectionAllowMessages {
+		if connectionAllowMsgs.ConnectionId == connectionID {
+			return connectionAllowMsgs.AllowMessages
+		}
+	}
+
+	return p.AllowMessages
+}
+
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAuditLogRetention, p.AuditLogRetention, validateAuditLogRetention),
+		paramtypes.NewParamSetPair(KeyConnectionAllowMessages, &p.ConnectionAllowMessages, validateConnectionAllowlists),
 	}
 }
 
@@ -81,3 +114,37 @@ func validateAllowlist(i interface{}) error {
 
 	return nil
 }
+
+func validateAuditLogRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateConnectionAllowlists(i interface{}) error {
+	connectionAllowMsgs, ok := i.([]ConnectionAllowMessages)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, allowlist := range connectionAllowMsgs {
+		if err := host.ConnectionIdentifierValidator(allowlist.ConnectionId); err != nil {
+			return fmt.Errorf("invalid connection identifier %s: %w", allowlist.ConnectionId, err)
+		}
+
+		if seen[allowlist.ConnectionId] {
+			return fmt.Errorf("duplicate allowlist for connection %s", allowlist.ConnectionId)
+		}
+		seen[allowlist.ConnectionId] = true
+
+		if err := validateAllowlist(allowlist.AllowMessages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
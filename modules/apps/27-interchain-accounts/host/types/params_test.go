@@ -11,4 +11,39 @@ import (
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(true, []string{types.AllowAllHostMsgs}).Validate())
+
+	testCases := []struct {
+		name      string
+		allowMsgs []types.ConnectionAllowMessages
+		expPass   bool
+	}{
+		{"valid connection allowlists", []types.ConnectionAllowMessages{{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}}, {ConnectionId: "connection-1", AllowMessages: []string{types.AllowAllHostMsgs}}}, true},
+		{"empty connection allowlist", []types.ConnectionAllowMessages{{ConnectionId: "connection-0", AllowMessages: []string{}}}, true},
+		{"invalid connection identifier", []types.ConnectionAllowMessages{{ConnectionId: "", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}}}, false},
+		{"duplicate connection", []types.ConnectionAllowMessages{{ConnectionId: "connection-0"}, {ConnectionId: "connection-0"}}, false},
+		{"empty message type", []types.ConnectionAllowMessages{{ConnectionId: "connection-0", AllowMessages: []string{" "}}}, false},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		params.ConnectionAllowMessages = tc.allowMsgs
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAllowMessagesForConnection(t *testing.T) {
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	params.ConnectionAllowMessages = []types.ConnectionAllowMessages{
+		{ConnectionId: "connection-1", AllowMessages: []string{types.AllowAllHostMsgs}},
+	}
+
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, params.AllowMessagesForConnection("connection-0"))
+	require.Equal(t, []string{types.AllowAllHostMsgs}, params.AllowMessagesForConnection("connection-1"))
 }
@@ -15,7 +15,6 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/client/cli"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller"
 	controllerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
@@ -44,7 +43,9 @@ func (AppModuleBasic) Name() string {
 }
 
 // RegisterLegacyAminoCodec implements AppModuleBasic.
-func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
 
 // RegisterInterfaces registers module concrete types into protobuf Any
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -77,14 +78,16 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	hosttypes.RegisterQueryHandlerClient(context.Background(), mux, hosttypes.NewQueryClient(clientCtx))
 }
 
-// GetTxCmd implements AppModuleBasic interface
+// GetTxCmd implements AppModuleBasic interface. The interchain accounts transaction commands are
+// registered under the ibc transaction namespace.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
 }
 
-// GetQueryCmd implements AppModuleBasic interface
+// GetQueryCmd implements AppModuleBasic interface. The interchain accounts query commands are
+// registered under the ibc query namespace.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
+	return nil
 }
 
 // AppModule is the application module for the IBC interchain accounts module
@@ -141,6 +144,9 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	controllertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	if am.controllerKeeper != nil {
+		types.RegisterMsgServer(cfg.MsgServer(), controllerkeeper.NewMsgServerImpl(*am.controllerKeeper))
+	}
 	hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
 }
 
@@ -190,5 +196,13 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.DispatchScheduledTxs(ctx)
+	}
+
+	if am.hostKeeper != nil {
+		am.hostKeeper.PruneAuditLog(ctx)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &SendTxAuthorization{}
+
+// NewSendTxAuthorization creates a new SendTxAuthorization allowing the given message types to be executed on the
+// host chain.
+func NewSendTxAuthorization(msgTypeURLs ...string) *SendTxAuthorization {
+	return &SendTxAuthorization{
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendTxAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendTx{})
+}
+
+// Accept implements Authorization.Accept. The MsgSendTx is accepted if all the messages of its packet data are of
+// one of the allowed message types. The authorization is not updated.
+func (a SendTxAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSendTx, ok := msg.(*MsgSendTx)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	// the messages are not unpacked, only their type URLs are checked
+	var cosmosTx CosmosTx
+	if err := cosmosTx.Unmarshal(msgSendTx.PacketData.Data); err != nil {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrInvalidOutgoingData, "cannot unmarshal packet data transaction: %v", err)
+	}
+
+	if len(cosmosTx.Messages) == 0 {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data transaction does not contain any message")
+	}
+
+	for _, msgAny := range cosmosTx.Messages {
+		if !a.isAllowed(msgAny.TypeUrl) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("message type %s is not allowed", msgAny.TypeUrl)
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendTxAuthorization) ValidateBasic() error {
+	if len(a.MsgTypeUrls) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed message type URLs cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range a.MsgTypeUrls {
+		if msgTypeURL == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowed message type URL cannot be empty")
+		}
+		if seen[msgTypeURL] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed message type URL %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+// isAllowed returns true if the provided message type URL is allowed by the authorization.
+func (a SendTxAuthorization) isAllowed(msgTypeURL string) bool {
+	for _, allowed := range a.MsgTypeUrls {
+		if allowed == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
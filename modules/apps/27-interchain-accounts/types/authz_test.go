@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *TypesTestSuite) TestSendTxAuthorizationValidateBasic() {
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name          string
+		authorization *types.SendTxAuthorization
+		expPass       bool
+	}{
+		{"success", types.NewSendTxAuthorization(msgSendTypeURL, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})), true},
+		{"no message type URLs", types.NewSendTxAuthorization(), false},
+		{"empty message type URL", types.NewSendTxAuthorization(msgSendTypeURL, ""), false},
+		{"duplicate message type URL", types.NewSendTxAuthorization(msgSendTypeURL, msgSendTypeURL), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestSendTxAuthorizationAccept() {
+	authorization := types.NewSendTxAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgSendTx{}), authorization.MsgTypeURL())
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		expPass bool
+	}{
+		{"success", []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}}, true},
+		{"message type not allowed", []sdk.Msg{&banktypes.MsgSend{}, &stakingtypes.MsgDelegate{}}, false},
+		{"no messages", []sdk.Msg{}, false},
+	}
+
+	for _, tc := range testCases {
+		data, err := types.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), tc.msgs)
+		suite.Require().NoError(err)
+
+		packetData := types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: data}
+		msg := types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, packetData, 1)
+
+		res, err := authorization.Accept(suite.chainA.GetContext(), msg)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().True(res.Accept, tc.name)
+			suite.Require().Nil(res.Updated, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+
+	// invalid transaction bytes
+	msg := types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("invalid")}, 1)
+	_, err := authorization.Accept(suite.chainA.GetContext(), msg)
+	suite.Require().Error(err)
+
+	// message type mismatch
+	_, err = authorization.Accept(suite.chainA.GetContext(), &banktypes.MsgSend{})
+	suite.Require().Error(err)
+}
@@ -5,23 +5,50 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 var (
+	amino = codec.NewLegacyAmino()
+
 	// ModuleCdc references the global interchain accounts module codec. Note, the codec
 	// should ONLY be used in certain instances of tests and for JSON encoding.
 	//
 	// The actual codec used for serialization should be provided to interchain accounts and
 	// defined at the application level.
 	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino json compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
 )
 
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the necessary interchain accounts interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterInterchainAccount{}, "cosmos-sdk/MsgRegisterInterchainAccount", nil)
+	cdc.RegisterConcrete(&MsgSendTx{}, "cosmos-sdk/MsgSendInterchainTx", nil)
+	cdc.RegisterConcrete(&MsgReopenChannel{}, "cosmos-sdk/MsgReopenInterchainAccountChannel", nil)
+	cdc.RegisterConcrete(&MsgScheduleTx{}, "cosmos-sdk/MsgScheduleInterchainTx", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledTx{}, "cosmos-sdk/MsgCancelScheduledInterchainTx", nil)
+}
+
 // RegisterInterfaces registers the concrete InterchainAccount implementation against the associated
-// x/auth AccountI and GenesisAccount interfaces
+// x/auth AccountI and GenesisAccount interfaces, the interchain accounts controller messages and the
+// SendTxAuthorization against the x/authz Authorization interface
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*authtypes.AccountI)(nil), &InterchainAccount{})
 	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &InterchainAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterInterchainAccount{}, &MsgSendTx{}, &MsgReopenChannel{}, &MsgScheduleTx{}, &MsgCancelScheduledTx{})
+	registry.RegisterImplementations((*authz.Authorization)(nil), &SendTxAuthorization{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
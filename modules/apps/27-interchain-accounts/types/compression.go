@@ -0,0 +1,149 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// AckCompressionGzip defines the gzip compression of acknowledgement results
+	AckCompressionGzip = "gzip"
+
+	// AckCompressionZstd defines the zstd compression of acknowledgement results
+	AckCompressionZstd = "zstd"
+
+	// AckCompressionThreshold is the size in bytes above which acknowledgement results are
+	// compressed on channels which negotiated an acknowledgement compression
+	AckCompressionThreshold = 1024
+
+	// MaxDecompressedAckResultSize is the maximum size in bytes of a decompressed
+	// acknowledgement result
+	MaxDecompressedAckResultSize = 4 * 1024 * 1024
+)
+
+// Acknowledgement results of channels which negotiated an acknowledgement compression are
+// prefixed with a single byte indicating whether the result is compressed.
+const (
+	ackResultUncompressed byte = iota
+	ackResultCompressed
+)
+
+// CompressAckResult frames the provided acknowledgement result for a channel which negotiated
+// the provided acknowledgement compression. Results larger than the AckCompressionThreshold
+// are compressed. The result is returned unmodified if the compression is empty. The
+// compression is deterministic as the compression library version is pinned by the module.
+func CompressAckResult(compression string, result []byte) ([]byte, error) {
+	if compression == "" {
+		return result, nil
+	}
+
+	if len(result) <= AckCompressionThreshold {
+		return append([]byte{ackResultUncompressed}, result...), nil
+	}
+
+	compressed := bytes.NewBuffer([]byte{ackResultCompressed})
+	switch compression {
+	case AckCompressionGzip:
+		w, err := gzip.NewWriterLevel(compressed, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(result); err != nil {
+			return nil, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case AckCompressionZstd:
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+		if err != nil {
+			return nil, err
+		}
+		defer encoder.Close()
+
+		compressed.Write(encoder.EncodeAll(result, nil))
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidAckCompression, "unsupported acknowledgement compression %s", compression)
+	}
+
+	return compressed.Bytes(), nil
+}
+
+// DecompressAckResult returns the acknowledgement result framed by CompressAckResult for a
+// channel which negotiated the provided acknowledgement compression. The framed result is
+// returned unmodified if the compression is empty.
+func DecompressAckResult(compression string, bz []byte) ([]byte, error) {
+	if compression == "" {
+		return bz, nil
+	}
+
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidAckCompression, "acknowledgement result cannot be empty")
+	}
+
+	switch bz[0] {
+	case ackResultUncompressed:
+		return bz[1:], nil
+	case ackResultCompressed:
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidAckCompression, "invalid acknowledgement result prefix %d", bz[0])
+	}
+
+	var (
+		result []byte
+		err    error
+	)
+
+	switch compression {
+	case AckCompressionGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(bz[1:])); err != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidAckCompression, err.Error())
+		}
+
+		result, err = ioutil.ReadAll(io.LimitReader(r, MaxDecompressedAckResultSize+1))
+	case AckCompressionZstd:
+		var decoder *zstd.Decoder
+		if decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(MaxDecompressedAckResultSize+1)); err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+
+		result, err = decoder.DecodeAll(bz[1:], nil)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidAckCompression, "unsupported acknowledgement compression %s", compression)
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAckCompression, err.Error())
+	}
+
+	// only results larger than the threshold are compressed
+	if len(result) <= AckCompressionThreshold {
+		return nil, sdkerrors.Wrapf(ErrInvalidAckCompression, "compressed acknowledgement result must be larger than %d bytes", AckCompressionThreshold)
+	}
+
+	if len(result) > MaxDecompressedAckResultSize {
+		return nil, sdkerrors.Wrapf(ErrInvalidAckCompression, "decompressed acknowledgement result exceeds the maximum size of %d bytes", MaxDecompressedAckResultSize)
+	}
+
+	return result, nil
+}
+
+// isSupportedAckCompression returns true if the provided acknowledgement compression is
+// supported, otherwise false. An empty compression is always supported.
+func isSupportedAckCompression(compression string) bool {
+	switch compression {
+	case "", AckCompressionGzip, AckCompressionZstd:
+		return true
+	default:
+		return false
+	}
+}
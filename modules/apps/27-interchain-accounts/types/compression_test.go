@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"bytes"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+func (suite *TypesTestSuite) TestCompressAckResult() {
+	small := []byte("small result")
+	large := bytes.Repeat([]byte("large result "), types.AckCompressionThreshold)
+
+	// results are not framed without an acknowledgement compression
+	bz, err := types.CompressAckResult("", large)
+	suite.Require().NoError(err)
+	suite.Require().Equal(large, bz)
+
+	for _, compression := range []string{types.AckCompressionGzip, types.AckCompressionZstd} {
+		// results below the threshold are not compressed
+		bz, err := types.CompressAckResult(compression, small)
+		suite.Require().NoError(err)
+		suite.Require().Equal(append([]byte{0}, small...), bz)
+
+		result, err := types.DecompressAckResult(compression, bz)
+		suite.Require().NoError(err)
+		suite.Require().Equal(small, result)
+
+		bz, err = types.CompressAckResult(compression, large)
+		suite.Require().NoError(err)
+		suite.Require().Less(len(bz), len(large))
+
+		// compression is deterministic
+		again, err := types.CompressAckResult(compression, large)
+		suite.Require().NoError(err)
+		suite.Require().Equal(bz, again)
+
+		result, err = types.DecompressAckResult(compression, bz)
+		suite.Require().NoError(err)
+		suite.Require().Equal(large, result)
+
+		// decompressed results may not exceed the maximum size
+		bomb, err := types.CompressAckResult(compression, make([]byte, types.MaxDecompressedAckResultSize+1))
+		suite.Require().NoError(err)
+
+		_, err = types.DecompressAckResult(compression, bomb)
+		suite.Require().ErrorIs(err, types.ErrInvalidAckCompression)
+
+		_, err = types.DecompressAckResult(compression, []byte{1, 2, 3})
+		suite.Require().ErrorIs(err, types.ErrInvalidAckCompression)
+
+		_, err = types.DecompressAckResult(compression, []byte{2})
+		suite.Require().ErrorIs(err, types.ErrInvalidAckCompression)
+
+		_, err = types.DecompressAckResult(compression, nil)
+		suite.Require().ErrorIs(err, types.ErrInvalidAckCompression)
+	}
+
+	_, err = types.CompressAckResult("lz4", large)
+	suite.Require().ErrorIs(err, types.ErrInvalidAckCompression)
+}
@@ -2,8 +2,14 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket      = "ics27_packet"
+	EventTypeScheduledTx = "ics27_scheduled_tx"
 
-	AttributeKeyAckError      = "error"
-	AttributeKeyHostChannelID = "host_channel_id"
+	AttributeKeyAckError         = "error"
+	AttributeKeyHostChannelID    = "host_channel_id"
+	AttributeKeyScheduledTxID    = "scheduled_tx_id"
+	AttributeKeyControllerPortID = "controller_port_id"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyDispatchError    = "dispatch_error"
+	AttributeKeyMemo             = "memo"
 )
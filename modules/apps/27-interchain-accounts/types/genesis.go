@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -80,6 +82,22 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	scheduledTxIDs := make(map[uint64]bool)
+	for _, scheduledTx := range gs.ScheduledTxs {
+		if err := scheduledTx.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if scheduledTx.Id >= gs.NextScheduledTxId {
+			return sdkerrors.Wrapf(controllertypes.ErrInvalidScheduledTx, "scheduled transaction identifier %d must be less than the next identifier %d", scheduledTx.Id, gs.NextScheduledTxId)
+		}
+
+		if scheduledTxIDs[scheduledTx.Id] {
+			return sdkerrors.Wrapf(controllertypes.ErrInvalidScheduledTx, "duplicate scheduled transaction identifier %d", scheduledTx.Id)
+		}
+		scheduledTxIDs[scheduledTx.Id] = true
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -87,6 +105,31 @@ func (gs ControllerGenesisState) Validate() error {
 	return nil
 }
 
+// ValidateBasic performs basic validation of the ScheduledTx
+func (st ScheduledTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(st.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(st.PortId); err != nil {
+		return err
+	}
+
+	if err := st.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if st.NextHeight == 0 {
+		return sdkerrors.Wrap(controllertypes.ErrInvalidScheduledTx, "next height cannot be zero")
+	}
+
+	if st.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(controllertypes.ErrInvalidScheduledTx, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
 func DefaultHostGenesis() HostGenesisState {
 	return HostGenesisState{
@@ -84,6 +84,8 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ScheduledTxs       []ScheduledTx                 `protobuf:"bytes,5,rep,name=scheduled_txs,json=scheduledTxs,proto3" json:"scheduled_txs" yaml:"scheduled_txs"`
+	NextScheduledTxId  uint64                        `protobuf:"varint,6,opt,name=next_scheduled_tx_id,json=nextScheduledTxId,proto3" json:"next_scheduled_tx_id,omitempty" yaml:"next_scheduled_tx_id"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,6 +149,20 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetScheduledTxs() []ScheduledTx {
+	if m != nil {
+		return m.ScheduledTxs
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextScheduledTxId() uint64 {
+	if m != nil {
+		return m.NextScheduledTxId
+	}
+	return 0
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
@@ -338,12 +354,110 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 	return ""
 }
 
+// ScheduledTx defines interchain account packet data registered by the owner of an interchain account to be sent
+// by the controller submodule at the end of a future block, once or at a fixed interval of blocks
+type ScheduledTx struct {
+	Id           uint64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConnectionId string                      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId       string                      `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	PacketData   InterchainAccountPacketData `protobuf:"bytes,4,opt,name=packet_data,json=packetData,proto3" json:"packet_data" yaml:"packet_data"`
+	// height at which the packet data is sent next
+	NextHeight uint64 `protobuf:"varint,5,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty" yaml:"next_height"`
+	// number of blocks between two sends, the packet data is sent once if zero
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// timeout of the sent packets in nanoseconds, relative to the block time of the send
+	RelativeTimeout uint64 `protobuf:"varint,7,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty" yaml:"relative_timeout"`
+}
+
+func (m *ScheduledTx) Reset()         { *m = ScheduledTx{} }
+func (m *ScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTx) ProtoMessage()    {}
+func (*ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_629b3ced0911516b, []int{5}
+}
+func (m *ScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTx.Merge(m, src)
+}
+func (m *ScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTx proto.InternalMessageInfo
+
+func (m *ScheduledTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ScheduledTx) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ScheduledTx) GetPacketData() InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return InterchainAccountPacketData{}
+}
+
+func (m *ScheduledTx) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
 	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
 	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
 	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
 	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*ScheduledTx)(nil), "ibc.applications.interchain_accounts.v1.ScheduledTx")
 }
 
 func init() {
@@ -351,48 +465,61 @@ func init() {
 }
 
 var fileDescriptor_629b3ced0911516b = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xe3, 0xa4, 0xbf, 0xfe, 0x14, 0xf7, 0x0f, 0xc5, 0x94, 0xea, 0x08, 0xd2, 0x25, 0x78,
-	0x69, 0x24, 0xd4, 0x3b, 0xf5, 0x0f, 0x54, 0x54, 0x42, 0xa8, 0x17, 0x10, 0x64, 0x43, 0x66, 0x41,
-	0x2c, 0x27, 0xc7, 0x67, 0x25, 0x96, 0x92, 0x73, 0x74, 0x76, 0x23, 0x75, 0x62, 0x67, 0x62, 0x43,
-	0xac, 0x48, 0xbc, 0x00, 0xde, 0x01, 0x63, 0x27, 0xd4, 0x91, 0x29, 0x42, 0xed, 0x3b, 0xc8, 0x2b,
-	0x40, 0xf6, 0x9d, 0x92, 0xf4, 0x48, 0xab, 0x9b, 0x98, 0x98, 0x62, 0xdf, 0xe3, 0xef, 0xf7, 0xf9,
-	0x3c, 0x7e, 0x1c, 0x1b, 0x3e, 0x12, 0x1d, 0xe6, 0xd3, 0xe1, 0xb0, 0x2f, 0x18, 0xd5, 0x42, 0xc6,
-	0xca, 0x17, 0xb1, 0xe6, 0x09, 0xeb, 0x51, 0x11, 0x87, 0x94, 0x31, 0x79, 0x12, 0x6b, 0xe5, 0x8f,
-	0x76, 0xfd, 0x2e, 0x8f, 0xb9, 0x12, 0xca, 0x1b, 0x26, 0x52, 0x4b, 0xb4, 0x2d, 0x3a, 0xcc, 0x9b,
-	0x97, 0x79, 0x0b, 0x64, 0xde, 0x68, 0xb7, 0xb6, 0xd9, 0x95, 0x5d, 0x69, 0x35, 0xbe, 0x19, 0xa5,
-	0xf2, 0x5a, 0xab, 0x50, 0x56, 0x26, 0x63, 0x9d, 0xc8, 0x7e, 0x9f, 0x27, 0x06, 0x60, 0x36, 0xcb,
-	0x4c, 0x0e, 0x0b, 0x99, 0xf4, 0xa4, 0xd2, 0x46, 0x6e, 0x7e, 0x53, 0x21, 0xfe, 0x5e, 0x86, 0xab,
-	0x2f, 0xd3, 0x72, 0xde, 0x68, 0xaa, 0x39, 0xfa, 0x02, 0xa0, 0x33, 0xb3, 0x0f, 0xb3, 0x52, 0x43,
-	0x65, 0x82, 0x0e, 0x68, 0x80, 0xe6, 0xca, 0xde, 0x33, 0xaf, 0x60, 0xc5, 0x5e, 0x6b, 0x6a, 0x34,
-	0x9f, 0x23, 0xd8, 0x3e, 0x1b, 0xd7, 0x4b, 0x93, 0x71, 0xbd, 0x7e, 0x4a, 0x07, 0xfd, 0x23, 0x7c,
-	0x5d, 0x3a, 0x4c, 0xb6, 0xd8, 0x42, 0x03, 0xf4, 0x01, 0x40, 0x64, 0x8a, 0xc8, 0xe1, 0x95, 0x2d,
-	0xde, 0x93, 0xc2, 0x78, 0xaf, 0xa4, 0xd2, 0x57, 0xc0, 0x1e, 0x64, 0x60, 0xf7, 0x52, 0xb0, 0x3f,
-	0x53, 0x60, 0xb2, 0xd1, 0xcb, 0x89, 0xf0, 0xd7, 0x0a, 0xdc, 0x5a, 0x5c, 0x28, 0x7a, 0x0f, 0x6f,
-	0x51, 0xa6, 0xc5, 0x88, 0x87, 0xac, 0x47, 0xe3, 0x98, 0xf7, 0x95, 0x03, 0x1a, 0x95, 0xe6, 0xca,
-	0xde, 0xe3, 0xc2, 0x8c, 0xc7, 0x56, 0xdf, 0x4a, 0xe5, 0x81, 0x9b, 0x01, 0x6e, 0xa5, 0x80, 0x39,
-	0x73, 0x4c, 0xd6, 0xe9, 0xfc, 0x72, 0x85, 0x3e, 0x03, 0x78, 0x67, 0x81, 0xb1, 0x53, 0xb6, 0x14,
-	0xcf, 0x0b, 0x53, 0x10, 0xde, 0x15, 0x4a, 0xf3, 0x84, 0x47, 0xed, 0xe9, 0x82, 0xe3, 0x34, 0x1e,
-	0xe0, 0x8c, 0xa9, 0x96, 0x32, 0x2d, 0x70, 0xc0, 0x04, 0x89, 0xbc, 0x4c, 0xa1, 0x4d, 0xf8, 0xdf,
-	0x50, 0x26, 0x5a, 0x39, 0x95, 0x46, 0xa5, 0x59, 0x25, 0xe9, 0x04, 0xbd, 0x85, 0xcb, 0x43, 0x9a,
-	0xd0, 0x81, 0x72, 0x96, 0x6c, 0x37, 0x8f, 0x8a, 0x31, 0xce, 0xfd, 0x23, 0x46, 0xbb, 0xde, 0x6b,
-	0xeb, 0x10, 0x2c, 0x19, 0x32, 0x92, 0xf9, 0xe1, 0x4f, 0x15, 0xb8, 0x91, 0xef, 0xf8, 0xbf, 0x0e,
-	0xdd, 0xd4, 0x21, 0x04, 0x97, 0x4c, 0x53, 0x9c, 0x4a, 0x03, 0x34, 0xab, 0xc4, 0x8e, 0x11, 0xc9,
-	0xf5, 0xe7, 0xa0, 0x18, 0xa1, 0xbd, 0x72, 0xae, 0xeb, 0xcc, 0x37, 0x00, 0xd7, 0xae, 0xec, 0x22,
-	0x7a, 0x0a, 0xd7, 0x98, 0x8c, 0x63, 0xce, 0x8c, 0x63, 0x28, 0x22, 0x7b, 0xf3, 0x54, 0x03, 0x67,
-	0x32, 0xae, 0x6f, 0x4e, 0x2f, 0x8d, 0x59, 0x18, 0x93, 0xd5, 0xd9, 0xbc, 0x1d, 0xa1, 0x87, 0xf0,
-	0x7f, 0x03, 0x6b, 0x84, 0x65, 0x2b, 0x44, 0x93, 0x71, 0x7d, 0x3d, 0x15, 0x66, 0x01, 0x4c, 0x96,
-	0xcd, 0xa8, 0x1d, 0xa1, 0x03, 0x08, 0xb3, 0xf6, 0x98, 0xf5, 0xb6, 0xd6, 0xe0, 0xee, 0x64, 0x5c,
-	0xbf, 0x9d, 0x25, 0x9a, 0xc6, 0x30, 0xa9, 0x66, 0x93, 0x76, 0x84, 0x7f, 0x00, 0x78, 0xff, 0x86,
-	0x3d, 0xff, 0xab, 0x15, 0xb4, 0xcc, 0x21, 0xb6, 0x69, 0x43, 0x1a, 0x45, 0x09, 0x57, 0x2a, 0x2b,
-	0xa3, 0x36, 0x7f, 0x10, 0xaf, 0x2c, 0xb0, 0x07, 0xd1, 0x7e, 0x39, 0x4e, 0x3f, 0x04, 0xe1, 0xd9,
-	0x85, 0x0b, 0xce, 0x2f, 0x5c, 0xf0, 0xeb, 0xc2, 0x05, 0x1f, 0x2f, 0xdd, 0xd2, 0xf9, 0xa5, 0x5b,
-	0xfa, 0x79, 0xe9, 0x96, 0xde, 0xbd, 0xe8, 0x0a, 0xdd, 0x3b, 0xe9, 0x78, 0x4c, 0x0e, 0x7c, 0x26,
-	0xd5, 0x40, 0x2a, 0x5f, 0x74, 0xd8, 0x4e, 0x57, 0xfa, 0xa3, 0x7d, 0x7f, 0x20, 0xa3, 0x93, 0x3e,
-	0x57, 0xe6, 0xf1, 0x51, 0xfe, 0xde, 0xe1, 0xce, 0xac, 0xf9, 0x3b, 0xd3, 0x77, 0x47, 0x9f, 0x0e,
-	0xb9, 0xea, 0x2c, 0xdb, 0x17, 0x67, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x08, 0x8f,
-	0x1f, 0x67, 0x07, 0x00, 0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0x23, 0x35,
+	0x14, 0xee, 0x24, 0x69, 0x97, 0x3a, 0x6d, 0xb7, 0x6b, 0x4a, 0x19, 0x52, 0x94, 0x04, 0x5f, 0x36,
+	0x12, 0x6a, 0x46, 0xed, 0x16, 0x2a, 0x56, 0x42, 0xa8, 0xd3, 0x05, 0x36, 0xb7, 0xca, 0xbb, 0x07,
+	0xc4, 0x65, 0xe4, 0x78, 0xac, 0x8c, 0xc5, 0x64, 0x3c, 0x1a, 0xbb, 0xa1, 0x7b, 0x42, 0x5c, 0x39,
+	0x71, 0x43, 0x5c, 0x39, 0x72, 0xe3, 0x1f, 0x70, 0xdc, 0x13, 0xda, 0x23, 0xa7, 0x08, 0xb5, 0x7f,
+	0x00, 0xe5, 0x17, 0x20, 0x7b, 0xbc, 0x99, 0x49, 0x9a, 0x5d, 0x46, 0x1c, 0x38, 0xed, 0x29, 0xf3,
+	0xec, 0xf7, 0x7d, 0xef, 0x7b, 0xcf, 0xcf, 0x2f, 0x06, 0x1f, 0xf1, 0x21, 0xf5, 0x48, 0x9a, 0xc6,
+	0x9c, 0x12, 0xc5, 0x45, 0x22, 0x3d, 0x9e, 0x28, 0x96, 0xd1, 0x88, 0xf0, 0x24, 0x20, 0x94, 0x8a,
+	0xcb, 0x44, 0x49, 0x6f, 0x72, 0xe4, 0x8d, 0x58, 0xc2, 0x24, 0x97, 0xfd, 0x34, 0x13, 0x4a, 0xc0,
+	0xfb, 0x7c, 0x48, 0xfb, 0x65, 0x58, 0x7f, 0x05, 0xac, 0x3f, 0x39, 0x6a, 0xed, 0x8d, 0xc4, 0x48,
+	0x18, 0x8c, 0xa7, 0xbf, 0x72, 0x78, 0xeb, 0xbc, 0x52, 0x54, 0x2a, 0x12, 0x95, 0x89, 0x38, 0x66,
+	0x99, 0x16, 0x50, 0x58, 0x96, 0xe4, 0xb4, 0x12, 0x49, 0x24, 0xa4, 0xd2, 0x70, 0xfd, 0x6b, 0x81,
+	0x27, 0x55, 0x73, 0x4e, 0x09, 0xfd, 0x86, 0x59, 0x14, 0xfa, 0xbd, 0x06, 0xb6, 0xbe, 0xcc, 0x8b,
+	0xf0, 0x44, 0x11, 0xc5, 0xe0, 0x2f, 0x0e, 0x70, 0x0b, 0x51, 0x81, 0x2d, 0x50, 0x20, 0xf5, 0xa6,
+	0xeb, 0x74, 0x9d, 0x5e, 0xf3, 0xf8, 0xb3, 0x7e, 0xc5, 0x3a, 0xf5, 0xcf, 0xe7, 0x44, 0xe5, 0x18,
+	0xfe, 0xfd, 0xe7, 0xd3, 0xce, 0xda, 0x6c, 0xda, 0xe9, 0x3c, 0x23, 0xe3, 0xf8, 0x21, 0x7a, 0x55,
+	0x38, 0x84, 0xf7, 0xe9, 0x4a, 0x02, 0xf8, 0x83, 0x03, 0xa0, 0x4e, 0x7d, 0x49, 0x5e, 0xcd, 0xc8,
+	0xfb, 0xa4, 0xb2, 0xbc, 0xc7, 0x42, 0xaa, 0x05, 0x61, 0x1f, 0x58, 0x61, 0xef, 0xe5, 0xc2, 0x6e,
+	0x87, 0x40, 0x78, 0x37, 0x5a, 0x02, 0xa1, 0xbf, 0x1b, 0x60, 0x7f, 0x75, 0xa2, 0xf0, 0x3b, 0x70,
+	0x97, 0x50, 0xc5, 0x27, 0x2c, 0xa0, 0x11, 0x49, 0x12, 0x16, 0x4b, 0xd7, 0xe9, 0xd6, 0x7b, 0xcd,
+	0xe3, 0x8f, 0x2b, 0x6b, 0x3c, 0x33, 0xf8, 0xf3, 0x1c, 0xee, 0xb7, 0xad, 0xc0, 0xfd, 0x5c, 0xe0,
+	0x12, 0x39, 0xc2, 0x3b, 0xa4, 0xec, 0x2e, 0xe1, 0xcf, 0x0e, 0x78, 0x7b, 0x05, 0xb1, 0x5b, 0x33,
+	0x2a, 0x1e, 0x55, 0x56, 0x81, 0xd9, 0x88, 0x4b, 0xc5, 0x32, 0x16, 0x0e, 0xe6, 0x0e, 0x67, 0xf9,
+	0xbe, 0x8f, 0xac, 0xa6, 0x56, 0xae, 0x69, 0x05, 0x03, 0xc2, 0x90, 0x2f, 0xc3, 0x24, 0xdc, 0x03,
+	0xeb, 0xa9, 0xc8, 0x94, 0x74, 0xeb, 0xdd, 0x7a, 0x6f, 0x13, 0xe7, 0x06, 0xfc, 0x0a, 0x6c, 0xa4,
+	0x24, 0x23, 0x63, 0xe9, 0x36, 0xcc, 0x69, 0x3e, 0xac, 0xa6, 0xb1, 0x74, 0x8f, 0x26, 0x47, 0xfd,
+	0x0b, 0xc3, 0xe0, 0x37, 0xb4, 0x32, 0x6c, 0xf9, 0xe0, 0xb7, 0x60, 0x5b, 0xd2, 0x88, 0x85, 0x97,
+	0x31, 0x0b, 0x03, 0x75, 0x25, 0xdd, 0x75, 0x53, 0x84, 0x93, 0xca, 0x45, 0x78, 0xf2, 0x12, 0xfd,
+	0xf4, 0xca, 0x7f, 0xdf, 0x26, 0xbd, 0x97, 0x27, 0xbd, 0x40, 0x8c, 0xf0, 0x96, 0x2c, 0x5c, 0x25,
+	0xbc, 0x00, 0x7b, 0x09, 0xbb, 0x52, 0x41, 0xd9, 0x29, 0xe0, 0xa1, 0xbb, 0xd1, 0x75, 0x7a, 0x0d,
+	0xbf, 0x33, 0x9b, 0x76, 0x0e, 0x72, 0x96, 0x55, 0x5e, 0x08, 0xdf, 0xd3, 0xcb, 0xa5, 0xd8, 0x83,
+	0x10, 0xfd, 0x54, 0x07, 0xbb, 0xcb, 0xcd, 0xfb, 0xa6, 0xd9, 0x5e, 0xd7, 0x6c, 0x10, 0x34, 0x74,
+	0x7f, 0xb9, 0xf5, 0xae, 0xd3, 0xdb, 0xc4, 0xe6, 0x1b, 0xe2, 0xa5, 0x56, 0xab, 0xd8, 0x09, 0x66,
+	0xe6, 0xbe, 0xa2, 0xc9, 0xd0, 0x6f, 0x0e, 0xd8, 0x5e, 0xa8, 0x22, 0xfc, 0x14, 0x6c, 0x53, 0x91,
+	0x24, 0x8c, 0x6a, 0x46, 0x7d, 0xec, 0x7a, 0x88, 0x6e, 0xfa, 0x6e, 0xd1, 0x3c, 0x0b, 0xdb, 0x08,
+	0x6f, 0x15, 0xf6, 0x20, 0x84, 0x1f, 0x82, 0x3b, 0x5a, 0xac, 0x06, 0xd6, 0x0c, 0x10, 0xce, 0xa6,
+	0x9d, 0x9d, 0x1c, 0x68, 0x37, 0x10, 0xde, 0xd0, 0x5f, 0x83, 0x10, 0x9e, 0x00, 0x60, 0x8f, 0x47,
+	0xfb, 0x9b, 0x5c, 0xfd, 0x77, 0x66, 0xd3, 0xce, 0x3d, 0x1b, 0x68, 0xbe, 0x87, 0xf0, 0xa6, 0x35,
+	0x06, 0x21, 0xfa, 0xc3, 0x01, 0x07, 0xaf, 0xa9, 0xf9, 0xff, 0x9a, 0xc1, 0xb9, 0x6e, 0x62, 0x13,
+	0x36, 0x20, 0x61, 0x98, 0x31, 0x29, 0x6d, 0x1a, 0xad, 0x72, 0x23, 0x2e, 0x38, 0x98, 0x46, 0x34,
+	0x2b, 0x67, 0x76, 0xe1, 0xd7, 0x3a, 0x68, 0x96, 0x2e, 0x0c, 0xdc, 0x01, 0x35, 0xab, 0xba, 0x81,
+	0x6b, 0x3c, 0xbc, 0x9d, 0x50, 0xed, 0xbf, 0x26, 0x54, 0xff, 0xd7, 0x84, 0xbe, 0x77, 0x40, 0x33,
+	0xff, 0xc7, 0x0d, 0x42, 0xa2, 0x88, 0x6d, 0xb5, 0xea, 0x97, 0xe1, 0xd6, 0x71, 0x5c, 0x18, 0xb2,
+	0x47, 0x44, 0x11, 0xbf, 0x65, 0x2f, 0x03, 0xb4, 0xb1, 0x8b, 0x30, 0x08, 0x83, 0x74, 0xee, 0x07,
+	0x4f, 0x41, 0xd3, 0x8c, 0x96, 0x88, 0xf1, 0x51, 0xa4, 0xdc, 0x75, 0x33, 0x77, 0xf6, 0x0b, 0x60,
+	0x69, 0x13, 0x61, 0xa0, 0xad, 0xc7, 0xc6, 0x80, 0x2d, 0xf0, 0x96, 0x91, 0x35, 0x21, 0x71, 0x3e,
+	0xad, 0xf0, 0xdc, 0x86, 0x5f, 0x80, 0xdd, 0x8c, 0xc5, 0xc4, 0xcc, 0x04, 0xc5, 0xc7, 0x4c, 0x5c,
+	0x2a, 0xf7, 0x8e, 0x61, 0x3e, 0x98, 0x4d, 0x3b, 0xef, 0xe6, 0xcc, 0xcb, 0x1e, 0x08, 0xdf, 0x7d,
+	0xb9, 0xf4, 0x34, 0x5f, 0xf1, 0x83, 0xe7, 0xd7, 0x6d, 0xe7, 0xc5, 0x75, 0xdb, 0xf9, 0xeb, 0xba,
+	0xed, 0xfc, 0x78, 0xd3, 0x5e, 0x7b, 0x71, 0xd3, 0x5e, 0xfb, 0xf3, 0xa6, 0xbd, 0xf6, 0xf5, 0xe7,
+	0x23, 0xae, 0xa2, 0xcb, 0x61, 0x9f, 0x8a, 0xb1, 0x47, 0x85, 0x1c, 0x0b, 0xe9, 0xf1, 0x21, 0x3d,
+	0x1c, 0x09, 0x6f, 0xf2, 0xc0, 0x1b, 0x0b, 0x7d, 0xba, 0x52, 0xbf, 0x78, 0xa4, 0x77, 0x7c, 0x7a,
+	0x58, 0x94, 0xef, 0x70, 0xfe, 0xd8, 0x51, 0xcf, 0x52, 0x26, 0x87, 0x1b, 0xe6, 0xa5, 0xf3, 0xe0,
+	0x9f, 0x01, 0x00, 0x57, 0x57, 0x42, 0xd2, 0x15, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -458,6 +585,25 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledTxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledTxId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ScheduledTxs) > 0 {
+		for iNdEx := len(m.ScheduledTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -664,6 +810,73 @@ func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -714,6 +927,15 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledTxs) > 0 {
+		for _, e := range m.ScheduledTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledTxId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledTxId))
+	}
 	return n
 }
 
@@ -786,6 +1008,37 @@ func (m *RegisteredInterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovGenesis(uint64(m.Interval))
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1070,6 +1323,59 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTxs = append(m.ScheduledTxs, ScheduledTx{})
+			if err := m.ScheduledTxs[len(m.ScheduledTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTxId", wireType)
+			}
+			m.NextScheduledTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1566,6 +1872,229 @@ func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
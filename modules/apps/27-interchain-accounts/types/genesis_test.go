@@ -65,6 +65,7 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 	var (
 		genesisState types.ControllerGenesisState
+		scheduledTx  types.ScheduledTx
 	)
 
 	testCases := []struct {
@@ -168,11 +169,51 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 			},
 			false,
 		},
+		{
+			"success with scheduled transaction",
+			func() {
+				genesisState.ScheduledTxs = []types.ScheduledTx{scheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			true,
+		},
+		{
+			"failed to validate scheduled transaction - relative timeout is zero",
+			func() {
+				scheduledTx.RelativeTimeout = 0
+
+				genesisState.ScheduledTxs = []types.ScheduledTx{scheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transaction - identifier is not less than the next identifier",
+			func() {
+				genesisState.ScheduledTxs = []types.ScheduledTx{scheduledTx}
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transaction - duplicate identifier",
+			func() {
+				genesisState.ScheduledTxs = []types.ScheduledTx{scheduledTx, scheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			genesisState = types.DefaultControllerGenesis()
+			scheduledTx = types.ScheduledTx{
+				ConnectionId:    ibctesting.FirstConnectionID,
+				PortId:          TestPortID,
+				PacketData:      types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data")},
+				NextHeight:      10,
+				RelativeTimeout: 100,
+			}
 
 			tc.malleate() // malleate mutates test data
 
@@ -39,7 +39,8 @@ func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 		previousMetadata.ControllerConnectionId == metadata.ControllerConnectionId &&
 		previousMetadata.HostConnectionId == metadata.HostConnectionId &&
 		previousMetadata.Encoding == metadata.Encoding &&
-		previousMetadata.TxType == metadata.TxType)
+		previousMetadata.TxType == metadata.TxType &&
+		previousMetadata.AckCompression == metadata.AckCompression)
 }
 
 // ValidateControllerMetadata performs validation of the provided ICS27 controller metadata parameters
@@ -52,6 +53,10 @@ func ValidateControllerMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, co
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckCompression(metadata.AckCompression) {
+		return sdkerrors.Wrapf(ErrInvalidAckCompression, "unsupported acknowledgement compression %s", metadata.AckCompression)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -84,6 +89,10 @@ func ValidateHostMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, connecti
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckCompression(metadata.AckCompression) {
+		return sdkerrors.Wrapf(ErrInvalidAckCompression, "unsupported acknowledgement compression %s", metadata.AckCompression)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
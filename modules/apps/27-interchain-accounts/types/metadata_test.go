@@ -182,6 +182,36 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			false,
 		},
+		{
+			"success with acknowledgement compression",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckCompression:         types.AckCompressionZstd,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement compression",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckCompression:         "lz4",
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
@@ -324,6 +354,36 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			false,
 		},
+		{
+			"success with acknowledgement compression",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckCompression:         types.AckCompressionZstd,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement compression",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckCompression:         "lz4",
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
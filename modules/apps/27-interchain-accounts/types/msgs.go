@@ -0,0 +1,274 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSendTx                    = "send_tx"
+	TypeMsgReopenChannel             = "reopen_channel"
+	TypeMsgScheduleTx                = "schedule_tx"
+	TypeMsgCancelScheduledTx         = "cancel_scheduled_tx"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+	_ sdk.Msg = &MsgReopenChannel{}
+	_ sdk.Msg = &MsgScheduleTx{}
+	_ sdk.Msg = &MsgCancelScheduledTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+//
+//nolint:interfacer
+func NewMsgRegisterInterchainAccount(owner, connectionID string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner,
+		ConnectionId: connectionID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterInterchainAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterInterchainAccount) Type() string {
+	return TypeMsgRegisterInterchainAccount
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterInterchainAccount fields.
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance
+//
+//nolint:interfacer
+func NewMsgSendTx(owner, connectionID string, packetData InterchainAccountPacketData, relativeTimeout uint64) *MsgSendTx {
+	return &MsgSendTx{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSendTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSendTx) Type() string {
+	return TypeMsgSendTx
+}
+
+// ValidateBasic performs a basic check of the MsgSendTx fields.
+func (msg MsgSendTx) ValidateBasic() error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSendTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgReopenChannel creates a new MsgReopenChannel instance
+//
+//nolint:interfacer
+func NewMsgReopenChannel(owner, connectionID string) *MsgReopenChannel {
+	return &MsgReopenChannel{
+		Owner:        owner,
+		ConnectionId: connectionID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgReopenChannel) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgReopenChannel) Type() string {
+	return TypeMsgReopenChannel
+}
+
+// ValidateBasic performs a basic check of the MsgReopenChannel fields.
+func (msg MsgReopenChannel) ValidateBasic() error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgReopenChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReopenChannel) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgScheduleTx creates a new MsgScheduleTx instance
+//
+//nolint:interfacer
+func NewMsgScheduleTx(owner, connectionID string, packetData InterchainAccountPacketData, height, interval, relativeTimeout uint64) *MsgScheduleTx {
+	return &MsgScheduleTx{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		PacketData:      packetData,
+		Height:          height,
+		Interval:        interval,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgScheduleTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgScheduleTx) Type() string {
+	return TypeMsgScheduleTx
+}
+
+// ValidateBasic performs a basic check of the MsgScheduleTx fields.
+func (msg MsgScheduleTx) ValidateBasic() error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+	if msg.Height == 0 {
+		return sdkerrors.Wrap(controllertypes.ErrInvalidScheduledTx, "height cannot be zero")
+	}
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgScheduleTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgScheduleTx) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgCancelScheduledTx creates a new MsgCancelScheduledTx instance
+//
+//nolint:interfacer
+func NewMsgCancelScheduledTx(owner string, id uint64) *MsgCancelScheduledTx {
+	return &MsgCancelScheduledTx{
+		Owner: owner,
+		Id:    id,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgCancelScheduledTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgCancelScheduledTx) Type() string {
+	return TypeMsgCancelScheduledTx
+}
+
+// ValidateBasic performs a basic check of the MsgCancelScheduledTx fields.
+func (msg MsgCancelScheduledTx) ValidateBasic() error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgCancelScheduledTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelScheduledTx) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
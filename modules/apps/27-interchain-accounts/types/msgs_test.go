@@ -0,0 +1,244 @@
+package types_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *TypesTestSuite) TestMsgRegisterInterchainAccountValidateBasic() {
+	var msg *types.MsgRegisterInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgRegisterInterchainAccount(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestOwnerAddress, msg.GetSigners()[0].String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgSendTxValidateBasic() {
+	var msg *types.MsgSendTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				msg.PacketData.Type = types.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+			msg = types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, packetData, uint64(time.Hour))
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestOwnerAddress, msg.GetSigners()[0].String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgReopenChannelValidateBasic() {
+	var msg *types.MsgReopenChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestOwnerAddress, msg.GetSigners()[0].String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgScheduleTxValidateBasic() {
+	var msg *types.MsgScheduleTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				msg.PacketData.Type = types.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"height is zero",
+			func() {
+				msg.Height = 0
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+			msg = types.NewMsgScheduleTx(TestOwnerAddress, ibctesting.FirstConnectionID, packetData, 10, 5, uint64(time.Hour))
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestOwnerAddress, msg.GetSigners()[0].String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgCancelScheduledTxValidateBasic() {
+	msg := types.NewMsgCancelScheduledTx(TestOwnerAddress, 1)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(TestOwnerAddress, msg.GetSigners()[0].String())
+
+	msg.Owner = "invalid-address"
+	suite.Require().Error(msg.ValidateBasic())
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+)
+
+// GetCmdQueryBounty defines the command to query the bounty placed on a client.
+func GetCmdQueryBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bounty [client-id]",
+		Short:   "Query the misbehaviour bounty placed on a client",
+		Long:    "Query the misbehaviour bounty placed on a client",
+		Example: fmt.Sprintf("%s query ibc-bounty bounty [client-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBountyRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.Bounty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBounties defines the command to query all misbehaviour bounties.
+func GetCmdQueryBounties() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bounties",
+		Short:   "Query all misbehaviour bounties",
+		Long:    "Query all misbehaviour bounties",
+		Example: fmt.Sprintf("%s query ibc-bounty bounties", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBountiesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Bounties(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bounties")
+	return cmd
+}
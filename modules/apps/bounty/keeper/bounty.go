@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// FundBounty transfers the provided amount from the community pool to the bounty module
+// account and adds it to the bounty placed on the given client. The client must exist and
+// be active.
+func (k Keeper) FundBounty(ctx sdk.Context, clientID string, amount sdk.Coins) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "cannot fund bounty of client %s", clientID)
+	}
+
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot fund bounty of client (%s) with status %s", clientID, status)
+	}
+
+	// distribute the funds from the community pool, see the distribution module
+	// DistributeFromFeePool, as the bounty module account cannot receive funds
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return sdkerrors.Wrapf(types.ErrInsufficientFunds, "community pool %s is less than bounty amount %s", feePool.CommunityPool, amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = newPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	bounty, found := k.GetBounty(ctx, clientID)
+	if !found {
+		bounty = types.NewBounty(clientID, sdk.NewCoins())
+	}
+
+	bounty.Amount = bounty.Amount.Add(amount...)
+	k.SetBounty(ctx, bounty)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundBounty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// PayBounty pays the bounty placed on the given client to the submitter of the
+// misbehaviour which froze the client. The bounty is removed once paid so that only the
+// first submitter is rewarded.
+func (k Keeper) PayBounty(ctx sdk.Context, clientID string, submitter sdk.AccAddress) error {
+	bounty, found := k.GetBounty(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBountyNotFound, "client %s", clientID)
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "cannot pay bounty of client %s", clientID)
+	}
+
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc); status != exported.Frozen {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidMisbehaviour, "cannot pay bounty of client (%s) with status %s", clientID, status)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, submitter, bounty.Amount); err != nil {
+		return err
+	}
+
+	k.deleteBounty(ctx, clientID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayBounty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, bounty.Amount.String()),
+		),
+	)
+
+	return nil
+}
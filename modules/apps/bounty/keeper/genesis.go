@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+)
+
+// InitGenesis initializes the ibc bounty state. The bounty module account must hold the
+// escrowed amounts of the bounties.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, bounty := range state.Bounties {
+		k.SetBounty(ctx, bounty)
+	}
+
+	// check if the module account exists
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis exports the ibc bounty module's bounties into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Bounties: k.GetAllBounties(ctx),
+	}
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Bounty implements the Query/Bounty gRPC method
+func (q Keeper) Bounty(c context.Context, req *types.QueryBountyRequest) (*types.QueryBountyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	bounty, found := q.GetBounty(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(types.ErrBountyNotFound, "client-id: %s", req.ClientId).Error())
+	}
+
+	return &types.QueryBountyResponse{
+		Bounty: bounty,
+	}, nil
+}
+
+// Bounties implements the Query/Bounties gRPC method
+func (q Keeper) Bounties(c context.Context, req *types.QueryBountiesRequest) (*types.QueryBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bounties := []types.Bounty{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.BountyKeyPrefix)
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var bounty types.Bounty
+		if err := q.cdc.Unmarshal(value, &bounty); err != nil {
+			return err
+		}
+
+		bounties = append(bounties, bounty)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBountiesResponse{
+		Bounties:   bounties,
+		Pagination: pageRes,
+	}, nil
+}
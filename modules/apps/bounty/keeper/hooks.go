@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+var _ clienttypes.ClientHooks = Hooks{}
+
+// Hooks wraps the bounty keeper to implement the core IBC client hooks
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the client hooks paying out bounties on frozen clients
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterClientFrozen implements the ClientHooks interface. It pays the bounty placed on the
+// frozen client, if any, to the submitter of the misbehaviour. A failed payout does not
+// revert the freezing of the client, the bounty remains in escrow instead.
+func (h Hooks) AfterClientFrozen(ctx sdk.Context, clientID string, submitter sdk.AccAddress) {
+	if _, found := h.k.GetBounty(ctx, clientID); !found {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := h.k.PayBounty(cacheCtx, clientID, submitter); err != nil {
+		h.k.Logger(ctx).Error("failed to pay misbehaviour bounty", "client-id", clientID, "submitter", submitter.String(), "error", err.Error())
+		return
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
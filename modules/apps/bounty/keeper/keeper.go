@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the IBC misbehaviour bounty keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	authKeeper   types.AccountKeeper
+	bankKeeper   types.BankKeeper
+	distrKeeper  types.DistributionKeeper
+	clientKeeper types.ClientKeeper
+}
+
+// NewKeeper creates a new IBC misbehaviour bounty Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
+	clientKeeper types.ClientKeeper,
+) Keeper {
+
+	// ensure ibc bounty module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the IBC bounty module account has not been set")
+	}
+
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
+		clientKeeper: clientKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetBounty returns the bounty placed on the given client.
+func (k Keeper) GetBounty(ctx sdk.Context, clientID string) (types.Bounty, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyBounty(clientID))
+	if bz == nil {
+		return types.Bounty{}, false
+	}
+
+	var bounty types.Bounty
+	k.cdc.MustUnmarshal(bz, &bounty)
+	return bounty, true
+}
+
+// SetBounty stores the provided bounty.
+func (k Keeper) SetBounty(ctx sdk.Context, bounty types.Bounty) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyBounty(bounty.ClientId), k.cdc.MustMarshal(&bounty))
+}
+
+// deleteBounty removes the bounty placed on the given client.
+func (k Keeper) deleteBounty(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyBounty(clientID))
+}
+
+// IterateBounties iterates over all bounties, calling the provided callback on each
+// bounty until stop=true is returned.
+func (k Keeper) IterateBounties(ctx sdk.Context, cb func(bounty types.Bounty) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BountyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bounty types.Bounty
+		k.cdc.MustUnmarshal(iterator.Value(), &bounty)
+
+		if cb(bounty) {
+			break
+		}
+	}
+}
+
+// GetAllBounties returns all bounties.
+func (k Keeper) GetAllBounties(ctx sdk.Context) []types.Bounty {
+	bounties := []types.Bounty{}
+	k.IterateBounties(ctx, func(bounty types.Bounty) bool {
+		bounties = append(bounties, bounty)
+		return false
+	})
+
+	return bounties
+}
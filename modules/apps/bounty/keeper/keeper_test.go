@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var bountyAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(suite.path)
+
+	// fund the community pool of chainA
+	err := suite.chainA.GetSimApp().DistrKeeper.FundCommunityPool(suite.chainA.GetContext(), bountyAmount, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().NoError(err)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().BountyKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// freezeClient submits a conflicting header for the client of chainA from the provided
+// submitter through the IBC message server, freezing the client.
+func (suite *KeeperTestSuite) freezeClient(submitter sdk.AccAddress) {
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, suite.path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	// store a conflicting consensus state at the header height
+	conflictConsState := header.ConsensusState()
+	conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, header.GetHeight(), conflictConsState)
+
+	msg, err := clienttypes.NewMsgUpdateClient(suite.path.EndpointA.ClientID, header, submitter.String())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.GetSimApp().IBCKeeper.UpdateClient(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	clientState := suite.path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	suite.Require().False(clientState.FrozenHeight.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestFundBounty() {
+	var (
+		clientID string
+		amount   sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"client not found", func() {
+			clientID = ibctesting.InvalidID
+		}, false},
+		{"client is not active", func() {
+			clientState := suite.path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			suite.path.EndpointA.SetClientState(clientState)
+		}, false},
+		{"insufficient community pool funds", func() {
+			communityPool := suite.chainA.GetSimApp().DistrKeeper.GetFeePoolCommunityCoins(suite.chainA.GetContext())
+			amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, communityPool.AmountOf(sdk.DefaultBondDenom).TruncateInt().AddRaw(1)))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			clientID = suite.path.EndpointA.ClientID
+			amount = bountyAmount
+
+			tc.malleate()
+
+			app := suite.chainA.GetSimApp()
+			ctx := suite.chainA.GetContext()
+			communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			err := app.BountyKeeper.FundBounty(ctx, clientID, amount)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				bounty, found := app.BountyKeeper.GetBounty(ctx, clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewBounty(clientID, amount), bounty)
+
+				moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+				suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+				suite.Require().Equal(communityPool.Sub(sdk.NewDecCoinsFromCoins(amount...)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			} else {
+				suite.Require().Error(err)
+
+				_, found := app.BountyKeeper.GetBounty(ctx, clientID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayBountyOnMisbehaviour() {
+	app := suite.chainA.GetSimApp()
+	clientID := suite.path.EndpointA.ClientID
+	submitter := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	err := app.BountyKeeper.FundBounty(suite.chainA.GetContext(), clientID, bountyAmount)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Bounties(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryBountiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Bounty{types.NewBounty(clientID, bountyAmount)}, res.Bounties)
+
+	balance := app.BankKeeper.GetBalance(suite.chainA.GetContext(), submitter, sdk.DefaultBondDenom)
+
+	suite.freezeClient(submitter)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(balance.Add(bountyAmount[0]), app.BankKeeper.GetBalance(ctx, submitter, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// the bounty is only paid once
+	_, err = suite.queryClient.Bounty(sdk.WrapSDKContext(ctx), &types.QueryBountyRequest{ClientId: clientID})
+	suite.Require().Error(err)
+
+	err = app.BountyKeeper.PayBounty(ctx, clientID, submitter)
+	suite.Require().ErrorIs(err, types.ErrBountyNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPayBountyClientNotFrozen() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	clientID := suite.path.EndpointA.ClientID
+
+	err := app.BountyKeeper.FundBounty(ctx, clientID, bountyAmount)
+	suite.Require().NoError(err)
+
+	err = app.BountyKeeper.PayBounty(ctx, clientID, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidMisbehaviour)
+
+	// a failed payout through the hooks keeps the bounty in escrow
+	app.BountyKeeper.Hooks().AfterClientFrozen(ctx, clientID, suite.chainA.SenderAccount.GetAddress())
+
+	_, found := app.BountyKeeper.GetBounty(ctx, clientID)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+
+	err := app.BountyKeeper.FundBounty(ctx, suite.path.EndpointA.ClientID, bountyAmount)
+	suite.Require().NoError(err)
+
+	genesis := app.BountyKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.Bounty{types.NewBounty(suite.path.EndpointA.ClientID, bountyAmount)}, genesis.Bounties)
+
+	suite.SetupTest() // reset
+
+	app = suite.chainA.GetSimApp()
+	ctx = suite.chainA.GetContext()
+	app.BountyKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, app.BountyKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,23 @@
+package bounty
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+)
+
+// NewBountyProposalHandler defines the ibc bounty proposal handler
+func NewBountyProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.FundBountyProposal:
+			return k.FundBounty(ctx, c.ClientId, c.Amount)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc bounty proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// NewBounty creates a new Bounty instance.
+func NewBounty(clientID string, amount sdk.Coins) Bounty {
+	return Bounty{
+		ClientId: clientID,
+		Amount:   amount,
+	}
+}
+
+// Validate performs a basic validation of the bounty fields.
+func (b Bounty) Validate() error {
+	if _, _, err := clienttypes.ParseClientIdentifier(b.ClientId); err != nil {
+		return err
+	}
+
+	if !b.Amount.IsValid() || b.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidBounty, "invalid bounty amount %s", b.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary IBC misbehaviour bounty interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for Amino JSON
+// serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&FundBountyProposal{}, "cosmos-sdk/FundBountyProposal", nil)
+}
+
+// RegisterInterfaces registers the IBC misbehaviour bounty module interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*govtypes.Content)(nil), &FundBountyProposal{})
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global IBC misbehaviour bounty module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC misbehaviour bounty sentinel errors
+var (
+	ErrInvalidBounty     = sdkerrors.Register(ModuleName, 2, "invalid bounty")
+	ErrBountyNotFound    = sdkerrors.Register(ModuleName, 3, "bounty not found")
+	ErrInsufficientFunds = sdkerrors.Register(ModuleName, 4, "insufficient community pool funds")
+)
@@ -0,0 +1,11 @@
+package types
+
+// IBC misbehaviour bounty events
+const (
+	EventTypeFundBounty = "fund_bounty"
+	EventTypePayBounty  = "pay_bounty"
+
+	AttributeKeyClientID  = "client_id"
+	AttributeKeyAmount    = "amount"
+	AttributeKeySubmitter = "submitter"
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new ibc bounty GenesisState instance.
+func NewGenesisState(bounties []Bounty) *GenesisState {
+	return &GenesisState{
+		Bounties: bounties,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without bounties.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Bounties: []Bounty{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, bounty := range gs.Bounties {
+		if err := bounty.Validate(); err != nil {
+			return fmt.Errorf("invalid bounty %d: %w", i, err)
+		}
+
+		if seen[bounty.ClientId] {
+			return fmt.Errorf("duplicate bounty for client %s", bounty.ClientId)
+		}
+		seen[bounty.ClientId] = true
+	}
+
+	return nil
+}
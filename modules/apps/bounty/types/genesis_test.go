@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState([]types.Bounty{
+				types.NewBounty(ibctesting.FirstClientID, amount),
+				types.NewBounty("07-tendermint-1", amount),
+			}),
+			true,
+		},
+		{
+			"invalid client identifier",
+			types.NewGenesisState([]types.Bounty{types.NewBounty("(INVALIDCLIENT)", amount)}),
+			false,
+		},
+		{
+			"empty bounty amount",
+			types.NewGenesisState([]types.Bounty{types.NewBounty(ibctesting.FirstClientID, sdk.NewCoins())}),
+			false,
+		},
+		{
+			"duplicate bounty",
+			types.NewGenesisState([]types.Bounty{
+				types.NewBounty(ibctesting.FirstClientID, amount),
+				types.NewBounty(ibctesting.FirstClientID, amount),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
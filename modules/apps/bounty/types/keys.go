@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName defines the IBC misbehaviour bounty name
+	ModuleName = "misbehaviourbounty"
+
+	// StoreKey is the store key string for IBC misbehaviour bounties
+	StoreKey = ModuleName
+
+	// RouterKey is the proposal route for IBC misbehaviour bounties
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC misbehaviour bounties
+	QuerierRoute = ModuleName
+)
+
+// BountyKeyPrefix defines the key prefix to store bounties in store
+var BountyKeyPrefix = []byte{0x01}
+
+// KeyBounty returns the store key of the bounty placed on the given client
+func KeyBounty(clientID string) []byte {
+	return append(BountyKeyPrefix, []byte(clientID)...)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+const (
+	// ProposalTypeFundBounty defines the type for a FundBountyProposal
+	ProposalTypeFundBounty = "FundBounty"
+)
+
+var _ govtypes.Content = &FundBountyProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeFundBounty)
+}
+
+// NewFundBountyProposal creates a new fund bounty proposal.
+func NewFundBountyProposal(title, description, clientID string, amount sdk.Coins) govtypes.Content {
+	return &FundBountyProposal{
+		Title:       title,
+		Description: description,
+		ClientId:    clientID,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of a fund bounty proposal.
+func (fbp *FundBountyProposal) GetTitle() string { return fbp.Title }
+
+// GetDescription returns the description of a fund bounty proposal.
+func (fbp *FundBountyProposal) GetDescription() string { return fbp.Description }
+
+// ProposalRoute returns the routing key of a fund bounty proposal.
+func (fbp *FundBountyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a fund bounty proposal.
+func (fbp *FundBountyProposal) ProposalType() string { return ProposalTypeFundBounty }
+
+// ValidateBasic runs basic stateless validity checks
+func (fbp *FundBountyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(fbp); err != nil {
+		return err
+	}
+
+	if _, _, err := clienttypes.ParseClientIdentifier(fbp.ClientId); err != nil {
+		return err
+	}
+
+	if !fbp.Amount.IsValid() || fbp.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidBounty, "invalid bounty amount %s", fbp.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestFundBountyProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"success", types.NewFundBountyProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstClientID, amount), true},
+		{"invalid title", types.NewFundBountyProposal("", ibctesting.Description, ibctesting.FirstClientID, amount), false},
+		{"invalid client identifier", types.NewFundBountyProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID, amount), false},
+		{"empty amount", types.NewFundBountyProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstClientID, sdk.NewCoins()), false},
+		{"invalid amount", types.NewFundBountyProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstClientID, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,1064 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/bounty/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBountyRequest is the request type for the Query/Bounty RPC method
+type QueryBountyRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryBountyRequest) Reset()         { *m = QueryBountyRequest{} }
+func (m *QueryBountyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBountyRequest) ProtoMessage()    {}
+func (*QueryBountyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c95e824ff4b49bf, []int{0}
+}
+func (m *QueryBountyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBountyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBountyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBountyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountyRequest.Merge(m, src)
+}
+func (m *QueryBountyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBountyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBountyRequest proto.InternalMessageInfo
+
+func (m *QueryBountyRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryBountyResponse is the response type for the Query/Bounty RPC method
+type QueryBountyResponse struct {
+	// bounty placed on the client
+	Bounty Bounty `protobuf:"bytes,1,opt,name=bounty,proto3" json:"bounty"`
+}
+
+func (m *QueryBountyResponse) Reset()         { *m = QueryBountyResponse{} }
+func (m *QueryBountyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBountyResponse) ProtoMessage()    {}
+func (*QueryBountyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c95e824ff4b49bf, []int{1}
+}
+func (m *QueryBountyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBountyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBountyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBountyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountyResponse.Merge(m, src)
+}
+func (m *QueryBountyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBountyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBountyResponse proto.InternalMessageInfo
+
+func (m *QueryBountyResponse) GetBounty() Bounty {
+	if m != nil {
+		return m.Bounty
+	}
+	return Bounty{}
+}
+
+// QueryBountiesRequest is the request type for the Query/Bounties RPC method
+type QueryBountiesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBountiesRequest) Reset()         { *m = QueryBountiesRequest{} }
+func (m *QueryBountiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBountiesRequest) ProtoMessage()    {}
+func (*QueryBountiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c95e824ff4b49bf, []int{2}
+}
+func (m *QueryBountiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBountiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBountiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBountiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountiesRequest.Merge(m, src)
+}
+func (m *QueryBountiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBountiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBountiesRequest proto.InternalMessageInfo
+
+func (m *QueryBountiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBountiesResponse is the response type for the Query/Bounties RPC method
+type QueryBountiesResponse struct {
+	// list of all bounties
+	Bounties []Bounty `protobuf:"bytes,1,rep,name=bounties,proto3" json:"bounties"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBountiesResponse) Reset()         { *m = QueryBountiesResponse{} }
+func (m *QueryBountiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBountiesResponse) ProtoMessage()    {}
+func (*QueryBountiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c95e824ff4b49bf, []int{3}
+}
+func (m *QueryBountiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBountiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBountiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBountiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBountiesResponse.Merge(m, src)
+}
+func (m *QueryBountiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBountiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBountiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBountiesResponse proto.InternalMessageInfo
+
+func (m *QueryBountiesResponse) GetBounties() []Bounty {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+func (m *QueryBountiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBountyRequest)(nil), "ibc.applications.bounty.v1.QueryBountyRequest")
+	proto.RegisterType((*QueryBountyResponse)(nil), "ibc.applications.bounty.v1.QueryBountyResponse")
+	proto.RegisterType((*QueryBountiesRequest)(nil), "ibc.applications.bounty.v1.QueryBountiesRequest")
+	proto.RegisterType((*QueryBountiesResponse)(nil), "ibc.applications.bounty.v1.QueryBountiesResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/bounty/v1/query.proto", fileDescriptor_2c95e824ff4b49bf)
+}
+
+var fileDescriptor_2c95e824ff4b49bf = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6b, 0x14, 0x31,
+	0x1c, 0xc5, 0x27, 0xab, 0x2e, 0xdb, 0x78, 0x8b, 0x15, 0xca, 0x58, 0xc6, 0x32, 0x48, 0x5b, 0x0a,
+	0xe6, 0xdf, 0x69, 0xf1, 0x2e, 0x8b, 0x28, 0x9e, 0xd4, 0xb9, 0x08, 0x1e, 0x94, 0x64, 0x36, 0xc4,
+	0xc0, 0xec, 0x64, 0xda, 0x64, 0x06, 0x16, 0xf1, 0xe2, 0x27, 0x10, 0xc4, 0x93, 0x77, 0xbf, 0x85,
+	0xf7, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xec, 0xfa, 0x41, 0x64, 0x93, 0xb4, 0xbb, 0x6b, 0x71, 0xdd,
+	0xbd, 0x0d, 0x99, 0xf7, 0xfe, 0xef, 0x97, 0x97, 0x04, 0xef, 0x2a, 0x5e, 0x00, 0xab, 0xeb, 0x52,
+	0x15, 0xcc, 0x2a, 0x5d, 0x19, 0xe0, 0xba, 0xa9, 0xec, 0x08, 0xda, 0x0c, 0x4e, 0x1a, 0x71, 0x3a,
+	0xa2, 0xf5, 0xa9, 0xb6, 0x9a, 0xc4, 0x8a, 0x17, 0x74, 0x5e, 0x47, 0xbd, 0x8e, 0xb6, 0x59, 0xbc,
+	0x29, 0xb5, 0xd4, 0x4e, 0x06, 0xd3, 0x2f, 0xef, 0x88, 0x0f, 0x0a, 0x6d, 0x86, 0xda, 0x00, 0x67,
+	0x46, 0xf8, 0x51, 0xd0, 0x66, 0x5c, 0x58, 0x96, 0x41, 0xcd, 0xa4, 0xaa, 0xdc, 0x98, 0xa0, 0xdd,
+	0x5b, 0x42, 0x11, 0x72, 0xbc, 0x70, 0x5b, 0x6a, 0x2d, 0x4b, 0x01, 0xac, 0x56, 0xc0, 0xaa, 0x4a,
+	0xdb, 0x00, 0xe3, 0xfe, 0xa6, 0x19, 0x26, 0x2f, 0xa6, 0x41, 0x7d, 0x67, 0xc9, 0xc5, 0x49, 0x23,
+	0x8c, 0x25, 0x77, 0xf0, 0x46, 0x51, 0x2a, 0x51, 0xd9, 0x37, 0x6a, 0xb0, 0x85, 0x76, 0xd0, 0xfe,
+	0x46, 0xde, 0xf3, 0x0b, 0x4f, 0x07, 0xe9, 0x4b, 0x7c, 0x6b, 0xc1, 0x62, 0x6a, 0x5d, 0x19, 0x41,
+	0x1e, 0xe2, 0xae, 0xcf, 0x75, 0x86, 0x9b, 0x47, 0x29, 0xfd, 0xf7, 0xfe, 0xa9, 0xf7, 0xf6, 0xaf,
+	0x9f, 0xfd, 0xbc, 0x1b, 0xe5, 0xc1, 0x97, 0xbe, 0xc6, 0x9b, 0xb3, 0xc1, 0x4a, 0x98, 0x0b, 0x9a,
+	0xc7, 0x18, 0xcf, 0xb6, 0x1f, 0xa6, 0xef, 0x52, 0xdf, 0x15, 0x9d, 0x76, 0x45, 0x7d, 0xed, 0xa1,
+	0x2b, 0xfa, 0x9c, 0x49, 0x11, 0xbc, 0xf9, 0x9c, 0x33, 0xfd, 0x8a, 0xf0, 0xed, 0xbf, 0x02, 0x02,
+	0xfb, 0x23, 0xdc, 0xe3, 0x61, 0x6d, 0x0b, 0xed, 0x5c, 0x5b, 0x8b, 0xfe, 0xd2, 0x49, 0x9e, 0x2c,
+	0x70, 0x76, 0x1c, 0xe7, 0xde, 0x7f, 0x39, 0x3d, 0xc2, 0x3c, 0xe8, 0xd1, 0xb7, 0x0e, 0xbe, 0xe1,
+	0x40, 0xc9, 0x17, 0x84, 0xbb, 0x3e, 0x8d, 0xd0, 0x65, 0x44, 0x57, 0xcf, 0x30, 0x86, 0x95, 0xf5,
+	0x9e, 0x20, 0x3d, 0xfc, 0xf0, 0xfd, 0xf7, 0xa7, 0xce, 0x01, 0xd9, 0x87, 0x70, 0xb5, 0xae, 0x5c,
+	0x29, 0x25, 0x0c, 0xbc, 0xbb, 0xbc, 0x18, 0xef, 0xc9, 0x67, 0x84, 0x7b, 0x17, 0x5d, 0x92, 0xc3,
+	0xd5, 0xf2, 0x66, 0xe7, 0x1a, 0x67, 0x6b, 0x38, 0x02, 0xe3, 0x3d, 0xc7, 0x98, 0x90, 0xed, 0x65,
+	0x8c, 0xfd, 0x67, 0x67, 0xe3, 0x04, 0x9d, 0x8f, 0x13, 0xf4, 0x6b, 0x9c, 0xa0, 0x8f, 0x93, 0x24,
+	0x3a, 0x9f, 0x24, 0xd1, 0x8f, 0x49, 0x12, 0xbd, 0x7a, 0x20, 0x95, 0x7d, 0xdb, 0x70, 0x5a, 0xe8,
+	0x21, 0x84, 0xc7, 0xa6, 0x78, 0x71, 0x5f, 0x6a, 0x68, 0x8f, 0x61, 0xa8, 0x07, 0x4d, 0x29, 0xcc,
+	0xc2, 0x58, 0x3b, 0xaa, 0x85, 0xe1, 0x5d, 0xf7, 0x58, 0x8e, 0xff, 0x0c, 0x00, 0x62, 0x45, 0xb0,
+	0xf0, 0xfb, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Bounty queries the bounty placed on a client.
+	Bounty(ctx context.Context, in *QueryBountyRequest, opts ...grpc.CallOption) (*QueryBountyResponse, error)
+	// Bounties queries all bounties.
+	Bounties(ctx context.Context, in *QueryBountiesRequest, opts ...grpc.CallOption) (*QueryBountiesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Bounty(ctx context.Context, in *QueryBountyRequest, opts ...grpc.CallOption) (*QueryBountyResponse, error) {
+	out := new(QueryBountyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.bounty.v1.Query/Bounty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bounties(ctx context.Context, in *QueryBountiesRequest, opts ...grpc.CallOption) (*QueryBountiesResponse, error) {
+	out := new(QueryBountiesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.bounty.v1.Query/Bounties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Bounty queries the bounty placed on a client.
+	Bounty(context.Context, *QueryBountyRequest) (*QueryBountyResponse, error)
+	// Bounties queries all bounties.
+	Bounties(context.Context, *QueryBountiesRequest) (*QueryBountiesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Bounty(ctx context.Context, req *QueryBountyRequest) (*QueryBountyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bounty not implemented")
+}
+func (*UnimplementedQueryServer) Bounties(ctx context.Context, req *QueryBountiesRequest) (*QueryBountiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bounties not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Bounty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBountyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bounty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.bounty.v1.Query/Bounty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bounty(ctx, req.(*QueryBountyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bounties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBountiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bounties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.bounty.v1.Query/Bounties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bounties(ctx, req.(*QueryBountiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.bounty.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bounty",
+			Handler:    _Query_Bounty_Handler,
+		},
+		{
+			MethodName: "Bounties",
+			Handler:    _Query_Bounties_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/bounty/v1/query.proto",
+}
+
+func (m *QueryBountyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBountyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBountiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBountiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBountiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBountiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBountyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBountyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bounty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBountiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBountiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBountyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBountyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBountiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBountiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBountiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBountiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounties = append(m.Bounties, Bounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,463 @@
+package callbacks
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ porttypes.IBCModule          = IBCMiddleware{}
+	_ porttypes.DeadLetterModule   = IBCMiddleware{}
+	_ porttypes.CompensatingModule = IBCMiddleware{}
+	_ porttypes.UpgradableModule   = IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 interface for the callbacks middleware given the underlying
+// application and the contract keeper executing the callbacks. Callbacks are requested in the
+// memo of the packet data, which by default must be encoded in JSON with the memo and sender
+// under the "memo" and "sender" keys as done by the ICS-20 and ICS-721 applications. Other
+// encodings may be decoded by registering packet data codecs per channel version.
+type IBCMiddleware struct {
+	app            porttypes.IBCModule
+	contractKeeper types.ContractKeeper
+
+	// maxCallbackGas is the maximum gas a single callback may consume
+	maxCallbackGas uint64
+
+	channelKeeper    types.ChannelKeeper
+	packetDataCodecs *porttypes.PacketDataCodecs
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, the contract
+// keeper and the maximum gas a single callback may consume.
+func NewIBCMiddleware(app porttypes.IBCModule, contractKeeper types.ContractKeeper, maxCallbackGas uint64) IBCMiddleware {
+	if contractKeeper == nil {
+		panic("contract keeper cannot be nil")
+	}
+
+	if maxCallbackGas == 0 {
+		panic("maximum callback gas cannot be zero")
+	}
+
+	return IBCMiddleware{
+		app:              app,
+		contractKeeper:   contractKeeper,
+		maxCallbackGas:   maxCallbackGas,
+		packetDataCodecs: porttypes.NewPacketDataCodecs(),
+	}
+}
+
+// WithPacketDataCodecs returns a copy of the middleware decoding the packet data of each channel
+// with the codec registered for the version of the channel, looked up with the channel keeper.
+// It allows a single middleware to sit above applications encoding their packet data
+// differently. The packet data of channels whose version has no registered codec is decoded as
+// JSON.
+func (im IBCMiddleware) WithPacketDataCodecs(channelKeeper types.ChannelKeeper, codecs *porttypes.PacketDataCodecs) IBCMiddleware {
+	if channelKeeper == nil {
+		panic("channel keeper cannot be nil")
+	}
+
+	if codecs == nil {
+		panic("packet data codecs cannot be nil")
+	}
+
+	im.channelKeeper = channelKeeper
+	im.packetDataCodecs = codecs
+	return im
+}
+
+// StackLayerName implements the StackLayer interface
+func (im IBCMiddleware) StackLayerName() string {
+	return types.ModuleName
+}
+
+// UnderlyingApplication implements the ApplicationWrapper interface
+func (im IBCMiddleware) UnderlyingApplication() porttypes.IBCModule {
+	return im.app
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Once the underlying application has
+// successfully received a packet requesting a destination callback, the callback is executed.
+// An error acknowledgement is returned if the callback data is invalid or the callback fails,
+// so that the state changes of the packet are discarded and the sender is refunded. No callback
+// is executed if the acknowledgement is written asynchronously.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	callbackData, isCallbackPacket, err := im.getCallbackData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData(), types.DestinationCallbackKey)
+	if !isCallbackPacket {
+		return ack
+	}
+
+	if err == nil {
+		err = im.processCallback(ctx, callbackData, func(callbackCtx sdk.Context) error {
+			return im.contractKeeper.IBCReceivePacketCallback(callbackCtx, packet, ack, callbackData.CallbackAddress)
+		})
+	}
+
+	emitCallbackEvent(ctx, types.EventTypeDestinationCallback, types.CallbackTypeReceivePacket, packet, callbackData, err)
+
+	if err != nil {
+		return types.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Once the underlying application
+// has processed the acknowledgement of a packet requesting a source callback, the callback is
+// executed. A failed callback does not fail the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.processSourceCallback(ctx, types.CallbackTypeAcknowledgementPacket, packet, func(callbackCtx sdk.Context, callbackData types.CallbackData) error {
+		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(callbackCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Once the underlying application has
+// refunded a timed out packet requesting a source callback, the callback is executed. A failed
+// callback does not fail the timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.processSourceCallback(ctx, types.CallbackTypeTimeoutPacket, packet, func(callbackCtx sdk.Context, callbackData types.CallbackData) error {
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(callbackCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// OnClientFrozen implements the IBCModule interface
+func (im IBCMiddleware) OnClientFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	im.app.OnClientFrozen(ctx, portID, channelID)
+}
+
+// IsDeadLetterModule implements the DeadLetterModule interface. The middleware opts in to the
+// dead-letter store only if the underlying application does.
+func (im IBCMiddleware) IsDeadLetterModule() bool {
+	_, ok := porttypes.GetDeadLetterModule(im.app)
+	return ok
+}
+
+// OnReclaimPacket implements the DeadLetterModule interface. The packet is reclaimed by the
+// underlying application if it opts in to the dead-letter store, after which the timeout
+// callback is executed as the packet will never be received.
+func (im IBCMiddleware) OnReclaimPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	reason string,
+	signer sdk.AccAddress,
+) error {
+	deadLetterModule, ok := porttypes.GetDeadLetterModule(im.app)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support reclaiming packets")
+	}
+
+	if err := deadLetterModule.OnReclaimPacket(ctx, packet, reason, signer); err != nil {
+		return err
+	}
+
+	im.processSourceCallback(ctx, types.CallbackTypeTimeoutPacket, packet, func(callbackCtx sdk.Context, callbackData types.CallbackData) error {
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(callbackCtx, packet, signer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// OnCompensatePacket implements the CompensatingModule interface. The compensation is executed
+// by the underlying application.
+func (im IBCMiddleware) OnCompensatePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	compensation []byte,
+	relayer sdk.AccAddress,
+) error {
+	compensatingModule, ok := im.app.(porttypes.CompensatingModule)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support packet compensations")
+	}
+
+	return compensatingModule.OnCompensatePacket(ctx, packet, compensation, relayer)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im IBCMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+	if upgradableModule, ok := im.app.(porttypes.UpgradableModule); ok {
+		upgradableModule.OnChanUpgradeOpen(ctx, portID, channelID, order, connectionHops, version)
+	}
+}
+
+// processSourceCallback executes the source callback requested by the given packet, if any, and
+// emits its result. Invalid callback data is reported in the event without executing a callback.
+func (im IBCMiddleware) processSourceCallback(
+	ctx sdk.Context,
+	callbackType types.CallbackType,
+	packet channeltypes.Packet,
+	callback func(callbackCtx sdk.Context, callbackData types.CallbackData) error,
+) {
+	callbackData, isCallbackPacket, err := im.getCallbackData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData(), types.SourceCallbackKey)
+	if !isCallbackPacket {
+		return
+	}
+
+	if err == nil {
+		err = im.processCallback(ctx, callbackData, func(callbackCtx sdk.Context) error {
+			return callback(callbackCtx, callbackData)
+		})
+	}
+
+	emitCallbackEvent(ctx, types.EventTypeSourceCallback, callbackType, packet, callbackData, err)
+}
+
+// getCallbackData decodes the packet data with the codec of the version of the given channel and
+// returns the callback requested under the given key of its memo. Packet data which cannot be
+// decoded does not request a callback.
+func (im IBCMiddleware) getCallbackData(ctx sdk.Context, portID, channelID string, bz []byte, callbackKey string) (types.CallbackData, bool, error) {
+	var version string
+	if im.channelKeeper != nil {
+		channel, found := im.channelKeeper.GetChannel(ctx, portID, channelID)
+		if found {
+			version = channel.Version
+		}
+	}
+
+	data, err := im.packetDataCodecs.DecodePacketData(version, bz)
+	if err != nil {
+		return types.CallbackData{}, false, nil
+	}
+
+	return types.GetCallbackData(data, callbackKey, im.maxCallbackGas)
+}
+
+// processCallback executes a callback in a cached context using a gas meter limited by the gas
+// limit of the callback. The state changes and events of the callback are committed only if it
+// succeeds and the gas it consumed is charged to the gas meter of the context. Panics raised by
+// the callback are recovered and returned as errors.
+//
+// If the gas remaining in the context is below the gas limit of the callback, the callback is
+// executed with the remaining gas and running out of gas fails the transaction, so that a
+// relayer cannot make a callback fail by providing too little gas.
+func (im IBCMiddleware) processCallback(ctx sdk.Context, callbackData types.CallbackData, callback func(callbackCtx sdk.Context) error) (err error) {
+	gasLimit := callbackData.GasLimit
+	relayerLimited := false
+
+	// a gas meter limit of zero denotes an infinite gas meter
+	if limit := ctx.GasMeter().Limit(); limit != 0 {
+		if remaining := limit - ctx.GasMeter().GasConsumedToLimit(); remaining < gasLimit {
+			gasLimit, relayerLimited = remaining, true
+		}
+	}
+
+	callbackMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				if relayerLimited {
+					panic(sdk.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc callback out of gas in location: %s; relayer must provide the callback gas limit %d", rType.Descriptor, callbackData.GasLimit)})
+				}
+
+				err = sdkerrors.Wrapf(types.ErrCallbackOutOfGas, "out of gas in location: %s; gas limit: %d", rType.Descriptor, gasLimit)
+			default:
+				err = sdkerrors.Wrapf(types.ErrCallbackPanic, "%v", r)
+			}
+		}
+
+		ctx.GasMeter().ConsumeGas(callbackMeter.GasConsumedToLimit(), "ibc callback")
+	}()
+
+	if err := callback(cacheCtx.WithGasMeter(callbackMeter)); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// emitCallbackEvent emits an event with the result of a callback.
+func emitCallbackEvent(
+	ctx sdk.Context,
+	eventType string,
+	callbackType types.CallbackType,
+	packet channeltypes.Packet,
+	callbackData types.CallbackData,
+	err error,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(types.AttributeKeyCallbackAddress, callbackData.CallbackAddress),
+		sdk.NewAttribute(types.AttributeKeyCallbackGasLimit, fmt.Sprintf("%d", callbackData.GasLimit)),
+		sdk.NewAttribute(types.AttributeKeyPort, packet.GetSourcePort()),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(types.AttributeKeyCallbackSuccess, fmt.Sprintf("%t", err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
@@ -0,0 +1,358 @@
+package callbacks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	callbacks "github.com/cosmos/ibc-go/v3/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+const maxCallbackGas = 500000
+
+var _ types.ContractKeeper = &mockContractKeeper{}
+
+// mockContractKeeper records the callbacks executed. Each callback consumes gasConsumed, sends
+// the callback fee from the contract account to the fee collector and then returns err.
+type mockContractKeeper struct {
+	app         *simapp.SimApp
+	contract    sdk.AccAddress
+	gasConsumed uint64
+	err         error
+
+	callbacks []types.CallbackType
+}
+
+func (k *mockContractKeeper) execute(ctx sdk.Context, callbackType types.CallbackType, contractAddress string) error {
+	if contractAddress != k.contract.String() {
+		return fmt.Errorf("unknown contract %s", contractAddress)
+	}
+
+	k.callbacks = append(k.callbacks, callbackType)
+	ctx.GasMeter().ConsumeGas(k.gasConsumed, "contract execution")
+
+	if err := k.app.BankKeeper.SendCoins(ctx, k.contract, k.app.AccountKeeper.GetModuleAddress("fee_collector"), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))); err != nil {
+		return err
+	}
+
+	return k.err
+}
+
+func (k *mockContractKeeper) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+	return k.execute(ctx, types.CallbackTypeAcknowledgementPacket, contractAddress)
+}
+
+func (k *mockContractKeeper) IBCOnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+	return k.execute(ctx, types.CallbackTypeTimeoutPacket, contractAddress)
+}
+
+func (k *mockContractKeeper) IBCReceivePacketCallback(ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement, contractAddress string) error {
+	return k.execute(ctx, types.CallbackTypeReceivePacket, contractAddress)
+}
+
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *CallbacksTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+// newMiddleware wraps the transfer stack of the given chain in the callbacks middleware with a
+// contract keeper whose contract account is funded.
+func (suite *CallbacksTestSuite) newMiddleware(chain *ibctesting.TestChain) (callbacks.IBCMiddleware, *mockContractKeeper) {
+	module, _, err := chain.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(chain.GetContext(), ibctesting.TransferPort)
+	suite.Require().NoError(err)
+
+	cbs, ok := chain.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	contractKeeper := &mockContractKeeper{
+		app:      chain.GetSimApp(),
+		contract: sdk.AccAddress([]byte("contract")),
+	}
+	suite.Require().NoError(simapp.FundAccount(chain.GetSimApp(), chain.GetContext(), contractKeeper.contract, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))))
+
+	return callbacks.NewIBCMiddleware(cbs, contractKeeper, maxCallbackGas), contractKeeper
+}
+
+func (suite *CallbacksTestSuite) newPacket(sequence uint64, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo)
+	return channeltypes.NewPacket(data.GetBytes(), sequence, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+}
+
+func (suite *CallbacksTestSuite) TestOnRecvPacket() {
+	var (
+		contractKeeper *mockContractKeeper
+		memo           string
+	)
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expCallback bool
+		expAck      bool
+	}{
+		{"success", func() {}, true, true},
+		{"packet without callback", func() {
+			memo = ""
+		}, false, true},
+		{"source callback only", func() {
+			memo = fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractKeeper.contract)
+		}, false, true},
+		{"invalid callback data", func() {
+			memo = `{"dest_callback":{"address":""}}`
+		}, false, false},
+		{"callback fails", func() {
+			contractKeeper.err = fmt.Errorf("contract failed")
+		}, true, false},
+		{"callback out of gas", func() {
+			contractKeeper.gasConsumed = maxCallbackGas + 1
+		}, true, false},
+		{"callback out of the gas limit of the memo", func() {
+			memo = fmt.Sprintf(`{"dest_callback":{"address":"%s","gas_limit":"1000"}}`, contractKeeper.contract)
+			contractKeeper.gasConsumed = 1001
+		}, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var middleware callbacks.IBCMiddleware
+			middleware, contractKeeper = suite.newMiddleware(suite.chainB)
+			memo = fmt.Sprintf(`{"dest_callback":{"address":"%s"}}`, contractKeeper.contract)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			ack := middleware.OnRecvPacket(ctx, suite.newPacket(1, memo), suite.chainB.SenderAccount.GetAddress())
+			suite.Require().Equal(tc.expAck, ack.Success())
+
+			if tc.expCallback {
+				suite.Require().Equal([]types.CallbackType{types.CallbackTypeReceivePacket}, contractKeeper.callbacks)
+			} else {
+				suite.Require().Empty(contractKeeper.callbacks)
+			}
+
+			// the state changes of the callback are only committed if it succeeds
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, contractKeeper.contract, sdk.DefaultBondDenom)
+			if tc.expCallback && tc.expAck {
+				suite.Require().Equal(sdk.NewInt(9), balance.Amount)
+			} else {
+				suite.Require().Equal(sdk.NewInt(10), balance.Amount)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketRelayerGasLimited tests that the transaction fails if the relayer does not
+// provide the gas limit of the callback, so that the callback cannot be made to fail.
+func (suite *CallbacksTestSuite) TestOnRecvPacketRelayerGasLimited() {
+	middleware, contractKeeper := suite.newMiddleware(suite.chainB)
+	contractKeeper.gasConsumed = maxCallbackGas
+
+	memo := fmt.Sprintf(`{"dest_callback":{"address":"%s"}}`, contractKeeper.contract)
+	ctx := suite.chainB.GetContext().WithGasMeter(sdk.NewGasMeter(maxCallbackGas))
+
+	defer func() {
+		r := recover()
+		suite.Require().IsType(sdk.ErrorOutOfGas{}, r)
+	}()
+
+	middleware.OnRecvPacket(ctx, suite.newPacket(1, memo), suite.chainB.SenderAccount.GetAddress())
+	suite.Fail("out of gas panic expected")
+}
+
+func (suite *CallbacksTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		msg         string
+		memo        string
+		err         error
+		expCallback bool
+		expSuccess  bool
+	}{
+		{"success", `{"src_callback":{"address":"%s"}}`, nil, true, true},
+		{"packet without callback", "", nil, false, false},
+		{"destination callback only", `{"dest_callback":{"address":"%s"}}`, nil, false, false},
+		{"callback fails", `{"src_callback":{"address":"%s"}}`, fmt.Errorf("contract failed"), true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			middleware, contractKeeper := suite.newMiddleware(suite.chainA)
+			contractKeeper.err = tc.err
+
+			memo := tc.memo
+			if memo != "" {
+				memo = fmt.Sprintf(memo, contractKeeper.contract)
+			}
+
+			ctx := suite.chainA.GetContext()
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+			// a failed callback does not fail the acknowledgement
+			err := middleware.OnAcknowledgementPacket(ctx, suite.newPacket(1, memo), ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+
+			if tc.expCallback {
+				suite.Require().Equal([]types.CallbackType{types.CallbackTypeAcknowledgementPacket}, contractKeeper.callbacks)
+				suite.Require().Equal(tc.expSuccess, callbackSucceeded(ctx, types.EventTypeSourceCallback))
+			} else {
+				suite.Require().Empty(contractKeeper.callbacks)
+			}
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, contractKeeper.contract, sdk.DefaultBondDenom)
+			if tc.expSuccess {
+				suite.Require().Equal(sdk.NewInt(9), balance.Amount)
+			} else {
+				suite.Require().Equal(sdk.NewInt(10), balance.Amount)
+			}
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestOnTimeoutPacket() {
+	middleware, contractKeeper := suite.newMiddleware(suite.chainA)
+
+	// the tokens of the timed out packet are escrowed on send
+	memo := fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractKeeper.contract)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, memo)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	err = middleware.OnTimeoutPacket(ctx, packet, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]types.CallbackType{types.CallbackTypeTimeoutPacket}, contractKeeper.callbacks)
+	suite.Require().True(callbackSucceeded(ctx, types.EventTypeSourceCallback))
+}
+
+// TestPacketDataCodecs tests that the callbacks requested in packet data encoded in protobuf are
+// only executed once a protobuf codec is registered for the version of the channel.
+func (suite *CallbacksTestSuite) TestPacketDataCodecs() {
+	_, contractKeeper := suite.newMiddleware(suite.chainA)
+
+	// the underlying application accepts any packet data
+	app := ibcmock.IBCModule{IBCApp: &ibcmock.MockIBCApp{
+		OnAcknowledgementPacket: func(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error { return nil },
+	}}
+	middleware := callbacks.NewIBCMiddleware(app, contractKeeper, maxCallbackGas)
+
+	memo := fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractKeeper.contract)
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo)
+	bz, err := data.Marshal()
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(bz, 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	// the protobuf packet data cannot be decoded as JSON
+	suite.Require().NoError(middleware.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Empty(contractKeeper.callbacks)
+
+	codecs := porttypes.NewPacketDataCodecs().Register(transfertypes.Version, porttypes.NewProtoPacketDataCodec(func() porttypes.ProtoPacketData {
+		return &transfertypes.FungibleTokenPacketData{}
+	}))
+	middleware = middleware.WithPacketDataCodecs(suite.chainA.App.GetIBCKeeper().ChannelKeeper, codecs)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().NoError(middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress()))
+	suite.Require().Equal([]types.CallbackType{types.CallbackTypeAcknowledgementPacket}, contractKeeper.callbacks)
+	suite.Require().True(callbackSucceeded(ctx, types.EventTypeSourceCallback))
+
+	suite.Require().Panics(func() {
+		codecs.Register(transfertypes.Version, porttypes.JSONPacketDataCodec{})
+	})
+}
+
+// TestIsDeadLetterModule tests that the middleware only opts in to the dead-letter store if the
+// underlying application does.
+func (suite *CallbacksTestSuite) TestIsDeadLetterModule() {
+	middleware, contractKeeper := suite.newMiddleware(suite.chainA)
+	suite.Require().True(middleware.IsDeadLetterModule())
+
+	// an application which does not implement the DeadLetterModule interface
+	app := struct{ porttypes.IBCModule }{IBCModule: ibcmock.IBCModule{}}
+	middleware = callbacks.NewIBCMiddleware(app, contractKeeper, maxCallbackGas)
+	suite.Require().False(middleware.IsDeadLetterModule())
+
+	_, ok := porttypes.GetDeadLetterModule(middleware)
+	suite.Require().False(ok)
+
+	// a middleware wrapping the callbacks middleware forwards the opt-in of the application
+	_, ok = porttypes.GetDeadLetterModule(callbacks.NewIBCMiddleware(middleware, contractKeeper, maxCallbackGas))
+	suite.Require().False(ok)
+}
+
+func (suite *CallbacksTestSuite) TestNewIBCMiddleware() {
+	var app porttypes.IBCModule
+
+	suite.Require().Panics(func() {
+		callbacks.NewIBCMiddleware(app, nil, maxCallbackGas)
+	})
+
+	suite.Require().Panics(func() {
+		callbacks.NewIBCMiddleware(app, &mockContractKeeper{}, 0)
+	})
+}
+
+// callbackSucceeded returns the success attribute of the callback event of the given type.
+func callbackSucceeded(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyCallbackSuccess {
+				return string(attr.Value) == "true"
+			}
+		}
+	}
+
+	return false
+}
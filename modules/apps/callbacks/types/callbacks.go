@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// CallbackType defines the packet lifecycle event a callback is invoked for.
+type CallbackType string
+
+const (
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+)
+
+// CallbackData defines the callback requested in the memo of a packet.
+type CallbackData struct {
+	// address of the contract to call
+	CallbackAddress string
+	// gas limit of the callback, capped by the maximum callback gas of the middleware
+	GasLimit uint64
+	// address of the packet sender on the source chain
+	SenderAddress string
+}
+
+// GetSourceCallbackData returns the callback requested under the src_callback key of the memo
+// of the given JSON encoded packet data. False is returned if the packet does not request a
+// source callback.
+func GetSourceCallbackData(bz []byte, maxCallbackGas uint64) (CallbackData, bool, error) {
+	return getJSONCallbackData(bz, SourceCallbackKey, maxCallbackGas)
+}
+
+// GetDestCallbackData returns the callback requested under the dest_callback key of the memo
+// of the given JSON encoded packet data. False is returned if the packet does not request a
+// destination callback.
+func GetDestCallbackData(bz []byte, maxCallbackGas uint64) (CallbackData, bool, error) {
+	return getJSONCallbackData(bz, DestinationCallbackKey, maxCallbackGas)
+}
+
+// getJSONCallbackData returns the callback requested under the given key of the memo of JSON
+// encoded packet data. Packet data which is not JSON does not request a callback.
+func getJSONCallbackData(bz []byte, callbackKey string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	data, err := porttypes.JSONPacketDataCodec{}.DecodePacketData(bz)
+	if err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	return GetCallbackData(data, callbackKey, maxCallbackGas)
+}
+
+// GetCallbackData parses the callback object stored under the given key of the memo of the
+// decoded packet data, e.g. {"src_callback": {"address": "cosmos1...", "gas_limit": "100000"}}.
+// Packets whose memo is not a JSON object or does not contain the key do not request a callback.
+// A gas limit which is not set, or above the maximum callback gas, is set to the maximum callback
+// gas.
+func GetCallbackData(data porttypes.PacketData, callbackKey string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	if data.Memo == "" {
+		return CallbackData{}, false, nil
+	}
+
+	var memo map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	rawCallback, found := memo[callbackKey]
+	if !found {
+		return CallbackData{}, false, nil
+	}
+
+	var callback map[string]string
+	if err := json.Unmarshal(rawCallback, &callback); err != nil {
+		return CallbackData{}, true, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s must be an object of strings: %s", callbackKey, err)
+	}
+
+	address := callback[CallbackAddressKey]
+	if strings.TrimSpace(address) == "" {
+		return CallbackData{}, true, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s address cannot be blank", callbackKey)
+	}
+
+	gasLimit := maxCallbackGas
+	if gasLimitStr, ok := callback[CallbackGasLimitKey]; ok {
+		userGasLimit, err := strconv.ParseUint(gasLimitStr, 10, 64)
+		if err != nil {
+			return CallbackData{}, true, sdkerrors.Wrapf(ErrInvalidCallbackData, "invalid %s gas limit %s: %s", callbackKey, gasLimitStr, err)
+		}
+
+		if userGasLimit != 0 && userGasLimit < maxCallbackGas {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		CallbackAddress: address,
+		GasLimit:        gasLimit,
+		SenderAddress:   data.Sender,
+	}, true, nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+)
+
+const maxCallbackGas = 1000000
+
+func TestGetCallbackData(t *testing.T) {
+	packetData := func(memo string) []byte {
+		return []byte(fmt.Sprintf(`{"amount":"100","denom":"stake","memo":%q,"receiver":"cosmos1receiver","sender":"cosmos1sender"}`, memo))
+	}
+
+	testCases := []struct {
+		name            string
+		packetData      []byte
+		expCallback     bool
+		expPass         bool
+		expCallbackData types.CallbackData
+	}{
+		{"packet without memo", []byte(`{"amount":"100","denom":"stake","receiver":"cosmos1receiver","sender":"cosmos1sender"}`), false, true, types.CallbackData{}},
+		{"packet data is not JSON", []byte("packet data"), false, true, types.CallbackData{}},
+		{"memo is not a JSON object", packetData("memo"), false, true, types.CallbackData{}},
+		{"memo without source callback", packetData(`{"dest_callback":{"address":"cosmos1contract"}}`), false, true, types.CallbackData{}},
+		{"callback without gas limit", packetData(`{"src_callback":{"address":"cosmos1contract"}}`), true, true, types.CallbackData{CallbackAddress: "cosmos1contract", GasLimit: maxCallbackGas, SenderAddress: "cosmos1sender"}},
+		{"callback with gas limit", packetData(`{"src_callback":{"address":"cosmos1contract","gas_limit":"50000"}}`), true, true, types.CallbackData{CallbackAddress: "cosmos1contract", GasLimit: 50000, SenderAddress: "cosmos1sender"}},
+		{"gas limit capped by the maximum callback gas", packetData(`{"src_callback":{"address":"cosmos1contract","gas_limit":"5000000"}}`), true, true, types.CallbackData{CallbackAddress: "cosmos1contract", GasLimit: maxCallbackGas, SenderAddress: "cosmos1sender"}},
+		{"callback is not an object", packetData(`{"src_callback":"cosmos1contract"}`), true, false, types.CallbackData{}},
+		{"blank address", packetData(`{"src_callback":{"address":" "}}`), true, false, types.CallbackData{}},
+		{"invalid gas limit", packetData(`{"src_callback":{"address":"cosmos1contract","gas_limit":"-1"}}`), true, false, types.CallbackData{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		callbackData, isCallbackPacket, err := types.GetSourceCallbackData(tc.packetData, maxCallbackGas)
+		require.Equal(t, tc.expCallback, isCallbackPacket, tc.name)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expCallbackData, callbackData, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidCallbackData, tc.name)
+		}
+	}
+}
+
+func TestGetDestCallbackData(t *testing.T) {
+	bz := []byte(`{"memo":"{\"src_callback\":{\"address\":\"cosmos1src\"},\"dest_callback\":{\"address\":\"cosmos1dest\"}}","sender":"cosmos1sender"}`)
+
+	callbackData, isCallbackPacket, err := types.GetDestCallbackData(bz, maxCallbackGas)
+	require.NoError(t, err)
+	require.True(t, isCallbackPacket)
+	require.Equal(t, "cosmos1dest", callbackData.CallbackAddress)
+}
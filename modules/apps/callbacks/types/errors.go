@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Callbacks sentinel errors
+var (
+	ErrInvalidCallbackData = sdkerrors.Register(ModuleName, 2, "invalid callback data")
+	ErrCallbackOutOfGas    = sdkerrors.Register(ModuleName, 3, "callback out of gas")
+	ErrCallbackPanic       = sdkerrors.Register(ModuleName, 4, "callback panicked")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Callbacks events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackType     = "callback_type"
+	AttributeKeyCallbackAddress  = "callback_address"
+	AttributeKeyCallbackGasLimit = "callback_gas_limit"
+	AttributeKeyCallbackSuccess  = "callback_success"
+	AttributeKeyCallbackError    = "callback_error"
+	AttributeKeyPort             = "port_id"
+	AttributeKeyChannel          = "channel_id"
+	AttributeKeySequence         = "sequence"
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ContractKeeper defines the expected keeper of the smart contract execution environment of the
+// chain, e.g. CosmWasm, which executes the callbacks. The callbacks are executed with a gas meter
+// limited to the gas limit of the callback, state changes are discarded if an error is returned.
+type ContractKeeper interface {
+	// IBCOnAcknowledgementPacketCallback is called on the source chain once a packet carrying a
+	// source callback is acknowledged. The contract should verify that it is allowed to act on
+	// behalf of the packet sender.
+	IBCOnAcknowledgementPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	// IBCOnTimeoutPacketCallback is called on the source chain once a packet carrying a source
+	// callback times out or is reclaimed from the dead-letter store. The contract should verify
+	// that it is allowed to act on behalf of the packet sender.
+	IBCOnTimeoutPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	// IBCReceivePacketCallback is called on the destination chain once a packet carrying a
+	// destination callback has been successfully received by the underlying application.
+	IBCReceivePacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+	) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper, used to look up the version of the
+// channel a packet is sent over in order to decode its packet data.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ModuleName defines the callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the key of the callback invoked on the source chain in the packet memo
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the key of the callback invoked on the destination chain in the
+	// packet memo
+	DestinationCallbackKey = "dest_callback"
+
+	// CallbackAddressKey is the key of the address of the contract to call in a callback object
+	CallbackAddressKey = "address"
+
+	// CallbackGasLimitKey is the key of the optional gas limit of the callback in a callback object
+	CallbackGasLimitKey = "gas_limit"
+)
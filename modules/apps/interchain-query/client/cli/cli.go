@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for IBC interchain queries
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "interchain-query",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC interchain query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+		GetCmdQueryResult(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for IBC interchain queries
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "interchain-query",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC interchain query transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSubmitQueryTxCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+)
+
+// GetCmdParams returns the command handler for the interchain query parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current interchain query parameters",
+		Long:    "Query the current interchain query parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-query params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryResult defines the command to query the result of an interchain query sent
+// by the chain.
+func GetCmdQueryResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "result [port-id] [channel-id] [sequence]",
+		Short:   "Query the result of an interchain query",
+		Long:    "Query the result of an interchain query from the port, channel and sequence of the packet which carried it",
+		Example: fmt.Sprintf("%s query interchain-query result icq channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQueryResultRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.QueryResult(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+)
+
+// InitGenesis initializes the interchain query state and binds to PortID.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.PortId)
+	k.SetParams(ctx, state.Params)
+
+	for _, result := range state.Results {
+		k.SetQueryResult(ctx, result.PortId, result.ChannelId, result.Sequence, result.Result)
+	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, state.PortId) {
+		// interchain query module binds to the icq port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, state.PortId)
+		if err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports the interchain query module's portID, params and query results into
+// its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetParams(ctx), k.GetAllQueryResults(ctx))
+}
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+	ctx := suite.chainA.GetContext()
+
+	params := types.NewParams(true, []string{"/store/bank/key"})
+	icqKeeper.SetParams(ctx, params)
+
+	results := []types.IdentifiedQueryResult{
+		{PortId: types.PortID, ChannelId: "channel-0", Sequence: 1, Result: types.QueryResult{Error: "error"}},
+		{PortId: types.PortID, ChannelId: "channel-0", Sequence: 256, Result: types.QueryResult{Responses: []types.ResponseQuery{{Value: []byte("value")}}}},
+		{PortId: types.PortID, ChannelId: "channel-1", Sequence: 1, Result: types.QueryResult{Error: "error"}},
+	}
+	for _, result := range results {
+		icqKeeper.SetQueryResult(ctx, result.PortId, result.ChannelId, result.Sequence, result.Result)
+	}
+
+	genesis := icqKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(types.PortID, genesis.PortId)
+	suite.Require().Equal(params, genesis.Params)
+	suite.Require().Equal(results, genesis.Results)
+
+	suite.Require().NotPanics(func() {
+		suite.chainB.GetSimApp().ICQKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
+	})
+	suite.Require().Equal(genesis, suite.chainB.GetSimApp().ICQKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
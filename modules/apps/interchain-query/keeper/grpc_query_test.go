@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryQueryResult() {
+	var (
+		req       *types.QueryQueryResultRequest
+		expResult types.QueryResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expResult = types.QueryResult{Responses: []types.ResponseQuery{{Key: []byte("key"), Value: []byte("value")}}}
+				suite.chainA.GetSimApp().ICQKeeper.SetQueryResult(suite.chainA.GetContext(), types.PortID, "channel-0", 1, expResult)
+
+				req = &types.QueryQueryResultRequest{PortId: types.PortID, ChannelId: "channel-0", Sequence: 1}
+			},
+			true,
+		},
+		{
+			"invalid port id",
+			func() {
+				req = &types.QueryQueryResultRequest{PortId: "", ChannelId: "channel-0", Sequence: 1}
+			},
+			false,
+		},
+		{
+			"invalid channel id",
+			func() {
+				req = &types.QueryQueryResultRequest{PortId: types.PortID, ChannelId: "(channel-0)", Sequence: 1}
+			},
+			false,
+		},
+		{
+			"zero sequence",
+			func() {
+				req = &types.QueryQueryResultRequest{PortId: types.PortID, ChannelId: "channel-0", Sequence: 0}
+			},
+			false,
+		},
+		{
+			"result not found",
+			func() {
+				req = &types.QueryQueryResultRequest{PortId: types.PortID, ChannelId: "channel-0", Sequence: 2}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.QueryResult(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expResult, res.Result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
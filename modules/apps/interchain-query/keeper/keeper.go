@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the IBC interchain query keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	querier types.Querier
+}
+
+// NewKeeper creates a new IBC interchain query Keeper instance. The querier is used to
+// execute the queries received from controller chains, it is usually the baseapp of the
+// application.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, querier types.Querier,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		querier:       querier,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper the module sends packets with.
+func (k Keeper) GetICS4Wrapper() types.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// IsBound checks if the interchain query module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the interchain query module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the interchain query module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// GetQueryResult returns the result of the interchain query sent in the packet with the
+// given port, channel and sequence.
+func (k Keeper) GetQueryResult(ctx sdk.Context, portID, channelID string, sequence uint64) (types.QueryResult, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueryResultPrefix(portID, channelID))
+	bz := store.Get(sdk.Uint64ToBigEndian(sequence))
+	if bz == nil {
+		return types.QueryResult{}, false
+	}
+
+	var result types.QueryResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetQueryResult stores the result of the interchain query sent in the packet with the
+// given port, channel and sequence.
+func (k Keeper) SetQueryResult(ctx sdk.Context, portID, channelID string, sequence uint64, result types.QueryResult) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueryResultPrefix(portID, channelID))
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(sdk.Uint64ToBigEndian(sequence), bz)
+}
+
+// GetAllQueryResults returns the results of all the interchain queries sent by this chain.
+func (k Keeper) GetAllQueryResults(ctx sdk.Context) []types.IdentifiedQueryResult {
+	results := []types.IdentifiedQueryResult{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.QueryResultKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, sequence, err := parseQueryResultKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var result types.QueryResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, types.IdentifiedQueryResult{
+			PortId:    portID,
+			ChannelId: channelID,
+			Sequence:  sequence,
+			Result:    result,
+		})
+	}
+
+	return results
+}
+
+// parseQueryResultKey parses the port, channel and sequence from a query result key with
+// the format {QueryResultKey}{portID}/{channelID}/{sequence}.
+func parseQueryResultKey(key []byte) (string, string, uint64, error) {
+	// the sequence is stored as 8 big endian bytes which may contain the separator
+	if len(key) < len(types.QueryResultKey)+8 {
+		return "", "", 0, fmt.Errorf("invalid query result key %X", key)
+	}
+
+	identifiers := strings.Split(string(key[len(types.QueryResultKey):len(key)-8]), "/")
+	if len(identifiers) != 3 || identifiers[2] != "" {
+		return "", "", 0, fmt.Errorf("invalid query result key %X", key)
+	}
+
+	return identifiers[0], identifiers[1], sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the interchain query module that can claim a capability that IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// SubmitQuery defines a rpc handler method for MsgSubmitQuery.
+func (k Keeper) SubmitQuery(goCtx context.Context, msg *types.MsgSubmitQuery) (*types.MsgSubmitQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := k.SendQuery(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.Requests,
+		msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain query submitted", "port-id", msg.SourcePort, "channel-id", msg.SourceChannel, "sequence", sequence, "sender", msg.Sender)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitQuery,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyPaths, types.QueryPaths(msg.Requests)),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSubmitQueryResponse{
+		Sequence: sequence,
+	}, nil
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+const (
+	// timeoutErrorString defines the error stored as the result of a timed out interchain query
+	timeoutErrorString = "interchain query packet timed out"
+)
+
+// SendQuery sends the given ABCI query requests to the host chain over the provided channel.
+// The sequence of the packet carrying the interchain query is returned, it identifies the
+// result of the query once the packet is acknowledged or timed out.
+func (k Keeper) SendQuery(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	requests []types.RequestQuery,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	// refuse queries whose packet can never be relayed to the host chain
+	clientID, status, err := k.channelKeeper.GetChannelClientStatus(ctx, sourcePort, sourceChannel)
+	if err != nil {
+		return 0, err
+	}
+
+	if status != ibcexported.Active {
+		return 0, sdkerrors.Wrapf(types.ErrInactiveClient, "cannot query over channel %s using client (%s) with status %s", sourceChannel, clientID, status)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	bz, err := types.SerializeCosmosQuery(requests)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := types.NewInterchainQueryPacketData(bz, memo)
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvPacket executes the ABCI query requests of an interchain query against the last
+// committed state of the host chain. The queried values and their proofs are returned as
+// the acknowledgement of the packet. The interchain query fails if the host is disabled, if
+// any of the query paths is not allowed or if any of the queries fails.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.InterchainQueryPacketData) ([]byte, error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !k.IsHostEnabled(ctx) {
+		return nil, types.ErrHostDisabled
+	}
+
+	requests, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateQueryRequests(requests); err != nil {
+		return nil, err
+	}
+
+	responses, err := k.executeQueries(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.SerializeCosmosResponse(responses)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteQuery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPaths, types.QueryPaths(requests)),
+		),
+	)
+
+	ack := types.InterchainQueryPacketAck{Data: bz}
+	return ack.GetBytes(), nil
+}
+
+// executeQueries executes the provided ABCI query requests with proofs. The queries are
+// executed against the last committed height, which is the latest height every validator
+// is guaranteed to agree upon, in order to keep the results deterministic. Gas is consumed
+// for every queried byte as for a regular store read.
+func (k Keeper) executeQueries(ctx sdk.Context, requests []types.RequestQuery) ([]types.ResponseQuery, error) {
+	params := k.GetParams(ctx)
+	gasConfig := storetypes.KVGasConfig()
+	height := ctx.BlockHeight() - 1
+
+	responses := make([]types.ResponseQuery, len(requests))
+	for i, request := range requests {
+		if !params.IsAllowedQuery(request.Path) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorizedQuery, "query path %s is not allowed", request.Path)
+		}
+
+		res := k.querier.Query(abci.RequestQuery{
+			Data:   request.Data,
+			Path:   request.Path,
+			Height: height,
+			Prove:  true,
+		})
+
+		// NOTE: the log of a failed query is not deterministic and is not included in the error
+		if res.Code != 0 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidQuery, "query %d on path %s failed with code %d", i, request.Path, res.Code)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, types.ModuleName)
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*sdk.Gas(len(res.Key)+len(res.Value)), types.ModuleName)
+
+		responses[i] = types.ResponseQuery{
+			Code:      res.Code,
+			Log:       res.Log,
+			Key:       res.Key,
+			Value:     res.Value,
+			ProofOps:  res.ProofOps,
+			Height:    res.Height,
+			Codespace: res.Codespace,
+		}
+	}
+
+	return responses, nil
+}
+
+// OnAcknowledgementPacket stores the result of an interchain query once the packet carrying
+// it has been acknowledged by the host chain. A successful acknowledgement contains the
+// responses of the host chain, the error of a failed acknowledgement is stored otherwise.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	var result types.QueryResult
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.InterchainQueryPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(resp.Result, &packetAck); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal interchain query packet acknowledgement: %v", err)
+		}
+
+		responses, err := types.DeserializeCosmosResponse(packetAck.Data)
+		if err != nil {
+			return err
+		}
+
+		result.Responses = responses
+	case *channeltypes.Acknowledgement_Error:
+		result.Error = resp.Error
+	}
+
+	k.SetQueryResult(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), result)
+	return nil
+}
+
+// OnTimeoutPacket stores a failed result for an interchain query whose packet timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.SetQueryResult(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.QueryResult{
+		Error: timeoutErrorString,
+	})
+	return nil
+}
@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// balanceKey returns the bank store key of the balance of the given account.
+func balanceKey(addr sdk.AccAddress) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(addr), []byte(sdk.DefaultBondDenom)...)
+}
+
+func (suite *KeeperTestSuite) TestSendQuery() {
+	var (
+		path     *ibctesting.Path
+		requests []types.RequestQuery
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"source channel not found", func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client not active", func() {
+				clientState := path.EndpointA.GetClientState()
+				path.EndpointA.SetClientState(clientState.ZeroCustomFields())
+			}, false,
+		},
+		{
+			"channel capability not found", func() {
+				cap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(suite.chainA.GetSimApp().ScopedICQKeeper.ReleaseCapability(suite.chainA.GetContext(), cap))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewQueryPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			requests = []types.RequestQuery{{Path: bankQueryPath, Data: balanceKey(suite.chainB.SenderAccount.GetAddress())}}
+
+			tc.malleate()
+
+			sequence, err := suite.chainA.GetSimApp().ICQKeeper.SendQuery(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, requests,
+				clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path     *ibctesting.Path
+		requests []types.RequestQuery
+		params   types.Params
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: absent key", func() {
+				requests = []types.RequestQuery{{Path: bankQueryPath, Data: balanceKey(sdk.AccAddress("absent"))}}
+			}, true,
+		},
+		{
+			"host disabled", func() {
+				params.HostEnabled = false
+			}, false,
+		},
+		{
+			"query path not allowed", func() {
+				params.AllowQueries = []string{"/store/staking/key"}
+			}, false,
+		},
+		{
+			"query fails on the host chain", func() {
+				params.AllowQueries = []string{"/store/unknown/key"}
+				requests = []types.RequestQuery{{Path: "/store/unknown/key", Data: []byte("key")}}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewQueryPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			requests = []types.RequestQuery{{Path: bankQueryPath, Data: balanceKey(suite.chainB.SenderAccount.GetAddress())}}
+			params = types.NewParams(true, []string{bankQueryPath})
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			bz, err := types.SerializeCosmosQuery(requests)
+			suite.Require().NoError(err)
+
+			data := types.NewInterchainQueryPacketData(bz, "")
+			packet := channeltypes.NewPacket(
+				data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+			)
+
+			ackBytes, err := suite.chainB.GetSimApp().ICQKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			var ack types.InterchainQueryPacketAck
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ackBytes, &ack))
+
+			responses, err := types.DeserializeCosmosResponse(ack.Data)
+			suite.Require().NoError(err)
+			suite.Require().Len(responses, 1)
+			suite.Require().Equal(suite.chainB.GetContext().BlockHeight()-1, responses[0].Height)
+
+			// the response must be provable against the last committed app hash of the host chain
+			merkleProof, err := commitmenttypes.ConvertProofs(responses[0].ProofOps)
+			suite.Require().NoError(err)
+
+			root := commitmenttypes.NewMerkleRoot(suite.chainB.App.LastCommitID().Hash)
+			// NOTE: the keys of a merkle path are url escaped
+			merklePath := commitmenttypes.NewMerklePath(banktypes.StoreKey, url.PathEscape(string(requests[0].Data)))
+			if len(responses[0].Value) == 0 {
+				suite.Require().NoError(merkleProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, merklePath))
+			} else {
+				suite.Require().NoError(merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, responses[0].Value))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRelayQuery() {
+	path := NewQueryPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{bankQueryPath}))
+
+	senderB := suite.chainB.SenderAccount.GetAddress()
+	requests := []types.RequestQuery{{Path: bankQueryPath, Data: balanceKey(senderB)}}
+	msg := types.NewMsgSubmitQuery(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, requests,
+		suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	bz, err := types.SerializeCosmosQuery(requests)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(
+		types.NewInterchainQueryPacketData(bz, "").GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0,
+	)
+
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	result, found := suite.chainA.GetSimApp().ICQKeeper.GetQueryResult(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Empty(result.Error)
+	suite.Require().Len(result.Responses, 1)
+	suite.Require().NotNil(result.Responses[0].ProofOps)
+
+	var balance sdk.Coin
+	suite.Require().NoError(suite.chainB.App.AppCodec().Unmarshal(result.Responses[0].Value, &balance))
+	suite.Require().Equal(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, sdk.DefaultBondDenom), balance)
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	path := NewQueryPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	responses := []types.ResponseQuery{{Key: []byte("key"), Value: []byte("value"), Height: 10}}
+	bz, err := types.SerializeCosmosResponse(responses)
+	suite.Require().NoError(err)
+
+	packetAck := types.InterchainQueryPacketAck{Data: bz}
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+	)
+
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+	ctx := suite.chainA.GetContext()
+
+	err = icqKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(packetAck.GetBytes()))
+	suite.Require().NoError(err)
+
+	result, found := icqKeeper.GetQueryResult(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(types.QueryResult{Responses: responses}, result)
+
+	// the error of a failed acknowledgement is stored
+	packet.Sequence = 2
+	err = icqKeeper.OnAcknowledgementPacket(ctx, packet, types.NewErrorAcknowledgement(types.ErrHostDisabled))
+	suite.Require().NoError(err)
+
+	result, found = icqKeeper.GetQueryResult(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Empty(result.Responses)
+	suite.Require().NotEmpty(result.Error)
+
+	// an acknowledgement which cannot be decoded is rejected
+	packet.Sequence = 3
+	err = icqKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte("invalid")))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := NewQueryPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+	)
+
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+	ctx := suite.chainA.GetContext()
+
+	suite.Require().NoError(icqKeeper.OnTimeoutPacket(ctx, packet))
+
+	result, found := icqKeeper.GetQueryResult(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().NotEmpty(result.Error)
+}
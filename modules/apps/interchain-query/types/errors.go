@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Interchain query sentinel errors
+var (
+	ErrInvalidVersion      = sdkerrors.Register(ModuleName, 2, "invalid interchain query version")
+	ErrMaxQueryChannels    = sdkerrors.Register(ModuleName, 3, "max interchain query channels")
+	ErrInvalidPacketData   = sdkerrors.Register(ModuleName, 4, "invalid interchain query packet data")
+	ErrInvalidQuery        = sdkerrors.Register(ModuleName, 5, "invalid interchain query request")
+	ErrHostDisabled        = sdkerrors.Register(ModuleName, 6, "interchain query host is disabled")
+	ErrUnauthorizedQuery   = sdkerrors.Register(ModuleName, 7, "query path is not allowed on the host chain")
+	ErrInactiveClient      = sdkerrors.Register(ModuleName, 8, "client of the host chain is not active")
+	ErrQueryResultNotFound = sdkerrors.Register(ModuleName, 9, "interchain query result not found")
+)
@@ -0,0 +1,15 @@
+package types
+
+// Interchain query events
+const (
+	EventTypePacket       = "interchain_query_packet"
+	EventTypeTimeout      = "timeout"
+	EventTypeSubmitQuery  = "submit_interchain_query"
+	EventTypeExecuteQuery = "execute_interchain_query"
+
+	AttributeKeyPaths      = "paths"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+	AttributeKeyMemo       = "memo"
+)
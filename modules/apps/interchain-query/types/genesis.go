@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewGenesisState creates a new interchain query GenesisState instance.
+func NewGenesisState(portID string, params Params, results []IdentifiedQueryResult) *GenesisState {
+	return &GenesisState{
+		PortId:  portID,
+		Params:  params,
+		Results: results,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with "icq" as the default PortID.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PortId:  PortID,
+		Params:  DefaultParams(),
+		Results: []IdentifiedQueryResult{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for i, result := range gs.Results {
+		if err := host.PortIdentifierValidator(result.PortId); err != nil {
+			return fmt.Errorf("invalid query result %d: %w", i, err)
+		}
+		if err := host.ChannelIdentifierValidator(result.ChannelId); err != nil {
+			return fmt.Errorf("invalid query result %d: %w", i, err)
+		}
+		if result.Sequence == 0 {
+			return fmt.Errorf("invalid query result %d: packet sequence cannot be 0", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the interchain query module name
+	ModuleName = "interchainquery"
+
+	// Version defines the current version the interchain query
+	// module supports
+	Version = "icq-1"
+
+	// PortID is the default port id that the interchain query module binds to
+	PortID = "icq"
+
+	// StoreKey is the store key string for the interchain query module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the interchain query module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the interchain query module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// QueryResultKey defines the key prefix to store the results of the interchain
+	// queries sent by the chain
+	QueryResultKey = []byte{0x02}
+)
+
+// QueryResultPrefix returns the store key prefix under which the query results of the
+// given channel are stored.
+func QueryResultPrefix(portID, channelID string) []byte {
+	return append(QueryResultKey, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+}
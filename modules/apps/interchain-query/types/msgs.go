@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgSubmitQuery = "submit_query"
+)
+
+// NewMsgSubmitQuery creates a new MsgSubmitQuery instance
+//
+//nolint:interfacer
+func NewMsgSubmitQuery(
+	sourcePort, sourceChannel string, requests []RequestQuery, sender string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgSubmitQuery {
+	return &MsgSubmitQuery{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Requests:         requests,
+		Sender:           sender,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSubmitQuery) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSubmitQuery) Type() string {
+	return TypeMsgSubmitQuery
+}
+
+// ValidateBasic performs a basic check of the MsgSubmitQuery fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgSubmitQuery) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if err := ValidateQueryRequests(msg.Requests); err != nil {
+		return err
+	}
+	if len(msg.Memo) > MaxMemoCharLength {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSubmitQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitQuery) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
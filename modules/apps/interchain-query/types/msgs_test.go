@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+var (
+	sender        = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	timeoutHeight = clienttypes.NewHeight(0, 10)
+	requests      = []RequestQuery{{Path: "/store/bank/key", Data: []byte("key")}}
+)
+
+// TestMsgSubmitQueryValidation tests ValidateBasic for MsgSubmitQuery
+func TestMsgSubmitQueryValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgSubmitQuery
+		expPass bool
+	}{
+		{"valid msg", NewMsgSubmitQuery("icq", "channel-0", requests, sender, timeoutHeight, 0, ""), true},
+		{"valid msg without query data", NewMsgSubmitQuery("icq", "channel-0", []RequestQuery{{Path: "/store/bank/key"}}, sender, timeoutHeight, 0, "memo"), true},
+		{"too short port id", NewMsgSubmitQuery("p", "channel-0", requests, sender, timeoutHeight, 0, ""), false},
+		{"invalid channel id", NewMsgSubmitQuery("icq", "(channel-0)", requests, sender, timeoutHeight, 0, ""), false},
+		{"no requests", NewMsgSubmitQuery("icq", "channel-0", nil, sender, timeoutHeight, 0, ""), false},
+		{"blank query path", NewMsgSubmitQuery("icq", "channel-0", []RequestQuery{{Path: " "}}, sender, timeoutHeight, 0, ""), false},
+		{"too many requests", NewMsgSubmitQuery("icq", "channel-0", make([]RequestQuery, MaxQueryRequests+1), sender, timeoutHeight, 0, ""), false},
+		{"memo too long", NewMsgSubmitQuery("icq", "channel-0", requests, sender, timeoutHeight, 0, strings.Repeat("a", MaxMemoCharLength+1)), false},
+		{"invalid sender", NewMsgSubmitQuery("icq", "channel-0", requests, "sender", timeoutHeight, 0, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgSubmitQueryGetSigners tests GetSigners for MsgSubmitQuery
+func TestMsgSubmitQueryGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgSubmitQuery("icq", "channel-0", requests, addr.String(), timeoutHeight, 0, "")
+	res := msg.GetSigners()
+
+	require.Equal(t, []sdk.AccAddress{addr}, res)
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxMemoCharLength defines the maximum length for the InterchainQueryPacketData memo field
+	MaxMemoCharLength = 256
+
+	// MaxQueryRequests defines the maximum number of ABCI query requests carried by a single
+	// interchain query packet
+	MaxQueryRequests = 50
+)
+
+// NewInterchainQueryPacketData contructs a new InterchainQueryPacketData instance
+func NewInterchainQueryPacketData(data []byte, memo string) InterchainQueryPacketData {
+	return InterchainQueryPacketData{
+		Data: data,
+		Memo: memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+// The memo may be empty.
+func (iqpd InterchainQueryPacketData) ValidateBasic() error {
+	if len(iqpd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "packet data cannot be empty")
+	}
+
+	if len(iqpd.Memo) > MaxMemoCharLength {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (iqpd InterchainQueryPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iqpd))
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (iqpa InterchainQueryPacketAck) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iqpa))
+}
+
+// ValidateBasic performs basic validation of the ABCI query request. The query data may
+// be empty.
+func (rq RequestQuery) ValidateBasic() error {
+	if strings.TrimSpace(rq.Path) == "" {
+		return sdkerrors.Wrap(ErrInvalidQuery, "query path cannot be blank")
+	}
+
+	return nil
+}
+
+// ValidateQueryRequests performs basic validation of the ABCI query requests of an
+// interchain query.
+func ValidateQueryRequests(requests []RequestQuery) error {
+	if len(requests) == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuery, "query requests cannot be empty")
+	}
+
+	if len(requests) > MaxQueryRequests {
+		return sdkerrors.Wrapf(ErrInvalidQuery, "number of query requests (%d) exceeds the maximum of %d", len(requests), MaxQueryRequests)
+	}
+
+	for i, request := range requests {
+		if err := request.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid query request %d", i)
+		}
+	}
+
+	return nil
+}
+
+// QueryPaths returns the comma separated ABCI query paths of the given requests.
+func QueryPaths(requests []RequestQuery) string {
+	paths := make([]string, len(requests))
+	for i, request := range requests {
+		paths[i] = request.Path
+	}
+	return strings.Join(paths, ",")
+}
+
+// SerializeCosmosQuery serializes the provided ABCI query requests into a CosmosQuery.
+func SerializeCosmosQuery(requests []RequestQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosQuery{Requests: requests})
+}
+
+// DeserializeCosmosQuery deserializes the ABCI query requests of a CosmosQuery.
+func DeserializeCosmosQuery(bz []byte) ([]RequestQuery, error) {
+	var cosmosQuery CosmosQuery
+	if err := ModuleCdc.Unmarshal(bz, &cosmosQuery); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPacketData, err.Error())
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// SerializeCosmosResponse serializes the provided ABCI query responses into a CosmosResponse.
+func SerializeCosmosResponse(responses []ResponseQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosResponse{Responses: responses})
+}
+
+// DeserializeCosmosResponse deserializes the ABCI query responses of a CosmosResponse.
+func DeserializeCosmosResponse(bz []byte) ([]ResponseQuery, error) {
+	var cosmosResponse CosmosResponse
+	if err := ModuleCdc.Unmarshal(bz, &cosmosResponse); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPacketData, err.Error())
+	}
+
+	return cosmosResponse.Responses, nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// TestInterchainQueryPacketDataValidateBasic tests ValidateBasic for InterchainQueryPacketData
+func TestInterchainQueryPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData InterchainQueryPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewInterchainQueryPacketData([]byte("data"), ""), true},
+		{"valid packet with memo", NewInterchainQueryPacketData([]byte("data"), "memo"), true},
+		{"empty data", NewInterchainQueryPacketData(nil, "memo"), false},
+		{"memo too long", NewInterchainQueryPacketData([]byte("data"), strings.Repeat("a", MaxMemoCharLength+1)), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestSerializeCosmosQuery tests that the query requests and responses carried by the packet
+// data and acknowledgement are decoded back to the original values.
+func TestSerializeCosmosQuery(t *testing.T) {
+	bz, err := SerializeCosmosQuery(requests)
+	require.NoError(t, err)
+
+	decodedRequests, err := DeserializeCosmosQuery(bz)
+	require.NoError(t, err)
+	require.Equal(t, requests, decodedRequests)
+
+	responses := []ResponseQuery{{
+		Key:      []byte("key"),
+		Value:    []byte("value"),
+		ProofOps: &crypto.ProofOps{Ops: []crypto.ProofOp{{Type: "ics23:iavl", Key: []byte("key"), Data: []byte("proof")}}},
+		Height:   10,
+	}}
+
+	bz, err = SerializeCosmosResponse(responses)
+	require.NoError(t, err)
+
+	decodedResponses, err := DeserializeCosmosResponse(bz)
+	require.NoError(t, err)
+	require.Equal(t, responses, decodedResponses)
+
+	_, err = DeserializeCosmosQuery([]byte("invalid"))
+	require.Error(t, err)
+
+	_, err = DeserializeCosmosResponse([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, NewParams(false, []string{"/store/bank/key"}).Validate())
+	require.Error(t, NewParams(true, []string{""}).Validate())
+	require.Error(t, NewParams(true, []string{"/custom/bank/balance"}).Validate())
+}
+
+func TestIsAllowedQuery(t *testing.T) {
+	params := NewParams(true, []string{"/store/bank/key", "/store/staking/key"})
+
+	require.True(t, params.IsAllowedQuery("/store/bank/key"))
+	require.False(t, params.IsAllowedQuery("/store/bank/subspace"))
+	require.False(t, DefaultParams().IsAllowedQuery("/store/bank/key"))
+}
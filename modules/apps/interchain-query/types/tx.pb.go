@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_query/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSubmitQuery defines a msg sending ABCI query requests to the host chain
+// of an interchain query channel.
+type MsgSubmitQuery struct {
+	// the port on which the packet will be sent
+	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
+	// the channel by which the packet will be sent
+	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
+	// the ABCI query requests executed on the host chain
+	Requests []RequestQuery `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests"`
+	// the sender address
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types.Height `protobuf:"bytes,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// Timeout timestamp in absolute nanoseconds since unix epoch.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// optional memo
+	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgSubmitQuery) Reset()         { *m = MsgSubmitQuery{} }
+func (m *MsgSubmitQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQuery) ProtoMessage()    {}
+func (*MsgSubmitQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb74eaf9f4f1ab9e, []int{0}
+}
+func (m *MsgSubmitQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQuery.Merge(m, src)
+}
+func (m *MsgSubmitQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitQuery proto.InternalMessageInfo
+
+// MsgSubmitQueryResponse defines the Msg/SubmitQuery response type.
+type MsgSubmitQueryResponse struct {
+	// sequence of the packet carrying the interchain query
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSubmitQueryResponse) Reset()         { *m = MsgSubmitQueryResponse{} }
+func (m *MsgSubmitQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResponse) ProtoMessage()    {}
+func (*MsgSubmitQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb74eaf9f4f1ab9e, []int{1}
+}
+func (m *MsgSubmitQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResponse.Merge(m, src)
+}
+func (m *MsgSubmitQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitQueryResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitQueryResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgSubmitQuery)(nil), "ibc.applications.interchain_query.v1.MsgSubmitQuery")
+	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "ibc.applications.interchain_query.v1.MsgSubmitQueryResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_query/v1/tx.proto", fileDescriptor_fb74eaf9f4f1ab9e)
+}
+
+var fileDescriptor_fb74eaf9f4f1ab9e = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbf, 0x8f, 0xd3, 0x30,
+	0x14, 0x4e, 0x68, 0x38, 0x8a, 0xab, 0xab, 0xc0, 0x82, 0x2a, 0x54, 0x90, 0x54, 0x11, 0x43, 0x97,
+	0xda, 0x6a, 0xef, 0x24, 0xa4, 0x13, 0x12, 0x28, 0x2c, 0x30, 0x9c, 0x04, 0xe1, 0x16, 0x58, 0x4a,
+	0xe2, 0xb3, 0x12, 0x4b, 0x49, 0x9c, 0xc6, 0x4e, 0x45, 0x47, 0x36, 0xd8, 0xf8, 0x13, 0xee, 0x8f,
+	0x61, 0xb8, 0xf1, 0x46, 0xa6, 0x0a, 0xb5, 0x0b, 0x73, 0xff, 0x02, 0xe4, 0x24, 0x2d, 0x0d, 0x53,
+	0xc5, 0x94, 0xf7, 0xe3, 0xfb, 0x5e, 0xde, 0xfb, 0xfc, 0x81, 0x11, 0x0b, 0x08, 0xf6, 0xb3, 0x2c,
+	0x66, 0xc4, 0x97, 0x8c, 0xa7, 0x02, 0xb3, 0x54, 0xd2, 0x9c, 0x44, 0x3e, 0x4b, 0xa7, 0xb3, 0x82,
+	0xe6, 0x0b, 0x3c, 0x1f, 0x63, 0xf9, 0x19, 0x65, 0x39, 0x97, 0x1c, 0x3e, 0x65, 0x01, 0x41, 0xfb,
+	0x70, 0xf4, 0x2f, 0x1c, 0xcd, 0xc7, 0xfd, 0x07, 0x21, 0x0f, 0x79, 0x49, 0xc0, 0x2a, 0xaa, 0xb8,
+	0x7d, 0x5b, 0xfd, 0x8a, 0xf0, 0x9c, 0x62, 0x12, 0x33, 0x9a, 0x4a, 0x35, 0xb8, 0x8a, 0x6a, 0x00,
+	0x3a, 0x68, 0x17, 0x46, 0x66, 0x15, 0xde, 0xf9, 0xd1, 0x02, 0xdd, 0x73, 0x11, 0xbe, 0x2f, 0x82,
+	0x84, 0xc9, 0x77, 0xaa, 0x0f, 0x9f, 0x81, 0x8e, 0xe0, 0x45, 0x4e, 0xe8, 0x34, 0xe3, 0xb9, 0x34,
+	0xf5, 0x81, 0x3e, 0xbc, 0xeb, 0xf6, 0x36, 0x4b, 0x1b, 0x2e, 0xfc, 0x24, 0x3e, 0x73, 0xf6, 0x9a,
+	0x8e, 0x07, 0xaa, 0xec, 0x2d, 0xcf, 0x25, 0x7c, 0x09, 0xba, 0x75, 0x8f, 0x44, 0x7e, 0x9a, 0xd2,
+	0xd8, 0xbc, 0x55, 0x72, 0x1f, 0x6d, 0x96, 0xf6, 0xc3, 0x06, 0xb7, 0xee, 0x3b, 0xde, 0x71, 0x55,
+	0x78, 0x55, 0xe5, 0xf0, 0x02, 0xb4, 0x73, 0x3a, 0x2b, 0xa8, 0x90, 0xc2, 0x6c, 0x0d, 0x5a, 0xc3,
+	0xce, 0x64, 0x82, 0x0e, 0x51, 0x0b, 0x79, 0x15, 0xab, 0x3c, 0xc0, 0x35, 0xae, 0x97, 0xb6, 0xe6,
+	0xed, 0x26, 0xc1, 0x1e, 0x38, 0x12, 0x34, 0xbd, 0xa4, 0xb9, 0x69, 0xa8, 0x7d, 0xbc, 0x3a, 0x83,
+	0x9f, 0x40, 0x57, 0xb2, 0x84, 0xf2, 0x42, 0x4e, 0x23, 0xca, 0xc2, 0x48, 0x9a, 0xb7, 0x07, 0xfa,
+	0xb0, 0x33, 0x29, 0x45, 0x44, 0x4a, 0x65, 0x54, 0x6b, 0x3b, 0x1f, 0xa3, 0xd7, 0x25, 0xc2, 0x7d,
+	0xa2, 0x66, 0xff, 0xbd, 0xa7, 0xc9, 0x77, 0xbc, 0xe3, 0xba, 0x50, 0xa1, 0xe1, 0x1b, 0x70, 0x7f,
+	0x8b, 0x50, 0x5f, 0x21, 0xfd, 0x24, 0x33, 0x8f, 0x06, 0xfa, 0xd0, 0x70, 0x1f, 0x6f, 0x96, 0xb6,
+	0xd9, 0x1c, 0xb2, 0x83, 0x38, 0xde, 0xbd, 0xba, 0x76, 0xb1, 0x2d, 0x41, 0x08, 0x8c, 0x84, 0x26,
+	0xdc, 0xbc, 0x53, 0x9e, 0x50, 0xc6, 0x67, 0xed, 0xaf, 0x57, 0xb6, 0xf6, 0xfb, 0xca, 0xd6, 0x9c,
+	0x53, 0xd0, 0x6b, 0xbe, 0xa2, 0x47, 0x45, 0xc6, 0x53, 0x41, 0x61, 0x1f, 0xb4, 0x85, 0x12, 0x22,
+	0x25, 0xb4, 0x7c, 0x4a, 0xc3, 0xdb, 0xe5, 0x93, 0x6f, 0x3a, 0x68, 0x9d, 0x8b, 0x10, 0x7e, 0xd1,
+	0x41, 0x67, 0xdf, 0x01, 0xa7, 0x87, 0x89, 0xde, 0xfc, 0x63, 0xff, 0xf9, 0xff, 0xb0, 0xb6, 0x7b,
+	0xba, 0x1f, 0xae, 0x57, 0x96, 0x7e, 0xb3, 0xb2, 0xf4, 0x5f, 0x2b, 0x4b, 0xff, 0xbe, 0xb6, 0xb4,
+	0x9b, 0xb5, 0xa5, 0xfd, 0x5c, 0x5b, 0xda, 0xc7, 0x17, 0x21, 0x93, 0x51, 0x11, 0x20, 0xc2, 0x13,
+	0x4c, 0xb8, 0x48, 0xb8, 0xc0, 0x2c, 0x20, 0xa3, 0x90, 0xe3, 0xf9, 0x09, 0x4e, 0xf8, 0x65, 0x11,
+	0x53, 0xa1, 0x2c, 0xbf, 0x6f, 0xf5, 0x51, 0x65, 0x75, 0xb9, 0xc8, 0xa8, 0x08, 0x8e, 0x4a, 0xab,
+	0x9f, 0xfc, 0x19, 0x00, 0x74, 0xcc, 0xbd, 0x4f, 0xa8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitQuery defines a rpc handler method for MsgSubmitQuery.
+	SubmitQuery(ctx context.Context, in *MsgSubmitQuery, opts ...grpc.CallOption) (*MsgSubmitQueryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitQuery(ctx context.Context, in *MsgSubmitQuery, opts ...grpc.CallOption) (*MsgSubmitQueryResponse, error) {
+	out := new(MsgSubmitQueryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_query.v1.Msg/SubmitQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitQuery defines a rpc handler method for MsgSubmitQuery.
+	SubmitQuery(context.Context, *MsgSubmitQuery) (*MsgSubmitQueryResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitQuery(ctx context.Context, req *MsgSubmitQuery) (*MsgSubmitQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuery not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_query.v1.Msg/SubmitQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitQuery(ctx, req.(*MsgSubmitQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_query.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitQuery",
+			Handler:    _Msg_SubmitQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_query/v1/tx.proto",
+}
+
+func (m *MsgSubmitQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, RequestQuery{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
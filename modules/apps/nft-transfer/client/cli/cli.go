@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for IBC non-fungible token transfer
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-nft-transfer",
+		Short:                      "IBC non-fungible token transfer query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryClassTrace(),
+		GetCmdQueryClassTraces(),
+		GetCmdQueryClassHash(),
+		GetCmdQueryEscrowAddress(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for IBC non-fungible token transfer
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-nft-transfer",
+		Short:                      "IBC non-fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTransferTxCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+)
+
+// GetCmdQueryClassTrace defines the command to query a class trace from a given hash or
+// voucher class id.
+func GetCmdQueryClassTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-trace [hash/class-id]",
+		Short:   "Query the class trace info from a given trace hash or ibc class id",
+		Long:    "Query the class trace info from a given trace hash or ibc class id",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer class-trace [hash/class-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClassTraceRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.ClassTrace(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClassTraces defines the command to query all the class trace infos
+// that this chain mantains.
+func GetCmdQueryClassTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-traces",
+		Short:   "Query the trace info for all non-fungible token classes",
+		Long:    "Query the trace info for all non-fungible token classes",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer class-traces", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClassTracesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClassTraces(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class traces")
+
+	return cmd
+}
+
+// GetCmdQueryClassHash defines the command to query a class hash from a given trace.
+func GetCmdQueryClassHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-hash [trace]",
+		Short:   "Query the class hash info from a given class trace",
+		Long:    "Query the class hash info from a given class trace",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer class-hash nft-transfer/channel-0/kitties", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClassHashRequest{
+				Trace: args[0],
+			}
+
+			res, err := queryClient.ClassHash(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowAddress returns the command handler for the nft-transfer escrow address query.
+func GetCmdQueryEscrowAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-address [port] [channel-id]",
+		Short:   "Get the escrow address for a channel",
+		Long:    "Get the escrow address for a channel",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer escrow-address nft-transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
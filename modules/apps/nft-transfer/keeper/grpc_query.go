@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method. The class trace may be queried
+// either by its hash or by the voucher class identifier ('ibc/{hash}').
+func (q Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hexHash := strings.TrimPrefix(req.Hash, types.ClassPrefix+"/")
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid class trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classTrace, found := q.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryClassTraceResponse{
+		ClassTrace: &classTrace,
+	}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (q Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.ClassTraceKey)
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.ClassTrace
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		traces = append(traces, result)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTracesResponse{
+		ClassTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// ClassHash implements the Query/ClassHash gRPC method
+func (q Keeper) ClassHash(c context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Convert given request trace path to ClassTrace struct to confirm the path in a valid class trace format
+	classTrace := types.ParseClassTrace(req.Trace)
+	if err := classTrace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// a trace without any port and channel identifiers refers to a native class
+	// which is never represented as an IBC voucher class
+	if classTrace.Path == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrapf(types.ErrInvalidClassIDForTransfer, "trace %s does not contain any port and channel identifiers", req.Trace).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classHash := classTrace.Hash()
+	if !q.HasClassTrace(ctx, classHash) {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Trace).Error(),
+		)
+	}
+
+	return &types.QueryClassHashResponse{
+		Hash:       classHash.String(),
+		IbcClassId: classTrace.IBCClassID(),
+	}, nil
+}
+
+// EscrowAddress implements the Query/EscrowAddress gRPC method
+func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: types.GetEscrowAddress(req.PortId, req.ChannelId).String(),
+	}, nil
+}
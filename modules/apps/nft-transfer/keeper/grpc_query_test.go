@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryClassTrace() {
+	var (
+		req      *types.QueryClassTraceRequest
+		expTrace types.ClassTrace
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: correct hash",
+			func() {
+				expTrace.Path = "nft-transfer/channelToA/nft-transfer/channelToB"
+				expTrace.BaseClassId = classID
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryClassTraceRequest{
+					Hash: expTrace.Hash().String(),
+				}
+			},
+			true,
+		},
+		{
+			"success: correct voucher class id",
+			func() {
+				expTrace.Path = "nft-transfer/channelToA/nft-transfer/channelToB"
+				expTrace.BaseClassId = classID
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryClassTraceRequest{
+					Hash: expTrace.IBCClassID(),
+				}
+			},
+			true,
+		},
+		{
+			"invalid hex hash",
+			func() {
+				req = &types.QueryClassTraceRequest{
+					Hash: "!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"not found class trace",
+			func() {
+				expTrace.Path = "nft-transfer/channelToA/nft-transfer/channelToB"
+				expTrace.BaseClassId = classID
+
+				req = &types.QueryClassTraceRequest{
+					Hash: expTrace.Hash().String(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ClassTrace(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expTrace, res.ClassTrace)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClassTraces() {
+	var (
+		req       *types.QueryClassTracesRequest
+		expTraces = types.Traces(nil)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryClassTracesRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expTraces = append(expTraces, types.ClassTrace{Path: "", BaseClassId: classID})
+				expTraces = append(expTraces, types.ClassTrace{Path: "nft-transfer/channelToB", BaseClassId: classID})
+				expTraces = append(expTraces, types.ClassTrace{Path: "nft-transfer/channelToA/nft-transfer/channelToB", BaseClassId: classID})
+
+				for _, trace := range expTraces {
+					suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), trace)
+				}
+
+				req = &types.QueryClassTracesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ClassTraces(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expTraces.Sort(), res.ClassTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClassHash() {
+	reqTrace := types.ClassTrace{
+		Path:        "nft-transfer/channel-0/nft-transfer/channel-1",
+		BaseClassId: classID,
+	}
+
+	var req *types.QueryClassHashRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nft-transfer/channel-0/nft-transfer/",
+				}
+			},
+			false,
+		},
+		{
+			"not found class trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nft-transfer/channel-2/kitties",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req = &types.QueryClassHashRequest{
+				Trace: reqTrace.GetFullClassPath(),
+			}
+			suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), reqTrace)
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ClassHash(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(reqTrace.Hash().String(), res.Hash)
+				suite.Require().Equal(reqTrace.IBCClassID(), res.IbcClassId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowAddress() {
+	var req *types.QueryEscrowAddressRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    ibctesting.MockPort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"failure - invalid port identifier",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    "(invalid)",
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+			if tc.expPass {
+				req.PortId = path.EndpointA.ChannelConfig.PortID
+				req.ChannelId = path.EndpointA.ChannelID
+			}
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.EscrowAddress(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				expected := types.GetEscrowAddress(req.PortId, req.ChannelId).String()
+				suite.Require().Equal(expected, res.EscrowAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
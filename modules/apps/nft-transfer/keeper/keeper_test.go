@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const (
+	classID  = "kitties"
+	classURI = "https://kitties.example/class"
+	tokenID  = "kitty-1"
+	tokenURI = "https://kitties.example/kitty-1"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().NFTTransferKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+// mintNFT creates the test class if needed and mints the given token to the owner on the chain.
+func (suite *KeeperTestSuite) mintNFT(chain *ibctesting.TestChain, tokenID string, owner sdk.AccAddress) {
+	nftKeeper := chain.GetSimApp().NFTKeeper
+	ctx := chain.GetContext()
+
+	if !nftKeeper.HasClass(ctx, classID) {
+		suite.Require().NoError(nftKeeper.SaveClass(ctx, classID, classURI, ""))
+	}
+	suite.Require().NoError(nftKeeper.Mint(ctx, classID, tokenID, tokenURI, "", owner))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// SendTransfer handles non-fungible token transfer sending logic. There are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The tokens are transferred
+// to an escrow address (i.e locked) on the sender chain and then transferred
+// to the receiving chain through IBC TAO logic. It is expected that the
+// receiving chain will mint vouchers of the tokens to the receiving address.
+//
+// 2. Sender chain is acting as the sink zone. The tokens (vouchers) are burned
+// on the sender chain and then transferred to the receiving chain though IBC
+// TAO logic. It is expected that the receiving chain, which had previously
+// sent the original class, will unescrow the tokens and send them to the
+// receiving address.
+//
+// The class identifiers are traced exactly like the denominations of ICS20
+// fungible tokens, see the SendTransfer function of the transfer module.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	// refuse transfers whose packet can never be relayed to the destination chain
+	clientID, status, err := k.channelKeeper.GetChannelClientStatus(ctx, sourcePort, sourceChannel)
+	if err != nil {
+		return err
+	}
+
+	if status != ibcexported.Active {
+		return sdkerrors.Wrapf(types.ErrInactiveClient, "cannot transfer over channel %s using client (%s) with status %s", sourceChannel, clientID, status)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	// begin createOutgoingPacket logic
+	// See spec for this logic: https://github.com/cosmos/ibc/tree/master/spec/app/ics-721-nft-transfer#packet-relay
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	// NOTE: class id and hex hash correctness checked during msg.ValidateBasic
+	fullClassPath := classID
+
+	// deconstruct the class id into the class trace info
+	// to determine if the sender is the source chain
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		fullClassPath, err = k.ClassPathFromHash(ctx, classID)
+		if err != nil {
+			return err
+		}
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrClassNotFound, classID)
+	}
+
+	tokenURIs := make([]string, len(tokenIDs))
+	tokenData := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		nft, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrTokenNotFound, "class id (%s) token id (%s)", classID, tokenID)
+		}
+
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(sender) {
+			return sdkerrors.Wrapf(types.ErrUnauthorizedOwner, "token %s of class %s is not owned by %s", tokenID, classID, sender)
+		}
+
+		tokenURIs[i] = nft.GetUri()
+		tokenData[i] = nft.GetData()
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	}
+
+	// NOTE: SendTransfer simply sends the class id as it exists on its own
+	// chain inside the packet data. The receiving chain will perform class
+	// prefixing as necessary.
+
+	if types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath) {
+		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+
+		// escrow the source tokens
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		for _, tokenID := range tokenIDs {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return err
+			}
+		}
+	} else {
+		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+
+		// burn the vouchers
+		for _, tokenID := range tokenIDs {
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return err
+			}
+		}
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.GetUri(), class.GetData(),
+		tokenIDs, tokenURIs, tokenData,
+		sender.String(), receiver, memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "send"},
+			float32(len(tokenIDs)),
+			labels,
+		)
+	}()
+
+	return nil
+}
+
+// OnRecvPacket processes a cross chain non-fungible token transfer. If the
+// sender chain is the source of the class then vouchers of the tokens will be
+// minted to the receiving address, creating the voucher class if needed.
+// Otherwise if the sender chain is sending back tokens this chain originally
+// transferred to it, the tokens are unescrowed and sent to the receiving address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
+		telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
+	}
+
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this class as seen in the "sender chain is the source" condition.
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassId[len(voucherPrefix):]
+
+		// The class id of the escrowed tokens is either the native class id or the
+		// voucher class id of the trace if the class is not native.
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIds {
+			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+			// counterparty module which sends back tokens that were not escrowed.
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(escrowAddress) {
+				return sdkerrors.Wrapf(types.ErrUnauthorizedOwner, "token %s of class %s is not escrowed, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module", tokenID, classID)
+			}
+
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+
+		defer func() {
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "receive"},
+				float32(len(data.TokenIds)),
+				append(
+					labels, telemetry.NewLabel(coretypes.LabelSource, "true"),
+				),
+			)
+		}()
+
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the class id, we must prefix the class id here
+	sourcePrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedClassID := sourcePrefix + data.ClassId
+
+	// construct the class trace from the full raw class id
+	classTrace := types.ParseClassTrace(prefixedClassID)
+
+	traceHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, traceHash) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	if !k.nftKeeper.HasClass(ctx, voucherClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, voucherClassID, data.ClassUri, data.ClassData); err != nil {
+			return err
+		}
+	}
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, voucherClassID, tokenID, data.GetTokenURI(i), data.GetTokenDatum(i), receiver); err != nil {
+			return err
+		}
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "receive"},
+			float32(len(data.TokenIds)),
+			append(
+				labels, telemetry.NewLabel(coretypes.LabelSource, "false"),
+			),
+		)
+	}()
+
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// was a success then nothing occurs. If the acknowledgement failed, then
+// the sender is refunded their tokens using the refundPacketToken function.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketToken(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketToken(ctx, packet, data)
+}
+
+// refundPacketToken will unescrow and send back the tokens back to sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
+// were burnt in the original send so they are minted again and sent to
+// the sending address.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// NOTE: packet data type already checked in handler.go
+
+	// parse the class id from the full class path
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// unescrow tokens back to sender
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(escrowAddress) {
+				return sdkerrors.Wrapf(types.ErrUnauthorizedOwner, "token %s of class %s is not escrowed", tokenID, classID)
+			}
+
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// mint vouchers back to sender
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, classID, tokenID, data.GetTokenURI(i), data.GetTokenDatum(i), sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClassPathFromHash returns the full class path prefix from a voucher class id with a hash
+// component.
+func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (string, error) {
+	// trim the class prefix, by default "ibc/"
+	hexHash := classID[len(types.ClassPrefix+"/"):]
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidClassIDForTransfer, err.Error())
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	return classTrace.GetFullClassPath(), nil
+}
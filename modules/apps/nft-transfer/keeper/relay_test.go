@@ -0,0 +1,329 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendTransfer() {
+	var (
+		path     *ibctesting.Path
+		classID_ string
+		tokenIDs []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"source channel not found", func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"class not found", func() {
+				classID_ = "puppies"
+			}, false,
+		},
+		{
+			"voucher class trace not found", func() {
+				classID_ = types.ParseClassTrace(types.GetPrefixedClassID(types.PortID, "channel-7", classID)).IBCClassID()
+			}, false,
+		},
+		{
+			"token not found", func() {
+				tokenIDs = []string{tokenID, "kitty-2"}
+			}, false,
+		},
+		{
+			"token not owned by sender", func() {
+				suite.mintNFT(suite.chainA, "kitty-2", suite.chainB.SenderAccount.GetAddress())
+				tokenIDs = []string{"kitty-2"}
+			}, false,
+		},
+		{
+			"channel capability not found", func() {
+				cap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(suite.chainA.GetSimApp().ScopedNFTTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			suite.mintNFT(suite.chainA, tokenID, sender)
+			classID_ = classID
+			tokenIDs = []string{tokenID}
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().NFTTransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID_, tokenIDs,
+				sender, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Equal(escrowAddress, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestTransferRoundTrip transfers a token from chainA to chainB, where a voucher is minted,
+// and back to chainA, where the token is unescrowed.
+func (suite *KeeperTestSuite) TestTransferRoundTrip() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+	suite.mintNFT(suite.chainA, tokenID, senderA)
+
+	// transfer the token from chainA to chainB
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, []string{tokenID},
+		senderA.String(), receiverB.String(), clienttypes.NewHeight(0, 110), 0, "hello",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(escrowAddress, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+
+	classTrace := types.ParseClassTrace(types.GetPrefixedClassID(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, classID))
+	voucherClassID := classTrace.IBCClassID()
+
+	nftKeeperB := suite.chainB.GetSimApp().NFTKeeper
+	suite.Require().Equal(receiverB, nftKeeperB.GetOwner(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	class, found := nftKeeperB.GetClass(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(classURI, class.GetUri())
+
+	nft, found := nftKeeperB.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+	suite.Require().True(found)
+	suite.Require().Equal(tokenURI, nft.GetUri())
+
+	storedTrace, found := suite.chainB.GetSimApp().NFTTransferKeeper.GetClassTrace(suite.chainB.GetContext(), classTrace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(classTrace, storedTrace)
+
+	// transfer the voucher back from chainB to chainA
+	msg = types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID, []string{tokenID},
+		receiverB.String(), senderA.String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	_, found = nftKeeperB.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+	suite.Require().False(found, "voucher has not been burned")
+	suite.Require().Equal(senderA, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path *ibctesting.Path
+		data types.NonFungibleTokenPacketData
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: voucher minted", func() {}, true,
+		},
+		{
+			"success: token unescrowed", func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.mintNFT(suite.chainB, tokenID, escrowAddress)
+				data.ClassId = types.GetPrefixedClassID(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID)
+			}, true,
+		},
+		{
+			"failure: unescrowed token not owned by the escrow address", func() {
+				suite.mintNFT(suite.chainB, tokenID, suite.chainB.SenderAccount.GetAddress())
+				data.ClassId = types.GetPrefixedClassID(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID)
+			}, false,
+		},
+		{
+			"failure: voucher already minted", func() {
+				voucherClassID := types.ParseClassTrace(types.GetPrefixedClassID(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, classID)).IBCClassID()
+				nftKeeper := suite.chainB.GetSimApp().NFTKeeper
+				suite.Require().NoError(nftKeeper.SaveClass(suite.chainB.GetContext(), voucherClassID, "", ""))
+				suite.Require().NoError(nftKeeper.Mint(suite.chainB.GetContext(), voucherClassID, tokenID, "", "", suite.chainB.SenderAccount.GetAddress()))
+			}, false,
+		},
+		{
+			"failure: invalid receiver address", func() {
+				data.Receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
+			}, false,
+		},
+		{
+			"failure: no token ids", func() {
+				data.TokenIds = nil
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			data = types.NewNonFungibleTokenPacketData(
+				classID, classURI, "", []string{tokenID}, []string{tokenURI}, nil,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+			)
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(
+				data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+			)
+
+			err := suite.chainB.GetSimApp().NFTTransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRefundPacketToken tests that the sender is refunded on an error acknowledgement or a
+// timeout, both for tokens escrowed and vouchers burned by the transfer.
+func (suite *KeeperTestSuite) TestRefundPacketToken() {
+	testCases := []struct {
+		msg     string
+		timeout bool
+	}{
+		{"error acknowledgement", false},
+		{"timeout", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			senderA := suite.chainA.SenderAccount.GetAddress()
+			receiverB := suite.chainB.SenderAccount.GetAddress()
+			suite.mintNFT(suite.chainA, tokenID, senderA)
+
+			refund := func(chain *ibctesting.TestChain, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+				nftTransferKeeper := chain.GetSimApp().NFTTransferKeeper
+				if tc.timeout {
+					return nftTransferKeeper.OnTimeoutPacket(chain.GetContext(), packet, data)
+				}
+				ack := channeltypes.NewErrorAcknowledgement("failed")
+				return nftTransferKeeper.OnAcknowledgementPacket(chain.GetContext(), packet, data, ack)
+			}
+
+			// escrowed token is refunded
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, []string{tokenID},
+				senderA.String(), receiverB.String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var data types.NonFungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+			suite.Require().NoError(refund(suite.chainA, packet, data))
+			suite.Require().Equal(senderA, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+
+			// burned voucher is refunded
+			voucherClassID := types.ParseClassTrace(types.GetPrefixedClassID(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, classID)).IBCClassID()
+			nftKeeperB := suite.chainB.GetSimApp().NFTKeeper
+			suite.Require().NoError(nftKeeperB.SaveClass(suite.chainB.GetContext(), voucherClassID, classURI, ""))
+			suite.Require().NoError(nftKeeperB.Mint(suite.chainB.GetContext(), voucherClassID, tokenID, tokenURI, "", receiverB))
+
+			data = types.NewNonFungibleTokenPacketData(
+				types.GetPrefixedClassID(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, classID), classURI, "",
+				[]string{tokenID}, []string{tokenURI}, nil, receiverB.String(), senderA.String(), "",
+			)
+			packet = channeltypes.NewPacket(
+				data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0,
+			)
+			suite.Require().NoError(nftKeeperB.Burn(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+			suite.Require().NoError(refund(suite.chainB, packet, data))
+			suite.Require().Equal(receiverB, nftKeeperB.GetOwner(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+			nft, found := nftKeeperB.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+			suite.Require().True(found)
+			suite.Require().Equal(tokenURI, nft.GetUri())
+		})
+	}
+}
+
+// TestOnAcknowledgementPacketSuccess tests that a successful acknowledgement leaves the
+// escrowed token untouched.
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSuccess() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.mintNFT(suite.chainA, tokenID, escrowAddress)
+
+	data := types.NewNonFungibleTokenPacketData(
+		classID, classURI, "", []string{tokenID}, nil, nil, senderA.String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+	)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0,
+	)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := suite.chainA.GetSimApp().NFTTransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.AccAddress(escrowAddress), suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC nft-transfer sentinel errors
+var (
+	ErrInvalidClassIDForTransfer = sdkerrors.Register(ModuleName, 2, "invalid class id for cross-chain transfer")
+	ErrInvalidVersion            = sdkerrors.Register(ModuleName, 3, "invalid ICS721 version")
+	ErrInvalidTokenID            = sdkerrors.Register(ModuleName, 4, "invalid token id")
+	ErrTraceNotFound             = sdkerrors.Register(ModuleName, 5, "class trace not found")
+	ErrMaxTransferChannels       = sdkerrors.Register(ModuleName, 6, "max nft-transfer channels")
+	ErrInactiveClient            = sdkerrors.Register(ModuleName, 7, "client of the destination chain is not active")
+	ErrInvalidPacketData         = sdkerrors.Register(ModuleName, 8, "invalid non-fungible token packet data")
+	ErrClassNotFound             = sdkerrors.Register(ModuleName, 9, "class not found")
+	ErrTokenNotFound             = sdkerrors.Register(ModuleName, 10, "token not found")
+	ErrUnauthorizedOwner         = sdkerrors.Register(ModuleName, 11, "token not owned by the expected address")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Class defines the expected interface of a non-fungible token class
+type Class interface {
+	GetId() string
+	GetUri() string
+	GetData() string
+}
+
+// NFT defines the expected interface of a non-fungible token
+type NFT interface {
+	GetClassId() string
+	GetId() string
+	GetUri() string
+	GetData() string
+}
+
+// NFTKeeper defines the expected keeper of the non-fungible token module of the chain. The
+// data of classes and tokens is relayed as an opaque string.
+type NFTKeeper interface {
+	// SaveClass creates the class with the given identifier if it does not exist,
+	// or updates its uri and data otherwise.
+	SaveClass(ctx sdk.Context, classID, classURI, classData string) error
+	GetClass(ctx sdk.Context, classID string) (Class, bool)
+	HasClass(ctx sdk.Context, classID string) bool
+
+	Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error
+	Transfer(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID, tokenID string) error
+
+	GetNFT(ctx sdk.Context, classID, tokenID string) (NFT, bool)
+	GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress
+}
+
+// ICS4Wrapper defines the expected ICS4Wrapper for middleware
+type ICS4Wrapper interface {
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetChannelClientStatus(ctx sdk.Context, portID, channelID string) (string, ibcexported.Status, error)
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+var (
+	sender        = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	timeoutHeight = clienttypes.NewHeight(0, 10)
+)
+
+// TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+func TestMsgTransferValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgTransfer
+		expPass bool
+	}{
+		{"valid msg", NewMsgTransfer("nft-transfer", "channel-0", "kitties", []string{"kitty-1"}, sender, addr2, timeoutHeight, 0, ""), true},
+		{"valid msg with voucher class id", NewMsgTransfer("nft-transfer", "channel-0", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", []string{"kitty-1", "kitty-2"}, sender, addr2, timeoutHeight, 0, "memo"), true},
+		{"too short port id", NewMsgTransfer("p", "channel-0", "kitties", []string{"kitty-1"}, sender, addr2, timeoutHeight, 0, ""), false},
+		{"invalid channel id", NewMsgTransfer("nft-transfer", "(channel-0)", "kitties", []string{"kitty-1"}, sender, addr2, timeoutHeight, 0, ""), false},
+		{"empty class id", NewMsgTransfer("nft-transfer", "channel-0", "", []string{"kitty-1"}, sender, addr2, timeoutHeight, 0, ""), false},
+		{"invalid voucher class id", NewMsgTransfer("nft-transfer", "channel-0", "ibc/abc", []string{"kitty-1"}, sender, addr2, timeoutHeight, 0, ""), false},
+		{"no token ids", NewMsgTransfer("nft-transfer", "channel-0", "kitties", nil, sender, addr2, timeoutHeight, 0, ""), false},
+		{"duplicated token ids", NewMsgTransfer("nft-transfer", "channel-0", "kitties", []string{"kitty-1", "kitty-1"}, sender, addr2, timeoutHeight, 0, ""), false},
+		{"invalid sender", NewMsgTransfer("nft-transfer", "channel-0", "kitties", []string{"kitty-1"}, "sender", addr2, timeoutHeight, 0, ""), false},
+		{"missing recipient address", NewMsgTransfer("nft-transfer", "channel-0", "kitties", []string{"kitty-1"}, sender, "", timeoutHeight, 0, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferGetSigners tests GetSigners for MsgTransfer
+func TestMsgTransferGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgTransfer("nft-transfer", "channel-0", "kitties", []string{"kitty-1"}, addr.String(), addr2, timeoutHeight, 0, "")
+	res := msg.GetSigners()
+
+	require.Equal(t, []sdk.AccAddress{addr}, res)
+}
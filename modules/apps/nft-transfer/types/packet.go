@@ -0,0 +1,102 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+// NewNonFungibleTokenPacketData contructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if err := ValidatePrefixedClassID(nftpd.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateTokenIDs(nftpd.TokenIds); err != nil {
+		return err
+	}
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "expected %d token uris, got %d", len(nftpd.TokenIds), len(nftpd.TokenUris))
+	}
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "expected %d token data, got %d", len(nftpd.TokenIds), len(nftpd.TokenData))
+	}
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetTokenURI returns the URI of the token at the given index of the packet, or an empty
+// string if the packet carries no token URIs.
+func (nftpd NonFungibleTokenPacketData) GetTokenURI(i int) string {
+	if i >= len(nftpd.TokenUris) {
+		return ""
+	}
+	return nftpd.TokenUris[i]
+}
+
+// GetTokenDatum returns the data of the token at the given index of the packet, or an empty
+// string if the packet carries no token data.
+func (nftpd NonFungibleTokenPacketData) GetTokenDatum(i int) string {
+	if i >= len(nftpd.TokenData) {
+		return ""
+	}
+	return nftpd.TokenData[i]
+}
+
+// GetBytes is a helper for serialising. The packet data is encoded using the camel case
+// field names defined by the ICS721 specification.
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	marshaler := jsonpb.Marshaler{}
+	bz, err := marshaler.MarshalToString(&nftpd)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON([]byte(bz))
+}
+
+// ValidateTokenIDs checks that at least one token is transferred and that the token
+// identifiers are neither blank nor duplicated.
+func ValidateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, tokenID := range tokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if seen[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "duplicated token id %s", tokenID)
+		}
+		seen[tokenID] = true
+	}
+	return nil
+}
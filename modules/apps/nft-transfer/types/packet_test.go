@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	addr1 = "cosmos1w3jhxarpv3j8yvg4ufs4x"
+	addr2 = "cosmos1w3jhxarpv3j8yvs7f9y7g"
+)
+
+// TestNonFungibleTokenPacketDataValidateBasic tests ValidateBasic for NonFungibleTokenPacketData
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData NonFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewNonFungibleTokenPacketData("kitties", "uri", "", []string{"kitty-1"}, []string{"uri-1"}, []string{"data-1"}, addr1, addr2, ""), true},
+		{"valid packet without token uris and data", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1", "kitty-2"}, nil, nil, addr1, addr2, "memo"), true},
+		{"valid packet with prefixed class id", NewNonFungibleTokenPacketData("nft-transfer/channel-1/kitties", "", "", []string{"kitty-1"}, nil, nil, addr1, addr2, ""), true},
+		{"invalid class id", NewNonFungibleTokenPacketData(" ", "", "", []string{"kitty-1"}, nil, nil, addr1, addr2, ""), false},
+		{"no token ids", NewNonFungibleTokenPacketData("kitties", "", "", nil, nil, nil, addr1, addr2, ""), false},
+		{"blank token id", NewNonFungibleTokenPacketData("kitties", "", "", []string{" "}, nil, nil, addr1, addr2, ""), false},
+		{"duplicated token id", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1", "kitty-1"}, nil, nil, addr1, addr2, ""), false},
+		{"token uris length mismatch", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1", "kitty-2"}, []string{"uri-1"}, nil, addr1, addr2, ""), false},
+		{"token data length mismatch", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1"}, nil, []string{"data-1", "data-2"}, addr1, addr2, ""), false},
+		{"missing sender address", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1"}, nil, nil, "", addr2, ""), false},
+		{"missing recipient address", NewNonFungibleTokenPacketData("kitties", "", "", []string{"kitty-1"}, nil, nil, addr1, "", ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestNonFungibleTokenPacketDataGetBytes tests that the packet data is encoded with the camel
+// case field names of the ICS721 specification and decoded back by the module codec.
+func TestNonFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewNonFungibleTokenPacketData("kitties", "uri", "", []string{"kitty-1"}, []string{"uri-1"}, nil, addr1, addr2, "memo")
+
+	bz := packetData.GetBytes()
+	require.Equal(
+		t,
+		`{"classId":"kitties","classUri":"uri","memo":"memo","receiver":"`+addr2+`","sender":"`+addr1+`","tokenIds":["kitty-1"],"tokenUris":["uri-1"]}`,
+		string(bz),
+	)
+
+	var decoded NonFungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, packetData, decoded)
+}
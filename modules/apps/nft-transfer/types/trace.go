@@ -0,0 +1,223 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the base class identifier
+// into a ClassTrace type. Leading pairs of port and channel identifiers make up the trace, the
+// remainder is the base class identifier, which may itself contain slashes.
+//
+// Examples:
+//
+//   - "portidone/channel-0/kitties" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "kitties"}
+//   - "portidone/channel-0/art/kitties" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "art/kitties"}
+//   - "kitties" => ClassTrace{Path: "", BaseClassId: "kitties"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	classSplit := strings.Split(rawClassID, "/")
+
+	i := 0
+	for ; i+2 < len(classSplit); i += 2 {
+		if host.PortIdentifierValidator(classSplit[i]) != nil || !channeltypes.IsValidChannelID(classSplit[i+1]) {
+			break
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(classSplit[:i], "/"),
+		BaseClassId: strings.Join(classSplit[i:], "/"),
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID returns the class identifier of an ICS721 voucher class in the format
+// 'ibc/{hash(tracePath + baseClassId)}'. If the trace is empty, it will return the base
+// class identifier.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class identifier according to the ICS721 specification:
+// tracePath + "/" + baseClassId
+// If there exists no trace then the base class identifier is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+func validateTraceIdentifiers(identifiers []string) error {
+	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+
+	// validate correctness of port and channel identifiers
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	// empty trace is accepted when the class lives on the original chain
+	switch {
+	case ct.Path == "" && strings.TrimSpace(ct.BaseClassId) != "":
+		return nil
+	case strings.TrimSpace(ct.BaseClassId) == "":
+		return fmt.Errorf("base class id cannot be blank")
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", trace.Hash())
+		}
+
+		if err := trace.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullClassPath() < t[j].GetFullClassPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of class traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// ValidatePrefixedClassID checks that the class identifier of an IBC non-fungible token packet
+// is not blank. The trace is not validated as the base class identifier may contain slashes.
+func ValidatePrefixedClassID(classID string) error {
+	if strings.TrimSpace(classID) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassIDForTransfer, "class id cannot be blank")
+	}
+
+	trace := ParseClassTrace(classID)
+	if strings.TrimSpace(trace.BaseClassId) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassIDForTransfer, "base class id cannot be blank")
+	}
+	return nil
+}
+
+// ValidateIBCClassID validates that the given class identifier is either:
+//
+//   - A valid base class identifier (eg: 'kitties')
+//   - A valid voucher class identifier (i.e 'ibc/{hash}')
+func ValidateIBCClassID(classID string) error {
+	if strings.TrimSpace(classID) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassIDForTransfer, "class id cannot be blank")
+	}
+
+	classSplit := strings.SplitN(classID, "/", 2)
+
+	switch {
+	case classID == ClassPrefix:
+		return sdkerrors.Wrapf(ErrInvalidClassIDForTransfer, "class id should be prefixed with the format 'ibc/{hash(trace + \"/\" + %s)}'", classID)
+
+	case len(classSplit) == 2 && classSplit[0] == ClassPrefix:
+		if strings.TrimSpace(classSplit[1]) == "" {
+			return sdkerrors.Wrapf(ErrInvalidClassIDForTransfer, "class id should be prefixed with the format 'ibc/{hash(trace + \"/\" + %s)}'", classID)
+		}
+
+		if _, err := ParseHexHash(classSplit[1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid class trace hash %s", classSplit[1])
+		}
+	}
+
+	return nil
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmtypes.ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// SenderChainIsSource returns false if the class originally came
+// from the receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came
+// from the receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// If the receiver chain originally sent the class to the sender chain
+	// the class id will have the sender's SourcePort and SourceChannel as
+	// the prefix.
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// GetClassPrefix returns the receiving class prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// GetPrefixedClassID returns the class identifier with the portID and channelID prefixed
+func GetPrefixedClassID(portID, channelID, baseClassID string) string {
+	return fmt.Sprintf("%s/%s/%s", portID, channelID, baseClassID)
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expTrace ClassTrace
+	}{
+		{"empty class id", "", ClassTrace{}},
+		{"base class id", "kitties", ClassTrace{BaseClassId: "kitties"}},
+		{"base class id with slashes", "art/kitties", ClassTrace{BaseClassId: "art/kitties"}},
+		{"trace info", "nft-transfer/channel-1/kitties", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/channel-1"}},
+		{"trace info with base class id with slashes", "nft-transfer/channel-1/art/kitties", ClassTrace{BaseClassId: "art/kitties", Path: "nft-transfer/channel-1"}},
+		{"multiple hops", "nft-transfer/channel-1/nft-transfer/channel-2/kitties", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/channel-1/nft-transfer/channel-2"}},
+		{"incomplete path", "nft-transfer/kitties", ClassTrace{BaseClassId: "nft-transfer/kitties"}},
+		{"invalid channel identifier", "nft-transfer/channel/kitties", ClassTrace{BaseClassId: "nft-transfer/channel/kitties"}},
+		{"path without base class id", "nft-transfer/channel-1", ClassTrace{BaseClassId: "nft-transfer/channel-1"}},
+	}
+
+	for _, tc := range testCases {
+		trace := ParseClassTrace(tc.classID)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+		require.Equal(t, tc.classID, trace.GetFullClassPath(), tc.name)
+	}
+}
+
+func TestClassTrace_IBCClassID(t *testing.T) {
+	testCases := []struct {
+		name       string
+		trace      ClassTrace
+		expClassID string
+	}{
+		{"base class id", ClassTrace{BaseClassId: "kitties"}, "kitties"},
+		{"trace info", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/channel-1"}, "ibc/2F38F5CD4A9DCF1A2D2F3D18A8DF1E0A3E1D0B6F6AE5F1C5FF4A31E6C7A3A6B4"},
+	}
+
+	for _, tc := range testCases {
+		classID := tc.trace.IBCClassID()
+		if tc.trace.Path == "" {
+			require.Equal(t, tc.expClassID, classID, tc.name)
+			continue
+		}
+		require.Equal(t, "ibc/"+tc.trace.Hash().String(), classID, tc.name)
+		require.NoError(t, ValidateIBCClassID(classID), tc.name)
+	}
+}
+
+func TestClassTrace_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trace    ClassTrace
+		expError bool
+	}{
+		{"base class id only", ClassTrace{BaseClassId: "kitties"}, false},
+		{"empty ClassTrace", ClassTrace{}, true},
+		{"valid single trace info", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/channel-1"}, false},
+		{"valid multiple trace info", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/channel-1/nft-transfer/channel-2"}, false},
+		{"single trace identifier", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer"}, true},
+		{"invalid port ID", ClassTrace{BaseClassId: "kitties", Path: "(nft-transfer)/channel-1"}, true},
+		{"invalid channel ID", ClassTrace{BaseClassId: "kitties", Path: "nft-transfer/(channel-1)"}, true},
+		{"empty base class id with trace", ClassTrace{BaseClassId: "", Path: "nft-transfer/channel-1"}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.trace.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestTraces_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		traces   Traces
+		expError bool
+	}{
+		{"empty Traces", Traces{}, false},
+		{"valid multiple trace info", Traces{{BaseClassId: "kitties", Path: "nft-transfer/channel-1/nft-transfer/channel-2"}}, false},
+		{
+			"valid multiple trace info",
+			Traces{
+				{BaseClassId: "kitties", Path: "nft-transfer/channel-1/nft-transfer/channel-2"},
+				{BaseClassId: "kitties", Path: "nft-transfer/channel-1/nft-transfer/channel-2"},
+			},
+			true,
+		},
+		{"empty ClassTrace", Traces{{}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.traces.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestValidateIBCClassID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expError bool
+	}{
+		{"base class id", "kitties", false},
+		{"base class id with slashes", "art/kitties", false},
+		{"valid voucher class id", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", false},
+		{"empty class id", "", true},
+		{"class prefix only", "ibc", true},
+		{"blank hash", "ibc/ ", true},
+		{"invalid hash", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A", true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateIBCClassID(tc.classID)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestReceiverChainIsSource(t *testing.T) {
+	require.True(t, ReceiverChainIsSource("nft-transfer", "channel-0", "nft-transfer/channel-0/kitties"))
+	require.False(t, ReceiverChainIsSource("nft-transfer", "channel-0", "nft-transfer/channel-01/kitties"))
+	require.False(t, ReceiverChainIsSource("nft-transfer", "channel-0", "kitties"))
+	require.True(t, SenderChainIsSource("nft-transfer", "channel-0", "kitties"))
+}
@@ -0,0 +1,300 @@
+package packetforward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// IBCModule implements the ICS26 interface for the packet forward middleware given the packet
+// forward keeper and the underlying ICS-20 transfer application.
+type IBCModule struct {
+	keeper keeper.Keeper
+	app    porttypes.IBCModule
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper and underlying application
+func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
+	return IBCModule{
+		keeper: k,
+		app:    app,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets whose receiver does not carry
+// routing information are passed to the underlying application. Otherwise the tokens are
+// credited to the forward address by the underlying application and forwarded over the next
+// channel. The acknowledgement of a forwarded packet is written asynchronously once the
+// forwarded packet is acknowledged or times out.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	version := im.keeper.GetChannelVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	data, err := im.keeper.DecodePacketData(version, packet.GetData())
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, forward, err := types.ParseForwardReceiver(data.Receiver)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	if !forward {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// credit the tokens to the forward address, the original packet is kept to write its
+	// acknowledgement
+	data.Receiver = types.GetForwardAddress().String()
+	forwardPacket := packet
+	forwardPacket.Data = transfertypes.EncodePacketData(version, data)
+
+	ack := im.app.OnRecvPacket(ctx, forwardPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardTransfer(ctx, packet, data, metadata); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// NOTE: acknowledgement will be written asynchronously once the forwarded packet completes.
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Once the underlying application
+// has processed the acknowledgement, the acknowledgement of the received packet whose tokens
+// were forwarded is written.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	return im.keeper.OnForwardComplete(ctx, packet, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Once the underlying application has
+// refunded the timed out packet, an error acknowledgement is written for the received packet
+// whose tokens were forwarded.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardComplete(ctx, packet, false)
+}
+
+// OnClientFrozen implements the IBCModule interface
+func (im IBCModule) OnClientFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	im.app.OnClientFrozen(ctx, portID, channelID)
+}
+
+// IsDeadLetterModule implements the DeadLetterModule interface. The middleware opts in to the
+// dead-letter store only if the underlying application does.
+func (im IBCModule) IsDeadLetterModule() bool {
+	_, ok := porttypes.GetDeadLetterModule(im.app)
+	return ok
+}
+
+// OnReclaimPacket implements the DeadLetterModule interface. The packet is reclaimed by the
+// underlying application if it opts in to the dead-letter store.
+func (im IBCModule) OnReclaimPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	reason string,
+	signer sdk.AccAddress,
+) error {
+	deadLetterModule, ok := porttypes.GetDeadLetterModule(im.app)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support reclaiming packets")
+	}
+
+	if err := deadLetterModule.OnReclaimPacket(ctx, packet, reason, signer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardComplete(ctx, packet, false)
+}
+
+// OnCompensatePacket implements the CompensatingModule interface. The compensation is executed
+// by the underlying application.
+func (im IBCModule) OnCompensatePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	compensation []byte,
+	relayer sdk.AccAddress,
+) error {
+	compensatingModule, ok := im.app.(porttypes.CompensatingModule)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support packet compensations")
+	}
+
+	return compensatingModule.OnCompensatePacket(ctx, packet, compensation, relayer)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	upgradableModule, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "underlying application does not support channel upgrades")
+	}
+
+	return upgradableModule.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im IBCModule) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+	if upgradableModule, ok := im.app.(porttypes.UpgradableModule); ok {
+		upgradableModule.OnChanUpgradeOpen(ctx, portID, channelID, order, connectionHops, version)
+	}
+}
+
+// StackLayerName implements the StackLayer interface
+func (im IBCModule) StackLayerName() string {
+	return types.ModuleName
+}
+
+// UnderlyingApplication implements the ApplicationWrapper interface
+func (im IBCModule) UnderlyingApplication() porttypes.IBCModule {
+	return im.app
+}
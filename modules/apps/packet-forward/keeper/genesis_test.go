@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	packetForwardKeeper := suite.chainA.GetSimApp().PacketForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	inFlightPackets := []types.InFlightPacket{
+		{ForwardPortId: transfertypes.PortID, ForwardChannelId: "channel-2", ForwardSequence: 1, OriginalPacket: packet, Token: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), Minted: false},
+		{ForwardPortId: transfertypes.PortID, ForwardChannelId: "channel-2", ForwardSequence: 2, OriginalPacket: packet, Token: sdk.NewCoin("ibc/voucher", sdk.NewInt(100)), Minted: true},
+	}
+	for _, inFlightPacket := range inFlightPackets {
+		suite.Require().NoError(inFlightPacket.Validate())
+		packetForwardKeeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+
+	genesis := packetForwardKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(inFlightPackets, genesis.InFlightPackets)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.Require().NotPanics(func() {
+		suite.chainB.GetSimApp().PacketForwardKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
+	})
+	suite.Require().Equal(genesis, suite.chainB.GetSimApp().PacketForwardKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the IBC packet forward keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	transferKeeper   types.TransferKeeper
+	channelKeeper    types.ChannelKeeper
+	bankKeeper       types.BankKeeper
+	packetDataCodecs *porttypes.PacketDataCodecs
+}
+
+// NewKeeper creates a new IBC packet forward Keeper instance. The transfer keeper is used to
+// forward the received tokens over the next channel. The packet data of each channel is decoded
+// with the packet data codec registered for the version of the channel.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey,
+	transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+	packetDataCodecs *porttypes.PacketDataCodecs,
+) Keeper {
+	if packetDataCodecs == nil {
+		panic("packet data codecs cannot be nil")
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		transferKeeper:   transferKeeper,
+		channelKeeper:    channelKeeper,
+		bankKeeper:       bankKeeper,
+		packetDataCodecs: packetDataCodecs,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetChannelVersion returns the version negotiated for the provided transfer channel, which
+// selects the encoding of the packet data sent over the channel.
+func (k Keeper) GetChannelVersion(ctx sdk.Context, portID, channelID string) string {
+	return k.transferKeeper.GetChannelVersion(ctx, portID, channelID)
+}
+
+// DecodePacketData decodes the ICS-20 packet data sent over a channel of the given version with
+// the packet data codec registered for the version.
+func (k Keeper) DecodePacketData(version string, bz []byte) (transfertypes.FungibleTokenPacketData, error) {
+	data, err := k.packetDataCodecs.DecodePacketData(version, bz)
+	if err != nil {
+		return transfertypes.FungibleTokenPacketData{}, err
+	}
+
+	return transfertypes.NewFungibleTokenPacketData(data.Denom, data.Amount, data.Sender, data.Receiver, data.Memo), nil
+}
+
+// GetInFlightPacket returns the in-flight packet of the forwarded packet with the given port,
+// channel and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketPath(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+	return inFlightPacket, true
+}
+
+// SetInFlightPacket stores the in-flight packet under the identifiers of its forwarded packet.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&inFlightPacket)
+	store.Set(types.InFlightPacketPath(inFlightPacket.ForwardPortId, inFlightPacket.ForwardChannelId, inFlightPacket.ForwardSequence), bz)
+}
+
+// deleteInFlightPacket removes the in-flight packet of the forwarded packet with the given
+// port, channel and sequence.
+func (k Keeper) deleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InFlightPacketPath(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns all the received packets awaiting the acknowledgement of their
+// forwarded packet.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	inFlightPackets := []types.InFlightPacket{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InFlightPacketKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlightPacket types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlightPacket)
+		inFlightPackets = append(inFlightPackets, inFlightPacket)
+	}
+
+	return inFlightPackets
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ForwardTransfer forwards the tokens of a received ICS-20 packet, which have been credited to
+// the forward address, over the channel given by the routing information. The forwarded packet
+// uses the default timeouts of the transfer module and carries the memo of the received packet
+// to the next chain. The received packet is recorded as in-flight
+// until the forwarded packet is acknowledged or times out.
+//
+// Forwarding requires the forward address to be exempt from transfer fees, otherwise the tokens
+// could not be returned in full to the escrow or burned if the forwarded packet fails.
+func (k Keeper) ForwardTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+) error {
+	forwardAddress := types.GetForwardAddress()
+	if !k.isFeeExempt(ctx, forwardAddress) {
+		return sdkerrors.Wrapf(types.ErrForwardFeeNotExempt, "forward address %s must be added to the transfer fee exempt addresses", forwardAddress)
+	}
+
+	token, minted, err := receivedToken(packet, data)
+	if err != nil {
+		return err
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", metadata.Port, metadata.Channel,
+		)
+	}
+
+	if err := k.transferKeeper.SendTransfer(
+		ctx, metadata.Port, metadata.Channel, token, forwardAddress, metadata.Receiver, clienttypes.ZeroHeight(), 0, data.Memo,
+	); err != nil {
+		return err
+	}
+
+	k.SetInFlightPacket(ctx, types.InFlightPacket{
+		ForwardPortId:    metadata.Port,
+		ForwardChannelId: metadata.Channel,
+		ForwardSequence:  sequence,
+		OriginalPacket:   packet,
+		Token:            token,
+		Minted:           minted,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// OnForwardComplete writes the acknowledgement of the received packet whose tokens were
+// forwarded in the given packet, once the forwarded packet is acknowledged or times out. If
+// the forwarded packet failed, the refund credited to the forward address by the transfer
+// module is returned to the escrow account or burned, reverting the receipt of the tokens, and
+// an error acknowledgement is written so that the sender is refunded on the source chain. No
+// action is taken if the packet was not forwarded by the middleware.
+func (k Keeper) OnForwardComplete(ctx sdk.Context, packet channeltypes.Packet, success bool) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.deleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	originalPacket := inFlightPacket.OriginalPacket
+
+	var ack channeltypes.Acknowledgement
+	if success {
+		version := k.transferKeeper.GetChannelVersion(ctx, originalPacket.GetDestPort(), originalPacket.GetDestChannel())
+		ack = transfertypes.NewResultAcknowledgement(version, inFlightPacket.Token.Denom)
+	} else {
+		if err := k.revertReceive(ctx, inFlightPacket); err != nil {
+			return err
+		}
+
+		ack = transfertypes.NewErrorAcknowledgement(types.ErrForwardFailed)
+	}
+
+	if err := k.writeAcknowledgement(ctx, originalPacket, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardComplete,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", success)),
+		),
+	)
+
+	return nil
+}
+
+// revertReceive reverts the receipt of the tokens of an in-flight packet, whose refund has been
+// credited to the forward address. Vouchers are burned and unescrowed tokens are returned to
+// the escrow account of the channel the packet was received on through the transfer keeper,
+// which tracks them in the total escrow of their denomination.
+func (k Keeper) revertReceive(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	forwardAddress := types.GetForwardAddress()
+	coins := sdk.NewCoins(inFlightPacket.Token)
+
+	if inFlightPacket.Minted {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins)
+	}
+
+	escrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.OriginalPacket.GetDestPort(), inFlightPacket.OriginalPacket.GetDestChannel())
+	return k.transferKeeper.EscrowToken(ctx, forwardAddress, escrowAddress, inFlightPacket.Token)
+}
+
+// writeAcknowledgement writes the acknowledgement of a received packet asynchronously using the
+// channel capability of the transfer module.
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// isFeeExempt returns true if the transfer module retains no fee from the given address.
+func (k Keeper) isFeeExempt(ctx sdk.Context, address sdk.AccAddress) bool {
+	if k.transferKeeper.GetFeeBasisPoints(ctx) == 0 {
+		return true
+	}
+
+	for _, exempt := range k.transferKeeper.GetFeeExemptAddresses(ctx) {
+		if exempt == address.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// receivedToken returns the token credited on this chain for the received ICS-20 packet, as
+// computed by the transfer module, and whether the token is a voucher minted on receive.
+func receivedToken(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, bool, error) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, false, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were unescrowed, remove the prefix added by the sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+
+		denom := unprefixedDenom
+		if denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom); denomTrace.Path != "" {
+			denom = denomTrace.IBCDenom()
+		}
+
+		return sdk.NewCoin(denom, amount), false, nil
+	}
+
+	// vouchers were minted with the denomination prefixed by the receiving channel
+	sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	denomTrace := transfertypes.ParseDenomTrace(sourcePrefix + data.Denom)
+
+	return sdk.NewCoin(denomTrace.IBCDenom(), amount), true, nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// sendForwardTransfer transfers the coin from chainA to chainB with a receiver routing the tokens
+// over the channel of pathBC on chainB. The packet is received on chainB and both the original
+// packet and the packet forwarded by chainB are returned.
+func (suite *KeeperTestSuite) sendForwardTransfer(pathAB, pathBC *ibctesting.Path, coin sdk.Coin, finalReceiver string) (channeltypes.Packet, channeltypes.Packet) {
+	receiver := fmt.Sprintf("%s/%s:%s", pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID, finalReceiver)
+	msg := transfertypes.NewMsgTransfer(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathAB.EndpointB.UpdateClient())
+	res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet, forwardPacket
+}
+
+// acknowledgeOriginalPacket verifies that chainB wrote the expected acknowledgement for the
+// original packet and relays it to chainA.
+func (suite *KeeperTestSuite) acknowledgeOriginalPacket(pathAB *ibctesting.Path, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	commitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), commitment)
+
+	suite.Require().NoError(pathAB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+}
+
+func (suite *KeeperTestSuite) TestForwardTransfer() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	pathBC := NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathAB)
+	suite.coordinator.Setup(pathBC)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	receiver := suite.chainC.SenderAccount.GetAddress()
+	packet, forwardPacket := suite.sendForwardTransfer(pathAB, pathBC, coin, receiver.String())
+
+	// the acknowledgement is pending on the forwarded packet
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, inFlightPacket.OriginalPacket)
+	suite.Require().Equal(sdk.NewCoin(voucherDenom, coin.Amount), inFlightPacket.Token)
+	suite.Require().True(inFlightPacket.Minted)
+
+	suite.Require().NoError(pathBC.RelayPacket(forwardPacket))
+
+	// the tokens are credited to the final receiver
+	fullDenomPath := transfertypes.GetPrefixedDenom(pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID, transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	balance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, transfertypes.ParseDenomTrace(fullDenomPath).IBCDenom())
+	suite.Require().Equal(coin.Amount, balance.Amount)
+
+	_, found = suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().False(found)
+
+	suite.acknowledgeOriginalPacket(pathAB, packet, transfertypes.NewResultAcknowledgement(transfertypes.Version, voucherDenom))
+}
+
+func (suite *KeeperTestSuite) TestForwardTransferErrorAcknowledgement() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	pathBC := NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathAB)
+	suite.coordinator.Setup(pathBC)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the transfer fails on chainC with an invalid receiver
+	packet, forwardPacket := suite.sendForwardTransfer(pathAB, pathBC, coin, "invalid")
+	suite.Require().NoError(pathBC.RelayPacket(forwardPacket))
+
+	// the vouchers minted on chainB are burned
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), types.GetForwardAddress()).IsZero())
+
+	suite.acknowledgeOriginalPacket(pathAB, packet, transfertypes.NewErrorAcknowledgement(types.ErrForwardFailed))
+
+	// the sender is refunded on chainA
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+}
+
+func (suite *KeeperTestSuite) TestForwardTransferTimeout() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	pathBC := NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathAB)
+	suite.coordinator.Setup(pathBC)
+
+	// transfer native tokens of chainB to chainA, they are unescrowed when sent back
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathAB.RelayPacket(packet))
+
+	// forwarded packets time out one block after the latest height of the client of chainC
+	params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+	params.DefaultTimeoutHeightOffset = 1
+	params.DefaultTimeoutTimestampDuration = 0
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, coin.Amount)
+	packet, forwardPacket := suite.sendForwardTransfer(pathAB, pathBC, voucher, suite.chainC.SenderAccount.GetAddress().String())
+
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(coin, inFlightPacket.Token)
+	suite.Require().False(inFlightPacket.Minted)
+
+	suite.coordinator.CommitNBlocks(suite.chainC, 2)
+	suite.Require().NoError(pathBC.EndpointA.UpdateClient())
+	suite.Require().NoError(pathBC.EndpointA.TimeoutPacket(forwardPacket))
+
+	// the unescrowed tokens are returned to the escrow account
+	escrowAddress := transfertypes.GetEscrowAddress(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID)
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coin, balance)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), types.GetForwardAddress()).IsZero())
+
+	// the returned tokens are tracked in the total escrow
+	suite.Require().Equal(coin, suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom))
+
+	suite.acknowledgeOriginalPacket(pathAB, packet, transfertypes.NewErrorAcknowledgement(types.ErrForwardFailed))
+
+	// the vouchers are refunded on chainA
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(voucher, balance)
+}
+
+func (suite *KeeperTestSuite) TestForwardTransferFeeNotExempt() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	pathBC := NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathAB)
+	suite.coordinator.Setup(pathBC)
+
+	params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+	params.FeeBasisPoints = 100
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	receiver := fmt.Sprintf("%s/%s:%s", pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID, suite.chainC.SenderAccount.GetAddress())
+	msg := transfertypes.NewMsgTransfer(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathAB.EndpointB.UpdateClient())
+	res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the packet is rejected synchronously without forwarding the tokens
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrForwardFeeNotExempt).Acknowledgement(), ack)
+	suite.Require().Empty(suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+
+	// exempting the forward address allows forwarding
+	params.FeeExemptAddresses = []string{types.GetForwardAddress().String()}
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet, forwardPacket := suite.sendForwardTransfer(pathAB, pathBC, coin, suite.chainC.SenderAccount.GetAddress().String())
+	suite.Require().NoError(pathBC.RelayPacket(forwardPacket))
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.acknowledgeOriginalPacket(pathAB, packet, transfertypes.NewResultAcknowledgement(transfertypes.Version, voucherDenom))
+}
+
+func (suite *KeeperTestSuite) TestForwardTransferInvalidReceiver() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathAB)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), "transfer:receiver", clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathAB.EndpointB.UpdateClient())
+	res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrInvalidForwardReceiver).Acknowledgement(), ack)
+}
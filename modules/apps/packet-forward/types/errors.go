@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Packet forward sentinel errors
+var (
+	ErrInvalidForwardReceiver = sdkerrors.Register(ModuleName, 2, "invalid forward receiver")
+	ErrForwardFeeNotExempt    = sdkerrors.Register(ModuleName, 3, "forward address is not exempt from transfer fees")
+	ErrForwardFailed          = sdkerrors.Register(ModuleName, 4, "forwarded packet failed")
+)
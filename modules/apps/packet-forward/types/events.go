@@ -0,0 +1,15 @@
+package types
+
+// Packet forward events
+const (
+	EventTypeForward         = "forward_packet"
+	EventTypeForwardComplete = "forward_packet_complete"
+
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyAckSuccess      = "success"
+)
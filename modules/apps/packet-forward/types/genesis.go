@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewGenesisState creates a new packet forward GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without in-flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for i, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return fmt.Errorf("invalid in-flight packet %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the in-flight packet.
+func (p InFlightPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return err
+	}
+
+	if p.ForwardSequence == 0 {
+		return fmt.Errorf("forward packet sequence cannot be 0")
+	}
+
+	if err := p.OriginalPacket.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return p.Token.Validate()
+}
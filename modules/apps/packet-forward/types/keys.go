@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the packet forward middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// InFlightPacketKey defines the key prefix to store the received packets whose tokens
+	// have been forwarded and which await the acknowledgement of the forwarded packet
+	InFlightPacketKey = []byte{0x01}
+)
+
+// InFlightPacketPath returns the store key of the in-flight packet for the forwarded packet
+// with the given port, channel and sequence.
+func InFlightPacketPath(portID, channelID string, sequence uint64) []byte {
+	return append(InFlightPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// GetForwardAddress returns the address which receives the tokens of the packets to forward
+// and sends them over the next channel. The address is not a module account so that it is
+// not blocked from receiving ICS-20 transfers.
+func GetForwardAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
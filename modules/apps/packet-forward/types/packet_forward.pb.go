@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packet_forward/v1/packet_forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received ICS-20 packet whose tokens have been forwarded over another
+// channel and whose acknowledgement is pending on the outcome of the forwarded packet.
+type InFlightPacket struct {
+	// identifiers of the forwarded packet
+	ForwardPortId    string `protobuf:"bytes,1,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty" yaml:"forward_port_id"`
+	ForwardChannelId string `protobuf:"bytes,2,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty" yaml:"forward_channel_id"`
+	ForwardSequence  uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty" yaml:"forward_sequence"`
+	// the received packet which is acknowledged once the forwarded packet completes
+	OriginalPacket types.Packet `protobuf:"bytes,4,opt,name=original_packet,json=originalPacket,proto3" json:"original_packet" yaml:"original_packet"`
+	// the token credited on this chain for the received packet
+	Token types1.Coin `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+	// minted is true if the token is a voucher minted on receive, false if it was unescrowed
+	Minted bool `protobuf:"varint,6,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d874023efc9137, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetOriginalPacket() types.Packet {
+	if m != nil {
+		return m.OriginalPacket
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types1.Coin{}
+}
+
+func (m *InFlightPacket) GetMinted() bool {
+	if m != nil {
+		return m.Minted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.packet_forward.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packet_forward/v1/packet_forward.proto", fileDescriptor_48d874023efc9137)
+}
+
+var fileDescriptor_48d874023efc9137 = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0x58, 0x57, 0x41, 0x10, 0x1b, 0x8a, 0xd0, 0xc8, 0x3a, 0xe1, 0x96, 0x9c, 0x7a, 0x99,
+	0xad, 0x30, 0x21, 0x24, 0x24, 0x2e, 0x99, 0x34, 0xa9, 0xe2, 0x32, 0x85, 0x03, 0x12, 0x97, 0xc8,
+	0x71, 0x4c, 0x6a, 0x2d, 0xf1, 0x17, 0x12, 0x37, 0x68, 0xff, 0x82, 0x5f, 0xc0, 0xef, 0xd9, 0x71,
+	0x47, 0x4e, 0x15, 0x6a, 0xff, 0x41, 0x7f, 0x01, 0x72, 0x62, 0x6b, 0x2c, 0x37, 0xe7, 0xe5, 0xf9,
+	0x7d, 0xfe, 0xde, 0x7b, 0xee, 0x07, 0x91, 0x32, 0x42, 0xab, 0xaa, 0x10, 0x8c, 0x2a, 0x01, 0xb2,
+	0x21, 0x15, 0x65, 0x37, 0x5c, 0x25, 0xdf, 0xa1, 0xfe, 0x49, 0xeb, 0x8c, 0xb4, 0xe1, 0x00, 0xc1,
+	0x55, 0x0d, 0x0a, 0xbc, 0x40, 0xa4, 0x0c, 0xff, 0x7f, 0x11, 0x0f, 0x68, 0x6d, 0x38, 0x7d, 0x95,
+	0x43, 0x0e, 0x1d, 0x9d, 0xe8, 0x53, 0x7f, 0x73, 0x8a, 0x18, 0x34, 0x25, 0x34, 0x24, 0xa5, 0x0d,
+	0x27, 0x6d, 0x98, 0x72, 0x45, 0x43, 0xc2, 0x40, 0x48, 0xf3, 0xff, 0xad, 0x7e, 0x12, 0x83, 0x9a,
+	0x13, 0xb6, 0xa2, 0x52, 0xf2, 0x42, 0xbf, 0xc1, 0x1c, 0x7b, 0x4a, 0xf0, 0xfb, 0xc0, 0x3d, 0x5a,
+	0xca, 0xab, 0x42, 0xe4, 0x2b, 0x75, 0xdd, 0x8d, 0xf5, 0x22, 0xf7, 0xd8, 0x4c, 0x4e, 0x2a, 0xa8,
+	0x55, 0x22, 0x32, 0xdf, 0x99, 0x3b, 0x8b, 0x67, 0xd1, 0x74, 0xbf, 0x99, 0x9d, 0xdc, 0xd2, 0xb2,
+	0xf8, 0x18, 0x0c, 0x08, 0x41, 0xfc, 0xc2, 0x20, 0xd7, 0x50, 0xab, 0x65, 0xe6, 0x7d, 0x76, 0x3d,
+	0x4b, 0x31, 0xf3, 0xb4, 0xcc, 0x93, 0x4e, 0xe6, 0xcd, 0x7e, 0x33, 0x3b, 0x7d, 0x2c, 0xf3, 0xc0,
+	0x09, 0xe2, 0x97, 0x06, 0xbc, 0xec, 0xb1, 0x65, 0xe6, 0x5d, 0xb9, 0x16, 0x4b, 0x1a, 0xfe, 0x63,
+	0xcd, 0x25, 0xe3, 0xfe, 0xc1, 0xdc, 0x59, 0x8c, 0xa3, 0xb3, 0xfd, 0x66, 0xf6, 0xfa, 0xb1, 0x94,
+	0x65, 0x04, 0xb1, 0xdd, 0xe2, 0x8b, 0x41, 0xbc, 0xcc, 0x3d, 0x86, 0x5a, 0xe4, 0x42, 0xd2, 0x22,
+	0xe9, 0x2d, 0xf6, 0xc7, 0x73, 0x67, 0xf1, 0xfc, 0xdd, 0x19, 0xd6, 0x11, 0x68, 0xa3, 0xb0, 0x75,
+	0xa7, 0x0d, 0x71, 0x6f, 0x47, 0x84, 0xee, 0x36, 0xb3, 0xd1, 0xc3, 0xe6, 0x03, 0x85, 0x20, 0x3e,
+	0xb2, 0x88, 0xb1, 0xef, 0xbd, 0x7b, 0xa8, 0xe0, 0x86, 0x4b, 0xff, 0xb0, 0xd3, 0x3e, 0xc5, 0x7d,
+	0x48, 0x58, 0x87, 0x84, 0x4d, 0x48, 0xf8, 0x12, 0x84, 0x8c, 0xc6, 0x5a, 0x39, 0xee, 0xd9, 0xde,
+	0x89, 0x3b, 0x29, 0x85, 0x54, 0x3c, 0xf3, 0x27, 0x73, 0x67, 0xf1, 0x34, 0x36, 0x5f, 0xd1, 0xd7,
+	0xbb, 0x2d, 0x72, 0xee, 0xb7, 0xc8, 0xf9, 0xbb, 0x45, 0xce, 0xaf, 0x1d, 0x1a, 0xdd, 0xef, 0xd0,
+	0xe8, 0xcf, 0x0e, 0x8d, 0xbe, 0x7d, 0xca, 0x85, 0x5a, 0xad, 0x53, 0xcc, 0xa0, 0x24, 0xa6, 0x08,
+	0x22, 0x65, 0xe7, 0x39, 0x90, 0xf6, 0x82, 0x94, 0x90, 0xad, 0x0b, 0xde, 0xe8, 0x42, 0xda, 0x22,
+	0x9e, 0xdb, 0x22, 0xaa, 0xdb, 0x8a, 0x37, 0xe9, 0xa4, 0x2b, 0xc0, 0xc5, 0xbf, 0x01, 0x00, 0x9d,
+	0xf9, 0xf8, 0x5f, 0xb8, 0x02, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted {
+		i--
+		if m.Minted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OriginalPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ForwardSequence != 0 {
+		i = encodeVarintPacketForward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintPacketForward(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintPacketForward(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovPacketForward(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketForward(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovPacketForward(uint64(m.ForwardSequence))
+	}
+	l = m.OriginalPacket.Size()
+	n += 1 + l + sovPacketForward(uint64(l))
+	l = m.Token.Size()
+	n += 1 + l + sovPacketForward(uint64(l))
+	if m.Minted {
+		n += 2
+	}
+	return n
+}
+
+func sovPacketForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketForward(x uint64) (n int) {
+	return sovPacketForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginalPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Minted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketForward = fmt.Errorf("proto: unexpected end of group")
+)
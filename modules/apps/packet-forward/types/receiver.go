@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// forwardSeparator separates the forward channel from the receiver on the next chain
+	forwardSeparator = ":"
+)
+
+// ForwardMetadata defines the routing information carried in the receiver of an ICS-20
+// packet whose tokens must be forwarded over another channel.
+type ForwardMetadata struct {
+	Port     string
+	Channel  string
+	Receiver string
+}
+
+// ParseForwardReceiver parses the routing information from the receiver of an ICS-20 packet
+// with the format {port}/{channel}:{receiver}. The receiver on the next chain may itself carry
+// routing information, which allows tokens to be forwarded over several hops. False is returned
+// if the receiver does not contain routing information, an error is returned if the routing
+// information is malformed.
+func ParseForwardReceiver(receiver string) (ForwardMetadata, bool, error) {
+	if !strings.Contains(receiver, forwardSeparator) {
+		return ForwardMetadata{}, false, nil
+	}
+
+	parts := strings.SplitN(receiver, forwardSeparator, 2)
+	identifiers := strings.Split(parts[0], "/")
+	if len(identifiers) != 2 {
+		return ForwardMetadata{}, false, sdkerrors.Wrapf(ErrInvalidForwardReceiver, "expected {port}/{channel}:{receiver}, got %s", receiver)
+	}
+
+	if err := host.PortIdentifierValidator(identifiers[0]); err != nil {
+		return ForwardMetadata{}, false, sdkerrors.Wrapf(ErrInvalidForwardReceiver, "invalid forward port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(identifiers[1]); err != nil {
+		return ForwardMetadata{}, false, sdkerrors.Wrapf(ErrInvalidForwardReceiver, "invalid forward channel: %s", err)
+	}
+
+	if strings.TrimSpace(parts[1]) == "" {
+		return ForwardMetadata{}, false, sdkerrors.Wrap(ErrInvalidForwardReceiver, "receiver on the next chain cannot be blank")
+	}
+
+	return ForwardMetadata{
+		Port:     identifiers[0],
+		Channel:  identifiers[1],
+		Receiver: parts[1],
+	}, true, nil
+}
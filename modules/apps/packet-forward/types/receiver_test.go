@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+const receiver = "cosmos1wdplq6qjh2xruc7qqagma9ya665q6qhcwju3ng"
+
+func TestParseForwardReceiver(t *testing.T) {
+	testCases := []struct {
+		name     string
+		receiver string
+		forward  bool
+		expPass  bool
+		expected types.ForwardMetadata
+	}{
+		{"plain receiver", receiver, false, true, types.ForwardMetadata{}},
+		{"single hop", "transfer/channel-0:" + receiver, true, true, types.ForwardMetadata{Port: "transfer", Channel: "channel-0", Receiver: receiver}},
+		{"multiple hops", "transfer/channel-0:transfer/channel-1:" + receiver, true, true, types.ForwardMetadata{Port: "transfer", Channel: "channel-0", Receiver: "transfer/channel-1:" + receiver}},
+		{"missing channel", "transfer:" + receiver, false, false, types.ForwardMetadata{}},
+		{"invalid port", "t/channel-0:" + receiver, false, false, types.ForwardMetadata{}},
+		{"invalid channel", "transfer/c:" + receiver, false, false, types.ForwardMetadata{}},
+		{"blank receiver", "transfer/channel-0: ", false, false, types.ForwardMetadata{}},
+	}
+
+	for _, tc := range testCases {
+		metadata, forward, err := types.ParseForwardReceiver(tc.receiver)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidForwardReceiver, tc.name)
+		}
+		require.Equal(t, tc.forward, forward, tc.name)
+		require.Equal(t, tc.expected, metadata, tc.name)
+	}
+}
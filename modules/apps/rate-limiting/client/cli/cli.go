@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for IBC rate limiting
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "rate-limiting",
+		Aliases:                    []string{"ratelimit"},
+		Short:                      "IBC rate limiting subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+		GetCmdFlow(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for IBC rate limiting
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "rate-limiting",
+		Aliases:                    []string{"ratelimit"},
+		Short:                      "IBC rate limiting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRemoveBypassAddressTxCmd(),
+	)
+
+	return txCmd
+}
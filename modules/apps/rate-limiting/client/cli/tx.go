@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// NewRemoveBypassAddressTxCmd returns the command to create a MsgRemoveBypassAddress transaction
+func NewRemoveBypassAddressTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-bypass-address [address]",
+		Short:   "Remove an address exempt from the rate limits",
+		Long:    "Remove an address from the addresses exempt from the rate limits. The transaction must be signed by the emergency authority.",
+		Example: fmt.Sprintf("%s tx rate-limiting remove-bypass-address cosmos1... --from emergency-authority", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveBypassAddress(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
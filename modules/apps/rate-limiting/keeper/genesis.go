@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// InitGenesis initializes the rate limiting params, flows and pending send packets.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
+	for _, flow := range state.Flows {
+		k.SetFlow(ctx, flow)
+	}
+
+	for _, packet := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis exports the rate limiting params, flows and pending send packets into its
+// genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllFlows(ctx), k.GetAllPendingSendPackets(ctx))
+}
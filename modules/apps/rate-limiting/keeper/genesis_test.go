@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	quota := types.Quota{ChannelId: "channel-0", Denom: sdk.DefaultBondDenom, MaxPercentSend: 10, MaxPercentRecv: 10}
+	params := types.NewParams([]types.Quota{quota}, time.Hour, []string{suite.chainA.SenderAccount.GetAddress().String()}, "")
+	rateLimitingKeeper.SetParams(ctx, params)
+
+	epochStart := ctx.BlockTime().UTC()
+	flows := []types.Flow{
+		types.NewFlow("channel-0", sdk.DefaultBondDenom, sdk.NewInt(1000), 1, epochStart),
+		types.NewFlow("channel-1", sdk.DefaultBondDenom, sdk.NewInt(2000), 3, epochStart),
+	}
+	flows[0].Outflow = sdk.NewInt(50)
+	for _, flow := range flows {
+		rateLimitingKeeper.SetFlow(ctx, flow)
+	}
+
+	pendingPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-0", Sequence: 1, Epoch: 1},
+		{ChannelId: "channel-0", Sequence: 256, Epoch: 1},
+	}
+	for _, packet := range pendingPackets {
+		rateLimitingKeeper.SetPendingSendPacket(ctx, packet)
+	}
+
+	genesis := rateLimitingKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(params, genesis.Params)
+	suite.Require().Equal(flows, genesis.Flows)
+	suite.Require().Equal(pendingPackets, genesis.PendingSendPackets)
+
+	suite.Require().NotPanics(func() {
+		suite.chainB.GetSimApp().RateLimitingKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
+	})
+	suite.Require().Equal(genesis, suite.chainB.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
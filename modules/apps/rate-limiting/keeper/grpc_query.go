@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// Flow implements the Query/Flow gRPC method
+func (q Keeper) Flow(c context.Context, req *types.QueryFlowRequest) (*types.QueryFlowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryFlowResponse{}
+
+	// the flow of an ended epoch is reset by the next transfer
+	flow, found := q.GetFlow(ctx, req.ChannelId, req.Denom)
+	if found && !flow.IsExpired(ctx.BlockTime(), q.GetEpochDuration(ctx)) {
+		res.Flow = &flow
+	}
+
+	if quota, found := q.GetParams(ctx).GetQuota(req.ChannelId, req.Denom); found {
+		res.Quota = &quota
+	}
+
+	return res, nil
+}
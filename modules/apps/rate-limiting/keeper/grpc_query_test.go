@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryFlow() {
+	var (
+		req      *types.QueryFlowRequest
+		expFlow  *types.Flow
+		expQuota *types.Quota
+	)
+
+	// setFlow stores a flow whose epoch started the given number of epochs ago
+	setFlow := func(epochsAgo time.Duration) {
+		ctx := suite.chainA.GetContext()
+		flow := types.NewFlow("channel-0", sdk.DefaultBondDenom, sdk.NewInt(1000), 1, ctx.BlockTime().Add(-epochsAgo*types.DefaultEpochDuration).UTC())
+		flow.Outflow = sdk.NewInt(10)
+		suite.chainA.GetSimApp().RateLimitingKeeper.SetFlow(ctx, flow)
+		expFlow = &flow
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				quota := types.Quota{ChannelId: "channel-0", Denom: sdk.DefaultBondDenom, MaxPercentSend: 10, MaxPercentRecv: 10}
+				params := types.DefaultParams()
+				params.Quotas = []types.Quota{quota}
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetParams(suite.chainA.GetContext(), params)
+				expQuota = &quota
+
+				setFlow(0)
+				req = &types.QueryFlowRequest{ChannelId: "channel-0", Denom: sdk.DefaultBondDenom}
+			},
+			true,
+		},
+		{
+			"success: no quota",
+			func() {
+				setFlow(0)
+				req = &types.QueryFlowRequest{ChannelId: "channel-0", Denom: sdk.DefaultBondDenom}
+			},
+			true,
+		},
+		{
+			"success: expired flow",
+			func() {
+				setFlow(1)
+				expFlow = nil
+				req = &types.QueryFlowRequest{ChannelId: "channel-0", Denom: sdk.DefaultBondDenom}
+			},
+			true,
+		},
+		{
+			"success: no flow",
+			func() {
+				req = &types.QueryFlowRequest{ChannelId: "channel-1", Denom: sdk.DefaultBondDenom}
+			},
+			true,
+		},
+		{
+			"invalid channel id",
+			func() {
+				req = &types.QueryFlowRequest{ChannelId: "(channel-0)", Denom: sdk.DefaultBondDenom}
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryFlowRequest{ChannelId: "channel-0", Denom: ""}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expFlow, expQuota = nil, nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.Flow(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expFlow, res.Flow)
+				suite.Require().Equal(expQuota, res.Quota)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
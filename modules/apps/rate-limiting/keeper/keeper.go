@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the IBC rate limiting keeper. It implements the ICS4Wrapper interface so that
+// it can be set as the ICS4Wrapper of the transfer keeper to account for the sent packets.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	ics4Wrapper      types.ICS4Wrapper
+	channelKeeper    types.ChannelKeeper
+	bankKeeper       types.BankKeeper
+	exemptionKeeper  types.ExemptionKeeper
+	packetDataCodecs *porttypes.PacketDataCodecs
+}
+
+// NewKeeper creates a new IBC rate limiting Keeper instance. The ICS4Wrapper is usually the
+// IBC channel keeper. The packet data of each channel is decoded with the packet data codec
+// registered for the version of the channel.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+	packetDataCodecs *porttypes.PacketDataCodecs,
+) Keeper {
+	if packetDataCodecs == nil {
+		panic("packet data codecs cannot be nil")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		bankKeeper:       bankKeeper,
+		packetDataCodecs: packetDataCodecs,
+	}
+}
+
+// SetExemptionKeeper sets the optional keeper of the protocol exempt accounts, whose transfers
+// are not limited by the quotas. It must be called before the keeper is passed to the rate
+// limiting IBC module and panics if the exemption keeper has already been set.
+func (k *Keeper) SetExemptionKeeper(exemptionKeeper types.ExemptionKeeper) *Keeper {
+	if k.exemptionKeeper != nil {
+		panic("cannot set exemption keeper twice")
+	}
+
+	k.exemptionKeeper = exemptionKeeper
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetFlow returns the stored flow of the given channel and denomination. The epoch of the
+// flow may have ended.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (types.Flow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FlowPath(channelID, denom))
+	if bz == nil {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores the flow of a channel and denomination.
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&flow)
+	store.Set(types.FlowPath(flow.ChannelId, flow.Denom), bz)
+}
+
+// GetAllFlows returns the stored flows of all channels and denominations.
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	flows := []types.Flow{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FlowKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// GetPendingSendPacket returns the pending send packet with the given channel and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingSendPacketPath(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPendingSendPacket stores a sent packet accounted for in the outflow of an epoch.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.PendingSendPacketPath(packet.ChannelId, packet.Sequence), bz)
+}
+
+// deletePendingSendPacket removes the pending send packet with the given channel and sequence.
+func (k Keeper) deletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingSendPacketPath(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the sent packets accounted for in the outflows.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	packets := []types.PendingSendPacket{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSendPacketKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
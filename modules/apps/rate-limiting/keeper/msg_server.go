@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RemoveBypassAddress defines a rpc handler method for MsgRemoveBypassAddress. It allows the
+// emergency authority to remove an address from the bypass addresses without a governance
+// proposal.
+func (k Keeper) RemoveBypassAddress(goCtx context.Context, msg *types.MsgRemoveBypassAddress) (*types.MsgRemoveBypassAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if params.EmergencyAuthority == "" {
+		return nil, types.ErrEmergencyRemovalDisabled
+	}
+
+	if msg.Authority != params.EmergencyAuthority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected emergency authority %s, got %s", params.EmergencyAuthority, msg.Authority)
+	}
+
+	bypassAddresses := make([]string, 0, len(params.BypassAddresses))
+	for _, address := range params.BypassAddresses {
+		if address != msg.Address {
+			bypassAddresses = append(bypassAddresses, address)
+		}
+	}
+
+	if len(bypassAddresses) == len(params.BypassAddresses) {
+		return nil, sdkerrors.Wrap(types.ErrBypassAddressNotFound, msg.Address)
+	}
+
+	params.BypassAddresses = bypassAddresses
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("rate limiting bypass address removed", "address", msg.Address, "authority", msg.Authority)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveBypassAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgRemoveBypassAddressResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestRemoveBypassAddress() {
+	var (
+		msg       *types.MsgRemoveBypassAddress
+		authority string
+	)
+
+	bypassAddress := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	otherAddress := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"emergency removal disabled",
+			func() {
+				authority = ""
+			},
+			types.ErrEmergencyRemovalDisabled,
+		},
+		{
+			"signer is not the emergency authority",
+			func() {
+				msg.Authority = otherAddress
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"address is not a bypass address",
+			func() {
+				msg.Address = otherAddress
+			},
+			types.ErrBypassAddressNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			authority = suite.chainA.SenderAccount.GetAddress().String()
+			msg = types.NewMsgRemoveBypassAddress(authority, bypassAddress)
+
+			tc.malleate()
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			params := types.NewParams(nil, types.DefaultEpochDuration, []string{bypassAddress}, authority)
+			rateLimitingKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext()
+			_, err := rateLimitingKeeper.RemoveBypassAddress(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Empty(rateLimitingKeeper.GetBypassAddresses(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal([]string{bypassAddress}, rateLimitingKeeper.GetBypassAddresses(ctx))
+			}
+		})
+	}
+}
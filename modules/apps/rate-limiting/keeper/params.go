@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// GetQuotas retrieves the quotas limiting the transfers from the paramstore
+func (k Keeper) GetQuotas(ctx sdk.Context) []types.Quota {
+	var res []types.Quota
+	k.paramSpace.Get(ctx, types.KeyQuotas, &res)
+	return res
+}
+
+// GetEpochDuration retrieves the duration of the epochs from the paramstore
+func (k Keeper) GetEpochDuration(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.Get(ctx, types.KeyEpochDuration, &res)
+	return res
+}
+
+// GetBypassAddresses retrieves the addresses exempt from the quotas from the paramstore
+func (k Keeper) GetBypassAddresses(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyBypassAddresses, &res)
+	return res
+}
+
+// GetEmergencyAuthority retrieves the address allowed to remove bypass addresses from the
+// paramstore
+func (k Keeper) GetEmergencyAuthority(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.Get(ctx, types.KeyEmergencyAuthority, &res)
+	return res
+}
+
+// GetParams returns the total set of the rate limiting parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetQuotas(ctx), k.GetEpochDuration(ctx), k.GetBypassAddresses(ctx), k.GetEmergencyAuthority(ctx))
+}
+
+// SetParams sets the total set of the rate limiting parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// SendPacket implements the ICS4Wrapper interface. The amount of a sent ICS-20 packet is
+// added to the outflow of its channel and denomination before the packet is sent, and the
+// packet is rejected if the net outflow would exceed the send quota.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.addOutflow(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// StackLayerName implements the StackLayer interface
+func (k Keeper) StackLayerName() string {
+	return types.ModuleName
+}
+
+// UnderlyingICS4Wrapper implements the PacketSenderWrapper interface
+func (k Keeper) UnderlyingICS4Wrapper() porttypes.PacketSender {
+	return k.ics4Wrapper
+}
+
+// AddInflow adds the amount of a received ICS-20 packet to the inflow of its channel and
+// denomination. An error is returned if the net inflow would exceed the receive quota.
+// Packets which are not ICS-20 packets, without a quota or received by a bypass address or a
+// protocol exempt account are ignored.
+func (k Keeper) AddInflow(ctx sdk.Context, packet channeltypes.Packet) error {
+	data, amount, ok := k.parsePacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet)
+	if !ok {
+		return nil
+	}
+
+	denom := types.GetReceiveDenom(packet, data)
+	quota, found := k.getQuota(ctx, packet.GetDestChannel(), denom, data.Receiver)
+	if !found {
+		return nil
+	}
+
+	flow := k.getCurrentFlow(ctx, packet.GetDestChannel(), denom)
+	if err := flow.AddInflow(amount, quota); err != nil {
+		emitQuotaExceededEvent(ctx, types.AttributeValueRecv, flow, amount)
+		return err
+	}
+
+	k.SetFlow(ctx, flow)
+	return nil
+}
+
+// RevertOutflow removes the amount of a sent packet which failed, i.e. whose acknowledgement is
+// an error or which timed out, from the outflow of its channel and denomination if the packet
+// was sent during the current epoch.
+func (k Keeper) RevertOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	pendingPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	k.deletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	// the packet data was parsed when the packet was sent
+	data, amount, _ := k.parsePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet)
+	flow, found := k.GetFlow(ctx, packet.GetSourceChannel(), types.GetSendDenom(data))
+	if !found || flow.Epoch != pendingPacket.Epoch {
+		return
+	}
+
+	flow.RevertOutflow(amount)
+	k.SetFlow(ctx, flow)
+}
+
+// ClearPendingSendPacket removes a sent packet which was successfully acknowledged from the
+// pending send packets, its amount remains in the outflow.
+func (k Keeper) ClearPendingSendPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.deletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// addOutflow adds the amount of a sent ICS-20 packet to the outflow of its channel and
+// denomination and records the packet as pending. An error is returned if the net outflow
+// would exceed the send quota. Packets which are not ICS-20 packets, without a quota or sent by
+// a bypass address or a protocol exempt account are ignored.
+func (k Keeper) addOutflow(ctx sdk.Context, packet ibcexported.PacketI) error {
+	data, amount, ok := k.parsePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet)
+	if !ok {
+		return nil
+	}
+
+	denom := types.GetSendDenom(data)
+	quota, found := k.getQuota(ctx, packet.GetSourceChannel(), denom, data.Sender)
+	if !found {
+		return nil
+	}
+
+	flow := k.getCurrentFlow(ctx, packet.GetSourceChannel(), denom)
+	if err := flow.AddOutflow(amount, quota); err != nil {
+		return err
+	}
+
+	k.SetFlow(ctx, flow)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Epoch:     flow.Epoch,
+	})
+
+	return nil
+}
+
+// getQuota returns the quota of the given channel and denomination, unless the transfer is
+// made by a bypass address or a protocol exempt account.
+func (k Keeper) getQuota(ctx sdk.Context, channelID, denom, address string) (types.Quota, bool) {
+	params := k.GetParams(ctx)
+
+	quota, found := params.GetQuota(channelID, denom)
+	if !found || params.IsBypassAddress(address) {
+		return types.Quota{}, false
+	}
+
+	if k.exemptionKeeper != nil && k.exemptionKeeper.IsProtocolExempt(ctx, address) {
+		return types.Quota{}, false
+	}
+
+	return quota, true
+}
+
+// getCurrentFlow returns the flow of the given channel and denomination for the current epoch.
+// A new epoch starts at the current block time if the epoch of the stored flow has ended, with
+// the current total supply of the denomination as channel value.
+func (k Keeper) getCurrentFlow(ctx sdk.Context, channelID, denom string) types.Flow {
+	flow, found := k.GetFlow(ctx, channelID, denom)
+	if found && !flow.IsExpired(ctx.BlockTime(), k.GetEpochDuration(ctx)) {
+		return flow
+	}
+
+	channelValue := k.bankKeeper.GetSupply(ctx, denom).Amount
+	return types.NewFlow(channelID, denom, channelValue, flow.Epoch+1, ctx.BlockTime())
+}
+
+// parsePacketData returns the ICS-20 packet data and amount of the packet, decoded with the
+// packet data codec registered for the version of the given channel end. False is returned if
+// the packet is not a valid ICS-20 packet.
+func (k Keeper) parsePacketData(ctx sdk.Context, portID, channelID string, packet ibcexported.PacketI) (transfertypes.FungibleTokenPacketData, sdk.Int, bool) {
+	var version string
+	if channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+		version = channel.Version
+	}
+
+	packetData, err := k.packetDataCodecs.DecodePacketData(version, packet.GetData())
+	if err != nil {
+		return transfertypes.FungibleTokenPacketData{}, sdk.Int{}, false
+	}
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		packetData.Denom, packetData.Amount, packetData.Sender, packetData.Receiver, packetData.Memo,
+	)
+
+	if err := data.ValidateBasic(); err != nil {
+		return data, sdk.Int{}, false
+	}
+
+	// the amount is validated to be a positive integer
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	return data, amount, true
+}
+
+// emitQuotaExceededEvent emits an event for a transfer rejected by a quota.
+func emitQuotaExceededEvent(ctx sdk.Context, direction string, flow types.Flow, amount sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyChannel, flow.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, flow.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
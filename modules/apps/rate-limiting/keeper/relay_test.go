@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// setQuota sets the quota of the given channel for the bond denomination of the chain, the
+// sender of the chain is exempt from the quotas if bypass is true.
+func (suite *KeeperTestSuite) setQuota(chain *ibctesting.TestChain, channelID string, maxPercentSend, maxPercentRecv uint64, bypass bool) {
+	params := types.DefaultParams()
+	params.Quotas = []types.Quota{{ChannelId: channelID, Denom: sdk.DefaultBondDenom, MaxPercentSend: maxPercentSend, MaxPercentRecv: maxPercentRecv}}
+	if bypass {
+		params.BypassAddresses = []string{chain.SenderAccount.GetAddress().String()}
+	}
+
+	chain.GetSimApp().RateLimitingKeeper.SetParams(chain.GetContext(), params)
+}
+
+// quotaThreshold returns the maximum net flow of the bond denomination of the chain allowed by
+// the given percentage of its supply.
+func (suite *KeeperTestSuite) quotaThreshold(chain *ibctesting.TestChain, maxPercent int64) sdk.Int {
+	supply := chain.GetSimApp().BankKeeper.GetSupply(chain.GetContext(), sdk.DefaultBondDenom)
+	return supply.Amount.MulRaw(maxPercent).QuoRaw(100)
+}
+
+// newTransferMsg creates a transfer of the amount of the given denomination from the sender of
+// the source endpoint to the sender of the counterparty chain.
+func newTransferMsg(endpoint *ibctesting.Endpoint, denom string, amount sdk.Int, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, sdk.NewCoin(denom, amount),
+		endpoint.Chain.SenderAccount.GetAddress().String(), endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "",
+	)
+}
+
+// sendTransfer sends the transfer and returns the sent packet.
+func (suite *KeeperTestSuite) sendTransfer(chain *ibctesting.TestChain, msg *transfertypes.MsgTransfer) channeltypes.Packet {
+	res, err := chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestSendQuota() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	packet := suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, clienttypes.NewHeight(0, 110)))
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	flow, found := rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), flow.Epoch)
+	suite.Require().Equal(threshold, flow.Outflow)
+	suite.Require().True(flow.Inflow.IsZero())
+
+	pendingPacket, found := rateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), pendingPacket.Epoch)
+
+	// the channel value is the supply at the start of the epoch, which grows with the inflation
+	remaining := flow.ChannelValue.QuoRaw(100).Sub(flow.Outflow)
+	ctx := suite.chainA.GetContext()
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, remaining.AddRaw(1), clienttypes.NewHeight(0, 110)))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// the outflow of an acknowledged packet is kept
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	_, found = rateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+	suite.Require().False(found)
+	flow, _ = rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(threshold, flow.Outflow)
+
+	// other channels and denominations are not limited
+	suite.Require().Len(rateLimitingKeeper.GetAllFlows(suite.chainA.GetContext()), 1)
+}
+
+// TestSendQuotaProtobuf tests that the packets of channels negotiated with the protobuf transfer
+// version are decoded and limited by the quotas.
+func (suite *KeeperTestSuite) TestSendQuotaProtobuf() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = transfertypes.VersionProtobuf
+	path.EndpointB.ChannelConfig.Version = transfertypes.VersionProtobuf
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, clienttypes.NewHeight(0, 110)))
+
+	flow, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(threshold, flow.Outflow)
+
+	remaining := flow.ChannelValue.QuoRaw(100).Sub(flow.Outflow)
+	ctx := suite.chainA.GetContext()
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, remaining.AddRaw(1), clienttypes.NewHeight(0, 110)))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+}
+
+func (suite *KeeperTestSuite) TestSendQuotaBypassAddress() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, true)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold.AddRaw(1), clienttypes.NewHeight(0, 110)))
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	suite.Require().Empty(rateLimitingKeeper.GetAllFlows(suite.chainA.GetContext()))
+	suite.Require().Empty(rateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestSendQuotaProtocolExemptAccount() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	transferParams := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	transferParams.ProtocolExemptAccounts = []string{suite.chainA.SenderAccount.GetAddress().String()}
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), transferParams)
+
+	suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold.AddRaw(1), clienttypes.NewHeight(0, 110)))
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	suite.Require().Empty(rateLimitingKeeper.GetAllFlows(suite.chainA.GetContext()))
+	suite.Require().Empty(rateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestSendQuotaRevertedOnTimeout() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	timeoutHeight.RevisionHeight += 1
+	packet := suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, timeoutHeight))
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	flow, found := rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(flow.Outflow.IsZero())
+	suite.Require().Empty(rateLimitingKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+
+	// the quota is available again
+	suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, clienttypes.NewHeight(0, 110)))
+}
+
+func (suite *KeeperTestSuite) TestSendQuotaEpochReset() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 1, 0, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	timeoutHeight.RevisionHeight += 1
+	packet := suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, timeoutHeight))
+
+	// the flow is reset in the next epoch
+	suite.coordinator.IncrementTimeBy(types.DefaultEpochDuration)
+	suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold, clienttypes.NewHeight(0, 110)))
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	flow, found := rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), flow.Epoch)
+	suite.Require().Equal(threshold, flow.Outflow)
+
+	// the timeout of a packet sent in the previous epoch does not change the current flow
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	flow, _ = rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(threshold, flow.Outflow)
+	_, found = rateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.GetSequence())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRecvQuota() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// the native tokens of chainA are transferred to chainB before the quota is set
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+	packet := suite.sendTransfer(suite.chainA, newTransferMsg(path.EndpointA, sdk.DefaultBondDenom, threshold.MulRaw(2), clienttypes.NewHeight(0, 110)))
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 0, 1, false)
+
+	// the tokens sent back to chainA exceed the quota, with a margin for the inflation
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	packet = suite.sendTransfer(suite.chainB, newTransferMsg(path.EndpointB, voucherDenom, threshold.MulRaw(3).QuoRaw(2), clienttypes.NewHeight(0, 110)))
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	res, err := path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrQuotaExceeded).Acknowledgement(), ack)
+
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+	_, found := rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	// the sender is refunded on chainB
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.AcknowledgePacket(packet, ack))
+
+	// the tokens within the quota are received
+	packet = suite.sendTransfer(suite.chainB, newTransferMsg(path.EndpointB, voucherDenom, threshold, clienttypes.NewHeight(0, 110)))
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	flow, found := rateLimitingKeeper.GetFlow(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(threshold, flow.Inflow)
+	suite.Require().True(flow.Outflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestAddInflowQuotaExceededEvent() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 0, 1, false)
+	threshold := suite.quotaThreshold(suite.chainA, 1)
+
+	// tokens of chainA returned by chainB
+	data := transfertypes.NewFungibleTokenPacketData(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+		threshold.AddRaw(1).String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "",
+	)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0,
+	)
+
+	ctx := suite.chainA.GetContext()
+	err := suite.chainA.GetSimApp().RateLimitingKeeper.AddInflow(ctx, packet)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	expEvent := sdk.NewEvent(
+		types.EventTypeQuotaExceeded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDirection, types.AttributeValueRecv),
+		sdk.NewAttribute(types.AttributeKeyChannel, path.EndpointA.ChannelID),
+		sdk.NewAttribute(types.AttributeKeyDenom, sdk.DefaultBondDenom),
+		sdk.NewAttribute(types.AttributeKeyAmount, threshold.AddRaw(1).String()),
+	)
+	suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+
+	// the receiver is exempt from the quota
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 0, 1, true)
+	suite.Require().NoError(suite.chainA.GetSimApp().RateLimitingKeeper.AddInflow(suite.chainA.GetContext(), packet))
+
+	// packets which are not ICS-20 packets are ignored
+	packet.Data = []byte("invalid")
+	suite.setQuota(suite.chainA, path.EndpointA.ChannelID, 0, 1, false)
+	suite.Require().NoError(suite.chainA.GetSimApp().RateLimitingKeeper.AddInflow(suite.chainA.GetContext(), packet))
+	suite.Require().Empty(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllFlows(suite.chainA.GetContext()))
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetSendDenom returns the denomination on this chain of the tokens sent in the given ICS-20
+// packet data, i.e. the base denomination of native tokens or the voucher denomination.
+func GetSendDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
+
+// GetReceiveDenom returns the denomination on this chain of the tokens received in the given
+// ICS-20 packet, i.e. the denomination of the unescrowed tokens if this chain is the source of
+// the tokens or the voucher denomination otherwise.
+func GetReceiveDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
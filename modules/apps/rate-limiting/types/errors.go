@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limiting sentinel errors
+var (
+	ErrInvalidQuota             = sdkerrors.Register(ModuleName, 2, "invalid quota")
+	ErrQuotaExceeded            = sdkerrors.Register(ModuleName, 3, "quota exceeded")
+	ErrZeroChannelValue         = sdkerrors.Register(ModuleName, 4, "channel value is zero")
+	ErrBypassAddressNotFound    = sdkerrors.Register(ModuleName, 5, "bypass address not found")
+	ErrInvalidEpochDuration     = sdkerrors.Register(ModuleName, 6, "invalid epoch duration")
+	ErrEmergencyRemovalDisabled = sdkerrors.Register(ModuleName, 7, "emergency removal disabled")
+)
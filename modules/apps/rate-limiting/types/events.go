@@ -0,0 +1,16 @@
+package types
+
+// Rate limiting events
+const (
+	EventTypeQuotaExceeded       = "rate_limit_quota_exceeded"
+	EventTypeRemoveBypassAddress = "remove_bypass_address"
+
+	AttributeKeyChannel   = "channel_id"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAddress   = "address"
+
+	AttributeValueSend = "send"
+	AttributeValueRecv = "recv"
+)
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewFlow creates a new Flow instance for the epoch starting at the given time, with no
+// amount transferred yet.
+func NewFlow(channelID, denom string, channelValue sdk.Int, epoch uint64, epochStart time.Time) Flow {
+	return Flow{
+		ChannelId:    channelID,
+		Denom:        denom,
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		Epoch:        epoch,
+		EpochStart:   epochStart,
+	}
+}
+
+// Validate performs a basic validation of the flow fields.
+func (f Flow) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() || f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "flows of channel %s and denom %s cannot be negative", f.ChannelId, f.Denom)
+	}
+
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "channel value of channel %s and denom %s cannot be negative", f.ChannelId, f.Denom)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the epoch of the flow has ended at the given time.
+func (f Flow) IsExpired(blockTime time.Time, epochDuration time.Duration) bool {
+	return !blockTime.Before(f.EpochStart.Add(epochDuration))
+}
+
+// AddOutflow adds the amount to the outflow. An error is returned if the net outflow would
+// exceed the send quota, in which case the flow is left unchanged.
+func (f *Flow) AddOutflow(amount sdk.Int, quota Quota) error {
+	outflow := f.Outflow.Add(amount)
+	if err := checkQuota(outflow.Sub(f.Inflow), f.ChannelValue, quota.MaxPercentSend); err != nil {
+		return sdkerrors.Wrapf(err, "send of %s%s over channel %s", amount, f.Denom, f.ChannelId)
+	}
+
+	f.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the amount to the inflow. An error is returned if the net inflow would
+// exceed the receive quota, in which case the flow is left unchanged.
+func (f *Flow) AddInflow(amount sdk.Int, quota Quota) error {
+	inflow := f.Inflow.Add(amount)
+	if err := checkQuota(inflow.Sub(f.Outflow), f.ChannelValue, quota.MaxPercentRecv); err != nil {
+		return sdkerrors.Wrapf(err, "receive of %s%s over channel %s", amount, f.Denom, f.ChannelId)
+	}
+
+	f.Inflow = inflow
+	return nil
+}
+
+// RevertOutflow removes the amount of a failed send from the outflow.
+func (f *Flow) RevertOutflow(amount sdk.Int) {
+	f.Outflow = sdk.MaxInt(f.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// checkQuota returns an error if the net flow exceeds the given percentage of the channel
+// value. A percentage of zero does not limit the flow.
+func checkQuota(netFlow, channelValue sdk.Int, maxPercent uint64) error {
+	if maxPercent == 0 {
+		return nil
+	}
+
+	if channelValue.IsZero() {
+		return ErrZeroChannelValue
+	}
+
+	threshold := channelValue.Mul(sdk.NewIntFromUint64(maxPercent)).QuoRaw(100)
+	if netFlow.GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net flow %s exceeds %d%% of the channel value %s", netFlow, maxPercent, channelValue)
+	}
+
+	return nil
+}
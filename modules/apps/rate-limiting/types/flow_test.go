@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowQuotas(t *testing.T) {
+	quota := Quota{ChannelId: "channel-0", Denom: "uatom", MaxPercentSend: 10, MaxPercentRecv: 0}
+	flow := NewFlow("channel-0", "uatom", sdk.NewInt(1000), 1, time.Now())
+
+	// the net outflow is limited to 10% of the channel value
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(100), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdk.NewInt(1), quota), ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), flow.Outflow)
+
+	// inflows are not limited and offset the outflow
+	require.NoError(t, flow.AddInflow(sdk.NewInt(50), quota))
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(50), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdk.NewInt(1), quota), ErrQuotaExceeded)
+
+	flow.RevertOutflow(sdk.NewInt(20))
+	require.Equal(t, sdk.NewInt(130), flow.Outflow)
+	flow.RevertOutflow(sdk.NewInt(1000))
+	require.True(t, flow.Outflow.IsZero())
+
+	// the net inflow is limited by the receive quota
+	quota.MaxPercentRecv = 5
+	require.ErrorIs(t, flow.AddInflow(sdk.NewInt(1), quota), ErrQuotaExceeded)
+
+	// the transfers of a channel without value are rejected
+	flow = NewFlow("channel-0", "uatom", sdk.ZeroInt(), 1, time.Now())
+	require.ErrorIs(t, flow.AddOutflow(sdk.NewInt(1), quota), ErrZeroChannelValue)
+}
+
+func TestFlowIsExpired(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	flow := NewFlow("channel-0", "uatom", sdk.NewInt(1000), 1, start)
+
+	require.False(t, flow.IsExpired(start, time.Hour))
+	require.False(t, flow.IsExpired(start.Add(time.Hour-1), time.Hour))
+	require.True(t, flow.IsExpired(start.Add(time.Hour), time.Hour))
+}
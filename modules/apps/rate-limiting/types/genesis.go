@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewGenesisState creates a new rate limiting GenesisState instance.
+func NewGenesisState(params Params, flows []Flow, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		Flows:              flows,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with the default params and no flows.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Flow{}, []PendingSendPacket{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	flows := make(map[string]bool, len(gs.Flows))
+	for i, flow := range gs.Flows {
+		if err := flow.Validate(); err != nil {
+			return fmt.Errorf("invalid flow %d: %w", i, err)
+		}
+
+		key := string(FlowPath(flow.ChannelId, flow.Denom))
+		if flows[key] {
+			return fmt.Errorf("duplicate flow for channel %s and denom %s", flow.ChannelId, flow.Denom)
+		}
+		flows[key] = true
+	}
+
+	for i, packet := range gs.PendingSendPackets {
+		if err := host.ChannelIdentifierValidator(packet.ChannelId); err != nil {
+			return fmt.Errorf("invalid pending send packet %d: %w", i, err)
+		}
+		if packet.Sequence == 0 {
+			return fmt.Errorf("invalid pending send packet %d: packet sequence cannot be 0", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FlowKey defines the key prefix to store the flows of the current epochs
+	FlowKey = []byte{0x01}
+	// PendingSendPacketKey defines the key prefix to store the sent packets accounted for
+	// in the outflows
+	PendingSendPacketKey = []byte{0x02}
+)
+
+// FlowPath returns the store key under which the flow of the given channel and denomination
+// is stored.
+func FlowPath(channelID, denom string) []byte {
+	return append(FlowKey, []byte(fmt.Sprintf("%s/%s", channelID, denom))...)
+}
+
+// PendingSendPacketPath returns the store key under which the pending send packet with the
+// given channel and sequence is stored.
+func PendingSendPacketPath(channelID string, sequence uint64) []byte {
+	return append(PendingSendPacketKey, []byte(fmt.Sprintf("%s/%d", channelID, sequence))...)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msg types
+const (
+	TypeMsgRemoveBypassAddress = "remove_bypass_address"
+)
+
+// NewMsgRemoveBypassAddress creates a new MsgRemoveBypassAddress instance
+//
+//nolint:interfacer
+func NewMsgRemoveBypassAddress(authority, address string) *MsgRemoveBypassAddress {
+	return &MsgRemoveBypassAddress{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRemoveBypassAddress) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRemoveBypassAddress) Type() string {
+	return TypeMsgRemoveBypassAddress
+}
+
+// ValidateBasic performs a basic check of the MsgRemoveBypassAddress fields.
+func (msg MsgRemoveBypassAddress) ValidateBasic() error {
+	// NOTE: authority format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bypass address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgRemoveBypassAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveBypassAddress) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// DefaultEpochDuration is the default duration of the epochs over which the flows are
+// accumulated
+const DefaultEpochDuration = 24 * time.Hour
+
+var (
+	// KeyQuotas is the store key for the Quotas Params
+	KeyQuotas = []byte("Quotas")
+	// KeyEpochDuration is the store key for the EpochDuration Params
+	KeyEpochDuration = []byte("EpochDuration")
+	// KeyBypassAddresses is the store key for the BypassAddresses Params
+	KeyBypassAddresses = []byte("BypassAddresses")
+	// KeyEmergencyAuthority is the store key for the EmergencyAuthority Params
+	KeyEmergencyAuthority = []byte("EmergencyAuthority")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the rate limiting middleware
+func NewParams(quotas []Quota, epochDuration time.Duration, bypassAddresses []string, emergencyAuthority string) Params {
+	return Params{
+		Quotas:             quotas,
+		EpochDuration:      epochDuration,
+		BypassAddresses:    bypassAddresses,
+		EmergencyAuthority: emergencyAuthority,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the rate limiting middleware.
+// No transfer is limited by default.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultEpochDuration, nil, "")
+}
+
+// Validate validates all rate limiting parameters
+func (p Params) Validate() error {
+	if err := validateQuotas(p.Quotas); err != nil {
+		return err
+	}
+
+	if err := validateEpochDuration(p.EpochDuration); err != nil {
+		return err
+	}
+
+	if err := validateBypassAddresses(p.BypassAddresses); err != nil {
+		return err
+	}
+
+	return validateEmergencyAuthority(p.EmergencyAuthority)
+}
+
+// GetQuota returns the quota of the given channel and denomination.
+func (p Params) GetQuota(channelID, denom string) (Quota, bool) {
+	for _, quota := range p.Quotas {
+		if quota.ChannelId == channelID && quota.Denom == denom {
+			return quota, true
+		}
+	}
+
+	return Quota{}, false
+}
+
+// IsBypassAddress returns true if the transfers of the given address are exempt from the
+// quotas.
+func (p Params) IsBypassAddress(address string) bool {
+	for _, bypassAddress := range p.BypassAddresses {
+		if bypassAddress == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyQuotas, p.Quotas, validateQuotas),
+		paramtypes.NewParamSetPair(KeyEpochDuration, p.EpochDuration, validateEpochDuration),
+		paramtypes.NewParamSetPair(KeyBypassAddresses, p.BypassAddresses, validateBypassAddresses),
+		paramtypes.NewParamSetPair(KeyEmergencyAuthority, p.EmergencyAuthority, validateEmergencyAuthority),
+	}
+}
+
+// Validate performs a basic validation of the quota fields.
+func (q Quota) Validate() error {
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return err
+	}
+
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return fmt.Errorf("quota percentages cannot exceed 100, got send %d and recv %d", q.MaxPercentSend, q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 {
+		return fmt.Errorf("quota of channel %s and denom %s limits neither sends nor receives", q.ChannelId, q.Denom)
+	}
+
+	return nil
+}
+
+func validateQuotas(i interface{}) error {
+	quotas, ok := i.([]Quota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+
+		key := string(FlowPath(quota.ChannelId, quota.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate quota for channel %s and denom %s", quota.ChannelId, quota.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateEpochDuration(i interface{}) error {
+	epochDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if epochDuration <= 0 {
+		return fmt.Errorf("epoch duration must be positive, got %s", epochDuration)
+	}
+
+	return nil
+}
+
+func validateBypassAddresses(i interface{}) error {
+	bypassAddresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(bypassAddresses))
+	for _, address := range bypassAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid bypass address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate bypass address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateEmergencyAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if authority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid emergency authority: %w", err)
+	}
+
+	return nil
+}
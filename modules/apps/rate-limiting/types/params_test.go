@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestValidateParams(t *testing.T) {
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	quota := Quota{ChannelId: "channel-0", Denom: "uatom", MaxPercentSend: 10, MaxPercentRecv: 0}
+
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, NewParams([]Quota{quota}, DefaultEpochDuration, []string{address}, address).Validate())
+
+	// invalid quotas
+	require.Error(t, NewParams([]Quota{quota, quota}, DefaultEpochDuration, nil, "").Validate())
+	require.Error(t, NewParams([]Quota{{ChannelId: "channel-0", Denom: "uatom"}}, DefaultEpochDuration, nil, "").Validate())
+	require.Error(t, NewParams([]Quota{{ChannelId: "channel-0", Denom: "uatom", MaxPercentSend: 101}}, DefaultEpochDuration, nil, "").Validate())
+	require.Error(t, NewParams([]Quota{{ChannelId: "invalid", Denom: "uatom", MaxPercentSend: 10}}, DefaultEpochDuration, nil, "").Validate())
+	require.Error(t, NewParams([]Quota{{ChannelId: "channel-0", Denom: "", MaxPercentSend: 10}}, DefaultEpochDuration, nil, "").Validate())
+
+	require.Error(t, NewParams(nil, 0, nil, "").Validate())
+	require.Error(t, NewParams(nil, DefaultEpochDuration, []string{"invalid"}, "").Validate())
+	require.Error(t, NewParams(nil, DefaultEpochDuration, []string{address, address}, "").Validate())
+	require.Error(t, NewParams(nil, DefaultEpochDuration, nil, "invalid").Validate())
+}
+
+func TestGetQuota(t *testing.T) {
+	quota := Quota{ChannelId: "channel-0", Denom: "uatom", MaxPercentSend: 10, MaxPercentRecv: 5}
+	params := NewParams([]Quota{quota}, DefaultEpochDuration, []string{"cosmos1bypass"}, "")
+
+	res, found := params.GetQuota("channel-0", "uatom")
+	require.True(t, found)
+	require.Equal(t, quota, res)
+
+	_, found = params.GetQuota("channel-1", "uatom")
+	require.False(t, found)
+	_, found = params.GetQuota("channel-0", "stake")
+	require.False(t, found)
+
+	require.True(t, params.IsBypassAddress("cosmos1bypass"))
+	require.False(t, params.IsBypassAddress("cosmos1other"))
+}
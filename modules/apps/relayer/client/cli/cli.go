@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the IBC relayer registry
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-relayer",
+		Short:                      "IBC relayer registry query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryRelayer(),
+		GetCmdQueryRelayers(),
+		GetCmdQueryRelayerStatistics(),
+		GetCmdQueryAllRelayerStatistics(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the IBC relayer registry
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-relayer",
+		Short:                      "IBC relayer registry transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRelayerTxCmd(),
+		NewDeregisterRelayerTxCmd(),
+	)
+
+	return txCmd
+}
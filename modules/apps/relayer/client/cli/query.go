@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+// GetCmdQueryRelayer defines the command to query a registered relayer.
+func GetCmdQueryRelayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer [address]",
+		Short:   "Query the operator metadata of a registered relayer",
+		Long:    "Query the operator metadata of a registered relayer",
+		Example: fmt.Sprintf("%s query ibc-relayer relayer [address]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRelayerRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Relayer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRelayers defines the command to query all registered relayers.
+func GetCmdQueryRelayers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayers",
+		Short:   "Query all registered relayers",
+		Long:    "Query all registered relayers",
+		Example: fmt.Sprintf("%s query ibc-relayer relayers", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayersRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Relayers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayers")
+	return cmd
+}
+
+// GetCmdQueryRelayerStatistics defines the command to query the statistics of an address.
+func GetCmdQueryRelayerStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "statistics [address]",
+		Short:   "Query the relaying statistics of an address",
+		Long:    "Query the relaying statistics and the success rate of an address, whether or not it is registered",
+		Example: fmt.Sprintf("%s query ibc-relayer statistics [address]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRelayerStatisticsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.RelayerStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllRelayerStatistics defines the command to query the statistics of all addresses.
+func GetCmdQueryAllRelayerStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-statistics",
+		Short:   "Query the relaying statistics of all addresses",
+		Long:    "Query the relaying statistics of all addresses",
+		Example: fmt.Sprintf("%s query ibc-relayer all-statistics", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllRelayerStatisticsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllRelayerStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "statistics")
+	return cmd
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+const (
+	flagWebsite         = "website"
+	flagSecurityContact = "security-contact"
+	flagDetails         = "details"
+)
+
+// NewRegisterRelayerTxCmd returns the command to create a MsgRegisterRelayer transaction
+func NewRegisterRelayerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [moniker]",
+		Short:   "Register the operator metadata of a relayer",
+		Long:    "Register the operator metadata of the relayer signing the transaction. The metadata of a registered relayer is replaced.",
+		Example: fmt.Sprintf("%s tx ibc-relayer register my-relayer --website https://relayer.example --from relayer", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			website, err := cmd.Flags().GetString(flagWebsite)
+			if err != nil {
+				return err
+			}
+
+			securityContact, err := cmd.Flags().GetString(flagSecurityContact)
+			if err != nil {
+				return err
+			}
+
+			details, err := cmd.Flags().GetString(flagDetails)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterRelayer(clientCtx.GetFromAddress().String(), args[0], website, securityContact, details)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagWebsite, "", "The website of the relayer operator")
+	cmd.Flags().String(flagSecurityContact, "", "The security contact of the relayer operator")
+	cmd.Flags().String(flagDetails, "", "Details about the relayer operator")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeregisterRelayerTxCmd returns the command to create a MsgDeregisterRelayer transaction
+func NewDeregisterRelayerTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deregister",
+		Short:   "Deregister the operator metadata of a relayer",
+		Long:    "Remove the operator metadata of the relayer signing the transaction. The statistics of the relayer are kept.",
+		Example: fmt.Sprintf("%s tx ibc-relayer deregister --from relayer", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeregisterRelayer(clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+// InitGenesis initializes the ibc relayer registry state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, relayer := range state.Relayers {
+		k.SetRelayer(ctx, relayer)
+	}
+
+	for _, statistics := range state.Statistics {
+		k.SetStatistics(ctx, statistics)
+	}
+}
+
+// ExportGenesis exports the ibc relayer registry's relayers and statistics into its
+// genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Relayers:   k.GetAllRelayers(ctx),
+		Statistics: k.GetAllStatistics(ctx),
+	}
+}
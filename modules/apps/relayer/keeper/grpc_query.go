@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Relayer implements the Query/Relayer gRPC method
+func (q Keeper) Relayer(c context.Context, req *types.QueryRelayerRequest) (*types.QueryRelayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	relayer, found := q.GetRelayer(ctx, addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(types.ErrRelayerNotFound, "address: %s", req.Address).Error())
+	}
+
+	return &types.QueryRelayerResponse{
+		Relayer: relayer,
+	}, nil
+}
+
+// Relayers implements the Query/Relayers gRPC method
+func (q Keeper) Relayers(c context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	relayers := []types.Relayer{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.RelayerKeyPrefix)
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var relayer types.Relayer
+		if err := q.cdc.Unmarshal(value, &relayer); err != nil {
+			return err
+		}
+
+		relayers = append(relayers, relayer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayersResponse{
+		Relayers:   relayers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RelayerStatistics implements the Query/RelayerStatistics gRPC method
+func (q Keeper) RelayerStatistics(c context.Context, req *types.QueryRelayerStatisticsRequest) (*types.QueryRelayerStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	statistics := q.GetStatistics(ctx, addr)
+
+	return &types.QueryRelayerStatisticsResponse{
+		Statistics:  statistics,
+		SuccessRate: statistics.SuccessRate().String(),
+	}, nil
+}
+
+// AllRelayerStatistics implements the Query/AllRelayerStatistics gRPC method
+func (q Keeper) AllRelayerStatistics(c context.Context, req *types.QueryAllRelayerStatisticsRequest) (*types.QueryAllRelayerStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	allStatistics := []types.RelayerStatistics{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.StatisticsKeyPrefix)
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var statistics types.RelayerStatistics
+		if err := q.cdc.Unmarshal(value, &statistics); err != nil {
+			return err
+		}
+
+		allStatistics = append(allStatistics, statistics)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRelayerStatisticsResponse{
+		Statistics: allStatistics,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+var _ coretypes.RelayerHooks = Hooks{}
+
+// Hooks wraps the relayer registry keeper to implement the core IBC relayer hooks
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the relayer hooks tracking the statistics of the relayers
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterClientUpdated implements the RelayerHooks interface. It increments the client updates
+// of the relayer, if registered.
+func (h Hooks) AfterClientUpdated(ctx sdk.Context, clientID string, relayer sdk.AccAddress) {
+	if !h.k.HasRelayer(ctx, relayer) {
+		return
+	}
+
+	statistics := h.k.GetStatistics(ctx, relayer)
+	statistics.ClientUpdates++
+	h.k.SetStatistics(ctx, statistics)
+}
+
+// ClientUpdateGas implements the RelayerHooks interface. It returns ClientUpdateHooksGas.
+func (h Hooks) ClientUpdateGas() sdk.Gas {
+	return types.ClientUpdateHooksGas
+}
+
+// AfterPacketRelayed implements the RelayerHooks interface. If the relayer is registered, it
+// increments the redundant packets of the relayer if the message was a no-op, and the counter
+// of the message type otherwise.
+func (h Hooks) AfterPacketRelayed(ctx sdk.Context, msg sdk.Msg, relayer sdk.AccAddress, redundant bool) {
+	if !h.k.HasRelayer(ctx, relayer) {
+		return
+	}
+
+	statistics := h.k.GetStatistics(ctx, relayer)
+
+	if redundant {
+		statistics.RedundantPackets++
+		h.k.SetStatistics(ctx, statistics)
+		return
+	}
+
+	switch msg.(type) {
+	case *channeltypes.MsgRecvPacket:
+		statistics.RecvPackets++
+	case *channeltypes.MsgAcknowledgement:
+		statistics.AcknowledgedPackets++
+	case *channeltypes.MsgTimeout, *channeltypes.MsgTimeoutOnClose:
+		statistics.TimedOutPackets++
+	default:
+		return
+	}
+
+	h.k.SetStatistics(ctx, statistics)
+}
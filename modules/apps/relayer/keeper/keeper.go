@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the IBC relayer registry keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+}
+
+// NewKeeper creates a new IBC relayer registry Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetRelayer returns the registered relayer with the given address.
+func (k Keeper) GetRelayer(ctx sdk.Context, addr sdk.AccAddress) (types.Relayer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayer(addr.String()))
+	if bz == nil {
+		return types.Relayer{}, false
+	}
+
+	var relayer types.Relayer
+	k.cdc.MustUnmarshal(bz, &relayer)
+	return relayer, true
+}
+
+// HasRelayer returns true if a relayer is registered with the given address.
+func (k Keeper) HasRelayer(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyRelayer(addr.String()))
+}
+
+// SetRelayer stores the provided relayer.
+func (k Keeper) SetRelayer(ctx sdk.Context, relayer types.Relayer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRelayer(relayer.Address), k.cdc.MustMarshal(&relayer))
+}
+
+// deleteRelayer removes the registered relayer with the given address along with its
+// statistics.
+func (k Keeper) deleteRelayer(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRelayer(addr.String()))
+	store.Delete(types.KeyStatistics(addr.String()))
+}
+
+// IterateRelayers iterates over all registered relayers, calling the provided callback on
+// each relayer until stop=true is returned.
+func (k Keeper) IterateRelayers(ctx sdk.Context, cb func(relayer types.Relayer) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var relayer types.Relayer
+		k.cdc.MustUnmarshal(iterator.Value(), &relayer)
+
+		if cb(relayer) {
+			break
+		}
+	}
+}
+
+// GetAllRelayers returns all registered relayers.
+func (k Keeper) GetAllRelayers(ctx sdk.Context) []types.Relayer {
+	relayers := []types.Relayer{}
+	k.IterateRelayers(ctx, func(relayer types.Relayer) bool {
+		relayers = append(relayers, relayer)
+		return false
+	})
+
+	return relayers
+}
+
+// GetStatistics returns the statistics of the given address. Empty statistics are returned
+// for an address which has not relayed any message since it was registered.
+func (k Keeper) GetStatistics(ctx sdk.Context, addr sdk.AccAddress) types.RelayerStatistics {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyStatistics(addr.String()))
+	if bz == nil {
+		return types.NewRelayerStatistics(addr.String())
+	}
+
+	var statistics types.RelayerStatistics
+	k.cdc.MustUnmarshal(bz, &statistics)
+	return statistics
+}
+
+// SetStatistics stores the provided statistics.
+func (k Keeper) SetStatistics(ctx sdk.Context, statistics types.RelayerStatistics) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyStatistics(statistics.Address), k.cdc.MustMarshal(&statistics))
+}
+
+// IterateStatistics iterates over the statistics of all addresses, calling the provided
+// callback on each statistics until stop=true is returned.
+func (k Keeper) IterateStatistics(ctx sdk.Context, cb func(statistics types.RelayerStatistics) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StatisticsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var statistics types.RelayerStatistics
+		k.cdc.MustUnmarshal(iterator.Value(), &statistics)
+
+		if cb(statistics) {
+			break
+		}
+	}
+}
+
+// GetAllStatistics returns the statistics of all addresses.
+func (k Keeper) GetAllStatistics(ctx sdk.Context) []types.RelayerStatistics {
+	allStatistics := []types.RelayerStatistics{}
+	k.IterateStatistics(ctx, func(statistics types.RelayerStatistics) bool {
+		allStatistics = append(allStatistics, statistics)
+		return false
+	})
+
+	return allStatistics
+}
@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	// the statistics are only tracked for registered relayers
+	for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+		relayer := types.NewRelayer(chain.SenderAccount.GetAddress().String(), "relayer", "", "", "")
+		chain.GetSimApp().RelayerKeeper.SetRelayer(chain.GetContext(), relayer)
+	}
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RelayerKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestRelayerStatistics() {
+	relayerA := suite.chainA.SenderAccount.GetAddress()
+	relayerB := suite.chainB.SenderAccount.GetAddress()
+
+	// the handshake updated the clients of both chains
+	statisticsA := suite.chainA.GetSimApp().RelayerKeeper.GetStatistics(suite.chainA.GetContext(), relayerA)
+	suite.Require().NotZero(statisticsA.ClientUpdates)
+	suite.Require().Zero(statisticsA.RelayedPackets())
+	clientUpdatesB := suite.chainB.GetSimApp().RelayerKeeper.GetStatistics(suite.chainB.GetContext(), relayerB).ClientUpdates
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+
+	// receive the packet twice, the second receive is redundant
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.RecvPacket(packet))
+
+	statisticsB := suite.chainB.GetSimApp().RelayerKeeper.GetStatistics(suite.chainB.GetContext(), relayerB)
+	suite.Require().Greater(statisticsB.ClientUpdates, clientUpdatesB)
+	suite.Require().Equal(uint64(1), statisticsB.RecvPackets)
+	suite.Require().Equal(uint64(1), statisticsB.RedundantPackets)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), statisticsB.SuccessRate())
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+
+	// time out a second packet
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, 2, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	statisticsA = suite.chainA.GetSimApp().RelayerKeeper.GetStatistics(suite.chainA.GetContext(), relayerA)
+	suite.Require().Equal(uint64(1), statisticsA.AcknowledgedPackets)
+	suite.Require().Equal(uint64(1), statisticsA.TimedOutPackets)
+	suite.Require().Zero(statisticsA.RecvPackets)
+	suite.Require().Zero(statisticsA.RedundantPackets)
+	suite.Require().Equal(sdk.OneDec(), statisticsA.SuccessRate())
+
+	res, err := suite.queryClient.RelayerStatistics(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryRelayerStatisticsRequest{Address: relayerA.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(statisticsA, res.Statistics)
+	suite.Require().Equal(sdk.OneDec().String(), res.SuccessRate)
+
+	allRes, err := suite.queryClient.AllRelayerStatistics(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryAllRelayerStatisticsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Contains(allRes.Statistics, statisticsA)
+}
+
+func (suite *KeeperTestSuite) TestRegisterRelayer() {
+	var msg *types.MsgRegisterRelayer
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"register a new relayer", func() {},
+		},
+		{
+			"replace the metadata of a registered relayer", func() {
+				_, err := suite.chainA.GetSimApp().RelayerKeeper.RegisterRelayer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+
+				msg.Moniker = "renamed relayer"
+				msg.Website = ""
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			relayer := suite.chainA.SenderAccount.GetAddress()
+			msg = types.NewMsgRegisterRelayer(relayer.String(), "relayer", "https://relayer.example", "security@relayer.example", "")
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RelayerKeeper.RegisterRelayer(sdk.WrapSDKContext(ctx), msg)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.Relayer(sdk.WrapSDKContext(ctx), &types.QueryRelayerRequest{Address: relayer.String()})
+			suite.Require().NoError(err)
+			suite.Require().Equal(msg.Relayer(), res.Relayer)
+
+			relayersRes, err := suite.queryClient.Relayers(sdk.WrapSDKContext(ctx), &types.QueryRelayersRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal([]types.Relayer{msg.Relayer()}, relayersRes.Relayers)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterRelayer() {
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RelayerKeeper
+	suite.Require().NotZero(keeper.GetStatistics(ctx, relayer).ClientUpdates)
+
+	_, err := keeper.DeregisterRelayer(sdk.WrapSDKContext(ctx), types.NewMsgDeregisterRelayer(relayer.String()))
+	suite.Require().NoError(err)
+
+	_, found := keeper.GetRelayer(ctx, relayer)
+	suite.Require().False(found)
+
+	_, err = suite.queryClient.Relayer(sdk.WrapSDKContext(ctx), &types.QueryRelayerRequest{Address: relayer.String()})
+	suite.Require().Error(err)
+
+	// the statistics are removed along with the relayer
+	suite.Require().Equal(types.NewRelayerStatistics(relayer.String()), keeper.GetStatistics(ctx, relayer))
+	suite.Require().Empty(keeper.GetAllStatistics(ctx))
+
+	// an unregistered relayer cannot be deregistered
+	_, err = keeper.DeregisterRelayer(sdk.WrapSDKContext(ctx), types.NewMsgDeregisterRelayer(relayer.String()))
+	suite.Require().ErrorIs(err, types.ErrRelayerNotFound)
+}
+
+// TestUnregisteredRelayerStatistics tests that the statistics of unregistered relayers are not tracked.
+func (suite *KeeperTestSuite) TestUnregisteredRelayerStatistics() {
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	_, err := suite.chainA.GetSimApp().RelayerKeeper.DeregisterRelayer(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgDeregisterRelayer(relayer.String()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	suite.Require().Empty(suite.chainA.GetSimApp().RelayerKeeper.GetAllStatistics(suite.chainA.GetContext()))
+}
+
+// TestQueuedClientUpdateStatistics tests that the queued client updates are only counted once applied.
+func (suite *KeeperTestSuite) TestQueuedClientUpdateStatistics() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	clientID := suite.path.EndpointA.ClientID
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	params := app.IBCKeeper.ClientKeeper.GetParams(ctx)
+	params.BatchedUpdateClients = []string{clientID}
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, params)
+
+	clientUpdates := app.RelayerKeeper.GetStatistics(ctx, relayer).ClientUpdates
+
+	// the relayer queues an invalid header and a valid one, of which only the valid one is applied
+	suite.coordinator.CommitBlock(suite.chainB)
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	invalidHeader, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+	suite.Require().NoError(err)
+	for i := range invalidHeader.Commit.Signatures {
+		invalidHeader.Commit.Signatures[i].Signature = make([]byte, len(invalidHeader.Commit.Signatures[i].Signature))
+	}
+
+	for _, header := range []*ibctmtypes.Header{invalidHeader, header} {
+		msg, err := clienttypes.NewMsgUpdateClient(clientID, header, relayer.String())
+		suite.Require().NoError(err)
+
+		_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	// the queued updates are not counted until they are applied at the end of the block
+	suite.Require().Equal(clientUpdates, app.RelayerKeeper.GetStatistics(ctx, relayer).ClientUpdates)
+
+	app.IBCKeeper.ApplyQueuedClientUpdates(ctx)
+	suite.Require().True(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, header.GetHeight()))
+	suite.Require().Equal(clientUpdates+1, app.RelayerKeeper.GetStatistics(ctx, relayer).ClientUpdates)
+}
+
+// TestClientUpdateHooksGas tests that the gas consumed by the client update hook is bounded by
+// ClientUpdateHooksGas, which is added to the client update gas estimates.
+func (suite *KeeperTestSuite) TestClientUpdateHooksGas() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	app.RelayerKeeper.Hooks().AfterClientUpdated(ctx, suite.path.EndpointA.ClientID, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().LessOrEqual(ctx.GasMeter().GasConsumed(), types.ClientUpdateHooksGas)
+
+	clientState := suite.path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	clientGas, _, err := clientState.EstimateUpdateGas(4)
+	suite.Require().NoError(err)
+
+	gas, _, err := app.IBCKeeper.ClientKeeper.EstimateClientUpdateGas(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, 4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clientGas+types.ClientUpdateHooksGas, gas)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().RelayerKeeper
+
+	relayer := types.NewRelayer(suite.chainA.SenderAccount.GetAddress().String(), "relayer", "", "", "")
+	keeper.SetRelayer(ctx, relayer)
+
+	genesis := keeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.Relayer{relayer}, genesis.Relayers)
+	suite.Require().NotEmpty(genesis.Statistics)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	ctx = suite.chainB.GetContext()
+	keeper = suite.chainB.GetSimApp().RelayerKeeper
+
+	keeper.InitGenesis(ctx, *genesis)
+	for _, relayer := range genesis.Relayers {
+		suite.Require().Contains(keeper.GetAllRelayers(ctx), relayer)
+	}
+	for _, statistics := range genesis.Statistics {
+		addr, err := sdk.AccAddressFromBech32(statistics.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(statistics, keeper.GetStatistics(ctx, addr))
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterRelayer defines a rpc handler method for MsgRegisterRelayer. It registers the
+// operator metadata of the signer, replacing its previous metadata.
+func (k Keeper) RegisterRelayer(goCtx context.Context, msg *types.MsgRegisterRelayer) (*types.MsgRegisterRelayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetRelayer(ctx, msg.Relayer())
+
+	k.Logger(ctx).Info("relayer registered", "address", msg.Address, "moniker", msg.Moniker)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRelayer,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyMoniker, msg.Moniker),
+		),
+	)
+
+	return &types.MsgRegisterRelayerResponse{}, nil
+}
+
+// DeregisterRelayer defines a rpc handler method for MsgDeregisterRelayer. It removes the
+// operator metadata of the signer along with its statistics.
+func (k Keeper) DeregisterRelayer(goCtx context.Context, msg *types.MsgDeregisterRelayer) (*types.MsgDeregisterRelayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetRelayer(ctx, addr); !found {
+		return nil, sdkerrors.Wrapf(types.ErrRelayerNotFound, "address: %s", msg.Address)
+	}
+
+	k.deleteRelayer(ctx, addr)
+
+	k.Logger(ctx).Info("relayer deregistered", "address", msg.Address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterRelayer,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgDeregisterRelayerResponse{}, nil
+}
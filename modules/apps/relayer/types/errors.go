@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC relayer registry sentinel errors
+var (
+	ErrInvalidRelayer    = sdkerrors.Register(ModuleName, 2, "invalid relayer")
+	ErrRelayerNotFound   = sdkerrors.Register(ModuleName, 3, "relayer not found")
+	ErrInvalidStatistics = sdkerrors.Register(ModuleName, 4, "invalid relayer statistics")
+)
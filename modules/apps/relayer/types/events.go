@@ -0,0 +1,10 @@
+package types
+
+// IBC relayer registry events
+const (
+	EventTypeRegisterRelayer   = "register_relayer"
+	EventTypeDeregisterRelayer = "deregister_relayer"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyMoniker = "moniker"
+)
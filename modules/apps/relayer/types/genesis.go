@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new ibc relayer GenesisState instance.
+func NewGenesisState(relayers []Relayer, statistics []RelayerStatistics) *GenesisState {
+	return &GenesisState{
+		Relayers:   relayers,
+		Statistics: statistics,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without relayers and statistics.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Relayers:   []Relayer{},
+		Statistics: []RelayerStatistics{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, relayer := range gs.Relayers {
+		if err := relayer.Validate(); err != nil {
+			return fmt.Errorf("invalid relayer %d: %w", i, err)
+		}
+
+		if seen[relayer.Address] {
+			return fmt.Errorf("duplicate relayer %s", relayer.Address)
+		}
+		seen[relayer.Address] = true
+	}
+
+	registered := seen
+	seen = make(map[string]bool)
+	for i, statistics := range gs.Statistics {
+		if err := statistics.Validate(); err != nil {
+			return fmt.Errorf("invalid relayer statistics %d: %w", i, err)
+		}
+
+		if !registered[statistics.Address] {
+			return fmt.Errorf("statistics for unregistered relayer %s", statistics.Address)
+		}
+
+		if seen[statistics.Address] {
+			return fmt.Errorf("duplicate statistics for relayer %s", statistics.Address)
+		}
+		seen[statistics.Address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+var (
+	relayerAddr      = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	otherRelayerAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]types.Relayer{types.NewRelayer(relayerAddr, "relayer", "https://relayer.example", "", ""), types.NewRelayer(otherRelayerAddr, "other relayer", "", "", "")},
+				[]types.RelayerStatistics{types.NewRelayerStatistics(relayerAddr), types.NewRelayerStatistics(otherRelayerAddr)},
+			),
+			true,
+		},
+		{
+			"invalid relayer address",
+			types.NewGenesisState([]types.Relayer{types.NewRelayer("invalid", "relayer", "", "", "")}, nil),
+			false,
+		},
+		{
+			"blank moniker",
+			types.NewGenesisState([]types.Relayer{types.NewRelayer(relayerAddr, " ", "", "", "")}, nil),
+			false,
+		},
+		{
+			"details too long",
+			types.NewGenesisState([]types.Relayer{types.NewRelayer(relayerAddr, "relayer", "", "", strings.Repeat("a", types.MaxDetailsLength+1))}, nil),
+			false,
+		},
+		{
+			"duplicate relayer",
+			types.NewGenesisState([]types.Relayer{
+				types.NewRelayer(relayerAddr, "relayer", "", "", ""),
+				types.NewRelayer(relayerAddr, "other relayer", "", "", ""),
+			}, nil),
+			false,
+		},
+		{
+			"invalid statistics address",
+			types.NewGenesisState(nil, []types.RelayerStatistics{types.NewRelayerStatistics("")}),
+			false,
+		},
+		{
+			"duplicate statistics",
+			types.NewGenesisState(
+				[]types.Relayer{types.NewRelayer(relayerAddr, "relayer", "", "", "")},
+				[]types.RelayerStatistics{types.NewRelayerStatistics(relayerAddr), types.NewRelayerStatistics(relayerAddr)},
+			),
+			false,
+		},
+		{
+			"statistics of unregistered relayer",
+			types.NewGenesisState(
+				[]types.Relayer{types.NewRelayer(relayerAddr, "relayer", "", "", "")},
+				[]types.RelayerStatistics{types.NewRelayerStatistics(otherRelayerAddr)},
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSuccessRate(t *testing.T) {
+	statistics := types.NewRelayerStatistics(relayerAddr)
+	require.Equal(t, sdk.ZeroDec(), statistics.SuccessRate())
+
+	statistics.RecvPackets = 2
+	statistics.AcknowledgedPackets = 1
+	statistics.RedundantPackets = 1
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), statistics.SuccessRate())
+
+	// client updates do not count towards the success rate
+	statistics.ClientUpdates = 10
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), statistics.SuccessRate())
+}
@@ -0,0 +1,33 @@
+package types
+
+const (
+	// ModuleName defines the IBC relayer registry name
+	ModuleName = "relayer"
+
+	// StoreKey is the store key string for the IBC relayer registry
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the IBC relayer registry
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the IBC relayer registry
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RelayerKeyPrefix defines the key prefix to store registered relayers in store
+	RelayerKeyPrefix = []byte{0x01}
+
+	// StatisticsKeyPrefix defines the key prefix to store relayer statistics in store
+	StatisticsKeyPrefix = []byte{0x02}
+)
+
+// KeyRelayer returns the store key of the registered relayer with the given bech32 address
+func KeyRelayer(address string) []byte {
+	return append(RelayerKeyPrefix, []byte(address)...)
+}
+
+// KeyStatistics returns the store key of the statistics of the given bech32 address
+func KeyStatistics(address string) []byte {
+	return append(StatisticsKeyPrefix, []byte(address)...)
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msg types
+const (
+	TypeMsgRegisterRelayer   = "register_relayer"
+	TypeMsgDeregisterRelayer = "deregister_relayer"
+)
+
+// NewMsgRegisterRelayer creates a new MsgRegisterRelayer instance
+//
+//nolint:interfacer
+func NewMsgRegisterRelayer(address, moniker, website, securityContact, details string) *MsgRegisterRelayer {
+	return &MsgRegisterRelayer{
+		Address:         address,
+		Moniker:         moniker,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// Relayer returns the relayer metadata registered by the message
+func (msg MsgRegisterRelayer) Relayer() Relayer {
+	return NewRelayer(msg.Address, msg.Moniker, msg.Website, msg.SecurityContact, msg.Details)
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterRelayer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterRelayer) Type() string {
+	return TypeMsgRegisterRelayer
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterRelayer fields.
+func (msg MsgRegisterRelayer) ValidateBasic() error {
+	return msg.Relayer().Validate()
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgRegisterRelayer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterRelayer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgDeregisterRelayer creates a new MsgDeregisterRelayer instance
+//
+//nolint:interfacer
+func NewMsgDeregisterRelayer(address string) *MsgDeregisterRelayer {
+	return &MsgDeregisterRelayer{
+		Address: address,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgDeregisterRelayer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgDeregisterRelayer) Type() string {
+	return TypeMsgDeregisterRelayer
+}
+
+// ValidateBasic performs a basic check of the MsgDeregisterRelayer fields.
+func (msg MsgDeregisterRelayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgDeregisterRelayer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDeregisterRelayer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
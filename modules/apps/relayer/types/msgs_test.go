@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
+)
+
+func TestMsgRegisterRelayerValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterRelayer
+		expPass bool
+	}{
+		{"success", types.NewMsgRegisterRelayer(relayerAddr, "relayer", "https://relayer.example", "security@relayer.example", "details"), true},
+		{"invalid address", types.NewMsgRegisterRelayer("invalid", "relayer", "", "", ""), false},
+		{"empty moniker", types.NewMsgRegisterRelayer(relayerAddr, "", "", "", ""), false},
+		{"moniker too long", types.NewMsgRegisterRelayer(relayerAddr, strings.Repeat("a", types.MaxMonikerLength+1), "", "", ""), false},
+		{"website too long", types.NewMsgRegisterRelayer(relayerAddr, "relayer", strings.Repeat("a", types.MaxWebsiteLength+1), "", ""), false},
+		{"security contact too long", types.NewMsgRegisterRelayer(relayerAddr, "relayer", "", strings.Repeat("a", types.MaxSecurityContactLength+1), ""), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, relayerAddr, tc.msg.GetSigners()[0].String())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgDeregisterRelayerValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgDeregisterRelayer(relayerAddr).ValidateBasic())
+	require.Error(t, types.NewMsgDeregisterRelayer("").ValidateBasic())
+}
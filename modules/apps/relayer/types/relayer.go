@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Maximum lengths of the relayer operator metadata
+const (
+	MaxMonikerLength         = 70
+	MaxWebsiteLength         = 140
+	MaxSecurityContactLength = 140
+	MaxDetailsLength         = 280
+)
+
+// ClientUpdateHooksGas is the upper bound of the gas consumed by the AfterClientUpdated relayer
+// hook, reading the registered relayer and updating its statistics.
+const ClientUpdateHooksGas uint64 = 10_000
+
+// NewRelayer creates a new Relayer instance
+func NewRelayer(addr, moniker, website, securityContact, details string) Relayer {
+	return Relayer{
+		Address:         addr,
+		Moniker:         moniker,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// Validate performs a stateless validation of the relayer metadata
+func (r Relayer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if strings.TrimSpace(r.Moniker) == "" {
+		return sdkerrors.Wrap(ErrInvalidRelayer, "moniker cannot be blank")
+	}
+
+	for _, field := range []struct {
+		name   string
+		value  string
+		maxLen int
+	}{
+		{"moniker", r.Moniker, MaxMonikerLength},
+		{"website", r.Website, MaxWebsiteLength},
+		{"security contact", r.SecurityContact, MaxSecurityContactLength},
+		{"details", r.Details, MaxDetailsLength},
+	} {
+		if len(field.value) > field.maxLen {
+			return sdkerrors.Wrapf(ErrInvalidRelayer, "%s length %d exceeds maximum length %d", field.name, len(field.value), field.maxLen)
+		}
+	}
+
+	return nil
+}
+
+// NewRelayerStatistics creates new empty statistics for the given address
+func NewRelayerStatistics(addr string) RelayerStatistics {
+	return RelayerStatistics{
+		Address: addr,
+	}
+}
+
+// Validate performs a stateless validation of the relayer statistics
+func (s RelayerStatistics) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// RelayedPackets returns the number of packet messages which were not redundant
+func (s RelayerStatistics) RelayedPackets() uint64 {
+	return s.RecvPackets + s.AcknowledgedPackets + s.TimedOutPackets
+}
+
+// SuccessRate returns the share of the packet messages which were not redundant. It is
+// zero if no packet message has been relayed.
+func (s RelayerStatistics) SuccessRate() sdk.Dec {
+	relayed := s.RelayedPackets()
+	total := relayed + s.RedundantPackets
+	if total == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(relayed)).QuoInt(sdk.NewIntFromUint64(total))
+}
@@ -0,0 +1,901 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/relayer/v1/relayer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Relayer defines the operator metadata registered by a relayer.
+type Relayer struct {
+	// the address signing the relaying messages
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the name of the relayer operator
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// the website of the relayer operator
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// the security contact of the relayer operator
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
+	// free form details about the relayer operator
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *Relayer) Reset()         { *m = Relayer{} }
+func (m *Relayer) String() string { return proto.CompactTextString(m) }
+func (*Relayer) ProtoMessage()    {}
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db6a4e3a221ea338, []int{0}
+}
+func (m *Relayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relayer.Merge(m, src)
+}
+func (m *Relayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relayer proto.InternalMessageInfo
+
+func (m *Relayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Relayer) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Relayer) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *Relayer) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
+	}
+	return ""
+}
+
+func (m *Relayer) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// RelayerStatistics defines the number of relaying messages processed by core
+// IBC for a registered relayer, since it was registered. Redundant packets are
+// the packet messages which were no-ops, as the packet had already been
+// relayed.
+type RelayerStatistics struct {
+	// the address signing the relaying messages
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the number of applied client updates
+	ClientUpdates uint64 `protobuf:"varint,2,opt,name=client_updates,json=clientUpdates,proto3" json:"client_updates,omitempty" yaml:"client_updates"`
+	// the number of received packets
+	RecvPackets uint64 `protobuf:"varint,3,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty" yaml:"recv_packets"`
+	// the number of acknowledged packets
+	AcknowledgedPackets uint64 `protobuf:"varint,4,opt,name=acknowledged_packets,json=acknowledgedPackets,proto3" json:"acknowledged_packets,omitempty" yaml:"acknowledged_packets"`
+	// the number of timed out packets, including timeouts on close
+	TimedOutPackets uint64 `protobuf:"varint,5,opt,name=timed_out_packets,json=timedOutPackets,proto3" json:"timed_out_packets,omitempty" yaml:"timed_out_packets"`
+	// the number of redundant packet messages
+	RedundantPackets uint64 `protobuf:"varint,6,opt,name=redundant_packets,json=redundantPackets,proto3" json:"redundant_packets,omitempty" yaml:"redundant_packets"`
+}
+
+func (m *RelayerStatistics) Reset()         { *m = RelayerStatistics{} }
+func (m *RelayerStatistics) String() string { return proto.CompactTextString(m) }
+func (*RelayerStatistics) ProtoMessage()    {}
+func (*RelayerStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db6a4e3a221ea338, []int{1}
+}
+func (m *RelayerStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStatistics.Merge(m, src)
+}
+func (m *RelayerStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStatistics proto.InternalMessageInfo
+
+func (m *RelayerStatistics) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RelayerStatistics) GetClientUpdates() uint64 {
+	if m != nil {
+		return m.ClientUpdates
+	}
+	return 0
+}
+
+func (m *RelayerStatistics) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *RelayerStatistics) GetAcknowledgedPackets() uint64 {
+	if m != nil {
+		return m.AcknowledgedPackets
+	}
+	return 0
+}
+
+func (m *RelayerStatistics) GetTimedOutPackets() uint64 {
+	if m != nil {
+		return m.TimedOutPackets
+	}
+	return 0
+}
+
+func (m *RelayerStatistics) GetRedundantPackets() uint64 {
+	if m != nil {
+		return m.RedundantPackets
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Relayer)(nil), "ibc.applications.relayer.v1.Relayer")
+	proto.RegisterType((*RelayerStatistics)(nil), "ibc.applications.relayer.v1.RelayerStatistics")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/relayer/v1/relayer.proto", fileDescriptor_db6a4e3a221ea338)
+}
+
+var fileDescriptor_db6a4e3a221ea338 = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xd6, 0x6d, 0xc2, 0xbc, 0x6c, 0xcd, 0x86, 0x16, 0x28, 0x4a, 0x90, 0x4f, 0x70,
+	0xa0, 0xd1, 0x34, 0x89, 0xc3, 0x4e, 0xa8, 0x48, 0x08, 0x4e, 0x4c, 0x46, 0x5c, 0xb8, 0x54, 0x8e,
+	0xfd, 0x28, 0x58, 0x4d, 0xe2, 0x28, 0x7e, 0xd2, 0xa9, 0xdf, 0x82, 0x03, 0x5f, 0x86, 0x6f, 0xc0,
+	0x71, 0x47, 0x4e, 0x11, 0x6a, 0xbf, 0x41, 0x3e, 0x01, 0x4a, 0x9c, 0x74, 0x65, 0x43, 0xbb, 0xf9,
+	0xff, 0xe2, 0x9f, 0xfd, 0x48, 0x0f, 0x79, 0xa5, 0x22, 0x11, 0xf2, 0x3c, 0x4f, 0x94, 0xe0, 0xa8,
+	0x74, 0x66, 0xc2, 0x02, 0x12, 0xbe, 0x84, 0x22, 0x5c, 0x9c, 0xf6, 0xc7, 0x49, 0x5e, 0x68, 0xd4,
+	0xee, 0x58, 0x45, 0x62, 0xb2, 0x5d, 0x9d, 0xf4, 0xf9, 0xe2, 0xf4, 0xd9, 0x71, 0xac, 0x63, 0xdd,
+	0xf6, 0xc2, 0xe6, 0x64, 0xaf, 0xd0, 0x9f, 0x0e, 0xd9, 0x67, 0xb6, 0xe4, 0x7a, 0x64, 0x9f, 0x4b,
+	0x59, 0x80, 0x31, 0x9e, 0xf3, 0xc2, 0x79, 0x79, 0x9f, 0xf5, 0xb2, 0x49, 0x52, 0x9d, 0xa9, 0x39,
+	0x14, 0xde, 0x3d, 0x9b, 0x74, 0xb2, 0x49, 0x2e, 0x21, 0x32, 0x0a, 0xc1, 0xdb, 0xb1, 0x49, 0x27,
+	0xdd, 0xf7, 0xe4, 0xd0, 0x80, 0x28, 0x0b, 0x85, 0xcb, 0x99, 0xd0, 0x19, 0x72, 0x81, 0xde, 0xb0,
+	0xa9, 0x4c, 0xc7, 0x75, 0x15, 0x9c, 0x2c, 0x79, 0x9a, 0x9c, 0xd3, 0x9b, 0x0d, 0xca, 0x0e, 0x7a,
+	0xeb, 0x9d, 0x75, 0x9a, 0x17, 0x24, 0x20, 0x57, 0x89, 0xf1, 0x76, 0xed, 0x0b, 0x9d, 0xa4, 0x3f,
+	0x76, 0xc8, 0xa8, 0xfb, 0xfb, 0x67, 0xe4, 0xa8, 0x0c, 0x2a, 0x61, 0xee, 0x98, 0xe2, 0x2d, 0x79,
+	0x2c, 0x12, 0x05, 0x19, 0xce, 0xca, 0x5c, 0x72, 0x04, 0xd3, 0x0e, 0x33, 0x9c, 0x3e, 0xad, 0xab,
+	0xe0, 0x89, 0xfd, 0xcf, 0xbf, 0x39, 0x65, 0x8f, 0xac, 0xf1, 0xc5, 0x6a, 0xf7, 0x9c, 0x3c, 0x2c,
+	0x40, 0x2c, 0x66, 0x39, 0x17, 0x73, 0x40, 0xd3, 0x8e, 0x3c, 0x9c, 0x9e, 0xd4, 0x55, 0x70, 0x64,
+	0xef, 0x6f, 0xa7, 0x94, 0x3d, 0x68, 0xe4, 0x85, 0x55, 0x2e, 0x23, 0xc7, 0x5c, 0xcc, 0x33, 0x7d,
+	0x99, 0x80, 0x8c, 0x41, 0x6e, 0x18, 0xc3, 0x96, 0x11, 0xd4, 0x55, 0x30, 0xb6, 0x8c, 0xff, 0xb5,
+	0x28, 0x3b, 0xda, 0xb6, 0x7b, 0xe6, 0x07, 0x32, 0x42, 0x95, 0x82, 0x9c, 0xe9, 0x12, 0x37, 0xc0,
+	0xdd, 0x16, 0xf8, 0xbc, 0xae, 0x02, 0xcf, 0x02, 0x6f, 0x55, 0x28, 0x3b, 0x68, 0xbd, 0x4f, 0x25,
+	0xf6, 0xa4, 0x8f, 0x64, 0x54, 0x80, 0x2c, 0x33, 0xc9, 0xb3, 0x6b, 0xd2, 0xde, 0x4d, 0xd2, 0xad,
+	0x0a, 0x65, 0x87, 0x1b, 0xaf, 0x43, 0x4d, 0x2f, 0x7e, 0xad, 0x7c, 0xe7, 0x6a, 0xe5, 0x3b, 0x7f,
+	0x56, 0xbe, 0xf3, 0x7d, 0xed, 0x0f, 0xae, 0xd6, 0xfe, 0xe0, 0xf7, 0xda, 0x1f, 0x7c, 0x7d, 0x13,
+	0x2b, 0xfc, 0x56, 0x46, 0x13, 0xa1, 0xd3, 0x50, 0x68, 0x93, 0x6a, 0x13, 0xaa, 0x48, 0xbc, 0x8e,
+	0x75, 0xb8, 0x38, 0x0b, 0x53, 0x2d, 0xcb, 0x04, 0x4c, 0xb3, 0xea, 0xd7, 0x2b, 0x8e, 0xcb, 0x1c,
+	0x4c, 0xb4, 0xd7, 0xee, 0xea, 0xd9, 0xdf, 0x01, 0x00, 0x5a, 0x02, 0x33, 0xb9, 0x0b, 0x03, 0x00,
+	0x00,
+}
+
+func (m *Relayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedundantPackets != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.RedundantPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimedOutPackets != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.TimedOutPackets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AcknowledgedPackets != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.AcknowledgedPackets))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecvPackets != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClientUpdates != 0 {
+		i = encodeVarintRelayer(dAtA, i, uint64(m.ClientUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRelayer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Relayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRelayer(uint64(l))
+	}
+	if m.ClientUpdates != 0 {
+		n += 1 + sovRelayer(uint64(m.ClientUpdates))
+	}
+	if m.RecvPackets != 0 {
+		n += 1 + sovRelayer(uint64(m.RecvPackets))
+	}
+	if m.AcknowledgedPackets != 0 {
+		n += 1 + sovRelayer(uint64(m.AcknowledgedPackets))
+	}
+	if m.TimedOutPackets != 0 {
+		n += 1 + sovRelayer(uint64(m.TimedOutPackets))
+	}
+	if m.RedundantPackets != 0 {
+		n += 1 + sovRelayer(uint64(m.RedundantPackets))
+	}
+	return n
+}
+
+func sovRelayer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayer(x uint64) (n int) {
+	return sovRelayer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Relayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdates", wireType)
+			}
+			m.ClientUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
+			}
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedPackets", wireType)
+			}
+			m.AcknowledgedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgedPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutPackets", wireType)
+			}
+			m.TimedOutPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOutPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundantPackets", wireType)
+			}
+			m.RedundantPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundantPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelayer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelayer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelayer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelayer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelayer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelayer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelayer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelayer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1039 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/relayer/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterRelayer defines a msg registering the operator metadata of the
+// relayer signing it. The metadata of a registered relayer is replaced.
+type MsgRegisterRelayer struct {
+	// the relayer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the name of the relayer operator
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// the website of the relayer operator
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// the security contact of the relayer operator
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty" yaml:"security_contact"`
+	// free form details about the relayer operator
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *MsgRegisterRelayer) Reset()         { *m = MsgRegisterRelayer{} }
+func (m *MsgRegisterRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRelayer) ProtoMessage()    {}
+func (*MsgRegisterRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e915f8df2a3513e6, []int{0}
+}
+func (m *MsgRegisterRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRelayer.Merge(m, src)
+}
+func (m *MsgRegisterRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRelayer proto.InternalMessageInfo
+
+// MsgRegisterRelayerResponse defines the Msg/RegisterRelayer response type.
+type MsgRegisterRelayerResponse struct {
+}
+
+func (m *MsgRegisterRelayerResponse) Reset()         { *m = MsgRegisterRelayerResponse{} }
+func (m *MsgRegisterRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRelayerResponse) ProtoMessage()    {}
+func (*MsgRegisterRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e915f8df2a3513e6, []int{1}
+}
+func (m *MsgRegisterRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRelayerResponse.Merge(m, src)
+}
+func (m *MsgRegisterRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRelayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRelayerResponse proto.InternalMessageInfo
+
+// MsgDeregisterRelayer defines a msg removing the operator metadata of the
+// relayer signing it along with its statistics.
+type MsgDeregisterRelayer struct {
+	// the relayer address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgDeregisterRelayer) Reset()         { *m = MsgDeregisterRelayer{} }
+func (m *MsgDeregisterRelayer) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterRelayer) ProtoMessage()    {}
+func (*MsgDeregisterRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e915f8df2a3513e6, []int{2}
+}
+func (m *MsgDeregisterRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterRelayer.Merge(m, src)
+}
+func (m *MsgDeregisterRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterRelayer proto.InternalMessageInfo
+
+// MsgDeregisterRelayerResponse defines the Msg/DeregisterRelayer response type.
+type MsgDeregisterRelayerResponse struct {
+}
+
+func (m *MsgDeregisterRelayerResponse) Reset()         { *m = MsgDeregisterRelayerResponse{} }
+func (m *MsgDeregisterRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterRelayerResponse) ProtoMessage()    {}
+func (*MsgDeregisterRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e915f8df2a3513e6, []int{3}
+}
+func (m *MsgDeregisterRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterRelayerResponse.Merge(m, src)
+}
+func (m *MsgDeregisterRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterRelayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterRelayerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterRelayer)(nil), "ibc.applications.relayer.v1.MsgRegisterRelayer")
+	proto.RegisterType((*MsgRegisterRelayerResponse)(nil), "ibc.applications.relayer.v1.MsgRegisterRelayerResponse")
+	proto.RegisterType((*MsgDeregisterRelayer)(nil), "ibc.applications.relayer.v1.MsgDeregisterRelayer")
+	proto.RegisterType((*MsgDeregisterRelayerResponse)(nil), "ibc.applications.relayer.v1.MsgDeregisterRelayerResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/relayer/v1/tx.proto", fileDescriptor_e915f8df2a3513e6)
+}
+
+var fileDescriptor_e915f8df2a3513e6 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6b, 0xdb, 0x40,
+	0x1c, 0x95, 0xec, 0xfe, 0xbd, 0xc5, 0xad, 0x30, 0x54, 0xc8, 0x46, 0x2e, 0xa2, 0x43, 0x97, 0xea,
+	0x70, 0x0d, 0x2d, 0xf5, 0xe8, 0x86, 0x6c, 0x86, 0xa0, 0x31, 0x4b, 0x90, 0x4e, 0xc7, 0xe5, 0x88,
+	0xa4, 0x13, 0xf7, 0x3b, 0x3b, 0x11, 0x99, 0xb2, 0x84, 0x8c, 0xf9, 0x08, 0xfe, 0x38, 0x99, 0x82,
+	0xc7, 0x4c, 0x21, 0xd8, 0x4b, 0xe6, 0x7c, 0x82, 0x20, 0x4b, 0x22, 0xc6, 0x0a, 0x49, 0xbc, 0xe9,
+	0xfd, 0xde, 0x7b, 0xbf, 0x3f, 0xba, 0x87, 0x7e, 0xf0, 0x80, 0x60, 0x3f, 0x4d, 0x23, 0x4e, 0x7c,
+	0xc5, 0x45, 0x02, 0x58, 0xd2, 0xc8, 0xcf, 0xa8, 0xc4, 0xd3, 0x3e, 0x56, 0x27, 0x6e, 0x2a, 0x85,
+	0x12, 0x46, 0x87, 0x07, 0xc4, 0x5d, 0x57, 0xb9, 0xa5, 0xca, 0x9d, 0xf6, 0xad, 0x36, 0x13, 0x4c,
+	0xac, 0x74, 0x38, 0xff, 0x2a, 0x2c, 0xce, 0xb5, 0x8e, 0x8c, 0x31, 0x30, 0x8f, 0x32, 0x0e, 0x8a,
+	0x4a, 0xaf, 0xd0, 0x1b, 0x26, 0xfa, 0xe8, 0x87, 0xa1, 0xa4, 0x00, 0xa6, 0xfe, 0x5d, 0xff, 0xf9,
+	0xd9, 0xab, 0x60, 0xce, 0xc4, 0x22, 0xe1, 0x47, 0x54, 0x9a, 0x8d, 0x82, 0x29, 0x61, 0xce, 0x1c,
+	0xd3, 0x00, 0xb8, 0xa2, 0x66, 0xb3, 0x60, 0x4a, 0x68, 0xec, 0xa2, 0x2f, 0x40, 0xc9, 0x44, 0x72,
+	0x95, 0x1d, 0x10, 0x91, 0x28, 0x9f, 0x28, 0xf3, 0x5d, 0x2e, 0x19, 0x75, 0x1e, 0x6e, 0x7b, 0xdf,
+	0x32, 0x3f, 0x8e, 0x86, 0xce, 0xa6, 0xc2, 0xf1, 0x5a, 0x55, 0xe9, 0x7f, 0x51, 0xc9, 0x27, 0x84,
+	0x54, 0xf9, 0x3c, 0x02, 0xf3, 0x7d, 0x31, 0xa1, 0x84, 0xc3, 0x4f, 0x17, 0xb3, 0x9e, 0x76, 0x3f,
+	0xeb, 0x69, 0x4e, 0x17, 0x59, 0xf5, 0x7b, 0x3c, 0x0a, 0xa9, 0x48, 0x80, 0x3a, 0x43, 0xd4, 0x1e,
+	0x03, 0xdb, 0xa1, 0xf2, 0xad, 0xf7, 0xae, 0x75, 0xb6, 0x51, 0xf7, 0x39, 0x6f, 0xd5, 0xfb, 0xf7,
+	0x79, 0x03, 0x35, 0xc7, 0xc0, 0x8c, 0x53, 0xd4, 0xda, 0xfc, 0x9d, 0xd8, 0x7d, 0xe1, 0x65, 0xdc,
+	0xfa, 0xbe, 0xd6, 0xdf, 0x2d, 0x0d, 0xd5, 0x12, 0xc6, 0x99, 0x8e, 0xbe, 0xd6, 0xcf, 0xeb, 0xbf,
+	0xd6, 0xae, 0x66, 0xb1, 0xfe, 0x6d, 0x6d, 0xa9, 0x76, 0x18, 0xed, 0x5d, 0x2d, 0x6c, 0x7d, 0xbe,
+	0xb0, 0xf5, 0xbb, 0x85, 0xad, 0x5f, 0x2e, 0x6d, 0x6d, 0xbe, 0xb4, 0xb5, 0x9b, 0xa5, 0xad, 0xed,
+	0xff, 0x61, 0x5c, 0x1d, 0x4e, 0x02, 0x97, 0x88, 0x18, 0x13, 0x01, 0xb1, 0x00, 0xcc, 0x03, 0xf2,
+	0x8b, 0x09, 0x3c, 0x1d, 0xe0, 0x58, 0x84, 0x93, 0x88, 0x42, 0x1e, 0xf3, 0xa7, 0x78, 0xab, 0x2c,
+	0xa5, 0x10, 0x7c, 0x58, 0x85, 0x75, 0xf0, 0x38, 0x00, 0xdd, 0xfb, 0x5f, 0xe5, 0x07, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterRelayer defines a rpc handler method for MsgRegisterRelayer.
+	RegisterRelayer(ctx context.Context, in *MsgRegisterRelayer, opts ...grpc.CallOption) (*MsgRegisterRelayerResponse, error)
+	// DeregisterRelayer defines a rpc handler method for MsgDeregisterRelayer.
+	DeregisterRelayer(ctx context.Context, in *MsgDeregisterRelayer, opts ...grpc.CallOption) (*MsgDeregisterRelayerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterRelayer(ctx context.Context, in *MsgRegisterRelayer, opts ...grpc.CallOption) (*MsgRegisterRelayerResponse, error) {
+	out := new(MsgRegisterRelayerResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.relayer.v1.Msg/RegisterRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterRelayer(ctx context.Context, in *MsgDeregisterRelayer, opts ...grpc.CallOption) (*MsgDeregisterRelayerResponse, error) {
+	out := new(MsgDeregisterRelayerResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.relayer.v1.Msg/DeregisterRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterRelayer defines a rpc handler method for MsgRegisterRelayer.
+	RegisterRelayer(context.Context, *MsgRegisterRelayer) (*MsgRegisterRelayerResponse, error)
+	// DeregisterRelayer defines a rpc handler method for MsgDeregisterRelayer.
+	DeregisterRelayer(context.Context, *MsgDeregisterRelayer) (*MsgDeregisterRelayerResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterRelayer(ctx context.Context, req *MsgRegisterRelayer) (*MsgRegisterRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRelayer not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterRelayer(ctx context.Context, req *MsgDeregisterRelayer) (*MsgDeregisterRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterRelayer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.relayer.v1.Msg/RegisterRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRelayer(ctx, req.(*MsgRegisterRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterRelayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.relayer.v1.Msg/DeregisterRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterRelayer(ctx, req.(*MsgDeregisterRelayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.relayer.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterRelayer",
+			Handler:    _Msg_RegisterRelayer_Handler,
+		},
+		{
+			MethodName: "DeregisterRelayer",
+			Handler:    _Msg_DeregisterRelayer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/relayer/v1/tx.proto",
+}
+
+func (m *MsgRegisterRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
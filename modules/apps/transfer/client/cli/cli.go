@@ -6,13 +6,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
-// GetQueryCmd returns the query commands for IBC connections
+// GetQueryCmd returns the query commands for IBC fungible token transfers, registered under
+// the ibc query namespace
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
-		Use:                        "ibc-transfer",
+		Use:                        "transfer",
 		Short:                      "IBC fungible token transfer query subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	queryCmd.AddCommand(
@@ -21,15 +23,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryEscrowSnapshots(),
+		GetCmdQueryDenomOrigin(),
+		GetCmdQueryCounterpartyModuleAccounts(),
+		GetCmdQueryPrecomputeChannel(),
+		GetCmdQueryTotalEscrowForDenom(),
 	)
 
 	return queryCmd
 }
 
-// NewTxCmd returns the transaction commands for IBC fungible token transfer
+// NewTxCmd returns the transaction commands for IBC fungible token transfer, registered under
+// the ibc transaction namespace
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
-		Use:                        "ibc-transfer",
+		Use:                        "transfer",
 		Short:                      "IBC fungible token transfer transaction subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
@@ -38,6 +46,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewUnwindTransferTxCmd(),
 	)
 
 	return txCmd
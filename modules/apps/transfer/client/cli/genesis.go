@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// GetCmdExportDenomTraces defines the command to export the denomination traces of a chain
+// along with the supply of their vouchers from an exported genesis file.
+func GetCmdExportDenomTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-denom-traces [genesis-file]",
+		Short: "Export the denom traces and voucher supplies of an exported genesis file",
+		Long: `Export the denom traces of the transfer module along with the supply of their
+vouchers from a genesis file exported from a chain, to be imported with import-denom-traces
+into the genesis of the chain migrating to a new chain ID.`,
+		Example: fmt.Sprintf("%s export-denom-traces exported-genesis.json > denom-traces.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var transferGenState types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &transferGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal transfer genesis state: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			export := types.NewDenomTraceExport(genDoc.ChainID, transferGenState.DenomTraces, bankGenState.Supply)
+			if err := export.Validate(); err != nil {
+				return err
+			}
+
+			// the export is printed as JSON to be imported with import-denom-traces
+			return clientCtx.WithOutputFormat("json").PrintProto(&export)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdImportDenomTraces defines the command to import the denomination traces exported with
+// export-denom-traces into the genesis file of the node.
+func GetCmdImportDenomTraces(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-denom-traces [export-file]",
+		Short: "Import exported denom traces into genesis.json",
+		Long: `Import the denom traces exported with export-denom-traces into the transfer module
+genesis state of genesis.json, along with the denom traces it already contains. The 'ibc/{hash}'
+voucher denominations only depend on the denom traces, so they are preserved across a migration
+to a new chain ID. The supply of every exported voucher must match its supply in the bank module
+genesis state, which ensures the voucher balances have been migrated.`,
+		Example: fmt.Sprintf("%s import-denom-traces denom-traces.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var export types.DenomTraceExport
+			if err := cdc.UnmarshalJSON(bz, &export); err != nil {
+				return fmt.Errorf("failed to unmarshal denom trace export: %w", err)
+			}
+
+			if err := export.Validate(); err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			if err := export.VerifySupply(bankGenState.Supply); err != nil {
+				return err
+			}
+
+			var transferGenState types.GenesisState
+			if err := cdc.UnmarshalJSON(appState[types.ModuleName], &transferGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal transfer genesis state: %w", err)
+			}
+
+			transferGenState.DenomTraces = export.MergeDenomTraces(transferGenState.DenomTraces)
+			if err := transferGenState.Validate(); err != nil {
+				return fmt.Errorf("invalid transfer genesis state: %w", err)
+			}
+
+			transferGenStateBz, err := cdc.MarshalJSON(&transferGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal transfer genesis state: %w", err)
+			}
+
+			appState[types.ModuleName] = transferGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
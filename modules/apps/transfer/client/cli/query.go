@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -11,13 +12,20 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
 
+const (
+	flagDenoms                = "denoms"
+	flagCounterpartyDenoms    = "counterparty-denoms"
+	flagCounterpartyPortID    = "counterparty-port-id"
+	flagCounterpartyChannelID = "counterparty-channel-id"
+)
+
 // GetCmdQueryDenomTrace defines the command to query a a denomination trace from a given hash.
 func GetCmdQueryDenomTrace() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "denom-trace [hash]",
 		Short:   "Query the denom trace info from a given trace hash",
 		Long:    "Query the denom trace info from a given trace hash",
-		Example: fmt.Sprintf("%s query ibc-transfer denom-trace [hash]", version.AppName),
+		Example: fmt.Sprintf("%s query ibc transfer denom-trace [hash]", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -50,7 +58,7 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 		Use:     "denom-traces",
 		Short:   "Query the trace info for all token denominations",
 		Long:    "Query the trace info for all token denominations",
-		Example: fmt.Sprintf("%s query ibc-transfer denom-traces", version.AppName),
+		Example: fmt.Sprintf("%s query ibc transfer denom-traces", version.AppName),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -89,7 +97,7 @@ func GetCmdParams() *cobra.Command {
 		Short:   "Query the current ibc-transfer parameters",
 		Long:    "Query the current ibc-transfer parameters",
 		Args:    cobra.NoArgs,
-		Example: fmt.Sprintf("%s query ibc-transfer params", version.AppName),
+		Example: fmt.Sprintf("%s query ibc transfer params", version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -111,14 +119,19 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
-// GetCmdParams returns the command handler for ibc-transfer parameter querying.
+// escrowAddressOutput defines the output of the escrow address command
+type escrowAddressOutput struct {
+	EscrowAddress string `json:"escrow_address" yaml:"escrow_address"`
+}
+
+// GetCmdQueryEscrowAddress returns the command handler for the escrow address of a channel.
 func GetCmdQueryEscrowAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "escrow-address",
 		Short:   "Get the escrow address for a channel",
 		Long:    "Get the escrow address for a channel",
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s query ibc-transfer escrow-address [port] [channel-id]", version.AppName),
+		Example: fmt.Sprintf("%s query ibc transfer escrow-address [port] [channel-id]", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -127,7 +140,7 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 			port := args[0]
 			channel := args[1]
 			addr := types.GetEscrowAddress(port, channel)
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
+			return clientCtx.PrintObjectLegacy(escrowAddressOutput{EscrowAddress: addr.String()})
 		},
 	}
 
@@ -139,10 +152,12 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 // GetCmdQueryDenomHash defines the command to query a denomination hash from a given trace.
 func GetCmdQueryDenomHash() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "denom-hash [trace]",
-		Short:   "Query the denom hash info from a given denom trace",
-		Long:    "Query the denom hash info from a given denom trace",
-		Example: fmt.Sprintf("%s query ibc-transfer denom-hash [denom_trace]", version.AppName),
+		Use:   "denom-hash [trace]",
+		Short: "Query the denom hash info from a given denom trace",
+		Long: `Query the denom hash info from a given denom trace.
+The trace must be of the form '{portID}/{channelID}/.../{baseDenom}'. The hash and the
+resulting 'ibc/{hash}' voucher denomination are computed by the node.`,
+		Example: fmt.Sprintf("%s query ibc transfer denom-hash transfer/channel-0/uatom", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -167,3 +182,214 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryEscrowSnapshots defines the command to query the escrow snapshots of a channel.
+func GetCmdQueryEscrowSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-snapshots [port-id] [channel-id]",
+		Short:   "Query the snapshots of the escrow balance of a channel",
+		Long:    "Query the snapshots of the escrow balance of a channel ordered by height. Use the reverse flag to list the latest snapshots first.",
+		Example: fmt.Sprintf("%s query ibc transfer escrow-snapshots [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEscrowSnapshotsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EscrowSnapshots(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrow snapshots")
+
+	return cmd
+}
+
+// GetCmdQueryDenomOrigin defines the command to query the provenance of an IBC voucher.
+func GetCmdQueryDenomOrigin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-origin [hash]",
+		Short:   "Query the provenance of an IBC voucher from a given trace hash",
+		Long:    "Query the hops of the denomination trace of an IBC voucher from a given trace hash. The chain IDs are only resolved for the hops which are known to this chain.",
+		Example: fmt.Sprintf("%s query ibc transfer denom-origin [hash]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomOriginRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomOrigin(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCounterpartyModuleAccounts defines the command to query the module accounts
+// registered for the counterparty chain of a channel.
+func GetCmdQueryCounterpartyModuleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-module-accounts [port-id] [channel-id]",
+		Short:   "Query the module accounts registered for the counterparty chain of a channel",
+		Long:    "Query the module accounts registered for the counterparty chain of a channel. Transfers to these accounts over the channel must explicitly allow the receiver.",
+		Example: fmt.Sprintf("%s query ibc transfer counterparty-module-accounts [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCounterpartyModuleAccountsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.CounterpartyModuleAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrecomputeChannel defines the command to precompute the channel identifier, escrow address
+// and voucher denominations resulting from the next channel opened on a port.
+func GetCmdQueryPrecomputeChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompute-channel [port-id]",
+		Short: "Precompute the identifiers, escrow address and voucher denominations of the next channel opened on a port",
+		Long: strings.TrimSpace(`Precompute the channel identifier and escrow address of the next channel opened on a port given the current
+channel sequence, so that configuration and frontends can be prepared before the channel is opened.
+
+The vouchers minted on this chain for the counterparty denominations received over the channel are computed for the
+denominations given with the counterparty-denoms flag. The vouchers minted on the counterparty chain for the
+denominations of this chain sent over the channel are computed for the denominations given with the denoms flag and
+require the identifiers of the counterparty channel end, which can be precomputed in the same way on the counterparty
+chain. The results no longer hold if another channel is opened before the planned one.`),
+		Example: fmt.Sprintf(
+			"%s query ibc transfer precompute-channel transfer --denoms stake --counterparty-denoms uatom --counterparty-port-id transfer --counterparty-channel-id channel-7",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denoms, err := cmd.Flags().GetStringSlice(flagDenoms)
+			if err != nil {
+				return err
+			}
+
+			counterpartyDenoms, err := cmd.Flags().GetStringSlice(flagCounterpartyDenoms)
+			if err != nil {
+				return err
+			}
+
+			counterpartyPortID, err := cmd.Flags().GetString(flagCounterpartyPortID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyChannelID, err := cmd.Flags().GetString(flagCounterpartyChannelID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPrecomputeChannelRequest{
+				PortId:                args[0],
+				Denoms:                denoms,
+				CounterpartyDenoms:    counterpartyDenoms,
+				CounterpartyPortId:    counterpartyPortID,
+				CounterpartyChannelId: counterpartyChannelID,
+			}
+
+			res, err := queryClient.PrecomputeChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagDenoms, []string{}, "Comma-separated denominations of this chain sent over the channel")
+	cmd.Flags().StringSlice(flagCounterpartyDenoms, []string{}, "Comma-separated denominations of the counterparty chain received over the channel")
+	cmd.Flags().String(flagCounterpartyPortID, types.PortID, "Port identifier of the counterparty channel end")
+	cmd.Flags().String(flagCounterpartyChannelID, "", "Channel identifier of the counterparty channel end")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of a denomination
+// escrowed by the transfer channels.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of a denomination escrowed by the transfer channels",
+		Long:    "Query the total amount of a denomination escrowed by all the transfer channels of this chain.",
+		Example: fmt.Sprintf("%s query ibc transfer total-escrow stake", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
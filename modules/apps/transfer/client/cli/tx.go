@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -22,6 +24,8 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
+	flagAllowModuleAccount     = "allow-module-account-receiver"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -34,8 +38,9 @@ as absolute or relative using the "absolute-timeouts" flag. Timeout height can b
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
-		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled. If both timeouts are 0, the default
+timeouts defined by the transfer module params of the sending chain are applied.`),
+		Example: fmt.Sprintf("%s tx ibc transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -110,17 +115,212 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			allowModuleAccount, err := cmd.Flags().GetBool(flagAllowModuleAccount)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
+				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
+			msg.AllowModuleAccountReceiver = allowModuleAccount
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
-	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "Packet timeout timestamp in nanoseconds from now. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo delivered with the packet to the destination chain.")
+	cmd.Flags().Bool(flagAllowModuleAccount, false, "Allow the receiver to be a module account registered for the counterparty chain of the channel.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewUnwindTransferTxCmd returns the command to create a NewMsgUnwindTransfer transaction
+func NewUnwindTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwind [receiver] [amount]",
+		Short: "Transfer IBC vouchers back to their origin chain",
+		Long: strings.TrimSpace(`Transfer IBC vouchers back to their origin chain, over the channels recorded in their
+denomination trace. Vouchers received over several hops are forwarded by the packet forward middleware of the
+intermediate chains, the receiver is the receiver on the origin chain. Timeouts are absolute,
+the timeout height is set in the form {revision}-{height} using the "packet-timeout-height" flag and the timeout
+timestamp in nanoseconds since the unix epoch using the "packet-timeout-timestamp" flag. Any timeout set to 0 is
+disabled. If both timeouts are 0, the default timeouts defined by the transfer module params of the sending chain
+are applied.`),
+		Example: fmt.Sprintf("%s tx ibc transfer unwind [receiver] [amount]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress().String()
+			receiver := args[0]
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			if !strings.HasPrefix(coin.Denom, "ibc/") {
+				denomTrace := types.ParseDenomTrace(coin.Denom)
+				coin.Denom = denomTrace.IBCDenom()
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnwindTransfer(coin, sender, receiver, timeoutHeight, timeoutTimestamp)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Absolute packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "Absolute packet timeout timestamp in nanoseconds since the unix epoch. The timeout is disabled when set to 0.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitDenomTraceCorrectionProposal implements a command handler for submitting a denomination
+// trace correction proposal transaction.
+func NewCmdSubmitDenomTraceCorrectionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "correct-denom-trace [trace-hash] [path] [base-denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to correct a malformed denomination trace",
+		Long: "Submit a proposal to correct the denomination trace stored under a trace hash along with an initial deposit.\n" +
+			"If the hash of the corrected trace differs from the trace hash, the vouchers of the stored trace are converted into vouchers of the corrected trace.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal correct-denom-trace [trace-hash] transfer/channel-0 gamm/pool/1 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			correction := types.NewDenomTraceCorrection(args[0], types.DenomTrace{
+				Path:      args[1],
+				BaseDenom: args[2],
+			})
+			content := types.NewDenomTraceCorrectionProposal(title, description, []types.DenomTraceCorrection{correction})
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitCounterpartyModuleAccountsProposal implements a command handler for submitting a
+// counterparty module accounts proposal transaction.
+func NewCmdSubmitCounterpartyModuleAccountsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "counterparty-module-accounts [port-id] [channel-id] [addresses...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Submit a proposal to register the module accounts of the counterparty chain of a transfer channel",
+		Long: "Submit a proposal to register the module accounts of the counterparty chain of a transfer channel along with an initial deposit.\n" +
+			"The addresses replace those registered for the channel, the registration is removed if no address is provided.\n" +
+			"Transfers to a registered module account are rejected unless the sender explicitly allows them.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal counterparty-module-accounts transfer channel-0 [address] --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			moduleAccounts := types.NewCounterpartyModuleAccounts(args[0], args[1], args[2:])
+			content := types.NewCounterpartyModuleAccountsProposal(title, description, moduleAccounts)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/client/cli"
+)
+
+// DenomTraceCorrectionProposalHandler is the denomination trace correction proposal handler
+var DenomTraceCorrectionProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitDenomTraceCorrectionProposal, emptyRestHandler)
+
+// CounterpartyModuleAccountsProposalHandler is the counterparty module accounts proposal handler
+var CounterpartyModuleAccountsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCounterpartyModuleAccountsProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-transfer",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC transfer proposals")
+		},
+	}
+}
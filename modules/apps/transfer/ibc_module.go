@@ -29,8 +29,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED or ORDERED, use the correct port (by default 'transfer'), and use the
+// current supported version. Only 2^32 channels are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -47,8 +47,10 @@ func ValidateTransferChannelParams(
 	if channelSequence > uint64(math.MaxUint32) {
 		return sdkerrors.Wrapf(types.ErrMaxTransferChannels, "channel sequence %d is greater than max allowed transfer channels %d", channelSequence, uint64(math.MaxUint32))
 	}
-	if order != channeltypes.UNORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	// ORDERED channels may be used for a strict sequencing of transfers, note that the channel
+	// is closed once a packet on an ORDERED channel times out
+	if order != channeltypes.UNORDERED && order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s ", channeltypes.UNORDERED, channeltypes.ORDERED, order)
 	}
 
 	// Require portID is the portID transfer module is bound to
@@ -75,8 +77,8 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s, %s or %s", version, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -102,8 +104,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s, %s or %s", counterpartyVersion, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -117,7 +119,8 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	// the version proposed by the counterparty is agreed on
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -128,8 +131,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s, %s or %s", counterpartyVersion, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
 	}
 	return nil
 }
@@ -164,7 +167,8 @@ func (im IBCModule) OnChanCloseConfirm(
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is successfully decoded and the receive application
-// logic returns without error.
+// logic returns without error. On channels negotiated with the received denom version,
+// the successful acknowledgement carries the denomination credited to the receiver.
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -172,17 +176,22 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	version := im.keeper.GetChannelVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	data, err := types.DecodePacketData(version, packet.GetData())
+	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement("cannot unmarshal ICS-20 transfer packet data")
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
+	var receivedDenom string
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		denom, err := im.keeper.OnRecvPacket(ctx, packet, data)
 		if err != nil {
 			ack = types.NewErrorAcknowledgement(err)
+		} else {
+			receivedDenom = denom
+			ack = types.NewResultAcknowledgement(version, receivedDenom)
 		}
 	}
 
@@ -194,6 +203,8 @@ func (im IBCModule) OnRecvPacket(
 			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
 			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
 			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+			sdk.NewAttribute(types.AttributeKeyReceivedDenom, receivedDenom),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		),
 	)
 
@@ -212,8 +223,8 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := types.DecodePacketData(im.keeper.GetChannelVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel()), packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
@@ -240,6 +251,17 @@ func (im IBCModule) OnAcknowledgementPacket(
 				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
 			),
 		)
+
+		// the denomination credited on the destination chain is only carried by the
+		// acknowledgements of channels negotiated with the received denom version
+		if receivedDenom, ok := types.ParseAcknowledgementResult(resp.Result); ok {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePacket,
+					sdk.NewAttribute(types.AttributeKeyReceivedDenom, receivedDenom),
+				),
+			)
+		}
 	case *channeltypes.Acknowledgement_Error:
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -252,14 +274,23 @@ func (im IBCModule) OnAcknowledgementPacket(
 	return nil
 }
 
+// OnClientFrozen implements the IBCModule interface. Transfers over the channel fail in
+// SendPacket while the client is frozen, no further action is taken.
+func (im IBCModule) OnClientFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+}
+
 // OnTimeoutPacket implements the IBCModule interface
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := types.DecodePacketData(im.keeper.GetChannelVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel()), packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
@@ -279,3 +310,120 @@ func (im IBCModule) OnTimeoutPacket(
 
 	return nil
 }
+
+// IsDeadLetterModule implements the DeadLetterModule interface. The transfer application opts
+// in to the dead-letter store.
+func (im IBCModule) IsDeadLetterModule() bool {
+	return true
+}
+
+// OnReclaimPacket implements the DeadLetterModule interface. A transfer packet whose refund
+// failed on timeout can be reclaimed by its sender, which retries the refund.
+func (im IBCModule) OnReclaimPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	reason string,
+	signer sdk.AccAddress,
+) error {
+	data, err := types.DecodePacketData(im.keeper.GetChannelVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel()), packet.GetData())
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	if data.Sender != signer.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the sender %s of the packet", signer, data.Sender)
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
+			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
+		),
+	)
+
+	return nil
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface. A transfer channel may be
+// upgraded to any of the supported transfer versions.
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(version) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s, %s or %s", version, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
+	}
+
+	return version, nil
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if err := ValidateTransferChannelParams(ctx, im.keeper, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s, %s or %s", counterpartyVersion, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
+	}
+
+	// the version proposed by the counterparty is agreed on
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s, %s or %s", counterpartyVersion, types.Version, types.VersionReceivedDenom, types.VersionProtobuf)
+	}
+	return nil
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+}
+
+// StackLayerName implements the StackLayer interface
+func (im IBCModule) StackLayerName() string {
+	return types.ModuleName
+}
+
+// GetICS4Wrapper implements the PacketSendingModule interface
+func (im IBCModule) GetICS4Wrapper() porttypes.PacketSender {
+	return im.keeper.GetICS4Wrapper()
+}
@@ -3,12 +3,16 @@ package transfer_test
 import (
 	"math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
 func (suite *TransferTestSuite) TestOnChanOpenInit() {
@@ -27,14 +31,29 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success - received denom version", func() {
+				channel.Version = types.VersionReceivedDenom
+			}, true,
+		},
+		{
+			"success - protobuf version", func() {
+				channel.Version = types.VersionProtobuf
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
 			}, false,
 		},
 		{
-			"invalid order - ORDERED", func() {
+			"success - ORDERED", func() {
 				channel.Ordering = channeltypes.ORDERED
+			}, true,
+		},
+		{
+			"invalid order - NONE", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
@@ -115,6 +134,16 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success - received denom version", func() {
+				counterpartyVersion = types.VersionReceivedDenom
+			}, true,
+		},
+		{
+			"success - protobuf version", func() {
+				counterpartyVersion = types.VersionProtobuf
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -127,8 +156,13 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 			}, true,
 		},
 		{
-			"invalid order - ORDERED", func() {
+			"success - ORDERED", func() {
 				channel.Ordering = channeltypes.ORDERED
+			}, true,
+		},
+		{
+			"invalid order - NONE", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
@@ -180,7 +214,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -202,6 +236,16 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success - received denom version", func() {
+				counterpartyVersion = types.VersionReceivedDenom
+			}, true,
+		},
+		{
+			"success - protobuf version", func() {
+				counterpartyVersion = types.VersionProtobuf
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -239,3 +283,140 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		})
 	}
 }
+
+func (suite *TransferTestSuite) TestOnRecvPacketReceivedDenom() {
+	testCases := []struct {
+		name             string
+		version          string
+		expReceivedDenom bool
+	}{
+		{
+			"received denom version", types.VersionReceivedDenom, true,
+		},
+		{
+			"original version", types.Version, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = tc.version
+			path.EndpointB.ChannelConfig.Version = tc.version
+			suite.coordinator.Setup(path)
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().True(ack.Success())
+
+			var result channeltypes.Acknowledgement
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &result))
+
+			receivedDenom, found := types.ParseAcknowledgementResult(result.GetResult())
+			suite.Require().Equal(tc.expReceivedDenom, found)
+			if tc.expReceivedDenom {
+				suite.Require().Equal(voucherDenom, receivedDenom)
+			} else {
+				suite.Require().Equal([]byte{byte(1)}, result.GetResult())
+			}
+
+			// the sending chain emits the received denom carried by the acknowledgement
+			module, _, err = suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok = suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ctx := suite.chainA.GetContext()
+			err = cbs.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+
+			emitted := false
+			for _, event := range ctx.EventManager().Events() {
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyReceivedDenom {
+						emitted = true
+						suite.Require().Equal(voucherDenom, string(attr.Value))
+					}
+				}
+			}
+			suite.Require().Equal(tc.expReceivedDenom, emitted)
+		})
+	}
+}
+
+func (suite *TransferTestSuite) TestOnReclaimPacket() {
+	var signer sdk.AccAddress
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"signer is not the sender", func() {
+				signer = suite.chainB.SenderAccount.GetAddress()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			signer = suite.chainA.SenderAccount.GetAddress()
+
+			// escrow the tokens which are refunded
+			amount := sdk.NewInt(100)
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, amount))
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			deadLetterModule, ok := cbs.(porttypes.DeadLetterModule)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			err = deadLetterModule.OnReclaimPacket(suite.chainA.GetContext(), packet, "codespace: bank, code: 5", signer)
+
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(amount, postCoin.Amount.Sub(preCoin.Amount))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(preCoin, postCoin)
+			}
+		})
+	}
+}
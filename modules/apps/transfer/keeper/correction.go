@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// CorrectDenomTraces applies the provided denomination trace corrections in order. It is
+// called by the denomination trace correction proposal handler and may be called by upgrade
+// handlers to correct malformed denomination traces during a migration.
+//
+// A corrected trace with the hash it is stored under replaces the stored trace. Otherwise the
+// vouchers of the stored trace are converted into vouchers of the corrected trace, merging
+// them with any existing vouchers of the corrected trace, and the stored trace is deleted.
+// Vouchers of the stored trace must not be escrowed, as they are owed to counterparty chains
+// under the stored trace.
+func (k Keeper) CorrectDenomTraces(ctx sdk.Context, corrections []types.DenomTraceCorrection) error {
+	for i, correction := range corrections {
+		if err := k.correctDenomTrace(ctx, correction); err != nil {
+			return sdkerrors.Wrapf(err, "correction %d", i)
+		}
+	}
+
+	return nil
+}
+
+// correctDenomTrace applies a single denomination trace correction.
+func (k Keeper) correctDenomTrace(ctx sdk.Context, correction types.DenomTraceCorrection) error {
+	if err := correction.Validate(); err != nil {
+		return err
+	}
+
+	// the hash is validated above
+	hash, _ := types.ParseHexHash(correction.Hash)
+	if !k.HasDenomTrace(ctx, hash) {
+		return sdkerrors.Wrap(types.ErrTraceNotFound, hash.String())
+	}
+
+	correctedHash := correction.Trace.Hash()
+	amount := sdk.ZeroInt()
+	if !bytes.Equal(hash, correctedHash) {
+		var err error
+		amount, err = k.convertVouchers(ctx, voucherDenom(hash), correction.Trace.IBCDenom())
+		if err != nil {
+			return err
+		}
+
+		k.deleteDenomTrace(ctx, hash)
+	}
+
+	k.SetDenomTrace(ctx, correction.Trace)
+
+	k.Logger(ctx).Info("denomination trace corrected", "trace-hash", hash.String(), "corrected-trace-hash", correctedHash.String(), "vouchers", amount.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomTraceCorrection,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, hash.String()),
+			sdk.NewAttribute(types.AttributeKeyCorrectedHash, correctedHash.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, correction.Trace.IBCDenom()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// convertVouchers replaces the vouchers of the given denomination held by every account with
+// the same amount of vouchers of the corrected denomination and returns the converted amount.
+// It fails if the balances of the vouchers do not add up to their supply or if vouchers are
+// escrowed by a transfer channel.
+func (k Keeper) convertVouchers(ctx sdk.Context, denom, correctedDenom string) (sdk.Int, error) {
+	var escrowErr error
+	portID := k.GetPort(ctx)
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.PortId != portID {
+			return false
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		if balance := k.bankKeeper.GetBalance(ctx, escrowAddress, denom); !balance.IsZero() {
+			escrowErr = sdkerrors.Wrapf(types.ErrInvalidTraceCorrection, "%s escrowed by channel %s", balance, channel.ChannelId)
+			return true
+		}
+
+		return false
+	})
+	if escrowErr != nil {
+		return sdk.Int{}, escrowErr
+	}
+
+	// balances cannot be updated while iterating over them
+	var holders []sdk.AccAddress
+	var amounts []sdk.Int
+	total := sdk.ZeroInt()
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.Denom == denom && coin.IsPositive() {
+			holders = append(holders, address)
+			amounts = append(amounts, coin.Amount)
+			total = total.Add(coin.Amount)
+		}
+		return false
+	})
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); !supply.Amount.Equal(total) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidTraceCorrection, "supply %s does not match the total balance %s%s", supply, total, denom)
+	}
+
+	if total.IsZero() {
+		return total, nil
+	}
+
+	moduleAddress := k.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, holder := range holders {
+		if err := k.bankKeeper.SendCoins(ctx, holder, moduleAddress, sdk.NewCoins(sdk.NewCoin(denom, amounts[i]))); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, total))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(correctedDenom, total))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	for i, holder := range holders {
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddress, holder, sdk.NewCoins(sdk.NewCoin(correctedDenom, amounts[i]))); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	return total, nil
+}
+
+// voucherDenom returns the denomination of the vouchers of the trace stored under the given
+// hash. It does not depend on the stored trace, which may be malformed.
+func voucherDenom(hash tmbytes.HexBytes) string {
+	return fmt.Sprintf("%s/%s", types.DenomPrefix, hash)
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestCorrectDenomTraces() {
+	var (
+		storedTrace    types.DenomTrace
+		correctedTrace types.DenomTrace
+		holders        []sdk.AccAddress
+	)
+
+	amount := sdk.NewInt(100)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		merged   bool
+		expPass  bool
+	}{
+		{
+			"success: trace split corrected in place", func() {
+				// a base denomination containing slashes parsed into the path
+				storedTrace = types.DenomTrace{Path: "transfer/channel-0/gamm/pool", BaseDenom: "1"}
+				correctedTrace = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "gamm/pool/1"}
+			}, false, true,
+		},
+		{
+			"success: vouchers converted into vouchers of the corrected trace", func() {
+				storedTrace = types.DenomTrace{Path: "transfer/channel-0/", BaseDenom: "uatom"}
+				correctedTrace = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+			}, true, true,
+		},
+		{
+			"success: vouchers merged with existing vouchers of the corrected trace", func() {
+				storedTrace = types.DenomTrace{Path: "transfer/channel-0/", BaseDenom: "uatom"}
+				correctedTrace = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), correctedTrace)
+				suite.fundVouchers(correctedTrace.IBCDenom(), holders[0], amount)
+			}, true, true,
+		},
+		{
+			"failure: vouchers escrowed by a channel", func() {
+				storedTrace = types.DenomTrace{Path: "transfer/channel-0/", BaseDenom: "uatom"}
+				correctedTrace = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+
+				path := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.fundVouchers(voucherDenom(storedTrace), escrowAddress, amount)
+			}, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			holders = []sdk.AccAddress{suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress()}
+
+			tc.malleate()
+
+			app := suite.chainA.GetSimApp()
+			ctx := suite.chainA.GetContext()
+
+			app.TransferKeeper.SetDenomTrace(ctx, storedTrace)
+			denom := voucherDenom(storedTrace)
+			for _, holder := range holders {
+				suite.fundVouchers(denom, holder, amount)
+			}
+
+			correctedDenom := correctedTrace.IBCDenom()
+			correctedSupply := app.BankKeeper.GetSupply(ctx, correctedDenom).Amount
+			balances := make([]sdk.Int, len(holders))
+			for i, holder := range holders {
+				balances[i] = app.BankKeeper.GetBalance(ctx, holder, correctedDenom).Amount
+			}
+
+			correction := types.NewDenomTraceCorrection(storedTrace.Hash().String(), correctedTrace)
+			err := app.TransferKeeper.CorrectDenomTraces(ctx, []types.DenomTraceCorrection{correction})
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			trace, found := app.TransferKeeper.GetDenomTrace(ctx, correctedTrace.Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(correctedTrace, trace)
+
+			if !tc.merged {
+				// the vouchers are left untouched
+				suite.Require().Equal(denom, correctedDenom)
+				return
+			}
+
+			suite.Require().False(app.TransferKeeper.HasDenomTrace(ctx, storedTrace.Hash()))
+			suite.Require().True(app.BankKeeper.GetSupply(ctx, denom).IsZero())
+			suite.Require().Equal(correctedSupply.Add(amount.MulRaw(int64(len(holders)))), app.BankKeeper.GetSupply(ctx, correctedDenom).Amount)
+			for i, holder := range holders {
+				suite.Require().True(app.BankKeeper.GetBalance(ctx, holder, denom).IsZero())
+				suite.Require().Equal(balances[i].Add(amount), app.BankKeeper.GetBalance(ctx, holder, correctedDenom).Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCorrectDenomTracesNotFound() {
+	trace := types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	correction := types.NewDenomTraceCorrection(trace.Hash().String(), trace)
+
+	err := suite.chainA.GetSimApp().TransferKeeper.CorrectDenomTraces(suite.chainA.GetContext(), []types.DenomTraceCorrection{correction})
+	suite.Require().ErrorIs(err, types.ErrTraceNotFound)
+}
+
+// fundVouchers mints the given amount of vouchers to the provided address on chainA.
+func (suite *KeeperTestSuite) fundVouchers(denom string, address sdk.AccAddress, amount sdk.Int) {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins))
+}
+
+// voucherDenom returns the denomination of the vouchers of a trace, which may be malformed.
+func voucherDenom(trace types.DenomTrace) string {
+	return types.DenomPrefix + "/" + trace.Hash().String()
+}
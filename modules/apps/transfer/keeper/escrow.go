@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// SetEscrowYieldHooks sets the optional escrow yield hooks on the transfer keeper.
+// It must be called before the keeper is passed to the transfer IBC module and
+// panics if the hooks have already been set.
+func (k *Keeper) SetEscrowYieldHooks(hooks types.EscrowYieldHooks) *Keeper {
+	if k.escrowYieldHooks != nil {
+		panic("cannot set escrow yield hooks twice")
+	}
+
+	k.escrowYieldHooks = hooks
+	return k
+}
+
+// unescrowToken sends the token from the escrow account to the receiver and subtracts
+// it from the total escrow of its denomination. If the escrow account does not hold
+// enough funds because part of them have been deployed by the escrow yield hooks, the
+// shortfall is recalled first.
+func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
+	if k.escrowYieldHooks != nil {
+		balance := k.bankKeeper.GetBalance(ctx, escrowAddress, token.Denom)
+		if balance.IsLT(token) {
+			shortfall := token.Sub(balance)
+			if err := k.escrowYieldHooks.RecallEscrow(ctx, escrowAddress, shortfall); err != nil {
+				return sdkerrors.Wrapf(types.ErrEscrowRecall, "failed to recall %s to escrow account %s: %s", shortfall, escrowAddress, err)
+			}
+
+			// the hooks must honour the guarantee that recalled funds are available immediately
+			if k.bankKeeper.GetBalance(ctx, escrowAddress, token.Denom).IsLT(token) {
+				return sdkerrors.Wrapf(types.ErrEscrowRecall, "escrow account %s holds less than %s after recall", escrowAddress, token)
+			}
+		}
+	}
+
+	if err := k.decreaseTotalEscrow(ctx, token); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token))
+}
+
+// EscrowToken sends the token from the sender to the escrow account and adds it to the total
+// escrow of its denomination. Modules returning tokens to the escrow accounts on behalf of the
+// transfer module, such as the packet forward middleware, must use it to keep the total escrow
+// in sync with the escrow balances.
+func (k Keeper) EscrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
+	// it fails if the balance is insufficient
+	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	k.increaseTotalEscrow(ctx, token)
+	return nil
+}
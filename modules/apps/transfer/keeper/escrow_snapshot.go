@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// SnapshotEscrowBalances stores a snapshot of the escrow balance of every open or closed channel
+// bound to the transfer port if the current height is a multiple of the escrow snapshot
+// interval parameter, and prunes the snapshots which have been kept for the number of blocks
+// defined by the escrow snapshot retention parameter. It is called at the end of every block.
+func (k Keeper) SnapshotEscrowBalances(ctx sdk.Context) {
+	interval := k.GetEscrowSnapshotInterval(ctx)
+	height := uint64(ctx.BlockHeight())
+	if interval == 0 || height%interval != 0 {
+		return
+	}
+
+	retention := k.GetEscrowSnapshotRetention(ctx)
+	portID := k.GetPort(ctx)
+
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		// channels which never opened do not hold escrowed funds
+		if channel.PortId != portID || channel.State == channeltypes.INIT || channel.State == channeltypes.TRYOPEN {
+			return false
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		k.SetEscrowSnapshot(ctx, channel.PortId, channel.ChannelId, types.EscrowSnapshot{
+			Height:  height,
+			Time:    ctx.BlockTime(),
+			Balance: k.bankKeeper.GetAllBalances(ctx, escrowAddress),
+		})
+
+		if retention != 0 && height >= retention {
+			k.pruneEscrowSnapshots(ctx, channel.PortId, channel.ChannelId, height-retention)
+		}
+
+		return false
+	})
+}
+
+// SetEscrowSnapshot stores the provided escrow snapshot of the given channel.
+func (k Keeper) SetEscrowSnapshot(ctx sdk.Context, portID, channelID string, snapshot types.EscrowSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.KeyEscrowSnapshot(portID, channelID, snapshot.Height), bz)
+}
+
+// GetEscrowSnapshot returns the escrow snapshot of the given channel taken at the provided
+// height.
+func (k Keeper) GetEscrowSnapshot(ctx sdk.Context, portID, channelID string, height uint64) (types.EscrowSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEscrowSnapshot(portID, channelID, height))
+	if bz == nil {
+		return types.EscrowSnapshot{}, false
+	}
+
+	var snapshot types.EscrowSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// MustUnmarshalEscrowSnapshot attempts to decode and return an EscrowSnapshot object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalEscrowSnapshot(bz []byte) types.EscrowSnapshot {
+	var snapshot types.EscrowSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot
+}
+
+// pruneEscrowSnapshots deletes the escrow snapshots of the given channel taken at heights up to
+// and including the cutoff height.
+func (k Keeper) pruneEscrowSnapshots(ctx sdk.Context, portID, channelID string, cutoff uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyEscrowSnapshotChannel(portID, channelID), types.KeyEscrowSnapshot(portID, channelID, cutoff+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
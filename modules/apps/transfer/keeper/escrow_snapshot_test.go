@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSnapshotEscrowBalances() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	app := suite.chainA.GetSimApp()
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	// a channel which never opened is not snapshotted
+	pending := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(pending)
+	suite.Require().NoError(pending.EndpointA.ChanOpenInit())
+
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
+	escrowed := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	suite.Require().NoError(app.BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), escrowAddress, escrowed))
+
+	snapshot := func(height int64) {
+		app.TransferKeeper.SnapshotEscrowBalances(suite.chainA.GetContext().WithBlockHeight(height))
+	}
+
+	hasSnapshot := func(height uint64) bool {
+		_, found := app.TransferKeeper.GetEscrowSnapshot(suite.chainA.GetContext(), portID, channelID, height)
+		return found
+	}
+
+	// escrow snapshots are disabled by default
+	snapshot(10)
+	suite.Require().False(hasSnapshot(10))
+
+	params := app.TransferKeeper.GetParams(suite.chainA.GetContext())
+	params.EscrowSnapshotInterval = 10
+	params.EscrowSnapshotRetention = 20
+	app.TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// snapshots are only taken at multiples of the interval
+	snapshot(15)
+	suite.Require().False(hasSnapshot(15))
+
+	for height := int64(10); height <= 30; height += 10 {
+		snapshot(height)
+	}
+
+	escrowSnapshot, found := app.TransferKeeper.GetEscrowSnapshot(suite.chainA.GetContext(), portID, channelID, 20)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(20), escrowSnapshot.Height)
+	suite.Require().Equal(escrowed, escrowSnapshot.Balance)
+
+	_, found = app.TransferKeeper.GetEscrowSnapshot(suite.chainA.GetContext(), pending.EndpointA.ChannelConfig.PortID, pending.EndpointA.ChannelID, 20)
+	suite.Require().False(found)
+
+	// snapshots kept for the retention period are pruned
+	snapshot(40)
+	suite.Require().False(hasSnapshot(10))
+	suite.Require().False(hasSnapshot(20))
+	suite.Require().True(hasSnapshot(30))
+	suite.Require().True(hasSnapshot(40))
+
+	// snapshots are kept indefinitely without a retention period
+	params.EscrowSnapshotRetention = 0
+	app.TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	snapshot(100)
+	suite.Require().True(hasSnapshot(30))
+	suite.Require().True(hasSnapshot(100))
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowSnapshots() {
+	var (
+		req          *types.QueryEscrowSnapshotsRequest
+		expSnapshots []types.EscrowSnapshot
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"no snapshots",
+			func() {
+				req.ChannelId = ibctesting.FirstChannelID
+				expSnapshots = []types.EscrowSnapshot{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				app := suite.chainA.GetSimApp()
+				for height := uint64(1); height <= 3; height++ {
+					snapshot := types.EscrowSnapshot{
+						Height:  height,
+						Time:    suite.chainA.GetContext().BlockTime().UTC(),
+						Balance: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(height))),
+					}
+					app.TransferKeeper.SetEscrowSnapshot(suite.chainA.GetContext(), req.PortId, req.ChannelId, snapshot)
+					expSnapshots = append(expSnapshots, snapshot)
+				}
+
+				// snapshots of other channels are not returned
+				app.TransferKeeper.SetEscrowSnapshot(suite.chainA.GetContext(), req.PortId, "channel-10", types.EscrowSnapshot{Height: 1})
+
+				// the latest snapshots are listed first in reverse order
+				req.Pagination = &query.PageRequest{Limit: 2, Reverse: true}
+				expSnapshots = []types.EscrowSnapshot{expSnapshots[2], expSnapshots[1]}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryEscrowSnapshotsRequest{
+				PortId:    types.PortID,
+				ChannelId: "channel-1",
+			}
+			expSnapshots = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.EscrowSnapshots(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSnapshots, res.Snapshots)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+var (
+	_ types.EscrowYieldHooks    = &mockEscrowYieldHooks{}
+	_ types.EscrowYieldReporter = &mockEscrowYieldHooks{}
+)
+
+// mockEscrowYieldHooks deploys funds from escrow accounts to a strategy account, keeping
+// track of the funds deployed from each escrow account, and returns them to the escrow
+// account when recalled. If shortfall is set, one token less than requested is returned.
+type mockEscrowYieldHooks struct {
+	bankKeeper bankkeeper.Keeper
+	strategy   sdk.AccAddress
+	deployed   map[string]sdk.Coins
+	recallErr  error
+	shortfall  bool
+}
+
+func newMockEscrowYieldHooks(bankKeeper bankkeeper.Keeper, strategy sdk.AccAddress) *mockEscrowYieldHooks {
+	return &mockEscrowYieldHooks{
+		bankKeeper: bankKeeper,
+		strategy:   strategy,
+		deployed:   make(map[string]sdk.Coins),
+	}
+}
+
+// deploy sends the given amount from the escrow account to the strategy account.
+func (h *mockEscrowYieldHooks) deploy(ctx sdk.Context, escrowAddress sdk.AccAddress, amount sdk.Coin) error {
+	if err := h.bankKeeper.SendCoins(ctx, escrowAddress, h.strategy, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	h.deployed[escrowAddress.String()] = h.deployed[escrowAddress.String()].Add(amount)
+	return nil
+}
+
+func (h *mockEscrowYieldHooks) RecallEscrow(ctx sdk.Context, escrowAddress sdk.AccAddress, amount sdk.Coin) error {
+	if h.recallErr != nil {
+		return h.recallErr
+	}
+
+	if h.shortfall {
+		amount = amount.SubAmount(sdk.OneInt())
+	}
+
+	if err := h.bankKeeper.SendCoins(ctx, h.strategy, escrowAddress, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	h.deployed[escrowAddress.String()] = h.deployed[escrowAddress.String()].Sub(sdk.NewCoins(amount))
+	return nil
+}
+
+func (h *mockEscrowYieldHooks) DeployedEscrow(ctx sdk.Context, escrowAddress sdk.AccAddress) sdk.Coins {
+	return h.deployed[escrowAddress.String()]
+}
+
+func (suite *KeeperTestSuite) TestUnescrowWithEscrowYieldHooks() {
+	var (
+		hooks  *mockEscrowYieldHooks
+		amount sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"escrow holds enough funds, no recall needed", func() {
+			hooks.recallErr = fmt.Errorf("recall must not be invoked")
+			amount = sdk.NewInt(40)
+		}, true},
+		{"deployed funds are recalled", func() {}, true},
+		{"recall fails", func() {
+			hooks.recallErr = fmt.Errorf("strategy is locked")
+		}, false},
+		{"recall does not return enough funds", func() {
+			hooks.shortfall = true
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			app := suite.chainA.GetSimApp()
+			ctx := suite.chainA.GetContext()
+			amount = sdk.NewInt(100)
+
+			// half of the escrowed funds are deployed to the strategy
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(simapp.FundAccount(app, ctx, escrow, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+			app.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+			hooks = newMockEscrowYieldHooks(app.BankKeeper, sdk.AccAddress(crypto.AddressHash([]byte("strategy"))))
+			suite.Require().NoError(hooks.deploy(ctx, escrow, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))))
+			app.TransferKeeper.SetEscrowYieldHooks(hooks)
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			preCoin := app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			err := app.TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+
+			postCoin := app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(amount, postCoin.Amount.Sub(preCoin.Amount))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, types.ErrEscrowRecall)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariantWithEscrowYieldHooks() {
+	var hooks *mockEscrowYieldHooks
+
+	testCases := []struct {
+		msg       string
+		malleate  func(escrow sdk.AccAddress)
+		expBroken bool
+	}{
+		{"deployed funds are reported", func(escrow sdk.AccAddress) {
+			suite.chainA.GetSimApp().TransferKeeper.SetEscrowYieldHooks(hooks)
+		}, false},
+		{"strategy loses deployed funds", func(escrow sdk.AccAddress) {
+			suite.chainA.GetSimApp().TransferKeeper.SetEscrowYieldHooks(hooks)
+			hooks.deployed[escrow.String()] = hooks.deployed[escrow.String()].Sub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
+		}, true},
+		{"deployed funds are reported for another escrow account", func(escrow sdk.AccAddress) {
+			suite.chainA.GetSimApp().TransferKeeper.SetEscrowYieldHooks(hooks)
+			other := types.GetEscrowAddress(ibctesting.TransferPort, "channel-100")
+			hooks.deployed[other.String()], hooks.deployed[escrow.String()] = hooks.deployed[escrow.String()], nil
+		}, true},
+		{"deployed funds are not reported without hooks", func(escrow sdk.AccAddress) {}, true},
+		{"invariant is not checked for hooks which do not report deployed funds", func(escrow sdk.AccAddress) {
+			suite.chainA.GetSimApp().TransferKeeper.SetEscrowYieldHooks(struct{ types.EscrowYieldHooks }{hooks})
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			app := suite.chainA.GetSimApp()
+			ctx := suite.chainA.GetContext()
+
+			// half of the escrowed funds are deployed to the strategy
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(simapp.FundAccount(app, ctx, escrow, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+			app.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+			hooks = newMockEscrowYieldHooks(app.BankKeeper, sdk.AccAddress(crypto.AddressHash([]byte("strategy"))))
+			suite.Require().NoError(hooks.deploy(ctx, escrow, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))))
+
+			tc.malleate(escrow)
+
+			_, broken := keeper.TotalEscrowPerDenomInvariant(app.TransferKeeper)(ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
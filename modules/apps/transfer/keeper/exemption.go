@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsProtocolExempt returns true if the provided address is a protocol exempt account, i.e. it
+// is listed in the ProtocolExemptAccounts param or is the address of a module account named in
+// it. The transfers of protocol exempt accounts are exempt from the transfer fee and from the
+// quotas of the rate limiting middleware.
+func (k Keeper) IsProtocolExempt(ctx sdk.Context, address string) bool {
+	for _, account := range k.GetProtocolExemptAccounts(ctx) {
+		if account == address {
+			return true
+		}
+
+		// accounts which are not addresses name module accounts
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			moduleAddr := k.authKeeper.GetModuleAddress(account)
+			if moduleAddr != nil && moduleAddr.String() == address {
+				return true
+			}
+		}
+	}
+
+	return false
+}
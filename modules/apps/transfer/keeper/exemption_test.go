@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (suite *KeeperTestSuite) TestIsProtocolExempt() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+
+	address := sdk.AccAddress("keeper").String()
+	moduleAddress := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+
+	suite.Require().False(app.TransferKeeper.IsProtocolExempt(ctx, address))
+	suite.Require().False(app.TransferKeeper.IsProtocolExempt(ctx, moduleAddress))
+
+	params := app.TransferKeeper.GetParams(ctx)
+	params.ProtocolExemptAccounts = []string{address, distrtypes.ModuleName, "unknown"}
+	app.TransferKeeper.SetParams(ctx, params)
+
+	suite.Require().True(app.TransferKeeper.IsProtocolExempt(ctx, address))
+	suite.Require().True(app.TransferKeeper.IsProtocolExempt(ctx, moduleAddress))
+	suite.Require().False(app.TransferKeeper.IsProtocolExempt(ctx, suite.chainA.SenderAccount.GetAddress().String()))
+}
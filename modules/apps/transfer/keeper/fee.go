@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// retainFee transfers the fee defined by the FeeBasisPoints param from the payer to the
+// fee collector module account, or to the community pool if no fee collector is set. The
+// token amount remaining after the fee is returned. No fee is retained if the fee is
+// disabled, the payer is exempt or the fee rounds down to zero.
+func (k Keeper) retainFee(ctx sdk.Context, payer sdk.AccAddress, token sdk.Coin) (sdk.Coin, error) {
+	feeBasisPoints := k.GetFeeBasisPoints(ctx)
+	if feeBasisPoints == 0 || k.isFeeExempt(ctx, payer) {
+		return token, nil
+	}
+
+	feeAmount := token.Amount.MulRaw(int64(feeBasisPoints)).QuoRaw(types.MaxFeeBasisPoints)
+	if feeAmount.IsZero() {
+		return token, nil
+	}
+
+	fee := sdk.NewCoin(token.Denom, feeAmount)
+	feeCollector := k.GetFeeCollector(ctx)
+
+	if feeCollector == "" {
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), payer); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else {
+		if k.authKeeper.GetModuleAddress(feeCollector) == nil {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFeeCollector, "module account %s does not exist", feeCollector)
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, feeCollector, sdk.NewCoins(fee)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeRetained,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, feeCollector),
+			sdk.NewAttribute(types.AttributeKeyAmount, fee.String()),
+		),
+	)
+
+	return token.Sub(fee), nil
+}
+
+// isFeeExempt returns true if the provided address is included in the FeeExemptAddresses param
+// or is a protocol exempt account.
+func (k Keeper) isFeeExempt(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, exempt := range k.GetFeeExemptAddresses(ctx) {
+		if exempt == address.String() {
+			return true
+		}
+	}
+
+	return k.IsProtocolExempt(ctx, address.String())
+}
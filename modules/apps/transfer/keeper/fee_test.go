@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TestSendTransferFeeRetention tests that the fee is retained from the sender on SendTransfer
+// and only the remaining amount is escrowed and sent in the packet.
+func (suite *KeeperTestSuite) TestSendTransferFeeRetention() {
+	var params types.Params
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expFee      sdk.Int
+		expPass     bool
+		toCommunity bool
+	}{
+		{"fee is sent to the community pool", func() {}, sdk.NewInt(10), true, true},
+		{"fee is sent to the fee collector module account", func() {
+			params.FeeCollector = minttypes.ModuleName
+		}, sdk.NewInt(10), true, false},
+		{"fee rounds down to zero", func() {
+			params.FeeBasisPoints = 9
+		}, sdk.ZeroInt(), true, true},
+		{"sender is exempt", func() {
+			params.FeeExemptAddresses = []string{suite.chainA.SenderAccount.GetAddress().String()}
+		}, sdk.ZeroInt(), true, true},
+		{"sender is a protocol exempt account", func() {
+			params.ProtocolExemptAccounts = []string{suite.chainA.SenderAccount.GetAddress().String()}
+		}, sdk.ZeroInt(), true, true},
+		{"fee collector module account does not exist", func() {
+			params.FeeCollector = "unknown"
+		}, sdk.ZeroInt(), false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			app := suite.chainA.GetSimApp()
+			sender := suite.chainA.SenderAccount.GetAddress()
+
+			// 1% fee
+			params = app.TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.FeeBasisPoints = 100
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			app.TransferKeeper.SetParams(ctx, params)
+
+			communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+			collectorBefore := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(minttypes.ModuleName), sdk.DefaultBondDenom).Amount
+
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+			err := app.TransferKeeper.SendTransfer(
+				ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
+				sender, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().Equal(amount.Amount.Sub(tc.expFee), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+
+			packetData := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.Amount.Sub(tc.expFee).String(), sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+			commitment := app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().Equal(channeltypes.CommitPacket(app.AppCodec(), packet), commitment)
+
+			communityPoolFee := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom).Sub(communityPoolBefore)
+			if tc.toCommunity {
+				suite.Require().True(tc.expFee.ToDec().Equal(communityPoolFee))
+			} else {
+				suite.Require().True(communityPoolFee.IsZero())
+				suite.Require().Equal(collectorBefore.Add(tc.expFee), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(minttypes.ModuleName), sdk.DefaultBondDenom).Amount)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketFeeRetention tests that the fee is retained from the receiver of minted
+// vouchers on OnRecvPacket.
+func (suite *KeeperTestSuite) TestOnRecvPacketFeeRetention() {
+	suite.SetupTest() // reset
+
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	app := suite.chainB.GetSimApp()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	params := app.TransferKeeper.GetParams(suite.chainB.GetContext())
+	params.FeeBasisPoints = 250
+	app.TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	ctx := suite.chainB.GetContext()
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+
+	receivedDenom, err := app.TransferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().Equal(voucherDenom, receivedDenom)
+	suite.Require().Equal(sdk.NewInt(975), app.BankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+	suite.Require().Equal(sdk.NewDec(25), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(voucherDenom))
+
+	// the fee may be disabled again
+	params.FeeBasisPoints = 0
+	app.TransferKeeper.SetParams(ctx, params)
+
+	packet.Sequence = 2
+	_, err = app.TransferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1975), app.BankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+}
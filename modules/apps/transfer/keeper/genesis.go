@@ -29,6 +29,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	k.SetParams(ctx, state.Params)
 
+	for _, moduleAccounts := range state.CounterpartyModuleAccounts {
+		k.SetCounterpartyModuleAccounts(ctx, moduleAccounts)
+	}
+
+	for _, escrowed := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, escrowed)
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetTransferAccount(ctx)
 	if moduleAcc == nil {
@@ -42,5 +50,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		PortId:      k.GetPort(ctx),
 		DenomTraces: k.GetAllDenomTraces(ctx),
 		Params:      k.GetParams(ctx),
+
+		CounterpartyModuleAccounts: k.GetAllCounterpartyModuleAccounts(ctx),
+		TotalEscrowed:              k.GetAllTotalEscrowed(ctx),
 	}
 }
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
 
@@ -28,10 +30,18 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
 	}
 
+	moduleAccounts := types.NewCounterpartyModuleAccounts(types.PortID, "channel-0", []string{"cosmos1module"})
+	suite.chainA.GetSimApp().TransferKeeper.SetCounterpartyModuleAccounts(suite.chainA.GetContext(), moduleAccounts)
+
+	escrowed := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrowed)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal([]types.CounterpartyModuleAccounts{moduleAccounts}, genesis.CounterpartyModuleAccounts)
+	suite.Require().Equal(sdk.NewCoins(escrowed), genesis.TotalEscrowed)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
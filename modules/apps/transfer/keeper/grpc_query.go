@@ -7,11 +7,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -52,7 +53,7 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	traces := types.Traces{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceKey)
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
 		result, err := q.UnmarshalDenomTrace(value)
 		if err != nil {
 			return err
@@ -67,7 +68,7 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}
 
 	return &types.QueryDenomTracesResponse{
-		DenomTraces: traces.Sort(),
+		DenomTraces: traces,
 		Pagination:  pageRes,
 	}, nil
 }
@@ -94,6 +95,15 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// a trace without any port and channel identifiers refers to a native denomination
+	// which is never represented as an IBC voucher
+	if denomTrace.Path == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "trace %s does not contain any port and channel identifiers", req.Trace).Error(),
+		)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	denomHash := denomTrace.Hash()
 	found := q.HasDenomTrace(ctx, denomHash)
@@ -105,6 +115,134 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 	}
 
 	return &types.QueryDenomHashResponse{
-		Hash: denomHash.String(),
+		Hash:     denomHash.String(),
+		IbcDenom: denomTrace.IBCDenom(),
+	}, nil
+}
+
+// EscrowSnapshots implements the Query/EscrowSnapshots gRPC method
+func (q Keeper) EscrowSnapshots(c context.Context, req *types.QueryEscrowSnapshotsRequest) (*types.QueryEscrowSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshots := []types.EscrowSnapshot{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyEscrowSnapshotChannel(req.PortId, req.ChannelId))
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var snapshot types.EscrowSnapshot
+		if err := q.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowSnapshotsResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomOrigin implements the Query/DenomOrigin gRPC method
+func (q Keeper) DenomOrigin(c context.Context, req *types.QueryDenomOriginRequest) (*types.QueryDenomOriginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := q.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	hops := q.GetDenomHops(ctx, denomTrace)
+
+	var originChainID string
+	if len(hops) > 0 {
+		originChainID = hops[len(hops)-1].CounterpartyChainId
+	}
+
+	return &types.QueryDenomOriginResponse{
+		DenomTrace:    denomTrace,
+		Hops:          hops,
+		OriginChainId: originChainID,
+	}, nil
+}
+
+// CounterpartyModuleAccounts implements the Query/CounterpartyModuleAccounts gRPC method
+func (q Keeper) CounterpartyModuleAccounts(c context.Context, req *types.QueryCounterpartyModuleAccountsRequest) (*types.QueryCounterpartyModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	moduleAccounts, _ := q.GetCounterpartyModuleAccounts(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryCounterpartyModuleAccountsResponse{
+		Addresses: moduleAccounts.Addresses,
+	}, nil
+}
+
+// PrecomputeChannel implements the Query/PrecomputeChannel gRPC method
+func (q Keeper) PrecomputeChannel(c context.Context, req *types.QueryPrecomputeChannelRequest) (*types.QueryPrecomputeChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res, err := q.PrecomputeNextChannel(ctx, req.PortId, req.Denoms, req.CounterpartyDenoms, req.CounterpartyPortId, req.CounterpartyChannelId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+// TotalEscrowForDenom implements the Query/TotalEscrowForDenom gRPC method
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: q.GetTotalEscrowForDenom(ctx, req.Denom),
 	}, nil
 }
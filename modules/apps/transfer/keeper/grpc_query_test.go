@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryDenomTrace() {
@@ -113,11 +116,32 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 			},
 			true,
 		},
+		{
+			"success with reverse pagination",
+			func() {
+				expTraces = append(expTraces, types.DenomTrace{Path: "", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channelToB", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channelToA/transfer/channelToB", BaseDenom: "uatom"})
+
+				for _, trace := range expTraces {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+				}
+
+				req = &types.QueryDenomTracesRequest{
+					Pagination: &query.PageRequest{
+						Limit:   5,
+						Reverse: true,
+					},
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expTraces = nil
 
 			tc.malleate()
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
@@ -125,9 +149,18 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 			res, err := suite.queryClient.DenomTraces(ctx, req)
 
 			if tc.expPass {
+				// denom traces are returned in the order of their hashes in the store
+				sort.Slice(expTraces, func(i, j int) bool {
+					cmp := bytes.Compare(expTraces[i].Hash(), expTraces[j].Hash())
+					if req.Pagination != nil && req.Pagination.Reverse {
+						return cmp > 0
+					}
+					return cmp < 0
+				})
+
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+				suite.Require().Equal(expTraces, res.DenomTraces)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -168,6 +201,15 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 			},
 			false,
 		},
+		{
+			"native denom without trace",
+			func() {
+				req = &types.QueryDenomHashRequest{
+					Trace: "uatom",
+				}
+			},
+			false,
+		},
 		{
 			"not found denom trace",
 			func() {
@@ -202,9 +244,194 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expHash, res.Hash)
+				suite.Require().Equal(reqTrace.IBCDenom(), res.IbcDenom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomOrigin() {
+	var (
+		req       *types.QueryDenomOriginRequest
+		expTrace  types.DenomTrace
+		expHops   []types.DenomHop
+		expOrigin string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid hex hash",
+			func() {
+				req = &types.QueryDenomOriginRequest{
+					Hash: "!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"not found denom trace",
+			func() {
+				expTrace = types.ParseDenomTrace("transfer/channel-0/uatom")
+				req = &types.QueryDenomOriginRequest{
+					Hash: expTrace.Hash().String(),
+				}
+			},
+			false,
+		},
+		{
+			"single hop",
+			func() {
+				path := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expTrace = types.ParseDenomTrace(fmt.Sprintf("%s/%s/uatom", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				expHops = []types.DenomHop{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, ChainId: suite.chainA.ChainID, CounterpartyChainId: suite.chainB.ChainID},
+				}
+				expOrigin = suite.chainB.ChainID
+			},
+			true,
+		},
+		{
+			"multiple hops",
+			func() {
+				path := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expTrace = types.ParseDenomTrace(fmt.Sprintf("%s/%s/transfer/channel-5/transfer/channel-7/uatom", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				expHops = []types.DenomHop{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, ChainId: suite.chainA.ChainID, CounterpartyChainId: suite.chainB.ChainID},
+					{PortId: "transfer", ChannelId: "channel-5", ChainId: suite.chainB.ChainID},
+					{PortId: "transfer", ChannelId: "channel-7"},
+				}
+				expOrigin = ""
+			},
+			true,
+		},
+		{
+			"hops going through this chain again",
+			func() {
+				path := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// the client of the channel tracks this chain, so that the tokens went through it again
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.ChainId = suite.chainA.ChainID
+				path.EndpointA.SetClientState(clientState)
+
+				expTrace = types.ParseDenomTrace(fmt.Sprintf("%[1]s/%[2]s/%[1]s/%[2]s/transfer/channel-7/uatom", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				expHops = []types.DenomHop{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, ChainId: suite.chainA.ChainID, CounterpartyChainId: suite.chainA.ChainID},
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, ChainId: suite.chainA.ChainID, CounterpartyChainId: suite.chainA.ChainID},
+					{PortId: "transfer", ChannelId: "channel-7", ChainId: suite.chainA.ChainID},
+				}
+				expOrigin = ""
+			},
+			true,
+		},
+		{
+			"channel of the first hop not found",
+			func() {
+				expTrace = types.ParseDenomTrace("transfer/channel-9/uatom")
+				expHops = []types.DenomHop{
+					{PortId: "transfer", ChannelId: "channel-9", ChainId: suite.chainA.ChainID},
+				}
+				expOrigin = ""
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			if tc.expPass {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+				req = &types.QueryDenomOriginRequest{
+					Hash: expTrace.Hash().String(),
+				}
+			}
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomOrigin(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTrace, res.DenomTrace)
+				suite.Require().Equal(expHops, res.Hops)
+				suite.Require().Equal(expOrigin, res.OriginChainId)
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryCounterpartyModuleAccounts() {
+	moduleAccounts := types.NewCounterpartyModuleAccounts(types.PortID, "channel-0", []string{"cosmos1module"})
+	suite.chainA.GetSimApp().TransferKeeper.SetCounterpartyModuleAccounts(suite.chainA.GetContext(), moduleAccounts)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.queryClient.CounterpartyModuleAccounts(ctx, &types.QueryCounterpartyModuleAccountsRequest{PortId: types.PortID, ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(moduleAccounts.Addresses, res.Addresses)
+
+	// no module accounts are registered for the channel
+	res, err = suite.queryClient.CounterpartyModuleAccounts(ctx, &types.QueryCounterpartyModuleAccountsRequest{PortId: types.PortID, ChannelId: "channel-1"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+
+	_, err = suite.queryClient.CounterpartyModuleAccounts(ctx, &types.QueryCounterpartyModuleAccountsRequest{PortId: types.PortID, ChannelId: ""})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryPrecomputeChannel() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.queryClient.PrecomputeChannel(ctx, &types.QueryPrecomputeChannelRequest{
+		PortId:             types.PortID,
+		CounterpartyDenoms: []string{"uatom"},
+	})
+	suite.Require().NoError(err)
+
+	channelID := fmt.Sprintf("channel-%d", suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext()))
+	suite.Require().Equal(channelID, res.ChannelId)
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, channelID).String(), res.EscrowAddress)
+	suite.Require().Empty(res.SendDenoms)
+	suite.Require().Len(res.ReceiveDenoms, 1)
+
+	_, err = suite.queryClient.PrecomputeChannel(ctx, &types.QueryPrecomputeChannelRequest{PortId: types.PortID, Denoms: []string{"uatom"}})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryTotalEscrowForDenom() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+
+	res, err := suite.queryClient.TotalEscrowForDenom(ctx, &types.QueryTotalEscrowForDenomRequest{Denom: sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin, res.Amount)
+
+	res, err = suite.queryClient.TotalEscrowForDenom(ctx, &types.QueryTotalEscrowForDenomRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Amount.IsZero())
+
+	_, err = suite.queryClient.TotalEscrowForDenom(ctx, &types.QueryTotalEscrowForDenomRequest{Denom: "!invalid"})
+	suite.Require().Error(err)
+}
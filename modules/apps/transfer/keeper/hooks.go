@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// SetTransferHooks sets the optional transfer hooks on the transfer keeper.
+// It must be called before the keeper is passed to the transfer IBC module and
+// panics if the hooks have already been set.
+func (k *Keeper) SetTransferHooks(hooks types.TransferHooks) *Keeper {
+	if k.transferHooks != nil {
+		panic("cannot set transfer hooks twice")
+	}
+
+	k.transferHooks = hooks
+	return k
+}
+
+// afterRecvTransfer calls the AfterRecvTransfer hook if the transfer hooks are set.
+func (k Keeper) afterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, receivedDenom string) error {
+	if k.transferHooks == nil {
+		return nil
+	}
+
+	return k.transferHooks.AfterRecvTransfer(ctx, packet, data, receivedDenom)
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+var _ types.TransferHooks = &mockTransferHooks{}
+
+// mockTransferHooks records the received transfers and fails if err is set.
+type mockTransferHooks struct {
+	err           error
+	data          types.FungibleTokenPacketData
+	receivedDenom string
+}
+
+func (h *mockTransferHooks) AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, receivedDenom string) error {
+	if h.err != nil {
+		return h.err
+	}
+
+	h.data = data
+	h.receivedDenom = receivedDenom
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketWithTransferHooks() {
+	var hooks *mockTransferHooks
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"hooks are called with the memo", func() {}, true},
+		{"hooks fail", func() {
+			hooks.err = fmt.Errorf("invalid memo instructions")
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			app := suite.chainB.GetSimApp()
+			hooks = &mockTransferHooks{}
+			app.TransferKeeper.SetTransferHooks(hooks)
+
+			tc.malleate()
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "stake")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			_, err := app.TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(data, hooks.data)
+				suite.Require().Equal(voucherDenom, hooks.receivedDenom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers the transfer invariants with the crisis module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom", TotalEscrowPerDenomInvariant(k))
+}
+
+// TotalEscrowPerDenomInvariant checks that the escrow accounts of the transfer channels, together
+// with the funds reported as deployed from them by the escrow yield hooks, hold at least the total
+// amount escrowed for each denomination. The escrow accounts may hold more than the total, as anyone
+// can send tokens to them. The invariant is not checked if escrow yield hooks which do not implement
+// EscrowYieldReporter are set, since the funds they deploy are not held by the escrow accounts.
+func TotalEscrowPerDenomInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if _, ok := k.escrowYieldHooks.(types.EscrowYieldReporter); k.escrowYieldHooks != nil && !ok {
+			return sdk.FormatInvariant(types.ModuleName, "total-escrow-per-denom", "not checked, escrow yield hooks do not report deployed funds\n"), false
+		}
+
+		var (
+			msg    string
+			broken int
+		)
+
+		balances := k.getEscrowBalances(ctx)
+		k.IterateTotalEscrowed(ctx, func(total sdk.Coin) bool {
+			if balance := balances.AmountOf(total.Denom); balance.LT(total.Amount) {
+				broken++
+				msg += fmt.Sprintf("\tescrow accounts and deployed funds hold %s%s, less than the total escrow %s\n", balance, total.Denom, total)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-escrow-per-denom",
+			fmt.Sprintf("%d denominations escrowed for more than the escrow balances\n%s", broken, msg),
+		), broken != 0
+	}
+}
@@ -26,14 +26,19 @@ type Keeper struct {
 	portKeeper    types.PortKeeper
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	escrowYieldHooks types.EscrowYieldHooks
+	transferHooks    types.TransferHooks
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
 
 	// ensure ibc transfer module account is set
@@ -55,6 +60,7 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		scopedKeeper:  scopedKeeper,
 	}
 }
@@ -64,6 +70,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// GetICS4Wrapper returns the ICS4Wrapper the module sends packets with.
+func (k Keeper) GetICS4Wrapper() types.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
 // GetTransferAccount returns the ICS20 - transfers ModuleAccount
 func (k Keeper) GetTransferAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
@@ -94,6 +105,17 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
+// GetChannelVersion returns the version negotiated for the provided transfer channel. An
+// empty string is returned if the channel does not exist.
+func (k Keeper) GetChannelVersion(ctx sdk.Context, portID, channelID string) string {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return ""
+	}
+
+	return channel.Version
+}
+
 // GetDenomTrace retreives the full identifiers trace and base denomination from the store.
 func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
@@ -119,6 +141,12 @@ func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
 	store.Set(denomTrace.Hash(), bz)
 }
 
+// deleteDenomTrace deletes the denomination trace stored under the given hash.
+func (k Keeper) deleteDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store.Delete(denomTraceHash)
+}
+
 // GetAllDenomTraces returns the trace information for all the denominations.
 func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
 	traces := types.Traces{}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration sets the total amount escrowed for each denomination to the escrow balances
+// of the transfer channels, as the tokens escrowed before the total escrow was tracked could
+// not be unescrowed otherwise.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.MigrateTotalEscrowForDenom(ctx)
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// RegisterCounterpartyModuleAccounts registers the module accounts of the counterparty chain
+// of a transfer channel, replacing those previously registered for the channel. An empty list
+// of addresses removes the registration. It is called by the counterparty module accounts
+// proposal handler.
+func (k Keeper) RegisterCounterpartyModuleAccounts(ctx sdk.Context, moduleAccounts types.CounterpartyModuleAccounts) error {
+	if err := moduleAccounts.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, moduleAccounts.PortId, moduleAccounts.ChannelId); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", moduleAccounts.PortId, moduleAccounts.ChannelId)
+	}
+
+	if len(moduleAccounts.Addresses) == 0 {
+		k.deleteCounterpartyModuleAccounts(ctx, moduleAccounts.PortId, moduleAccounts.ChannelId)
+	} else {
+		k.SetCounterpartyModuleAccounts(ctx, moduleAccounts)
+	}
+
+	k.Logger(ctx).Info("counterparty module accounts registered", "port-id", moduleAccounts.PortId, "channel-id", moduleAccounts.ChannelId, "addresses", len(moduleAccounts.Addresses))
+
+	return nil
+}
+
+// ValidateReceiver returns an error if the receiver of a transfer over the provided channel is
+// a module account registered for the counterparty chain and the sender did not explicitly
+// allow it. Tokens sent to a module account by mistake cannot be recovered.
+func (k Keeper) ValidateReceiver(ctx sdk.Context, portID, channelID, receiver string, allowModuleAccount bool) error {
+	if allowModuleAccount {
+		return nil
+	}
+
+	moduleAccounts, found := k.GetCounterpartyModuleAccounts(ctx, portID, channelID)
+	if found && moduleAccounts.Contains(receiver) {
+		return sdkerrors.Wrapf(types.ErrModuleAccountReceiver, "%s must be explicitly allowed as receiver", receiver)
+	}
+
+	return nil
+}
+
+// GetCounterpartyModuleAccounts returns the module accounts registered for the counterparty
+// chain of the provided channel.
+func (k Keeper) GetCounterpartyModuleAccounts(ctx sdk.Context, portID, channelID string) (types.CounterpartyModuleAccounts, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCounterpartyModuleAccounts(portID, channelID))
+	if bz == nil {
+		return types.CounterpartyModuleAccounts{}, false
+	}
+
+	var moduleAccounts types.CounterpartyModuleAccounts
+	k.cdc.MustUnmarshal(bz, &moduleAccounts)
+	return moduleAccounts, true
+}
+
+// SetCounterpartyModuleAccounts stores the module accounts registered for the counterparty
+// chain of a channel.
+func (k Keeper) SetCounterpartyModuleAccounts(ctx sdk.Context, moduleAccounts types.CounterpartyModuleAccounts) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&moduleAccounts)
+	store.Set(types.KeyCounterpartyModuleAccounts(moduleAccounts.PortId, moduleAccounts.ChannelId), bz)
+}
+
+// deleteCounterpartyModuleAccounts deletes the module accounts registered for the counterparty
+// chain of the provided channel.
+func (k Keeper) deleteCounterpartyModuleAccounts(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCounterpartyModuleAccounts(portID, channelID))
+}
+
+// GetAllCounterpartyModuleAccounts returns the module accounts registered for the counterparty
+// chains of all channels.
+func (k Keeper) GetAllCounterpartyModuleAccounts(ctx sdk.Context) []types.CounterpartyModuleAccounts {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CounterpartyModuleAccountsKey)
+	defer iterator.Close()
+
+	var allModuleAccounts []types.CounterpartyModuleAccounts
+	for ; iterator.Valid(); iterator.Next() {
+		var moduleAccounts types.CounterpartyModuleAccounts
+		k.cdc.MustUnmarshal(iterator.Value(), &moduleAccounts)
+		allModuleAccounts = append(allModuleAccounts, moduleAccounts)
+	}
+
+	return allModuleAccounts
+}
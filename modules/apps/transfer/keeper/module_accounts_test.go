@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyModuleAccounts() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	keeper := suite.chainA.GetSimApp().TransferKeeper
+	ctx := suite.chainA.GetContext()
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	moduleAccount := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+
+	moduleAccounts := types.NewCounterpartyModuleAccounts(portID, channelID, []string{moduleAccount})
+	suite.Require().NoError(keeper.RegisterCounterpartyModuleAccounts(ctx, moduleAccounts))
+
+	stored, found := keeper.GetCounterpartyModuleAccounts(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(moduleAccounts, stored)
+	suite.Require().Equal([]types.CounterpartyModuleAccounts{moduleAccounts}, keeper.GetAllCounterpartyModuleAccounts(ctx))
+
+	// an empty list removes the registration
+	suite.Require().NoError(keeper.RegisterCounterpartyModuleAccounts(ctx, types.NewCounterpartyModuleAccounts(portID, channelID, nil)))
+	_, found = keeper.GetCounterpartyModuleAccounts(ctx, portID, channelID)
+	suite.Require().False(found)
+
+	// the channel must exist
+	err := keeper.RegisterCounterpartyModuleAccounts(ctx, types.NewCounterpartyModuleAccounts(portID, ibctesting.InvalidID, []string{moduleAccount}))
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelNotFound)
+
+	// duplicated addresses are rejected
+	err = keeper.RegisterCounterpartyModuleAccounts(ctx, types.NewCounterpartyModuleAccounts(portID, channelID, []string{moduleAccount, strings.ToUpper(moduleAccount)}))
+	suite.Require().ErrorIs(err, types.ErrInvalidModuleAccounts)
+}
+
+// TestTransferToCounterpartyModuleAccount tests that transfers to a module account registered
+// for the counterparty chain are only sent if the sender explicitly allows the receiver.
+func (suite *KeeperTestSuite) TestTransferToCounterpartyModuleAccount() {
+	var (
+		path     *ibctesting.Path
+		receiver string
+		allow    bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: receiver is not a module account", func() {
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+		}, true},
+		{"success: module account receiver explicitly allowed", func() {
+			allow = true
+		}, true},
+		{"success: module account registered for another channel", func() {
+			pathAC := NewTransferPath(suite.chainA, suite.chainC)
+			suite.coordinator.Setup(pathAC)
+			path = pathAC
+		}, true},
+		{"module account receiver not allowed", func() {}, false},
+		{"module account receiver with different case not allowed", func() {
+			receiver = strings.ToUpper(receiver)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			allow = false
+
+			moduleAccounts := types.NewCounterpartyModuleAccounts(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []string{receiver})
+			suite.Require().NoError(suite.chainA.GetSimApp().TransferKeeper.RegisterCounterpartyModuleAccounts(suite.chainA.GetContext(), moduleAccounts))
+
+			tc.malleate()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
+			msg.AllowModuleAccountReceiver = allow
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrModuleAccountReceiver)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnwindTransferToCounterpartyModuleAccount() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// transfer native tokens of chainB to chainA
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	receiver := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+	moduleAccounts := types.NewCounterpartyModuleAccounts(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []string{receiver})
+	suite.Require().NoError(suite.chainA.GetSimApp().TransferKeeper.RegisterCounterpartyModuleAccounts(suite.chainA.GetContext(), moduleAccounts))
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, coin.Amount)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	_, err = suite.chainA.GetSimApp().TransferKeeper.UnwindTransfer(ctx, types.NewMsgUnwindTransfer(voucher, suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.ZeroHeight(), 0))
+	suite.Require().ErrorIs(err, types.ErrModuleAccountReceiver)
+}
+
+// TestSendTransferToCounterpartyModuleAccount tests that the transfers sent by other modules
+// through SendTransfer, e.g. the packet forward middleware, are rejected if the receiver is a
+// registered module account unless it is allowed in the context.
+func (suite *KeeperTestSuite) TestSendTransferToCounterpartyModuleAccount() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	receiver := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+	moduleAccounts := types.NewCounterpartyModuleAccounts(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []string{receiver})
+	suite.Require().NoError(suite.chainA.GetSimApp().TransferKeeper.RegisterCounterpartyModuleAccounts(suite.chainA.GetContext(), moduleAccounts))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().False(types.IsModuleAccountReceiverAllowed(ctx))
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress(), receiver, clienttypes.NewHeight(0, 110), 0, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrModuleAccountReceiver)
+
+	ctx = types.WithModuleAccountReceiverAllowed(ctx)
+	suite.Require().True(types.IsModuleAccountReceiverAllowed(ctx))
+
+	err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress(), receiver, clienttypes.NewHeight(0, 110), 0, "",
+	)
+	suite.Require().NoError(err)
+}
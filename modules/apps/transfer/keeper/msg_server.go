@@ -20,8 +20,15 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	if err != nil {
 		return nil, err
 	}
+
+	// the receiver is validated by SendTransfer
+	sendCtx := ctx
+	if msg.AllowModuleAccountReceiver {
+		sendCtx = types.WithModuleAccountReceiverAllowed(ctx)
+	}
+
 	if err := k.SendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		sendCtx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
 	); err != nil {
 		return nil, err
 	}
@@ -33,6 +40,7 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 			types.EventTypeTransfer,
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -42,3 +50,45 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	return &types.MsgTransferResponse{}, nil
 }
+
+// UnwindTransfer defines a rpc handler method for MsgUnwindTransfer.
+func (k Keeper) UnwindTransfer(goCtx context.Context, msg *types.MsgUnwindTransfer) (*types.MsgUnwindTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort, sourceChannel, receiver, err := k.UnwindRoute(ctx, msg.Token.Denom, msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	// unwinding to a registered counterparty module account is not supported, such transfers
+	// must explicitly allow the receiver with a MsgTransfer
+	if err := k.SendTransfer(
+		ctx, sourcePort, sourceChannel, msg.Token, sender, receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, "",
+	); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC fungible token unwind transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver, "source-channel", sourceChannel)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgUnwindTransferResponse{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// GetDenomHops returns the hops of the provided denomination trace, from this chain towards
+// the origin chain of the base denomination. The whole trace path is walked: the chain ID of a
+// hop is the counterparty chain ID of the previous hop, and the counterparty chain ID of a hop
+// is resolved through the client state of its channel when the hop lives on this chain, e.g. the
+// first hop or the hops of tokens which went through this chain before. The chain IDs of the hops
+// following a hop on another chain cannot be resolved on this chain and are left empty.
+func (k Keeper) GetDenomHops(ctx sdk.Context, denomTrace types.DenomTrace) []types.DenomHop {
+	if denomTrace.Path == "" {
+		return []types.DenomHop{}
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+	hops := make([]types.DenomHop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		hops = append(hops, types.DenomHop{
+			PortId:    identifiers[i],
+			ChannelId: identifiers[i+1],
+		})
+	}
+
+	chainID := ctx.ChainID()
+	for i := range hops {
+		hops[i].ChainId = chainID
+
+		// only the channel ends of the hops on this chain can be resolved
+		if chainID != ctx.ChainID() {
+			chainID = ""
+			continue
+		}
+
+		hops[i].CounterpartyChainId = k.getCounterpartyChainID(ctx, hops[i].PortId, hops[i].ChannelId)
+		chainID = hops[i].CounterpartyChainId
+	}
+
+	return hops
+}
+
+// getCounterpartyChainID returns the chain ID of the counterparty chain of the provided channel
+// end, tracked by the client state of the channel, or an empty string if it cannot be resolved.
+func (k Keeper) getCounterpartyChainID(ctx sdk.Context, portID, channelID string) string {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return ""
+	}
+
+	// not every client type tracks the chain ID of its counterparty
+	cs, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return ""
+	}
+
+	return cs.GetChainID()
+}
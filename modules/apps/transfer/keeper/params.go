@@ -20,9 +20,96 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetReceiveDustThresholds retrieves the per denomination minimum receive amounts
+// from the paramstore. An empty set is returned if the parameter has not been set.
+func (k Keeper) GetReceiveDustThresholds(ctx sdk.Context) sdk.Coins {
+	var res sdk.Coins
+	k.paramSpace.GetIfExists(ctx, types.KeyReceiveDustThresholds, &res)
+	return res
+}
+
+// GetFeeBasisPoints retrieves the fee, in basis points, retained from transfers from the
+// paramstore. Zero is returned if the parameter has not been set.
+func (k Keeper) GetFeeBasisPoints(ctx sdk.Context) uint32 {
+	var res uint32
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeBasisPoints, &res)
+	return res
+}
+
+// GetFeeCollector retrieves the name of the module account receiving retained fees from
+// the paramstore. An empty string, meaning the community pool, is returned if the
+// parameter has not been set.
+func (k Keeper) GetFeeCollector(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeCollector, &res)
+	return res
+}
+
+// GetFeeExemptAddresses retrieves the addresses from which no fee is retained from the
+// paramstore.
+func (k Keeper) GetFeeExemptAddresses(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeExemptAddresses, &res)
+	return res
+}
+
+// GetDefaultTimeoutHeightOffset retrieves the number of blocks added to the latest height of
+// the counterparty client for transfers without timeouts from the paramstore. Zero is
+// returned if the parameter has not been set.
+func (k Keeper) GetDefaultTimeoutHeightOffset(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyDefaultTimeoutHeightOffset, &res)
+	return res
+}
+
+// GetDefaultTimeoutTimestampDuration retrieves the duration, in nanoseconds, added to the
+// block time for transfers without timeouts from the paramstore. Zero is returned if the
+// parameter has not been set.
+func (k Keeper) GetDefaultTimeoutTimestampDuration(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyDefaultTimeoutTimestampDuration, &res)
+	return res
+}
+
+// GetEscrowSnapshotInterval retrieves the number of blocks between two escrow snapshots from
+// the paramstore. Zero, meaning escrow snapshots are disabled, is returned if the parameter has
+// not been set.
+func (k Keeper) GetEscrowSnapshotInterval(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyEscrowSnapshotInterval, &res)
+	return res
+}
+
+// GetEscrowSnapshotRetention retrieves the number of blocks for which escrow snapshots are kept
+// from the paramstore. Zero, meaning escrow snapshots are kept indefinitely, is returned if the
+// parameter has not been set.
+func (k Keeper) GetEscrowSnapshotRetention(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyEscrowSnapshotRetention, &res)
+	return res
+}
+
+// GetProtocolExemptAccounts retrieves the addresses and module account names of the protocol
+// exempt accounts from the paramstore.
+func (k Keeper) GetProtocolExemptAccounts(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyProtocolExemptAccounts, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	params := types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	params.ReceiveDustThresholds = k.GetReceiveDustThresholds(ctx)
+	params.FeeBasisPoints = k.GetFeeBasisPoints(ctx)
+	params.FeeCollector = k.GetFeeCollector(ctx)
+	params.FeeExemptAddresses = k.GetFeeExemptAddresses(ctx)
+	params.DefaultTimeoutHeightOffset = k.GetDefaultTimeoutHeightOffset(ctx)
+	params.DefaultTimeoutTimestampDuration = k.GetDefaultTimeoutTimestampDuration(ctx)
+	params.EscrowSnapshotInterval = k.GetEscrowSnapshotInterval(ctx)
+	params.EscrowSnapshotRetention = k.GetEscrowSnapshotRetention(ctx)
+	params.ProtocolExemptAccounts = k.GetProtocolExemptAccounts(ctx)
+	return params
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
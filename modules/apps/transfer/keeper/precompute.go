@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// PrecomputeNextChannel returns the identifier and escrow address of the next channel opened on the provided port
+// given the current channel sequence, along with the vouchers resulting from transfers over that channel:
+// the vouchers minted on the counterparty chain for the provided denominations of this chain, which require the
+// identifiers of the counterparty channel end, and the vouchers minted on this chain for the provided counterparty
+// denominations. The results only hold if no other channel is opened on this chain before the planned one.
+func (k Keeper) PrecomputeNextChannel(
+	ctx sdk.Context,
+	portID string,
+	denoms,
+	counterpartyDenoms []string,
+	counterpartyPortID,
+	counterpartyChannelID string,
+) (*types.QueryPrecomputeChannelResponse, error) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return nil, err
+	}
+
+	channelID := channeltypes.FormatChannelIdentifier(k.channelKeeper.GetNextChannelSequence(ctx))
+
+	sendDenoms := make([]types.PrecomputedDenom, 0, len(denoms))
+	if len(denoms) > 0 {
+		if err := host.PortIdentifierValidator(counterpartyPortID); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid counterparty port ID")
+		}
+		if err := host.ChannelIdentifierValidator(counterpartyChannelID); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid counterparty channel ID")
+		}
+
+		for _, denom := range denoms {
+			fullDenomPath, err := k.getFullDenomPath(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+
+			sendDenoms = append(sendDenoms, newPrecomputedDenom(denom, counterpartyPortID, counterpartyChannelID, fullDenomPath))
+		}
+	}
+
+	receiveDenoms := make([]types.PrecomputedDenom, 0, len(counterpartyDenoms))
+	for _, denom := range counterpartyDenoms {
+		if err := types.ValidatePrefixedDenom(denom); err != nil {
+			return nil, err
+		}
+
+		receiveDenoms = append(receiveDenoms, newPrecomputedDenom(denom, portID, channelID, denom))
+	}
+
+	return &types.QueryPrecomputeChannelResponse{
+		ChannelId:     channelID,
+		EscrowAddress: types.GetEscrowAddress(portID, channelID).String(),
+		SendDenoms:    sendDenoms,
+		ReceiveDenoms: receiveDenoms,
+	}, nil
+}
+
+// getFullDenomPath returns the full denomination trace of the provided denomination of this chain,
+// resolving the trace of 'ibc/{hash}' vouchers.
+func (k Keeper) getFullDenomPath(ctx sdk.Context, denom string) (string, error) {
+	if err := types.ValidateIBCDenom(denom); err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(denom, types.DenomPrefix+"/") {
+		return denom, nil
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(denom, types.DenomPrefix+"/"))
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, denom)
+	}
+
+	return denomTrace.GetFullDenomPath(), nil
+}
+
+// newPrecomputedDenom returns the voucher of the provided denomination received over the provided channel end.
+func newPrecomputedDenom(denom, portID, channelID, fullDenomPath string) types.PrecomputedDenom {
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(portID, channelID, fullDenomPath))
+
+	return types.PrecomputedDenom{
+		Denom:    denom,
+		Trace:    denomTrace.GetFullDenomPath(),
+		IbcDenom: denomTrace.IBCDenom(),
+	}
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestPrecomputeNextChannel() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	// an IBC voucher of chainA, sent back to chainB over the new channel
+	voucherTrace := types.ParseDenomTrace("transfer/channel-5/uatom")
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), voucherTrace)
+
+	// the counterparty channel end is precomputed on chainB
+	resB, err := suite.chainB.GetSimApp().TransferKeeper.PrecomputeNextChannel(suite.chainB.GetContext(), types.PortID, nil, nil, "", "")
+	suite.Require().NoError(err)
+
+	resA, err := suite.chainA.GetSimApp().TransferKeeper.PrecomputeNextChannel(
+		suite.chainA.GetContext(), types.PortID,
+		[]string{sdk.DefaultBondDenom, voucherTrace.IBCDenom()},
+		[]string{"uosmo", "transfer/channel-3/uatom"},
+		types.PortID, resB.ChannelId,
+	)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CreateTransferChannels(path)
+
+	suite.Require().Equal(path.EndpointA.ChannelID, resA.ChannelId)
+	suite.Require().Equal(path.EndpointB.ChannelID, resB.ChannelId)
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, path.EndpointA.ChannelID).String(), resA.EscrowAddress)
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, path.EndpointB.ChannelID).String(), resB.EscrowAddress)
+
+	expSendTraces := []string{
+		fmt.Sprintf("%s/%s/%s", types.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+		fmt.Sprintf("%s/%s/transfer/channel-5/uatom", types.PortID, path.EndpointB.ChannelID),
+	}
+	suite.Require().Len(resA.SendDenoms, len(expSendTraces))
+	for i, expTrace := range expSendTraces {
+		suite.Require().Equal(expTrace, resA.SendDenoms[i].Trace)
+		suite.Require().Equal(types.ParseDenomTrace(expTrace).IBCDenom(), resA.SendDenoms[i].IbcDenom)
+	}
+	suite.Require().Equal(voucherTrace.IBCDenom(), resA.SendDenoms[1].Denom)
+
+	expReceiveTraces := []string{
+		fmt.Sprintf("%s/%s/uosmo", types.PortID, path.EndpointA.ChannelID),
+		fmt.Sprintf("%s/%s/transfer/channel-3/uatom", types.PortID, path.EndpointA.ChannelID),
+	}
+	suite.Require().Len(resA.ReceiveDenoms, len(expReceiveTraces))
+	for i, expTrace := range expReceiveTraces {
+		suite.Require().Equal(expTrace, resA.ReceiveDenoms[i].Trace)
+		suite.Require().Equal(types.ParseDenomTrace(expTrace).IBCDenom(), resA.ReceiveDenoms[i].IbcDenom)
+	}
+
+	// the precomputed voucher matches the voucher minted on chainB
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), resA.SendDenoms[0].IbcDenom)
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
+
+func (suite *KeeperTestSuite) TestPrecomputeNextChannelInvalid() {
+	testCases := []struct {
+		msg                   string
+		portID                string
+		denoms                []string
+		counterpartyDenoms    []string
+		counterpartyChannelID string
+	}{
+		{"invalid port identifier", "", nil, nil, ""},
+		{"sent denominations without counterparty channel", types.PortID, []string{sdk.DefaultBondDenom}, nil, ""},
+		{"invalid denomination", types.PortID, []string{"ibc/"}, nil, "channel-0"},
+		{"denomination trace not found", types.PortID, []string{types.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom()}, nil, "channel-0"},
+		{"invalid counterparty denomination", types.PortID, nil, []string{"transfer/uatom"}, ""},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.chainA.GetSimApp().TransferKeeper.PrecomputeNextChannel(suite.chainA.GetContext(), tc.portID, tc.denoms, tc.counterpartyDenoms, types.PortID, tc.counterpartyChannelID)
+		suite.Require().Error(err, tc.msg)
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -13,6 +14,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
@@ -57,6 +59,7 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) error {
 
 	if !k.GetSendEnabled(ctx) {
@@ -68,6 +71,22 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	// refuse transfers whose packet can never be relayed to the destination chain
+	clientID, status, err := k.channelKeeper.GetChannelClientStatus(ctx, sourcePort, sourceChannel)
+	if err != nil {
+		return err
+	}
+
+	if status != ibcexported.Active {
+		return sdkerrors.Wrapf(types.ErrInactiveClient, "cannot transfer over channel %s using client (%s) with status %s", sourceChannel, clientID, status)
+	}
+
+	// transfers to the module accounts registered for the counterparty chain must be allowed
+	// explicitly, see WithModuleAccountReceiverAllowed
+	if err := k.ValidateReceiver(ctx, sourcePort, sourceChannel, receiver, types.IsModuleAccountReceiverAllowed(ctx)); err != nil {
+		return err
+	}
+
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
@@ -90,8 +109,6 @@ func (k Keeper) SendTransfer(
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
-	var err error
-
 	// deconstruct the token denomination into the denomination trace info
 	// to determine if the sender is the source chain
 	if strings.HasPrefix(token.Denom, "ibc/") {
@@ -101,6 +118,20 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
+	// apply the default timeouts if the transfer sets neither a timeout height nor a timeout timestamp
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		timeoutHeight, timeoutTimestamp, err = k.defaultTimeouts(ctx, sourcePort, sourceChannel)
+		if err != nil {
+			return err
+		}
+	}
+
+	// retain the transfer fee from the sender, only the remaining amount is transferred
+	token, err = k.retainFee(ctx, sender, token)
+	if err != nil {
+		return err
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -116,10 +147,8 @@ func (k Keeper) SendTransfer(
 		// create the escrow address for the tokens
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
-		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
+		// escrow source tokens and track the total amount escrowed for the denomination
+		if err := k.EscrowToken(ctx, sender, escrowAddress, token); err != nil {
 			return err
 		}
 
@@ -144,11 +173,11 @@ func (k Keeper) SendTransfer(
 	}
 
 	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
+		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
 	)
 
 	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
+		types.EncodePacketData(sourceChannelEnd.Version, packetData),
 		sequence,
 		sourcePort,
 		sourceChannel,
@@ -185,27 +214,29 @@ func (k Keeper) SendTransfer(
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// unescrowed and sent to the receiving address. The denomination credited to the
+// receiving address is returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) (string, error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return err
+		return "", err
 	}
 
 	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+		defer incrFailureCounter([]string{"ibc", types.ModuleName, "receive", "failure"}, packet.GetSourcePort(), packet.GetSourceChannel(), types.FailureReasonReceiveDisabled)
+		return "", types.ErrReceiveDisabled
 	}
 
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+		return "", sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
 	}
 
 	labels := []metrics.Label{
@@ -239,14 +270,27 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		}
 		token := sdk.NewCoin(denom, transferAmount)
 
+		if err := k.validateDustThreshold(ctx, packet, token); err != nil {
+			return "", err
+		}
+
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
 			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+			return "", sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		}
+
+		// retain the transfer fee from the receiver
+		if _, err := k.retainFee(ctx, receiver, token); err != nil {
+			return "", err
+		}
+
+		if err := k.afterRecvTransfer(ctx, packet, data, denom); err != nil {
+			return "", err
 		}
 
 		defer func() {
@@ -267,7 +311,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return denom, nil
 	}
 
 	// sender chain is the source, mint vouchers
@@ -280,12 +324,18 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
 
+	voucherDenom := denomTrace.IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, transferAmount)
+
+	if err := k.validateDustThreshold(ctx, packet, voucher); err != nil {
+		return "", err
+	}
+
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
@@ -293,20 +343,32 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
 		),
 	)
-	voucher := sdk.NewCoin(voucherDenom, transferAmount)
 
 	// mint new tokens if the source of the transfer is the same chain
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return err
+		return "", err
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
 	); err != nil {
-		return err
+		// the bank keeper rejects sends to blocked module accounts as unauthorized
+		if errors.Is(err, sdkerrors.ErrUnauthorized) {
+			defer incrFailureCounter([]string{"ibc", types.ModuleName, "receive", "failure"}, packet.GetSourcePort(), packet.GetSourceChannel(), types.FailureReasonBlockedAddress)
+		}
+		return "", err
+	}
+
+	// retain the transfer fee from the receiver
+	if _, err := k.retainFee(ctx, receiver, voucher); err != nil {
+		return "", err
+	}
+
+	if err := k.afterRecvTransfer(ctx, packet, data, voucherDenom); err != nil {
+		return "", err
 	}
 
 	defer func() {
@@ -327,7 +389,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucherDenom, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
@@ -337,7 +399,12 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+
+		defer incrFailureCounter([]string{"ibc", types.ModuleName, "refund"}, packet.GetSourcePort(), packet.GetSourceChannel(), types.FailureReasonAckError)
+		return nil
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
@@ -348,7 +415,12 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	defer incrFailureCounter([]string{"ibc", types.ModuleName, "refund"}, packet.GetSourcePort(), packet.GetSourceChannel(), types.FailureReasonTimeout)
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -377,7 +449,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -402,6 +474,18 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	return nil
 }
 
+// validateDustThreshold returns an error if the amount of the token to be received
+// is below the receive dust threshold configured for its local denomination.
+func (k Keeper) validateDustThreshold(ctx sdk.Context, packet channeltypes.Packet, token sdk.Coin) error {
+	threshold := k.GetReceiveDustThresholds(ctx).AmountOfNoDenomValidation(token.Denom)
+	if token.Amount.LT(threshold) {
+		defer incrFailureCounter([]string{"ibc", types.ModuleName, "receive", "failure"}, packet.GetSourcePort(), packet.GetSourceChannel(), types.FailureReasonDustThreshold)
+		return sdkerrors.Wrapf(types.ErrBelowDustThreshold, "amount %s is below the minimum of %s for denom %s", token.Amount, threshold, token.Denom)
+	}
+
+	return nil
+}
+
 // DenomPathFromHash returns the full denomination path prefix from an ibc denom with a hash
 // component.
 func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
@@ -421,3 +505,18 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// incrFailureCounter increments the telemetry counter under the provided keys,
+// labelled with the port and channel of the sending chain and the reason of the
+// failure, so that spikes of a particular failure mode can be alerted on.
+func incrFailureCounter(keys []string, sourcePort, sourceChannel, reason string) {
+	telemetry.IncrCounterWithLabels(
+		keys,
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelSourcePort, sourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, sourceChannel),
+			telemetry.NewLabel(coretypes.LabelReason, reason),
+		},
+	)
+}
@@ -2,13 +2,17 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
@@ -97,12 +101,12 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			if !tc.sendFromSource {
 				// send coin from chainB to chainA
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				_, err = suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
 				// receive coin on chainA from chainB
-				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 				packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0)
 
 				// get proof of packet commitment from chainB
@@ -118,7 +122,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 
 			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
-				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
 			)
 
 			if tc.expPass {
@@ -130,15 +134,74 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSendTransferInactiveClient() {
+	testCases := []struct {
+		msg      string
+		malleate func(clientState *ibctmtypes.ClientState)
+	}{
+		{"frozen client", func(clientState *ibctmtypes.ClientState) {
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		}},
+		{"expired client", func(clientState *ibctmtypes.ClientState) {
+			// increment latest height so no consensus state is stored
+			clientState.LatestHeight = clientState.LatestHeight.Increment().(clienttypes.Height)
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			tc.malleate(clientState)
+			path.EndpointA.SetClientState(clientState)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				sender, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+			suite.Require().ErrorIs(err, types.ErrInactiveClient)
+
+			// no funds are escrowed
+			suite.Require().Equal(balance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+// test that the priority class set in the context reaches the core channel keeper through the
+// middleware the transfer keeper sends packets with
+func (suite *KeeperTestSuite) TestSendTransferPacketPriority() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := channeltypes.WithPacketPriority(suite.chainA.GetContext(), channeltypes.PRIORITY_HIGH)
+	err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+	suite.Require().NoError(err)
+
+	priority := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketPriority(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().Equal(channeltypes.PRIORITY_HIGH, priority)
+}
+
 // test receiving coin on chainB with coin that orignate on chainA and
 // coin that orignated on chainB (source). The bulk of the testing occurs
 // in the test case for loop since setup is intensive for all cases. The
 // malleate function allows for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	var (
-		trace    types.DenomTrace
-		amount   sdk.Int
-		receiver string
+		trace         types.DenomTrace
+		amount        sdk.Int
+		receiver      string
+		failureReason string
 	)
 
 	testCases := []struct {
@@ -156,6 +219,29 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"invalid receiver address", func() {
 			receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
 		}, true, false},
+		{"failure: receive disabled", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+		}, true, false},
+		{"failure: unescrowed amount below dust threshold", func() {
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.ReceiveDustThresholds = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount.AddRaw(1)))
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, true, false},
+		{"failure: minted voucher amount below dust threshold", func() {
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(ibctesting.TransferPort, "channel-0", sdk.DefaultBondDenom)).IBCDenom()
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.ReceiveDustThresholds = sdk.NewCoins(sdk.NewCoin(voucherDenom, amount.AddRaw(1)))
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, false, false},
+		{"success: amount equal to dust threshold", func() {
+			params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+			params.ReceiveDustThresholds = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+		}, true, true},
+		{"failure: receiver is a blocked module account", func() {
+			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			failureReason = types.FailureReasonBlockedAddress
+		}, false, false},
 
 		// onRecvPacket
 		// - coin from chain chainA
@@ -180,12 +266,13 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
 
 			amount = sdk.NewInt(100) // must be explicitly changed in malleate
+			failureReason = ""       // must be explicitly changed in malleate
 			seq := uint64(1)
 
 			if tc.recvIsSource {
 				// send coin from chainB to chainA, receive them, acknowledge them, and send back to chainB
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
@@ -204,26 +291,60 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			// send coin from chainA to chainB
-			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0)
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
 			_, err := suite.chainA.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			// record the telemetry of the receive in memory
+			sink := metrics.NewInmemSink(time.Hour, time.Hour)
+			conf := metrics.DefaultConfig("")
+			conf.EnableHostname = false
+			conf.EnableRuntimeMetrics = false
+			_, err = metrics.NewGlobal(conf, sink)
+			suite.Require().NoError(err)
+			defer metrics.NewGlobal(conf, &metrics.BlackholeSink{}) //nolint:errcheck
+
+			_, err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 			} else {
 				suite.Require().Error(err)
 			}
+
+			if failureReason != "" {
+				suite.Require().Equal(1, receiveFailureCount(sink, failureReason))
+			}
 		})
 	}
 }
 
+// receiveFailureCount returns the number of receive failures recorded in the given sink
+// for the provided failure reason.
+func receiveFailureCount(sink *metrics.InmemSink, reason string) int {
+	var count int
+	for _, interval := range sink.Data() {
+		for _, counter := range interval.Counters {
+			if counter.Name != "ibc.transfer.receive.failure" {
+				continue
+			}
+
+			for _, label := range counter.Labels {
+				if label.Value == reason {
+					count += counter.Count
+				}
+			}
+		}
+	}
+
+	return count
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund when attempting to send from chainA
 // to chainB. If sender is source than the denomination being refunded has no
@@ -253,6 +374,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{"unsuccessful refund from source", failedAck,
 			func() {
@@ -279,7 +401,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -327,6 +449,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true},
 		{"successful timeout from external chain",
 			func() {
@@ -365,7 +488,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// defaultTimeouts returns the timeout height and timeout timestamp applied to a transfer over
+// the provided channel which sets neither timeout. The timeout height is the latest height of
+// the counterparty client increased by the DefaultTimeoutHeightOffset param and the timeout
+// timestamp is the block time increased by the DefaultTimeoutTimestampDuration param. A
+// timeout is disabled if its param is zero.
+func (k Keeper) defaultTimeouts(ctx sdk.Context, sourcePort, sourceChannel string) (clienttypes.Height, uint64, error) {
+	var (
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	)
+
+	if offset := k.GetDefaultTimeoutHeightOffset(ctx); offset != 0 {
+		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, sourcePort, sourceChannel)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		latestHeight := clientState.GetLatestHeight()
+		timeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+offset)
+	}
+
+	if duration := k.GetDefaultTimeoutTimestampDuration(ctx); duration != 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + duration
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TestSendTransferDefaultTimeouts tests that the default timeouts defined by the params are
+// applied to transfers which set neither a timeout height nor a timeout timestamp.
+func (suite *KeeperTestSuite) TestSendTransferDefaultTimeouts() {
+	var (
+		params           types.Params
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg              string
+		malleate         func()
+		expTimeoutHeight func(latestHeight clienttypes.Height) clienttypes.Height
+		expTimeoutOffset uint64
+		expPass          bool
+	}{
+		{"default timeouts are applied", func() {}, func(latestHeight clienttypes.Height) clienttypes.Height {
+			return clienttypes.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+types.DefaultTimeoutHeightOffset)
+		}, types.DefaultTimeoutTimestampDuration, true},
+		{"only the default timeout height is applied", func() {
+			params.DefaultTimeoutTimestampDuration = 0
+		}, func(latestHeight clienttypes.Height) clienttypes.Height {
+			return clienttypes.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+types.DefaultTimeoutHeightOffset)
+		}, 0, true},
+		{"only the default timeout timestamp is applied", func() {
+			params.DefaultTimeoutHeightOffset = 0
+		}, func(clienttypes.Height) clienttypes.Height {
+			return clienttypes.ZeroHeight()
+		}, types.DefaultTimeoutTimestampDuration, true},
+		{"default timeouts are not applied to transfers with a timeout", func() {
+			timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+		}, func(clienttypes.Height) clienttypes.Height {
+			return clienttypes.ZeroHeight()
+		}, 0, true},
+		{"default timeouts are disabled", func() {
+			params.DefaultTimeoutHeightOffset = 0
+			params.DefaultTimeoutTimestampDuration = 0
+		}, nil, 0, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			app := suite.chainA.GetSimApp()
+			params = app.TransferKeeper.GetParams(suite.chainA.GetContext())
+			timeoutHeight, timeoutTimestamp = clienttypes.ZeroHeight(), 0
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			app.TransferKeeper.SetParams(ctx, params)
+
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			err := app.TransferKeeper.SendTransfer(
+				ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, timeoutTimestamp, "",
+			)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+			expTimeoutTimestamp := timeoutTimestamp
+			if tc.expTimeoutOffset != 0 {
+				expTimeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + tc.expTimeoutOffset
+			}
+
+			packetData := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, tc.expTimeoutHeight(latestHeight), expTimeoutTimestamp)
+			commitment := app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			suite.Require().Equal(channeltypes.CommitPacket(app.AppCodec(), packet), commitment)
+		})
+	}
+}
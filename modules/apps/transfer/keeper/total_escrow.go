@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetTotalEscrowForDenom returns the total amount of the provided denomination escrowed by the
+// transfer channels. A zero coin is returned if the denomination is not escrowed.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyTotalEscrowForDenom(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetTotalEscrowForDenom stores the total amount of the denomination of the provided coin
+// escrowed by the transfer channels. The entry is deleted if the amount is zero.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	if coin.Amount.IsZero() {
+		store.Delete(types.KeyTotalEscrowForDenom(coin.Denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(types.KeyTotalEscrowForDenom(coin.Denom), bz)
+}
+
+// IterateTotalEscrowed provides an iterator over the total amount escrowed for each
+// denomination. For each denomination, cb will be called. If the cb returns true, the iterator
+// will close and stop.
+func (k Keeper) IterateTotalEscrowed(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalEscrowForDenomKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		denom := string(iterator.Key()[len(types.TotalEscrowForDenomKey):])
+		if cb(sdk.NewCoin(denom, amount.Int)) {
+			break
+		}
+	}
+}
+
+// GetAllTotalEscrowed returns the total amount escrowed for every escrowed denomination.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	var escrowed sdk.Coins
+	k.IterateTotalEscrowed(ctx, func(coin sdk.Coin) bool {
+		escrowed = append(escrowed, coin)
+		return false
+	})
+	return escrowed
+}
+
+// MigrateTotalEscrowForDenom sets the total amount escrowed for each denomination to the sum
+// of the balances of the escrow accounts of the transfer channels and of the funds reported as
+// deployed from them by the escrow yield hooks. It may be called by upgrade handlers of chains
+// which escrowed tokens before the total escrow was tracked. Funds deployed by escrow yield hooks
+// which do not implement EscrowYieldReporter must be added by the chain.
+func (k Keeper) MigrateTotalEscrowForDenom(ctx sdk.Context) {
+	for _, coin := range k.getEscrowBalances(ctx) {
+		k.SetTotalEscrowForDenom(ctx, coin)
+	}
+}
+
+// increaseTotalEscrow adds the provided token to the total amount escrowed for its denomination.
+func (k Keeper) increaseTotalEscrow(ctx sdk.Context, token sdk.Coin) {
+	k.SetTotalEscrowForDenom(ctx, k.GetTotalEscrowForDenom(ctx, token.Denom).Add(token))
+}
+
+// decreaseTotalEscrow subtracts the provided token from the total amount escrowed for its
+// denomination. It returns an error if the total escrow is less than the token, which reveals
+// tokens leaving the escrow accounts without being tracked, or tokens escrowed before the
+// total escrow was tracked on chains which did not run the migration.
+func (k Keeper) decreaseTotalEscrow(ctx sdk.Context, token sdk.Coin) error {
+	total := k.GetTotalEscrowForDenom(ctx, token.Denom)
+	if total.IsLT(token) {
+		return sdkerrors.Wrapf(types.ErrTotalEscrowUnderflow, "cannot unescrow %s, the total escrow is %s", token, total)
+	}
+
+	k.SetTotalEscrowForDenom(ctx, total.Sub(token))
+	return nil
+}
+
+// getEscrowBalances returns the sum of the balances of the escrow accounts of the channels
+// bound to the transfer port, including the funds reported as deployed from them by the escrow
+// yield hooks.
+func (k Keeper) getEscrowBalances(ctx sdk.Context) sdk.Coins {
+	balances := sdk.NewCoins()
+	portID := k.GetPort(ctx)
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.PortId != portID {
+			return false
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+		if reporter, ok := k.escrowYieldHooks.(types.EscrowYieldReporter); ok {
+			balances = balances.Add(reporter.DeployedEscrow(ctx, escrowAddress)...)
+		}
+		return false
+	})
+	return balances
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	app := suite.chainA.GetSimApp()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	// send from chainA to chainB escrows the tokens on chainA
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	suite.Require().Equal(coin, app.TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewCoins(coin), app.TransferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext()))
+
+	_, broken := keeper.TotalEscrowPerDenomInvariant(app.TransferKeeper)(suite.chainA.GetContext())
+	suite.Require().False(broken)
+
+	// the vouchers on chainB are burned, not escrowed
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().True(suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), voucherDenom).IsZero())
+
+	// send half of the vouchers back from chainB to chainA, which unescrows the tokens on chainA
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, sdk.NewInt(40)), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60)), app.TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom))
+
+	// tokens cannot be unescrowed for more than the total escrow
+	app.TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, sdk.NewInt(60)), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	res, err = path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewErrorAcknowledgement(types.ErrTotalEscrowUnderflow).Acknowledgement(), ack)
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), app.TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom))
+
+	// the migration restores the total escrow from the escrow balances
+	app.TransferKeeper.MigrateTotalEscrowForDenom(suite.chainA.GetContext())
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60)), app.TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	app := suite.chainA.GetSimApp()
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	suite.Require().NoError(app.BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), escrowAddress, sdk.NewCoins(coin)))
+
+	invariant := keeper.TotalEscrowPerDenomInvariant(app.TransferKeeper)
+
+	// the escrow accounts may hold more than the total escrow
+	_, broken := invariant(suite.chainA.GetContext())
+	suite.Require().False(broken)
+
+	// the migration sets the total escrow to the escrow balances
+	app.TransferKeeper.MigrateTotalEscrowForDenom(suite.chainA.GetContext())
+	suite.Require().Equal(coin, app.TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom))
+
+	_, broken = invariant(suite.chainA.GetContext())
+	suite.Require().False(broken)
+
+	app.TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin.AddAmount(sdk.OneInt()))
+
+	_, broken = invariant(suite.chainA.GetContext())
+	suite.Require().True(broken)
+}
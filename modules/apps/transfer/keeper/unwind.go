@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// forwardSeparator separates the forward channel from the receiver on the next chain in the
+// receiver format of the packet forward middleware, {port}/{channel}:{receiver}.
+const forwardSeparator = ":"
+
+// UnwindRoute returns the port and channel over which the IBC vouchers of the provided
+// ibc/{hash} denomination must be sent to return them to their origin chain, which is the
+// first hop of their denomination trace, along with the receiver of the sent packet. Vouchers
+// received over several hops are forwarded by the intermediate chains over the remaining hops
+// of the trace, the returned receiver then carries the routing information of the packet
+// forward middleware for these hops, ending with the provided receiver on the origin chain.
+func (k Keeper) UnwindRoute(ctx sdk.Context, denom, receiver string) (string, string, string, error) {
+	if !strings.HasPrefix(denom, types.DenomPrefix+"/") {
+		return "", "", "", sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "denomination %s is not an IBC voucher", denom)
+	}
+
+	hexHash := denom[len(types.DenomPrefix+"/"):]
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", "", "", sdkerrors.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", "", "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+	if len(identifiers) > 2 && strings.Contains(receiver, forwardSeparator) {
+		return "", "", "", sdkerrors.Wrapf(
+			types.ErrUnwindRequiresForwarding,
+			"receiver %s on the origin chain of %s cannot contain %s", receiver, denomTrace.GetFullDenomPath(), forwardSeparator,
+		)
+	}
+
+	// the receiver on each intermediate chain routes the vouchers over the next hop, starting
+	// from the last intermediate chain
+	for i := len(identifiers) - 2; i >= 2; i -= 2 {
+		receiver = fmt.Sprintf("%s/%s%s%s", identifiers[i], identifiers[i+1], forwardSeparator, receiver)
+	}
+
+	return identifiers[0], identifiers[1], receiver, nil
+}
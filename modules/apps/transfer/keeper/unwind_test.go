@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestUnwindTransfer tests that IBC vouchers are sent back over the channel they were
+// received on.
+func (suite *KeeperTestSuite) TestUnwindTransfer() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// transfer native tokens of chainB to chainA
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, coin.Amount)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	unwindRes, err := suite.chainA.GetSimApp().TransferKeeper.UnwindTransfer(ctx, types.NewMsgUnwindTransfer(voucher, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), 0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, unwindRes.SourcePort)
+	suite.Require().Equal(path.EndpointA.ChannelID, unwindRes.SourceChannel)
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero(), "vouchers are burned when unwound")
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	// vouchers without a trace cannot be unwound
+	_, _, _, err = suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(suite.chainA.GetContext(), types.ParseDenomTrace("transfer/channel-100/"+sdk.DefaultBondDenom).IBCDenom(), receiver)
+	suite.Require().ErrorIs(err, types.ErrTraceNotFound)
+
+	// native denominations cannot be unwound
+	_, _, _, err = suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(suite.chainA.GetContext(), sdk.DefaultBondDenom, receiver)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomForTransfer)
+}
+
+// TestUnwindTransferMultiHop tests that IBC vouchers received over two hops are sent back
+// over the channel they were received on and forwarded by the intermediate chain to their
+// origin chain.
+func (suite *KeeperTestSuite) TestUnwindTransferMultiHop() {
+	pathAB := NewTransferPath(suite.chainA, suite.chainB)
+	pathBC := NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(pathAB)
+	suite.coordinator.Setup(pathBC)
+
+	// transfer native tokens of chainC to chainA through chainB
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID, coin, suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err := suite.chainC.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathBC.RelayPacket(packet))
+
+	trace := types.ParseDenomTrace(types.GetPrefixedDenom(pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	msg = types.NewMsgTransfer(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.NewCoin(trace.IBCDenom(), coin.Amount), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathAB.RelayPacket(packet))
+
+	trace = types.ParseDenomTrace(types.GetPrefixedDenom(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID, trace.GetFullDenomPath()))
+	voucher := sdk.NewCoin(trace.IBCDenom(), coin.Amount)
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	// the receiver on chainB routes the vouchers back to chainC
+	sourcePort, sourceChannel, packetReceiver, err := suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(suite.chainA.GetContext(), voucher.Denom, receiver.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(pathAB.EndpointA.ChannelConfig.PortID, sourcePort)
+	suite.Require().Equal(pathAB.EndpointA.ChannelID, sourceChannel)
+	suite.Require().Equal(fmt.Sprintf("%s/%s:%s", pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID, receiver), packetReceiver)
+
+	// the receiver on the origin chain cannot carry routing information itself
+	_, _, _, err = suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(suite.chainA.GetContext(), voucher.Denom, "transfer/channel-0:"+receiver.String())
+	suite.Require().ErrorIs(err, types.ErrUnwindRequiresForwarding)
+
+	preCoin := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, sdk.DefaultBondDenom)
+
+	res, err = suite.chainA.SendMsgs(types.NewMsgUnwindTransfer(voucher, suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), clienttypes.NewHeight(0, 110), 0))
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// chainB forwards the vouchers to chainC
+	suite.Require().NoError(pathAB.EndpointB.UpdateClient())
+	res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathBC.RelayPacket(forwardPacket))
+
+	// the native tokens are returned to the receiver on chainC
+	postCoin := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, sdk.DefaultBondDenom)
+	suite.Require().Equal(coin.Amount, postCoin.Amount.Sub(preCoin.Amount))
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().True(balance.IsZero(), "vouchers are burned when unwound")
+}
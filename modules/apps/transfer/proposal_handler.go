@@ -0,0 +1,26 @@
+package transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// NewTransferProposalHandler defines the ibc transfer proposal handler
+func NewTransferProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.DenomTraceCorrectionProposal:
+			return k.CorrectDenomTraces(ctx, c.Corrections)
+
+		case *types.CounterpartyModuleAccountsProposal:
+			return k.RegisterCounterpartyModuleAccounts(ctx, c.ModuleAccounts)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc transfer proposal content type: %T", c)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalDenomTrace([]byte) types.DenomTrace
+	MustUnmarshalEscrowSnapshot([]byte) types.EscrowSnapshot
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
@@ -27,6 +28,11 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.EscrowSnapshotKey):
+			snapshotA := cdc.MustUnmarshalEscrowSnapshot(kvA.Value)
+			snapshotB := cdc.MustUnmarshalEscrowSnapshot(kvB.Value)
+			return fmt.Sprintf("EscrowSnapshot A: %s\nEscrowSnapshot B: %s", snapshotA.Balance, snapshotB.Balance)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +22,11 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	snapshot := types.EscrowSnapshot{
+		Height:  10,
+		Balance: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -31,6 +37,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.KeyEscrowSnapshot(types.PortID, "channel-0", snapshot.Height),
+				Value: app.AppCodec().MustMarshal(&snapshot),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +53,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"EscrowSnapshot", fmt.Sprintf("EscrowSnapshot A: %s\nEscrowSnapshot B: %s", snapshot.Balance, snapshot.Balance)},
 		{"other", ""},
 	}
 
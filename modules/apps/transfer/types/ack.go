@@ -25,3 +25,28 @@ func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 
 	return channeltypes.NewErrorAcknowledgement(errorString)
 }
+
+// NewResultAcknowledgement returns the successful acknowledgement of a packet received on a
+// channel with the provided version. On channels negotiated with VersionReceivedDenom the
+// result carries the denomination credited to the receiver, otherwise it is the single byte
+// result of the original version.
+func NewResultAcknowledgement(version, receivedDenom string) channeltypes.Acknowledgement {
+	if version != VersionReceivedDenom {
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	}
+
+	result := FungibleTokenPacketAcknowledgementResult{Denom: receivedDenom}
+	return channeltypes.NewResultAcknowledgement(result.GetBytes())
+}
+
+// ParseAcknowledgementResult returns the denomination credited on the destination chain
+// from the result of a successful acknowledgement. False is returned if the result does
+// not carry the denomination.
+func ParseAcknowledgementResult(result []byte) (string, bool) {
+	var ackResult FungibleTokenPacketAcknowledgementResult
+	if err := ModuleCdc.UnmarshalJSON(result, &ackResult); err != nil || ackResult.Denom == "" {
+		return "", false
+	}
+
+	return ackResult.Denom, true
+}
@@ -5,18 +5,27 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc transfer interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgTransfer", nil)
+	cdc.RegisterConcrete(&MsgUnwindTransfer{}, "cosmos-sdk/MsgUnwindTransfer", nil)
+	cdc.RegisterConcrete(&DenomTraceCorrectionProposal{}, "cosmos-sdk/DenomTraceCorrectionProposal", nil)
+	cdc.RegisterConcrete(&CounterpartyModuleAccountsProposal{}, "cosmos-sdk/CounterpartyModuleAccountsProposal", nil)
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUnwindTransfer{})
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&DenomTraceCorrectionProposal{},
+		&CounterpartyModuleAccountsProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
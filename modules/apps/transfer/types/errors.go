@@ -6,12 +6,22 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidPacketTimeout     = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = sdkerrors.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrBelowDustThreshold       = sdkerrors.Register(ModuleName, 10, "transfer amount below receive dust threshold")
+	ErrEscrowRecall             = sdkerrors.Register(ModuleName, 11, "failed to recall escrowed funds")
+	ErrInvalidFeeCollector      = sdkerrors.Register(ModuleName, 12, "invalid fee collector")
+	ErrUnwindRequiresForwarding = sdkerrors.Register(ModuleName, 13, "unwinding requires forwarding")
+	ErrInactiveClient           = sdkerrors.Register(ModuleName, 14, "client of the destination chain is not active")
+	ErrInvalidTraceCorrection   = sdkerrors.Register(ModuleName, 15, "invalid denomination trace correction")
+	ErrInvalidMemo              = sdkerrors.Register(ModuleName, 16, "invalid memo")
+	ErrInvalidModuleAccounts    = sdkerrors.Register(ModuleName, 17, "invalid counterparty module accounts")
+	ErrModuleAccountReceiver    = sdkerrors.Register(ModuleName, 18, "receiver is a counterparty module account")
+	ErrTotalEscrowUnderflow     = sdkerrors.Register(ModuleName, 19, "total escrow underflow")
 )
@@ -7,6 +7,9 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeFeeRetained  = "fee_retained"
+
+	EventTypeDenomTraceCorrection = "denomination_trace_correction"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -18,4 +21,9 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyPayer          = "payer"
+	AttributeKeyFeeCollector   = "fee_collector"
+	AttributeKeyReceivedDenom  = "received_denom"
+	AttributeKeyCorrectedHash  = "corrected_trace_hash"
+	AttributeKeyMemo           = "memo"
 )
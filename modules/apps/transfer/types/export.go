@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomTraceExport creates a new DenomTraceExport of the provided denomination traces of
+// the given chain, along with the supply of their vouchers taken from the provided total
+// supply of the chain. The exported traces are sorted by full denomination path.
+func NewDenomTraceExport(chainID string, traces Traces, supply sdk.Coins) DenomTraceExport {
+	sorted := make(Traces, len(traces))
+	copy(sorted, traces)
+
+	denomTraces := make([]DenomTraceSupply, len(sorted))
+	for i, trace := range sorted.Sort() {
+		ibcDenom := trace.IBCDenom()
+		denomTraces[i] = DenomTraceSupply{
+			DenomTrace: trace,
+			Supply:     sdk.NewCoin(ibcDenom, supply.AmountOf(ibcDenom)),
+		}
+	}
+
+	return DenomTraceExport{
+		ChainId:     chainID,
+		DenomTraces: denomTraces,
+	}
+}
+
+// Validate performs a basic validation of the export. The chain ID must be set, the
+// denomination traces must be valid and unique, and the supply of each trace must be a valid
+// coin of its voucher denomination.
+func (e DenomTraceExport) Validate() error {
+	if strings.TrimSpace(e.ChainId) == "" {
+		return fmt.Errorf("chain ID cannot be blank")
+	}
+
+	seen := make(map[string]bool)
+	for i, denomTrace := range e.DenomTraces {
+		if err := denomTrace.DenomTrace.Validate(); err != nil {
+			return fmt.Errorf("invalid denom trace %d: %w", i, err)
+		}
+
+		ibcDenom := denomTrace.DenomTrace.IBCDenom()
+		if seen[ibcDenom] {
+			return fmt.Errorf("duplicated denom trace %s", denomTrace.DenomTrace.GetFullDenomPath())
+		}
+		seen[ibcDenom] = true
+
+		if err := denomTrace.Supply.Validate(); err != nil {
+			return fmt.Errorf("invalid supply of denom trace %d: %w", i, err)
+		}
+
+		if denomTrace.Supply.Denom != ibcDenom {
+			return fmt.Errorf("supply denomination %s of denom trace %d does not match voucher denomination %s", denomTrace.Supply.Denom, i, ibcDenom)
+		}
+	}
+
+	return nil
+}
+
+// Traces returns the exported denomination traces.
+func (e DenomTraceExport) Traces() Traces {
+	traces := make(Traces, len(e.DenomTraces))
+	for i, denomTrace := range e.DenomTraces {
+		traces[i] = denomTrace.DenomTrace
+	}
+
+	return traces
+}
+
+// VerifySupply returns an error if the provided total supply of a chain does not match the
+// exported supply of a voucher. It ensures that the balances of the exported chain have been
+// migrated along with its denomination traces.
+func (e DenomTraceExport) VerifySupply(supply sdk.Coins) error {
+	for _, denomTrace := range e.DenomTraces {
+		amount := supply.AmountOf(denomTrace.Supply.Denom)
+		if !amount.Equal(denomTrace.Supply.Amount) {
+			return fmt.Errorf(
+				"supply %s%s of denom trace %s does not match exported supply %s",
+				amount, denomTrace.Supply.Denom, denomTrace.DenomTrace.GetFullDenomPath(), denomTrace.Supply,
+			)
+		}
+	}
+
+	return nil
+}
+
+// MergeDenomTraces returns the provided denomination traces along with the exported traces
+// which are not part of them, sorted by full denomination path.
+func (e DenomTraceExport) MergeDenomTraces(traces Traces) Traces {
+	merged := make(Traces, len(traces))
+	copy(merged, traces)
+
+	seen := make(map[string]bool)
+	for _, trace := range traces {
+		seen[trace.IBCDenom()] = true
+	}
+
+	for _, trace := range e.Traces() {
+		if !seen[trace.IBCDenom()] {
+			merged = append(merged, trace)
+		}
+	}
+
+	return merged.Sort()
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestDenomTraceExport(t *testing.T) {
+	atom := types.ParseDenomTrace("transfer/channel-0/uatom")
+	osmo := types.ParseDenomTrace("transfer/channel-1/uosmo")
+	supply := sdk.NewCoins(sdk.NewInt64Coin(atom.IBCDenom(), 100), sdk.NewInt64Coin("stake", 1000))
+
+	export := types.NewDenomTraceExport("chain-1", types.Traces{osmo, atom}, supply)
+	require.NoError(t, export.Validate())
+	require.Equal(t, types.Traces{atom, osmo}, export.Traces())
+	require.Equal(t, sdk.NewInt64Coin(atom.IBCDenom(), 100), export.DenomTraces[0].Supply)
+	require.Equal(t, sdk.NewInt64Coin(osmo.IBCDenom(), 0), export.DenomTraces[1].Supply)
+
+	// the supply must match the exported supply of every voucher
+	require.NoError(t, export.VerifySupply(supply))
+	require.Error(t, export.VerifySupply(sdk.NewCoins(sdk.NewInt64Coin(atom.IBCDenom(), 99))))
+	require.Error(t, export.VerifySupply(supply.Add(sdk.NewInt64Coin(osmo.IBCDenom(), 1))))
+
+	// the exported traces are merged with the traces which are not part of them
+	juno := types.ParseDenomTrace("transfer/channel-2/ujuno")
+	require.Equal(t, types.Traces{atom, osmo, juno}, export.MergeDenomTraces(types.Traces{juno, atom}))
+	require.Equal(t, types.Traces{atom, osmo}, export.MergeDenomTraces(nil))
+}
+
+func TestValidateDenomTraceExport(t *testing.T) {
+	atom := types.ParseDenomTrace("transfer/channel-0/uatom")
+
+	testCases := []struct {
+		name     string
+		malleate func(export *types.DenomTraceExport)
+		expPass  bool
+	}{
+		{"valid export", func(export *types.DenomTraceExport) {}, true},
+		{"valid empty export", func(export *types.DenomTraceExport) { export.DenomTraces = nil }, true},
+		{"blank chain ID", func(export *types.DenomTraceExport) { export.ChainId = " " }, false},
+		{"invalid denom trace", func(export *types.DenomTraceExport) { export.DenomTraces[0].DenomTrace.BaseDenom = "" }, false},
+		{
+			"duplicated denom trace",
+			func(export *types.DenomTraceExport) {
+				export.DenomTraces = append(export.DenomTraces, export.DenomTraces[0])
+			},
+			false,
+		},
+		{
+			"supply denomination does not match voucher denomination",
+			func(export *types.DenomTraceExport) {
+				export.DenomTraces[0].Supply = sdk.NewInt64Coin("stake", 100)
+			},
+			false,
+		},
+		{
+			"negative supply",
+			func(export *types.DenomTraceExport) {
+				export.DenomTraces[0].Supply = sdk.Coin{Denom: atom.IBCDenom(), Amount: sdk.NewInt(-1)}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			export := types.NewDenomTraceExport("chain-1", types.Traces{atom}, sdk.NewCoins(sdk.NewInt64Coin(atom.IBCDenom(), 100)))
+			tc.malleate(&export)
+
+			err := export.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -31,5 +33,23 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+
+	seenChannels := make(map[string]bool)
+	for i, moduleAccounts := range gs.CounterpartyModuleAccounts {
+		if err := moduleAccounts.Validate(); err != nil {
+			return fmt.Errorf("invalid counterparty module accounts %d: %w", i, err)
+		}
+
+		channel := fmt.Sprintf("%s/%s", moduleAccounts.PortId, moduleAccounts.ChannelId)
+		if seenChannels[channel] {
+			return fmt.Errorf("duplicated counterparty module accounts of channel %s", channel)
+		}
+		seenChannels[channel] = true
+	}
+
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return fmt.Errorf("invalid total escrowed: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
@@ -26,6 +26,38 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid counterparty module accounts",
+			&types.GenesisState{
+				PortId: "portidone",
+				CounterpartyModuleAccounts: []types.CounterpartyModuleAccounts{
+					types.NewCounterpartyModuleAccounts("portidone", "channel-0", []string{"cosmos1module"}),
+					types.NewCounterpartyModuleAccounts("portidone", "channel-1", []string{"cosmos1module"}),
+				},
+			},
+			true,
+		},
+		{
+			"invalid counterparty module accounts",
+			&types.GenesisState{
+				PortId: "portidone",
+				CounterpartyModuleAccounts: []types.CounterpartyModuleAccounts{
+					types.NewCounterpartyModuleAccounts("portidone", "channel-0", []string{" "}),
+				},
+			},
+			false,
+		},
+		{
+			"duplicated counterparty module accounts",
+			&types.GenesisState{
+				PortId: "portidone",
+				CounterpartyModuleAccounts: []types.CounterpartyModuleAccounts{
+					types.NewCounterpartyModuleAccounts("portidone", "channel-0", []string{"cosmos1module"}),
+					types.NewCounterpartyModuleAccounts("portidone", "channel-0", []string{"cosmos1other"}),
+				},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// EscrowYieldHooks defines an optional interface which allows a chain to put the
+// idle funds held in transfer escrow accounts to work, for example by delegating
+// them to a community pool owned strategy.
+//
+// Funds deployed by the hooks are no longer held by the escrow account. Whenever
+// the transfer module needs to unescrow tokens, for a packet received from the
+// counterparty or for a refund, and the escrow account balance is insufficient,
+// RecallEscrow is invoked with the shortfall. The hooks must return the recalled
+// funds to the escrow account before returning, the transfer module verifies the
+// escrow balance afterwards and fails the unescrow otherwise.
+type EscrowYieldHooks interface {
+	// RecallEscrow must transfer at least the given amount back into the escrow
+	// account within the same transaction.
+	RecallEscrow(ctx sdk.Context, escrowAddress sdk.AccAddress, amount sdk.Coin) error
+}
+
+// EscrowYieldReporter defines an optional interface which escrow yield hooks may
+// implement to report the funds they deployed from each escrow account. The reported
+// funds are added to the escrow balances when the total escrow invariant is checked,
+// so that a strategy losing escrowed funds breaks the invariant. The invariant is not
+// checked if the hooks do not implement it.
+type EscrowYieldReporter interface {
+	// DeployedEscrow returns the funds deployed from the escrow account which are
+	// recallable through RecallEscrow.
+	DeployedEscrow(ctx sdk.Context, escrowAddress sdk.AccAddress) sdk.Coins
+}
+
+// TransferHooks defines an optional interface which allows downstream modules to react
+// to the transfers received by the chain, for example to execute the instructions carried
+// by the memo of the packet, such as staking the received tokens or calling a contract.
+type TransferHooks interface {
+	// AfterRecvTransfer is called once the tokens of a received packet have been credited
+	// to the receiver, with the denomination credited on this chain. The memo of the packet
+	// data may be empty. Returning an error fails the receive, all the state changes of the
+	// packet are reverted and the sender is refunded on the source chain.
+	AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, receivedDenom string) error
+}
@@ -15,6 +15,14 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// VersionReceivedDenom defines the version of the IBC transfer module in which the
+	// successful acknowledgements carry the denomination credited on the destination chain
+	VersionReceivedDenom = "ics20-1-received-denom"
+
+	// VersionProtobuf defines the version of the IBC transfer module in which the packet data
+	// is encoded in protobuf instead of JSON
+	VersionProtobuf = "ics20-2"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -36,8 +44,41 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// EscrowSnapshotKey defines the key prefix to store the escrow snapshots in store
+	EscrowSnapshotKey = []byte{0x03}
+	// CounterpartyModuleAccountsKey defines the key prefix to store the module accounts
+	// registered for the counterparty chains of channels in store
+	CounterpartyModuleAccountsKey = []byte{0x04}
+	// TotalEscrowForDenomKey defines the key prefix to store the total amount escrowed by
+	// the transfer channels for each denomination in store
+	TotalEscrowForDenomKey = []byte{0x05}
 )
 
+// KeyEscrowSnapshotChannel returns the key prefix of the escrow snapshots of the provided
+// channel. The channel is terminated by a slash, which identifiers cannot contain.
+func KeyEscrowSnapshotChannel(portID, channelID string) []byte {
+	return append(append([]byte{}, EscrowSnapshotKey...), []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+}
+
+// KeyEscrowSnapshot returns the key under which the escrow snapshot of the provided channel
+// taken at the provided height is stored. The height is big endian encoded so that the
+// snapshots of a channel are ordered by height.
+func KeyEscrowSnapshot(portID, channelID string, height uint64) []byte {
+	return append(KeyEscrowSnapshotChannel(portID, channelID), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyCounterpartyModuleAccounts returns the key under which the module accounts registered
+// for the counterparty chain of the provided channel are stored.
+func KeyCounterpartyModuleAccounts(portID, channelID string) []byte {
+	return append(append([]byte{}, CounterpartyModuleAccountsKey...), []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// KeyTotalEscrowForDenom returns the key under which the total amount of the provided
+// denomination escrowed by the transfer channels is stored.
+func KeyTotalEscrowForDenom(denom string) []byte {
+	return append(append([]byte{}, TotalEscrowForDenomKey...), []byte(denom)...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -53,3 +94,9 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// IsSupportedVersion returns true if the provided channel version is supported by the IBC
+// transfer module.
+func IsSupportedVersion(version string) bool {
+	return version == Version || version == VersionReceivedDenom || version == VersionProtobuf
+}
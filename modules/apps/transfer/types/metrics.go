@@ -0,0 +1,10 @@
+package types
+
+// Reasons used to label the transfer failure and refund telemetry counters.
+const (
+	FailureReasonTimeout         = "timeout"
+	FailureReasonAckError        = "ack-error"
+	FailureReasonReceiveDisabled = "receive-disabled"
+	FailureReasonBlockedAddress  = "blocked-address"
+	FailureReasonDustThreshold   = "dust-threshold"
+)
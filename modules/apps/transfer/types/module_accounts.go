@@ -0,0 +1,77 @@
+package types
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// moduleAccountReceiverKey is the context key under which the transfers to registered module
+// accounts are allowed
+type moduleAccountReceiverKey struct{}
+
+// WithModuleAccountReceiverAllowed returns a context in which the transfers sent with
+// SendTransfer may be received by a module account registered for the counterparty chain.
+func WithModuleAccountReceiverAllowed(ctx sdk.Context) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), moduleAccountReceiverKey{}, true))
+}
+
+// IsModuleAccountReceiverAllowed returns true if the transfers sent with the given context may
+// be received by a module account registered for the counterparty chain.
+func IsModuleAccountReceiverAllowed(ctx sdk.Context) bool {
+	allowed, _ := ctx.Context().Value(moduleAccountReceiverKey{}).(bool)
+	return allowed
+}
+
+// NewCounterpartyModuleAccounts creates a new registration of the module accounts of the
+// counterparty chain of the provided channel.
+func NewCounterpartyModuleAccounts(portID, channelID string, addresses []string) CounterpartyModuleAccounts {
+	return CounterpartyModuleAccounts{
+		PortId:    portID,
+		ChannelId: channelID,
+		Addresses: addresses,
+	}
+}
+
+// Validate performs a basic validation of the counterparty module accounts. The addresses
+// belong to the counterparty chain and are only checked for blanks and duplicates.
+func (cma CounterpartyModuleAccounts) Validate() error {
+	if err := host.PortIdentifierValidator(cma.PortId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidModuleAccounts, "invalid port ID: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(cma.ChannelId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidModuleAccounts, "invalid channel ID: %s", err)
+	}
+
+	seenAddresses := make(map[string]bool)
+	for i, address := range cma.Addresses {
+		if strings.TrimSpace(address) == "" {
+			return sdkerrors.Wrapf(ErrInvalidModuleAccounts, "address %d cannot be blank", i)
+		}
+
+		// addresses are compared case-insensitively, as bech32 and hex addresses are
+		normalized := strings.ToLower(address)
+		if seenAddresses[normalized] {
+			return sdkerrors.Wrapf(ErrInvalidModuleAccounts, "duplicated address %s", address)
+		}
+		seenAddresses[normalized] = true
+	}
+
+	return nil
+}
+
+// Contains returns true if the provided receiver is one of the registered module accounts.
+func (cma CounterpartyModuleAccounts) Contains(receiver string) bool {
+	for _, address := range cma.Addresses {
+		if strings.EqualFold(address, receiver) {
+			return true
+		}
+	}
+
+	return false
+}
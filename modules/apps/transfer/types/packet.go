@@ -1,36 +1,32 @@
 package types
 
 import (
+	"bytes"
 	"strings"
-	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-)
 
-var (
-	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
-	// to the current block height of the counterparty chain provided by the client state. The
-	// timeout is disabled when set to 0.
-	DefaultRelativePacketTimeoutHeight = "0-1000"
-
-	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
-	// relative to the current block timestamp of the counterparty chain provided by the client
-	// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
-	// timeout.
-	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 )
 
+// MaximumMemoLength defines the maximum length, in bytes, of the memo of a transfer
+const MaximumMemoLength = 32768
+
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
 	denom string, amount string,
 	sender, receiver string,
+	memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -51,10 +47,75 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if strings.TrimSpace(ftpd.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
+	if len(ftpd.Memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// EncodePacketData returns the encoding of the packet data sent over a channel with the provided
+// version. The packet data is encoded in protobuf on channels negotiated with VersionProtobuf
+// and in JSON otherwise.
+func EncodePacketData(version string, data FungibleTokenPacketData) []byte {
+	if version != VersionProtobuf {
+		return data.GetBytes()
+	}
+
+	bz, err := data.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// DecodePacketData decodes the packet data sent over a channel with the provided version.
+func DecodePacketData(version string, bz []byte) (FungibleTokenPacketData, error) {
+	var data FungibleTokenPacketData
+	if version != VersionProtobuf {
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return FungibleTokenPacketData{}, err
+		}
+
+		return data, nil
+	}
+
+	if err := data.Unmarshal(bz); err != nil {
+		return FungibleTokenPacketData{}, err
+	}
+
+	return data, nil
+}
+
+// RegisterPacketDataCodecs registers on the given packet data codecs the codecs decoding the
+// packet data of the transfer channel versions which do not encode it in JSON, so that middleware
+// decode the packet data of every transfer channel. The given packet data codecs are returned.
+func RegisterPacketDataCodecs(codecs *porttypes.PacketDataCodecs) *porttypes.PacketDataCodecs {
+	return codecs.Register(VersionProtobuf, porttypes.NewProtoPacketDataCodec(func() porttypes.ProtoPacketData {
+		return &FungibleTokenPacketData{}
+	}))
+}
+
+// GetBytes is a helper for serialising. An empty memo is omitted so that the packets without
+// memo are serialised as before the memo was introduced.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// mustProtoMarshalJSON returns the proto3 JSON encoding of the packet data with the same
+// options as the module codec, except that unpopulated fields are not emitted.
+func mustProtoMarshalJSON(ftpd *FungibleTokenPacketData) []byte {
+	jm := &jsonpb.Marshaler{OrigName: true, EmitDefaults: false}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, ftpd); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
+
+// GetBytes is a helper for serialising
+func (r FungibleTokenPacketAcknowledgementResult) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&r))
 }
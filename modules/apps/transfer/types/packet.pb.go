@@ -34,6 +34,8 @@ type FungibleTokenPacketData struct {
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional memo, e.g. instructions for the application hooks of the destination chain
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -97,8 +99,67 @@ func (m *FungibleTokenPacketData) GetReceiver() string {
 	return ""
 }
 
+func (m *FungibleTokenPacketData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// FungibleTokenPacketAcknowledgementResult defines the result of a successful acknowledgement
+// on channels negotiated with the received denom version. It carries the denomination
+// credited to the receiver on the destination chain.
+type FungibleTokenPacketAcknowledgementResult struct {
+	// the denomination credited on the destination chain, either the voucher denomination
+	// minted or the denomination unescrowed
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FungibleTokenPacketAcknowledgementResult) Reset() {
+	*m = FungibleTokenPacketAcknowledgementResult{}
+}
+func (m *FungibleTokenPacketAcknowledgementResult) String() string { return proto.CompactTextString(m) }
+func (*FungibleTokenPacketAcknowledgementResult) ProtoMessage()    {}
+func (*FungibleTokenPacketAcknowledgementResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{1}
+}
+func (m *FungibleTokenPacketAcknowledgementResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FungibleTokenPacketAcknowledgementResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FungibleTokenPacketAcknowledgementResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FungibleTokenPacketAcknowledgementResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FungibleTokenPacketAcknowledgementResult.Merge(m, src)
+}
+func (m *FungibleTokenPacketAcknowledgementResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FungibleTokenPacketAcknowledgementResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FungibleTokenPacketAcknowledgementResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FungibleTokenPacketAcknowledgementResult proto.InternalMessageInfo
+
+func (m *FungibleTokenPacketAcknowledgementResult) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
+	proto.RegisterType((*FungibleTokenPacketAcknowledgementResult)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketAcknowledgementResult")
 }
 
 func init() {
@@ -106,23 +167,25 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xbd, 0x4a, 0x04, 0x31,
-	0x14, 0x46, 0x27, 0xfe, 0x2c, 0x9a, 0x72, 0x10, 0x1d, 0x44, 0x82, 0x58, 0x69, 0x61, 0x02, 0xbb,
-	0x85, 0xbd, 0x88, 0xb5, 0x8a, 0x95, 0x5d, 0x92, 0xb9, 0x8e, 0x61, 0x27, 0xb9, 0x21, 0xc9, 0x0c,
-	0x88, 0x2f, 0xe1, 0x63, 0x59, 0x6e, 0x69, 0x29, 0x33, 0x2f, 0x22, 0x9b, 0xd1, 0x65, 0xcb, 0x73,
-	0xee, 0x77, 0x8b, 0x43, 0xaf, 0x8c, 0xd2, 0x42, 0x7a, 0xdf, 0x1a, 0x2d, 0x93, 0x41, 0x17, 0x45,
-	0x0a, 0xd2, 0xc5, 0x57, 0x08, 0xa2, 0x9f, 0x0b, 0x2f, 0xf5, 0x12, 0x12, 0xf7, 0x01, 0x13, 0x96,
-	0x67, 0x46, 0x69, 0xbe, 0x3d, 0xe5, 0xff, 0x53, 0xde, 0xcf, 0x2f, 0x3e, 0xe8, 0xc9, 0x7d, 0xe7,
-	0x1a, 0xa3, 0x5a, 0x78, 0xc6, 0x25, 0xb8, 0x87, 0xfc, 0x7a, 0x27, 0x93, 0x2c, 0x8f, 0xe8, 0x7e,
-	0x0d, 0x0e, 0x6d, 0x45, 0xce, 0xc9, 0xe5, 0xe1, 0xd3, 0x04, 0xe5, 0x31, 0x9d, 0x49, 0x8b, 0x9d,
-	0x4b, 0xd5, 0x4e, 0xd6, 0x7f, 0xb4, 0xf6, 0x11, 0x5c, 0x0d, 0xa1, 0xda, 0x9d, 0xfc, 0x44, 0xe5,
-	0x29, 0x3d, 0x08, 0xa0, 0xc1, 0xf4, 0x10, 0xaa, 0xbd, 0x7c, 0xd9, 0xf0, 0xed, 0xe3, 0xd7, 0xc0,
-	0xc8, 0x6a, 0x60, 0xe4, 0x67, 0x60, 0xe4, 0x73, 0x64, 0xc5, 0x6a, 0x64, 0xc5, 0xf7, 0xc8, 0x8a,
-	0x97, 0x9b, 0xc6, 0xa4, 0xb7, 0x4e, 0x71, 0x8d, 0x56, 0x68, 0x8c, 0x16, 0xa3, 0x30, 0x4a, 0x5f,
-	0x37, 0x28, 0xfa, 0x85, 0xb0, 0x58, 0x77, 0x2d, 0xc4, 0x75, 0xff, 0x56, 0x77, 0x7a, 0xf7, 0x10,
-	0xd5, 0x2c, 0x47, 0x2f, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x82, 0x62, 0x02, 0xc6, 0x21, 0x01,
-	0x00, 0x00,
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xeb, 0xff, 0x6f, 0x2b, 0xf0, 0x68, 0x21, 0x88, 0x10, 0xb2, 0x50, 0xa7, 0x32, 0x10,
+	0x4b, 0xed, 0xc0, 0x0a, 0x08, 0x31, 0x43, 0xc5, 0xc4, 0x66, 0x3b, 0x97, 0x60, 0x35, 0xf6, 0x8d,
+	0x6c, 0x27, 0x88, 0xa7, 0x80, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0x9a, 0x17, 0x41, 0x75, 0x00, 0x75,
+	0xe8, 0xe6, 0xef, 0xf3, 0xb9, 0xd2, 0xd1, 0xa1, 0x67, 0x46, 0x69, 0x21, 0xeb, 0xba, 0x32, 0x5a,
+	0x46, 0x83, 0x2e, 0x88, 0xe8, 0xa5, 0x0b, 0x4f, 0xe0, 0x45, 0x3b, 0x13, 0xb5, 0xd4, 0x4b, 0x88,
+	0x79, 0xed, 0x31, 0x22, 0x3b, 0x31, 0x4a, 0xe7, 0xdb, 0xd1, 0xfc, 0x37, 0x9a, 0xb7, 0xb3, 0xc9,
+	0x1b, 0xa1, 0x47, 0xb7, 0x8d, 0x2b, 0x8d, 0xaa, 0xe0, 0x01, 0x97, 0xe0, 0xee, 0xd2, 0xed, 0x8d,
+	0x8c, 0x92, 0x1d, 0xd0, 0x51, 0x01, 0x0e, 0x6d, 0x46, 0x4e, 0xc9, 0x74, 0x7f, 0xd1, 0x03, 0x3b,
+	0xa4, 0x63, 0x69, 0xb1, 0x71, 0x31, 0xfb, 0x97, 0xf4, 0x0f, 0x6d, 0x7c, 0x00, 0x57, 0x80, 0xcf,
+	0xfe, 0xf7, 0xbe, 0x27, 0x76, 0x4c, 0xf7, 0x3c, 0x68, 0x30, 0x2d, 0xf8, 0x6c, 0x98, 0x7e, 0xfe,
+	0x98, 0x31, 0x3a, 0xb4, 0x60, 0x31, 0x1b, 0x25, 0x9f, 0xde, 0x93, 0x4b, 0x3a, 0xdd, 0x51, 0xe8,
+	0x4a, 0x2f, 0x1d, 0xbe, 0x54, 0x50, 0x94, 0x60, 0xc1, 0xc5, 0x05, 0x84, 0xa6, 0x8a, 0xbb, 0x1b,
+	0x5e, 0xdf, 0x7f, 0xac, 0x39, 0x59, 0xad, 0x39, 0xf9, 0x5a, 0x73, 0xf2, 0xde, 0xf1, 0xc1, 0xaa,
+	0xe3, 0x83, 0xcf, 0x8e, 0x0f, 0x1e, 0x2f, 0x4a, 0x13, 0x9f, 0x1b, 0x95, 0x6b, 0xb4, 0x42, 0x63,
+	0xb0, 0x18, 0x84, 0x51, 0xfa, 0xbc, 0x44, 0xd1, 0xce, 0x85, 0xc5, 0xa2, 0xa9, 0x20, 0x6c, 0x66,
+	0xdd, 0x9a, 0x33, 0xbe, 0xd6, 0x10, 0xd4, 0x38, 0x6d, 0x39, 0xff, 0x1e, 0x00, 0xc8, 0xc5, 0xcf,
+	0x28, 0x78, 0x01, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -145,6 +208,13 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -176,6 +246,36 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *FungibleTokenPacketAcknowledgementResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FungibleTokenPacketAcknowledgementResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FungibleTokenPacketAcknowledgementResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -209,6 +309,23 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *FungibleTokenPacketAcknowledgementResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -375,6 +492,120 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FungibleTokenPacketAcknowledgementResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FungibleTokenPacketAcknowledgementResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FungibleTokenPacketAcknowledgementResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 )
 
 const (
@@ -20,15 +24,17 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo"), true},
+		{"memo too long", NewFungibleTokenPacketData(denom, amount, addr1, addr2, strings.Repeat("a", MaximumMemoLength+1)), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +46,51 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that the memo is omitted from the packet bytes if
+// empty, keeping the packet encoding of counterparties without memo support.
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	expected := fmt.Sprintf(`{"amount":"100","denom":"transfer/gaiachannel/atom","receiver":"%s","sender":"%s"}`, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+
+	packetData.Memo = "memo"
+	expected = fmt.Sprintf(`{"amount":"100","denom":"transfer/gaiachannel/atom","memo":"memo","receiver":"%s","sender":"%s"}`, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+}
+
+func TestEncodePacketData(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+
+	for _, version := range []string{Version, VersionReceivedDenom, VersionProtobuf} {
+		bz := EncodePacketData(version, packetData)
+
+		decoded, err := DecodePacketData(version, bz)
+		require.NoError(t, err, version)
+		require.Equal(t, packetData, decoded, version)
+	}
+
+	// the packet data is encoded in protobuf on channels negotiated with the protobuf version
+	bz, err := packetData.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, bz, EncodePacketData(VersionProtobuf, packetData))
+	require.Equal(t, packetData.GetBytes(), EncodePacketData(Version, packetData))
+
+	_, err = DecodePacketData(VersionProtobuf, packetData.GetBytes())
+	require.Error(t, err)
+
+	_, err = DecodePacketData(Version, bz)
+	require.Error(t, err)
+}
+
+func TestRegisterPacketDataCodecs(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	expected := porttypes.PacketData{Sender: addr1, Receiver: addr2, Memo: "memo", Denom: denom, Amount: amount}
+
+	codecs := RegisterPacketDataCodecs(porttypes.NewPacketDataCodecs())
+	for _, version := range []string{Version, VersionReceivedDenom, VersionProtobuf} {
+		decoded, err := codecs.DecodePacketData(version, EncodePacketData(version, packetData))
+		require.NoError(t, err, version)
+		require.Equal(t, expected, decoded, version)
+	}
+}
@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,13 +14,42 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+
+	// MaxFeeBasisPoints is the exclusive upper bound of the FeeBasisPoints param
+	MaxFeeBasisPoints = 10000
+
+	// DefaultTimeoutHeightOffset is the default number of blocks added to the latest height of
+	// the counterparty client for transfers without timeouts
+	DefaultTimeoutHeightOffset = uint64(1000)
 )
 
+// DefaultTimeoutTimestampDuration is the default duration, in nanoseconds, added to the block
+// time for transfers without timeouts. It is set to 10 minutes.
+var DefaultTimeoutTimestampDuration = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
 var (
 	// KeySendEnabled is store's key for SendEnabled Params
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyReceiveDustThresholds is store's key for ReceiveDustThresholds Params
+	KeyReceiveDustThresholds = []byte("ReceiveDustThresholds")
+	// KeyFeeBasisPoints is store's key for FeeBasisPoints Params
+	KeyFeeBasisPoints = []byte("FeeBasisPoints")
+	// KeyFeeCollector is store's key for FeeCollector Params
+	KeyFeeCollector = []byte("FeeCollector")
+	// KeyFeeExemptAddresses is store's key for FeeExemptAddresses Params
+	KeyFeeExemptAddresses = []byte("FeeExemptAddresses")
+	// KeyDefaultTimeoutHeightOffset is store's key for DefaultTimeoutHeightOffset Params
+	KeyDefaultTimeoutHeightOffset = []byte("DefaultTimeoutHeightOffset")
+	// KeyDefaultTimeoutTimestampDuration is store's key for DefaultTimeoutTimestampDuration Params
+	KeyDefaultTimeoutTimestampDuration = []byte("DefaultTimeoutTimestampDuration")
+	// KeyEscrowSnapshotInterval is store's key for EscrowSnapshotInterval Params
+	KeyEscrowSnapshotInterval = []byte("EscrowSnapshotInterval")
+	// KeyEscrowSnapshotRetention is store's key for EscrowSnapshotRetention Params
+	KeyEscrowSnapshotRetention = []byte("EscrowSnapshotRetention")
+	// KeyProtocolExemptAccounts is store's key for ProtocolExemptAccounts Params
+	KeyProtocolExemptAccounts = []byte("ProtocolExemptAccounts")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -35,7 +67,10 @@ func NewParams(enableSend, enableReceive bool) Params {
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	params := NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	params.DefaultTimeoutHeightOffset = DefaultTimeoutHeightOffset
+	params.DefaultTimeoutTimestampDuration = DefaultTimeoutTimestampDuration
+	return params
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +79,43 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateDustThresholds(p.ReceiveDustThresholds); err != nil {
+		return err
+	}
+
+	if err := validateFeeBasisPoints(p.FeeBasisPoints); err != nil {
+		return err
+	}
+
+	if err := validateFeeCollector(p.FeeCollector); err != nil {
+		return err
+	}
+
+	if err := validateFeeExemptAddresses(p.FeeExemptAddresses); err != nil {
+		return err
+	}
+
+	if err := validateTimeout(p.DefaultTimeoutHeightOffset); err != nil {
+		return err
+	}
+
+	if err := validateTimeout(p.DefaultTimeoutTimestampDuration); err != nil {
+		return err
+	}
+
+	if err := validateBlocks(p.EscrowSnapshotInterval); err != nil {
+		return err
+	}
+
+	if err := validateBlocks(p.EscrowSnapshotRetention); err != nil {
+		return err
+	}
+
+	return validateProtocolExemptAccounts(p.ProtocolExemptAccounts)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +123,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyReceiveDustThresholds, p.ReceiveDustThresholds, validateDustThresholds),
+		paramtypes.NewParamSetPair(KeyFeeBasisPoints, p.FeeBasisPoints, validateFeeBasisPoints),
+		paramtypes.NewParamSetPair(KeyFeeCollector, p.FeeCollector, validateFeeCollector),
+		paramtypes.NewParamSetPair(KeyFeeExemptAddresses, p.FeeExemptAddresses, validateFeeExemptAddresses),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutHeightOffset, p.DefaultTimeoutHeightOffset, validateTimeout),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutTimestampDuration, p.DefaultTimeoutTimestampDuration, validateTimeout),
+		paramtypes.NewParamSetPair(KeyEscrowSnapshotInterval, p.EscrowSnapshotInterval, validateBlocks),
+		paramtypes.NewParamSetPair(KeyEscrowSnapshotRetention, p.EscrowSnapshotRetention, validateBlocks),
+		paramtypes.NewParamSetPair(KeyProtocolExemptAccounts, p.ProtocolExemptAccounts, validateProtocolExemptAccounts),
 	}
 }
 
@@ -63,3 +143,98 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateDustThresholds(i interface{}) error {
+	thresholds, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := thresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid receive dust thresholds: %w", err)
+	}
+
+	return nil
+}
+
+func validateFeeBasisPoints(i interface{}) error {
+	feeBasisPoints, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if feeBasisPoints >= MaxFeeBasisPoints {
+		return fmt.Errorf("fee basis points must be less than %d: %d", MaxFeeBasisPoints, feeBasisPoints)
+	}
+
+	return nil
+}
+
+func validateFeeCollector(i interface{}) error {
+	feeCollector, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if feeCollector != strings.TrimSpace(feeCollector) {
+		return fmt.Errorf("fee collector must not contain leading or trailing whitespace: %q", feeCollector)
+	}
+
+	return nil
+}
+
+func validateFeeExemptAddresses(i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid fee exempt address %s: %w", address, err)
+		}
+	}
+
+	return nil
+}
+
+func validateTimeout(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateProtocolExemptAccounts checks that every protocol exempt account is either a valid
+// address or a module account name, and that no account is listed twice.
+func validateProtocolExemptAccounts(i interface{}) error {
+	accounts, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		if strings.TrimSpace(account) == "" || account != strings.TrimSpace(account) {
+			return fmt.Errorf("invalid protocol exempt account %q", account)
+		}
+
+		if seen[account] {
+			return fmt.Errorf("duplicate protocol exempt account %s", account)
+		}
+		seen[account] = true
+	}
+
+	return nil
+}
@@ -3,10 +3,48 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
 	require.NoError(t, NewParams(true, false).Validate())
+
+	params := DefaultParams()
+	params.ReceiveDustThresholds = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+	require.NoError(t, params.Validate())
+
+	params.ReceiveDustThresholds = sdk.Coins{sdk.NewInt64Coin("uosmo", 10), sdk.NewInt64Coin("uatom", 10)}
+	require.Error(t, params.Validate(), "unsorted thresholds")
+
+	params.ReceiveDustThresholds = sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.ZeroInt()}}
+	require.Error(t, params.Validate(), "zero threshold")
+
+	params = DefaultParams()
+	params.FeeBasisPoints = 50
+	params.FeeCollector = "feecollector"
+	params.FeeExemptAddresses = []string{sdk.AccAddress("exempt").String()}
+	require.NoError(t, params.Validate())
+
+	params.FeeBasisPoints = MaxFeeBasisPoints
+	require.Error(t, params.Validate(), "fee of 100%")
+
+	params.FeeBasisPoints = 50
+	params.FeeCollector = " feecollector"
+	require.Error(t, params.Validate(), "fee collector with whitespace")
+
+	params.FeeCollector = ""
+	params.FeeExemptAddresses = []string{"invalid"}
+	require.Error(t, params.Validate(), "invalid exempt address")
+
+	params = DefaultParams()
+	params.ProtocolExemptAccounts = []string{sdk.AccAddress("keeper").String(), "distribution"}
+	require.NoError(t, params.Validate())
+
+	params.ProtocolExemptAccounts = []string{"distribution", "distribution"}
+	require.Error(t, params.Validate(), "duplicate protocol exempt account")
+
+	params.ProtocolExemptAccounts = []string{" "}
+	require.Error(t, params.Validate(), "empty protocol exempt account")
 }
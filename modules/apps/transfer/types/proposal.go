@@ -0,0 +1,134 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeDenomTraceCorrection defines the type for a DenomTraceCorrectionProposal
+	ProposalTypeDenomTraceCorrection = "DenomTraceCorrection"
+	// ProposalTypeCounterpartyModuleAccounts defines the type for a CounterpartyModuleAccountsProposal
+	ProposalTypeCounterpartyModuleAccounts = "CounterpartyModuleAccounts"
+)
+
+var (
+	_ govtypes.Content = &DenomTraceCorrectionProposal{}
+	_ govtypes.Content = &CounterpartyModuleAccountsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDenomTraceCorrection)
+	govtypes.RegisterProposalType(ProposalTypeCounterpartyModuleAccounts)
+}
+
+// NewDenomTraceCorrection creates a new correction of the denomination trace stored under
+// the provided hex encoded hash.
+func NewDenomTraceCorrection(hash string, trace DenomTrace) DenomTraceCorrection {
+	return DenomTraceCorrection{
+		Hash:  hash,
+		Trace: trace,
+	}
+}
+
+// Validate performs a basic validation of the denomination trace correction. The corrected
+// trace must be the trace of a voucher.
+func (c DenomTraceCorrection) Validate() error {
+	if _, err := ParseHexHash(c.Hash); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTraceCorrection, "invalid trace hash %s: %s", c.Hash, err)
+	}
+
+	if strings.TrimSpace(c.Trace.Path) == "" {
+		return sdkerrors.Wrap(ErrInvalidTraceCorrection, "corrected trace path cannot be blank")
+	}
+
+	if err := c.Trace.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTraceCorrection, "invalid corrected trace: %s", err)
+	}
+
+	return nil
+}
+
+// NewDenomTraceCorrectionProposal creates a new denomination trace correction proposal.
+func NewDenomTraceCorrectionProposal(title, description string, corrections []DenomTraceCorrection) govtypes.Content {
+	return &DenomTraceCorrectionProposal{
+		Title:       title,
+		Description: description,
+		Corrections: corrections,
+	}
+}
+
+// GetTitle returns the title of a denomination trace correction proposal.
+func (dtcp *DenomTraceCorrectionProposal) GetTitle() string { return dtcp.Title }
+
+// GetDescription returns the description of a denomination trace correction proposal.
+func (dtcp *DenomTraceCorrectionProposal) GetDescription() string { return dtcp.Description }
+
+// ProposalRoute returns the routing key of a denomination trace correction proposal.
+func (dtcp *DenomTraceCorrectionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a denomination trace correction proposal.
+func (dtcp *DenomTraceCorrectionProposal) ProposalType() string {
+	return ProposalTypeDenomTraceCorrection
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (dtcp *DenomTraceCorrectionProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(dtcp); err != nil {
+		return err
+	}
+
+	if len(dtcp.Corrections) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTraceCorrection, "proposal must contain at least one correction")
+	}
+
+	seenHashes := make(map[string]bool)
+	for i, correction := range dtcp.Corrections {
+		if err := correction.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "correction %d", i)
+		}
+
+		hash := strings.ToUpper(correction.Hash)
+		if seenHashes[hash] {
+			return sdkerrors.Wrapf(ErrInvalidTraceCorrection, "duplicated correction of trace hash %s", hash)
+		}
+		seenHashes[hash] = true
+	}
+
+	return nil
+}
+
+// NewCounterpartyModuleAccountsProposal creates a new proposal registering the module accounts
+// of the counterparty chain of a transfer channel.
+func NewCounterpartyModuleAccountsProposal(title, description string, moduleAccounts CounterpartyModuleAccounts) govtypes.Content {
+	return &CounterpartyModuleAccountsProposal{
+		Title:          title,
+		Description:    description,
+		ModuleAccounts: moduleAccounts,
+	}
+}
+
+// GetTitle returns the title of a counterparty module accounts proposal.
+func (cmap *CounterpartyModuleAccountsProposal) GetTitle() string { return cmap.Title }
+
+// GetDescription returns the description of a counterparty module accounts proposal.
+func (cmap *CounterpartyModuleAccountsProposal) GetDescription() string { return cmap.Description }
+
+// ProposalRoute returns the routing key of a counterparty module accounts proposal.
+func (cmap *CounterpartyModuleAccountsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a counterparty module accounts proposal.
+func (cmap *CounterpartyModuleAccountsProposal) ProposalType() string {
+	return ProposalTypeCounterpartyModuleAccounts
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (cmap *CounterpartyModuleAccountsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cmap); err != nil {
+		return err
+	}
+
+	return cmap.ModuleAccounts.Validate()
+}
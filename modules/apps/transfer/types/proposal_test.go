@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestDenomTraceCorrectionProposalValidateBasic(t *testing.T) {
+	trace := types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "gamm/pool/1"}
+	hash := types.DenomTrace{Path: "transfer/channel-0/gamm/pool", BaseDenom: "1"}.Hash().String()
+	correction := types.NewDenomTraceCorrection(hash, trace)
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"success", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, []types.DenomTraceCorrection{correction}), true},
+		{"invalid title", types.NewDenomTraceCorrectionProposal("", ibctesting.Description, []types.DenomTraceCorrection{correction}), false},
+		{"no corrections", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, nil), false},
+		{"invalid hash", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, []types.DenomTraceCorrection{types.NewDenomTraceCorrection("hash", trace)}), false},
+		{"native denomination", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, []types.DenomTraceCorrection{types.NewDenomTraceCorrection(hash, types.DenomTrace{BaseDenom: "uatom"})}), false},
+		{"invalid corrected trace", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, []types.DenomTraceCorrection{types.NewDenomTraceCorrection(hash, types.DenomTrace{Path: "transfer", BaseDenom: "uatom"})}), false},
+		{"duplicated correction", types.NewDenomTraceCorrectionProposal(ibctesting.Title, ibctesting.Description, []types.DenomTraceCorrection{correction, correction}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCounterpartyModuleAccountsProposalValidateBasic(t *testing.T) {
+	moduleAccounts := types.NewCounterpartyModuleAccounts(types.PortID, ibctesting.FirstChannelID, []string{"cosmos1module", "cosmos1other"})
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"success", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, moduleAccounts), true},
+		{"success: registration removed", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, types.NewCounterpartyModuleAccounts(types.PortID, ibctesting.FirstChannelID, nil)), true},
+		{"invalid title", types.NewCounterpartyModuleAccountsProposal("", ibctesting.Description, moduleAccounts), false},
+		{"invalid port", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, types.NewCounterpartyModuleAccounts("(invalidport)", ibctesting.FirstChannelID, []string{"cosmos1module"})), false},
+		{"invalid channel", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, types.NewCounterpartyModuleAccounts(types.PortID, "(invalidchannel)", []string{"cosmos1module"})), false},
+		{"blank address", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, types.NewCounterpartyModuleAccounts(types.PortID, ibctesting.FirstChannelID, []string{" "})), false},
+		{"duplicated address", types.NewCounterpartyModuleAccountsProposal(ibctesting.Title, ibctesting.Description, types.NewCounterpartyModuleAccounts(types.PortID, ibctesting.FirstChannelID, []string{"cosmos1module", "COSMOS1MODULE"})), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -91,6 +97,39 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// receive_dust_thresholds defines, per local denomination, the minimum amount
+	// an incoming transfer must carry. Transfers of a smaller amount are rejected
+	// with an error acknowledgement instead of minting or unescrowing dust.
+	ReceiveDustThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=receive_dust_thresholds,json=receiveDustThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"receive_dust_thresholds" yaml:"receive_dust_thresholds"`
+	// fee_basis_points defines the fee, in basis points of the transferred amount,
+	// retained from outgoing and incoming transfers. A value of 0 disables the fee.
+	FeeBasisPoints uint32 `protobuf:"varint,4,opt,name=fee_basis_points,json=feeBasisPoints,proto3" json:"fee_basis_points,omitempty" yaml:"fee_basis_points"`
+	// fee_collector is the name of the module account receiving retained fees. If
+	// empty, retained fees are sent to the community pool.
+	FeeCollector string `protobuf:"bytes,5,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty" yaml:"fee_collector"`
+	// fee_exempt_addresses defines the addresses from which no fee is retained.
+	FeeExemptAddresses []string `protobuf:"bytes,6,rep,name=fee_exempt_addresses,json=feeExemptAddresses,proto3" json:"fee_exempt_addresses,omitempty" yaml:"fee_exempt_addresses"`
+	// default_timeout_height_offset defines the number of blocks added to the
+	// latest height of the counterparty client to obtain the timeout height of a
+	// transfer which sets neither a timeout height nor a timeout timestamp. A
+	// value of 0 disables the default timeout height.
+	DefaultTimeoutHeightOffset uint64 `protobuf:"varint,7,opt,name=default_timeout_height_offset,json=defaultTimeoutHeightOffset,proto3" json:"default_timeout_height_offset,omitempty" yaml:"default_timeout_height_offset"`
+	// default_timeout_timestamp_duration defines the duration, in nanoseconds,
+	// added to the block time to obtain the timeout timestamp of a transfer which
+	// sets neither a timeout height nor a timeout timestamp. A value of 0 disables
+	// the default timeout timestamp.
+	DefaultTimeoutTimestampDuration uint64 `protobuf:"varint,8,opt,name=default_timeout_timestamp_duration,json=defaultTimeoutTimestampDuration,proto3" json:"default_timeout_timestamp_duration,omitempty" yaml:"default_timeout_timestamp_duration"`
+	// escrow_snapshot_interval defines the number of blocks between two snapshots
+	// of the escrow balances of the transfer channels. A value of 0 disables the
+	// escrow snapshots.
+	EscrowSnapshotInterval uint64 `protobuf:"varint,9,opt,name=escrow_snapshot_interval,json=escrowSnapshotInterval,proto3" json:"escrow_snapshot_interval,omitempty" yaml:"escrow_snapshot_interval"`
+	// escrow_snapshot_retention defines the number of blocks for which escrow
+	// snapshots are kept. A value of 0 keeps escrow snapshots indefinitely.
+	EscrowSnapshotRetention uint64 `protobuf:"varint,10,opt,name=escrow_snapshot_retention,json=escrowSnapshotRetention,proto3" json:"escrow_snapshot_retention,omitempty" yaml:"escrow_snapshot_retention"`
+	// protocol_exempt_accounts defines the addresses and module account names of
+	// protocol-owned accounts whose transfers are exempt from the transfer fee and
+	// from the quotas of the rate limiting middleware.
+	ProtocolExemptAccounts []string `protobuf:"bytes,11,rep,name=protocol_exempt_accounts,json=protocolExemptAccounts,proto3" json:"protocol_exempt_accounts,omitempty" yaml:"protocol_exempt_accounts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,168 +179,2827 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
-	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+func (m *Params) GetReceiveDustThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiveDustThresholds
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+func (m *Params) GetFeeBasisPoints() uint32 {
+	if m != nil {
+		return m.FeeBasisPoints
+	}
+	return 0
 }
 
-var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0xe9, 0x42, 0xe8, 0x46, 0xa8, 0xba, 0xd7, 0xe2, 0xca, 0x4d, 0x49, 0x32, 0xc7, 0x69, 0x60,
-	0x32, 0x19, 0x92, 0x74, 0xa0, 0x8f, 0xe0, 0xce, 0xc7, 0x72, 0xd9, 0xa5, 0xab, 0x22, 0xed, 0x1b,
-	0xf4, 0x09, 0x64, 0xd2, 0x52, 0x06, 0x77, 0xff, 0x39, 0xe7, 0xfb, 0xce, 0xe2, 0xc7, 0x37, 0x4a,
-	0x48, 0xc6, 0x8b, 0x22, 0x53, 0x92, 0x7b, 0x65, 0x72, 0xc7, 0xbc, 0xe5, 0xb9, 0x7b, 0x07, 0xcb,
-	0xca, 0xfe, 0x3e, 0xc7, 0x85, 0x35, 0xde, 0x90, 0x0b, 0x25, 0x64, 0x5c, 0x87, 0xe3, 0x3d, 0x50,
-	0xf6, 0xbb, 0xa7, 0xa9, 0x49, 0x4d, 0x00, 0x59, 0x95, 0xb6, 0x4e, 0x74, 0x8f, 0xf1, 0x23, 0xe4,
-	0x46, 0xbf, 0x5a, 0x2e, 0x81, 0x10, 0xfc, 0xaf, 0xe0, 0x7e, 0xda, 0x41, 0x57, 0xe8, 0xba, 0x3d,
-	0x0e, 0x99, 0x5c, 0x62, 0x2c, 0xb8, 0x83, 0x49, 0x52, 0x61, 0x9d, 0x66, 0xb8, 0xb4, 0xab, 0x4d,
-	0xf0, 0xa2, 0x0f, 0x84, 0x5b, 0xcf, 0xdc, 0x72, 0xed, 0xc8, 0x10, 0x1f, 0x3a, 0xc8, 0x93, 0x09,
-	0xe4, 0x5c, 0x64, 0x90, 0x84, 0x2f, 0xff, 0x47, 0xe7, 0x9b, 0x65, 0xef, 0x64, 0xce, 0x75, 0x36,
-	0x8c, 0xea, 0xd7, 0x68, 0x7c, 0x50, 0x8d, 0x4f, 0xdb, 0x89, 0x3c, 0xe0, 0x63, 0x0b, 0x12, 0x54,
-	0x09, 0x7b, 0xbd, 0x19, 0xf4, 0xee, 0x66, 0xd9, 0x3b, 0xdb, 0xea, 0x7f, 0x80, 0x68, 0x7c, 0xb4,
-	0xdb, 0xec, 0x9e, 0x8c, 0x5e, 0xbe, 0x56, 0x14, 0x2d, 0x56, 0x14, 0xfd, 0xac, 0x28, 0xfa, 0x5c,
-	0xd3, 0xc6, 0x62, 0x4d, 0x1b, 0xdf, 0x6b, 0xda, 0x78, 0xbb, 0x4b, 0x95, 0x9f, 0xce, 0x44, 0x2c,
-	0x8d, 0x66, 0xd2, 0x38, 0x6d, 0x1c, 0x53, 0x42, 0xde, 0xa6, 0x86, 0x95, 0x03, 0xa6, 0x4d, 0x32,
-	0xcb, 0xc0, 0x55, 0x3d, 0xd7, 0xfa, 0xf5, 0xf3, 0x02, 0x9c, 0x68, 0x85, 0x9a, 0x06, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0xdf, 0x93, 0x8e, 0x89, 0x01, 0x00, 0x00,
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
 }
 
-func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetFeeExemptAddresses() []string {
+	if m != nil {
+		return m.FeeExemptAddresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetDefaultTimeoutHeightOffset() uint64 {
+	if m != nil {
+		return m.DefaultTimeoutHeightOffset
+	}
+	return 0
 }
 
-func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x12
+func (m *Params) GetDefaultTimeoutTimestampDuration() uint64 {
+	if m != nil {
+		return m.DefaultTimeoutTimestampDuration
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetEscrowSnapshotInterval() uint64 {
+	if m != nil {
+		return m.EscrowSnapshotInterval
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetEscrowSnapshotRetention() uint64 {
+	if m != nil {
+		return m.EscrowSnapshotRetention
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetProtocolExemptAccounts() []string {
+	if m != nil {
+		return m.ProtocolExemptAccounts
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReceiveEnabled {
-		i--
-		if m.ReceiveEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// EscrowSnapshot records the balance of the escrow account of a transfer channel
+// at the end of a block.
+type EscrowSnapshot struct {
+	// height at which the snapshot was taken
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the snapshot was taken
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// balance of the escrow account of the channel
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *EscrowSnapshot) Reset()         { *m = EscrowSnapshot{} }
+func (m *EscrowSnapshot) String() string { return proto.CompactTextString(m) }
+func (*EscrowSnapshot) ProtoMessage()    {}
+func (*EscrowSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *EscrowSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.SendEnabled {
-		i--
-		if m.SendEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *EscrowSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowSnapshot.Merge(m, src)
+}
+func (m *EscrowSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowSnapshot proto.InternalMessageInfo
+
+func (m *EscrowSnapshot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EscrowSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *EscrowSnapshot) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// DenomHop describes a hop of the denomination trace of an IBC voucher, which
+// is the channel end over which the tokens were received on a chain.
+type DenomHop struct {
+	// port identifier of the channel end
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel identifier of the channel end
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// chain ID of the chain on which the channel end lives, only resolved for the
+	// hops following a hop on this chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// chain ID of the chain the tokens were received from over the channel, only
+	// resolved for the hops on this chain
+	CounterpartyChainId string `protobuf:"bytes,4,opt,name=counterparty_chain_id,json=counterpartyChainId,proto3" json:"counterparty_chain_id,omitempty" yaml:"counterparty_chain_id"`
+}
+
+func (m *DenomHop) Reset()         { *m = DenomHop{} }
+func (m *DenomHop) String() string { return proto.CompactTextString(m) }
+func (*DenomHop) ProtoMessage()    {}
+func (*DenomHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *DenomHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomHop.Merge(m, src)
+}
+func (m *DenomHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomHop proto.InternalMessageInfo
+
+func (m *DenomHop) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *DenomHop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DenomHop) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DenomHop) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+// DenomTraceCorrection replaces the denomination trace stored under a trace
+// hash by a corrected trace.
+type DenomTraceCorrection struct {
+	// hex encoded hash of the stored denomination trace
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// corrected denomination trace
+	Trace DenomTrace `protobuf:"bytes,2,opt,name=trace,proto3" json:"trace"`
+}
+
+func (m *DenomTraceCorrection) Reset()         { *m = DenomTraceCorrection{} }
+func (m *DenomTraceCorrection) String() string { return proto.CompactTextString(m) }
+func (*DenomTraceCorrection) ProtoMessage()    {}
+func (*DenomTraceCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *DenomTraceCorrection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTraceCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTraceCorrection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTraceCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTraceCorrection.Merge(m, src)
+}
+func (m *DenomTraceCorrection) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTraceCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTraceCorrection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTraceCorrection proto.InternalMessageInfo
+
+func (m *DenomTraceCorrection) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DenomTraceCorrection) GetTrace() DenomTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return DenomTrace{}
+}
+
+// DenomTraceCorrectionProposal is a gov Content type correcting malformed
+// denomination traces. If the hash of a corrected trace differs from the hash
+// it is stored under, the vouchers of the stored trace are converted into
+// vouchers of the corrected trace.
+type DenomTraceCorrectionProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the corrections applied in order
+	Corrections []DenomTraceCorrection `protobuf:"bytes,3,rep,name=corrections,proto3" json:"corrections"`
+}
+
+func (m *DenomTraceCorrectionProposal) Reset()         { *m = DenomTraceCorrectionProposal{} }
+func (m *DenomTraceCorrectionProposal) String() string { return proto.CompactTextString(m) }
+func (*DenomTraceCorrectionProposal) ProtoMessage()    {}
+func (*DenomTraceCorrectionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{5}
+}
+func (m *DenomTraceCorrectionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTraceCorrectionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTraceCorrectionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTraceCorrectionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTraceCorrectionProposal.Merge(m, src)
+}
+func (m *DenomTraceCorrectionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTraceCorrectionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTraceCorrectionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTraceCorrectionProposal proto.InternalMessageInfo
+
+// CounterpartyModuleAccounts defines the module accounts of the counterparty
+// chain of a transfer channel. Transfers to these accounts over the channel are
+// rejected unless the sender explicitly allows them, as tokens sent to a module
+// account by mistake cannot be recovered.
+type CounterpartyModuleAccounts struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// addresses of the module accounts on the counterparty chain
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *CounterpartyModuleAccounts) Reset()         { *m = CounterpartyModuleAccounts{} }
+func (m *CounterpartyModuleAccounts) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyModuleAccounts) ProtoMessage()    {}
+func (*CounterpartyModuleAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{6}
+}
+func (m *CounterpartyModuleAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyModuleAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyModuleAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyModuleAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyModuleAccounts.Merge(m, src)
+}
+func (m *CounterpartyModuleAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyModuleAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyModuleAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyModuleAccounts proto.InternalMessageInfo
+
+func (m *CounterpartyModuleAccounts) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *CounterpartyModuleAccounts) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *CounterpartyModuleAccounts) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// CounterpartyModuleAccountsProposal is a gov Content type registering the
+// module accounts of the counterparty chain of a transfer channel. The
+// registered addresses replace those of the channel, an empty list removes them.
+type CounterpartyModuleAccountsProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the module accounts registered for the channel
+	ModuleAccounts CounterpartyModuleAccounts `protobuf:"bytes,3,opt,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts" yaml:"module_accounts"`
+}
+
+func (m *CounterpartyModuleAccountsProposal) Reset()         { *m = CounterpartyModuleAccountsProposal{} }
+func (m *CounterpartyModuleAccountsProposal) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyModuleAccountsProposal) ProtoMessage()    {}
+func (*CounterpartyModuleAccountsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{7}
+}
+func (m *CounterpartyModuleAccountsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyModuleAccountsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyModuleAccountsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyModuleAccountsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyModuleAccountsProposal.Merge(m, src)
+}
+func (m *CounterpartyModuleAccountsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyModuleAccountsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyModuleAccountsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyModuleAccountsProposal proto.InternalMessageInfo
+
+// PrecomputedDenom describes the voucher resulting from the transfer of a
+// denomination over a channel which has not been opened yet.
+type PrecomputedDenom struct {
+	// denomination on the sending chain
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// full denomination trace of the voucher on the receiving chain
+	Trace string `protobuf:"bytes,2,opt,name=trace,proto3" json:"trace,omitempty"`
+	// voucher denomination ('ibc/{hash}') on the receiving chain
+	IbcDenom string `protobuf:"bytes,3,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty" yaml:"ibc_denom"`
+}
+
+func (m *PrecomputedDenom) Reset()         { *m = PrecomputedDenom{} }
+func (m *PrecomputedDenom) String() string { return proto.CompactTextString(m) }
+func (*PrecomputedDenom) ProtoMessage()    {}
+func (*PrecomputedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{8}
+}
+func (m *PrecomputedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecomputedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecomputedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecomputedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecomputedDenom.Merge(m, src)
+}
+func (m *PrecomputedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecomputedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecomputedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecomputedDenom proto.InternalMessageInfo
+
+func (m *PrecomputedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PrecomputedDenom) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+func (m *PrecomputedDenom) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
+	}
+	return ""
+}
+
+// DenomTraceSupply defines a denomination trace along with the total supply of
+// its vouchers.
+type DenomTraceSupply struct {
+	// denomination trace of the vouchers
+	DenomTrace DenomTrace `protobuf:"bytes,1,opt,name=denom_trace,json=denomTrace,proto3" json:"denom_trace" yaml:"denom_trace"`
+	// total supply of the vouchers ('ibc/{hash}')
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *DenomTraceSupply) Reset()         { *m = DenomTraceSupply{} }
+func (m *DenomTraceSupply) String() string { return proto.CompactTextString(m) }
+func (*DenomTraceSupply) ProtoMessage()    {}
+func (*DenomTraceSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{9}
+}
+func (m *DenomTraceSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTraceSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTraceSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTraceSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTraceSupply.Merge(m, src)
+}
+func (m *DenomTraceSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTraceSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTraceSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTraceSupply proto.InternalMessageInfo
+
+func (m *DenomTraceSupply) GetDenomTrace() DenomTrace {
+	if m != nil {
+		return m.DenomTrace
+	}
+	return DenomTrace{}
+}
+
+func (m *DenomTraceSupply) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// DenomTraceExport defines the denomination traces of a chain along with the
+// supply of their vouchers, exported to be imported into the genesis of a chain
+// migrating to a new chain ID. The voucher denominations only depend on the
+// traces, so they are preserved across the migration.
+type DenomTraceExport struct {
+	// chain ID of the exported chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// exported denomination traces
+	DenomTraces []DenomTraceSupply `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3" json:"denom_traces" yaml:"denom_traces"`
+}
+
+func (m *DenomTraceExport) Reset()         { *m = DenomTraceExport{} }
+func (m *DenomTraceExport) String() string { return proto.CompactTextString(m) }
+func (*DenomTraceExport) ProtoMessage()    {}
+func (*DenomTraceExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{10}
+}
+func (m *DenomTraceExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTraceExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTraceExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTraceExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTraceExport.Merge(m, src)
+}
+func (m *DenomTraceExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTraceExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTraceExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTraceExport proto.InternalMessageInfo
+
+func (m *DenomTraceExport) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DenomTraceExport) GetDenomTraces() []DenomTraceSupply {
+	if m != nil {
+		return m.DenomTraces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
+	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*EscrowSnapshot)(nil), "ibc.applications.transfer.v1.EscrowSnapshot")
+	proto.RegisterType((*DenomHop)(nil), "ibc.applications.transfer.v1.DenomHop")
+	proto.RegisterType((*DenomTraceCorrection)(nil), "ibc.applications.transfer.v1.DenomTraceCorrection")
+	proto.RegisterType((*DenomTraceCorrectionProposal)(nil), "ibc.applications.transfer.v1.DenomTraceCorrectionProposal")
+	proto.RegisterType((*CounterpartyModuleAccounts)(nil), "ibc.applications.transfer.v1.CounterpartyModuleAccounts")
+	proto.RegisterType((*CounterpartyModuleAccountsProposal)(nil), "ibc.applications.transfer.v1.CounterpartyModuleAccountsProposal")
+	proto.RegisterType((*PrecomputedDenom)(nil), "ibc.applications.transfer.v1.PrecomputedDenom")
+	proto.RegisterType((*DenomTraceSupply)(nil), "ibc.applications.transfer.v1.DenomTraceSupply")
+	proto.RegisterType((*DenomTraceExport)(nil), "ibc.applications.transfer.v1.DenomTraceExport")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+}
+
+var fileDescriptor_5041673e96e97901 = []byte{
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x3b, 0x6f, 0xdc, 0xc6,
+	0x13, 0x17, 0xad, 0xb3, 0x74, 0xb7, 0x67, 0xcb, 0xfa, 0xaf, 0x64, 0xe9, 0x7c, 0x96, 0x8f, 0x87,
+	0xfd, 0xbb, 0xb8, 0xc0, 0x30, 0x19, 0xd9, 0x01, 0x6c, 0x18, 0x08, 0x82, 0x50, 0x12, 0x60, 0x15,
+	0x41, 0xe4, 0xb5, 0x2a, 0x03, 0x01, 0xc3, 0xc7, 0xde, 0x1d, 0x61, 0x1e, 0x97, 0xe1, 0xee, 0x29,
+	0x56, 0xba, 0x74, 0x29, 0x0d, 0xa4, 0x0e, 0x90, 0x26, 0x4d, 0xca, 0x54, 0xf9, 0x08, 0x4e, 0xe7,
+	0x32, 0x15, 0x1d, 0xd8, 0x40, 0x3e, 0x00, 0xf3, 0x05, 0x82, 0x7d, 0x90, 0xf7, 0xd0, 0x23, 0x32,
+	0x02, 0xa4, 0xe2, 0xee, 0xcc, 0x6f, 0x7e, 0xb3, 0x33, 0x3b, 0x33, 0x4b, 0x70, 0x27, 0xf2, 0x03,
+	0xdb, 0x4b, 0xd3, 0x38, 0x0a, 0x3c, 0x1e, 0xd1, 0x84, 0xd9, 0x3c, 0xf3, 0x12, 0xd6, 0x27, 0x99,
+	0x7d, 0xb4, 0x5d, 0xad, 0xad, 0x34, 0xa3, 0x9c, 0xc2, 0xad, 0xc8, 0x0f, 0xac, 0x69, 0xb0, 0x55,
+	0x01, 0x8e, 0xb6, 0xdb, 0xeb, 0x03, 0x3a, 0xa0, 0x12, 0x68, 0x8b, 0x95, 0xb2, 0x69, 0x77, 0x02,
+	0xca, 0x46, 0x94, 0xd9, 0xbe, 0xc7, 0x88, 0x7d, 0xb4, 0xed, 0x13, 0xee, 0x6d, 0xdb, 0x01, 0x8d,
+	0x12, 0xad, 0x37, 0x07, 0x94, 0x0e, 0x62, 0x62, 0xcb, 0x9d, 0x3f, 0xee, 0xdb, 0x3c, 0x1a, 0x11,
+	0xc6, 0xbd, 0x51, 0xaa, 0x00, 0xe8, 0x13, 0x00, 0x76, 0x49, 0x42, 0x47, 0x87, 0x99, 0x17, 0x10,
+	0x08, 0x41, 0x2d, 0xf5, 0xf8, 0xb0, 0x65, 0x74, 0x8d, 0x5e, 0x03, 0xcb, 0x35, 0xbc, 0x05, 0x80,
+	0x60, 0x77, 0x43, 0x01, 0x6b, 0x5d, 0x92, 0x9a, 0x86, 0x90, 0x48, 0x3b, 0xf4, 0x7d, 0x1d, 0x2c,
+	0x1d, 0x78, 0x99, 0x37, 0x62, 0xf0, 0x11, 0xb8, 0xc2, 0x48, 0x12, 0xba, 0x24, 0xf1, 0xfc, 0x98,
+	0x84, 0x92, 0xa5, 0xee, 0x6c, 0x16, 0xb9, 0xb9, 0x76, 0xec, 0x8d, 0xe2, 0x47, 0x68, 0x5a, 0x8b,
+	0x70, 0x53, 0x6c, 0xf7, 0xd4, 0x0e, 0xee, 0x80, 0x6b, 0x19, 0x09, 0x48, 0x74, 0x44, 0x2a, 0xf3,
+	0x4b, 0xd2, 0xbc, 0x5d, 0xe4, 0xe6, 0x86, 0x32, 0x9f, 0x03, 0x20, 0xbc, 0xa2, 0x25, 0x25, 0xc9,
+	0x4f, 0x06, 0xd8, 0x2c, 0x41, 0xe1, 0x98, 0x71, 0x97, 0x0f, 0x33, 0xc2, 0x86, 0x34, 0x0e, 0x59,
+	0x6b, 0xb1, 0xbb, 0xd8, 0x6b, 0xde, 0xbb, 0x61, 0xa9, 0x84, 0x59, 0x22, 0x00, 0x4b, 0x27, 0xcc,
+	0xda, 0xa1, 0x51, 0xe2, 0xe0, 0x57, 0xb9, 0xb9, 0x50, 0xe4, 0x66, 0x67, 0xd6, 0xd9, 0x1c, 0x0f,
+	0xfa, 0xf9, 0x8d, 0xd9, 0x1b, 0x44, 0x7c, 0x38, 0xf6, 0xad, 0x80, 0x8e, 0x6c, 0x9d, 0x7f, 0xf5,
+	0xb9, 0xcb, 0xc2, 0xe7, 0x36, 0x3f, 0x4e, 0x09, 0x93, 0x94, 0x0c, 0x5f, 0xd7, 0x2c, 0xbb, 0x63,
+	0xc6, 0x0f, 0x2b, 0x0e, 0xb8, 0x07, 0x56, 0xfb, 0x84, 0xb8, 0xbe, 0xc7, 0x22, 0xe6, 0xa6, 0x34,
+	0x4a, 0x38, 0x6b, 0xd5, 0xba, 0x46, 0xef, 0xaa, 0x73, 0xb3, 0xc8, 0xcd, 0x4d, 0x75, 0x80, 0x79,
+	0x04, 0xc2, 0x2b, 0x7d, 0x42, 0x1c, 0x21, 0x39, 0x90, 0x02, 0xf8, 0x31, 0xb8, 0x2a, 0x40, 0x01,
+	0x8d, 0x63, 0x12, 0x70, 0x9a, 0xb5, 0x2e, 0x8b, 0xcb, 0x71, 0x5a, 0x45, 0x6e, 0xae, 0x4f, 0x38,
+	0x2a, 0x35, 0xc2, 0x57, 0xfa, 0x84, 0xec, 0x94, 0x5b, 0xf8, 0x04, 0xac, 0x0b, 0x3d, 0x79, 0x41,
+	0x46, 0x29, 0x77, 0xbd, 0x30, 0xcc, 0x08, 0x63, 0x84, 0xb5, 0x96, 0xba, 0x8b, 0xbd, 0x86, 0x63,
+	0x16, 0xb9, 0x79, 0x73, 0xc2, 0x32, 0x8f, 0x42, 0x18, 0xf6, 0x09, 0xd9, 0x93, 0xd2, 0x4f, 0x4b,
+	0x21, 0x7c, 0x0e, 0x6e, 0x85, 0xa4, 0xef, 0x8d, 0x63, 0xee, 0x8a, 0x42, 0xa3, 0x63, 0xee, 0x0e,
+	0x49, 0x34, 0x18, 0x72, 0x97, 0xf6, 0xfb, 0x8c, 0xf0, 0xd6, 0x72, 0xd7, 0xe8, 0xd5, 0x9c, 0x5e,
+	0x91, 0x9b, 0xb7, 0x15, 0xf7, 0xb9, 0x70, 0x84, 0xdb, 0x5a, 0x7f, 0xa8, 0xd4, 0x8f, 0xa5, 0xf6,
+	0x73, 0xa9, 0x84, 0xdf, 0x80, 0x13, 0xd6, 0x55, 0x75, 0xbb, 0xe1, 0x38, 0x93, 0x4d, 0xd4, 0xaa,
+	0x4b, 0x8f, 0x77, 0x8b, 0xdc, 0xfc, 0xe0, 0x74, 0x8f, 0x27, 0x6d, 0x10, 0x36, 0x67, 0xdd, 0x1e,
+	0x96, 0x90, 0x5d, 0x8d, 0x80, 0x5f, 0x80, 0x16, 0x61, 0x41, 0x46, 0xbf, 0x76, 0x59, 0xe2, 0xa5,
+	0x6c, 0x48, 0xb9, 0x1b, 0x25, 0x9c, 0x64, 0x47, 0x5e, 0xdc, 0x6a, 0x48, 0x8f, 0xff, 0x2f, 0x72,
+	0xd3, 0x54, 0x1e, 0xcf, 0x42, 0x22, 0xbc, 0xa1, 0x54, 0x4f, 0xb5, 0x66, 0x5f, 0x2b, 0xe0, 0x97,
+	0xe0, 0xc6, 0xbc, 0x51, 0x46, 0x38, 0x49, 0x64, 0x44, 0x40, 0xf2, 0xdf, 0x2e, 0x72, 0xb3, 0x7b,
+	0x3a, 0x7f, 0x05, 0x45, 0x78, 0x73, 0xd6, 0x01, 0x2e, 0x35, 0x22, 0x00, 0x39, 0x00, 0x02, 0x1a,
+	0x57, 0x77, 0x1b, 0x04, 0x74, 0x2c, 0x4a, 0xb1, 0x29, 0x0b, 0x60, 0x2a, 0x80, 0xb3, 0x90, 0x08,
+	0x6f, 0x94, 0x2a, 0x5d, 0x09, 0xa5, 0xe2, 0x37, 0x03, 0xac, 0xec, 0xcd, 0xb8, 0x86, 0x1b, 0x60,
+	0x49, 0x5d, 0xae, 0x9c, 0x0b, 0x35, 0xac, 0x77, 0xf0, 0x21, 0xa8, 0x89, 0x2b, 0x90, 0xed, 0xde,
+	0xbc, 0xd7, 0xb6, 0xd4, 0xc4, 0xb2, 0xca, 0x89, 0x65, 0x55, 0xc9, 0x77, 0xea, 0xa2, 0x43, 0x5f,
+	0xbe, 0x31, 0x0d, 0x2c, 0x2d, 0x20, 0x01, 0xcb, 0xbe, 0x17, 0x7b, 0x49, 0x40, 0xfe, 0xb9, 0xbb,
+	0x3f, 0x14, 0xb6, 0xef, 0xd5, 0xbb, 0x25, 0x37, 0xfa, 0xcb, 0x00, 0x75, 0x39, 0xeb, 0x1e, 0xd3,
+	0x14, 0xde, 0x01, 0xcb, 0x29, 0xcd, 0xb8, 0x1b, 0xa9, 0xf1, 0xd6, 0x70, 0x60, 0x91, 0x9b, 0x2b,
+	0x3a, 0x4d, 0x4a, 0x81, 0xf0, 0x92, 0x58, 0xed, 0x87, 0xf0, 0x23, 0x00, 0x82, 0xa1, 0x97, 0x24,
+	0x24, 0x16, 0x78, 0x39, 0x3a, 0x9d, 0xeb, 0x45, 0x6e, 0xfe, 0x4f, 0xe1, 0x27, 0x3a, 0x84, 0x1b,
+	0x7a, 0xb3, 0x1f, 0x42, 0x0b, 0xd4, 0x83, 0xa1, 0x17, 0x25, 0xc2, 0x66, 0x51, 0xda, 0xac, 0x15,
+	0xb9, 0x79, 0xad, 0xb2, 0x91, 0x1a, 0x84, 0x97, 0xe5, 0x72, 0x3f, 0x84, 0x87, 0xe0, 0xba, 0xcc,
+	0x3a, 0xc9, 0x52, 0x2f, 0xe3, 0xc7, 0x6e, 0x65, 0x5c, 0x93, 0xc6, 0xdd, 0x22, 0x37, 0xb7, 0xb4,
+	0xf1, 0x69, 0x30, 0x84, 0xd7, 0xa6, 0xe5, 0x3b, 0x8a, 0x15, 0xa5, 0x60, 0x7d, 0xf2, 0x30, 0xec,
+	0xd0, 0x2c, 0x23, 0x81, 0x2c, 0x1c, 0x08, 0x6a, 0x43, 0x8f, 0x55, 0x4f, 0x84, 0x58, 0xc3, 0x5d,
+	0x70, 0x99, 0x0b, 0x98, 0xbe, 0xc3, 0x9e, 0x75, 0xde, 0x4b, 0x66, 0x4d, 0x68, 0x9d, 0x9a, 0xb8,
+	0x15, 0xac, 0x8c, 0xd1, 0xaf, 0x06, 0xd8, 0x3a, 0xcd, 0xe5, 0x41, 0x46, 0x53, 0xca, 0xbc, 0x18,
+	0xae, 0x83, 0xcb, 0x3c, 0xe2, 0x31, 0xd1, 0xbe, 0xd5, 0x06, 0x76, 0x41, 0x33, 0x14, 0x55, 0x1e,
+	0xa5, 0xb2, 0x3b, 0xd4, 0x03, 0x35, 0x2d, 0x82, 0xcf, 0x40, 0x33, 0xa8, 0xd8, 0xca, 0x97, 0xe0,
+	0xde, 0x45, 0x0f, 0x39, 0x39, 0x88, 0x3e, 0xee, 0x34, 0xd9, 0xa3, 0xda, 0x77, 0x3f, 0x9a, 0x0b,
+	0xe8, 0x07, 0x03, 0xb4, 0x77, 0xa6, 0x92, 0xf8, 0x19, 0x0d, 0xc7, 0x31, 0x29, 0xbb, 0xe1, 0xbf,
+	0x28, 0x9a, 0x2d, 0xd0, 0x98, 0x4c, 0x70, 0x11, 0x61, 0x03, 0x4f, 0x04, 0xe8, 0x4f, 0x03, 0xa0,
+	0xb3, 0xcf, 0xf7, 0xaf, 0x13, 0xfc, 0xad, 0x01, 0xae, 0x8d, 0x24, 0xe5, 0x64, 0x88, 0x2c, 0xca,
+	0x52, 0x78, 0x78, 0x7e, 0x96, 0xcf, 0x3e, 0x93, 0xd3, 0xd1, 0xcf, 0xb1, 0x7e, 0xfb, 0xe7, 0xe8,
+	0x11, 0x5e, 0x19, 0xcd, 0xe0, 0xf5, 0x45, 0x7c, 0x05, 0x56, 0x0f, 0x32, 0x12, 0xd0, 0x51, 0x3a,
+	0xe6, 0x24, 0x94, 0x97, 0x28, 0xa2, 0x52, 0xff, 0x2e, 0x3a, 0xaa, 0xb0, 0x94, 0x4e, 0x6a, 0xb6,
+	0xa1, 0x6b, 0x10, 0x6e, 0x83, 0x46, 0xe4, 0x07, 0xfa, 0x5f, 0x47, 0x35, 0xdf, 0x7a, 0x91, 0x9b,
+	0xab, 0xea, 0x10, 0x95, 0x0a, 0xe1, 0x7a, 0xe4, 0x07, 0xea, 0x07, 0xe8, 0x17, 0x03, 0xac, 0x4e,
+	0xaa, 0xe5, 0xe9, 0x38, 0x4d, 0xe3, 0x63, 0x48, 0x44, 0xce, 0x12, 0x3a, 0x72, 0x95, 0x0f, 0xe3,
+	0x3d, 0xfb, 0xa2, 0xad, 0x83, 0x87, 0xe5, 0x93, 0x55, 0x51, 0x21, 0x0c, 0xc2, 0x0a, 0x07, 0x1f,
+	0x80, 0x25, 0x26, 0x1d, 0xea, 0xce, 0x3b, 0x67, 0x00, 0xaa, 0xda, 0xd5, 0xf0, 0xb9, 0x43, 0xef,
+	0xbd, 0x10, 0xb5, 0x37, 0x33, 0x78, 0x8c, 0x0b, 0x0c, 0x9e, 0x04, 0x5c, 0x99, 0x3a, 0x19, 0x6b,
+	0x5d, 0x92, 0x8d, 0x65, 0x5d, 0x34, 0x4a, 0x95, 0x2a, 0xe7, 0xa6, 0x8e, 0x75, 0xed, 0x44, 0xac,
+	0x0c, 0xe1, 0xe6, 0x24, 0x58, 0xe6, 0x3c, 0x79, 0xf5, 0xb6, 0x63, 0xbc, 0x7e, 0xdb, 0x31, 0xfe,
+	0x78, 0xdb, 0x31, 0x5e, 0xbe, 0xeb, 0x2c, 0xbc, 0x7e, 0xd7, 0x59, 0xf8, 0xfd, 0x5d, 0x67, 0xe1,
+	0xd9, 0x83, 0x93, 0x53, 0x3d, 0xf2, 0x83, 0xbb, 0x03, 0x6a, 0x1f, 0xdd, 0xb7, 0x55, 0xa1, 0x30,
+	0xf1, 0x1f, 0x3e, 0xf5, 0xff, 0x2d, 0x47, 0xbd, 0xbf, 0x24, 0x9f, 0x99, 0xfb, 0x7f, 0x0f, 0x00,
+	0xa9, 0x1d, 0x2e, 0xce, 0xa9, 0x0b, 0x00, 0x00,
+}
+
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolExemptAccounts) > 0 {
+		for iNdEx := len(m.ProtocolExemptAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProtocolExemptAccounts[iNdEx])
+			copy(dAtA[i:], m.ProtocolExemptAccounts[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ProtocolExemptAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.EscrowSnapshotRetention != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.EscrowSnapshotRetention))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EscrowSnapshotInterval != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.EscrowSnapshotInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DefaultTimeoutTimestampDuration != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.DefaultTimeoutTimestampDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DefaultTimeoutHeightOffset != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.DefaultTimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeExemptAddresses) > 0 {
+		for iNdEx := len(m.FeeExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.FeeExemptAddresses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.FeeExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FeeBasisPoints != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.FeeBasisPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReceiveDustThresholds) > 0 {
+		for iNdEx := len(m.ReceiveDustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveDustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTransfer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTraceCorrection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTraceCorrection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTraceCorrection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Trace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTraceCorrectionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTraceCorrectionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTraceCorrectionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Corrections) > 0 {
+		for iNdEx := len(m.Corrections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corrections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CounterpartyModuleAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyModuleAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyModuleAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CounterpartyModuleAccountsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyModuleAccountsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyModuleAccountsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ModuleAccounts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecomputedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecomputedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecomputedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Trace) > 0 {
+		i -= len(m.Trace)
+		copy(dAtA[i:], m.Trace)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Trace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTraceSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTraceSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTraceSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DenomTrace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTraceExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTraceExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTraceExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomTraces) > 0 {
+		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.ReceiveDustThresholds) > 0 {
+		for _, e := range m.ReceiveDustThresholds {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.FeeBasisPoints != 0 {
+		n += 1 + sovTransfer(uint64(m.FeeBasisPoints))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.FeeExemptAddresses) > 0 {
+		for _, s := range m.FeeExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.DefaultTimeoutHeightOffset != 0 {
+		n += 1 + sovTransfer(uint64(m.DefaultTimeoutHeightOffset))
+	}
+	if m.DefaultTimeoutTimestampDuration != 0 {
+		n += 1 + sovTransfer(uint64(m.DefaultTimeoutTimestampDuration))
+	}
+	if m.EscrowSnapshotInterval != 0 {
+		n += 1 + sovTransfer(uint64(m.EscrowSnapshotInterval))
+	}
+	if m.EscrowSnapshotRetention != 0 {
+		n += 1 + sovTransfer(uint64(m.EscrowSnapshotRetention))
+	}
+	if len(m.ProtocolExemptAccounts) > 0 {
+		for _, s := range m.ProtocolExemptAccounts {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTransfer(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTransfer(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomTraceCorrection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Trace.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *DenomTraceCorrectionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Corrections) > 0 {
+		for _, e := range m.Corrections {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CounterpartyModuleAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CounterpartyModuleAccountsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.ModuleAccounts.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *PrecomputedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomTraceSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DenomTrace.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *DenomTraceExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveDustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveDustThresholds = append(m.ReceiveDustThresholds, types.Coin{})
+			if err := m.ReceiveDustThresholds[len(m.ReceiveDustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBasisPoints", wireType)
+			}
+			m.FeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptAddresses = append(m.FeeExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutHeightOffset", wireType)
+			}
+			m.DefaultTimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutTimestampDuration", wireType)
+			}
+			m.DefaultTimeoutTimestampDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTimeoutTimestampDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSnapshotInterval", wireType)
+			}
+			m.EscrowSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowSnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSnapshotRetention", wireType)
+			}
+			m.EscrowSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowSnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolExemptAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolExemptAccounts = append(m.ProtocolExemptAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTraceCorrection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTraceCorrection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTraceCorrection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTraceCorrectionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTraceCorrectionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTraceCorrectionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corrections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corrections = append(m.Corrections, DenomTraceCorrection{})
+			if err := m.Corrections[len(m.Corrections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CounterpartyModuleAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyModuleAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyModuleAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CounterpartyModuleAccountsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyModuleAccountsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyModuleAccountsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DenomTrace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SendEnabled {
-		n += 2
-	}
-	if m.ReceiveEnabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+func (m *PrecomputedDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -324,15 +3022,15 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrecomputedDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrecomputedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -360,11 +3058,11 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -392,7 +3090,39 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -415,7 +3145,7 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DenomTraceSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -438,17 +3168,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomTraceSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomTraceSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTrace", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -458,17 +3188,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SendEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomTrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -478,12 +3221,141 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReceiveEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTraceExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTraceExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTraceExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraces = append(m.DenomTraces, DenomTraceSupply{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
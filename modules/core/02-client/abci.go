@@ -8,7 +8,8 @@ import (
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
-// BeginBlocker updates an existing localhost client with the latest block height.
+// BeginBlocker prunes the expired consensus states of 07-tendermint clients and updates an
+// existing localhost client with the latest block height.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
@@ -30,6 +31,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
+	k.PruneExpiredConsensusStates(ctx)
+
 	_, found = k.GetClientState(ctx, exported.Localhost)
 	if !found {
 		return
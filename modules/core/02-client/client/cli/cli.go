@@ -23,9 +23,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStatus(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryTrustedConsensusState(),
+		GetCmdQueryFrozenClientEvidence(),
+		GetCmdQueryUpgradedClientState(),
+		GetCmdQueryUpgradedConsensusState(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
 		GetCmdParams(),
+		GetCmdQueryEstimateUpdateClientGas(),
 	)
 
 	return queryCmd
@@ -44,8 +49,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewCreateClientCmd(),
 		NewUpdateClientCmd(),
+		NewBatchUpdateClientCmd(),
 		NewSubmitMisbehaviourCmd(),
 		NewUpgradeClientCmd(),
+		NewRecoverClientCmd(),
+		NewIBCSoftwareUpgradeCmd(),
 	)
 
 	return txCmd
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -119,6 +120,8 @@ func GetCmdQueryClientStatus() *cobra.Command {
 		},
 	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -212,6 +215,156 @@ If the '--latest' flag is included, the query returns the latest consensus state
 	return cmd
 }
 
+// GetCmdQueryTrustedConsensusState defines the command to query the trusted consensus state
+// of a client which may be used to construct a header at a given target height.
+func GetCmdQueryTrustedConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trusted-consensus-state [client-id] [target-height]",
+		Short: "Query the trusted consensus state of a client for a header at a given height",
+		Long: `Query the highest unexpired consensus state of a light client below the target height.
+The returned height and consensus state may be used as the trusted fields of a header updating the client to the target height.`,
+		Example: fmt.Sprintf("%s query %s %s trusted-consensus-state [client-id] [target-height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := types.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTrustedConsensusStateRequest{
+				ClientId:       args[0],
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			res, err := queryClient.TrustedConsensusState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFrozenClientEvidence defines the command to query the misbehaviour evidence
+// stored when a client was frozen.
+func GetCmdQueryFrozenClientEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-evidence [client-id]",
+		Short:   "Query the misbehaviour evidence of a frozen client",
+		Long:    "Query the misbehaviour which froze a client, with the height and block time at which the client was frozen.",
+		Example: fmt.Sprintf("%s query %s %s frozen-evidence [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFrozenClientEvidenceRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.FrozenClientEvidence(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgradedClientState defines the command to query the upgraded client
+// state committed by the upgrade module for a planned upgrade height.
+func GetCmdQueryUpgradedClientState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgraded-client-state [plan-height]",
+		Short:   "Query the upgraded client state for a planned upgrade height",
+		Long:    "Query the upgraded client state committed by the upgrade module for a planned upgrade height, along with its proof under the upgrade store",
+		Example: fmt.Sprintf("%s query %s %s upgraded-client-state [plan-height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryUpgradedClientState(clientCtx, planHeight, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgradedConsensusState defines the command to query the upgraded
+// consensus state committed by the upgrade module for a planned upgrade height.
+func GetCmdQueryUpgradedConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgraded-consensus-state [plan-height]",
+		Short:   "Query the upgraded consensus state for a planned upgrade height",
+		Long:    "Query the upgraded consensus state committed by the upgrade module for a planned upgrade height, along with its proof under the upgrade store",
+		Example: fmt.Sprintf("%s query %s %s upgraded-consensus-state [plan-height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryUpgradedConsensusState(clientCtx, planHeight, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHeader defines the command to query the latest header on the chain
 func GetCmdQueryHeader() *cobra.Command {
 	cmd := &cobra.Command{
@@ -290,3 +443,44 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEstimateUpdateClientGas defines the command to estimate the gas of a client update
+// given the validator set size of the counterparty chain.
+func GetCmdQueryEstimateUpdateClientGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-update-gas [client-id] [validator-set-size]",
+		Short:   "Estimate the gas of a client update",
+		Long:    "Estimate the gas of a transaction with a single MsgUpdateClient updating the client, given the number of validators of the counterparty chain",
+		Example: fmt.Sprintf("%s query %s %s estimate-update-gas [client-id] [validator-set-size]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorSetSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEstimateUpdateClientGasRequest{
+				ClientId:         args[0],
+				ValidatorSetSize: validatorSetSize,
+			}
+
+			res, err := queryClient.EstimateUpdateClientGas(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
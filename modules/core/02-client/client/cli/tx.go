@@ -85,7 +85,7 @@ func NewCreateClientCmd() *cobra.Command {
 
 // NewUpdateClientCmd defines the command to update an IBC client.
 func NewUpdateClientCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "update [client-id] [path/to/header.json]",
 		Short:   "update existing client with a header",
 		Long:    "update existing client with a header",
@@ -123,12 +123,63 @@ func NewUpdateClientCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBatchUpdateClientCmd defines the command to update an IBC client with a sequence of headers.
+func NewBatchUpdateClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-update [client-id] [path/to/header.json]...",
+		Short:   "update existing client with a sequence of headers",
+		Long:    "update existing client with a sequence of headers, applied in the given order. The client is not updated if any of the headers is invalid.",
+		Example: fmt.Sprintf("%s tx ibc %s batch-update [client-id] [path/to/header_1.json] [path/to/header_2.json] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			headers := make([]exported.Header, len(args)-1)
+			for i, headerContentOrFileName := range args[1:] {
+				if err := cdc.UnmarshalInterfaceJSON([]byte(headerContentOrFileName), &headers[i]); err != nil {
+
+					// check for file path if JSON input is not provided
+					contents, err := ioutil.ReadFile(headerContentOrFileName)
+					if err != nil {
+						return fmt.Errorf("neither JSON input nor path to .json file for header %d were provided: %w", i, err)
+					}
+
+					if err := cdc.UnmarshalInterfaceJSON(contents, &headers[i]); err != nil {
+						return fmt.Errorf("error unmarshalling header file %d: %w", i, err)
+					}
+				}
+			}
+
+			msg, err := types.NewMsgBatchUpdateClient(clientID, headers, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
 
 // NewSubmitMisbehaviourCmd defines the command to submit a misbehaviour to prevent
 // future updates.
 func NewSubmitMisbehaviourCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "misbehaviour [path/to/misbehaviour.json]",
 		Short:   "submit a client misbehaviour",
 		Long:    "submit a client misbehaviour to prevent future updates",
@@ -164,6 +215,10 @@ func NewSubmitMisbehaviourCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
 
 // NewUpgradeClientCmd defines the command to upgrade an IBC light client.
@@ -233,6 +288,91 @@ func NewUpgradeClientCmd() *cobra.Command {
 	return cmd
 }
 
+// NewRecoverClientCmd defines the command to recover a frozen or expired IBC light client.
+func NewRecoverClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recover [subject-client-id] [substitute-client-id]",
+		Short:   "recover a frozen or expired IBC client",
+		Long:    "recover a frozen or expired IBC client by updating it with the state of an active substitute client tracking the same chain",
+		Example: fmt.Sprintf("%s tx ibc %s recover [subject-client-id] [substitute-client-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRecoverClient(args[0], args[1], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewIBCSoftwareUpgradeCmd defines the command to schedule an upgrade along with the upgraded
+// client state. The transaction must be signed by the IBC authority.
+func NewIBCSoftwareUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-software-upgrade [name] [height] [path/to/upgraded_client_state.json]",
+		Short:   "schedule an IBC software upgrade",
+		Long:    "schedule an upgrade at the specified height along with the upgraded client state representing the upgraded chain. The signer must be the IBC authority, which defaults to the gov module account.",
+		Example: fmt.Sprintf("%s tx ibc %s ibc-software-upgrade [name] [height] [path/to/upgraded_client_state.json] --from authority --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			plan := upgradetypes.Plan{
+				Name:   args[0],
+				Height: height,
+			}
+
+			// attempt to unmarshal client state argument
+			var clientState exported.ClientState
+			clientContentOrFileName := args[2]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := ioutil.ReadFile(clientContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for client state were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgIBCSoftwareUpgrade(clientCtx.GetFromAddress().String(), plan, clientState)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdSubmitUpdateClientProposal implements a command handler for submitting an update IBC client proposal transaction.
 func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,6 +434,64 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitArchiveClientProposal implements a command handler for submitting an archive IBC client proposal transaction.
+func NewCmdSubmitArchiveClientProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-client [client-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an archive IBC client proposal",
+		Long: "Submit an archive IBC client proposal along with an initial deposit.\n" +
+			"Please specify the identifier of the client you want to archive.\n" +
+			"An archived client can no longer be updated or used for new connections, but its consensus states remain queryable.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewClientArchiveProposal(title, description, args[0])
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting an upgrade IBC client proposal transaction.
 func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd := &cobra.Command{
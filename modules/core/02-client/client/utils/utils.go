@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -117,6 +118,107 @@ func QueryConsensusStateABCI(
 	return types.NewQueryConsensusStateResponse(anyConsensusState, proofBz, proofHeight), nil
 }
 
+// QueryUpgradedClientState returns the upgraded client state stored by the upgrade
+// module at the given plan height. If prove is true, it performs an ABCI store query
+// in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
+func QueryUpgradedClientState(
+	clientCtx client.Context, planHeight int64, prove bool,
+) (*types.QueryUpgradedClientStateResponse, error) {
+	if prove {
+		return QueryUpgradedClientStateABCI(clientCtx, planHeight)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryUpgradedClientStateRequest{
+		PlanHeight: planHeight,
+	}
+
+	return queryClient.UpgradedClientState(context.Background(), req)
+}
+
+// QueryUpgradedClientStateABCI queries the upgrade store to get the upgraded client
+// state at the given plan height and a merkle proof of its existence.
+func QueryUpgradedClientStateABCI(
+	clientCtx client.Context, planHeight int64,
+) (*types.QueryUpgradedClientStateResponse, error) {
+	key := upgradetypes.UpgradedClientKey(planHeight)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProofForStore(clientCtx, upgradetypes.StoreKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgraded client exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "upgraded client not found at plan height %d", planHeight)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	clientState, err := types.UnmarshalClientState(cdc, value)
+	if err != nil {
+		return nil, err
+	}
+
+	anyClientState, err := types.PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryUpgradedClientStateResponse(anyClientState, proofBz, proofHeight), nil
+}
+
+// QueryUpgradedConsensusState returns the upgraded consensus state stored by the
+// upgrade module at the given plan height. If prove is true, it performs an ABCI
+// store query in order to retrieve the merkle proof. Otherwise, it uses the gRPC
+// query client.
+func QueryUpgradedConsensusState(
+	clientCtx client.Context, planHeight int64, prove bool,
+) (*types.QueryUpgradedConsensusStateResponse, error) {
+	if prove {
+		return QueryUpgradedConsensusStateABCI(clientCtx, planHeight)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryUpgradedConsensusStateRequest{
+		PlanHeight: planHeight,
+	}
+
+	return queryClient.UpgradedConsensusState(context.Background(), req)
+}
+
+// QueryUpgradedConsensusStateABCI queries the upgrade store to get the upgraded
+// consensus state at the given plan height and a merkle proof of its existence.
+func QueryUpgradedConsensusStateABCI(
+	clientCtx client.Context, planHeight int64,
+) (*types.QueryUpgradedConsensusStateResponse, error) {
+	key := upgradetypes.UpgradedConsStateKey(planHeight)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProofForStore(clientCtx, upgradetypes.StoreKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgraded consensus state exists
+	if len(value) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "upgraded consensus state not found at plan height %d", planHeight)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	cs, err := types.UnmarshalConsensusState(cdc, value)
+	if err != nil {
+		return nil, err
+	}
+
+	anyConsensusState, err := types.PackConsensusState(cs)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryUpgradedConsensusStateResponse(anyConsensusState, proofBz, proofHeight), nil
+}
+
 // QueryTendermintHeader takes a client context and returns the appropriate
 // tendermint header
 func QueryTendermintHeader(clientCtx client.Context) (ibctmtypes.Header, int64, error) {
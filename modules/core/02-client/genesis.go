@@ -47,8 +47,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
-	// NOTE: localhost creation is specifically disallowed for the time being.
-	// Issue: https://github.com/cosmos/cosmos-sdk/issues/7871
+	for _, sequence := range gs.ReservedClientSequences {
+		k.SetReservedClientSequence(ctx, sequence)
+	}
+
+	for _, clientID := range gs.ArchivedClients {
+		k.SetClientArchived(ctx, clientID)
+	}
+
+	// the localhost client is created unless it is included in the genesis clients
+	if _, found := k.GetClientState(ctx, exported.Localhost); gs.CreateLocalhost && !found {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			panic(fmt.Sprintf("failed to create the localhost client: %s", err))
+		}
+	}
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
@@ -61,11 +73,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		panic(err)
 	}
 	return types.GenesisState{
-		Clients:            genClients,
-		ClientsMetadata:    clientsMetadata,
-		ClientsConsensus:   k.GetAllConsensusStates(ctx),
-		Params:             k.GetParams(ctx),
-		CreateLocalhost:    false,
-		NextClientSequence: k.GetNextClientSequence(ctx),
+		Clients:                 genClients,
+		ClientsMetadata:         clientsMetadata,
+		ClientsConsensus:        k.GetAllConsensusStates(ctx),
+		Params:                  k.GetParams(ctx),
+		CreateLocalhost:         false,
+		NextClientSequence:      k.GetNextClientSequence(ctx),
+		ReservedClientSequences: k.GetReservedClientSequences(ctx),
+		ArchivedClients:         k.GetArchivedClients(ctx),
 	}
 }
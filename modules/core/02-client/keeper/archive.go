@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ArchiveClient archives the given client. An archived client can no longer be updated,
+// upgraded, frozen by misbehaviour or used to open new connections, but its consensus states
+// remain stored and queryable and are excluded from the pruning of expired consensus states.
+// Archiving a client is irreversible. The localhost client cannot be archived.
+func (k Keeper) ArchiveClient(ctx sdk.Context, clientID string) error {
+	if clientID == exported.Localhost {
+		return sdkerrors.Wrap(types.ErrInvalidArchiveClientProposal, "cannot archive localhost client")
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot archive client with ID %s", clientID)
+	}
+
+	if k.IsClientArchived(ctx, clientID) {
+		return sdkerrors.Wrapf(types.ErrClientArchived, "client %s is already archived", clientID)
+	}
+
+	k.SetClientArchived(ctx, clientID)
+
+	k.Logger(ctx).Info("client archived", "client-id", clientID, "height", clientState.GetLatestHeight().String())
+
+	EmitArchiveClientEvent(ctx, clientID, clientState)
+
+	return nil
+}
+
+// GetClientStatus returns the status of the given client. The Archived status takes
+// precedence over the status reported by the client state.
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	if k.IsClientArchived(ctx, clientID) {
+		return exported.Archived
+	}
+
+	return clientState.Status(ctx, k.ClientStore(ctx, clientID), k.cdc)
+}
+
+// SetClientArchived marks the given client as archived.
+func (k Keeper) SetClientArchived(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ArchivedClientKey(clientID), []byte{byte(1)})
+}
+
+// IsClientArchived returns true if the given client is archived.
+func (k Keeper) IsClientArchived(ctx sdk.Context, clientID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ArchivedClientKey(clientID))
+}
+
+// GetArchivedClients returns the identifiers of all the archived clients, ordered
+// lexicographically.
+func (k Keeper) GetArchivedClients(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyArchivedClients+"/"))
+	defer iterator.Close()
+
+	var clientIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		// key is archivedClients/{clientID}
+		clientIDs = append(clientIDs, strings.TrimPrefix(string(iterator.Key()), host.KeyArchivedClients+"/"))
+	}
+
+	return clientIDs
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestArchiveClient() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"localhost client", func() {
+				clientID = exported.Localhost
+			}, false,
+		},
+		{
+			"client already archived", func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientArchived(suite.chainA.GetContext(), clientID)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			err := clientKeeper.ClientArchiveProposal(suite.chainA.GetContext(), types.NewClientArchiveProposal(ibctesting.Title, ibctesting.Description, clientID).(*types.ClientArchiveProposal))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				ctx := suite.chainA.GetContext()
+				suite.Require().True(clientKeeper.IsClientArchived(ctx, clientID))
+				suite.Require().Equal([]string{clientID}, clientKeeper.GetArchivedClients(ctx))
+
+				clientState := path.EndpointA.GetClientState()
+				suite.Require().Equal(exported.Archived, clientKeeper.GetClientStatus(ctx, clientState, clientID))
+
+				// the consensus states remain queryable
+				_, found := clientKeeper.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
+				suite.Require().True(found)
+
+				// the client can no longer be updated or used for new connections
+				header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+				suite.Require().NoError(err)
+				suite.Require().ErrorIs(clientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header), types.ErrClientNotActive)
+
+				_, err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnOpenInit(
+					suite.chainA.GetContext(), clientID, connectiontypes.NewCounterparty(path.EndpointB.ClientID, "", suite.chainB.GetPrefix()), nil, 0,
+				)
+				suite.Require().ErrorIs(err, types.ErrClientArchived)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestArchivedClientNotPruned() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	expiredHeight := path.EndpointA.GetClientState().GetLatestHeight()
+
+	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	ctx := suite.chainA.GetContext()
+
+	params := clientKeeper.GetParams(ctx)
+	params.ConsensusStatePruningGasLimit = 10_000_000
+	clientKeeper.SetParams(ctx, params)
+
+	suite.Require().NoError(clientKeeper.ArchiveClient(ctx, path.EndpointA.ClientID))
+
+	clientKeeper.PruneExpiredConsensusStates(ctx)
+
+	suite.Require().True(clientKeeper.HasClientConsensusState(ctx, path.EndpointA.ClientID, expiredHeight))
+}
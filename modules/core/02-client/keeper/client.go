@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -25,15 +26,36 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	// the localhost client is only stored under its sentinel client identifier
+	if clientState.ClientType() == exported.Localhost {
+		return "", sdkerrors.Wrap(types.ErrInvalidClientType, "the localhost client can only be created with CreateLocalhostClient")
+	}
+
+	if err := k.validateProofSpecsHashOps(clientState); err != nil {
+		return "", err
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 
+	if err := k.createClient(ctx, clientID, clientState, consensusState); err != nil {
+		return "", err
+	}
+
+	return clientID, nil
+}
+
+// createClient stores the provided client state under the given client identifier and
+// populates it with the given consensus state.
+func (k Keeper) createClient(
+	ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState,
+) error {
 	k.SetClientState(ctx, clientID, clientState)
 	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", clientState.GetLatestHeight().String())
 
 	// verifies initial consensus state against client state and initializes client store with any client-specific metadata
 	// e.g. set ProcessedTime in Tendermint clients
-	if err := clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, clientID), consensusState); err != nil {
-		return "", err
+	if err := clientState.Initialize(k.WithHostTimeOracle(ctx), k.cdc, k.ClientStore(ctx, clientID), consensusState); err != nil {
+		return err
 	}
 
 	// check if consensus state is nil in case the created client is Localhost
@@ -53,28 +75,104 @@ func (k Keeper) CreateClient(
 
 	EmitCreateClientEvent(ctx, clientID, clientState)
 
-	return clientID, nil
+	return nil
 }
 
 // UpdateClient updates the consensus state and the state root from a provided header.
 func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error {
+	clientState, err := k.getUpdatableClientState(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	newClientState, _, err := k.applyHeader(ctx, clientID, clientState, header)
+	if err != nil {
+		return err
+	}
+
+	// set new client state regardless of if update is valid update or misbehaviour
+	k.SetClientState(ctx, clientID, newClientState)
+
+	return nil
+}
+
+// BatchUpdateClient updates the client with a sequence of headers, applied in order. The headers
+// are applied atomically: the client is left unchanged if any of the headers fails to update it.
+// The client status is checked once for the whole batch and each header is verified against the
+// client state resulting from the previous header, which is kept in memory: the client state is
+// read before the first header and written after the last header only. The headers following a
+// header which froze the client are ignored.
+func (k Keeper) BatchUpdateClient(ctx sdk.Context, clientID string, headers []exported.Header) error {
+	if len(headers) == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidHeader, "cannot update client with ID %s without headers", clientID)
+	}
+
+	if len(headers) > types.MaxBatchUpdateHeaders {
+		return sdkerrors.Wrapf(types.ErrInvalidHeader, "cannot update client with ID %s with %d headers, maximum is %d", clientID, len(headers), types.MaxBatchUpdateHeaders)
+	}
+
+	clientState, err := k.getUpdatableClientState(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, header := range headers {
+		var frozen bool
+		clientState, frozen, err = k.applyHeader(cacheCtx, clientID, clientState, header)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to apply header %d", i)
+		}
+
+		if frozen {
+			k.Logger(ctx).Info("client frozen during batch update, ignoring remaining headers", "client-id", clientID, "applied", i+1, "headers", len(headers))
+			break
+		}
+	}
+
+	k.SetClientState(cacheCtx, clientID, clientState)
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// getUpdatableClientState returns the client state of the provided client if the client is active.
+func (k Keeper) getUpdatableClientState(ctx sdk.Context, clientID string) (exported.ClientState, error) {
 	clientState, found := k.GetClientState(ctx, clientID)
 	if !found {
-		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
+	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
+	return clientState, nil
+}
+
+// applyHeader verifies the header against the provided client state and stores the resulting
+// consensus state. The resulting client state is returned along with whether the header froze
+// the client, it is not stored.
+func (k Keeper) applyHeader(ctx sdk.Context, clientID string, clientState exported.ClientState, header exported.Header) (exported.ClientState, bool, error) {
+	clientStore := k.ClientStore(ctx, clientID)
+
 	// Any writes made in CheckHeaderAndUpdateState are persisted on both valid updates and misbehaviour updates.
 	// Light client implementations are responsible for writing the correct metadata (if any) in either case.
-	newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, header)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	var (
+		newClientState    exported.ClientState
+		newConsensusState exported.ConsensusState
+	)
+	err := k.callClient(ctx, clientState.ClientType(), func(callCtx sdk.Context) (err error) {
+		newClientState, newConsensusState, err = clientState.CheckHeaderAndUpdateState(callCtx, k.cdc, k.ClientStore(callCtx, clientID), header)
+		return err
+	})
 	if err != nil {
-		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+		return nil, false, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
 	}
+	headerGas := ctx.GasMeter().GasConsumed() - gasBefore
 
 	// emit the full header in events
 	var (
@@ -91,8 +189,6 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 
 	}
 
-	// set new client state regardless of if update is valid update or misbehaviour
-	k.SetClientState(ctx, clientID, newClientState)
 	// If client state is not frozen after clientState CheckHeaderAndUpdateState,
 	// then update was valid. Write the update state changes, and set new consensus state.
 	// Else the update was proof of misbehaviour and we must emit appropriate misbehaviour events.
@@ -120,7 +216,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		}()
 
 		// emitting events in the keeper emits for both begin block and handler client updates
-		EmitUpdateClientEvent(ctx, clientID, newClientState, consensusHeight, headerStr)
+		EmitUpdateClientEvent(ctx, clientID, newClientState, consensusHeight, headerStr, headerGas)
 	} else {
 
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
@@ -138,9 +234,11 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		}()
 
 		EmitSubmitMisbehaviourEventOnUpdate(ctx, clientID, newClientState, consensusHeight, headerStr)
+
+		return newClientState, true, nil
 	}
 
-	return nil
+	return newClientState, false, nil
 }
 
 // UpgradeClient upgrades the client to a new client state if this new client was committed to
@@ -152,18 +250,27 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	updatedClientState, updatedConsState, err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
-		upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState)
+	var (
+		updatedClientState exported.ClientState
+		updatedConsState   exported.ConsensusState
+	)
+	err := k.callClient(ctx, clientState.ClientType(), func(callCtx sdk.Context) (err error) {
+		updatedClientState, updatedConsState, err = clientState.VerifyUpgradeAndUpdateState(callCtx, k.cdc, k.ClientStore(callCtx, clientID),
+			upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState)
+		return err
+	})
 	if err != nil {
 		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
 
+	if err := k.validateProofSpecsHashOps(updatedClientState); err != nil {
+		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
+	}
+
 	k.SetClientState(ctx, clientID, updatedClientState)
 	k.SetClientConsensusState(ctx, clientID, updatedClientState.GetLatestHeight(), updatedConsState)
 
@@ -186,6 +293,37 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 	return nil
 }
 
+// VerifyClientUpgradePlan verifies proofs of the upgrade plan scheduled by the counterparty chain of
+// the given client and of the upgraded client committed to for the plan, at the provided proof
+// height. The client must implement the UpgradePlanVerifier interface. A verified plan allows
+// the upgrade of the client with UpgradeClient to be prepared once the counterparty chain
+// halted at the plan height.
+func (k Keeper) VerifyClientUpgradePlan(ctx sdk.Context, clientID string, proofHeight exported.Height, plan upgradetypes.Plan, upgradedClient exported.ClientState,
+	proofPlan, proofUpgradedClient []byte) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot verify upgrade plan of client with ID %s", clientID)
+	}
+
+	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot verify upgrade plan of client (%s) with status %s", clientID, status)
+	}
+
+	verifier, ok := clientState.(exported.UpgradePlanVerifier)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUpgradePlanVerificationUnsupported, "client type %s", clientState.ClientType())
+	}
+
+	err := k.callClient(ctx, clientState.ClientType(), func(callCtx sdk.Context) error {
+		return verifier.VerifyUpgradePlan(k.ClientStore(callCtx, clientID), k.cdc, proofHeight, plan, upgradedClient, proofPlan, proofUpgradedClient)
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot verify upgrade plan of client with ID %s", clientID)
+	}
+
+	return nil
+}
+
 // CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes the
 // client if so.
 func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error {
@@ -194,9 +332,7 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot check misbehaviour for client with ID %s", misbehaviour.GetClientID())
 	}
 
-	clientStore := k.ClientStore(ctx, misbehaviour.GetClientID())
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := k.GetClientStatus(ctx, clientState, misbehaviour.GetClientID()); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot process misbehaviour for client (%s) with status %s", misbehaviour.GetClientID(), status)
 	}
 
@@ -204,8 +340,10 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return err
 	}
 
-	clientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, misbehaviour)
-	if err != nil {
+	if err := k.callClient(ctx, clientState.ClientType(), func(callCtx sdk.Context) (err error) {
+		clientState, err = clientState.CheckMisbehaviourAndUpdateState(callCtx, k.cdc, k.ClientStore(callCtx, misbehaviour.GetClientID()), misbehaviour)
+		return err
+	}); err != nil {
 		return err
 	}
 
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// SetClientStoreDecorator sets the decorator wrapping the client stores of all clients of
+// the provided client type. The decorators are shared by all copies of the keeper. The
+// method panics if the decorator is nil.
+func (k *Keeper) SetClientStoreDecorator(clientType string, decorator types.ClientStoreDecorator) {
+	if decorator == nil {
+		panic(fmt.Errorf("client store decorator for client type %s cannot be nil", clientType))
+	}
+
+	k.storeDecorators[clientType] = decorator
+}
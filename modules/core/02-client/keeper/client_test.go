@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"time"
 
+	ics23 "github.com/confio/ics23/go"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -41,6 +44,75 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+// TestCreateClientAllowedHashOps tests that the clients whose proof specs use hash operations
+// which are not allowed cannot be created.
+func (suite *KeeperTestSuite) TestCreateClientAllowedHashOps() {
+	sdkClient := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+
+	leafSpec, innerSpec := *ics23.IavlSpec.LeafSpec, *ics23.IavlSpec.InnerSpec
+	leafSpec.Hash = ics23.HashOp_SHA512
+	sha512Specs := []*ics23.ProofSpec{{LeafSpec: &leafSpec, InnerSpec: &innerSpec}, ics23.TendermintSpec}
+	sha512Client := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, sha512Specs, ibctesting.UpgradePath, false, false)
+
+	// all the hash operations implemented by ICS-23 are allowed by default
+	suite.Require().Nil(suite.keeper.GetAllowedHashOps())
+	_, err := suite.keeper.CreateClient(suite.ctx, sha512Client, suite.consensusState)
+	suite.Require().NoError(err)
+
+	suite.keeper.SetAllowedHashOps(ics23.HashOp_SHA256)
+	suite.Require().Equal([]ics23.HashOp{ics23.HashOp_SHA256}, suite.keeper.GetAllowedHashOps())
+
+	_, err = suite.keeper.CreateClient(suite.ctx, sdkClient, suite.consensusState)
+	suite.Require().NoError(err)
+
+	nextSequence := suite.keeper.GetNextClientSequence(suite.ctx)
+	_, err = suite.keeper.CreateClient(suite.ctx, sha512Client, suite.consensusState)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClient)
+	suite.Require().Equal(nextSequence, suite.keeper.GetNextClientSequence(suite.ctx))
+
+	suite.Require().Panics(func() {
+		suite.keeper.SetAllowedHashOps()
+	})
+
+	suite.Require().Panics(func() {
+		suite.keeper.SetAllowedHashOps(ics23.HashOp_KECCAK)
+	})
+}
+
+func (suite *KeeperTestSuite) TestCreateClientWithReservedSequence() {
+	clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+
+	nextSequence := suite.keeper.GetNextClientSequence(suite.ctx)
+	reserved := suite.keeper.ReserveClientIdentifiers(suite.ctx, 2)
+	suite.Require().Equal([]uint64{nextSequence, nextSequence + 1}, reserved)
+	suite.Require().Equal(reserved, suite.keeper.GetReservedClientSequences(suite.ctx))
+
+	// generated client identifiers skip the reserved sequences
+	clientID, err := suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clienttypes.FormatClientIdentifier(exported.Tendermint, nextSequence+2), clientID)
+
+	clientID, err = suite.keeper.CreateClientWithReservedSequence(suite.ctx, reserved[1], clientState, suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clienttypes.FormatClientIdentifier(exported.Tendermint, reserved[1]), clientID)
+	suite.Require().Equal([]uint64{reserved[0]}, suite.keeper.GetReservedClientSequences(suite.ctx))
+
+	_, found := suite.keeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+
+	// a reserved sequence is only used once
+	_, err = suite.keeper.CreateClientWithReservedSequence(suite.ctx, reserved[1], clientState, suite.consensusState)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientSequenceNotReserved)
+
+	_, err = suite.keeper.CreateClientWithReservedSequence(suite.ctx, nextSequence+2, clientState, suite.consensusState)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientSequenceNotReserved)
+
+	// the client type must be allowed
+	_, err = suite.keeper.CreateClientWithReservedSequence(suite.ctx, reserved[0], localhosttypes.NewClientState(testChainID, clienttypes.NewHeight(0, 1)), nil)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClientType)
+	suite.Require().True(suite.keeper.IsReservedClientSequence(suite.ctx, reserved[0]))
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -265,6 +337,192 @@ func (suite *KeeperTestSuite) TestUpdateClientLocalhost() {
 	suite.Require().Equal(localhostClient.GetLatestHeight().(types.Height).Increment(), clientState.GetLatestHeight())
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientHeaderVerificationGas() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+
+	var headerGas string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeUpdateClient {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyHeaderGas {
+				headerGas = string(attr.Value)
+			}
+		}
+	}
+
+	gas, err := strconv.ParseUint(headerGas, 10, 64)
+	suite.Require().NoError(err)
+	suite.Require().NotZero(gas)
+	suite.Require().LessOrEqual(gas, ctx.GasMeter().GasConsumed())
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientCallGasLimit() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientKeeper := &suite.chainA.App.GetIBCKeeper().ClientKeeper
+	suite.Require().Panics(func() {
+		clientKeeper.SetClientCallGasLimits(map[string]sdk.Gas{exported.Tendermint: 0})
+	})
+
+	clientKeeper.SetClientCallGasLimits(map[string]sdk.Gas{exported.Tendermint: 1})
+	defer clientKeeper.SetClientCallGasLimits(nil)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().ErrorIs(err, types.ErrClientCallOutOfGas)
+
+	clientKeeper.SetClientCallGasLimits(nil)
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestBatchUpdateClient() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		headers  []exported.Header
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expFrozen bool
+	}{
+		{"success", func() {}, true, false},
+		{"empty headers", func() {
+			headers = nil
+		}, false, false},
+		{"client not found", func() {
+			clientID = ibctesting.InvalidID
+		}, false, false},
+		{"too many headers", func() {
+			for len(headers) <= types.MaxBatchUpdateHeaders {
+				headers = append(headers, headers[1])
+			}
+		}, false, false},
+		{"invalid last header reverts all headers", func() {
+			header := headers[1].(*ibctmtypes.Header)
+			header.TrustedHeight = header.TrustedHeight.Increment().(clienttypes.Height)
+		}, false, false},
+		{"headers following a freezing header are ignored", func() {
+			// store a conflicting consensus state at the height of the first header
+			conflictConsState := headers[0].(*ibctmtypes.Header).ConsensusState()
+			conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headers[0].GetHeight(), conflictConsState)
+		}, true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			// both headers are trusted from the latest height of the client
+			headers = make([]exported.Header, 2)
+			for i := range headers {
+				suite.coordinator.CommitBlock(suite.chainB)
+
+				header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+				suite.Require().NoError(err)
+				headers[i] = header
+			}
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			clientStateBefore := path.EndpointA.GetClientState()
+			ctx := suite.chainA.GetContext()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.BatchUpdateClient(ctx, clientID, headers)
+
+			clientState := path.EndpointA.GetClientState()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				// no header is applied
+				suite.Require().Equal(clientStateBefore, clientState)
+				if len(headers) > 0 {
+					suite.Require().False(suite.chainA.App.GetIBCKeeper().ClientKeeper.HasClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headers[0].GetHeight()))
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			if tc.expFrozen {
+				suite.Require().False(clientState.(*ibctmtypes.ClientState).FrozenHeight.IsZero())
+				suite.Require().Equal(clientStateBefore.GetLatestHeight(), clientState.GetLatestHeight())
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ClientKeeper.HasClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headers[1].GetHeight()))
+				return
+			}
+
+			suite.Require().Equal(headers[1].GetHeight(), clientState.GetLatestHeight())
+			for _, header := range headers {
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ClientKeeper.HasClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight()))
+			}
+
+			// an update_client event is emitted for every header
+			var updateEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeUpdateClient {
+					updateEvents++
+				}
+			}
+			suite.Require().Equal(len(headers), updateEvents)
+		})
+	}
+}
+
+// TestBatchUpdateClientGas tests that a batch update consumes less gas than updating the client
+// with the same headers one by one, as the client state is read and written once per batch.
+func (suite *KeeperTestSuite) TestBatchUpdateClientGas() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	headers := make([]exported.Header, 3)
+	for i := range headers {
+		suite.coordinator.CommitBlock(suite.chainB)
+
+		header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+		suite.Require().NoError(err)
+		headers[i] = header
+	}
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, header := range headers {
+		suite.Require().NoError(clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header))
+	}
+	sequentialGas := ctx.GasMeter().GasConsumed()
+
+	ctx, _ = suite.chainA.GetContext().CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	suite.Require().NoError(clientKeeper.BatchUpdateClient(ctx, path.EndpointA.ClientID, headers))
+	batchGas := ctx.GasMeter().GasConsumed()
+
+	suite.Require().Less(batchGas, sequentialGas)
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path                                        *ibctesting.Path
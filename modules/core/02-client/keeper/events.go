@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -23,8 +25,9 @@ func EmitCreateClientEvent(ctx sdk.Context, clientID string, clientState exporte
 	})
 }
 
-// EmitUpdateClientEvent emits an update client event
-func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeight exported.Height, headerStr string) {
+// EmitUpdateClientEvent emits an update client event. The gas consumed by the verification
+// of the header is included so that relayers may estimate the gas of future updates.
+func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeight exported.Height, headerStr string, headerGas sdk.Gas) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpdateClient,
@@ -32,6 +35,7 @@ func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientState exporte
 			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
 			sdk.NewAttribute(types.AttributeKeyConsensusHeight, consensusHeight.String()),
 			sdk.NewAttribute(types.AttributeKeyHeader, headerStr),
+			sdk.NewAttribute(types.AttributeKeyHeaderGas, strconv.FormatUint(headerGas, 10)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -68,6 +72,42 @@ func EmitUpdateClientProposalEvent(ctx sdk.Context, clientID string, clientState
 	)
 }
 
+// EmitRecoverClientEvent emits a recover client event
+func EmitRecoverClientEvent(ctx sdk.Context, subjectClientID, substituteClientID string, clientState exported.ClientState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverClient,
+			sdk.NewAttribute(types.AttributeKeySubjectClientID, subjectClientID),
+			sdk.NewAttribute(types.AttributeKeySubstituteID, substituteClientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+		),
+	)
+}
+
+// EmitScheduleIBCSoftwareUpgradeEvent emits a schedule ibc software upgrade event
+func EmitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, name string, height int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleIBCSoftwareUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradePlanName, name),
+			sdk.NewAttribute(types.AttributeKeyUpgradePlanHeight, strconv.FormatInt(height, 10)),
+		),
+	)
+}
+
+// EmitArchiveClientEvent emits an archive client event
+func EmitArchiveClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeArchiveClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+		),
+	)
+}
+
 // EmitSubmitMisbehaviourEvent emits a client misbehaviour event
 func EmitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvent(
@@ -91,3 +131,19 @@ func EmitSubmitMisbehaviourEventOnUpdate(ctx sdk.Context, clientID string, clien
 		),
 	)
 }
+
+// EmitQueueClientUpdateEvent emits a queue client update event
+func EmitQueueClientUpdateEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, header exported.Header) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueClientUpdate,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, header.GetHeight().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// SetClientCallGasLimits sets the per client type gas limits of the calls made into light
+// clients when updating, upgrading or checking misbehaviour of a client. Calls of client
+// types without a gas limit are only limited by the gas meter of the context. The limits
+// are shared by all copies of the keeper. The method panics if a gas limit is zero.
+func (k *Keeper) SetClientCallGasLimits(limits map[string]sdk.Gas) {
+	for clientType, limit := range limits {
+		if limit == 0 {
+			panic(fmt.Errorf("gas limit for client type %s cannot be zero", clientType))
+		}
+	}
+
+	for clientType := range k.callGasLimits {
+		delete(k.callGasLimits, clientType)
+	}
+
+	for clientType, limit := range limits {
+		k.callGasLimits[clientType] = limit
+	}
+}
+
+// SetUpdateGasOverhead sets the gas consumed when a client is updated outside of the client
+// keeper and the light client, such as by the relayer hooks of the core IBC keeper, which is
+// added to the client update gas estimates. The overhead is shared by all copies of the keeper.
+func (k *Keeper) SetUpdateGasOverhead(gas sdk.Gas) {
+	*k.updateGasOverhead = gas
+}
+
+// EstimateClientUpdateGas estimates the gas of a transaction with a single MsgUpdateClient
+// updating the client with the provided identifier, given the validator set size of the
+// counterparty chain. It returns the estimated gas, including the overhead set with
+// SetUpdateGasOverhead, and the number of signatures verified by the client.
+func (k Keeper) EstimateClientUpdateGas(ctx sdk.Context, clientID string, validatorSetSize uint64) (uint64, uint64, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrClientNotFound, "cannot estimate update gas of client with ID %s", clientID)
+	}
+
+	estimator, ok := clientState.(exported.UpdateGasEstimator)
+	if !ok {
+		return 0, 0, sdkerrors.Wrapf(types.ErrUpdateGasEstimationUnsupported, "client type %s", clientState.ClientType())
+	}
+
+	gas, signaturesVerified, err := estimator.EstimateUpdateGas(validatorSetSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return gas + *k.updateGasOverhead, signaturesVerified, nil
+}
+
+// callClient executes a call into a light client of the provided client type using a gas
+// meter limited by the gas limit of the client type and the gas remaining in the context.
+// The gas consumed by the call is charged to the gas meter of the context. Panics raised by
+// the call, including running out of gas, are recovered and returned as errors so that a
+// faulty or malicious light client cannot halt the chain. The host time oracle of the keeper is
+// set in the context of the call.
+func (k Keeper) callClient(ctx sdk.Context, clientType string, call func(callCtx sdk.Context) error) (err error) {
+	gasLimit, hasLimit := k.callGasLimits[clientType]
+
+	// a gas meter limit of zero denotes an infinite gas meter
+	if limit := ctx.GasMeter().Limit(); limit != 0 {
+		remaining := limit - ctx.GasMeter().GasConsumedToLimit()
+		if !hasLimit || remaining < gasLimit {
+			gasLimit, hasLimit = remaining, true
+		}
+	}
+
+	callMeter := sdk.NewInfiniteGasMeter()
+	if hasLimit {
+		callMeter = sdk.NewGasMeter(gasLimit)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(types.ErrClientCallOutOfGas, "%s client call out of gas in location: %s; gas limit: %d", clientType, rType.Descriptor, gasLimit)
+			default:
+				err = sdkerrors.Wrapf(types.ErrClientCallPanic, "%s client call panicked: %v", clientType, r)
+			}
+		}
+
+		ctx.GasMeter().ConsumeGas(callMeter.GasConsumedToLimit(), fmt.Sprintf("%s client call", clientType))
+	}()
+
+	return call(k.WithHostTimeOracle(ctx).WithGasMeter(callMeter))
+}
@@ -3,20 +3,21 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -63,33 +64,34 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 	clientStates := types.IdentifiedClientStates{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.KeyClientStorePrefix)
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		keySplit := strings.Split(string(key), "/")
 		if keySplit[len(keySplit)-1] != "clientState" {
-			return nil
+			return false, nil
 		}
 
-		clientState, err := q.UnmarshalClientState(value)
-		if err != nil {
-			return err
-		}
+		if accumulate {
+			clientState, err := q.UnmarshalClientState(value)
+			if err != nil {
+				return false, err
+			}
 
-		clientID := keySplit[1]
-		if err := host.ClientIdentifierValidator(clientID); err != nil {
-			return err
+			clientID := keySplit[1]
+			if err := host.ClientIdentifierValidator(clientID); err != nil {
+				return false, err
+			}
+
+			identifiedClient := types.NewIdentifiedClientState(clientID, clientState)
+			clientStates = append(clientStates, identifiedClient)
 		}
 
-		identifiedClient := types.NewIdentifiedClientState(clientID, clientState)
-		clientStates = append(clientStates, identifiedClient)
-		return nil
+		return true, nil
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	sort.Sort(clientStates)
-
 	return &types.QueryClientStatesResponse{
 		ClientStates: clientStates,
 		Pagination:   pageRes,
@@ -143,6 +145,107 @@ func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusState
 	}, nil
 }
 
+// TrustedConsensusState implements the Query/TrustedConsensusState gRPC method
+func (q Keeper) TrustedConsensusState(c context.Context, req *types.QueryTrustedConsensusStateRequest) (*types.QueryTrustedConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.RevisionHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	// trusted consensus states may only be determined for clients storing consensus state metadata
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "trusted consensus state queries are not supported for client type %s", clientState.ClientType())
+	}
+
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	trustedHeight, consensusState, found := ibctmtypes.GetTrustedConsensusState(ctx, q.ClientStore(ctx, req.ClientId), q.cdc, tmClientState, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "no unexpired consensus state below height %s for client-id: %s", height, req.ClientId).Error(),
+		)
+	}
+
+	any, err := types.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrustedConsensusStateResponse{
+		TrustedHeight:  trustedHeight.(types.Height),
+		ConsensusState: any,
+		ProofHeight:    types.GetSelfHeight(ctx),
+	}, nil
+}
+
+// FrozenClientEvidence implements the Query/FrozenClientEvidence gRPC method
+func (q Keeper) FrozenClientEvidence(c context.Context, req *types.QueryFrozenClientEvidenceRequest) (*types.QueryFrozenClientEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	// misbehaviour evidence is only stored by 07-tendermint clients
+	if _, ok := clientState.(*ibctmtypes.ClientState); !ok {
+		return nil, status.Errorf(codes.Unimplemented, "frozen client evidence queries are not supported for client type %s", clientState.ClientType())
+	}
+
+	evidence, found := ibctmtypes.GetFrozenEvidence(q.ClientStore(ctx, req.ClientId), q.cdc)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no misbehaviour evidence stored for client-id: %s", req.ClientId)
+	}
+
+	res := &types.QueryFrozenClientEvidenceResponse{
+		FrozenHeight: evidence.FrozenHeight,
+		FrozenTime:   evidence.FrozenTime,
+		ProofHeight:  types.GetSelfHeight(ctx),
+	}
+
+	var err error
+	if evidence.Misbehaviour != nil {
+		if res.Misbehaviour, err = types.PackMisbehaviour(evidence.Misbehaviour); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if evidence.Header != nil {
+		if res.Header, err = types.PackHeader(evidence.Header); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if evidence.ConflictingConsensusState != nil {
+		if res.ConflictingConsensusState, err = types.PackConsensusState(evidence.ConflictingConsensusState); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
 // ConsensusStates implements the Query/ConsensusStates gRPC method
 func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStatesRequest) (*types.QueryConsensusStatesResponse, error) {
 	if req == nil {
@@ -158,23 +261,26 @@ func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStat
 	consensusStates := []types.ConsensusStateWithHeight{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.FullClientKey(req.ClientId, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
 
-	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := pagination.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		// filter any metadata stored under consensus state key
 		if bytes.Contains(key, []byte("/")) {
 			return false, nil
 		}
 
-		height, err := types.ParseHeight(string(key))
-		if err != nil {
-			return false, err
-		}
+		if accumulate {
+			height, err := types.ParseHeight(string(key))
+			if err != nil {
+				return false, err
+			}
 
-		consensusState, err := q.UnmarshalConsensusState(value)
-		if err != nil {
-			return false, err
+			consensusState, err := q.UnmarshalConsensusState(value)
+			if err != nil {
+				return false, err
+			}
+
+			consensusStates = append(consensusStates, types.NewConsensusStateWithHeight(height, consensusState))
 		}
 
-		consensusStates = append(consensusStates, types.NewConsensusStateWithHeight(height, consensusState))
 		return true, nil
 	})
 
@@ -207,8 +313,7 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 		)
 	}
 
-	clientStore := q.ClientStore(ctx, req.ClientId)
-	status := clientState.Status(ctx, clientStore, q.cdc)
+	status := q.GetClientStatus(ctx, clientState, req.ClientId)
 
 	return &types.QueryClientStatusResponse{
 		Status: status.String(),
@@ -231,18 +336,27 @@ func (q Keeper) UpgradedClientState(c context.Context, req *types.QueryUpgradedC
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.PlanHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan height cannot be negative")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
-	plan, found := q.GetUpgradePlan(ctx)
-	if !found {
-		return nil, status.Error(
-			codes.NotFound, "upgrade plan not found",
-		)
+	planHeight := req.PlanHeight
+	if planHeight == 0 {
+		plan, found := q.GetUpgradePlan(ctx)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound, "upgrade plan not found",
+			)
+		}
+
+		planHeight = plan.Height
 	}
 
-	bz, found := q.GetUpgradedClient(ctx, plan.Height)
+	bz, found := q.GetUpgradedClient(ctx, planHeight)
 	if !found {
-		return nil, status.Error(codes.NotFound, types.ErrClientNotFound.Error())
+		return nil, status.Errorf(codes.NotFound, "%s, plan height %d", types.ErrClientNotFound.Error(), planHeight)
 	}
 
 	clientState, err := types.UnmarshalClientState(q.cdc, bz)
@@ -257,6 +371,8 @@ func (q Keeper) UpgradedClientState(c context.Context, req *types.QueryUpgradedC
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// the proof can only be retrieved through an ABCI store query, the proof height is not set
+	// without a proof
 	return &types.QueryUpgradedClientStateResponse{
 		UpgradedClientState: any,
 	}, nil
@@ -268,11 +384,20 @@ func (q Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.PlanHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan height cannot be negative")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
-	bz, found := q.GetUpgradedConsensusState(ctx, ctx.BlockHeight())
+	planHeight := req.PlanHeight
+	if planHeight == 0 {
+		planHeight = ctx.BlockHeight()
+	}
+
+	bz, found := q.GetUpgradedConsensusState(ctx, planHeight)
 	if !found {
-		return nil, status.Errorf(codes.NotFound, "%s, height %d", types.ErrConsensusStateNotFound.Error(), ctx.BlockHeight())
+		return nil, status.Errorf(codes.NotFound, "%s, height %d", types.ErrConsensusStateNotFound.Error(), planHeight)
 	}
 
 	consensusState, err := types.UnmarshalConsensusState(q.cdc, bz)
@@ -287,7 +412,60 @@ func (q Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// the proof can only be retrieved through an ABCI store query, the proof height is not set
+	// without a proof
 	return &types.QueryUpgradedConsensusStateResponse{
 		UpgradedConsensusState: any,
 	}, nil
 }
+
+// VerifyUpgradePlan implements the Query/VerifyUpgradePlan gRPC method
+func (q Keeper) VerifyUpgradePlan(c context.Context, req *types.QueryVerifyUpgradePlanRequest) (*types.QueryVerifyUpgradePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	upgradedClient, err := types.UnpackClientState(req.UpgradedClientState)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := q.VerifyClientUpgradePlan(ctx, req.ClientId, req.ProofHeight, req.Plan, upgradedClient, req.ProofPlan, req.ProofUpgradedClient); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryVerifyUpgradePlanResponse{}, nil
+}
+
+// EstimateUpdateClientGas implements the Query/EstimateUpdateClientGas gRPC method
+func (q Keeper) EstimateUpdateClientGas(c context.Context, req *types.QueryEstimateUpdateClientGasRequest) (*types.QueryEstimateUpdateClientGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gas, signaturesVerified, err := q.EstimateClientUpdateGas(ctx, req.ClientId, req.ValidatorSetSize)
+	if err != nil {
+		if errors.Is(err, types.ErrClientNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateUpdateClientGasResponse{
+		GasEstimate:        gas,
+		SignaturesVerified: signaturesVerified,
+	}, nil
+}
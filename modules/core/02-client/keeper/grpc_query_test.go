@@ -6,10 +6,10 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
-	"google.golang.org/grpc/metadata"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	relayertypes "github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -291,6 +291,235 @@ func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryTrustedConsensusState() {
+	var (
+		path             *ibctesting.Path
+		req              *types.QueryTrustedConsensusStateRequest
+		expTrustedHeight exported.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: latest consensus state below target height",
+			func() {},
+			true,
+		},
+		{
+			"success: consensus state below intermediate target height",
+			func() {
+				trustedHeight := expTrustedHeight
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				req.RevisionHeight = path.EndpointA.GetClientState().GetLatestHeight().GetRevisionHeight()
+				expTrustedHeight = trustedHeight
+			},
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid target height",
+			func() {
+				req.RevisionHeight = 0
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"no consensus state below target height",
+			func() {
+				req.RevisionHeight = expTrustedHeight.GetRevisionHeight()
+			},
+			false,
+		},
+		{
+			"consensus state is expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			false,
+		},
+		{
+			"client type not supported",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+
+				req.ClientId = solomachine.ClientID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			expTrustedHeight = path.EndpointA.GetClientState().GetLatestHeight()
+			req = &types.QueryTrustedConsensusStateRequest{
+				ClientId:       path.EndpointA.ClientID,
+				RevisionNumber: expTrustedHeight.GetRevisionNumber(),
+				RevisionHeight: expTrustedHeight.GetRevisionHeight() + 10,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.TrustedConsensusState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTrustedHeight, res.TrustedHeight)
+
+				expConsensusState, err := types.PackConsensusState(path.EndpointA.GetConsensusState(expTrustedHeight))
+				suite.Require().NoError(err)
+				suite.Require().Equal(expConsensusState, res.ConsensusState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFrozenClientEvidence() {
+	var (
+		path              *ibctesting.Path
+		req               *types.QueryFrozenClientEvidenceRequest
+		expHeader         *ibctmtypes.Header
+		expConsensusState *ibctmtypes.ConsensusState
+	)
+
+	// freeze the client with a header conflicting with a stored consensus state
+	freezeClient := func() {
+		trustedHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+
+		consState := path.EndpointA.GetConsensusState(trustedHeight).(*ibctmtypes.ConsensusState)
+		height := path.EndpointA.GetClientState().GetLatestHeight()
+		expConsensusState = path.EndpointA.GetConsensusState(height).(*ibctmtypes.ConsensusState)
+		header := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(height.GetRevisionHeight()), trustedHeight, consState.Timestamp.Add(time.Second*5),
+			suite.chainB.Vals, suite.chainB.Vals, suite.chainB.Signers)
+
+		err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+		suite.Require().NoError(err)
+		suite.Require().Equal(exported.Frozen, path.EndpointA.GetClientState().Status(suite.chainA.GetContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID), suite.chainA.Codec))
+
+		expHeader = header
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			freezeClient,
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"client is not frozen",
+			func() {},
+			false,
+		},
+		{
+			"client type not supported",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+
+				req.ClientId = solomachine.ClientID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			req = &types.QueryFrozenClientEvidenceRequest{
+				ClientId: path.EndpointA.ClientID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.FrozenClientEvidence(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(types.GetSelfHeight(suite.chainA.GetContext()), res.FrozenHeight)
+				suite.Require().True(suite.chainA.GetContext().BlockTime().Equal(res.FrozenTime))
+
+				// the misbehaviour was detected while updating the client
+				suite.Require().Nil(res.Misbehaviour)
+
+				header, err := types.UnpackHeader(res.Header)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.chainA.Codec.MustMarshal(expHeader), suite.chainA.Codec.MustMarshal(header.(*ibctmtypes.Header)))
+
+				consensusState, err := types.UnpackConsensusState(res.ConflictingConsensusState)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expConsensusState, consensusState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusStates() {
 	var (
 		req                *types.QueryConsensusStatesRequest
@@ -531,6 +760,26 @@ func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 			},
 			true,
 		},
+		{
+			"valid consensus state at plan height",
+			func() {
+				planHeight := suite.ctx.BlockHeight() + 100
+				req = &types.QueryUpgradedConsensusStateRequest{PlanHeight: planHeight}
+
+				expConsensusState = types.MustPackConsensusState(suite.consensusState)
+				bz := types.MustMarshalConsensusState(suite.cdc, suite.consensusState)
+				err := suite.keeper.SetUpgradedConsensusState(suite.ctx, planHeight, bz)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"negative plan height",
+			func() {
+				req = &types.QueryUpgradedConsensusStateRequest{PlanHeight: -1}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -540,12 +789,300 @@ func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 			tc.malleate()
 
 			ctx := sdk.WrapSDKContext(suite.ctx)
-			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, fmt.Sprintf("%d", height))
 
-			res, err := suite.queryClient.UpgradedConsensusState(ctx, req)
+			res, err := suite.keeper.UpgradedConsensusState(ctx, req)
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().True(expConsensusState.Equal(res.UpgradedConsensusState))
+				suite.Require().Empty(res.Proof)
+				suite.Require().True(res.ProofHeight.IsZero(), "proof height is not set without a proof")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
+	var (
+		req            *types.QueryUpgradedClientStateRequest
+		expClientState *codectypes.Any
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"no plan",
+			func() {
+				req = &types.QueryUpgradedClientStateRequest{}
+			},
+			false,
+		},
+		{
+			"negative plan height",
+			func() {
+				req = &types.QueryUpgradedClientStateRequest{PlanHeight: -1}
+			},
+			false,
+		},
+		{
+			"no upgraded client at plan height",
+			func() {
+				req = &types.QueryUpgradedClientStateRequest{PlanHeight: 1000}
+			},
+			false,
+		},
+		{
+			"valid upgraded client state at plan height",
+			func() {
+				planHeight := int64(1000)
+				req = &types.QueryUpgradedClientStateRequest{PlanHeight: planHeight}
+
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+				var err error
+				expClientState, err = types.PackClientState(clientState)
+				suite.Require().NoError(err)
+
+				bz := types.MustMarshalClientState(suite.chainA.App.AppCodec(), clientState)
+				err = suite.chainA.GetSimApp().UpgradeKeeper.SetUpgradedClient(suite.chainA.GetContext(), planHeight, bz)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.UpgradedClientState(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(expClientState.Equal(res.UpgradedClientState))
+				suite.Require().Empty(res.Proof)
+				suite.Require().True(res.ProofHeight.IsZero(), "proof height is not set without a proof")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyUpgradePlan() {
+	var (
+		req  *types.QueryVerifyUpgradePlanRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"invalid upgraded client state",
+			func() {
+				req.UpgradedClientState = nil
+			},
+			false,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			false,
+		},
+		{
+			"client type not supported",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+
+				req.ClientId = solomachine.ClientID
+			},
+			false,
+		},
+		{
+			"verification failed",
+			func() {
+				req.Plan.Name = "other upgrade"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			upgradedClient := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, newClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+			upgradedClient = upgradedClient.ZeroCustomFields().(*ibctmtypes.ClientState)
+
+			// schedule an upgrade plan on chainB and commit to the upgraded client
+			plan := upgradetypes.Plan{
+				Name:   "upgrade IBC clients",
+				Height: suite.chainB.GetContext().BlockHeight() + 100,
+			}
+			err := suite.chainB.GetSimApp().UpgradeKeeper.ScheduleUpgrade(suite.chainB.GetContext(), plan)
+			suite.Require().NoError(err)
+			err = suite.chainB.GetSimApp().UpgradeKeeper.SetUpgradedClient(suite.chainB.GetContext(), plan.Height, types.MustMarshalClientState(suite.chainB.App.AppCodec(), upgradedClient))
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			proofHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+			proofPlan, _ := suite.chainB.QueryUpgradeProof(upgradetypes.PlanKey(), proofHeight.GetRevisionHeight())
+			proofUpgradedClient, _ := suite.chainB.QueryUpgradeProof(upgradetypes.UpgradedClientKey(plan.Height), proofHeight.GetRevisionHeight())
+
+			any, err := types.PackClientState(upgradedClient)
+			suite.Require().NoError(err)
+
+			req = &types.QueryVerifyUpgradePlanRequest{
+				ClientId:            path.EndpointA.ClientID,
+				Plan:                plan,
+				UpgradedClientState: any,
+				ProofPlan:           proofPlan,
+				ProofUpgradedClient: proofUpgradedClient,
+				ProofHeight:         proofHeight,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.VerifyUpgradePlan(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateUpdateClientGas() {
+	var req *types.QueryEstimateUpdateClientGasRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"empty validator set",
+			func() {
+				req.ValidatorSetSize = 0
+			},
+			false,
+		},
+		{
+			"client does not support update gas estimation",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), solomachine.ClientID, solomachine.ClientState())
+
+				req.ClientId = solomachine.ClientID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			req = &types.QueryEstimateUpdateClientGasRequest{
+				ClientId:         path.EndpointA.ClientID,
+				ValidatorSetSize: 150,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.EstimateUpdateClientGas(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				// the testing app sets the relayer hooks, whose gas is added to the estimate of the client
+				suite.Require().Equal(ibctmtypes.UpdateBaseGas+150*ibctmtypes.UpdateGasPerValidator+relayertypes.ClientUpdateHooksGas, res.GasEstimate)
+				suite.Require().Equal(uint64(51+101), res.SignaturesVerified)
 			} else {
 				suite.Require().Error(err)
 			}
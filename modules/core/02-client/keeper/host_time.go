@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// hostTimeOracle holds the host time oracle shared by all copies of the keeper
+type hostTimeOracle struct {
+	oracle exported.HostTimeOracle
+}
+
+// SetHostTimeOracle sets the host time oracle against which packet timeouts, channel upgrade
+// timeouts and connection delay periods are evaluated, in transactions as well as in the
+// BeginBlock and EndBlock logic. The oracle is shared by all copies of the keeper. The method
+// panics if the oracle is nil.
+func (k *Keeper) SetHostTimeOracle(oracle exported.HostTimeOracle) {
+	if oracle == nil {
+		panic("host time oracle cannot be nil")
+	}
+
+	k.hostTimeOracle.oracle = oracle
+}
+
+// WithHostTimeOracle returns a context in which the light clients retrieve the host height and
+// timestamp from the host time oracle of the keeper.
+func (k Keeper) WithHostTimeOracle(ctx sdk.Context) sdk.Context {
+	return types.WithHostTimeOracle(ctx, k.hostTimeOracle.oracle)
+}
+
+// GetHostHeight returns the current height of the host chain given by the host time oracle of
+// the keeper. See types.GetHostHeight.
+func (k Keeper) GetHostHeight(ctx sdk.Context) types.Height {
+	return types.GetHostHeight(k.WithHostTimeOracle(ctx))
+}
+
+// GetHostTimestamp returns the current timestamp of the host chain in nanoseconds given by the
+// host time oracle of the keeper. See types.GetHostTimestamp.
+func (k Keeper) GetHostTimestamp(ctx sdk.Context) uint64 {
+	return types.GetHostTimestamp(k.WithHostTimeOracle(ctx))
+}
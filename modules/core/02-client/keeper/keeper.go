@@ -21,6 +21,8 @@ import (
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
+var _ types.ClientKeeper = Keeper{}
+
 // Keeper represents a type that grants read and write permissions to any client
 // state information
 type Keeper struct {
@@ -29,6 +31,17 @@ type Keeper struct {
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 	upgradeKeeper types.UpgradeKeeper
+
+	// callGasLimits is shared by all copies of the keeper, see SetClientCallGasLimits
+	callGasLimits map[string]sdk.Gas
+	// storeDecorators is shared by all copies of the keeper, see SetClientStoreDecorator
+	storeDecorators map[string]types.ClientStoreDecorator
+	// hostTimeOracle is shared by all copies of the keeper, see SetHostTimeOracle
+	hostTimeOracle *hostTimeOracle
+	// updateGasOverhead is shared by all copies of the keeper, see SetUpdateGasOverhead
+	updateGasOverhead *sdk.Gas
+	// proofSpecs is shared by all copies of the keeper, see SetSelfProofSpecs and SetAllowedHashOps
+	proofSpecs *proofSpecsConfig
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -39,11 +52,20 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 	}
 
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		stakingKeeper: sk,
-		upgradeKeeper: uk,
+		storeKey:        key,
+		cdc:             cdc,
+		paramSpace:      paramSpace,
+		stakingKeeper:   sk,
+		upgradeKeeper:   uk,
+		callGasLimits:   make(map[string]sdk.Gas),
+		storeDecorators: make(map[string]types.ClientStoreDecorator),
+		hostTimeOracle: &hostTimeOracle{
+			oracle: types.DefaultHostTimeOracle{},
+		},
+		updateGasOverhead: new(sdk.Gas),
+		proofSpecs: &proofSpecsConfig{
+			selfProofSpecs: commitmenttypes.GetSDKSpecs(),
+		},
 	}
 }
 
@@ -229,6 +251,13 @@ func (k Keeper) HasClientConsensusState(ctx sdk.Context, clientID string, height
 	return store.Has(host.ConsensusStateKey(height))
 }
 
+// GetNextClientConsensusStateHeight returns the lowest height larger than the given height at
+// which the client stores a consensus state. Only the consensus states of 07-tendermint clients
+// are indexed by height, no height is returned for other client types.
+func (k Keeper) GetNextClientConsensusStateHeight(ctx sdk.Context, clientID string, height exported.Height) (exported.Height, bool) {
+	return ibctmtypes.GetNextConsensusStateHeight(k.ClientStore(ctx, clientID), height)
+}
+
 // GetLatestClientConsensusState gets the latest ConsensusState stored for a given client
 func (k Keeper) GetLatestClientConsensusState(ctx sdk.Context, clientID string) (exported.ConsensusState, bool) {
 	clientState, ok := k.GetClientState(ctx, clientID)
@@ -297,7 +326,7 @@ func (k Keeper) ValidateSelfClient(ctx sdk.Context, clientState exported.ClientS
 			tmClient.LatestHeight, selfHeight)
 	}
 
-	expectedProofSpecs := commitmenttypes.GetSDKSpecs()
+	expectedProofSpecs := k.GetSelfProofSpecs()
 	if !reflect.DeepEqual(expectedProofSpecs, tmClient.ProofSpecs) {
 		return sdkerrors.Wrapf(types.ErrInvalidClient, "client has invalid proof specs. expected: %v got: %v",
 			expectedProofSpecs, tmClient.ProofSpecs)
@@ -382,8 +411,24 @@ func (k Keeper) GetAllClients(ctx sdk.Context) (states []exported.ClientState) {
 }
 
 // ClientStore returns isolated prefix store for each client so they can read/write in separate
-// namespace without being able to read/write other client's data
+// namespace without being able to read/write other client's data. The store is wrapped by the
+// decorator of the client type, if one is set.
 func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
 	clientPrefix := []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
-	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
+	clientStore := prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
+
+	if len(k.storeDecorators) == 0 {
+		return clientStore
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return clientStore
+	}
+
+	if decorator, ok := k.storeDecorators[clientType]; ok {
+		return decorator(clientStore)
+	}
+
+	return clientStore
 }
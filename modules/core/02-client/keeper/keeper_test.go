@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -233,6 +234,35 @@ func (suite *KeeperTestSuite) TestValidateSelfClient() {
 	}
 }
 
+// TestSetSelfProofSpecs tests that the client of the running chain is validated against the
+// configured proof specs of the running chain.
+func (suite *KeeperTestSuite) TestSetSelfProofSpecs() {
+	clientKeeper := &suite.chainA.App.GetIBCKeeper().ClientKeeper
+	testClientHeight := types.NewHeight(0, uint64(suite.chainA.GetContext().BlockHeight()-1))
+	smtSpecs := []*ics23.ProofSpec{ics23.SmtSpec, ics23.TendermintSpec}
+	sdkClient := ibctmtypes.NewClientState(suite.chainA.ChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+	smtClient := ibctmtypes.NewClientState(suite.chainA.ChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, smtSpecs, ibctesting.UpgradePath, false, false)
+
+	suite.Require().Equal(commitmenttypes.GetSDKSpecs(), clientKeeper.GetSelfProofSpecs())
+	suite.Require().NoError(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), sdkClient))
+	suite.Require().Error(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), smtClient))
+
+	clientKeeper.SetSelfProofSpecs(smtSpecs)
+	defer clientKeeper.SetSelfProofSpecs(commitmenttypes.GetSDKSpecs())
+
+	suite.Require().Equal(smtSpecs, clientKeeper.GetSelfProofSpecs())
+	suite.Require().NoError(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), smtClient))
+	suite.Require().Error(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), sdkClient))
+
+	suite.Require().Panics(func() {
+		clientKeeper.SetSelfProofSpecs(nil)
+	})
+
+	suite.Require().Panics(func() {
+		clientKeeper.SetSelfProofSpecs([]*ics23.ProofSpec{ics23.IavlSpec, nil})
+	})
+}
+
 func (suite KeeperTestSuite) TestGetAllGenesisClients() {
 	clientIDs := []string{
 		testClientID2, testClientID3, testClientID,
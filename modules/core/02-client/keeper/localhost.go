@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
+)
+
+// CreateLocalhostClient creates the localhost client under the 09-localhost client
+// identifier, at the current height of the running chain. Connections of modules of the
+// running chain to each other are opened through the standard handshake on top of this
+// client, which is updated with the latest height of the chain at the beginning of every
+// block. The localhost client type must be allowed.
+func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
+	if !k.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "client type %s is not allowed", exported.Localhost)
+	}
+
+	if _, found := k.GetClientState(ctx, exported.Localhost); found {
+		return sdkerrors.Wrap(types.ErrClientExists, exported.Localhost)
+	}
+
+	clientState := localhosttypes.NewClientState(ctx.ChainID(), types.GetSelfHeight(ctx))
+	return k.createClient(ctx, exported.Localhost, clientState, nil)
+}
@@ -13,9 +13,40 @@ func (k Keeper) GetAllowedClients(ctx sdk.Context) []string {
 	return res
 }
 
+// GetBatchedUpdateClients retrieves the identifiers of the clients with batched updates from the paramstore
+func (k Keeper) GetBatchedUpdateClients(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyBatchedUpdateClients, &res)
+	return res
+}
+
+// GetMonotonicHeightClients retrieves the client types with monotonic heights from the paramstore
+func (k Keeper) GetMonotonicHeightClients(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyMonotonicHeightClients, &res)
+	return res
+}
+
+// GetConsensusStatePruningGasLimit retrieves the gas budget of the consensus state pruning from the paramstore
+func (k Keeper) GetConsensusStatePruningGasLimit(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyConsensusStatePruningGasLimit, &res)
+	return res
+}
+
+// IsMonotonicHeightClient returns true if the heights of the given client type are a single
+// monotonic counter, as defined by the monotonic height clients parameter.
+func (k Keeper) IsMonotonicHeightClient(ctx sdk.Context, clientType string) bool {
+	return k.GetParams(ctx).IsMonotonicHeightClient(clientType)
+}
+
 // GetParams returns the total set of ibc-client parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetAllowedClients(ctx)...)
+	params := types.NewParams(k.GetAllowedClients(ctx)...)
+	params.BatchedUpdateClients = k.GetBatchedUpdateClients(ctx)
+	params.MonotonicHeightClients = k.GetMonotonicHeightClients(ctx)
+	params.ConsensusStatePruningGasLimit = k.GetConsensusStatePruningGasLimit(ctx)
+	return params
 }
 
 // SetParams sets the total set of ibc-client parameters.
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// proofSpecsConfig holds the proof specs of the running chain and the hash operations allowed
+// in the proof specs of the light clients.
+type proofSpecsConfig struct {
+	selfProofSpecs []*ics23.ProofSpec
+	hashOps        []ics23.HashOp
+}
+
+// proofSpecsClientState is implemented by the client states verifying ICS-23 proofs, e.g. the
+// tendermint client state.
+type proofSpecsClientState interface {
+	GetProofSpecs() []*ics23.ProofSpec
+}
+
+// SetSelfProofSpecs sets the proof specs of the stores of the running chain, which the clients
+// of the running chain stored on counterparty chains must use. They default to the proof specs
+// of the SDK IAVL store and must be set by chains using other stores, e.g. sparse merkle trees.
+// The proof specs are shared by all copies of the keeper. The method panics if the proof specs
+// are invalid.
+func (k *Keeper) SetSelfProofSpecs(specs []*ics23.ProofSpec) {
+	if err := commitmenttypes.ValidateProofSpecs(specs); err != nil {
+		panic(fmt.Sprintf("invalid self proof specs: %s", err))
+	}
+
+	k.proofSpecs.selfProofSpecs = specs
+}
+
+// GetSelfProofSpecs returns the proof specs of the stores of the running chain.
+func (k Keeper) GetSelfProofSpecs() []*ics23.ProofSpec {
+	return k.proofSpecs.selfProofSpecs
+}
+
+// SetAllowedHashOps restricts the hash operations which the leaf, prehash and inner hash
+// operations of the proof specs of the created and upgraded light clients may use. All the hash
+// operations implemented by ICS-23 are allowed by default. The hash operations are shared by
+// all copies of the keeper. The method panics if a hash operation is not implemented by ICS-23.
+func (k *Keeper) SetAllowedHashOps(hashOps ...ics23.HashOp) {
+	if err := commitmenttypes.ValidateHashOps(hashOps); err != nil {
+		panic(fmt.Sprintf("invalid allowed hash operations: %s", err))
+	}
+
+	k.proofSpecs.hashOps = hashOps
+}
+
+// GetAllowedHashOps returns the hash operations allowed in the proof specs of the light clients,
+// or nil if all the hash operations implemented by ICS-23 are allowed.
+func (k Keeper) GetAllowedHashOps() []ics23.HashOp {
+	return k.proofSpecs.hashOps
+}
+
+// validateProofSpecsHashOps validates that the proof specs of the given client state only use
+// the allowed hash operations. Client states without proof specs are not restricted.
+func (k Keeper) validateProofSpecsHashOps(clientState exported.ClientState) error {
+	specsClient, ok := clientState.(proofSpecsClientState)
+	if !ok || k.proofSpecs.hashOps == nil {
+		return nil
+	}
+
+	if err := commitmenttypes.ValidateProofSpecsHashOps(specsClient.GetProofSpecs(), k.proofSpecs.hashOps); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidClient, err.Error())
+	}
+
+	return nil
+}
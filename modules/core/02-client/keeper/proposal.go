@@ -7,53 +7,15 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
-	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-// ClientUpdateProposal will retrieve the subject and substitute client.
-// A callback will occur to the subject client state with the client
-// prefixed store being provided for both the subject and the substitute client.
-// The localhost client is not allowed to be modified with a proposal. The IBC
-// client implementations are responsible for validating the parameters of the
-// subtitute (enusring they match the subject's parameters) as well as copying
-// the necessary consensus states from the subtitute to the subject client
-// store. The substitute must be Active and the subject must not be Active.
+// ClientUpdateProposal updates the subject client of the proposal with its substitute
+// client. See RecoverClient.
 func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
-	if p.SubjectClientId == exported.Localhost || p.SubstituteClientId == exported.Localhost {
-		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update localhost client with proposal")
-	}
-
-	subjectClientState, found := k.GetClientState(ctx, p.SubjectClientId)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", p.SubjectClientId)
-	}
-
-	subjectClientStore := k.ClientStore(ctx, p.SubjectClientId)
-
-	if status := subjectClientState.Status(ctx, subjectClientStore, k.cdc); status == exported.Active {
-		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update Active subject client")
-	}
-
-	substituteClientState, found := k.GetClientState(ctx, p.SubstituteClientId)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", p.SubstituteClientId)
-	}
-
-	if subjectClientState.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
-		return sdkerrors.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
-	}
-
-	substituteClientStore := k.ClientStore(ctx, p.SubstituteClientId)
-
-	if status := substituteClientState.Status(ctx, substituteClientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
-	}
-
-	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState)
+	clientState, err := k.recoverClient(ctx, p.SubjectClientId, p.SubstituteClientId, false)
 	if err != nil {
 		return err
 	}
-	k.SetClientState(ctx, p.SubjectClientId, clientState)
 
 	k.Logger(ctx).Info("client updated after governance proposal passed", "client-id", p.SubjectClientId, "height", clientState.GetLatestHeight().String())
 
@@ -75,6 +37,11 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 	return nil
 }
 
+// ClientArchiveProposal archives the client of the proposal. See ArchiveClient.
+func (k Keeper) ClientArchiveProposal(ctx sdk.Context, p *types.ClientArchiveProposal) error {
+	return k.ArchiveClient(ctx, p.ClientId)
+}
+
 // HandleUpgradeProposal sets the upgraded client state in the upgrade store. It clears
 // an IBC client state and consensus state if a previous plan was set. Then  it
 // will schedule an upgrade and finally set the upgraded client state in upgrade
@@ -85,18 +52,5 @@ func (k Keeper) HandleUpgradeProposal(ctx sdk.Context, p *types.UpgradeProposal)
 		return sdkerrors.Wrap(err, "could not unpack UpgradedClientState")
 	}
 
-	// zero out any custom fields before setting
-	cs := clientState.ZeroCustomFields()
-	bz, err := types.MarshalClientState(k.cdc, cs)
-	if err != nil {
-		return sdkerrors.Wrap(err, "could not marshal UpgradedClientState")
-	}
-
-	if err := k.upgradeKeeper.ScheduleUpgrade(ctx, p.Plan); err != nil {
-		return err
-	}
-
-	// sets the new upgraded client in last height committed on this chain is at plan.Height,
-	// since the chain will panic at plan.Height and new chain will resume at plan.Height
-	return k.upgradeKeeper.SetUpgradedClient(ctx, p.Plan.Height, bz)
+	return k.ScheduleIBCSoftwareUpgrade(ctx, p.Plan, clientState)
 }
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+// PruneExpiredConsensusStates prunes the expired consensus states of the active 07-tendermint
+// clients within the gas budget defined by the consensus state pruning gas limit parameter. It
+// is called at the beginning of every block. The clients are visited in the order of their
+// sequence, starting with the client at which the pruning stopped in the previous block, and
+// their expired consensus states are pruned in batches of ConsensusStatePruningBatchSize. A
+// batch which does not fit into the remaining gas budget is discarded and retried in the next
+// block. The gas consumed by the pruning itself is not charged to the gas meter of the context.
+func (k Keeper) PruneExpiredConsensusStates(ctx sdk.Context) {
+	gasLimit := k.GetConsensusStatePruningGasLimit(ctx)
+	if gasLimit == 0 {
+		return
+	}
+
+	nextSequence := k.GetNextClientSequence(ctx)
+	if nextSequence == 0 {
+		return
+	}
+
+	pruneCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	startSequence := k.getConsensusStatePruningSequence(ctx) % nextSequence
+	sequence := startSequence
+
+	// every client is visited at most once per block
+	for i := uint64(0); i < nextSequence; i++ {
+		clientID := types.FormatClientIdentifier(exported.Tendermint, sequence)
+		if !k.pruneClientConsensusStates(pruneCtx, clientID) {
+			break
+		}
+
+		sequence = (sequence + 1) % nextSequence
+	}
+
+	if sequence != startSequence {
+		k.setConsensusStatePruningSequence(ctx, sequence)
+	}
+}
+
+// pruneClientConsensusStates prunes the expired consensus states of the given client in batches
+// and returns false if the gas budget of the context is exhausted before all of them are pruned.
+func (k Keeper) pruneClientConsensusStates(ctx sdk.Context, clientID string) bool {
+	var pruned int
+	defer func() {
+		if pruned == 0 {
+			return
+		}
+
+		k.Logger(ctx).Debug("pruned expired consensus states", "client-id", clientID, "count", pruned)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "client", "pruned_consensus_states"},
+			float32(pruned),
+			[]metrics.Label{
+				telemetry.NewLabel(types.LabelClientType, exported.Tendermint),
+				telemetry.NewLabel(types.LabelClientID, clientID),
+			},
+		)
+	}()
+
+	for {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		count, ok := k.pruneConsensusStateBatch(cacheCtx, clientID)
+		if !ok {
+			return false
+		}
+
+		writeCache()
+		pruned += count
+
+		if count < types.ConsensusStatePruningBatchSize {
+			return true
+		}
+	}
+}
+
+// pruneConsensusStateBatch prunes a batch of expired consensus states of the given client if it
+// is an active 07-tendermint client. It returns the number of consensus states pruned and false
+// if the gas budget of the context is exhausted, in which case the batch must be discarded.
+func (k Keeper) pruneConsensusStateBatch(ctx sdk.Context, clientID string) (count int, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+
+			count, ok = 0, false
+		}
+	}()
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return 0, true
+	}
+
+	tmClientState, isTendermint := clientState.(*ibctmtypes.ClientState)
+	if !isTendermint {
+		return 0, true
+	}
+
+	// archived clients keep their consensus states
+	if status := k.GetClientStatus(ctx, tmClientState, clientID); status != exported.Active {
+		return 0, true
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	count, err := ibctmtypes.PruneExpiredConsensusStates(ctx, clientStore, k.cdc, tmClientState, types.ConsensusStatePruningBatchSize)
+	if err != nil {
+		k.Logger(ctx).Error("failed to prune expired consensus states", "client-id", clientID, "error", err)
+		return 0, true
+	}
+
+	return count, true
+}
+
+// getConsensusStatePruningSequence returns the sequence of the client whose expired consensus
+// states are pruned next.
+func (k Keeper) getConsensusStatePruningSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyConsensusStatePruningSequence))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setConsensusStatePruningSequence stores the sequence of the client whose expired consensus
+// states are pruned next.
+func (k Keeper) setConsensusStatePruningSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyConsensusStatePruningSequence), sdk.Uint64ToBigEndian(sequence))
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStates() {
+	var (
+		paths          []*ibctesting.Path
+		expiredHeights [][]exported.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		gasLimit uint64
+		expPrune bool
+	}{
+		{"pruning disabled", 0, false},
+		{"gas budget exhausted by the first batch", 1, false},
+		{"expired consensus states of all clients pruned", 10_000_000, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			paths = []*ibctesting.Path{ibctesting.NewPath(suite.chainA, suite.chainB), ibctesting.NewPath(suite.chainA, suite.chainB)}
+			expiredHeights = make([][]exported.Height, len(paths))
+			for i, path := range paths {
+				suite.coordinator.SetupClients(path)
+				expiredHeights[i] = append(expiredHeights[i], path.EndpointA.GetClientState().GetLatestHeight())
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				expiredHeights[i] = append(expiredHeights[i], path.EndpointA.GetClientState().GetLatestHeight())
+			}
+
+			suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+			for _, path := range paths {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			}
+			suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			ctx := suite.chainA.GetContext()
+
+			params := clientKeeper.GetParams(ctx)
+			params.ConsensusStatePruningGasLimit = tc.gasLimit
+			clientKeeper.SetParams(ctx, params)
+
+			clientKeeper.PruneExpiredConsensusStates(ctx)
+
+			for i, path := range paths {
+				clientStore := clientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+				for _, height := range expiredHeights[i] {
+					suite.Require().Equal(!tc.expPrune, clientKeeper.HasClientConsensusState(ctx, path.EndpointA.ClientID, height))
+					suite.Require().Equal(!tc.expPrune, ibctmtypes.GetIterationKey(clientStore, height) != nil)
+				}
+
+				// the latest consensus state never expires for an active client
+				suite.Require().True(clientKeeper.HasClientConsensusState(ctx, path.EndpointA.ClientID, path.EndpointA.GetClientState().GetLatestHeight()))
+				suite.Require().Equal(exported.Active, path.EndpointA.GetClientState().Status(ctx, clientStore, suite.chainA.Codec))
+			}
+		})
+	}
+}
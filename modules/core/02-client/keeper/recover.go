@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// RecoverClient reactivates the frozen or expired subject client with the state of the
+// Active substitute client. The IBC client implementations are responsible for validating
+// that the substitute matches all the immutable parameters of the subject, and thus tracks
+// the same chain, as well as copying the necessary consensus states from the substitute to
+// the subject client store. Unlike the client update proposal, the substitute must also track
+// the chain ID of the subject, for the client states exposing it. The localhost client cannot
+// be recovered.
+func (k Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	clientState, err := k.recoverClient(ctx, subjectClientID, substituteClientID, true)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID, "substitute-id", substituteClientID, "height", clientState.GetLatestHeight().String())
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "client", "update"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.LabelClientType, clientState.ClientType()),
+				telemetry.NewLabel(types.LabelClientID, subjectClientID),
+				telemetry.NewLabel(types.LabelUpdateType, "recovery"),
+			},
+		)
+	}()
+
+	EmitRecoverClientEvent(ctx, subjectClientID, substituteClientID, clientState)
+
+	return nil
+}
+
+// recoverClient performs the checks of a client recovery and updates the subject client
+// with the substitute. The subject must not be Active or Archived and the substitute must
+// be Active at a greater height than the subject. If matchChainID is true, the substitute must
+// track the same chain ID as the subject.
+func (k Keeper) recoverClient(ctx sdk.Context, subjectClientID, substituteClientID string, matchChainID bool) (exported.ClientState, error) {
+	if subjectClientID == exported.Localhost || substituteClientID == exported.Localhost {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRecoverClient, "cannot recover localhost client")
+	}
+
+	subjectClientState, found := k.GetClientState(ctx, subjectClientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", subjectClientID)
+	}
+
+	subjectClientStore := k.ClientStore(ctx, subjectClientID)
+
+	switch status := k.GetClientStatus(ctx, subjectClientState, subjectClientID); status {
+	case exported.Active:
+		return nil, sdkerrors.Wrap(types.ErrInvalidRecoverClient, "cannot recover Active subject client")
+	case exported.Archived:
+		return nil, sdkerrors.Wrap(types.ErrInvalidRecoverClient, "cannot recover Archived subject client")
+	}
+
+	substituteClientState, found := k.GetClientState(ctx, substituteClientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", substituteClientID)
+	}
+
+	if subjectClientState.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
+	}
+
+	if matchChainID {
+		subjectChainID, substituteChainID := getChainID(subjectClientState), getChainID(substituteClientState)
+		if subjectChainID != substituteChainID {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRecoverClient, "substitute client chain ID %s does not match subject client chain ID %s", substituteChainID, subjectChainID)
+		}
+	}
+
+	substituteClientStore := k.ClientStore(ctx, substituteClientID)
+
+	if status := k.GetClientStatus(ctx, substituteClientState, substituteClientID); status != exported.Active {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
+	}
+
+	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState)
+	if err != nil {
+		return nil, err
+	}
+	k.SetClientState(ctx, subjectClientID, clientState)
+
+	return clientState, nil
+}
+
+// getChainID returns the chain ID tracked by the client state, or an empty string if the client
+// state does not expose one.
+func getChainID(clientState exported.ClientState) string {
+	if cs, ok := clientState.(interface{ GetChainID() string }); ok {
+		return cs.GetChainID()
+	}
+
+	return ""
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestRecoverClient() {
+	var (
+		subject, substitute                       string
+		subjectClientState, substituteClientState exported.ClientState
+		substitutePath                            *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success, frozen subject", func() {}, true,
+		},
+		{
+			"success, expired subject", func() {
+				tmClientState, ok := subjectClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = types.ZeroHeight()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+
+				// expire the subject while keeping the substitute active
+				suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod / 2)
+				suite.Require().NoError(substitutePath.EndpointA.UpdateClient())
+				suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod/2 + time.Second)
+				substituteClientState = suite.chainA.GetClientState(substitute)
+			}, true,
+		},
+		{
+			"cannot use localhost as subject", func() {
+				subject = exported.Localhost
+			}, false,
+		},
+		{
+			"subject client does not exist", func() {
+				subject = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"substitute client does not exist", func() {
+				substitute = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"subject is Active", func() {
+				tmClientState, ok := subjectClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = types.ZeroHeight()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+			}, false,
+		},
+		{
+			"subject is Archived", func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientArchived(suite.chainA.GetContext(), subject)
+			}, false,
+		},
+		{
+			"substitute is frozen", func() {
+				tmClientState, ok := substituteClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = types.NewHeight(0, 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+			}, false,
+		},
+		{
+			"substitute tracks a different chain ID", func() {
+				tmClientState, ok := substituteClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.ChainId = "different-chain"
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+			}, false,
+		},
+		{
+			"substitute does not match the immutable parameters of the subject", func() {
+				tmClientState, ok := substituteClientState.(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.UnbondingPeriod = tmClientState.UnbondingPeriod + 1
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+			subject = subjectPath.EndpointA.ClientID
+			subjectClientState = suite.chainA.GetClientState(subject)
+
+			substitutePath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			substitute = substitutePath.EndpointA.ClientID
+
+			// update substitute twice
+			substitutePath.EndpointA.UpdateClient()
+			substitutePath.EndpointA.UpdateClient()
+			substituteClientState = suite.chainA.GetClientState(substitute)
+
+			tmClientState, ok := subjectClientState.(*ibctmtypes.ClientState)
+			suite.Require().True(ok)
+			tmClientState.AllowUpdateAfterMisbehaviour = true
+			tmClientState.AllowUpdateAfterExpiry = true
+			tmClientState.FrozenHeight = tmClientState.LatestHeight
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+
+			tmClientState, ok = substituteClientState.(*ibctmtypes.ClientState)
+			suite.Require().True(ok)
+			tmClientState.AllowUpdateAfterMisbehaviour = true
+			tmClientState.AllowUpdateAfterExpiry = true
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClient(ctx, subject, substitute)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientState := suite.chainA.GetClientState(subject)
+				suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientState, subject))
+				suite.Require().Equal(substituteClientState.GetLatestHeight(), clientState.GetLatestHeight())
+
+				var found bool
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeRecoverClient {
+						found = true
+					}
+				}
+				suite.Require().True(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ReserveClientIdentifiers reserves the given number of client identifier sequences for an
+// upcoming upgrade and returns them. The reserved sequences are skipped by the generation
+// of client identifiers, so the clients created by the upgrade handler with
+// CreateClientWithReservedSequence do not collide with clients created by users in the
+// meantime. It should be called ahead of the upgrade height, for instance in the upgrade
+// handler of a previous upgrade.
+func (k Keeper) ReserveClientIdentifiers(ctx sdk.Context, count uint64) []uint64 {
+	sequences := make([]uint64, count)
+	for i := range sequences {
+		sequence := k.GetNextClientSequence(ctx)
+		k.SetNextClientSequence(ctx, sequence+1)
+
+		k.SetReservedClientSequence(ctx, sequence)
+		sequences[i] = sequence
+	}
+
+	return sequences
+}
+
+// CreateClientWithReservedSequence creates a new client state under the client identifier
+// formatted from its client type and the given reserved sequence, and populates it with the
+// given consensus state. The reservation of the sequence is released.
+func (k Keeper) CreateClientWithReservedSequence(
+	ctx sdk.Context, sequence uint64, clientState exported.ClientState, consensusState exported.ConsensusState,
+) (string, error) {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedClient(clientState.ClientType()) {
+		return "", sdkerrors.Wrapf(
+			types.ErrInvalidClientType,
+			"client state type %s is not registered in the allowlist", clientState.ClientType(),
+		)
+	}
+
+	// the localhost client is only stored under its sentinel client identifier
+	if clientState.ClientType() == exported.Localhost {
+		return "", sdkerrors.Wrap(types.ErrInvalidClientType, "the localhost client can only be created with CreateLocalhostClient")
+	}
+
+	if !k.IsReservedClientSequence(ctx, sequence) {
+		return "", sdkerrors.Wrapf(types.ErrClientSequenceNotReserved, "sequence %d", sequence)
+	}
+
+	k.deleteReservedClientSequence(ctx, sequence)
+
+	clientID := types.FormatClientIdentifier(clientState.ClientType(), sequence)
+	if err := k.createClient(ctx, clientID, clientState, consensusState); err != nil {
+		return "", err
+	}
+
+	return clientID, nil
+}
+
+// SetReservedClientSequence marks the given client identifier sequence as reserved.
+func (k Keeper) SetReservedClientSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ReservedClientSequenceKey(sequence), []byte{byte(1)})
+}
+
+// IsReservedClientSequence returns true if the given client identifier sequence is reserved.
+func (k Keeper) IsReservedClientSequence(ctx sdk.Context, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ReservedClientSequenceKey(sequence))
+}
+
+// deleteReservedClientSequence releases the reservation of the given client identifier sequence.
+func (k Keeper) deleteReservedClientSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ReservedClientSequenceKey(sequence))
+}
+
+// GetReservedClientSequences returns all the reserved client identifier sequences in
+// ascending order.
+func (k Keeper) GetReservedClientSequences(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyReservedClientSequences+"/"))
+	defer iterator.Close()
+
+	var sequences []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		// key is reservedClientSequences/{sequence}
+		sequence, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), host.KeyReservedClientSequences+"/"), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	// keys are ordered lexicographically
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+	return sequences
+}
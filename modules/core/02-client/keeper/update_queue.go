@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// IsBatchedUpdateClient returns true if the updates of the given client are queued and
+// applied at the end of the block, as defined by the batched update clients parameter.
+func (k Keeper) IsBatchedUpdateClient(ctx sdk.Context, clientID string) bool {
+	return k.GetParams(ctx).IsBatchedUpdateClient(clientID)
+}
+
+// QueueClientUpdate queues the provided header submitted by the signer to be applied to the
+// given client at the end of the block. Only the checks of the header which do not require
+// verifying its signatures are performed on submission, by client states implementing the
+// HeaderPrechecker interface, so that invalid headers never take up a slot of the queue. At
+// most MaxQueuedClientUpdates headers may be queued for a client within a block, of which at
+// most MaxQueuedClientUpdatesPerSigner by the same signer.
+func (k Keeper) QueueClientUpdate(ctx sdk.Context, clientID string, header exported.Header, signer string) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
+	}
+
+	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
+	}
+
+	if prechecker, ok := clientState.(exported.HeaderPrechecker); ok {
+		if err := k.callClient(ctx, clientState.ClientType(), func(callCtx sdk.Context) error {
+			return prechecker.PrecheckHeader(callCtx, k.cdc, k.ClientStore(callCtx, clientID), header)
+		}); err != nil {
+			return sdkerrors.Wrapf(err, "cannot queue update of client with ID %s", clientID)
+		}
+	}
+
+	queue := k.GetQueuedClientUpdates(ctx, clientID)
+	if len(queue.Updates) >= types.MaxQueuedClientUpdates {
+		return sdkerrors.Wrapf(types.ErrClientUpdateQueueFull, "%d updates are already queued for client %s", len(queue.Updates), clientID)
+	}
+
+	var signerUpdates int
+	for _, update := range queue.Updates {
+		if update.Signer == signer {
+			signerUpdates++
+		}
+	}
+
+	if signerUpdates >= types.MaxQueuedClientUpdatesPerSigner {
+		return sdkerrors.Wrapf(types.ErrClientUpdateQueueFull, "%d updates are already queued by %s for client %s", signerUpdates, signer, clientID)
+	}
+
+	update, err := types.NewQueuedClientUpdate(header, signer)
+	if err != nil {
+		return err
+	}
+
+	queue.Updates = append(queue.Updates, update)
+	k.setQueuedClientUpdates(ctx, clientID, queue)
+
+	EmitQueueClientUpdateEvent(ctx, clientID, clientState, header)
+	return nil
+}
+
+// GetQueuedClientUpdates returns the updates queued for the given client during the current
+// block, in the order in which they were queued.
+func (k Keeper) GetQueuedClientUpdates(ctx sdk.Context, clientID string) types.QueuedClientUpdates {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.QueuedClientUpdatesKey(clientID))
+	if bz == nil {
+		return types.QueuedClientUpdates{}
+	}
+
+	var queue types.QueuedClientUpdates
+	k.cdc.MustUnmarshal(bz, &queue)
+	return queue
+}
+
+// setQueuedClientUpdates stores the updates queued for the given client.
+func (k Keeper) setQueuedClientUpdates(ctx sdk.Context, clientID string, queue types.QueuedClientUpdates) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&queue)
+	store.Set(host.QueuedClientUpdatesKey(clientID), bz)
+}
+
+// DeleteQueuedClientUpdates removes the updates queued for the given client.
+func (k Keeper) DeleteQueuedClientUpdates(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.QueuedClientUpdatesKey(clientID))
+}
+
+// GetQueuedUpdateClientIDs returns the identifiers of the clients with updates queued during
+// the current block, in ascending order.
+func (k Keeper) GetQueuedUpdateClientIDs(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyQueuedClientUpdates+"/"))
+	defer iterator.Close()
+
+	var clientIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		// key is queuedClientUpdates/{clientID}
+		clientIDs = append(clientIDs, strings.TrimPrefix(string(iterator.Key()), host.KeyQueuedClientUpdates+"/"))
+	}
+
+	return clientIDs
+}
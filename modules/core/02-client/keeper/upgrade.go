@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ScheduleIBCSoftwareUpgrade schedules the upgrade plan and sets the upgraded client state,
+// with its custom fields zeroed, in the upgrade store at the plan height. Counterparty
+// chains upgrade their clients of this chain with the upgraded client state once the
+// upgrade height has been committed. A previously scheduled plan, and its upgraded client
+// state, is replaced.
+func (k Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.Plan, upgradedClientState exported.ClientState) error {
+	// zero out any custom fields before setting
+	cs := upgradedClientState.ZeroCustomFields()
+	bz, err := types.MarshalClientState(k.cdc, cs)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not marshal UpgradedClientState")
+	}
+
+	if err := k.upgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+		return err
+	}
+
+	// sets the new upgraded client in last height committed on this chain is at plan.Height,
+	// since the chain will panic at plan.Height and new chain will resume at plan.Height
+	if err := k.upgradeKeeper.SetUpgradedClient(ctx, plan.Height, bz); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("ibc software upgrade scheduled", "name", plan.Name, "height", plan.Height)
+
+	EmitScheduleIBCSoftwareUpgradeEvent(ctx, plan.Name, plan.Height)
+
+	return nil
+}
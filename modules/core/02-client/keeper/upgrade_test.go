@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestScheduleIBCSoftwareUpgrade() {
+	var (
+		upgradedClientState *ibctmtypes.ClientState
+		plan                upgradetypes.Plan
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with previous plan replaced", func() {
+				oldPlan := upgradetypes.Plan{
+					Name:   "upgrade IBC clients",
+					Height: 100,
+				}
+				suite.Require().NoError(suite.chainA.GetSimApp().UpgradeKeeper.ScheduleUpgrade(suite.chainA.GetContext(), oldPlan))
+
+				bz, err := types.MarshalClientState(suite.chainA.App.AppCodec(), upgradedClientState)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.chainA.GetSimApp().UpgradeKeeper.SetUpgradedClient(suite.chainA.GetContext(), oldPlan.Height, bz))
+			}, true,
+		},
+		{
+			"plan height is in the past", func() {
+				plan.Height = suite.chainA.GetContext().BlockHeight() - 1
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			upgradedClientState = suite.chainA.GetClientState(path.EndpointA.ClientID).(*ibctmtypes.ClientState)
+
+			plan = upgradetypes.Plan{
+				Name:   "upgrade IBC clients",
+				Height: 1000,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ScheduleIBCSoftwareUpgrade(ctx, plan, upgradedClientState)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				storedPlan, found := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradePlan(ctx)
+				suite.Require().True(found)
+				suite.Require().Equal(plan, storedPlan)
+
+				// the upgraded client state of the previous plan is cleared
+				_, found = suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradedClient(ctx, 100)
+				suite.Require().False(found)
+
+				// the upgraded client state is stored with its custom fields zeroed
+				bz, found := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradedClient(ctx, plan.Height)
+				suite.Require().True(found)
+				clientState, err := types.UnmarshalClientState(suite.chainA.App.AppCodec(), bz)
+				suite.Require().NoError(err)
+				suite.Require().Equal(upgradedClientState.ZeroCustomFields(), clientState)
+
+				events := ctx.EventManager().Events()
+				suite.Require().Equal(types.EventTypeScheduleIBCSoftwareUpgrade, events[len(events)-1].Type)
+			} else {
+				suite.Require().Error(err)
+
+				_, found := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradePlan(ctx)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
@@ -17,8 +17,23 @@ import (
 var (
 	_ codectypes.UnpackInterfacesMessage = IdentifiedClientState{}
 	_ codectypes.UnpackInterfacesMessage = ConsensusStateWithHeight{}
+	_ codectypes.UnpackInterfacesMessage = QueuedClientUpdate{}
+	_ codectypes.UnpackInterfacesMessage = QueuedClientUpdates{}
 )
 
+// MaxQueuedClientUpdates is the maximum number of updates which may be queued for a client
+// with batched updates within a single block.
+const MaxQueuedClientUpdates = 10
+
+// MaxQueuedClientUpdatesPerSigner is the maximum number of updates which may be queued by a
+// single signer for a client with batched updates within a single block.
+const MaxQueuedClientUpdatesPerSigner = 2
+
+// ConsensusStatePruningBatchSize is the maximum number of expired consensus states of a client
+// pruned atomically at the beginning of a block. The consensus states of a batch are only
+// pruned if the whole batch fits into the consensus state pruning gas budget.
+const ConsensusStatePruningBatchSize = 10
+
 // NewIdentifiedClientState creates a new IdentifiedClientState instance
 func NewIdentifiedClientState(clientID string, clientState exported.ClientState) IdentifiedClientState {
 	msg, ok := clientState.(proto.Message)
@@ -109,3 +124,31 @@ func ValidateClientType(clientType string) error {
 
 	return nil
 }
+
+// NewQueuedClientUpdate creates a new QueuedClientUpdate instance
+func NewQueuedClientUpdate(header exported.Header, signer string) (QueuedClientUpdate, error) {
+	anyHeader, err := PackHeader(header)
+	if err != nil {
+		return QueuedClientUpdate{}, err
+	}
+
+	return QueuedClientUpdate{
+		Header: anyHeader,
+		Signer: signer,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qcu QueuedClientUpdate) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qcu.Header, new(exported.Header))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qcu QueuedClientUpdates) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, update := range qcu.Updates {
+		if err := update.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -242,6 +242,52 @@ func (m *ClientUpdateProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientUpdateProposal proto.InternalMessageInfo
 
+// ClientArchiveProposal is a governance proposal archiving a client. An
+// archived client can no longer be updated, upgraded or used to open new
+// connections, but its consensus states remain stored and queryable and are
+// excluded from the pruning of expired consensus states.
+type ClientArchiveProposal struct {
+	// the title of the archive proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the client identifier for the client to be archived if the proposal passes
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+}
+
+func (m *ClientArchiveProposal) Reset()         { *m = ClientArchiveProposal{} }
+func (m *ClientArchiveProposal) String() string { return proto.CompactTextString(m) }
+func (*ClientArchiveProposal) ProtoMessage()    {}
+func (*ClientArchiveProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{4}
+}
+func (m *ClientArchiveProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientArchiveProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientArchiveProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientArchiveProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientArchiveProposal.Merge(m, src)
+}
+func (m *ClientArchiveProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientArchiveProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientArchiveProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientArchiveProposal proto.InternalMessageInfo
+
 // UpgradeProposal is a gov Content type for initiating an IBC breaking
 // upgrade.
 type UpgradeProposal struct {
@@ -260,7 +306,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{5}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +355,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{6}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,13 +388,25 @@ var xxx_messageInfo_Height proto.InternalMessageInfo
 type Params struct {
 	// allowed_clients defines the list of allowed client state types.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty" yaml:"allowed_clients"`
+	// batched_update_clients defines the list of client identifiers whose updates are
+	// queued during the block and applied once per client at the end of the block.
+	BatchedUpdateClients []string `protobuf:"bytes,2,rep,name=batched_update_clients,json=batchedUpdateClients,proto3" json:"batched_update_clients,omitempty" yaml:"batched_update_clients"`
+	// monotonic_height_clients defines the list of client state types whose heights are a
+	// single monotonic counter rather than revision-formatted. The revision numbers are
+	// ignored when evaluating the timeout heights of packets sent over their channels.
+	MonotonicHeightClients []string `protobuf:"bytes,3,rep,name=monotonic_height_clients,json=monotonicHeightClients,proto3" json:"monotonic_height_clients,omitempty" yaml:"monotonic_height_clients"`
+	// consensus_state_pruning_gas_limit defines the gas budget of the pruning of the
+	// expired consensus states of 07-tendermint clients at the beginning of every
+	// block. A value of 0 disables the pruning, expired consensus states are then
+	// only pruned one at a time on client updates.
+	ConsensusStatePruningGasLimit uint64 `protobuf:"varint,4,opt,name=consensus_state_pruning_gas_limit,json=consensusStatePruningGasLimit,proto3" json:"consensus_state_pruning_gas_limit,omitempty" yaml:"consensus_state_pruning_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,65 +442,203 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetBatchedUpdateClients() []string {
+	if m != nil {
+		return m.BatchedUpdateClients
+	}
+	return nil
+}
+
+func (m *Params) GetMonotonicHeightClients() []string {
+	if m != nil {
+		return m.MonotonicHeightClients
+	}
+	return nil
+}
+
+func (m *Params) GetConsensusStatePruningGasLimit() uint64 {
+	if m != nil {
+		return m.ConsensusStatePruningGasLimit
+	}
+	return 0
+}
+
+// QueuedClientUpdate defines a header submitted in a MsgUpdateClient of a client
+// with batched updates, which is applied at the end of the block.
+type QueuedClientUpdate struct {
+	// header to update the light client
+	Header *types.Any `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// signer address of the MsgUpdateClient
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *QueuedClientUpdate) Reset()         { *m = QueuedClientUpdate{} }
+func (m *QueuedClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*QueuedClientUpdate) ProtoMessage()    {}
+func (*QueuedClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{8}
+}
+func (m *QueuedClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedClientUpdate.Merge(m, src)
+}
+func (m *QueuedClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedClientUpdate proto.InternalMessageInfo
+
+func (m *QueuedClientUpdate) GetHeader() *types.Any {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *QueuedClientUpdate) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// QueuedClientUpdates defines the client updates queued for a client during the
+// current block.
+type QueuedClientUpdates struct {
+	Updates []QueuedClientUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *QueuedClientUpdates) Reset()         { *m = QueuedClientUpdates{} }
+func (m *QueuedClientUpdates) String() string { return proto.CompactTextString(m) }
+func (*QueuedClientUpdates) ProtoMessage()    {}
+func (*QueuedClientUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{9}
+}
+func (m *QueuedClientUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedClientUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedClientUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedClientUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedClientUpdates.Merge(m, src)
+}
+func (m *QueuedClientUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedClientUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedClientUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedClientUpdates proto.InternalMessageInfo
+
+func (m *QueuedClientUpdates) GetUpdates() []QueuedClientUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
+	proto.RegisterType((*ClientArchiveProposal)(nil), "ibc.core.client.v1.ClientArchiveProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*QueuedClientUpdate)(nil), "ibc.core.client.v1.QueuedClientUpdate")
+	proto.RegisterType((*QueuedClientUpdates)(nil), "ibc.core.client.v1.QueuedClientUpdates")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xa2, 0xe6, 0x52, 0x35, 0xfd, 0xb9, 0x29, 0x0d, 0xa1, 0xca, 0x45, 0x27,
-	0x86, 0x0c, 0xd4, 0x26, 0xa9, 0x84, 0xaa, 0x6c, 0x24, 0x4b, 0x3b, 0x80, 0x82, 0x51, 0x85, 0x60,
-	0x89, 0xfc, 0xe7, 0xea, 0x5c, 0xe5, 0xf8, 0x22, 0xdf, 0x39, 0x90, 0x6f, 0xc0, 0xc8, 0xc8, 0xc0,
-	0xd0, 0x6f, 0xc0, 0x97, 0x60, 0xe8, 0xd8, 0x91, 0xc9, 0x42, 0xed, 0xc2, 0x4a, 0x56, 0x16, 0x94,
-	0xbb, 0x73, 0x1b, 0xf7, 0x0f, 0x42, 0xb0, 0xdd, 0x3d, 0xf7, 0xdc, 0x73, 0xcf, 0xf3, 0xda, 0xef,
-	0x0b, 0x20, 0x71, 0x5c, 0xd3, 0xa5, 0x11, 0x36, 0xdd, 0x80, 0xe0, 0x90, 0x9b, 0x93, 0x96, 0x5a,
-	0x19, 0xe3, 0x88, 0x72, 0xaa, 0xeb, 0xc4, 0x71, 0x8d, 0x39, 0xc1, 0x50, 0xf0, 0xa4, 0x55, 0xab,
-	0xf8, 0xd4, 0xa7, 0xe2, 0xd8, 0x9c, 0xaf, 0x24, 0xb3, 0x76, 0xdf, 0xa7, 0xd4, 0x0f, 0xb0, 0x29,
-	0x76, 0x4e, 0x7c, 0x64, 0xda, 0xe1, 0x54, 0x1d, 0x3d, 0x74, 0x29, 0x1b, 0x51, 0x66, 0xc6, 0x63,
-	0x3f, 0xb2, 0x3d, 0x6c, 0x4e, 0x5a, 0x0e, 0xe6, 0x76, 0x2b, 0xdd, 0x4b, 0x16, 0xfa, 0xa4, 0x81,
-	0xcd, 0x03, 0x0f, 0x87, 0x9c, 0x1c, 0x11, 0xec, 0xf5, 0xc4, 0x73, 0x2f, 0xb9, 0xcd, 0xb1, 0xde,
-	0x02, 0x45, 0xf9, 0xfa, 0x80, 0x78, 0x55, 0xad, 0xa1, 0x35, 0x8b, 0xdd, 0xca, 0x2c, 0x81, 0xeb,
-	0x53, 0x7b, 0x14, 0x74, 0xd0, 0xe5, 0x11, 0xb2, 0x56, 0xe4, 0xfa, 0xc0, 0xd3, 0xfb, 0x60, 0x55,
-	0xe1, 0x6c, 0x2e, 0x51, 0x5d, 0x6a, 0x68, 0xcd, 0x52, 0xbb, 0x62, 0x48, 0x93, 0x46, 0x6a, 0xd2,
-	0x78, 0x1a, 0x4e, 0xbb, 0x5b, 0xb3, 0x04, 0x6e, 0x64, 0xb4, 0xc4, 0x1d, 0x64, 0x95, 0xdc, 0x2b,
-	0x13, 0xe8, 0xb3, 0x06, 0xaa, 0x3d, 0x1a, 0x32, 0x1c, 0xb2, 0x98, 0x09, 0xe8, 0x15, 0xe1, 0xc3,
-	0x7d, 0x4c, 0xfc, 0x21, 0xd7, 0xf7, 0x40, 0x61, 0x28, 0x56, 0xc2, 0x5e, 0xa9, 0x5d, 0x33, 0x6e,
-	0xd6, 0xcd, 0x90, 0xdc, 0x6e, 0xfe, 0x34, 0x81, 0x39, 0x4b, 0xf1, 0xf5, 0xd7, 0xa0, 0xec, 0xa6,
-	0xaa, 0x7f, 0xe0, 0xb5, 0x36, 0x4b, 0xe0, 0x3d, 0xe5, 0x35, 0x7b, 0x0d, 0x59, 0x6b, 0x6e, 0xc6,
-	0x1e, 0xfa, 0xa2, 0x81, 0x4d, 0x59, 0xc6, 0xac, 0x6f, 0xf6, 0x37, 0x05, 0x7d, 0x07, 0xd6, 0xaf,
-	0x3d, 0xc8, 0xaa, 0x4b, 0x8d, 0xe5, 0x66, 0xa9, 0xfd, 0xe8, 0xb6, 0xac, 0x77, 0x55, 0xaa, 0x0b,
-	0xe7, 0xe9, 0x67, 0x09, 0xdc, 0xba, 0x35, 0x04, 0x43, 0x56, 0x39, 0x9b, 0x82, 0xa1, 0x1f, 0x1a,
-	0xa8, 0xc8, 0x18, 0x87, 0x63, 0xcf, 0xe6, 0xb8, 0x1f, 0xd1, 0x31, 0x65, 0x76, 0xa0, 0x57, 0xc0,
-	0x7f, 0x9c, 0xf0, 0x00, 0xcb, 0x04, 0x96, 0xdc, 0xe8, 0x0d, 0x50, 0xf2, 0x30, 0x73, 0x23, 0x32,
-	0xe6, 0x84, 0x86, 0xa2, 0x98, 0x45, 0x6b, 0x11, 0xd2, 0xf7, 0xc1, 0xff, 0x2c, 0x76, 0x8e, 0xb1,
-	0xcb, 0x07, 0x57, 0x55, 0x58, 0x16, 0x55, 0xd8, 0x9e, 0x25, 0xb0, 0x2a, 0x9d, 0xdd, 0xa0, 0x20,
-	0xab, 0xac, 0xb0, 0x5e, 0x5a, 0x94, 0x17, 0xa0, 0xc2, 0x62, 0x87, 0x71, 0xc2, 0x63, 0x8e, 0x17,
-	0xc4, 0xf2, 0x42, 0x0c, 0xce, 0x12, 0xf8, 0xe0, 0x52, 0xec, 0x06, 0x0b, 0x59, 0xfa, 0x15, 0x9c,
-	0x4a, 0x76, 0xf2, 0xef, 0x4f, 0x60, 0x0e, 0xfd, 0xd4, 0x40, 0xf9, 0x50, 0x76, 0xc7, 0x3f, 0xc7,
-	0x7d, 0x02, 0xf2, 0xe3, 0xc0, 0x0e, 0x45, 0xc2, 0x52, 0x7b, 0xdb, 0x90, 0xcd, 0x68, 0xa4, 0xcd,
-	0xa7, 0x9a, 0xd1, 0xe8, 0x07, 0x76, 0xa8, 0xfe, 0x4d, 0xc1, 0xd7, 0x8f, 0xc1, 0xa6, 0xe2, 0x78,
-	0x83, 0x4c, 0x2f, 0xe5, 0x7f, 0xf3, 0x7f, 0x36, 0x66, 0x09, 0xdc, 0x96, 0x99, 0x6f, 0xbd, 0x8c,
-	0xac, 0x8d, 0x14, 0x5f, 0xe8, 0xf0, 0xce, 0xea, 0x3c, 0xf5, 0xc7, 0x13, 0x98, 0xfb, 0x7e, 0x02,
-	0xb5, 0xf9, 0x24, 0x28, 0xa8, 0xc6, 0xea, 0x81, 0x72, 0x84, 0x27, 0x84, 0x11, 0x1a, 0x0e, 0xc2,
-	0x78, 0xe4, 0xe0, 0x48, 0xc4, 0xcf, 0x2f, 0x36, 0xc2, 0x35, 0x02, 0xb2, 0xd6, 0x52, 0xe4, 0xb9,
-	0x00, 0x32, 0x22, 0xaa, 0x4d, 0x97, 0xee, 0x14, 0x91, 0x84, 0x05, 0x11, 0xe9, 0xa4, 0xb3, 0x92,
-	0x5a, 0x44, 0xcf, 0x40, 0xa1, 0x6f, 0x47, 0xf6, 0x88, 0xcd, 0x85, 0xed, 0x20, 0xa0, 0x6f, 0x2f,
-	0x43, 0xb2, 0xaa, 0xd6, 0x58, 0x6e, 0x16, 0x17, 0x85, 0xaf, 0x11, 0x90, 0xb5, 0xa6, 0x10, 0x99,
-	0x9f, 0x75, 0xad, 0xd3, 0xf3, 0xba, 0x76, 0x76, 0x5e, 0xd7, 0xbe, 0x9d, 0xd7, 0xb5, 0x0f, 0x17,
-	0xf5, 0xdc, 0xd9, 0x45, 0x3d, 0xf7, 0xf5, 0xa2, 0x9e, 0x7b, 0xb3, 0xe7, 0x13, 0x3e, 0x8c, 0x1d,
-	0xc3, 0xa5, 0x23, 0x53, 0x8d, 0x50, 0xe2, 0xb8, 0x3b, 0x3e, 0x35, 0x27, 0xbb, 0xe6, 0x88, 0x7a,
-	0x71, 0x80, 0x99, 0x9c, 0xde, 0x8f, 0xdb, 0x3b, 0x6a, 0x80, 0xf3, 0xe9, 0x18, 0x33, 0xa7, 0x20,
-	0x3e, 0xca, 0xee, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x6f, 0x16, 0x77, 0xe0, 0x05, 0x00,
-	0x00,
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x6c, 0xcf, 0x6b, 0xe8, 0x22, 0xee, 0x14, 0x27, 0xf5, 0xb2, 0xd4, 0x74, 0xb9, 0x61,
+	0xf0, 0x21, 0x95, 0x66, 0x17, 0x18, 0x8a, 0xdc, 0xea, 0x00, 0x5b, 0x0b, 0x0c, 0x83, 0xcb, 0xa1,
+	0x28, 0x56, 0xa0, 0x30, 0xf4, 0x83, 0x95, 0x59, 0xc8, 0xa2, 0x21, 0x52, 0xde, 0xf2, 0x1f, 0xf4,
+	0xb8, 0xe3, 0x0e, 0x3b, 0xe4, 0x3f, 0xd8, 0x3f, 0xb1, 0x43, 0x8f, 0x3d, 0xee, 0x24, 0x0c, 0xc9,
+	0x65, 0xd7, 0xe9, 0x3a, 0x0c, 0x18, 0x44, 0x52, 0x8e, 0x15, 0xdb, 0x43, 0xb1, 0xde, 0xc8, 0xc7,
+	0x8f, 0x1f, 0xbf, 0xef, 0x91, 0xef, 0x49, 0x00, 0x52, 0xd7, 0xb3, 0x3d, 0x16, 0x13, 0xdb, 0x0b,
+	0x29, 0x89, 0x84, 0xbd, 0x18, 0xe8, 0x91, 0x35, 0x8f, 0x99, 0x60, 0xa6, 0x49, 0x5d, 0xcf, 0xca,
+	0x01, 0x96, 0x0e, 0x2f, 0x06, 0x87, 0xed, 0x80, 0x05, 0x4c, 0x2e, 0xdb, 0xf9, 0x48, 0x21, 0x0f,
+	0x3f, 0x0e, 0x18, 0x0b, 0x42, 0x62, 0xcb, 0x99, 0x9b, 0xbc, 0xb4, 0x9d, 0xe8, 0x4c, 0x2f, 0x7d,
+	0xe6, 0x31, 0x3e, 0x63, 0xdc, 0x4e, 0xe6, 0x41, 0xec, 0xf8, 0xc4, 0x5e, 0x0c, 0x5c, 0x22, 0x9c,
+	0x41, 0x31, 0x57, 0x28, 0xf4, 0x8b, 0x01, 0xf6, 0x1f, 0xfb, 0x24, 0x12, 0xf4, 0x25, 0x25, 0xfe,
+	0xa9, 0x3c, 0xee, 0x3b, 0xe1, 0x08, 0x62, 0x0e, 0xc0, 0x8e, 0x3a, 0x7d, 0x42, 0xfd, 0x8e, 0xd1,
+	0x33, 0xfa, 0x3b, 0xa3, 0x76, 0x96, 0xc2, 0x5b, 0x67, 0xce, 0x2c, 0x3c, 0x41, 0xcb, 0x25, 0x84,
+	0x6f, 0xa8, 0xf1, 0x63, 0xdf, 0x1c, 0x83, 0x9b, 0x3a, 0xce, 0x73, 0x8a, 0x4e, 0xb5, 0x67, 0xf4,
+	0x9b, 0xc3, 0xb6, 0xa5, 0x44, 0x5a, 0x85, 0x48, 0xeb, 0x61, 0x74, 0x36, 0xba, 0x9d, 0xa5, 0x70,
+	0xaf, 0xc4, 0x25, 0xf7, 0x20, 0xdc, 0xf4, 0xae, 0x44, 0xa0, 0x5f, 0x0d, 0xd0, 0x39, 0x65, 0x11,
+	0x27, 0x11, 0x4f, 0xb8, 0x0c, 0x3d, 0xa3, 0x62, 0xfa, 0x88, 0xd0, 0x60, 0x2a, 0xcc, 0x07, 0xa0,
+	0x31, 0x95, 0x23, 0x29, 0xaf, 0x39, 0x3c, 0xb4, 0xd6, 0xf3, 0x66, 0x29, 0xec, 0xa8, 0xfe, 0x26,
+	0x85, 0x15, 0xac, 0xf1, 0xe6, 0xf7, 0xa0, 0xe5, 0x15, 0xac, 0xef, 0xa0, 0xf5, 0x30, 0x4b, 0xe1,
+	0x81, 0xd6, 0x5a, 0xde, 0x86, 0xf0, 0xae, 0x57, 0x92, 0x87, 0x7e, 0x33, 0xc0, 0xbe, 0x4a, 0x63,
+	0x59, 0x37, 0xff, 0x3f, 0x09, 0xfd, 0x11, 0xdc, 0xba, 0x76, 0x20, 0xef, 0x54, 0x7b, 0xb5, 0x7e,
+	0x73, 0x78, 0xbc, 0xc9, 0xeb, 0xb6, 0x4c, 0x8d, 0x60, 0xee, 0x3e, 0x4b, 0xe1, 0xed, 0x8d, 0x26,
+	0x38, 0xc2, 0xad, 0xb2, 0x0b, 0x8e, 0xfe, 0x32, 0x40, 0x5b, 0xd9, 0x78, 0x3a, 0xf7, 0x1d, 0x41,
+	0xc6, 0x31, 0x9b, 0x33, 0xee, 0x84, 0x66, 0x1b, 0x7c, 0x20, 0xa8, 0x08, 0x89, 0x72, 0x80, 0xd5,
+	0xc4, 0xec, 0x81, 0xa6, 0x4f, 0xb8, 0x17, 0xd3, 0xb9, 0xa0, 0x2c, 0x92, 0xc9, 0xdc, 0xc1, 0xab,
+	0x21, 0xf3, 0x11, 0xf8, 0x88, 0x27, 0xee, 0x2b, 0xe2, 0x89, 0xc9, 0x55, 0x16, 0x6a, 0x32, 0x0b,
+	0x47, 0x59, 0x0a, 0x3b, 0x4a, 0xd9, 0x1a, 0x04, 0xe1, 0x96, 0x8e, 0x9d, 0x16, 0x49, 0x79, 0x02,
+	0xda, 0x3c, 0x71, 0xb9, 0xa0, 0x22, 0x11, 0x64, 0x85, 0xac, 0x2e, 0xc9, 0x60, 0x96, 0xc2, 0x4f,
+	0x96, 0x64, 0x6b, 0x28, 0x84, 0xcd, 0xab, 0x70, 0x41, 0x79, 0x52, 0x7f, 0x7d, 0x0e, 0x2b, 0xe8,
+	0xf5, 0xf2, 0xea, 0x1e, 0xc6, 0xde, 0x94, 0x2e, 0xde, 0xdf, 0x74, 0xe9, 0xca, 0x6b, 0xef, 0x72,
+	0xe5, 0x5a, 0xca, 0xdf, 0x06, 0x68, 0x3d, 0x55, 0x85, 0xfa, 0xde, 0x22, 0xbe, 0x04, 0xf5, 0x79,
+	0xe8, 0x44, 0xf2, 0xfc, 0xe6, 0xf0, 0xc8, 0x52, 0x7d, 0xc1, 0x2a, 0xfa, 0x80, 0xee, 0x0b, 0xd6,
+	0x38, 0x74, 0x22, 0x5d, 0x26, 0x12, 0x6f, 0xbe, 0x02, 0xfb, 0x1a, 0xe3, 0x4f, 0x4a, 0x65, 0x5d,
+	0xff, 0x8f, 0x52, 0xe9, 0x65, 0x29, 0x3c, 0x52, 0xf6, 0x36, 0x6e, 0x46, 0x78, 0xaf, 0x88, 0xaf,
+	0x34, 0x9b, 0x93, 0x9b, 0xb9, 0xeb, 0x9f, 0xcf, 0x61, 0xe5, 0xcf, 0x73, 0x68, 0xe4, 0x4d, 0xa9,
+	0xa1, 0x6b, 0xfc, 0x14, 0xb4, 0x62, 0xb2, 0xa0, 0x9c, 0xb2, 0x68, 0x12, 0x25, 0x33, 0x97, 0xc4,
+	0xd2, 0x7e, 0x7d, 0xb5, 0x26, 0xaf, 0x01, 0x10, 0xde, 0x2d, 0x22, 0xdf, 0xca, 0x40, 0x89, 0x44,
+	0x77, 0x8c, 0xea, 0x56, 0x12, 0x05, 0x58, 0x21, 0x51, 0x4a, 0x4e, 0x6e, 0x14, 0x12, 0xd1, 0x3f,
+	0x55, 0xd0, 0x18, 0x3b, 0xb1, 0x33, 0xe3, 0x39, 0xb3, 0x13, 0x86, 0xec, 0x87, 0xa5, 0x4b, 0xde,
+	0x31, 0x7a, 0xb5, 0xfe, 0xce, 0x2a, 0xf3, 0x35, 0x00, 0xc2, 0xbb, 0x3a, 0xa2, 0x12, 0xc0, 0xcd,
+	0x67, 0xe0, 0xc0, 0x75, 0x84, 0x37, 0x25, 0xfe, 0x24, 0x91, 0xc5, 0xb6, 0xe4, 0xaa, 0x4a, 0xae,
+	0xbb, 0x59, 0x0a, 0xef, 0x28, 0xae, 0xcd, 0x38, 0x84, 0xdb, 0x7a, 0x41, 0x15, 0x6b, 0x41, 0xfc,
+	0x02, 0x74, 0x66, 0x2c, 0x62, 0x82, 0x45, 0xd4, 0xd3, 0xbe, 0x96, 0xd4, 0x35, 0x49, 0xfd, 0x69,
+	0x96, 0x42, 0xa8, 0xa8, 0xb7, 0x21, 0x11, 0x3e, 0x58, 0x2e, 0xa9, 0x54, 0x14, 0xf4, 0x0b, 0x70,
+	0xf7, 0x5a, 0x27, 0x99, 0xcc, 0xe3, 0x24, 0xa2, 0x51, 0x30, 0x09, 0x1c, 0x3e, 0x09, 0xe9, 0x8c,
+	0x0a, 0xf9, 0x58, 0xea, 0xa3, 0xe3, 0x2c, 0x85, 0xfd, 0x8d, 0xcd, 0x67, 0x7d, 0x0b, 0xc2, 0x77,
+	0xca, 0xdd, 0x68, 0xac, 0x10, 0x5f, 0x3b, 0xfc, 0x1b, 0xb9, 0xfe, 0x1c, 0x98, 0x4f, 0x12, 0x92,
+	0x14, 0x09, 0x54, 0x9e, 0xcd, 0xe3, 0xfc, 0x6b, 0xe0, 0xf8, 0xfa, 0x81, 0x6c, 0x79, 0x9f, 0x58,
+	0x63, 0xcc, 0x03, 0xd0, 0xe0, 0x34, 0x88, 0x48, 0xac, 0x2b, 0x46, 0xcf, 0xd0, 0x0b, 0xb0, 0xb7,
+	0xce, 0xcd, 0xcd, 0xaf, 0xc0, 0x87, 0x2a, 0xe5, 0xea, 0x7e, 0x9b, 0xc3, 0xcf, 0x37, 0xf5, 0xdf,
+	0xf5, 0x9d, 0xba, 0xa0, 0x8a, 0xcd, 0x23, 0xfc, 0xe6, 0xa2, 0x6b, 0xbc, 0xbd, 0xe8, 0x1a, 0x7f,
+	0x5c, 0x74, 0x8d, 0x9f, 0x2e, 0xbb, 0x95, 0xb7, 0x97, 0xdd, 0xca, 0xef, 0x97, 0xdd, 0xca, 0xf3,
+	0x07, 0x01, 0x15, 0xd3, 0xc4, 0xb5, 0x3c, 0x36, 0xb3, 0xf5, 0x97, 0x9b, 0xba, 0xde, 0xbd, 0x80,
+	0xd9, 0x8b, 0xfb, 0xf6, 0x8c, 0xf9, 0x49, 0x48, 0xb8, 0xfa, 0x69, 0xf8, 0x62, 0x78, 0x4f, 0xff,
+	0x37, 0x88, 0xb3, 0x39, 0xe1, 0x6e, 0x43, 0x1a, 0xbc, 0xff, 0xef, 0x00, 0x3e, 0x24, 0xa7, 0xb2,
+	0x57, 0x08, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -660,6 +856,50 @@ func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientArchiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientArchiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientArchiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -772,6 +1012,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConsensusStatePruningGasLimit != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.ConsensusStatePruningGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MonotonicHeightClients) > 0 {
+		for iNdEx := len(m.MonotonicHeightClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MonotonicHeightClients[iNdEx])
+			copy(dAtA[i:], m.MonotonicHeightClients[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.MonotonicHeightClients[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BatchedUpdateClients) > 0 {
+		for iNdEx := len(m.BatchedUpdateClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BatchedUpdateClients[iNdEx])
+			copy(dAtA[i:], m.BatchedUpdateClients[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.BatchedUpdateClients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -784,6 +1047,85 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedClientUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedClientUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedClientUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClient(v)
 	base := offset
@@ -871,6 +1213,27 @@ func (m *ClientUpdateProposal) Size() (n int) {
 	return n
 }
 
+func (m *ClientArchiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *UpgradeProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -921,21 +1284,68 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if len(m.BatchedUpdateClients) > 0 {
+		for _, s := range m.BatchedUpdateClients {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.MonotonicHeightClients) > 0 {
+		for _, s := range m.MonotonicHeightClients {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.ConsensusStatePruningGasLimit != 0 {
+		n += 1 + sovClient(uint64(m.ConsensusStatePruningGasLimit))
+	}
 	return n
 }
 
-func sovClient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClient(x uint64) (n int) {
-	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *QueuedClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedClientUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovClient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClient(x uint64) (n int) {
+	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowClient
@@ -1461,6 +1871,152 @@ func (m *ClientUpdateProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClientArchiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientArchiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientArchiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1793,6 +2349,291 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchedUpdateClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchedUpdateClients = append(m.BatchedUpdateClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonotonicHeightClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonotonicHeightClients = append(m.MonotonicHeightClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStatePruningGasLimit", wireType)
+			}
+			m.ConsensusStatePruningGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStatePruningGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedClientUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedClientUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedClientUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedClientUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedClientUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, QueuedClientUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
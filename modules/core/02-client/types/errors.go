@@ -34,4 +34,14 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrClientCallOutOfGas                     = sdkerrors.Register(SubModuleName, 30, "light client call exceeded gas limit")
+	ErrClientCallPanic                        = sdkerrors.Register(SubModuleName, 31, "light client call panicked")
+	ErrUpgradePlanVerificationUnsupported     = sdkerrors.Register(SubModuleName, 32, "light client does not support upgrade plan verification")
+	ErrClientUpdateQueueFull                  = sdkerrors.Register(SubModuleName, 33, "client update queue is full")
+	ErrClientSequenceNotReserved              = sdkerrors.Register(SubModuleName, 34, "client identifier sequence is not reserved")
+	ErrChannelUpgradeVerificationUnsupported  = sdkerrors.Register(SubModuleName, 35, "light client does not support channel upgrade verification")
+	ErrClientArchived                         = sdkerrors.Register(SubModuleName, 36, "client is archived")
+	ErrInvalidArchiveClientProposal           = sdkerrors.Register(SubModuleName, 37, "invalid archive client proposal")
+	ErrUpdateGasEstimationUnsupported         = sdkerrors.Register(SubModuleName, 38, "light client does not support update gas estimation")
+	ErrInvalidRecoverClient                   = sdkerrors.Register(SubModuleName, 39, "invalid client recovery")
 )
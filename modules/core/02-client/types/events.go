@@ -8,20 +8,28 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID        = "client_id"
-	AttributeKeySubjectClientID = "subject_client_id"
-	AttributeKeyClientType      = "client_type"
-	AttributeKeyConsensusHeight = "consensus_height"
-	AttributeKeyHeader          = "header"
+	AttributeKeyClientID          = "client_id"
+	AttributeKeySubjectClientID   = "subject_client_id"
+	AttributeKeySubstituteID      = "substitute_client_id"
+	AttributeKeyClientType        = "client_type"
+	AttributeKeyConsensusHeight   = "consensus_height"
+	AttributeKeyHeader            = "header"
+	AttributeKeyHeaderGas         = "header_verification_gas"
+	AttributeKeyUpgradePlanName   = "upgrade_plan_name"
+	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 )
 
 // IBC client events vars
 var (
-	EventTypeCreateClient         = "create_client"
-	EventTypeUpdateClient         = "update_client"
-	EventTypeUpgradeClient        = "upgrade_client"
-	EventTypeSubmitMisbehaviour   = "client_misbehaviour"
-	EventTypeUpdateClientProposal = "update_client_proposal"
+	EventTypeCreateClient               = "create_client"
+	EventTypeUpdateClient               = "update_client"
+	EventTypeUpgradeClient              = "upgrade_client"
+	EventTypeSubmitMisbehaviour         = "client_misbehaviour"
+	EventTypeUpdateClientProposal       = "update_client_proposal"
+	EventTypeQueueClientUpdate          = "queue_client_update"
+	EventTypeArchiveClient              = "archive_client"
+	EventTypeRecoverClient              = "recover_client"
+	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultVMGasMultiplier is the default number of gas units of a light client virtual machine,
+// such as a Wasm VM, which are equal to one SDK gas unit.
+const DefaultVMGasMultiplier uint64 = 140_000_000
+
+// VMGasConverter converts gas deterministically between the gas units of a light client virtual
+// machine and SDK gas units, using integer arithmetic only.
+type VMGasConverter struct {
+	multiplier uint64
+}
+
+// NewVMGasConverter returns a new VMGasConverter using the provided number of virtual machine
+// gas units per SDK gas unit. It panics if the multiplier is zero.
+func NewVMGasConverter(multiplier uint64) VMGasConverter {
+	if multiplier == 0 {
+		panic(fmt.Errorf("VM gas multiplier cannot be zero"))
+	}
+
+	return VMGasConverter{
+		multiplier: multiplier,
+	}
+}
+
+// ToSDKGas converts virtual machine gas to SDK gas. Partial SDK gas units are rounded up so
+// that any virtual machine gas consumption is charged.
+func (c VMGasConverter) ToSDKGas(vmGas uint64) sdk.Gas {
+	gas := vmGas / c.multiplier
+	if vmGas%c.multiplier != 0 {
+		gas++
+	}
+
+	return gas
+}
+
+// FromSDKGas converts SDK gas to virtual machine gas, for example to obtain the gas limit of a
+// virtual machine call. The result saturates at the maximum uint64 value.
+func (c VMGasConverter) FromSDKGas(gas sdk.Gas) uint64 {
+	if gas > math.MaxUint64/c.multiplier {
+		return math.MaxUint64
+	}
+
+	return gas * c.multiplier
+}
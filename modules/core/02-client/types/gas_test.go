@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+func TestVMGasConverter(t *testing.T) {
+	require.Panics(t, func() { types.NewVMGasConverter(0) })
+
+	converter := types.NewVMGasConverter(types.DefaultVMGasMultiplier)
+
+	require.Equal(t, uint64(0), converter.ToSDKGas(0))
+	require.Equal(t, uint64(1), converter.ToSDKGas(1), "partial gas units are rounded up")
+	require.Equal(t, uint64(1), converter.ToSDKGas(types.DefaultVMGasMultiplier))
+	require.Equal(t, uint64(2), converter.ToSDKGas(types.DefaultVMGasMultiplier+1))
+
+	require.Equal(t, uint64(0), converter.FromSDKGas(0))
+	require.Equal(t, 3*types.DefaultVMGasMultiplier, converter.FromSDKGas(3))
+	require.Equal(t, uint64(math.MaxUint64), converter.FromSDKGas(math.MaxUint64), "conversion saturates")
+
+	// conversions round trip
+	require.Equal(t, uint64(1000), converter.ToSDKGas(converter.FromSDKGas(1000)))
+}
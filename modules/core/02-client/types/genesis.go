@@ -117,6 +117,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	validClients := make(map[string]string)
+	usedSequences := make(map[uint64]bool)
 
 	for i, client := range gs.Clients {
 		if err := host.ClientIdentifierValidator(client.ClientId); err != nil {
@@ -135,6 +136,16 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid client %v index %d: %w", client, i, err)
 		}
 
+		// the localhost client is stored under its sentinel client identifier
+		if client.ClientId == exported.Localhost {
+			if clientState.ClientType() != exported.Localhost {
+				return fmt.Errorf("client state type %s cannot be stored under the %s client identifier", clientState.ClientType(), exported.Localhost)
+			}
+
+			validClients[client.ClientId] = clientState.ClientType()
+			continue
+		}
+
 		clientType, sequence, err := ParseClientIdentifier(client.ClientId)
 		if err != nil {
 			return err
@@ -151,6 +162,7 @@ func (gs GenesisState) Validate() error {
 		if sequence > maxSequence {
 			maxSequence = sequence
 		}
+		usedSequences[sequence] = true
 
 		// add client id to validClients map
 		validClients[client.ClientId] = clientState.ClientType()
@@ -209,6 +221,35 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
 
+	reservedSequences := make(map[uint64]bool, len(gs.ReservedClientSequences))
+	for _, sequence := range gs.ReservedClientSequences {
+		if sequence >= gs.NextClientSequence {
+			return fmt.Errorf("reserved client identifier sequence %d must be less than the next client identifier sequence %d", sequence, gs.NextClientSequence)
+		}
+
+		if usedSequences[sequence] {
+			return fmt.Errorf("reserved client identifier sequence %d is used by a client", sequence)
+		}
+
+		if reservedSequences[sequence] {
+			return fmt.Errorf("duplicate reserved client identifier sequence %d", sequence)
+		}
+		reservedSequences[sequence] = true
+	}
+
+	archivedClients := make(map[string]bool, len(gs.ArchivedClients))
+	for _, clientID := range gs.ArchivedClients {
+		// check that the archived client is in the genesis clients list
+		if _, ok := validClients[clientID]; !ok {
+			return fmt.Errorf("archived client id %s does not map to a genesis client", clientID)
+		}
+
+		if archivedClients[clientID] {
+			return fmt.Errorf("duplicate archived client id %s", clientID)
+		}
+		archivedClients[clientID] = true
+	}
+
 	return nil
 }
 
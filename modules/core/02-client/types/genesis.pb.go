@@ -36,6 +36,10 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty" yaml:"create_localhost"`
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty" yaml:"next_client_sequence"`
+	// client identifier sequences reserved for an upcoming upgrade
+	ReservedClientSequences []uint64 `protobuf:"varint,7,rep,packed,name=reserved_client_sequences,json=reservedClientSequences,proto3" json:"reserved_client_sequences,omitempty" yaml:"reserved_client_sequences"`
+	// identifiers of the archived clients
+	ArchivedClients []string `protobuf:"bytes,8,rep,name=archived_clients,json=archivedClients,proto3" json:"archived_clients,omitempty" yaml:"archived_clients"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,6 +117,20 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetReservedClientSequences() []uint64 {
+	if m != nil {
+		return m.ReservedClientSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedClients() []string {
+	if m != nil {
+		return m.ArchivedClients
+	}
+	return nil
+}
+
 // GenesisMetadata defines the genesis type for metadata that clients may return
 // with ExportMetadata
 type GenesisMetadata struct {
@@ -218,41 +236,45 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0xcd, 0x34, 0x69, 0x68, 0xa7, 0x15, 0x0d, 0xa3, 0xa8, 0x98, 0x54, 0xb2, 0x2d, 0xb3, 0x09,
-	0x8b, 0xd8, 0x24, 0xdd, 0x54, 0xd9, 0x20, 0xb9, 0x12, 0xa8, 0x12, 0x48, 0x60, 0x76, 0x6c, 0xac,
-	0xc9, 0x78, 0x48, 0x47, 0xd8, 0x9e, 0x90, 0x99, 0x44, 0xe4, 0x06, 0x2c, 0x11, 0x27, 0x60, 0xcd,
-	0x19, 0x38, 0x40, 0x97, 0x5d, 0x76, 0x15, 0x50, 0x72, 0x83, 0x9c, 0x00, 0x79, 0x66, 0x4c, 0xdb,
-	0x34, 0x65, 0xf7, 0xf3, 0xfc, 0xde, 0xfb, 0x4f, 0xef, 0x67, 0xa0, 0xcb, 0x06, 0x24, 0x20, 0x7c,
-	0x4c, 0x03, 0x92, 0x32, 0x9a, 0xcb, 0x60, 0xda, 0x0d, 0x86, 0x34, 0xa7, 0x82, 0x09, 0x7f, 0x34,
-	0xe6, 0x92, 0x23, 0xc4, 0x06, 0xc4, 0x2f, 0x18, 0xbe, 0x66, 0xf8, 0xd3, 0x6e, 0xcb, 0xd9, 0xa0,
-	0x32, 0x5f, 0x95, 0xa8, 0xd5, 0x1c, 0xf2, 0x21, 0x57, 0x63, 0x50, 0x4c, 0x1a, 0xf5, 0xae, 0x6a,
-	0x70, 0xff, 0x95, 0x36, 0x7f, 0x2f, 0xb1, 0xa4, 0x88, 0xc0, 0x07, 0x5a, 0x26, 0x2c, 0xe0, 0x56,
-	0xdb, 0x7b, 0xbd, 0x67, 0xfe, 0xdd, 0x6d, 0xfe, 0x59, 0x42, 0x73, 0xc9, 0x3e, 0x32, 0x9a, 0x9c,
-	0x2a, 0x4c, 0x69, 0x43, 0xfb, 0x62, 0xee, 0x54, 0x7e, 0xfe, 0x76, 0x0e, 0x37, 0x7e, 0x16, 0x51,
-	0xe9, 0x8c, 0xbe, 0x03, 0xf8, 0xc8, 0xcc, 0x31, 0xe1, 0xb9, 0xa0, 0xb9, 0x98, 0x08, 0x6b, 0xeb,
-	0xfe, 0x7d, 0xda, 0xe6, 0xb4, 0xa4, 0x6a, 0xbf, 0xb0, 0x5f, 0xec, 0x5b, 0xcd, 0x1d, 0x6b, 0x86,
-	0xb3, 0xb4, 0xef, 0xdd, 0x71, 0xf4, 0x8a, 0x2c, 0x5a, 0x2a, 0xd6, 0xb4, 0x51, 0x83, 0xac, 0xe1,
-	0x68, 0x06, 0x4b, 0x2c, 0xce, 0xa8, 0xc4, 0x09, 0x96, 0xd8, 0xaa, 0xaa, 0x48, 0x9d, 0xff, 0x57,
-	0x60, 0xfa, 0x7b, 0x63, 0x44, 0xa1, 0x63, 0x62, 0x3d, 0xbe, 0x1d, 0xab, 0x34, 0xf5, 0xa2, 0x03,
-	0x03, 0x95, 0x0a, 0x74, 0x02, 0xeb, 0x23, 0x3c, 0xc6, 0x99, 0xb0, 0x6a, 0x2e, 0x68, 0xef, 0xf5,
-	0x5a, 0x9b, 0x16, 0xbe, 0x55, 0x8c, 0xb0, 0x56, 0xb8, 0x47, 0x86, 0x8f, 0x5e, 0xc2, 0x06, 0x19,
-	0x53, 0x2c, 0x69, 0x9c, 0x72, 0x82, 0xd3, 0x73, 0x2e, 0xa4, 0xb5, 0xed, 0x82, 0xf6, 0x4e, 0x78,
-	0x74, 0x23, 0xc1, 0x1a, 0xa3, 0x48, 0xa0, 0xa0, 0xd7, 0x25, 0x82, 0xde, 0xc1, 0x66, 0x4e, 0xbf,
-	0xc8, 0x58, 0xaf, 0x8b, 0x05, 0xfd, 0x3c, 0xa1, 0x39, 0xa1, 0x56, 0xdd, 0x05, 0xed, 0x5a, 0xe8,
-	0xac, 0xe6, 0xce, 0x91, 0xf6, 0xda, 0xc4, 0xf2, 0x22, 0x54, 0xc0, 0xe6, 0xd6, 0x25, 0xf8, 0x02,
-	0x1e, 0xac, 0x35, 0x83, 0x1a, 0xb0, 0xfa, 0x89, 0xce, 0x2c, 0xe0, 0x82, 0xf6, 0x7e, 0x54, 0x8c,
-	0xa8, 0x09, 0xb7, 0xa7, 0x38, 0x9d, 0x50, 0x6b, 0x4b, 0x61, 0xfa, 0x47, 0xbf, 0xf6, 0xf5, 0x87,
-	0x53, 0xf1, 0x7e, 0x01, 0xf8, 0xe4, 0xde, 0x96, 0x51, 0x17, 0xee, 0x9a, 0x18, 0x2c, 0x51, 0x8e,
-	0xbb, 0x61, 0x73, 0x35, 0x77, 0x1a, 0x37, 0x4b, 0x8f, 0x59, 0xe2, 0x45, 0x3b, 0x7a, 0x3e, 0x4b,
-	0x50, 0x0a, 0x4d, 0xf3, 0xd7, 0x07, 0xd6, 0xff, 0xb9, 0xa7, 0x9b, 0xfa, 0x5e, 0x3f, 0xab, 0x6d,
-	0xce, 0x7a, 0x78, 0x6b, 0xc3, 0xf5, 0x55, 0x1f, 0x6a, 0xe4, 0x1f, 0x3f, 0xba, 0x58, 0xd8, 0xe0,
-	0x72, 0x61, 0x83, 0x3f, 0x0b, 0x1b, 0x7c, 0x5b, 0xda, 0x95, 0xcb, 0xa5, 0x5d, 0xb9, 0x5a, 0xda,
-	0x95, 0x0f, 0x27, 0x43, 0x26, 0xcf, 0x27, 0x03, 0x9f, 0xf0, 0x2c, 0x20, 0x5c, 0x64, 0x5c, 0x04,
-	0x6c, 0x40, 0x3a, 0x43, 0x1e, 0x4c, 0x8f, 0x83, 0x8c, 0x27, 0x93, 0x94, 0x0a, 0xfd, 0x96, 0x9f,
-	0xf7, 0x3a, 0xe6, 0x39, 0xcb, 0xd9, 0x88, 0x8a, 0x41, 0x5d, 0xbd, 0xda, 0xe3, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0x4b, 0x29, 0xf0, 0x24, 0x04, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x41, 0x6e, 0xd3, 0x4c,
+	0x18, 0x8d, 0x9b, 0x34, 0x4d, 0xa6, 0xd5, 0x9f, 0xfc, 0xa3, 0xa8, 0x75, 0x53, 0xc9, 0xb6, 0x0c,
+	0x0b, 0xb3, 0x88, 0x4d, 0xd2, 0x4d, 0x95, 0x0d, 0x92, 0x2b, 0x81, 0x2a, 0x81, 0x04, 0x66, 0xc7,
+	0xc6, 0x38, 0xe3, 0x21, 0xb1, 0xb0, 0x3d, 0xc1, 0x33, 0x89, 0xc8, 0x96, 0x15, 0x4b, 0xc4, 0x09,
+	0x58, 0x73, 0x06, 0x0e, 0xd0, 0x65, 0x97, 0xac, 0x02, 0x4a, 0x6e, 0x90, 0x13, 0x20, 0x7b, 0xc6,
+	0x24, 0x71, 0x13, 0x76, 0x9f, 0xdf, 0xbc, 0xf7, 0xbe, 0x6f, 0x66, 0x9e, 0x07, 0x68, 0xc1, 0x00,
+	0x59, 0x88, 0x24, 0xd8, 0x42, 0x61, 0x80, 0x63, 0x66, 0x4d, 0xbb, 0xd6, 0x10, 0xc7, 0x98, 0x06,
+	0xd4, 0x1c, 0x27, 0x84, 0x11, 0x08, 0x83, 0x01, 0x32, 0x53, 0x86, 0xc9, 0x19, 0xe6, 0xb4, 0xdb,
+	0x56, 0x77, 0xa8, 0xc4, 0x6a, 0x26, 0x6a, 0xb7, 0x86, 0x64, 0x48, 0xb2, 0xd2, 0x4a, 0x2b, 0x8e,
+	0xea, 0x9f, 0xaa, 0xe0, 0xe4, 0x19, 0x37, 0x7f, 0xcd, 0x3c, 0x86, 0x21, 0x02, 0x47, 0x5c, 0x46,
+	0x65, 0x49, 0x2b, 0x1b, 0xc7, 0xbd, 0x47, 0xe6, 0xfd, 0x6e, 0xe6, 0x8d, 0x8f, 0x63, 0x16, 0xbc,
+	0x0b, 0xb0, 0x7f, 0x9d, 0x61, 0x99, 0xd6, 0x56, 0x6e, 0xe7, 0x6a, 0xe9, 0xfb, 0x2f, 0xf5, 0x74,
+	0xe7, 0x32, 0x75, 0x72, 0x67, 0xf8, 0x55, 0x02, 0xff, 0x8b, 0xda, 0x45, 0x24, 0xa6, 0x38, 0xa6,
+	0x13, 0x2a, 0x1f, 0xec, 0xef, 0xc7, 0x6d, 0xae, 0x73, 0x2a, 0xf7, 0xb3, 0xfb, 0x69, 0xbf, 0xd5,
+	0x5c, 0x95, 0x67, 0x5e, 0x14, 0xf6, 0xf5, 0x7b, 0x8e, 0x7a, 0x3a, 0x0b, 0x97, 0xd2, 0x82, 0xd6,
+	0x69, 0xa2, 0x02, 0x0e, 0x67, 0x20, 0xc7, 0xdc, 0x08, 0x33, 0xcf, 0xf7, 0x98, 0x27, 0x97, 0xb3,
+	0x91, 0x3a, 0xff, 0x3e, 0x02, 0x71, 0x7e, 0x2f, 0x84, 0xc8, 0x56, 0xc5, 0x58, 0x67, 0xdb, 0x63,
+	0xe5, 0xa6, 0xba, 0xd3, 0x10, 0x50, 0xae, 0x80, 0x57, 0xa0, 0x3a, 0xf6, 0x12, 0x2f, 0xa2, 0x72,
+	0x45, 0x93, 0x8c, 0xe3, 0x5e, 0x7b, 0x57, 0xc3, 0x97, 0x19, 0xc3, 0xae, 0xa4, 0xee, 0x8e, 0xe0,
+	0xc3, 0xa7, 0xa0, 0x89, 0x12, 0xec, 0x31, 0xec, 0x86, 0x04, 0x79, 0xe1, 0x88, 0x50, 0x26, 0x1f,
+	0x6a, 0x92, 0x51, 0xb3, 0x2f, 0x36, 0x26, 0x28, 0x30, 0xd2, 0x09, 0x32, 0xe8, 0x79, 0x8e, 0xc0,
+	0x57, 0xa0, 0x15, 0xe3, 0x8f, 0xcc, 0xe5, 0xed, 0x5c, 0x8a, 0x3f, 0x4c, 0x70, 0x8c, 0xb0, 0x5c,
+	0xd5, 0x24, 0xa3, 0x62, 0xab, 0xab, 0xb9, 0x7a, 0xc1, 0xbd, 0x76, 0xb1, 0x74, 0x07, 0xa6, 0xb0,
+	0xb8, 0x6b, 0x01, 0xc2, 0xb7, 0xe0, 0x3c, 0xc1, 0x14, 0x27, 0x53, 0xec, 0x17, 0x05, 0x54, 0x3e,
+	0xd2, 0xca, 0x46, 0xc5, 0x7e, 0xb8, 0x9a, 0xab, 0x1a, 0xf7, 0xdd, 0x4b, 0xd5, 0x9d, 0xb3, 0x7c,
+	0x6d, 0xbb, 0x41, 0xb6, 0x79, 0x2f, 0x41, 0xa3, 0x60, 0x2d, 0xa3, 0x72, 0x4d, 0x2b, 0x1b, 0xf5,
+	0xcd, 0xcd, 0x17, 0x19, 0xba, 0xd3, 0xc8, 0x21, 0x11, 0x0d, 0xfd, 0x09, 0x68, 0x14, 0xee, 0x10,
+	0x36, 0x41, 0xf9, 0x3d, 0x9e, 0xc9, 0x92, 0x26, 0x19, 0x27, 0x4e, 0x5a, 0xc2, 0x16, 0x38, 0x9c,
+	0x7a, 0xe1, 0x04, 0xcb, 0x07, 0x19, 0xc6, 0x3f, 0xfa, 0x95, 0xcf, 0xdf, 0xd4, 0x92, 0xfe, 0x43,
+	0x02, 0xe7, 0x7b, 0xf3, 0x00, 0xbb, 0xa0, 0x2e, 0x36, 0x15, 0xf8, 0x99, 0x63, 0xdd, 0x6e, 0xad,
+	0xe6, 0x6a, 0x73, 0x33, 0x1e, 0x6e, 0xe0, 0xeb, 0x4e, 0x8d, 0xd7, 0x37, 0x3e, 0x0c, 0x81, 0xc8,
+	0xc8, 0x3a, 0x8a, 0xfc, 0xef, 0x78, 0xb0, 0x2b, 0x19, 0xc5, 0x00, 0x2a, 0x22, 0x80, 0xa7, 0x5b,
+	0x1d, 0xd6, 0xf9, 0xfb, 0x8f, 0x23, 0x7f, 0xf9, 0xce, 0xed, 0x42, 0x91, 0xee, 0x16, 0x8a, 0xf4,
+	0x7b, 0xa1, 0x48, 0x5f, 0x96, 0x4a, 0xe9, 0x6e, 0xa9, 0x94, 0x7e, 0x2e, 0x95, 0xd2, 0x9b, 0xab,
+	0x61, 0xc0, 0x46, 0x93, 0x81, 0x89, 0x48, 0x64, 0x21, 0x42, 0x23, 0x42, 0xad, 0x60, 0x80, 0x3a,
+	0x43, 0x62, 0x4d, 0x2f, 0xad, 0x88, 0xf8, 0x93, 0x10, 0x53, 0xfe, 0xea, 0x3c, 0xee, 0x75, 0xc4,
+	0xc3, 0xc3, 0x66, 0x63, 0x4c, 0x07, 0xd5, 0xec, 0x7d, 0xb9, 0xfc, 0x33, 0x00, 0xf5, 0x65, 0x2f,
+	0x32, 0xce, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -275,6 +297,33 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArchivedClients) > 0 {
+		for iNdEx := len(m.ArchivedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArchivedClients[iNdEx])
+			copy(dAtA[i:], m.ArchivedClients[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ArchivedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReservedClientSequences) > 0 {
+		dAtA2 := make([]byte, len(m.ReservedClientSequences)*10)
+		var j1 int
+		for _, num := range m.ReservedClientSequences {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -469,6 +518,19 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.ReservedClientSequences) > 0 {
+		l = 0
+		for _, e := range m.ReservedClientSequences {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ArchivedClients) > 0 {
+		for _, s := range m.ArchivedClients {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -717,6 +779,114 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReservedClientSequences = append(m.ReservedClientSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReservedClientSequences) == 0 {
+					m.ReservedClientSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReservedClientSequences = append(m.ReservedClientSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedClientSequences", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedClients = append(m.ArchivedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
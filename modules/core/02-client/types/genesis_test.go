@@ -68,6 +68,36 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			genState: types.DefaultGenesisState(),
 			expPass:  true,
 		},
+		{
+			name: "localhost client stored under its sentinel client identifier",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(exported.Localhost, localhosttypes.NewClientState("chainID", clientHeight)),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Localhost),
+				false,
+				0,
+			),
+			expPass: true,
+		},
+		{
+			name: "client stored under the localhost sentinel client identifier is not a localhost client",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						exported.Localhost, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+					),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint),
+				false,
+				0,
+			),
+			expPass: false,
+		},
 		{
 			name: "valid custom genesis",
 			genState: types.NewGenesisState(
@@ -536,6 +566,70 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid reserved client sequences",
+			genState: types.GenesisState{
+				Params:                  types.DefaultParams(),
+				NextClientSequence:      3,
+				ReservedClientSequences: []uint64{0, 2},
+			},
+			expPass: true,
+		},
+		{
+			name: "reserved client sequence is not less than the next client sequence",
+			genState: types.GenesisState{
+				Params:                  types.DefaultParams(),
+				NextClientSequence:      1,
+				ReservedClientSequences: []uint64{1},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate reserved client sequence",
+			genState: types.GenesisState{
+				Params:                  types.DefaultParams(),
+				NextClientSequence:      3,
+				ReservedClientSequences: []uint64{1, 1},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid archived clients",
+			genState: types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						tmClientID0, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+					),
+				},
+				Params:             types.DefaultParams(),
+				NextClientSequence: 1,
+				ArchivedClients:    []string{tmClientID0},
+			},
+			expPass: true,
+		},
+		{
+			name: "archived client does not map to a genesis client",
+			genState: types.GenesisState{
+				Params:             types.DefaultParams(),
+				NextClientSequence: 1,
+				ArchivedClients:    []string{tmClientID0},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate archived client",
+			genState: types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						tmClientID0, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+					),
+				},
+				Params:             types.DefaultParams(),
+				NextClientSequence: 1,
+				ArchivedClients:    []string{tmClientID0, tmClientID0},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClientHooks defines the interface used by modules to be notified of client events
+// processed by core IBC.
+type ClientHooks interface {
+	// AfterClientFrozen is called after a client has been frozen by valid misbehaviour
+	// submitted by the provided submitter.
+	AfterClientFrozen(ctx sdk.Context, clientID string, submitter sdk.AccAddress)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.HostTimeOracle = DefaultHostTimeOracle{}
+
+// hostTimeOracleKey is the context key under which the host time oracle is stored
+type hostTimeOracleKey struct{}
+
+// DefaultHostTimeOracle is the host time oracle used if none is set in the context. It returns
+// the height and time of the block header.
+type DefaultHostTimeOracle struct{}
+
+// GetHostHeight implements the HostTimeOracle interface, it returns the self height.
+func (DefaultHostTimeOracle) GetHostHeight(ctx sdk.Context) exported.Height {
+	return GetSelfHeight(ctx)
+}
+
+// GetHostTimestamp implements the HostTimeOracle interface, it returns the block time.
+func (DefaultHostTimeOracle) GetHostTimestamp(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().UnixNano())
+}
+
+// WithHostTimeOracle returns a context in which the host height and timestamp used to evaluate
+// packet timeouts and delay periods are retrieved from the given oracle. The client keeper sets
+// its oracle in the context of the calls made into light clients, see the SetHostTimeOracle
+// method of the client keeper.
+func WithHostTimeOracle(ctx sdk.Context, oracle exported.HostTimeOracle) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), hostTimeOracleKey{}, oracle))
+}
+
+// GetHostTimeOracle returns the host time oracle set in the context, or the
+// DefaultHostTimeOracle if none is set.
+func GetHostTimeOracle(ctx sdk.Context) exported.HostTimeOracle {
+	if oracle, ok := ctx.Context().Value(hostTimeOracleKey{}).(exported.HostTimeOracle); ok {
+		return oracle
+	}
+
+	return DefaultHostTimeOracle{}
+}
+
+// GetHostHeight returns the current height of the host chain given by the host time oracle of
+// the context. The oracle cannot move the height backwards: the self height is returned if the
+// height of the oracle is lower.
+func GetHostHeight(ctx sdk.Context) Height {
+	selfHeight := GetSelfHeight(ctx)
+
+	height := GetHostTimeOracle(ctx).GetHostHeight(ctx)
+	hostHeight := NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+	if hostHeight.LT(selfHeight) {
+		return selfHeight
+	}
+
+	return hostHeight
+}
+
+// GetHostTimestamp returns the current timestamp of the host chain in nanoseconds given by the
+// host time oracle of the context. The oracle cannot move the time backwards: the block time is
+// returned if the timestamp of the oracle is lower.
+func GetHostTimestamp(ctx sdk.Context) uint64 {
+	blockTimestamp := uint64(ctx.BlockTime().UnixNano())
+
+	if timestamp := GetHostTimeOracle(ctx).GetHostTimestamp(ctx); timestamp > blockTimestamp {
+		return timestamp
+	}
+
+	return blockTimestamp
+}
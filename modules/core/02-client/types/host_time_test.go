@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *TypesTestSuite) TestHostTimeOracle() {
+	ctx := suite.chainA.GetContext()
+
+	// the default oracle uses the block header
+	suite.Require().Equal(types.DefaultHostTimeOracle{}, types.GetHostTimeOracle(ctx))
+	suite.Require().Equal(types.GetSelfHeight(ctx), types.GetHostHeight(ctx))
+	suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), types.GetHostTimestamp(ctx))
+
+	// an oracle ahead of the block header is used
+	timestamp := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+	oracle := mock.HostTimeOracle{Height: types.NewHeight(1, 1000), Timestamp: timestamp}
+	ctx = types.WithHostTimeOracle(ctx, oracle)
+
+	suite.Require().Equal(oracle, types.GetHostTimeOracle(ctx))
+	suite.Require().Equal(types.NewHeight(1, 1000), types.GetHostHeight(ctx))
+	suite.Require().Equal(timestamp, types.GetHostTimestamp(ctx))
+
+	// an oracle lagging behind the block header cannot move the host time backwards
+	ctx = types.WithHostTimeOracle(ctx, mock.HostTimeOracle{Height: types.NewHeight(0, 1), Timestamp: 42})
+
+	suite.Require().Equal(types.GetSelfHeight(ctx), types.GetHostHeight(ctx))
+	suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), types.GetHostTimestamp(ctx))
+}
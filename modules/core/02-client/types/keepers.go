@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ReadOnlyClientKeeper defines the read-only methods of the IBC client keeper. External modules
+// which only inspect clients, such as rate limiters or analytics modules, may depend on this
+// interface so that they can be wired without access to the methods mutating the client state.
+type ReadOnlyClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
+	GetLatestClientConsensusState(ctx sdk.Context, clientID string) (exported.ConsensusState, bool)
+	HasClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) bool
+	IterateClients(ctx sdk.Context, cb func(clientID string, cs exported.ClientState) bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	GetParams(ctx sdk.Context) Params
+}
+
+// ClientKeeper defines the methods of the IBC client keeper mutating the client state in addition
+// to the read-only methods.
+type ClientKeeper interface {
+	ReadOnlyClientKeeper
+
+	CreateClient(ctx sdk.Context, clientState exported.ClientState, consensusState exported.ConsensusState) (string, error)
+	UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error
+	BatchUpdateClient(ctx sdk.Context, clientID string, headers []exported.Header) error
+	UpgradeClient(
+		ctx sdk.Context,
+		clientID string,
+		upgradedClient exported.ClientState,
+		upgradedConsState exported.ConsensusState,
+		proofUpgradeClient,
+		proofUpgradeConsState []byte,
+	) error
+	CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error
+}
+
+// ClientStoreDecorator wraps the client store of a client before it is passed to the light
+// client. Light client modules register decorators with the client keeper to provide their
+// clients with dependencies, such as a Wasm VM, which cannot be stored in the client state.
+type ClientStoreDecorator func(clientStore sdk.KVStore) sdk.KVStore
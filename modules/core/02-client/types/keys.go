@@ -24,6 +24,10 @@ const (
 	// KeyNextClientSequence is the key used to store the next client sequence in
 	// the keeper.
 	KeyNextClientSequence = "nextClientSequence"
+
+	// KeyConsensusStatePruningSequence is the key used to store the sequence of the client
+	// whose expired consensus states are pruned next in the keeper.
+	KeyConsensusStatePruningSequence = "consensusStatePruningSequence"
 )
 
 // FormatClientIdentifier returns the client identifier with the sequence appended.
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -13,20 +14,33 @@ import (
 const (
 	TypeMsgCreateClient       string = "create_client"
 	TypeMsgUpdateClient       string = "update_client"
+	TypeMsgBatchUpdateClient  string = "batch_update_client"
 	TypeMsgUpgradeClient      string = "upgrade_client"
 	TypeMsgSubmitMisbehaviour string = "submit_misbehaviour"
+	TypeMsgRecoverClient      string = "recover_client"
+	TypeMsgIBCSoftwareUpgrade string = "ibc_software_upgrade"
 )
 
+// MaxBatchUpdateHeaders is the maximum number of headers of a MsgBatchUpdateClient. The
+// verification of the header signatures by the light clients is not metered, the bound
+// limits the work a single message can cause.
+const MaxBatchUpdateHeaders = 10
+
 var (
 	_ sdk.Msg = &MsgCreateClient{}
 	_ sdk.Msg = &MsgUpdateClient{}
+	_ sdk.Msg = &MsgBatchUpdateClient{}
 	_ sdk.Msg = &MsgSubmitMisbehaviour{}
 	_ sdk.Msg = &MsgUpgradeClient{}
+	_ sdk.Msg = &MsgRecoverClient{}
+	_ sdk.Msg = &MsgIBCSoftwareUpgrade{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgCreateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpdateClient{}
+	_ codectypes.UnpackInterfacesMessage = MsgBatchUpdateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgSubmitMisbehaviour{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpgradeClient{}
+	_ codectypes.UnpackInterfacesMessage = MsgIBCSoftwareUpgrade{}
 )
 
 // NewMsgCreateClient creates a new MsgCreateClient instance
@@ -151,6 +165,72 @@ func (msg MsgUpdateClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 	return unpacker.UnpackAny(msg.Header, &header)
 }
 
+// NewMsgBatchUpdateClient creates a new MsgBatchUpdateClient instance
+//nolint:interfacer
+func NewMsgBatchUpdateClient(id string, headers []exported.Header, signer string) (*MsgBatchUpdateClient, error) {
+	anyHeaders := make([]*codectypes.Any, len(headers))
+	for i, header := range headers {
+		anyHeader, err := PackHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		anyHeaders[i] = anyHeader
+	}
+
+	return &MsgBatchUpdateClient{
+		ClientId: id,
+		Headers:  anyHeaders,
+		Signer:   signer,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgBatchUpdateClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.Headers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "headers cannot be empty")
+	}
+	if len(msg.Headers) > MaxBatchUpdateHeaders {
+		return sdkerrors.Wrapf(ErrInvalidHeader, "number of headers %d exceeds maximum %d", len(msg.Headers), MaxBatchUpdateHeaders)
+	}
+	for i, anyHeader := range msg.Headers {
+		header, err := UnpackHeader(anyHeader)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "header %d", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "header %d", i)
+		}
+	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(ErrInvalidClient, "localhost client is only updated on ABCI BeginBlock")
+	}
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgBatchUpdateClient) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgBatchUpdateClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, anyHeader := range msg.Headers {
+		var header exported.Header
+		if err := unpacker.UnpackAny(anyHeader, &header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMsgUpgradeClient creates a new MsgUpgradeClient instance
 // nolint: interfacer
 func NewMsgUpgradeClient(clientID string, clientState exported.ClientState, consState exported.ConsensusState,
@@ -280,3 +360,91 @@ func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	var misbehaviour exported.Misbehaviour
 	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
 }
+
+// NewMsgRecoverClient creates a new MsgRecoverClient instance
+func NewMsgRecoverClient(subjectClientID, substituteClientID, signer string) *MsgRecoverClient {
+	return &MsgRecoverClient{
+		SubjectClientId:    subjectClientID,
+		SubstituteClientId: substituteClientID,
+		Signer:             signer,
+	}
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRecoverClient.
+func (msg MsgRecoverClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ClientIdentifierValidator(msg.SubjectClientId); err != nil {
+		return err
+	}
+	if err := host.ClientIdentifierValidator(msg.SubstituteClientId); err != nil {
+		return err
+	}
+	if msg.SubjectClientId == msg.SubstituteClientId {
+		return sdkerrors.Wrap(ErrInvalidSubstitute, "subject and substitute client identifiers are equal")
+	}
+
+	return nil
+}
+
+// GetSigners returns the single expected signer for a MsgRecoverClient.
+func (msg MsgRecoverClient) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgIBCSoftwareUpgrade creates a new MsgIBCSoftwareUpgrade instance
+func NewMsgIBCSoftwareUpgrade(signer string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (*MsgIBCSoftwareUpgrade, error) {
+	anyClient, err := PackClientState(upgradedClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgIBCSoftwareUpgrade{
+		Plan:                plan,
+		UpgradedClientState: anyClient,
+		Signer:              signer,
+	}, nil
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgIBCSoftwareUpgrade.
+func (msg MsgIBCSoftwareUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.UpgradedClientState == nil {
+		return sdkerrors.Wrap(ErrInvalidUpgradeClient, "upgraded client state cannot be nil")
+	}
+
+	if _, err := UnpackClientState(msg.UpgradedClientState); err != nil {
+		return sdkerrors.Wrap(err, "failed to unpack upgraded client state")
+	}
+
+	return nil
+}
+
+// GetSigners returns the single expected signer for a MsgIBCSoftwareUpgrade.
+func (msg MsgIBCSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgIBCSoftwareUpgrade) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var clientState exported.ClientState
+	return unpacker.UnpackAny(msg.UpgradedClientState, &clientState)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
@@ -326,6 +327,93 @@ func (suite *TypesTestSuite) TestMsgUpdateClient_ValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgBatchUpdateClient_ValidateBasic() {
+	var (
+		msg *types.MsgBatchUpdateClient
+		err error
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid - tendermint headers",
+			func() {},
+			true,
+		},
+		{
+			"invalid client-id",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"empty headers",
+			func() {
+				msg.Headers = nil
+			},
+			false,
+		},
+		{
+			"too many headers",
+			func() {
+				headers := make([]exported.Header, types.MaxBatchUpdateHeaders+1)
+				for i := range headers {
+					headers[i] = suite.chainA.CurrentTMClientHeader()
+				}
+				msg, err = types.NewMsgBatchUpdateClient("tendermint", headers, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"invalid tendermint header",
+			func() {
+				msg, err = types.NewMsgBatchUpdateClient("tendermint", []exported.Header{suite.chainA.CurrentTMClientHeader(), &ibctmtypes.Header{}}, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"failed to unpack header",
+			func() {
+				msg.Headers[1] = nil
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+		{
+			"unsupported - localhost",
+			func() {
+				msg.ClientId = exported.Localhost
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg, err = types.NewMsgBatchUpdateClient("tendermint", []exported.Header{suite.chainA.CurrentTMClientHeader(), suite.chainA.CurrentTMClientHeader()}, suite.chainA.SenderAccount.GetAddress().String())
+		suite.Require().NoError(err)
+
+		tc.malleate()
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestMarshalMsgUpgradeClient() {
 	var (
 		msg *types.MsgUpgradeClient
@@ -617,3 +705,122 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgRecoverClient_ValidateBasic() {
+	var msg *types.MsgRecoverClient
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid subject client-id",
+			func() {
+				msg.SubjectClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid substitute client-id",
+			func() {
+				msg.SubstituteClientId = ""
+			},
+			false,
+		},
+		{
+			"subject and substitute are equal",
+			func() {
+				msg.SubstituteClientId = msg.SubjectClientId
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg = types.NewMsgRecoverClient("07-tendermint-0", "07-tendermint-1", suite.chainA.SenderAccount.GetAddress().String())
+
+		tc.malleate()
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgIBCSoftwareUpgrade_ValidateBasic() {
+	var msg *types.MsgIBCSoftwareUpgrade
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid plan",
+			func() {
+				msg.Plan.Height = 0
+			},
+			false,
+		},
+		{
+			"upgraded client state is nil",
+			func() {
+				msg.UpgradedClientState = nil
+			},
+			false,
+		},
+		{
+			"upgraded client state is not a client state",
+			func() {
+				consensusState, err := types.PackConsensusState(&ibctmtypes.ConsensusState{})
+				suite.Require().NoError(err)
+				msg.UpgradedClientState = consensusState
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		plan := upgradetypes.Plan{Name: "upgrade IBC clients", Height: 1000}
+		clientState := ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+
+		var err error
+		msg, err = types.NewMsgIBCSoftwareUpgrade(suite.chainA.SenderAccount.GetAddress().String(), plan, clientState)
+		suite.Require().NoError(err)
+
+		tc.malleate()
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -13,8 +14,21 @@ var (
 	// DefaultAllowedClients are "06-solomachine" and "07-tendermint"
 	DefaultAllowedClients = []string{exported.Solomachine, exported.Tendermint}
 
+	// MonotonicHeightClientTypes are "06-solomachine" and "08-wasm", the client types of counterparties whose heights
+	// may not be revision-formatted. Only these client types may be set as monotonic height clients.
+	MonotonicHeightClientTypes = []string{exported.Solomachine, exported.Wasm}
+
 	// KeyAllowedClients is store's key for AllowedClients Params
 	KeyAllowedClients = []byte("AllowedClients")
+
+	// KeyBatchedUpdateClients is store's key for BatchedUpdateClients Params
+	KeyBatchedUpdateClients = []byte("BatchedUpdateClients")
+
+	// KeyMonotonicHeightClients is store's key for MonotonicHeightClients Params
+	KeyMonotonicHeightClients = []byte("MonotonicHeightClients")
+
+	// KeyConsensusStatePruningGasLimit is store's key for ConsensusStatePruningGasLimit Params
+	KeyConsensusStatePruningGasLimit = []byte("ConsensusStatePruningGasLimit")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -36,13 +50,28 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	if err := validateBatchedUpdateClients(p.BatchedUpdateClients); err != nil {
+		return err
+	}
+
+	if err := validateMonotonicHeightClients(p.MonotonicHeightClients); err != nil {
+		return err
+	}
+
+	return validateConsensusStatePruningGasLimit(p.ConsensusStatePruningGasLimit)
 }
 
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedClients, p.AllowedClients, validateClients),
+		paramtypes.NewParamSetPair(KeyBatchedUpdateClients, &p.BatchedUpdateClients, validateBatchedUpdateClients),
+		paramtypes.NewParamSetPair(KeyMonotonicHeightClients, &p.MonotonicHeightClients, validateMonotonicHeightClients),
+		paramtypes.NewParamSetPair(KeyConsensusStatePruningGasLimit, &p.ConsensusStatePruningGasLimit, validateConsensusStatePruningGasLimit),
 	}
 }
 
@@ -56,6 +85,28 @@ func (p Params) IsAllowedClient(clientType string) bool {
 	return false
 }
 
+// IsBatchedUpdateClient checks if the updates of the given client are queued and applied at
+// the end of the block.
+func (p Params) IsBatchedUpdateClient(clientID string) bool {
+	for _, batchedClient := range p.BatchedUpdateClients {
+		if batchedClient == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMonotonicHeightClient checks if the heights of the given client type are a single monotonic
+// counter rather than revision-formatted.
+func (p Params) IsMonotonicHeightClient(clientType string) bool {
+	for _, monotonicClient := range p.MonotonicHeightClients {
+		if monotonicClient == clientType {
+			return true
+		}
+	}
+	return false
+}
+
 func validateClients(i interface{}) error {
 	clients, ok := i.([]string)
 	if !ok {
@@ -70,3 +121,71 @@ func validateClients(i interface{}) error {
 
 	return nil
 }
+
+func validateBatchedUpdateClients(i interface{}) error {
+	clientIDs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, clientID := range clientIDs {
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return err
+		}
+
+		if clientID == exported.Localhost {
+			return fmt.Errorf("updates of the %s client cannot be batched", exported.Localhost)
+		}
+
+		if seen[clientID] {
+			return fmt.Errorf("duplicate batched update client %s", clientID)
+		}
+		seen[clientID] = true
+	}
+
+	return nil
+}
+
+func validateMonotonicHeightClients(i interface{}) error {
+	clients, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for i, clientType := range clients {
+		if strings.TrimSpace(clientType) == "" {
+			return fmt.Errorf("client type %d cannot be blank", i)
+		}
+
+		if !isMonotonicHeightClientType(clientType) {
+			return fmt.Errorf("client type %s cannot have monotonic heights, expected one of %v", clientType, MonotonicHeightClientTypes)
+		}
+
+		if seen[clientType] {
+			return fmt.Errorf("duplicate monotonic height client type %s", clientType)
+		}
+		seen[clientType] = true
+	}
+
+	return nil
+}
+
+func isMonotonicHeightClientType(clientType string) bool {
+	for _, monotonicClientType := range MonotonicHeightClientTypes {
+		if clientType == monotonicClientType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateConsensusStatePruningGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -17,6 +17,16 @@ func TestValidateParams(t *testing.T) {
 		{"default params", DefaultParams(), true},
 		{"custom params", NewParams(exported.Tendermint), true},
 		{"blank client", NewParams(" "), false},
+		{"batched update clients", Params{AllowedClients: DefaultAllowedClients, BatchedUpdateClients: []string{"07-tendermint-0", "07-tendermint-1"}}, true},
+		{"invalid batched update client", Params{AllowedClients: DefaultAllowedClients, BatchedUpdateClients: []string{"(client)"}}, false},
+		{"batched localhost updates", Params{AllowedClients: DefaultAllowedClients, BatchedUpdateClients: []string{exported.Localhost}}, false},
+		{"duplicate batched update client", Params{AllowedClients: DefaultAllowedClients, BatchedUpdateClients: []string{"07-tendermint-0", "07-tendermint-0"}}, false},
+		{"monotonic height clients", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{exported.Solomachine}}, true},
+		{"wasm monotonic height client", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{exported.Wasm}}, true},
+		{"tendermint monotonic height client", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{exported.Tendermint}}, false},
+		{"localhost monotonic height client", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{exported.Localhost}}, false},
+		{"blank monotonic height client", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{" "}}, false},
+		{"duplicate monotonic height client", Params{AllowedClients: DefaultAllowedClients, MonotonicHeightClients: []string{exported.Solomachine, exported.Solomachine}}, false},
 	}
 
 	for _, tc := range testCases {
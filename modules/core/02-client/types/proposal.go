@@ -14,17 +14,21 @@ import (
 const (
 	// ProposalTypeClientUpdate defines the type for a ClientUpdateProposal
 	ProposalTypeClientUpdate = "ClientUpdate"
-	ProposalTypeUpgrade      = "IBCUpgrade"
+	// ProposalTypeClientArchive defines the type for a ClientArchiveProposal
+	ProposalTypeClientArchive = "ClientArchive"
+	ProposalTypeUpgrade       = "IBCUpgrade"
 )
 
 var (
 	_ govtypes.Content                   = &ClientUpdateProposal{}
+	_ govtypes.Content                   = &ClientArchiveProposal{}
 	_ govtypes.Content                   = &UpgradeProposal{}
 	_ codectypes.UnpackInterfacesMessage = &UpgradeProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeClientUpdate)
+	govtypes.RegisterProposalType(ProposalTypeClientArchive)
 	govtypes.RegisterProposalType(ProposalTypeUpgrade)
 }
 
@@ -70,6 +74,44 @@ func (cup *ClientUpdateProposal) ValidateBasic() error {
 	return nil
 }
 
+// NewClientArchiveProposal creates a new client archive proposal.
+func NewClientArchiveProposal(title, description, clientID string) govtypes.Content {
+	return &ClientArchiveProposal{
+		Title:       title,
+		Description: description,
+		ClientId:    clientID,
+	}
+}
+
+// GetTitle returns the title of a client archive proposal.
+func (cp *ClientArchiveProposal) GetTitle() string { return cp.Title }
+
+// GetDescription returns the description of a client archive proposal.
+func (cp *ClientArchiveProposal) GetDescription() string { return cp.Description }
+
+// ProposalRoute returns the routing key of a client archive proposal.
+func (cp *ClientArchiveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a client archive proposal.
+func (cp *ClientArchiveProposal) ProposalType() string { return ProposalTypeClientArchive }
+
+// ValidateBasic runs basic stateless validity checks
+func (cp *ClientArchiveProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cp)
+	if err != nil {
+		return err
+	}
+
+	if cp.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(ErrInvalidArchiveClientProposal, "cannot archive localhost client")
+	}
+	if _, _, err := ParseClientIdentifier(cp.ClientId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpgradeProposal creates a new IBC breaking upgrade proposal.
 func NewUpgradeProposal(title, description string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (govtypes.Content, error) {
 	any, err := PackClientState(upgradedClientState)
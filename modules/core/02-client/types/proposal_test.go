@@ -9,6 +9,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -52,6 +53,26 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, ibctesting.InvalidID),
 			false,
 		},
+		{
+			"success: archive proposal",
+			types.NewClientArchiveProposal(ibctesting.Title, ibctesting.Description, subject),
+			true,
+		},
+		{
+			"archive proposal fails validate abstract - empty title",
+			types.NewClientArchiveProposal("", ibctesting.Description, subject),
+			false,
+		},
+		{
+			"archive proposal with invalid clientID",
+			types.NewClientArchiveProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID),
+			false,
+		},
+		{
+			"archive proposal for localhost client",
+			types.NewClientArchiveProposal(ibctesting.Title, ibctesting.Description, exported.Localhost),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
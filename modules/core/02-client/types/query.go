@@ -11,6 +11,8 @@ var (
 	_ codectypes.UnpackInterfacesMessage = QueryClientStatesResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStateResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStatesResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryUpgradedClientStateResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryUpgradedConsensusStateResponse{}
 )
 
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
@@ -64,3 +66,35 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// NewQueryUpgradedClientStateResponse creates a new QueryUpgradedClientStateResponse instance.
+func NewQueryUpgradedClientStateResponse(
+	clientStateAny *codectypes.Any, proof []byte, height Height,
+) *QueryUpgradedClientStateResponse {
+	return &QueryUpgradedClientStateResponse{
+		UpgradedClientState: clientStateAny,
+		Proof:               proof,
+		ProofHeight:         height,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qucsr QueryUpgradedClientStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qucsr.UpgradedClientState, new(exported.ClientState))
+}
+
+// NewQueryUpgradedConsensusStateResponse creates a new QueryUpgradedConsensusStateResponse instance.
+func NewQueryUpgradedConsensusStateResponse(
+	consensusStateAny *codectypes.Any, proof []byte, height Height,
+) *QueryUpgradedConsensusStateResponse {
+	return &QueryUpgradedConsensusStateResponse{
+		UpgradedConsensusState: consensusStateAny,
+		Proof:                  proof,
+		ProofHeight:            height,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qucsr QueryUpgradedConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qucsr.UpgradedConsensusState, new(exported.ConsensusState))
+}
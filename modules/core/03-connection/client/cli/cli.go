@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
@@ -13,12 +14,14 @@ func GetQueryCmd() *cobra.Command {
 		Short:                      "IBC connection query subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	queryCmd.AddCommand(
 		GetCmdQueryConnections(),
 		GetCmdQueryConnection(),
 		GetCmdQueryClientConnections(),
+		GetCmdQueryConnectionHandshakeStep(),
 	)
 
 	return queryCmd
@@ -116,3 +116,45 @@ func GetCmdQueryClientConnections() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryConnectionHandshakeStep defines the command to query the next message of the
+// handshake of a connection.
+func GetCmdQueryConnectionHandshakeStep() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handshake-step [connection-id] [counterparty-state]",
+		Short: "Query the next handshake message of a connection",
+		Long: `Query the next handshake message of a connection, the chain it must be submitted to and the height of its proofs.
+The counterparty state is the state of the counterparty connection end as queried on the counterparty chain,
+STATE_UNINITIALIZED_UNSPECIFIED if the counterparty connection end does not exist.`,
+		Example: fmt.Sprintf("%s query %s %s handshake-step [connection-id] STATE_INIT", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			counterpartyState, ok := types.State_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid counterparty connection state %s", args[1])
+			}
+
+			req := &types.QueryConnectionHandshakeStepRequest{
+				ConnectionId:      args[0],
+				CounterpartyState: types.State(counterpartyState),
+			}
+
+			res, err := queryClient.ConnectionHandshakeStep(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,13 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -53,7 +53,7 @@ func (q Keeper) Connections(c context.Context, req *types.QueryConnectionsReques
 	connections := []*types.IdentifiedConnection{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyConnectionPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
 		var result types.ConnectionEnd
 		if err := q.cdc.Unmarshal(value, &result); err != nil {
 			return err
@@ -177,3 +177,52 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *types.QueryConn
 	proofHeight := clienttypes.GetSelfHeight(ctx)
 	return types.NewQueryConnectionConsensusStateResponse(connection.ClientId, anyConsensusState, height, nil, proofHeight), nil
 }
+
+// ConnectionHandshakeStep implements the Query/ConnectionHandshakeStep gRPC method
+func (q Keeper) ConnectionHandshakeStep(c context.Context, req *types.QueryConnectionHandshakeStepRequest) (*types.QueryConnectionHandshakeStepResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connection, found := q.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConnectionNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	msgTypeURL, submitToCounterparty, err := types.NextHandshakeStep(connection.State, req.CounterpartyState)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	height := clienttypes.GetSelfHeight(ctx)
+
+	// proofs of this chain queried at the current height are verified at the next height
+	proofHeight := height.Increment().(clienttypes.Height)
+	if !submitToCounterparty {
+		clientState, found := q.clientKeeper.GetClientState(ctx, connection.ClientId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId).Error(),
+			)
+		}
+
+		proofHeight = clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), clientState.GetLatestHeight().GetRevisionHeight())
+	}
+
+	return &types.QueryConnectionHandshakeStepResponse{
+		NextMsgTypeUrl:       msgTypeURL,
+		SubmitToCounterparty: submitToCounterparty,
+		ProofHeight:          proofHeight,
+		Height:               height,
+	}, nil
+}
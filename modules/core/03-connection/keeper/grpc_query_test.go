@@ -436,3 +436,54 @@ func (suite *KeeperTestSuite) TestQueryConnectionConsensusState() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryConnectionHandshakeStep() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	queryStep := func(endpoint *ibctesting.Endpoint, counterpartyState types.State) *types.QueryConnectionHandshakeStepResponse {
+		res, err := endpoint.Chain.QueryServer.ConnectionHandshakeStep(sdk.WrapSDKContext(endpoint.Chain.GetContext()), &types.QueryConnectionHandshakeStepRequest{
+			ConnectionId:      endpoint.ConnectionID,
+			CounterpartyState: counterpartyState,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(clienttypes.GetSelfHeight(endpoint.Chain.GetContext()), res.Height)
+		return res
+	}
+
+	// proofs of the queried chain are verified at the height following the query height
+	requireStepOnCounterparty := func(res *types.QueryConnectionHandshakeStepResponse, msg sdk.Msg) {
+		suite.Require().Equal(sdk.MsgTypeURL(msg), res.NextMsgTypeUrl)
+		suite.Require().True(res.SubmitToCounterparty)
+		suite.Require().Equal(res.Height.Increment(), res.ProofHeight)
+	}
+
+	// proofs of the counterparty chain are verified at the latest height of the client
+	requireStepOnSelf := func(endpoint *ibctesting.Endpoint, res *types.QueryConnectionHandshakeStepResponse, msg sdk.Msg) {
+		suite.Require().Equal(sdk.MsgTypeURL(msg), res.NextMsgTypeUrl)
+		suite.Require().False(res.SubmitToCounterparty)
+		suite.Require().Equal(endpoint.GetClientState().GetLatestHeight(), res.ProofHeight)
+	}
+
+	_, err := suite.chainA.QueryServer.ConnectionHandshakeStep(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryConnectionHandshakeStepRequest{ConnectionId: ibctesting.FirstConnectionID})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(path.EndpointA.ConnOpenInit())
+	requireStepOnCounterparty(queryStep(path.EndpointA, types.UNINITIALIZED), &types.MsgConnectionOpenTry{})
+
+	suite.Require().NoError(path.EndpointB.ConnOpenTry())
+	requireStepOnSelf(path.EndpointA, queryStep(path.EndpointA, types.TRYOPEN), &types.MsgConnectionOpenAck{})
+	requireStepOnCounterparty(queryStep(path.EndpointB, types.INIT), &types.MsgConnectionOpenAck{})
+
+	suite.Require().NoError(path.EndpointA.ConnOpenAck())
+	requireStepOnSelf(path.EndpointB, queryStep(path.EndpointB, types.OPEN), &types.MsgConnectionOpenConfirm{})
+	requireStepOnCounterparty(queryStep(path.EndpointA, types.TRYOPEN), &types.MsgConnectionOpenConfirm{})
+
+	suite.Require().NoError(path.EndpointB.ConnOpenConfirm())
+	res := queryStep(path.EndpointA, types.OPEN)
+	suite.Require().Empty(res.NextMsgTypeUrl)
+
+	// an open connection end cannot have an uninitialized counterparty
+	_, err = suite.chainA.QueryServer.ConnectionHandshakeStep(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryConnectionHandshakeStepRequest{ConnectionId: path.EndpointA.ConnectionID})
+	suite.Require().Error(err)
+}
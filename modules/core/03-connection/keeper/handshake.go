@@ -35,6 +35,11 @@ func (k Keeper) ConnOpenInit(
 		versions = []exported.Version{version}
 	}
 
+	// the counterparty of a connection over the localhost client is the running chain
+	if clientID == exported.Localhost && counterparty.ClientId != exported.Localhost {
+		return "", sdkerrors.Wrapf(types.ErrInvalidCounterparty, "counterparty client of a connection over the %s client must be %s", exported.Localhost, exported.Localhost)
+	}
+
 	// connection defines chain A's ConnectionEnd
 	connectionID := k.GenerateConnectionIdentifier(ctx)
 	connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, types.ExportedVersionsToProto(versions), delayPeriod)
@@ -81,6 +86,11 @@ func (k Keeper) ConnOpenTry(
 		found              bool
 	)
 
+	// the counterparty of a connection over the localhost client is the running chain
+	if clientID == exported.Localhost && counterparty.ClientId != exported.Localhost {
+		return "", sdkerrors.Wrapf(types.ErrInvalidCounterparty, "counterparty client of a connection over the %s client must be %s", exported.Localhost, exported.Localhost)
+	}
+
 	// empty connection identifier indicates continuing a previous connection handshake
 	if previousConnectionID != "" {
 		// ensure that the previous connection exists
@@ -114,22 +124,9 @@ func (k Keeper) ConnOpenTry(
 		connectionID = k.GenerateConnectionIdentifier(ctx)
 	}
 
-	selfHeight := clienttypes.GetSelfHeight(ctx)
-	if consensusHeight.GTE(selfHeight) {
-		return "", sdkerrors.Wrapf(
-			sdkerrors.ErrInvalidHeight,
-			"consensus height is greater than or equal to the current block height (%s >= %s)", consensusHeight, selfHeight,
-		)
-	}
-
-	// validate client parameters of a chainB client stored on chainA
-	if err := k.clientKeeper.ValidateSelfClient(ctx, clientState); err != nil {
-		return "", err
-	}
-
-	expectedConsensusState, err := k.clientKeeper.GetSelfConsensusState(ctx, consensusHeight)
+	expectedConsensusState, err := k.validateSelfClient(ctx, clientID, clientState, consensusHeight)
 	if err != nil {
-		return "", sdkerrors.Wrapf(err, "self consensus state not found for height %s", consensusHeight.String())
+		return "", err
 	}
 
 	// expectedConnection defines Chain A's ConnectionEnd
@@ -208,15 +205,6 @@ func (k Keeper) ConnOpenAck(
 	proofHeight exported.Height, // height that relayer constructed proofTry
 	consensusHeight exported.Height, // latest height of chainA that chainB has stored on its chainA client
 ) error {
-	// Check that chainB client hasn't stored invalid height
-	selfHeight := clienttypes.GetSelfHeight(ctx)
-	if consensusHeight.GTE(selfHeight) {
-		return sdkerrors.Wrapf(
-			sdkerrors.ErrInvalidHeight,
-			"consensus height is greater than or equal to the current block height (%s >= %s)", consensusHeight, selfHeight,
-		)
-	}
-
 	// Retrieve connection
 	connection, found := k.GetConnection(ctx, connectionID)
 	if !found {
@@ -248,15 +236,10 @@ func (k Keeper) ConnOpenAck(
 		)
 	}
 
-	// validate client parameters of a chainA client stored on chainB
-	if err := k.clientKeeper.ValidateSelfClient(ctx, clientState); err != nil {
-		return err
-	}
-
-	// Retrieve chainA's consensus state at consensusheight
-	expectedConsensusState, err := k.clientKeeper.GetSelfConsensusState(ctx, consensusHeight)
+	// validate the chainA client stored on chainB and retrieve chainA's consensus state at consensusHeight
+	expectedConsensusState, err := k.validateSelfClient(ctx, connection.ClientId, clientState, consensusHeight)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "self consensus state not found for height %s", consensusHeight.String())
+		return err
 	}
 
 	prefix := k.GetCommitmentPrefix()
@@ -349,3 +332,57 @@ func (k Keeper) ConnOpenConfirm(
 
 	return nil
 }
+
+// validateSelfClient validates the client of the running chain stored on the counterparty
+// chain of a connection over the given client, and returns the consensus state of the running
+// chain at the given consensus height stored on the counterparty client. The counterparty of
+// a connection over the localhost client is the running chain itself, so the client state must
+// be the stored localhost client and the consensus height its latest height. No consensus state
+// is returned for the localhost client since it does not store consensus states.
+func (k Keeper) validateSelfClient(
+	ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeight exported.Height,
+) (exported.ConsensusState, error) {
+	if clientID == exported.Localhost {
+		localhostClient, found := k.clientKeeper.GetClientState(ctx, exported.Localhost)
+		if !found {
+			return nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, exported.Localhost)
+		}
+
+		if !proto.Equal(localhostClient, clientState) {
+			return nil, sdkerrors.Wrapf(
+				clienttypes.ErrInvalidClient,
+				"client state is not the stored %s client: \n%v\n≠\n%v", exported.Localhost, clientState, localhostClient,
+			)
+		}
+
+		if !consensusHeight.EQ(localhostClient.GetLatestHeight()) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidHeight,
+				"consensus height is not the latest height of the %s client (%s != %s)", exported.Localhost, consensusHeight, localhostClient.GetLatestHeight(),
+			)
+		}
+
+		return nil, nil
+	}
+
+	// Check that the counterparty client hasn't stored invalid height
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if consensusHeight.GTE(selfHeight) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"consensus height is greater than or equal to the current block height (%s >= %s)", consensusHeight, selfHeight,
+		)
+	}
+
+	// validate client parameters of the running chain client stored on the counterparty chain
+	if err := k.clientKeeper.ValidateSelfClient(ctx, clientState); err != nil {
+		return nil, err
+	}
+
+	expectedConsensusState, err := k.clientKeeper.GetSelfConsensusState(ctx, consensusHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "self consensus state not found for height %s", consensusHeight.String())
+	}
+
+	return expectedConsensusState, nil
+}
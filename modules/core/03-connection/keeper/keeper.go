@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+var _ types.ConnectionKeeper = Keeper{}
+
 // Keeper defines the IBC connection keeper
 type Keeper struct {
 	// implements gRPC QueryServer interface
@@ -196,6 +198,10 @@ func (k Keeper) addConnectionToClient(ctx sdk.Context, clientID, connectionID st
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
+	if k.clientKeeper.IsClientArchived(ctx, clientID) {
+		return sdkerrors.Wrapf(clienttypes.ErrClientArchived, "cannot open a new connection on client %s", clientID)
+	}
+
 	conns, found := k.GetClientConnectionPaths(ctx, clientID)
 	if !found {
 		conns = []string{}
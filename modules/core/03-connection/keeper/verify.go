@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"math"
+	"time"
 
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -31,6 +37,8 @@ func (k Keeper) VerifyClientState(
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
+	defer reportVerification(ctx, targetClient.ClientType(), "client-state", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := targetClient.VerifyClientState(
 		clientStore, k.cdc, height,
 		connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState); err != nil {
@@ -62,6 +70,8 @@ func (k Keeper) VerifyClientConsensusState(
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
+	defer reportVerification(ctx, clientState.ClientType(), "consensus-state", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyClientConsensusState(
 		clientStore, k.cdc, height,
 		connection.GetCounterparty().GetClientID(), consensusHeight, connection.GetCounterparty().GetPrefix(), proof, consensusState,
@@ -83,7 +93,7 @@ func (k Keeper) VerifyConnectionState(
 	connectionEnd exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -94,6 +104,8 @@ func (k Keeper) VerifyConnectionState(
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
+	defer reportVerification(ctx, clientState.ClientType(), "connection-state", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyConnectionState(
 		clientStore, k.cdc, height,
 		connection.GetCounterparty().GetPrefix(), proof, connectionID, connectionEnd,
@@ -116,7 +128,7 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -127,6 +139,8 @@ func (k Keeper) VerifyChannelState(
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
+	defer reportVerification(ctx, clientState.ClientType(), "channel-state", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyChannelState(
 		clientStore, k.cdc, height,
 		connection.GetCounterparty().GetPrefix(), proof,
@@ -138,6 +152,92 @@ func (k Keeper) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed for the specified
+// channel end, under the specified port, stored on the target machine. The client
+// of the connection must implement the ChannelUpgradeVerifier interface.
+func (k Keeper) VerifyChannelUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade codec.ProtoMarshaler,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.stateVerificationStore(ctx, clientID)
+
+	verifier, clientType, err := k.getChannelUpgradeVerifier(ctx, clientStore, clientID)
+	if err != nil {
+		return err
+	}
+
+	defer reportVerification(ctx, clientType, "channel-upgrade", time.Now(), ctx.GasMeter().GasConsumed())
+
+	if err := verifier.VerifyChannelUpgrade(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, upgrade,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the error receipt of the last aborted
+// upgrade of the specified channel end, under the specified port, stored on the target
+// machine. The client of the connection must implement the ChannelUpgradeVerifier interface.
+func (k Keeper) VerifyChannelUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt codec.ProtoMarshaler,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.stateVerificationStore(ctx, clientID)
+
+	verifier, clientType, err := k.getChannelUpgradeVerifier(ctx, clientStore, clientID)
+	if err != nil {
+		return err
+	}
+
+	defer reportVerification(ctx, clientType, "channel-upgrade-error", time.Now(), ctx.GasMeter().GasConsumed())
+
+	if err := verifier.VerifyChannelUpgradeError(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, errorReceipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade error verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// getChannelUpgradeVerifier returns the client state of the given active client as a
+// ChannelUpgradeVerifier together with its client type.
+func (k Keeper) getChannelUpgradeVerifier(ctx sdk.Context, clientStore sdk.KVStore, clientID string) (exported.ChannelUpgradeVerifier, string, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, "", sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return nil, "", sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	verifier, ok := clientState.(exported.ChannelUpgradeVerifier)
+	if !ok {
+		return nil, "", sdkerrors.Wrapf(clienttypes.ErrChannelUpgradeVerificationUnsupported, "client type %s", clientState.ClientType())
+	}
+
+	return verifier, clientState.ClientType(), nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (k Keeper) VerifyPacketCommitment(
@@ -151,7 +251,7 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -166,8 +266,10 @@ func (k Keeper) VerifyPacketCommitment(
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
+	defer reportVerification(ctx, clientState.ClientType(), "packet-commitment", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyPacketCommitment(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.WithHostTimeOracle(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence, commitmentBytes,
@@ -191,7 +293,7 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -206,8 +308,10 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
+	defer reportVerification(ctx, clientState.ClientType(), "packet-acknowledgement", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyPacketAcknowledgement(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.WithHostTimeOracle(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence, acknowledgement,
@@ -231,7 +335,7 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -246,8 +350,10 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
+	defer reportVerification(ctx, clientState.ClientType(), "packet-receipt-absence", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyPacketReceiptAbsence(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.WithHostTimeOracle(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence,
@@ -270,7 +376,7 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientStore := k.stateVerificationStore(ctx, clientID)
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
 	if !found {
@@ -285,8 +391,10 @@ func (k Keeper) VerifyNextSequenceRecv(
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
+	defer reportVerification(ctx, clientState.ClientType(), "next-sequence-recv", time.Now(), ctx.GasMeter().GasConsumed())
+
 	if err := clientState.VerifyNextSequenceRecv(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.WithHostTimeOracle(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		nextSequenceRecv,
@@ -311,3 +419,30 @@ func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI)
 	timeDelay := connection.GetDelayPeriod()
 	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
+
+// reportVerification records the time and gas spent by a light client to verify a proof,
+// labelled by the client type and the verified state.
+func reportVerification(ctx sdk.Context, clientType, verification string, start time.Time, gasBefore sdk.Gas) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(types.LabelClientType, clientType),
+		telemetry.NewLabel(types.LabelVerification, verification),
+	}
+
+	metrics.MeasureSinceWithLabels([]string{"ibc", "client", "verify"}, start.UTC(), labels)
+	metrics.AddSampleWithLabels(
+		[]string{"ibc", "client", "verify", "gas"},
+		float32(ctx.GasMeter().GasConsumed()-gasBefore),
+		labels,
+	)
+}
+
+// stateVerificationStore returns the store provided to the given client to verify the state
+// of the counterparty chain. The localhost client verifies the state of the running chain
+// directly and is provided the IBC store instead of its client store.
+func (k Keeper) stateVerificationStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	if clientID == exported.Localhost {
+		return ctx.KVStore(k.storeKey)
+	}
+
+	return k.clientKeeper.ClientStore(ctx, clientID)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
@@ -126,3 +127,32 @@ func (ic IdentifiedConnection) ValidateBasic() error {
 	connection := NewConnectionEnd(ic.State, ic.ClientId, ic.Counterparty, ic.Versions, ic.DelayPeriod)
 	return connection.ValidateBasic()
 }
+
+// NextHandshakeStep returns the type URL of the next message of the handshake of a connection
+// whose connection end is in the provided state and whose counterparty connection end is in the
+// provided counterparty state. The returned bool is true if the message must be submitted to
+// the counterparty chain with proofs of the connection end, and false if it must be submitted
+// to the chain of the connection end with proofs of the counterparty connection end. An empty
+// type URL is returned if the handshake is complete. Every handshake message advances only
+// one of the connection ends, hence both states are required to determine the next message.
+func NextHandshakeStep(state, counterpartyState State) (string, bool, error) {
+	switch {
+	case state == INIT && counterpartyState == UNINITIALIZED:
+		return sdk.MsgTypeURL(&MsgConnectionOpenTry{}), true, nil
+	case state == INIT && counterpartyState == TRYOPEN:
+		return sdk.MsgTypeURL(&MsgConnectionOpenAck{}), false, nil
+	case state == TRYOPEN && counterpartyState == INIT:
+		return sdk.MsgTypeURL(&MsgConnectionOpenAck{}), true, nil
+	case state == TRYOPEN && counterpartyState == OPEN:
+		return sdk.MsgTypeURL(&MsgConnectionOpenConfirm{}), false, nil
+	case state == OPEN && counterpartyState == TRYOPEN:
+		return sdk.MsgTypeURL(&MsgConnectionOpenConfirm{}), true, nil
+	case state == OPEN && counterpartyState == OPEN:
+		return "", false, nil
+	default:
+		return "", false, sdkerrors.Wrapf(
+			ErrInvalidConnectionState,
+			"no handshake step for connection state %s and counterparty connection state %s", state, counterpartyState,
+		)
+	}
+}
@@ -14,4 +14,6 @@ type ClientKeeper interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 	IterateClients(ctx sdk.Context, cb func(string, exported.ClientState) bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	IsClientArchived(ctx sdk.Context, clientID string) bool
+	WithHostTimeOracle(ctx sdk.Context) sdk.Context
 }
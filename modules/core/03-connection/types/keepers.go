@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ReadOnlyConnectionKeeper defines the read-only methods of the IBC connection keeper. External
+// modules which only inspect connections, such as rate limiters or analytics modules, may depend
+// on this interface so that they can be wired without access to the methods mutating the
+// connection state.
+type ReadOnlyConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (ConnectionEnd, bool)
+	GetClientConnectionPaths(ctx sdk.Context, clientID string) ([]string, bool)
+	GetTimestampAtHeight(ctx sdk.Context, connection ConnectionEnd, height exported.Height) (uint64, error)
+	IterateConnections(ctx sdk.Context, cb func(IdentifiedConnection) bool)
+	GetCommitmentPrefix() exported.Prefix
+	GetParams(ctx sdk.Context) Params
+}
+
+// ConnectionKeeper defines the methods of the IBC connection keeper mutating the connection state
+// in addition to the read-only methods.
+type ConnectionKeeper interface {
+	ReadOnlyConnectionKeeper
+
+	ConnOpenInit(
+		ctx sdk.Context,
+		clientID string,
+		counterparty Counterparty,
+		version *Version,
+		delayPeriod uint64,
+	) (string, error)
+}
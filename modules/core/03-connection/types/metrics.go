@@ -0,0 +1,7 @@
+package types
+
+// Prometheus metric labels.
+const (
+	LabelClientType   = "client_type"
+	LabelVerification = "verification"
+)
@@ -617,6 +617,143 @@ func (m *QueryConnectionConsensusStateResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryConnectionHandshakeStepRequest is the request type for the
+// Query/ConnectionHandshakeStep RPC method
+type QueryConnectionHandshakeStepRequest struct {
+	// connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// state of the counterparty connection end as queried on the counterparty
+	// chain, UNINITIALIZED if the counterparty connection end does not exist
+	CounterpartyState State `protobuf:"varint,2,opt,name=counterparty_state,json=counterpartyState,proto3,enum=ibc.core.connection.v1.State" json:"counterparty_state,omitempty" yaml:"counterparty_state"`
+}
+
+func (m *QueryConnectionHandshakeStepRequest) Reset()         { *m = QueryConnectionHandshakeStepRequest{} }
+func (m *QueryConnectionHandshakeStepRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionHandshakeStepRequest) ProtoMessage()    {}
+func (*QueryConnectionHandshakeStepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{10}
+}
+func (m *QueryConnectionHandshakeStepRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionHandshakeStepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionHandshakeStepRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionHandshakeStepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionHandshakeStepRequest.Merge(m, src)
+}
+func (m *QueryConnectionHandshakeStepRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionHandshakeStepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionHandshakeStepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionHandshakeStepRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionHandshakeStepRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryConnectionHandshakeStepRequest) GetCounterpartyState() State {
+	if m != nil {
+		return m.CounterpartyState
+	}
+	return UNINITIALIZED
+}
+
+// QueryConnectionHandshakeStepResponse is the response type for the
+// Query/ConnectionHandshakeStep RPC method
+type QueryConnectionHandshakeStepResponse struct {
+	// type URL of the next handshake message, empty if the handshake is complete
+	NextMsgTypeUrl string `protobuf:"bytes,1,opt,name=next_msg_type_url,json=nextMsgTypeUrl,proto3" json:"next_msg_type_url,omitempty" yaml:"next_msg_type_url"`
+	// true if the next message must be submitted to the counterparty chain with
+	// proofs of this chain, false if it must be submitted to this chain with
+	// proofs of the counterparty chain
+	SubmitToCounterparty bool `protobuf:"varint,2,opt,name=submit_to_counterparty,json=submitToCounterparty,proto3" json:"submit_to_counterparty,omitempty" yaml:"submit_to_counterparty"`
+	// height of the proofs included in the next message. Proofs of this chain
+	// must be queried at the query height and are verified at the returned
+	// height. Proofs of the counterparty chain are verified at the latest height
+	// of the client of the connection, which must be updated first if the
+	// counterparty connection end changed after that height.
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	// query block height
+	Height types.Height `protobuf:"bytes,4,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryConnectionHandshakeStepResponse) Reset()         { *m = QueryConnectionHandshakeStepResponse{} }
+func (m *QueryConnectionHandshakeStepResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionHandshakeStepResponse) ProtoMessage()    {}
+func (*QueryConnectionHandshakeStepResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{11}
+}
+func (m *QueryConnectionHandshakeStepResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionHandshakeStepResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionHandshakeStepResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionHandshakeStepResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionHandshakeStepResponse.Merge(m, src)
+}
+func (m *QueryConnectionHandshakeStepResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionHandshakeStepResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionHandshakeStepResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionHandshakeStepResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionHandshakeStepResponse) GetNextMsgTypeUrl() string {
+	if m != nil {
+		return m.NextMsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryConnectionHandshakeStepResponse) GetSubmitToCounterparty() bool {
+	if m != nil {
+		return m.SubmitToCounterparty
+	}
+	return false
+}
+
+func (m *QueryConnectionHandshakeStepResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+func (m *QueryConnectionHandshakeStepResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
 func init() {
 	proto.RegisterType((*QueryConnectionRequest)(nil), "ibc.core.connection.v1.QueryConnectionRequest")
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.core.connection.v1.QueryConnectionResponse")
@@ -628,6 +765,8 @@ func init() {
 	proto.RegisterType((*QueryConnectionClientStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionClientStateResponse")
 	proto.RegisterType((*QueryConnectionConsensusStateRequest)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateRequest")
 	proto.RegisterType((*QueryConnectionConsensusStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateResponse")
+	proto.RegisterType((*QueryConnectionHandshakeStepRequest)(nil), "ibc.core.connection.v1.QueryConnectionHandshakeStepRequest")
+	proto.RegisterType((*QueryConnectionHandshakeStepResponse)(nil), "ibc.core.connection.v1.QueryConnectionHandshakeStepResponse")
 }
 
 func init() {
@@ -635,63 +774,76 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x38, 0xb9, 0xd3, 0x65, 0x1c, 0xee, 0x60, 0xe4, 0xbb, 0x33, 0x0b, 0x38, 0x61, 0x8f,
-	0x90, 0x1c, 0x70, 0x33, 0xe7, 0x58, 0x77, 0x3a, 0x8e, 0x18, 0x81, 0xa3, 0x40, 0xd2, 0x44, 0x61,
-	0x91, 0x28, 0x68, 0xa2, 0xdd, 0xf5, 0x64, 0xbd, 0x92, 0xbd, 0xe3, 0x78, 0xd6, 0x46, 0x56, 0x64,
-	0x21, 0xf1, 0x07, 0x40, 0xa2, 0xa1, 0xa1, 0xa5, 0xe0, 0x0f, 0x50, 0xd0, 0x51, 0xa5, 0x8c, 0x44,
-	0x93, 0x2a, 0x42, 0x0e, 0x2d, 0x0d, 0xbf, 0x00, 0xed, 0xcc, 0x38, 0x3b, 0x6b, 0xaf, 0x13, 0xc7,
-	0x22, 0xdd, 0xe6, 0xcd, 0x7b, 0xf3, 0xbe, 0xef, 0x7b, 0x6f, 0x3e, 0x07, 0x9a, 0xbe, 0xe3, 0x12,
-	0x97, 0xb5, 0x29, 0x71, 0x59, 0x10, 0x50, 0x37, 0xf4, 0x59, 0x40, 0xba, 0x25, 0x72, 0xd8, 0xa1,
-	0xed, 0x1e, 0x6e, 0xb5, 0x59, 0xc8, 0xd0, 0x03, 0xdf, 0x71, 0x71, 0x94, 0x83, 0xe3, 0x1c, 0xdc,
-	0x2d, 0x19, 0x79, 0x8f, 0x79, 0x4c, 0xa4, 0x90, 0xe8, 0x4b, 0x66, 0x1b, 0xef, 0xb9, 0x8c, 0x37,
-	0x19, 0x27, 0x8e, 0xcd, 0xa9, 0xbc, 0x86, 0x74, 0x4b, 0x0e, 0x0d, 0xed, 0x12, 0x69, 0xd9, 0x9e,
-	0x1f, 0xd8, 0xa2, 0x5c, 0xe6, 0x2e, 0xc5, 0xdd, 0x1b, 0x3e, 0x0d, 0xc2, 0xa8, 0xb3, 0xfc, 0x52,
-	0x09, 0xab, 0x13, 0xe0, 0x69, 0x40, 0x64, 0xe2, 0x9b, 0x1e, 0x63, 0x5e, 0x83, 0x12, 0xbb, 0xe5,
-	0x13, 0x3b, 0x08, 0x58, 0x28, 0xda, 0x70, 0x75, 0xfa, 0xba, 0x3a, 0x15, 0x7f, 0x39, 0x9d, 0x03,
-	0x62, 0x07, 0x8a, 0x9c, 0x59, 0x81, 0x0f, 0xbe, 0x88, 0x40, 0x6e, 0x5e, 0xdc, 0x68, 0xd1, 0xc3,
-	0x0e, 0xe5, 0x21, 0x7a, 0x04, 0x5f, 0x89, 0xdb, 0xec, 0xfb, 0xb5, 0x02, 0x58, 0x06, 0x6b, 0x0b,
-	0xd6, 0x62, 0x1c, 0xdc, 0xa9, 0x99, 0xbf, 0x03, 0xf8, 0x70, 0xac, 0x9e, 0xb7, 0x58, 0xc0, 0x29,
-	0xda, 0x82, 0x30, 0xce, 0x15, 0xd5, 0xb9, 0xf5, 0x15, 0x9c, 0x2e, 0x26, 0x8e, 0xeb, 0xb7, 0x82,
-	0x9a, 0xa5, 0x15, 0xa2, 0x3c, 0xbc, 0xd5, 0x6a, 0x33, 0x76, 0x50, 0xc8, 0x2e, 0x83, 0xb5, 0x45,
-	0x4b, 0xfe, 0x81, 0x36, 0xe1, 0xa2, 0xf8, 0xd8, 0xaf, 0x53, 0xdf, 0xab, 0x87, 0x85, 0x39, 0x71,
-	0xbd, 0xa1, 0x5d, 0x2f, 0x75, 0xec, 0x96, 0xf0, 0xb6, 0xc8, 0xa8, 0xce, 0x1f, 0x9f, 0x2d, 0x65,
-	0xac, 0x9c, 0xa8, 0x92, 0x21, 0xd3, 0x1e, 0x03, 0xcf, 0x87, 0xec, 0x3f, 0x83, 0x30, 0x1e, 0x97,
-	0x02, 0xff, 0x2e, 0x96, 0xb3, 0xc5, 0xd1, 0x6c, 0xb1, 0x5c, 0x11, 0x35, 0x5b, 0xbc, 0x67, 0x7b,
-	0x54, 0xd5, 0x5a, 0x5a, 0xa5, 0xf9, 0x0f, 0x80, 0x85, 0xf1, 0x1e, 0x4a, 0xa1, 0x5d, 0x98, 0x8b,
-	0x89, 0xf2, 0x02, 0x58, 0x9e, 0x5b, 0xcb, 0xad, 0x7f, 0x30, 0x49, 0xa2, 0x9d, 0x1a, 0x0d, 0x42,
-	0xff, 0xc0, 0xa7, 0x35, 0x4d, 0x6c, 0xfd, 0x02, 0xf4, 0x79, 0x02, 0x74, 0x56, 0x80, 0x5e, 0xbd,
-	0x12, 0xb4, 0x04, 0xa3, 0xa3, 0x46, 0x2f, 0xe0, 0xed, 0x6b, 0xea, 0xaa, 0xf2, 0xcd, 0x0d, 0xf8,
-	0x96, 0xa4, 0x2b, 0xd2, 0x52, 0x84, 0x7d, 0x03, 0x2e, 0xc8, 0x2b, 0xe2, 0x95, 0xba, 0x23, 0x03,
-	0x3b, 0x35, 0xf3, 0x17, 0x00, 0x8b, 0x93, 0xca, 0x95, 0x66, 0x8f, 0xe1, 0xab, 0xda, 0x5a, 0xb6,
-	0xec, 0xb0, 0x2e, 0x85, 0x5b, 0xb0, 0xee, 0xc5, 0xf1, 0xbd, 0x28, 0x7c, 0x93, 0x9b, 0xe3, 0xc0,
-	0xb7, 0x47, 0xa6, 0x2a, 0x11, 0x7f, 0x19, 0xda, 0xe1, 0x70, 0x0f, 0x50, 0x25, 0xf5, 0x05, 0x55,
-	0x0b, 0xff, 0x9e, 0x2d, 0xe5, 0x7b, 0x76, 0xb3, 0xf1, 0xd2, 0x4c, 0x1c, 0x9b, 0x23, 0x6f, 0x6b,
-	0x00, 0xa0, 0x79, 0x59, 0x13, 0x25, 0x88, 0x0d, 0x1f, 0xfa, 0x17, 0x9b, 0xb1, 0xaf, 0xb4, 0xe5,
-	0x51, 0x8a, 0x5a, 0xdb, 0xc7, 0x69, 0xd4, 0xb4, 0x65, 0xd2, 0xee, 0xbc, 0xef, 0xa7, 0x85, 0x6f,
-	0x52, 0xc8, 0xdf, 0x00, 0x7c, 0x67, 0x94, 0x64, 0x44, 0x2b, 0xe0, 0x1d, 0xfe, 0x3f, 0x8a, 0x89,
-	0x56, 0xe1, 0xbd, 0x36, 0xed, 0xfa, 0x3c, 0x3a, 0x0d, 0x3a, 0x4d, 0x87, 0xb6, 0x05, 0x99, 0x79,
-	0xeb, 0xee, 0x30, 0xbc, 0x2b, 0xa2, 0x89, 0x44, 0x8d, 0x98, 0x96, 0xa8, 0x90, 0x9f, 0x01, 0xb8,
-	0x72, 0x05, 0x72, 0x35, 0xa1, 0x0a, 0x8c, 0x56, 0x53, 0x9e, 0x24, 0x26, 0x93, 0xc7, 0xd2, 0x98,
-	0xf1, 0xd0, 0x98, 0xf1, 0xa7, 0x41, 0xcf, 0xba, 0xeb, 0x26, 0xae, 0x49, 0xbe, 0x98, 0x6c, 0xf2,
-	0xc5, 0xc4, 0xa3, 0x99, 0xbb, 0x6c, 0x34, 0xf3, 0x33, 0x8c, 0x66, 0xfd, 0xfb, 0x3b, 0xf0, 0x96,
-	0x20, 0x88, 0x7e, 0x05, 0x10, 0xc6, 0x2c, 0x11, 0x9e, 0xe4, 0x50, 0xe9, 0xbf, 0x24, 0x06, 0x99,
-	0x3a, 0x5f, 0x0a, 0x66, 0x7e, 0xf4, 0xdd, 0x9f, 0x7f, 0xff, 0x98, 0x7d, 0x86, 0xca, 0xe4, 0xca,
-	0xdf, 0x3f, 0x4e, 0x8e, 0x12, 0x73, 0xef, 0xa3, 0x9f, 0x01, 0xcc, 0x69, 0xc6, 0x81, 0xa6, 0xed,
-	0x3e, 0x74, 0x28, 0xe3, 0xe9, 0xf4, 0x05, 0x0a, 0xef, 0xfb, 0x02, 0xef, 0x0a, 0x7a, 0x34, 0x05,
-	0x5e, 0xf4, 0x07, 0x80, 0xaf, 0x8d, 0xd9, 0x1b, 0x7a, 0x76, 0x79, 0xd3, 0x09, 0x6e, 0x6a, 0x3c,
-	0xbf, 0x6e, 0x99, 0x42, 0xfc, 0xb1, 0x40, 0xfc, 0x02, 0x3d, 0x9f, 0x88, 0x58, 0x6e, 0x5c, 0x52,
-	0xe8, 0xe1, 0x16, 0xf6, 0xd1, 0x29, 0x80, 0xf7, 0x53, 0x6d, 0x09, 0x7d, 0x38, 0xa5, 0x7a, 0xe3,
-	0x7e, 0x69, 0xbc, 0x9c, 0xa5, 0x54, 0x11, 0xda, 0x16, 0x84, 0xaa, 0xe8, 0x93, 0x19, 0x56, 0x86,
-	0xe8, 0xa6, 0x89, 0x7e, 0xca, 0xc2, 0xc2, 0xa4, 0x27, 0x8d, 0x36, 0xa6, 0x85, 0x98, 0xe6, 0x61,
-	0x46, 0x65, 0xc6, 0x6a, 0xc5, 0xf1, 0x5b, 0xc1, 0xb1, 0x87, 0xbe, 0x99, 0x89, 0x63, 0xd2, 0x81,
-	0xc8, 0xd0, 0xcd, 0xc8, 0xd1, 0x88, 0x2f, 0xf6, 0x89, 0x34, 0x0d, 0xed, 0x40, 0x06, 0xfa, 0xd5,
-	0xaf, 0x8e, 0x07, 0x45, 0x70, 0x32, 0x28, 0x82, 0xbf, 0x06, 0x45, 0xf0, 0xc3, 0x79, 0x31, 0x73,
-	0x72, 0x5e, 0xcc, 0x9c, 0x9e, 0x17, 0x33, 0x5f, 0x6f, 0x78, 0x7e, 0x58, 0xef, 0x38, 0xd8, 0x65,
-	0x4d, 0xa2, 0xfe, 0x01, 0xf6, 0x1d, 0xf7, 0x89, 0xc7, 0x48, 0xb7, 0x4c, 0x9a, 0xac, 0xd6, 0x69,
-	0x50, 0x2e, 0x11, 0x3f, 0x2d, 0x3f, 0xd1, 0x40, 0x87, 0xbd, 0x16, 0xe5, 0xce, 0x6d, 0xe1, 0x7f,
-	0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xd7, 0xeb, 0x28, 0x8e, 0x0b, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x3a, 0x69, 0xd5, 0x4c, 0xf2, 0x4b, 0x7e, 0x19, 0xd2, 0xc4, 0x75, 0x1b, 0x3b, 0xdd,
+	0x34, 0x24, 0x05, 0xba, 0x53, 0x27, 0x6a, 0x55, 0xda, 0x04, 0x81, 0x43, 0x69, 0x82, 0x44, 0x55,
+	0xb6, 0x05, 0xa4, 0x5e, 0xac, 0xdd, 0xf5, 0x64, 0xbd, 0xaa, 0xbd, 0xb3, 0xdd, 0x99, 0x35, 0x58,
+	0x55, 0x84, 0xc4, 0x27, 0x40, 0xe2, 0xc2, 0x85, 0x2b, 0x07, 0xbe, 0x00, 0x07, 0x6e, 0x9c, 0x7a,
+	0x2c, 0xe2, 0xd2, 0x93, 0x55, 0x25, 0x5c, 0xb9, 0xf8, 0x13, 0xa0, 0x9d, 0x99, 0xed, 0xee, 0xda,
+	0xeb, 0xc4, 0xb1, 0xda, 0x9b, 0xfd, 0xce, 0xfb, 0xe7, 0x79, 0x9e, 0xf7, 0x9d, 0x79, 0x6d, 0xa0,
+	0x3a, 0xa6, 0x85, 0x2c, 0xe2, 0x63, 0x64, 0x11, 0xd7, 0xc5, 0x16, 0x73, 0x88, 0x8b, 0x5a, 0x65,
+	0xf4, 0x34, 0xc0, 0x7e, 0x5b, 0xf3, 0x7c, 0xc2, 0x08, 0x5c, 0x70, 0x4c, 0x4b, 0x0b, 0x7d, 0xb4,
+	0xd8, 0x47, 0x6b, 0x95, 0x0b, 0xf3, 0x36, 0xb1, 0x09, 0x77, 0x41, 0xe1, 0x27, 0xe1, 0x5d, 0x78,
+	0xcf, 0x22, 0xb4, 0x49, 0x28, 0x32, 0x0d, 0x8a, 0x45, 0x1a, 0xd4, 0x2a, 0x9b, 0x98, 0x19, 0x65,
+	0xe4, 0x19, 0xb6, 0xe3, 0x1a, 0x3c, 0x5c, 0xf8, 0x96, 0xe2, 0xea, 0x0d, 0x07, 0xbb, 0x2c, 0xac,
+	0x2c, 0x3e, 0x49, 0x87, 0xb5, 0x01, 0xf0, 0xe2, 0x6f, 0xd2, 0xf1, 0x92, 0x4d, 0x88, 0xdd, 0xc0,
+	0xc8, 0xf0, 0x1c, 0x64, 0xb8, 0x2e, 0x61, 0xbc, 0x0c, 0x95, 0xa7, 0x17, 0xe4, 0x29, 0xff, 0x66,
+	0x06, 0xfb, 0xc8, 0x70, 0x25, 0x39, 0x75, 0x1b, 0x2c, 0x7c, 0x19, 0x82, 0xdc, 0x79, 0x9d, 0x51,
+	0xc7, 0x4f, 0x03, 0x4c, 0x19, 0x5c, 0x01, 0xff, 0x8b, 0xcb, 0x54, 0x9d, 0x5a, 0x5e, 0x59, 0x56,
+	0xd6, 0x27, 0xf5, 0xe9, 0xd8, 0xb8, 0x57, 0x53, 0xff, 0x50, 0xc0, 0x62, 0x5f, 0x3c, 0xf5, 0x88,
+	0x4b, 0x31, 0xbc, 0x0b, 0x40, 0xec, 0xcb, 0xa3, 0xa7, 0x36, 0x56, 0xb5, 0x6c, 0x31, 0xb5, 0x38,
+	0xfe, 0xae, 0x5b, 0xd3, 0x13, 0x81, 0x70, 0x1e, 0x9c, 0xf1, 0x7c, 0x42, 0xf6, 0xf3, 0xb9, 0x65,
+	0x65, 0x7d, 0x5a, 0x17, 0x5f, 0xe0, 0x0e, 0x98, 0xe6, 0x1f, 0xaa, 0x75, 0xec, 0xd8, 0x75, 0x96,
+	0x1f, 0xe7, 0xe9, 0x0b, 0x89, 0xf4, 0x42, 0xc7, 0x56, 0x59, 0xdb, 0xe5, 0x1e, 0x95, 0x89, 0xe7,
+	0x9d, 0xd2, 0x98, 0x3e, 0xc5, 0xa3, 0x84, 0x49, 0x35, 0xfa, 0xc0, 0xd3, 0x88, 0xfd, 0x67, 0x00,
+	0xc4, 0xed, 0x92, 0xe0, 0xdf, 0xd5, 0x44, 0x6f, 0xb5, 0xb0, 0xb7, 0x9a, 0x18, 0x11, 0xd9, 0x5b,
+	0xed, 0x81, 0x61, 0x63, 0x19, 0xab, 0x27, 0x22, 0xd5, 0x7f, 0x15, 0x90, 0xef, 0xaf, 0x21, 0x15,
+	0xba, 0x0f, 0xa6, 0x62, 0xa2, 0x34, 0xaf, 0x2c, 0x8f, 0xaf, 0x4f, 0x6d, 0x7c, 0x30, 0x48, 0xa2,
+	0xbd, 0x1a, 0x76, 0x99, 0xb3, 0xef, 0xe0, 0x5a, 0x42, 0xec, 0x64, 0x02, 0x78, 0x2f, 0x05, 0x3a,
+	0xc7, 0x41, 0xaf, 0x9d, 0x08, 0x5a, 0x80, 0x49, 0xa2, 0x86, 0xb7, 0xc0, 0xd9, 0x53, 0xea, 0x2a,
+	0xfd, 0xd5, 0x2d, 0xb0, 0x24, 0xe8, 0x72, 0xb7, 0x0c, 0x61, 0x2f, 0x82, 0x49, 0x91, 0x22, 0x1e,
+	0xa9, 0x73, 0xc2, 0xb0, 0x57, 0x53, 0x7f, 0x55, 0x40, 0x71, 0x50, 0xb8, 0xd4, 0xec, 0x2a, 0xf8,
+	0x7f, 0x62, 0x2c, 0x3d, 0x83, 0xd5, 0x85, 0x70, 0x93, 0xfa, 0x6c, 0x6c, 0x7f, 0x10, 0x9a, 0xdf,
+	0xe6, 0xe4, 0x98, 0xe0, 0x72, 0x4f, 0x57, 0x05, 0xe2, 0x87, 0xcc, 0x60, 0xd1, 0x1c, 0xc0, 0xed,
+	0xcc, 0x1b, 0x54, 0xc9, 0x77, 0x3b, 0xa5, 0xf9, 0xb6, 0xd1, 0x6c, 0xdc, 0x56, 0x53, 0xc7, 0x6a,
+	0xcf, 0xdd, 0x3a, 0x54, 0x80, 0x7a, 0x5c, 0x11, 0x29, 0x88, 0x01, 0x16, 0x9d, 0xd7, 0x93, 0x51,
+	0x95, 0xda, 0xd2, 0xd0, 0x45, 0x8e, 0xed, 0xd5, 0x2c, 0x6a, 0x89, 0x61, 0x4a, 0xe4, 0x3c, 0xef,
+	0x64, 0x99, 0xdf, 0xa6, 0x90, 0xbf, 0x2b, 0xe0, 0x4a, 0x2f, 0xc9, 0x90, 0x96, 0x4b, 0x03, 0xfa,
+	0x06, 0xc5, 0x84, 0x6b, 0x60, 0xd6, 0xc7, 0x2d, 0x87, 0x86, 0xa7, 0x6e, 0xd0, 0x34, 0xb1, 0xcf,
+	0xc9, 0x4c, 0xe8, 0x33, 0x91, 0xf9, 0x3e, 0xb7, 0xa6, 0x1c, 0x13, 0xc4, 0x12, 0x8e, 0x12, 0x79,
+	0x47, 0x01, 0xab, 0x27, 0x20, 0x97, 0x1d, 0xda, 0x06, 0xb3, 0x56, 0x74, 0x92, 0xea, 0xcc, 0xbc,
+	0x26, 0x1e, 0x66, 0x2d, 0x7a, 0x98, 0xb5, 0x4f, 0xdc, 0xb6, 0x3e, 0x63, 0xa5, 0xd2, 0xa4, 0x6f,
+	0x4c, 0x2e, 0x7d, 0x63, 0xe2, 0xd6, 0x8c, 0x1f, 0xd7, 0x9a, 0x89, 0x51, 0x5a, 0xf3, 0x97, 0x02,
+	0x56, 0x7a, 0x08, 0xee, 0x1a, 0x6e, 0x8d, 0xd6, 0x8d, 0x27, 0xf8, 0x21, 0xc3, 0xde, 0x1b, 0xea,
+	0xcc, 0x13, 0x00, 0x2d, 0x12, 0xb8, 0x0c, 0xfb, 0x9e, 0xe1, 0xb3, 0xb6, 0x14, 0x28, 0xe4, 0x39,
+	0xb3, 0xb1, 0x34, 0xe8, 0x2d, 0xe4, 0xca, 0x54, 0x96, 0xba, 0x9d, 0xd2, 0x85, 0xa8, 0x44, 0x6f,
+	0x0a, 0x55, 0x9f, 0x4b, 0x1a, 0x79, 0x84, 0xda, 0xc9, 0x81, 0x2b, 0xc7, 0x73, 0x92, 0x3d, 0xbb,
+	0x07, 0xe6, 0x5c, 0xfc, 0x1d, 0xab, 0x36, 0xa9, 0x5d, 0x65, 0x6d, 0x0f, 0x57, 0x03, 0xbf, 0x21,
+	0x89, 0x5d, 0xea, 0x76, 0x4a, 0x79, 0x51, 0xb5, 0xcf, 0x45, 0xd5, 0x67, 0x42, 0xdb, 0x17, 0xd4,
+	0x7e, 0xd4, 0xf6, 0xf0, 0x57, 0x7e, 0x03, 0x7e, 0x03, 0x16, 0x68, 0x60, 0x36, 0x1d, 0x56, 0x65,
+	0xa4, 0x9a, 0x04, 0xc4, 0x29, 0x9e, 0xab, 0x5c, 0xee, 0x76, 0x4a, 0x4b, 0x22, 0x5b, 0xb6, 0x9f,
+	0xaa, 0xcf, 0x8b, 0x83, 0x47, 0x64, 0x27, 0x61, 0x86, 0x8f, 0x4f, 0x7d, 0xfd, 0x2e, 0x86, 0x3d,
+	0xee, 0x76, 0x4a, 0xef, 0x88, 0x72, 0xc9, 0x68, 0x35, 0xd5, 0xfa, 0xc4, 0xfb, 0x3f, 0x71, 0xba,
+	0xf7, 0x7f, 0xa3, 0x3b, 0x09, 0xce, 0x70, 0x81, 0xe1, 0x6f, 0x0a, 0x00, 0xb1, 0xca, 0x50, 0x1b,
+	0xd4, 0xca, 0xec, 0x9f, 0x1f, 0x05, 0x34, 0xb4, 0xbf, 0xe8, 0x98, 0x7a, 0xe7, 0x87, 0xbf, 0xff,
+	0xf9, 0x29, 0x77, 0x03, 0x6e, 0xa2, 0x13, 0x7f, 0x34, 0x51, 0xf4, 0x2c, 0x35, 0x92, 0x07, 0xf0,
+	0x17, 0x05, 0x4c, 0xc5, 0x39, 0x29, 0x1c, 0xb6, 0x7a, 0xb4, 0xd6, 0x0a, 0xd7, 0x87, 0x0f, 0x90,
+	0x78, 0xdf, 0xe7, 0x78, 0x57, 0xe1, 0xca, 0x10, 0x78, 0xe1, 0x9f, 0x0a, 0x98, 0xeb, 0xdb, 0x89,
+	0xf0, 0xc6, 0xf1, 0x45, 0x07, 0xac, 0xe0, 0xc2, 0xcd, 0xd3, 0x86, 0x49, 0xc4, 0x1f, 0x71, 0xc4,
+	0xb7, 0xe0, 0xcd, 0x81, 0x88, 0xc5, 0x33, 0x95, 0x16, 0x3a, 0x7a, 0xba, 0x0e, 0xe0, 0x4b, 0x05,
+	0x9c, 0xcf, 0xdc, 0x65, 0xf0, 0xc3, 0x21, 0xd5, 0xeb, 0x5f, 0xb2, 0x85, 0xdb, 0xa3, 0x84, 0x4a,
+	0x42, 0xbb, 0x9c, 0x50, 0x05, 0x7e, 0x3c, 0xc2, 0xc8, 0xa0, 0xe4, 0xa6, 0x85, 0x3f, 0xe7, 0x40,
+	0x7e, 0xd0, 0x1e, 0x80, 0x5b, 0xc3, 0x42, 0xcc, 0x5a, 0x7c, 0x85, 0xed, 0x11, 0xa3, 0x25, 0xc7,
+	0xef, 0x39, 0xc7, 0x36, 0xfc, 0x76, 0x24, 0x8e, 0xe9, 0xb5, 0x85, 0xa2, 0x15, 0x88, 0x9e, 0xf5,
+	0x2c, 0xd3, 0x03, 0x24, 0xee, 0x7f, 0xe2, 0x40, 0x18, 0x0e, 0xe0, 0x2b, 0x05, 0x2c, 0x0e, 0x78,
+	0x6d, 0xe1, 0x9d, 0x21, 0xb9, 0x65, 0xed, 0x9d, 0xc2, 0xd6, 0x68, 0xc1, 0x52, 0x97, 0xcf, 0xb9,
+	0x2e, 0x9f, 0xc2, 0xca, 0x28, 0xba, 0xd4, 0xa3, 0x94, 0x55, 0xca, 0xb0, 0x57, 0xf9, 0xfa, 0xf9,
+	0x61, 0x51, 0x79, 0x71, 0x58, 0x54, 0x5e, 0x1d, 0x16, 0x95, 0x1f, 0x8f, 0x8a, 0x63, 0x2f, 0x8e,
+	0x8a, 0x63, 0x2f, 0x8f, 0x8a, 0x63, 0x8f, 0xb7, 0x6c, 0x87, 0xd5, 0x03, 0x53, 0xb3, 0x48, 0x13,
+	0xc9, 0x3f, 0x86, 0x8e, 0x69, 0x5d, 0xb3, 0x09, 0x6a, 0x6d, 0xa2, 0x26, 0xa9, 0x05, 0x0d, 0x4c,
+	0x45, 0xf1, 0xeb, 0x9b, 0xd7, 0x12, 0xf5, 0xc3, 0x7d, 0x42, 0xcd, 0xb3, 0xfc, 0x77, 0xc1, 0xe6,
+	0x7f, 0x03, 0x00, 0x69, 0x05, 0xbc, 0xf2, 0xa6, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -719,6 +871,9 @@ type QueryClient interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(ctx context.Context, in *QueryConnectionConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionHandshakeStep queries the next message of the handshake of a
+	// connection given the state of its counterparty connection end.
+	ConnectionHandshakeStep(ctx context.Context, in *QueryConnectionHandshakeStepRequest, opts ...grpc.CallOption) (*QueryConnectionHandshakeStepResponse, error)
 }
 
 type queryClient struct {
@@ -774,6 +929,15 @@ func (c *queryClient) ConnectionConsensusState(ctx context.Context, in *QueryCon
 	return out, nil
 }
 
+func (c *queryClient) ConnectionHandshakeStep(ctx context.Context, in *QueryConnectionHandshakeStepRequest, opts ...grpc.CallOption) (*QueryConnectionHandshakeStepResponse, error) {
+	out := new(QueryConnectionHandshakeStepResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionHandshakeStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Connection queries an IBC connection end.
@@ -789,6 +953,9 @@ type QueryServer interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(context.Context, *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionHandshakeStep queries the next message of the handshake of a
+	// connection given the state of its counterparty connection end.
+	ConnectionHandshakeStep(context.Context, *QueryConnectionHandshakeStepRequest) (*QueryConnectionHandshakeStepResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -810,6 +977,9 @@ func (*UnimplementedQueryServer) ConnectionClientState(ctx context.Context, req
 func (*UnimplementedQueryServer) ConnectionConsensusState(ctx context.Context, req *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionHandshakeStep(ctx context.Context, req *QueryConnectionHandshakeStepRequest) (*QueryConnectionHandshakeStepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHandshakeStep not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -905,6 +1075,24 @@ func _Query_ConnectionConsensusState_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionHandshakeStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionHandshakeStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionHandshakeStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionHandshakeStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionHandshakeStep(ctx, req.(*QueryConnectionHandshakeStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.connection.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -929,6 +1117,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionConsensusState",
 			Handler:    _Query_ConnectionConsensusState_Handler,
 		},
+		{
+			MethodName: "ConnectionHandshakeStep",
+			Handler:    _Query_ConnectionHandshakeStep_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/connection/v1/query.proto",
@@ -1370,6 +1562,101 @@ func (m *QueryConnectionConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConnectionHandshakeStepRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionHandshakeStepRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionHandshakeStepRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CounterpartyState != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CounterpartyState))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionHandshakeStepResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionHandshakeStepResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionHandshakeStepResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SubmitToCounterparty {
+		i--
+		if m.SubmitToCounterparty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NextMsgTypeUrl) > 0 {
+		i -= len(m.NextMsgTypeUrl)
+		copy(dAtA[i:], m.NextMsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextMsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1555,6 +1842,42 @@ func (m *QueryConnectionConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConnectionHandshakeStepRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CounterpartyState != 0 {
+		n += 1 + sovQuery(uint64(m.CounterpartyState))
+	}
+	return n
+}
+
+func (m *QueryConnectionHandshakeStepResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextMsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitToCounterparty {
+		n += 2
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2806,6 +3129,275 @@ func (m *QueryConnectionConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionHandshakeStepRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionHandshakeStepRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionHandshakeStepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyState", wireType)
+			}
+			m.CounterpartyState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyState |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionHandshakeStepResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionHandshakeStepResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionHandshakeStepResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextMsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitToCounterparty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubmitToCounterparty = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
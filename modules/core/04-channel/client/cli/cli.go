@@ -21,14 +21,30 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannels(),
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
+		GetCmdQueryChannelsByClient(),
+		GetCmdQueryDuplicateChannels(),
 		GetCmdQueryChannelClientState(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
+		GetCmdQueryPrioritizedPackets(),
 		GetCmdQueryPacketReceipt(),
 		GetCmdQueryPacketAcknowledgement(),
+		GetCmdQueryPacketAcknowledgementsByRange(),
 		GetCmdQueryUnreceivedPackets(),
+		GetCmdQueryUnreceivedPacketsByRange(),
 		GetCmdQueryUnreceivedAcks(),
+		GetCmdQuerySendPacketEvents(),
+		GetCmdQueryWriteAcknowledgementEvents(),
 		GetCmdQueryNextSequenceReceive(),
+		GetCmdQueryPacketDataSchemas(),
+		GetCmdQueryPacketDataSchema(),
+		GetCmdQueryDeadLetterPacket(),
+		GetCmdQueryDeadLetterPackets(),
+		GetCmdQueryPacketLatency(),
+		GetCmdQueryQuarantinedChannels(),
+		GetCmdQueryPendingAsyncAcknowledgements(),
+		GetCmdQueryChannelHandshakeStep(),
+		GetCmdQueryMiddlewareStack(),
 		// TODO: next sequence Send ?
 	)
 
@@ -45,7 +61,11 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewReclaimPacketCmd(),
+		NewQuarantineChannelCmd(),
+		NewReleaseChannelCmd(),
+	)
 
 	return txCmd
 }
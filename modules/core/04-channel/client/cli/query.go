@@ -132,6 +132,80 @@ func GetCmdQueryConnectionChannels() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelsByClient defines the command to query all the channels associated with the
+// connections of a client
+func GetCmdQueryChannelsByClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-channels [client-id]",
+		Short:   "Query all channels associated with the connections of a client",
+		Long:    "Query all channels associated with the connections of a client",
+		Example: fmt.Sprintf("%s query %s %s client-channels [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelsByClientRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelsByClient(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels associated with the connections of a client")
+
+	return cmd
+}
+
+// GetCmdQueryDuplicateChannels defines the command to query the OPEN channels duplicating a channel
+func GetCmdQueryDuplicateChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "duplicate-channels [port-id] [channel-id]",
+		Short:   "Query the OPEN channels duplicating a channel",
+		Long:    "Query the OPEN channels using the same port, connection and counterparty port as a channel",
+		Example: fmt.Sprintf("%s query %s %s duplicate-channels [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDuplicateChannelsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.DuplicateChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryChannelClientState defines the command to query a client state from a channel
 func GetCmdQueryChannelClientState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -203,6 +277,47 @@ func GetCmdQueryPacketCommitments() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPrioritizedPackets defines the command to query the pending packets of a channel
+// sent with a priority class
+func GetCmdQueryPrioritizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prioritized-packets [port-id] [channel-id]",
+		Short:   "Query the pending packets of a channel sent with a priority class",
+		Long:    "Query the pending packets of a channel sent with a priority class, ordered by descending priority class and ascending sequence",
+		Example: fmt.Sprintf("%s query %s %s prioritized-packets [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPrioritizedPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PrioritizedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prioritized packets of a channel")
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitment defines the command to query a packet commitment
 func GetCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -320,6 +435,60 @@ func GetCmdQueryPacketAcknowledgement() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketAcknowledgementsByRange defines the command to query the packet acknowledgements associated with a channel
+// within a range of packet sequences
+func GetCmdQueryPacketAcknowledgementsByRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-acks-by-range [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short:   "Query the packet acknowledgements associated with a channel within a range of sequences",
+		Long:    "Query the packet acknowledgements associated with a channel within an inclusive range of packet sequences",
+		Example: fmt.Sprintf("%s query %s %s packet-acks-by-range [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketAcknowledgementsByRangeRequest{
+				PortId:        args[0],
+				ChannelId:     args[1],
+				StartSequence: startSequence,
+				EndSequence:   endSequence,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.PacketAcknowledgementsByRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet acknowledgements within the range")
+
+	return cmd
+}
+
 // GetCmdQueryUnreceivedPackets defines the command to query all the unreceived
 // packets on the receiving chain
 func GetCmdQueryUnreceivedPackets() *cobra.Command {
@@ -371,6 +540,60 @@ The return value represents:
 	return cmd
 }
 
+// GetCmdQueryUnreceivedPacketsByRange defines the command to query the unreceived packets on the receiving chain
+// within a range of packet sequences
+func GetCmdQueryUnreceivedPacketsByRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unreceived-packets-by-range [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short:   "Query the unreceived packets associated with a channel within a range of sequences",
+		Long:    "Query the sequences within an inclusive range of packet sequences for which no packet receipt exists on the receiving chain. Sequences of packets which have not been sent by the counterparty are returned as well.",
+		Example: fmt.Sprintf("%s query %s %s unreceived-packets-by-range [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnreceivedPacketsByRangeRequest{
+				PortId:        args[0],
+				ChannelId:     args[1],
+				StartSequence: startSequence,
+				EndSequence:   endSequence,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.UnreceivedPacketsByRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unreceived packets within the range")
+
+	return cmd
+}
+
 // GetCmdQueryUnreceivedAcks defines the command to query all the unreceived acks on the original sending chain
 func GetCmdQueryUnreceivedAcks() *cobra.Command {
 	cmd := &cobra.Command{
@@ -421,6 +644,114 @@ The return value represents:
 	return cmd
 }
 
+// GetCmdQuerySendPacketEvents defines the command to query the send packet events of a channel
+// within a range of packet sequences, reconstructed from state
+func GetCmdQuerySendPacketEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-packet-events [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short:   "Query the send packet events of a channel within a range of sequences",
+		Long:    "Query the send packet events of the pending packets of a channel within an inclusive range of packet sequences, reconstructed from state",
+		Example: fmt.Sprintf("%s query %s %s send-packet-events [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySendPacketEventsRequest{
+				PortId:        args[0],
+				ChannelId:     args[1],
+				StartSequence: startSequence,
+				EndSequence:   endSequence,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.SendPacketEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send packet events within the range")
+
+	return cmd
+}
+
+// GetCmdQueryWriteAcknowledgementEvents defines the command to query the write acknowledgement events of a channel
+// within a range of packet sequences, reconstructed from state
+func GetCmdQueryWriteAcknowledgementEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "write-ack-events [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short:   "Query the write acknowledgement events of a channel within a range of sequences",
+		Long:    "Query the write acknowledgement events of the received packets of a channel within an inclusive range of packet sequences, reconstructed from state",
+		Example: fmt.Sprintf("%s query %s %s write-ack-events [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryWriteAcknowledgementEventsRequest{
+				PortId:        args[0],
+				ChannelId:     args[1],
+				StartSequence: startSequence,
+				EndSequence:   endSequence,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.WriteAcknowledgementEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "write acknowledgement events within the range")
+
+	return cmd
+}
+
 // GetCmdQueryNextSequenceReceive defines the command to query a next receive sequence for a given channel
 func GetCmdQueryNextSequenceReceive() *cobra.Command {
 	cmd := &cobra.Command{
@@ -455,3 +786,342 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPacketDataSchemas defines the command to query all registered packet data schemas
+func GetCmdQueryPacketDataSchemas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-data-schemas",
+		Short:   "Query all registered packet data schemas",
+		Long:    "Query all packet data schemas registered by the applications wired into the chain",
+		Example: fmt.Sprintf("%s query %s %s packet-data-schemas", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PacketDataSchemas(cmd.Context(), &types.QueryPacketDataSchemasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketDataSchema defines the command to query the packet data schema of a channel
+func GetCmdQueryPacketDataSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-data-schema [port-id] [channel-id]",
+		Short: "Query the packet data schema of a channel",
+		Long:  "Query the packet data schema registered for the port and version of a channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-data-schema [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPacketDataSchemaRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PacketDataSchema(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDeadLetterPacket defines the command to query a packet recorded in the dead-letter store
+func GetCmdQueryDeadLetterPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead-letter-packet [port-id] [channel-id] [sequence]",
+		Short: "Query a dead-letter packet",
+		Long:  "Query a packet recorded in the dead-letter store by the source port, source channel and sequence of the packet",
+		Example: fmt.Sprintf(
+			"%s query %s %s dead-letter-packet [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeadLetterPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.DeadLetterPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDeadLetterPackets defines the command to query all packets of a channel recorded in the dead-letter store
+func GetCmdQueryDeadLetterPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dead-letter-packets [port-id] [channel-id]",
+		Short:   "Query all dead-letter packets associated with a channel",
+		Long:    "Query all packets recorded in the dead-letter store whose source is the given channel",
+		Example: fmt.Sprintf("%s query %s %s dead-letter-packets [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeadLetterPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DeadLetterPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dead-letter packets associated with a channel")
+
+	return cmd
+}
+
+// GetCmdQueryQuarantinedChannels defines the command to query all the channels placed in quarantine
+func GetCmdQueryQuarantinedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quarantined-channels",
+		Short:   "Query all quarantined channels",
+		Long:    "Query all the channels placed in quarantine, on which packets can neither be sent nor processed",
+		Example: fmt.Sprintf("%s query %s %s quarantined-channels", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQuarantinedChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QuarantinedChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quarantined channels")
+
+	return cmd
+}
+
+// GetCmdQueryPendingAsyncAcknowledgements defines the command to query the packets of a channel
+// awaiting an asynchronous acknowledgement
+func GetCmdQueryPendingAsyncAcknowledgements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-async-acks [port-id] [channel-id]",
+		Short:   "Query the packets of a channel awaiting an asynchronous acknowledgement",
+		Long:    "Query the packets received on a channel whose acknowledgement is still to be written asynchronously by the application",
+		Example: fmt.Sprintf("%s query %s %s pending-async-acks [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingAsyncAcknowledgementsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingAsyncAcknowledgements(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending asynchronous acknowledgements of a channel")
+
+	return cmd
+}
+
+// GetCmdQueryPacketLatency defines the command to query the latency statistics of the acknowledged packets of a channel
+func GetCmdQueryPacketLatency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-latency [port-id] [channel-id]",
+		Short:   "Query the packet latency statistics of a channel",
+		Long:    "Query the latency statistics, in milliseconds, of the acknowledged packets sent on the given channel",
+		Example: fmt.Sprintf("%s query %s %s packet-latency [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPacketLatencyRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PacketLatency(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelHandshakeStep defines the command to query the next message of the
+// handshake of a channel.
+func GetCmdQueryChannelHandshakeStep() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handshake-step [port-id] [channel-id] [counterparty-state]",
+		Short: "Query the next handshake message of a channel",
+		Long: `Query the next handshake message of a channel, the chain it must be submitted to and the height of its proofs.
+The counterparty state is the state of the counterparty channel end as queried on the counterparty chain,
+STATE_UNINITIALIZED_UNSPECIFIED if the counterparty channel end does not exist.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s handshake-step [port-id] [channel-id] STATE_INIT", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			counterpartyState, ok := types.State_value[args[2]]
+			if !ok {
+				return fmt.Errorf("invalid counterparty channel state %s", args[2])
+			}
+
+			req := &types.QueryChannelHandshakeStepRequest{
+				PortId:            args[0],
+				ChannelId:         args[1],
+				CounterpartyState: types.State(counterpartyState),
+			}
+
+			res, err := queryClient.ChannelHandshakeStep(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMiddlewareStack defines the command to query the middleware stack of a channel
+func GetCmdQueryMiddlewareStack() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "middleware-stack [port-id] [channel-id]",
+		Short:   "Query the middleware stack of a channel",
+		Long:    "Query the layers of the middleware stack processing the packets received and sent on the given channel, in processing order",
+		Example: fmt.Sprintf("%s query %s %s middleware-stack [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMiddlewareStackRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.MiddlewareStack(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewReclaimPacketCmd defines the command to reclaim a packet recorded in the dead-letter store.
+func NewReclaimPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-packet [port-id] [channel-id] [sequence]",
+		Short:   "reclaim a dead-letter packet",
+		Long:    "reclaim a packet recorded in the dead-letter store through the application owning its source channel",
+		Example: fmt.Sprintf("%s tx %s %s reclaim-packet [port-id] [channel-id] [sequence] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReclaimPacket(args[0], args[1], seq, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQuarantineChannelCmd defines the command to place a channel in quarantine.
+func NewQuarantineChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantine-channel [port-id] [channel-id]",
+		Short: "place a channel in quarantine",
+		Long: `place a channel in quarantine during an incident. Packets sent on the channel are rejected and
+packets received on it are acknowledged with a retryable error, while its handshake state is preserved.
+The transaction must be signed by the IBC authority or one of the circuit breakers of the core IBC params.`,
+		Example: fmt.Sprintf("%s tx %s %s quarantine-channel [port-id] [channel-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgQuarantineChannel(args[0], args[1], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReleaseChannelCmd defines the command to release a channel from quarantine.
+func NewReleaseChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-channel [port-id] [channel-id]",
+		Short:   "release a channel from quarantine",
+		Long:    "release a channel from quarantine. The transaction must be signed by the IBC authority or one of the circuit breakers of the core IBC params.",
+		Example: fmt.Sprintf("%s tx %s %s release-channel [port-id] [channel-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseChannel(args[0], args[1], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+		ch.UpgradeSequence = channel.UpgradeSequence
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
 	}
 	for _, ack := range gs.Acknowledgements {
@@ -32,19 +33,39 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, dlp := range gs.DeadLetterPackets {
+		k.SetDeadLetterPacket(ctx, dlp)
+	}
+	for _, sequence := range gs.ReservedChannelSequences {
+		k.SetReservedChannelSequence(ctx, sequence)
+	}
+	for _, qc := range gs.QuarantinedChannels {
+		k.SetQuarantinedChannel(ctx, qc)
+	}
+	for _, pa := range gs.PendingAsyncAcknowledgements {
+		k.SetPendingAsyncAcknowledgement(ctx, pa)
+	}
+	for _, pc := range gs.PacketCompensations {
+		k.SetPacketCompensation(ctx, pc.PortId, pc.ChannelId, pc.Sequence, pc.Data)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:                     k.GetAllChannels(ctx),
+		Acknowledgements:             k.GetAllPacketAcks(ctx),
+		Commitments:                  k.GetAllPacketCommitments(ctx),
+		Receipts:                     k.GetAllPacketReceipts(ctx),
+		SendSequences:                k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:                k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:                 k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:          k.GetNextChannelSequence(ctx),
+		DeadLetterPackets:            k.GetAllDeadLetterPackets(ctx),
+		ReservedChannelSequences:     k.GetReservedChannelSequences(ctx),
+		QuarantinedChannels:          k.GetAllQuarantinedChannels(ctx),
+		PendingAsyncAcknowledgements: k.GetAllPendingAsyncAcknowledgements(ctx),
+		PacketCompensations:          k.GetAllPacketCompensations(ctx),
 	}
 }
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// BufferPacketPayload appends an application payload to the buffer of the given
+// channel. Buffered payloads are sent in a single packet carrying an
+// AggregatedPacketData once FlushBufferedPayloads is called, usually from the
+// EndBlock of the application owning the channel.
+func (k Keeper) BufferPacketPayload(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	portID, channelID string,
+	payload []byte,
+) error {
+	if len(payload) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidAggregatedPacket, "payload cannot be empty")
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	data := k.GetBufferedPayloads(ctx, portID, channelID)
+	data.Payloads = append(data.Payloads, payload)
+	k.setBufferedPayloads(ctx, portID, channelID, data)
+
+	return nil
+}
+
+// GetBufferedPayloads returns the payloads currently buffered for the given
+// channel, in the order in which they were buffered.
+func (k Keeper) GetBufferedPayloads(ctx sdk.Context, portID, channelID string) types.AggregatedPacketData {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.AggregatedPayloadsKey(portID, channelID))
+	if bz == nil {
+		return types.AggregatedPacketData{}
+	}
+
+	var data types.AggregatedPacketData
+	k.cdc.MustUnmarshal(bz, &data)
+	return data
+}
+
+// setBufferedPayloads stores the payloads buffered for the given channel.
+func (k Keeper) setBufferedPayloads(ctx sdk.Context, portID, channelID string, data types.AggregatedPacketData) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(host.AggregatedPayloadsKey(portID, channelID), bz)
+}
+
+// deleteBufferedPayloads removes the payloads buffered for the given channel.
+func (k Keeper) deleteBufferedPayloads(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.AggregatedPayloadsKey(portID, channelID))
+}
+
+// FlushBufferedPayloads sends all payloads buffered for the given channel in a
+// single packet carrying an AggregatedPacketData and clears the buffer. The
+// sequence of the sent packet is returned. If no payloads are buffered no packet
+// is sent and a zero sequence is returned.
+func (k Keeper) FlushBufferedPayloads(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	portID, channelID string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	data := k.GetBufferedPayloads(ctx, portID, channelID)
+	if len(data.Payloads) == 0 {
+		return 0, nil
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	sequence, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			types.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	packet := types.NewPacket(
+		data.GetBytes(), sequence,
+		portID, channelID,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	)
+
+	if err := k.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	k.deleteBufferedPayloads(ctx, portID, channelID)
+
+	k.Logger(ctx).Debug("flushed buffered payloads", "port-id", portID, "channel-id", channelID, "sequence", sequence, "payloads", len(data.Payloads))
+
+	return sequence, nil
+}
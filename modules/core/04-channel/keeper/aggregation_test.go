@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestBufferPacketPayload tests BufferPacketPayload on chainA
+func (suite *KeeperTestSuite) TestBufferPacketPayload() {
+	var (
+		path       *ibctesting.Path
+		payload    []byte
+		channelCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"empty payload", func() {
+			payload = []byte{}
+		}, false},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is not OPEN", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"capability is incorrect", func() {
+			channelCap = capabilitytypes.NewCapability(5)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			payload = ibctesting.MockPacketData
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.BufferPacketPayload(suite.chainA.GetContext(), channelCap, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, payload)
+			data := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetBufferedPayloads(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([][]byte{payload}, data.Payloads)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(data.Payloads)
+			}
+		})
+	}
+}
+
+// TestFlushBufferedPayloads tests that payloads buffered on chainA are sent in a
+// single packet and that the packet is processed payload by payload on chainB.
+func (suite *KeeperTestSuite) TestFlushBufferedPayloads() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	channelCap := suite.chainA.GetChannelCapability(portID, channelID)
+
+	// flushing an empty buffer is a no-op
+	sequence, err := channelKeeper.FlushBufferedPayloads(suite.chainA.GetContext(), channelCap, portID, channelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(err)
+	suite.Require().Zero(sequence)
+
+	payloads := [][]byte{[]byte("success"), []byte("failure"), []byte("success")}
+	for _, payload := range payloads {
+		err := channelKeeper.BufferPacketPayload(suite.chainA.GetContext(), channelCap, portID, channelID, payload)
+		suite.Require().NoError(err)
+	}
+
+	// the capability is authenticated on flush
+	_, err = channelKeeper.FlushBufferedPayloads(suite.chainA.GetContext(), capabilitytypes.NewCapability(5), portID, channelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().Error(err)
+
+	sequence, err = channelKeeper.FlushBufferedPayloads(suite.chainA.GetContext(), channelCap, portID, channelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+	suite.Require().Empty(channelKeeper.GetBufferedPayloads(suite.chainA.GetContext(), portID, channelID).Payloads)
+
+	packet := types.NewPacket(types.NewAggregatedPacketData(payloads).GetBytes(), sequence, portID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	commitment := channelKeeper.GetPacketCommitment(suite.chainA.GetContext(), portID, channelID, sequence)
+	suite.Require().Equal(types.CommitPacket(suite.chainA.App.AppCodec(), packet), commitment)
+
+	// process the aggregated packet on chainB, writing a marker for every payload
+	data, err := types.UnmarshalAggregatedPacketData(packet.GetData())
+	suite.Require().NoError(err)
+
+	ctx := suite.chainB.GetContext()
+	store := ctx.KVStore(suite.chainB.GetSimApp().GetKey(host.StoreKey))
+	ack := types.ProcessAggregatedPacketData(ctx, data, func(ctx sdk.Context, payload []byte) types.Acknowledgement {
+		ctx.KVStore(suite.chainB.GetSimApp().GetKey(host.StoreKey)).Set(payload, payload)
+		if string(payload) == "failure" {
+			return types.NewErrorAcknowledgement("failure")
+		}
+		return types.NewResultAcknowledgement(payload)
+	})
+
+	suite.Require().True(ack.Success())
+	suite.Require().Len(ack.Acknowledgements, len(payloads))
+	suite.Require().True(ack.Acknowledgements[0].Success())
+	suite.Require().False(ack.Acknowledgements[1].Success())
+	suite.Require().True(ack.Acknowledgements[2].Success())
+
+	// state changes of the failed payload are discarded
+	suite.Require().True(store.Has([]byte("success")))
+	suite.Require().False(store.Has([]byte("failure")))
+
+	decoded, err := types.UnmarshalAggregatedAcknowledgement(ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, decoded)
+
+	// timeout height is required by SendPacket
+	err = channelKeeper.BufferPacketPayload(suite.chainA.GetContext(), channelCap, portID, channelID, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	_, err = channelKeeper.FlushBufferedPayloads(suite.chainA.GetContext(), channelCap, portID, channelID, clienttypes.ZeroHeight(), disabledTimeoutTimestamp)
+	suite.Require().Error(err)
+	suite.Require().Len(channelKeeper.GetBufferedPayloads(suite.chainA.GetContext(), portID, channelID).Payloads, 1)
+}
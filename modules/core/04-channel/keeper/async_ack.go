@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ types.AsyncAckManager = Keeper{}
+
+// RecordAsyncAcknowledgement records a received packet for which the receiving application
+// returned no acknowledgement from its OnRecvPacket callback. The packet awaits an
+// acknowledgement written asynchronously with WriteAsyncAcknowledgement. It is called by core
+// IBC and is a no-op if the acknowledgement was already written during the callback.
+func (k Keeper) RecordAsyncAcknowledgement(ctx sdk.Context, packet exported.PacketI) {
+	if k.HasPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
+		return
+	}
+
+	timeoutHeight := packet.GetTimeoutHeight()
+	pending := types.NewPendingAsyncAcknowledgement(
+		types.NewPacket(
+			packet.GetData(), packet.GetSequence(),
+			packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(),
+			clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), timeoutHeight.GetRevisionHeight()),
+			packet.GetTimeoutTimestamp(),
+		),
+		clienttypes.GetSelfHeight(ctx),
+	)
+	k.SetPendingAsyncAcknowledgement(ctx, pending)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "packet", "async_ack", "pending"},
+		1,
+		asyncAcknowledgementLabels(packet),
+	)
+}
+
+// WriteAsyncAcknowledgement writes the acknowledgement of a packet recorded as awaiting an
+// asynchronous acknowledgement. An acknowledgement can only be written once: an error is
+// returned if the packet is not awaiting an acknowledgement, either because the receiving
+// application returned an acknowledgement from OnRecvPacket or because it was already written.
+func (k Keeper) WriteAsyncAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	acknowledgement exported.Acknowledgement,
+) error {
+	if !k.HasPendingAsyncAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
+		return sdkerrors.Wrapf(
+			types.ErrAsyncAcknowledgementNotPending,
+			"port ID (%s) channel ID (%s) sequence (%d)", packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		)
+	}
+
+	return k.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// clearPendingAsyncAcknowledgement removes the record of a packet awaiting an asynchronous
+// acknowledgement once its acknowledgement is written, and reports the number of blocks
+// elapsed since the packet was received. Packets which were not recorded are ignored.
+func (k Keeper) clearPendingAsyncAcknowledgement(ctx sdk.Context, packet exported.PacketI) {
+	pending, found := k.GetPendingAsyncAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PendingAsyncAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	labels := asyncAcknowledgementLabels(packet)
+	telemetry.IncrCounterWithLabels([]string{"ibc", "packet", "async_ack", "written"}, 1, labels)
+
+	// the delay is only reported for packets received in the current revision of the chain
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if selfHeight.RevisionNumber == pending.ReceivedHeight.RevisionNumber {
+		metrics.AddSampleWithLabels(
+			[]string{"ibc", "packet", "async_ack", "delay"},
+			float32(selfHeight.RevisionHeight-pending.ReceivedHeight.RevisionHeight),
+			labels,
+		)
+	}
+}
+
+// HasPendingAsyncAcknowledgement returns true if the packet received on the given channel
+// with the given sequence awaits an asynchronous acknowledgement.
+func (k Keeper) HasPendingAsyncAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.PendingAsyncAcknowledgementKey(portID, channelID, sequence))
+}
+
+// GetPendingAsyncAcknowledgement returns the record of the packet received on the given
+// channel with the given sequence, if it awaits an asynchronous acknowledgement.
+func (k Keeper) GetPendingAsyncAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingAsyncAcknowledgement, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PendingAsyncAcknowledgementKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingAsyncAcknowledgement{}, false
+	}
+
+	var pending types.PendingAsyncAcknowledgement
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// SetPendingAsyncAcknowledgement stores the provided record of a packet awaiting an
+// asynchronous acknowledgement under the destination port, destination channel and sequence
+// of its packet.
+func (k Keeper) SetPendingAsyncAcknowledgement(ctx sdk.Context, pending types.PendingAsyncAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pending)
+	store.Set(host.PendingAsyncAcknowledgementKey(pending.Packet.GetDestPort(), pending.Packet.GetDestChannel(), pending.Packet.GetSequence()), bz)
+}
+
+// IteratePendingAsyncAcknowledgements provides an iterator over all packets awaiting an
+// asynchronous acknowledgement. For each packet, cb will be called. If the cb returns true,
+// the iterator will close and stop.
+func (k Keeper) IteratePendingAsyncAcknowledgements(ctx sdk.Context, cb func(pending types.PendingAsyncAcknowledgement) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPendingAsyncAckPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingAsyncAcknowledgement
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+
+		if cb(pending) {
+			break
+		}
+	}
+}
+
+// GetAllPendingAsyncAcknowledgements returns all packets awaiting an asynchronous
+// acknowledgement.
+func (k Keeper) GetAllPendingAsyncAcknowledgements(ctx sdk.Context) (pendings []types.PendingAsyncAcknowledgement) {
+	k.IteratePendingAsyncAcknowledgements(ctx, func(pending types.PendingAsyncAcknowledgement) bool {
+		pendings = append(pendings, pending)
+		return false
+	})
+	return pendings
+}
+
+// asyncAcknowledgementLabels returns the telemetry labels of the asynchronous acknowledgement
+// of the given packet.
+func asyncAcknowledgementLabels(packet exported.PacketI) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel(types.LabelSourcePort, packet.GetSourcePort()),
+		telemetry.NewLabel(types.LabelSourceChannel, packet.GetSourceChannel()),
+		telemetry.NewLabel(types.LabelDestinationPort, packet.GetDestPort()),
+		telemetry.NewLabel(types.LabelDestinationChannel, packet.GetDestChannel()),
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// SendPacketWithCompensation executes the local state change of an application and sends
+// the packet through the provided ICS4Wrapper atomically: both are executed in a cached
+// context which is only written if neither fails. The execute function returns the
+// compensation data of the packet, which is stored until the packet is acknowledged or timed
+// out. If the packet is acknowledged with an error acknowledgement or times out, core IBC
+// passes the compensation data to the OnCompensatePacket callback of the sending application,
+// which must implement the CompensatingModule interface, to revert the local state change.
+//
+// The ICS4Wrapper is usually the one the application was constructed with, so that the
+// packet goes through the same middleware stack as the packets sent with SendPacket.
+func (k Keeper) SendPacketWithCompensation(
+	ctx sdk.Context,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	execute func(ctx sdk.Context) ([]byte, error),
+) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	compensation, err := execute(cacheCtx)
+	if err != nil {
+		return err
+	}
+
+	if err := ics4Wrapper.SendPacket(cacheCtx, channelCap, packet); err != nil {
+		return err
+	}
+
+	k.SetPacketCompensation(cacheCtx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), compensation)
+
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// SetPacketCompensation stores the compensation data of a packet sent with a compensation.
+func (k Keeper) SetPacketCompensation(ctx sdk.Context, portID, channelID string, sequence uint64, compensation []byte) {
+	if compensation == nil {
+		compensation = []byte{}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketCompensationKey(portID, channelID, sequence), compensation)
+}
+
+// GetPacketCompensation returns the compensation data stored for a sent packet which has
+// not been acknowledged or timed out yet.
+func (k Keeper) GetPacketCompensation(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketCompensationKey(portID, channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// DeletePacketCompensation deletes the compensation data of a sent packet.
+func (k Keeper) DeletePacketCompensation(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketCompensationKey(portID, channelID, sequence))
+}
+
+// IteratePacketCompensations provides an iterator over the compensation data of all sent
+// packets. For each packet, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k Keeper) IteratePacketCompensations(ctx sdk.Context, cb func(portID, channelID string, sequence uint64, compensation []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPacketCompensations))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
+// GetAllPacketCompensations returns the compensation data of all sent packets.
+func (k Keeper) GetAllPacketCompensations(ctx sdk.Context) (compensations []types.PacketState) {
+	k.IteratePacketCompensations(ctx, func(portID, channelID string, sequence uint64, compensation []byte) bool {
+		compensations = append(compensations, types.NewPacketState(portID, channelID, sequence, compensation))
+		return false
+	})
+	return compensations
+}
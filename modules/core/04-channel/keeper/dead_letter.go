@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// SetDeadLetterPacket stores the provided dead-letter packet under the source port, source
+// channel and sequence of its packet.
+func (k Keeper) SetDeadLetterPacket(ctx sdk.Context, deadLetter types.DeadLetterPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&deadLetter)
+	store.Set(host.DeadLetterPacketKey(deadLetter.Packet.GetSourcePort(), deadLetter.Packet.GetSourceChannel(), deadLetter.Packet.GetSequence()), bz)
+}
+
+// GetDeadLetterPacket returns the dead-letter packet stored for the given source port, source
+// channel and sequence.
+func (k Keeper) GetDeadLetterPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.DeadLetterPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.DeadLetterPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.DeadLetterPacket{}, false
+	}
+
+	var deadLetter types.DeadLetterPacket
+	k.cdc.MustUnmarshal(bz, &deadLetter)
+	return deadLetter, true
+}
+
+// DeleteDeadLetterPacket removes the dead-letter packet stored for the given source port,
+// source channel and sequence.
+func (k Keeper) DeleteDeadLetterPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.DeadLetterPacketKey(portID, channelID, sequence))
+}
+
+// IterateDeadLetterPackets provides an iterator over all dead-letter packets. For each
+// dead-letter packet, cb will be called. If the cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateDeadLetterPackets(ctx sdk.Context, cb func(deadLetter types.DeadLetterPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyDeadLetterPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deadLetter types.DeadLetterPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &deadLetter)
+
+		if cb(deadLetter) {
+			break
+		}
+	}
+}
+
+// GetAllDeadLetterPackets returns all stored dead-letter packets.
+func (k Keeper) GetAllDeadLetterPackets(ctx sdk.Context) (deadLetters []types.DeadLetterPacket) {
+	k.IterateDeadLetterPackets(ctx, func(deadLetter types.DeadLetterPacket) bool {
+		deadLetters = append(deadLetters, deadLetter)
+		return false
+	})
+	return deadLetters
+}
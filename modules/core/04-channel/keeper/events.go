@@ -3,13 +3,40 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+// EmitChannelStateTransitionEvent emits an event for the transition of a channel end from the
+// previous state to its current state, with the ordering, version, connection hops and
+// counterparty of the channel. No event is emitted if the state of the channel is unchanged.
+func EmitChannelStateTransitionEvent(ctx sdk.Context, portID, channelID string, previousState types.State, channel types.Channel) {
+	if previousState == channel.State {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelStateTransition,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPreviousChannelState, previousState.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelState, channel.State.String()),
+			sdk.NewAttribute(types.AttributeKeyOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyVersion, channel.Version),
+			sdk.NewAttribute(types.AttributeKeyConnectionHops, strings.Join(channel.ConnectionHops, ",")),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+		),
+	)
+}
+
 // EmitChannelOpenInitEvent emits a channel open init event
 func EmitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -51,6 +78,26 @@ func EmitChannelOpenTryEvent(ctx sdk.Context, portID string, channelID string, c
 	})
 }
 
+// EmitDuplicateChannelEvent emits an advisory event for a new channel duplicating the provided
+// OPEN channels
+func EmitDuplicateChannelEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, duplicates []types.IdentifiedChannel) {
+	duplicateChannelIDs := make([]string, len(duplicates))
+	for i, duplicate := range duplicates {
+		duplicateChannelIDs[i] = duplicate.ChannelId
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDuplicateChannel,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyDuplicateChannelIDs, strings.Join(duplicateChannelIDs, ",")),
+		),
+	})
+}
+
 // EmitChannelOpenAckEvent emits a channel open acknowledge event
 func EmitChannelOpenAckEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -125,7 +172,7 @@ func EmitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 
 // EmitSendPacketEvent emits an event with packet data along with other packet information for relayer
 // to pick up and relay to other chain
-func EmitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutHeight exported.Height) {
+func EmitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutHeight exported.Height, priority types.PacketPriority) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSendPacket,
@@ -138,10 +185,15 @@ func EmitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
 			// we only support 1-hop packets now, and that is the most important hop for a relayer
 			// (is it going to a chain I am connected to)
 			sdk.NewAttribute(types.AttributeKeyConnection, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyProofHeight, proofHeight(ctx).String()),
+			sdk.NewAttribute(types.AttributeKeyPacketPriority, priority.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -165,6 +217,9 @@ func EmitRecvPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
 			// we only support 1-hop packets now, and that is the most important hop for a relayer
 			// (is it going to a chain I am connected to)
@@ -191,11 +246,15 @@ func EmitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, cha
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
 			sdk.NewAttribute(types.AttributeKeyAck, string(acknowledgement)),
 			sdk.NewAttribute(types.AttributeKeyAckHex, hex.EncodeToString(acknowledgement)),
 			// we only support 1-hop packets now, and that is the most important hop for a relayer
 			// (is it going to a chain I am connected to)
 			sdk.NewAttribute(types.AttributeKeyConnection, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyProofHeight, proofHeight(ctx).String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -217,6 +276,9 @@ func EmitAcknowledgePacketEvent(ctx sdk.Context, packet exported.PacketI, channe
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
 			// we only support 1-hop packets now, and that is the most important hop for a relayer
 			// (is it going to a chain I am connected to)
@@ -242,6 +304,9 @@ func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
 			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
 			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
 		),
 		sdk.NewEvent(
@@ -250,3 +315,197 @@ func EmitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 		),
 	})
 }
+
+// EmitPacketLatencyEvent emits a packet latency event when a packet is acknowledged.
+func EmitPacketLatencyEvent(ctx sdk.Context, packet exported.PacketI, latency time.Duration) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketLatency,
+			sdk.NewAttribute(types.AttributeKeyPacketLatency, fmt.Sprintf("%d", latency.Milliseconds())),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitPacketAlreadyRelayedEvent emits an event marking that the provided packet message was a no-op
+// because the packet has already been relayed. The processed height is the height at which the
+// packet was received, for receive messages, or at which its commitment was cleared, for
+// acknowledgement and timeout messages. It is zero if the height was not recorded.
+func (k Keeper) emitPacketAlreadyRelayedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, eventType string) {
+	var processedHeight uint64
+	if eventType == types.EventTypeRecvPacket {
+		processedHeight, _ = k.GetPacketRecvHeight(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	} else {
+		processedHeight, _ = k.GetPacketClearHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	EmitPacketAlreadyRelayedEvent(ctx, packet, channel, eventType, processedHeight)
+}
+
+// EmitPacketAlreadyRelayedEvent emits a packet already relayed event. It will be emitted for every
+// receive, acknowledgement or timeout message which is a no-op because the packet has already been
+// relayed, along with the regular event of the message.
+func EmitPacketAlreadyRelayedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, eventType string, processedHeight uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketAlreadyRelayed,
+			sdk.NewAttribute(types.AttributeKeyRelayedEventType, eventType),
+			sdk.NewAttribute(types.AttributeKeyProcessedHeight, fmt.Sprintf("%d", processedHeight)),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketID, types.FormatPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPortChannel, types.FormatPortChannelID(packet.GetSourcePort(), packet.GetSourceChannel())),
+			sdk.NewAttribute(types.AttributeKeyDstPortChannel, types.FormatPortChannelID(packet.GetDestPort(), packet.GetDestChannel())),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyConnection, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitDeadLetterPacketEvent emits an event when a packet is recorded in the dead-letter store.
+func EmitDeadLetterPacketEvent(ctx sdk.Context, deadLetter types.DeadLetterPacket) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeadLetterPacket,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", deadLetter.Packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, deadLetter.Packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, deadLetter.Packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, deadLetter.Packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, deadLetter.Packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyDeadLetterReason, deadLetter.Reason),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitReclaimPacketEvent emits an event when a dead-letter packet is reclaimed by its application.
+func EmitReclaimPacketEvent(ctx sdk.Context, packet exported.PacketI) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReclaimPacket,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitCompensatePacketEvent emits an event when the compensation of a packet is executed by
+// its sending application.
+func EmitCompensatePacketEvent(ctx sdk.Context, packet exported.PacketI) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCompensatePacket,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelQuarantinedEvent emits an event when a channel is placed in quarantine.
+func EmitChannelQuarantinedEvent(ctx sdk.Context, quarantined types.QuarantinedChannel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelQuarantined,
+			sdk.NewAttribute(types.AttributeKeyPortID, quarantined.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, quarantined.ChannelId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelReleasedEvent emits an event when a channel is released from quarantine.
+func EmitChannelReleasedEvent(ctx sdk.Context, portID, channelID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelReleased,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelUpgradeEvent emits an event of the provided channel upgrade handshake step with
+// the upgraded parameters, the upgrade sequence and the state of the channel end.
+func EmitChannelUpgradeEvent(ctx sdk.Context, eventType, portID, channelID string, channel types.Channel, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyChannelState, channel.State.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Fields.Version),
+			sdk.NewAttribute(types.AttributeKeyUpgradeOrdering, upgrade.Fields.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeConnectionHops, strings.Join(upgrade.Fields.ConnectionHops, ",")),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutHeight, upgrade.Timeout.Height.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, fmt.Sprintf("%d", upgrade.Timeout.Timestamp)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelUpgradeErrorEvent emits an event when an upgrade is aborted and its error receipt
+// is written.
+func EmitChannelUpgradeErrorEvent(ctx sdk.Context, portID, channelID string, errorReceipt types.ErrorReceipt) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelUpgradeError,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", errorReceipt.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeErrorReceipt, errorReceipt.Message),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// proofHeight returns the earliest height at which the counterparty can prove state
+// written in the current block. State is committed to in the app hash of the next
+// block, hence a proof must be queried at the height following the current one.
+func proofHeight(ctx sdk.Context) exported.Height {
+	return clienttypes.GetSelfHeight(ctx).Increment()
+}
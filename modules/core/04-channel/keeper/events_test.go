@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestPacketEventQueries tests that the events of a relayed packet are matched by the
+// Tendermint event queries of its channel and packet identifiers.
+func (suite *KeeperTestSuite) TestPacketEventQueries() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	ctx := suite.chainA.GetContext()
+	channelCap := suite.chainA.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, packet)
+	suite.Require().NoError(err)
+	sendEvents := txEvents(ctx.EventManager().ABCIEvents())
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	recvEvents := txEvents(res.Events)
+
+	// send_packet events are matched by the source channel
+	suite.requireMatch(true, sendEvents, types.EventTypeSendPacket, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.requireMatch(false, sendEvents, types.EventTypeSendPacket, path.EndpointA.ChannelConfig.PortID, "channel-1")
+
+	// recv_packet and write_acknowledgement events are matched by the destination channel
+	suite.requireMatch(true, recvEvents, types.EventTypeRecvPacket, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.requireMatch(true, recvEvents, types.EventTypeWriteAck, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.requireMatch(false, recvEvents, types.EventTypeRecvPacket, path.EndpointA.ChannelConfig.PortID, "channel-1")
+
+	// the packet identifier is identical on both chains
+	for _, tc := range []struct {
+		events    map[string][]string
+		eventType string
+	}{
+		{sendEvents, types.EventTypeSendPacket},
+		{recvEvents, types.EventTypeRecvPacket},
+		{recvEvents, types.EventTypeWriteAck},
+	} {
+		q, err := types.PacketEventQuery(tc.eventType, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().NoError(err)
+
+		matches, err := query.MustParse(q).Matches(tc.events)
+		suite.Require().NoError(err)
+		suite.Require().True(matches, q)
+
+		q, err = types.PacketEventQuery(tc.eventType, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+		suite.Require().NoError(err)
+
+		matches, err = query.MustParse(q).Matches(tc.events)
+		suite.Require().NoError(err)
+		suite.Require().False(matches, q)
+	}
+}
+
+// TestChannelStateTransitionEvent tests that a channel state transition event is emitted with the
+// metadata of the channel when a channel end is closed.
+func (suite *KeeperTestSuite) TestChannelStateTransitionEvent() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanCloseInit(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap)
+	suite.Require().NoError(err)
+
+	var attributes map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeChannelStateTransition {
+			continue
+		}
+
+		suite.Require().Nil(attributes, "a single state transition event is expected")
+		attributes = make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+	}
+
+	suite.Require().Equal(map[string]string{
+		types.AttributeKeyPortID:               path.EndpointA.ChannelConfig.PortID,
+		types.AttributeKeyChannelID:            path.EndpointA.ChannelID,
+		types.AttributeKeyPreviousChannelState: types.OPEN.String(),
+		types.AttributeKeyChannelState:         types.CLOSED.String(),
+		types.AttributeKeyOrdering:             types.UNORDERED.String(),
+		types.AttributeKeyVersion:              path.EndpointA.ChannelConfig.Version,
+		types.AttributeKeyConnectionHops:       path.EndpointA.ConnectionID,
+		types.AttributeCounterpartyPortID:      path.EndpointB.ChannelConfig.PortID,
+		types.AttributeCounterpartyChannelID:   path.EndpointB.ChannelID,
+	}, attributes)
+
+	// no event is emitted if the state is unchanged
+	ctx = suite.chainA.GetContext()
+	keeper.EmitChannelStateTransitionEvent(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.CLOSED, path.EndpointA.GetChannel())
+	suite.Require().Empty(ctx.EventManager().Events())
+}
+
+// requireMatch checks whether the channel event query of the given event type and channel matches
+// the provided events.
+func (suite *KeeperTestSuite) requireMatch(expMatch bool, events map[string][]string, eventType, portID, channelID string) {
+	q, err := types.ChannelPacketEventQuery(eventType, portID, channelID)
+	suite.Require().NoError(err)
+
+	matches, err := query.MustParse(q).Matches(events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expMatch, matches, q)
+}
+
+// txEvents returns the events of a transaction as indexed by Tendermint for event queries.
+func txEvents(events []abci.Event) map[string][]string {
+	indexed := map[string][]string{
+		tmtypes.EventTypeKey: {tmtypes.EventTx},
+	}
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			key := event.Type + "." + string(attr.Key)
+			indexed[key] = append(indexed[key], string(attr.Value))
+		}
+	}
+
+	return indexed
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -16,6 +15,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -54,7 +54,7 @@ func (q Keeper) Channels(c context.Context, req *types.QueryChannelsRequest) (*t
 	channels := []*types.IdentifiedChannel{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
 		var result types.Channel
 		if err := q.cdc.Unmarshal(value, &result); err != nil {
 			return err
@@ -97,26 +97,29 @@ func (q Keeper) ConnectionChannels(c context.Context, req *types.QueryConnection
 	channels := []*types.IdentifiedChannel{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var result types.Channel
 		if err := q.cdc.Unmarshal(value, &result); err != nil {
-			return err
+			return false, err
 		}
 
 		// ignore channel and continue to the next item if the connection is
 		// different than the requested one
 		if result.ConnectionHops[0] != req.Connection {
-			return nil
+			return false, nil
 		}
 
-		portID, channelID, err := host.ParseChannelPath(string(key))
-		if err != nil {
-			return err
+		if accumulate {
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
 		}
 
-		identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
-		channels = append(channels, &identifiedChannel)
-		return nil
+		return true, nil
 	})
 
 	if err != nil {
@@ -131,6 +134,105 @@ func (q Keeper) ConnectionChannels(c context.Context, req *types.QueryConnection
 	}, nil
 }
 
+// ChannelsByClient implements the Query/ChannelsByClient gRPC method
+func (q Keeper) ChannelsByClient(c context.Context, req *types.QueryChannelsByClientRequest) (*types.QueryChannelsByClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// connectionClients caches the client identifiers of the connections already looked up
+	connectionClients := make(map[string]string)
+
+	channels := []*types.IdentifiedChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
+
+	pageRes, err := pagination.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var result types.Channel
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return false, err
+		}
+
+		connectionID := result.ConnectionHops[0]
+		clientID, ok := connectionClients[connectionID]
+		if !ok {
+			connection, found := q.connectionKeeper.GetConnection(ctx, connectionID)
+			if found {
+				clientID = connection.GetClientID()
+			}
+
+			connectionClients[connectionID] = clientID
+		}
+
+		// ignore channel and continue to the next item if the connection is
+		// built on top of a different client than the requested one
+		if clientID != req.ClientId {
+			return false, nil
+		}
+
+		if accumulate {
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelsByClientResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// DuplicateChannels implements the Query/DuplicateChannels gRPC method
+func (q Keeper) DuplicateChannels(c context.Context, req *types.QueryDuplicateChannelsRequest) (*types.QueryDuplicateChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	channels := []*types.IdentifiedChannel{}
+	for _, duplicate := range q.GetDuplicateChannels(ctx, req.PortId, req.ChannelId, channel) {
+		duplicate := duplicate
+		channels = append(channels, &duplicate)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryDuplicateChannelsResponse{
+		Channels: channels,
+		Height:   selfHeight,
+	}, nil
+}
+
 // ChannelClientState implements the Query/ChannelClientState gRPC method
 func (q Keeper) ChannelClientState(c context.Context, req *types.QueryChannelClientStateRequest) (*types.QueryChannelClientStateResponse, error) {
 	if req == nil {
@@ -240,7 +342,7 @@ func (q Keeper) PacketCommitments(c context.Context, req *types.QueryPacketCommi
 	commitments := []*types.PacketState{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.PacketCommitmentPrefixPath(req.PortId, req.ChannelId)))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
 		keySplit := strings.Split(string(key), "/")
 
 		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
@@ -265,6 +367,42 @@ func (q Keeper) PacketCommitments(c context.Context, req *types.QueryPacketCommi
 	}, nil
 }
 
+// PrioritizedPackets implements the Query/PrioritizedPackets gRPC method
+func (q Keeper) PrioritizedPackets(c context.Context, req *types.QueryPrioritizedPacketsRequest) (*types.QueryPrioritizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packets := []types.PrioritizedPacket{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.PrioritizedPacketsPrefixKey(req.PortId, req.ChannelId))
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		priority, sequence, err := parsePrioritizedPacketKey(key)
+		if err != nil {
+			return err
+		}
+
+		packets = append(packets, types.NewPrioritizedPacket(req.PortId, req.ChannelId, sequence, priority))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPrioritizedPacketsResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // PacketReceipt implements the Query/PacketReceipt gRPC method
 func (q Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptRequest) (*types.QueryPacketReceiptResponse, error) {
 	if req == nil {
@@ -348,7 +486,7 @@ func (q Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacket
 		}, nil
 	}
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, value []byte) error {
 		keySplit := strings.Split(string(key), "/")
 
 		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
@@ -374,6 +512,46 @@ func (q Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacket
 	}, nil
 }
 
+// PacketAcknowledgementsByRange implements the Query/PacketAcknowledgementsByRange gRPC method.
+// The acknowledgements are looked up by sequence within the requested range, so that the
+// acknowledgements written before the range are not iterated.
+func (q Keeper) PacketAcknowledgementsByRange(c context.Context, req *types.QueryPacketAcknowledgementsByRangeRequest) (*types.QueryPacketAcknowledgementsByRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	acks := []*types.PacketState{}
+	pageRes, err := pagination.PaginateSequences(req.StartSequence, req.EndSequence, req.Pagination, func(sequence uint64, accumulate bool) (bool, error) {
+		acknowledgementBz, found := q.GetPacketAcknowledgement(ctx, req.PortId, req.ChannelId, sequence)
+		if !found || len(acknowledgementBz) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			ack := types.NewPacketState(req.PortId, req.ChannelId, sequence, acknowledgementBz)
+			acks = append(acks, &ack)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketAcknowledgementsByRangeResponse{
+		Acknowledgements: acks,
+		Pagination:       pageRes,
+		Height:           selfHeight,
+	}, nil
+}
+
 // UnreceivedPackets implements the Query/UnreceivedPackets gRPC method. Given
 // a list of counterparty packet commitments, the querier checks if the packet
 // has already been received by checking if a receipt exists on this
@@ -422,6 +600,50 @@ func (q Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 	}, nil
 }
 
+// UnreceivedPacketsByRange implements the Query/UnreceivedPacketsByRange gRPC method. It
+// returns the sequences within the requested range for which no packet receipt exists on
+// this chain, without requiring the list of counterparty packet commitments.
+//
+// NOTE: The sequences of packets which have not been sent by the counterparty are returned
+// as well. The range should therefore end at or before the last packet sequence sent on
+// the counterparty channel end, and the result should be checked against the packet
+// commitments of the counterparty chain before relaying.
+func (q Keeper) UnreceivedPacketsByRange(c context.Context, req *types.QueryUnreceivedPacketsByRangeRequest) (*types.QueryUnreceivedPacketsByRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var unreceivedSequences = []uint64{}
+	pageRes, err := pagination.PaginateSequences(req.StartSequence, req.EndSequence, req.Pagination, func(sequence uint64, accumulate bool) (bool, error) {
+		// if packet receipt exists on the receiving chain, then packet has already been received
+		if _, found := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, sequence); found {
+			return false, nil
+		}
+
+		if accumulate {
+			unreceivedSequences = append(unreceivedSequences, sequence)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryUnreceivedPacketsByRangeResponse{
+		Sequences:  unreceivedSequences,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // UnreceivedAcks implements the Query/UnreceivedAcks gRPC method. Given
 // a list of counterparty packet acknowledgements, the querier checks if the packet
 // has already been received by checking if the packet commitment still exists on this
@@ -472,6 +694,101 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *types.QueryUnreceivedAcks
 	}, nil
 }
 
+// SendPacketEvents implements the Query/SendPacketEvents gRPC method. The send packet events
+// are reconstructed from the event data kept in state for the pending packets within the
+// requested range, so that relayers can recover them without querying indexed events.
+func (q Keeper) SendPacketEvents(c context.Context, req *types.QuerySendPacketEventsRequest) (*types.QuerySendPacketEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	events := []types.SendPacketEvent{}
+	pageRes, err := pagination.PaginateSequences(req.StartSequence, req.EndSequence, req.Pagination, func(sequence uint64, accumulate bool) (bool, error) {
+		event, found := q.GetSendPacketEvent(ctx, channel, req.PortId, req.ChannelId, sequence)
+		if !found {
+			return false, nil
+		}
+
+		if accumulate {
+			events = append(events, event)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QuerySendPacketEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// WriteAcknowledgementEvents implements the Query/WriteAcknowledgementEvents gRPC method.
+// The write acknowledgement events are reconstructed from the event data kept in state for
+// the acknowledgements written within the requested range, so that relayers can recover
+// them without querying indexed events.
+func (q Keeper) WriteAcknowledgementEvents(c context.Context, req *types.QueryWriteAcknowledgementEventsRequest) (*types.QueryWriteAcknowledgementEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	events := []types.WriteAcknowledgementEvent{}
+	pageRes, err := pagination.PaginateSequences(req.StartSequence, req.EndSequence, req.Pagination, func(sequence uint64, accumulate bool) (bool, error) {
+		event, found := q.GetWriteAcknowledgementEvent(ctx, channel, req.PortId, req.ChannelId, sequence)
+		if !found {
+			return false, nil
+		}
+
+		if accumulate {
+			events = append(events, event)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryWriteAcknowledgementEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // NextSequenceReceive implements the Query/NextSequenceReceive gRPC method
 func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSequenceReceiveRequest) (*types.QueryNextSequenceReceiveResponse, error) {
 	if req == nil {
@@ -506,3 +823,310 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// PacketDataSchemas implements the Query/PacketDataSchemas gRPC method
+func (q Keeper) PacketDataSchemas(c context.Context, req *types.QueryPacketDataSchemasRequest) (*types.QueryPacketDataSchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schemas := q.GetPacketDataSchemas()
+	if schemas == nil {
+		schemas = []types.PacketDataSchema{}
+	}
+
+	return &types.QueryPacketDataSchemasResponse{
+		Schemas: schemas,
+	}, nil
+}
+
+// PacketDataSchema implements the Query/PacketDataSchema gRPC method
+func (q Keeper) PacketDataSchema(c context.Context, req *types.QueryPacketDataSchemaRequest) (*types.QueryPacketDataSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	schema, found := types.MatchPacketDataSchema(q.GetPacketDataSchemas(), req.PortId, channel.Version)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrInvalidPacketDataSchema, "no schema registered for port-id: %s, version: %s", req.PortId, channel.Version).Error(),
+		)
+	}
+
+	return &types.QueryPacketDataSchemaResponse{
+		Schema: schema,
+	}, nil
+}
+
+// DeadLetterPacket implements the Query/DeadLetterPacket gRPC method
+func (q Keeper) DeadLetterPacket(c context.Context, req *types.QueryDeadLetterPacketRequest) (*types.QueryDeadLetterPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	deadLetter, found := q.GetDeadLetterPacket(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrDeadLetterPacketNotFound, "port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryDeadLetterPacketResponse{
+		DeadLetterPacket: deadLetter,
+		Height:           clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// DeadLetterPackets implements the Query/DeadLetterPackets gRPC method
+func (q Keeper) DeadLetterPackets(c context.Context, req *types.QueryDeadLetterPacketsRequest) (*types.QueryDeadLetterPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deadLetters := []types.DeadLetterPacket{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.DeadLetterPacketPrefixPath(req.PortId, req.ChannelId)))
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var deadLetter types.DeadLetterPacket
+		if err := q.cdc.Unmarshal(value, &deadLetter); err != nil {
+			return err
+		}
+
+		deadLetters = append(deadLetters, deadLetter)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeadLetterPacketsResponse{
+		DeadLetterPackets: deadLetters,
+		Pagination:        pageRes,
+		Height:            clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// PacketLatency implements the Query/PacketLatency gRPC method
+func (q Keeper) PacketLatency(c context.Context, req *types.QueryPacketLatencyRequest) (*types.QueryPacketLatencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryPacketLatencyResponse{
+		Latency:        q.GetPacketLatency(ctx, req.PortId, req.ChannelId),
+		BucketBoundsMs: types.PacketLatencyBucketBoundsMs(),
+		Height:         clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// ChannelHandshakeStep implements the Query/ChannelHandshakeStep gRPC method
+func (q Keeper) ChannelHandshakeStep(c context.Context, req *types.QueryChannelHandshakeStepRequest) (*types.QueryChannelHandshakeStepResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	msgTypeURL, submitToCounterparty, err := types.NextHandshakeStep(channel.State, req.CounterpartyState)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	height := clienttypes.GetSelfHeight(ctx)
+
+	// proofs of this chain queried at the current height are verified at the next height
+	proofHeight := height.Increment().(clienttypes.Height)
+	if !submitToCounterparty {
+		_, clientState, err := q.GetChannelClientState(ctx, req.PortId, req.ChannelId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		proofHeight = clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), clientState.GetLatestHeight().GetRevisionHeight())
+	}
+
+	return &types.QueryChannelHandshakeStepResponse{
+		NextMsgTypeUrl:       msgTypeURL,
+		SubmitToCounterparty: submitToCounterparty,
+		ProofHeight:          proofHeight,
+		Height:               height,
+	}, nil
+}
+
+// QuarantinedChannels implements the Query/QuarantinedChannels gRPC method
+func (q Keeper) QuarantinedChannels(c context.Context, req *types.QueryQuarantinedChannelsRequest) (*types.QueryQuarantinedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := []types.QuarantinedChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyQuarantinedChannels))
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var quarantined types.QuarantinedChannel
+		if err := q.cdc.Unmarshal(value, &quarantined); err != nil {
+			return err
+		}
+
+		channels = append(channels, quarantined)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuarantinedChannelsResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// PendingAsyncAcknowledgements implements the Query/PendingAsyncAcknowledgements gRPC method
+func (q Keeper) PendingAsyncAcknowledgements(c context.Context, req *types.QueryPendingAsyncAcknowledgementsRequest) (*types.QueryPendingAsyncAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := q.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	acks := []types.PendingAsyncAcknowledgement{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.PendingAsyncAcknowledgementsPrefixKey(req.PortId, req.ChannelId))
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pending types.PendingAsyncAcknowledgement
+		if err := q.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+
+		acks = append(acks, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingAsyncAcknowledgementsResponse{
+		Acknowledgements: acks,
+		Pagination:       pageRes,
+		Height:           clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// Upgrade implements the Query/Upgrade gRPC method
+func (q Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*types.QueryUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	upgrade, found := q.GetUpgrade(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
+}
+
+// UpgradeError implements the Query/UpgradeError gRPC method
+func (q Keeper) UpgradeError(c context.Context, req *types.QueryUpgradeErrorRequest) (*types.QueryUpgradeErrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	errorReceipt, found := q.GetUpgradeErrorReceipt(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUpgradeErrorReceiptNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeErrorResponse(errorReceipt, nil, selfHeight), nil
+}
+
+// MiddlewareStack implements the Query/MiddlewareStack gRPC method. The middleware stack of a
+// channel is resolved with the IBC router, which is only set on the core IBC keeper.
+func (q Keeper) MiddlewareStack(c context.Context, req *types.QueryMiddlewareStackRequest) (*types.QueryMiddlewareStackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "middleware stack queries are served by the core IBC keeper")
+}
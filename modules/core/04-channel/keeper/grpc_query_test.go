@@ -10,6 +10,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -315,6 +316,260 @@ func (suite *KeeperTestSuite) TestQueryConnectionChannels() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionChannelsPagination() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// path1 creates a second channel on the first connection on chainA
+	path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path1.EndpointA.ClientID = path.EndpointA.ClientID
+	path1.EndpointB.ClientID = path.EndpointB.ClientID
+	path1.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	path1.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+	suite.coordinator.CreateMockChannels(path1)
+
+	// path2 creates a channel on a second connection on chainA which must not be counted
+	path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path2)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	queryClient := suite.chainA.QueryServer
+
+	res, err := queryClient.ConnectionChannels(ctx, &types.QueryConnectionChannelsRequest{
+		Connection: path.EndpointA.ConnectionID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	first := res.Channels[0]
+
+	// the total of a key based page request only counts the channels of the connection
+	res, err = queryClient.ConnectionChannels(ctx, &types.QueryConnectionChannelsRequest{
+		Connection: path.EndpointA.ConnectionID,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	last := res.Channels[0]
+	suite.Require().NotEqual(first, last)
+
+	res, err = queryClient.ConnectionChannels(ctx, &types.QueryConnectionChannelsRequest{
+		Connection: path.EndpointA.ConnectionID,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.IdentifiedChannel{last}, res.Channels)
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelsByClient() {
+	var (
+		req         *types.QueryChannelsByClientRequest
+		expChannels = []*types.IdentifiedChannel{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryChannelsByClientRequest{
+					ClientId: "",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// path1 creates a channel on a second connection of the same client on chainA
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.EndpointA.ClientID = path.EndpointA.ClientID
+				path1.EndpointB.ClientID = path.EndpointB.ClientID
+				suite.coordinator.CreateConnections(path1)
+				suite.coordinator.CreateMockChannels(path1)
+
+				// path2 creates a channel on a different client which is not returned
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path2)
+
+				idCh0 := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+				idCh1 := types.NewIdentifiedChannel(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, path1.EndpointA.GetChannel())
+
+				expChannels = []*types.IdentifiedChannel{&idCh0, &idCh1}
+
+				req = &types.QueryChannelsByClientRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, empty response",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expChannels = []*types.IdentifiedChannel{}
+				req = &types.QueryChannelsByClientRequest{
+					ClientId: "07-tendermint-100",
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelsByClient(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDuplicateChannels() {
+	var (
+		req         *types.QueryDuplicateChannelsRequest
+		expChannels = []*types.IdentifiedChannel{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryDuplicateChannelsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryDuplicateChannelsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// path1 creates a second channel on the same connection and ports
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.EndpointA.ClientID = path.EndpointA.ClientID
+				path1.EndpointB.ClientID = path.EndpointB.ClientID
+				path1.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				path1.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+				suite.coordinator.CreateMockChannels(path1)
+
+				// path2 creates a channel on a different connection which is not a duplicate
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path2)
+
+				// a third channel on the same connection which is not OPEN is not a duplicate
+				path3 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path3.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				path3.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+				suite.Require().NoError(path3.EndpointA.ChanOpenInit())
+
+				idCh0 := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+				expChannels = []*types.IdentifiedChannel{&idCh0}
+
+				req = &types.QueryDuplicateChannelsRequest{
+					PortId:    path1.EndpointA.ChannelConfig.PortID,
+					ChannelId: path1.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"success, no duplicates",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expChannels = []*types.IdentifiedChannel{}
+				req = &types.QueryDuplicateChannelsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.DuplicateChannels(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelClientState() {
 	var (
 		req                      *types.QueryChannelClientStateRequest
@@ -1101,10 +1356,11 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgementsByRange() {
 	var (
-		req    *types.QueryUnreceivedPacketsRequest
-		expSeq = []uint64{}
+		req                 *types.QueryPacketAcknowledgementsByRangeRequest
+		expAcknowledgements = []*types.PacketState{}
+		expNextKey          []byte
 	)
 
 	testCases := []struct {
@@ -1120,93 +1376,125 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 			false,
 		},
 		{
-			"invalid port ID",
+			"invalid ID",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:    "",
-					ChannelId: "test-channel-id",
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        "",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   10,
 				}
 			},
 			false,
 		},
 		{
-			"invalid channel ID",
+			"start sequence is 0",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:    "test-port-id",
-					ChannelId: "",
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 0,
+					EndSequence:   10,
 				}
 			},
 			false,
 		},
 		{
-			"invalid seq",
+			"end sequence less than start sequence",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    "test-port-id",
-					ChannelId:                 "test-channel-id",
-					PacketCommitmentSequences: []uint64{0},
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 10,
+					EndSequence:   9,
 				}
 			},
 			false,
 		},
 		{
-			"basic success unreceived packet commitments",
+			"range exceeds the maximum range",
 			func() {
-				path := ibctesting.NewPath(suite.chainA, suite.chainB)
-				suite.coordinator.Setup(path)
-
-				// no ack exists
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   pagination.MaxSequenceRange + 1,
+				}
+			},
+			false,
+		},
+		{
+			"success, empty res",
+			func() {
+				expAcknowledgements = []*types.PacketState{}
+				expNextKey = nil
 
-				expSeq = []uint64{1}
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{1},
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   10,
 				}
 			},
 			true,
 		},
 		{
-			"basic success unreceived packet commitments, nothing to relay",
+			"success, acknowledgements within the range",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				suite.coordinator.Setup(path)
 
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+				expAcknowledgements = []*types.PacketState{}
+				expNextKey = nil
 
-				expSeq = []uint64{}
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{1},
+				for i := uint64(1); i < 100; i++ {
+					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+
+					if i >= 50 && i <= 60 {
+						expAcknowledgements = append(expAcknowledgements, &ack)
+					}
+				}
+
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        path.EndpointA.ChannelConfig.PortID,
+					ChannelId:     path.EndpointA.ChannelID,
+					StartSequence: 50,
+					EndSequence:   60,
 				}
 			},
 			true,
 		},
 		{
-			"success multiple unreceived packet commitments",
+			"success, paginated",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				suite.coordinator.Setup(path)
-				expSeq = []uint64{} // reset
-				packetCommitments := []uint64{}
 
-				// set packet receipt for every other sequence
-				for seq := uint64(1); seq < 10; seq++ {
-					packetCommitments = append(packetCommitments, seq)
+				expAcknowledgements = []*types.PacketState{}
 
-					if seq%2 == 0 {
-						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
-					} else {
-						expSeq = append(expSeq, seq)
+				// acknowledgements are only written for every other sequence
+				for i := uint64(2); i < 20; i += 2 {
+					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+
+					if i >= 6 && i < 12 {
+						expAcknowledgements = append(expAcknowledgements, &ack)
 					}
 				}
 
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: packetCommitments,
+				// the next key is the sequence of the next acknowledgement
+				expNextKey = sdk.Uint64ToBigEndian(12)
+
+				req = &types.QueryPacketAcknowledgementsByRangeRequest{
+					PortId:        path.EndpointA.ChannelConfig.PortID,
+					ChannelId:     path.EndpointA.ChannelID,
+					StartSequence: 1,
+					EndSequence:   20,
+					Pagination: &query.PageRequest{
+						Key:   sdk.Uint64ToBigEndian(5),
+						Limit: 3,
+					},
 				}
 			},
 			true,
@@ -1220,12 +1508,13 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 			tc.malleate()
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 
-			res, err := suite.chainA.QueryServer.UnreceivedPackets(ctx, req)
+			res, err := suite.chainA.QueryServer.PacketAcknowledgementsByRange(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expAcknowledgements, res.Acknowledgements)
+				suite.Require().Equal(expNextKey, res.Pagination.NextKey)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1233,9 +1522,9 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
+func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	var (
-		req    *types.QueryUnreceivedAcksRequest
+		req    *types.QueryUnreceivedPacketsRequest
 		expSeq = []uint64{}
 	)
 
@@ -1254,7 +1543,7 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
+				req = &types.QueryUnreceivedPacketsRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
 				}
@@ -1264,7 +1553,7 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
+				req = &types.QueryUnreceivedPacketsRequest{
 					PortId:    "test-port-id",
 					ChannelId: "",
 				}
@@ -1274,8 +1563,272 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		{
 			"invalid seq",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
-					PortId:             "test-port-id",
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    "test-port-id",
+					ChannelId:                 "test-channel-id",
+					PacketCommitmentSequences: []uint64{0},
+				}
+			},
+			false,
+		},
+		{
+			"basic success unreceived packet commitments",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// no ack exists
+
+				expSeq = []uint64{1}
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"basic success unreceived packet commitments, nothing to relay",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+				expSeq = []uint64{}
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"success multiple unreceived packet commitments",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = []uint64{} // reset
+				packetCommitments := []uint64{}
+
+				// set packet receipt for every other sequence
+				for seq := uint64(1); seq < 10; seq++ {
+					packetCommitments = append(packetCommitments, seq)
+
+					if seq%2 == 0 {
+						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+					} else {
+						expSeq = append(expSeq, seq)
+					}
+				}
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: packetCommitments,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.UnreceivedPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedPacketsByRange() {
+	var (
+		req      *types.QueryUnreceivedPacketsByRangeRequest
+		expSeq   = []uint64{}
+		expTotal uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUnreceivedPacketsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "",
+					StartSequence: 1,
+					EndSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"start sequence is 0",
+			func() {
+				req = &types.QueryUnreceivedPacketsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 0,
+					EndSequence:   10,
+				}
+			},
+			false,
+		},
+		{
+			"reverse pagination",
+			func() {
+				req = &types.QueryUnreceivedPacketsByRangeRequest{
+					PortId:        "test-port-id",
+					ChannelId:     "test-channel-id",
+					StartSequence: 1,
+					EndSequence:   10,
+					Pagination:    &query.PageRequest{Reverse: true},
+				}
+			},
+			false,
+		},
+		{
+			"success, nothing to relay",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				for seq := uint64(1); seq <= 5; seq++ {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				}
+
+				expSeq = []uint64{}
+				expTotal = 0
+				req = &types.QueryUnreceivedPacketsByRangeRequest{
+					PortId:        path.EndpointA.ChannelConfig.PortID,
+					ChannelId:     path.EndpointA.ChannelID,
+					StartSequence: 1,
+					EndSequence:   5,
+				}
+			},
+			true,
+		},
+		{
+			"success, multiple unreceived packets",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = []uint64{} // reset
+
+				// set packet receipt for every other sequence
+				for seq := uint64(1); seq < 20; seq++ {
+					if seq%2 == 0 {
+						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+					} else if seq >= 7 && len(expSeq) < 3 {
+						expSeq = append(expSeq, seq)
+					}
+				}
+
+				// the total counts the unreceived packets of the whole range
+				expTotal = 8
+				req = &types.QueryUnreceivedPacketsByRangeRequest{
+					PortId:        path.EndpointA.ChannelConfig.PortID,
+					ChannelId:     path.EndpointA.ChannelID,
+					StartSequence: 2,
+					EndSequence:   17,
+					Pagination: &query.PageRequest{
+						Offset:     2,
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.UnreceivedPacketsByRange(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expTotal, res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
+	var (
+		req    *types.QueryUnreceivedAcksRequest
+		expSeq = []uint64{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"invalid seq",
+			func() {
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:             "test-port-id",
 					ChannelId:          "test-channel-id",
 					PacketAckSequences: []uint64{0},
 				}
@@ -1445,3 +1998,270 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPacketDataSchemas() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	_, err := suite.chainA.QueryServer.PacketDataSchemas(ctx, nil)
+	suite.Require().Error(err)
+
+	schemas := []types.PacketDataSchema{
+		types.NewPacketDataSchema(ibctesting.MockPort, ibctesting.DefaultChannelVersion, "/mock.PacketData"),
+		types.NewPacketDataSchema(ibctesting.TransferPort, "", "/transfer.PacketData"),
+	}
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDataSchemas(schemas)
+
+	res, err := suite.chainA.QueryServer.PacketDataSchemas(ctx, &types.QueryPacketDataSchemasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(schemas, res.Schemas)
+
+	// invalid and duplicate schemas are rejected
+	suite.Require().Panics(func() {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDataSchemas([]types.PacketDataSchema{types.NewPacketDataSchema("", "", "/mock.PacketData")})
+	})
+	suite.Require().Panics(func() {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDataSchemas(append(schemas, schemas[0]))
+	})
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketDataSchema() {
+	var (
+		req       *types.QueryPacketDataSchemaRequest
+		expSchema types.PacketDataSchema
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketDataSchemaRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketDataSchemaRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"no schema registered for the channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDataSchemas([]types.PacketDataSchema{
+					types.NewPacketDataSchema(ibctesting.MockPort, "other-version", "/mock.PacketData"),
+				})
+
+				req = &types.QueryPacketDataSchemaRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expSchema = types.NewPacketDataSchema(ibctesting.MockPort, path.EndpointA.ChannelConfig.Version, "/mock.PacketData")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDataSchemas([]types.PacketDataSchema{
+					types.NewPacketDataSchema(ibctesting.MockPort, "other-version", "/mock.OtherPacketData"),
+					expSchema,
+				})
+
+				req = &types.QueryPacketDataSchemaRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketDataSchema(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSchema, res.Schema)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDeadLetterPackets() {
+	var (
+		req            *types.QueryDeadLetterPacketsRequest
+		expDeadLetters = []types.DeadLetterPacket{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryDeadLetterPacketsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success, empty res",
+			func() {
+				expDeadLetters = []types.DeadLetterPacket{}
+
+				req = &types.QueryDeadLetterPacketsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expDeadLetters = make([]types.DeadLetterPacket, 9)
+
+				for i := uint64(1); i <= 9; i++ {
+					packet := types.NewPacket(ibctesting.MockPacketData, i, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+					deadLetter := types.NewDeadLetterPacket(packet, fmt.Sprintf("reason_%d", i), clienttypes.GetSelfHeight(suite.chainA.GetContext()))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetDeadLetterPacket(suite.chainA.GetContext(), deadLetter)
+					expDeadLetters[i-1] = deadLetter
+				}
+
+				req = &types.QueryDeadLetterPacketsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.DeadLetterPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expDeadLetters, res.DeadLetterPackets)
+				suite.Require().Equal(uint64(len(expDeadLetters)), res.Pagination.Total)
+
+				for _, deadLetter := range expDeadLetters {
+					res, err := suite.chainA.QueryServer.DeadLetterPacket(ctx, &types.QueryDeadLetterPacketRequest{
+						PortId:    deadLetter.Packet.SourcePort,
+						ChannelId: deadLetter.Packet.SourceChannel,
+						Sequence:  deadLetter.Packet.Sequence,
+					})
+					suite.Require().NoError(err)
+					suite.Require().Equal(deadLetter, res.DeadLetterPacket)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelHandshakeStep() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	queryStep := func(endpoint *ibctesting.Endpoint, counterpartyState types.State) (*types.QueryChannelHandshakeStepResponse, error) {
+		return endpoint.Chain.QueryServer.ChannelHandshakeStep(sdk.WrapSDKContext(endpoint.Chain.GetContext()), &types.QueryChannelHandshakeStepRequest{
+			PortId:            endpoint.ChannelConfig.PortID,
+			ChannelId:         endpoint.ChannelID,
+			CounterpartyState: counterpartyState,
+		})
+	}
+
+	path.EndpointA.ChannelID = ibctesting.FirstChannelID
+	_, err := queryStep(path.EndpointA, types.UNINITIALIZED)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(path.EndpointA.ChanOpenInit())
+	res, err := queryStep(path.EndpointA, types.UNINITIALIZED)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgChannelOpenTry{}), res.NextMsgTypeUrl)
+	suite.Require().True(res.SubmitToCounterparty)
+	suite.Require().Equal(res.Height.Increment(), res.ProofHeight)
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	res, err = queryStep(path.EndpointA, types.TRYOPEN)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgChannelOpenAck{}), res.NextMsgTypeUrl)
+	suite.Require().False(res.SubmitToCounterparty)
+	suite.Require().Equal(path.EndpointA.GetClientState().GetLatestHeight(), res.ProofHeight)
+
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+	res, err = queryStep(path.EndpointB, types.OPEN)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.NextMsgTypeUrl)
+
+	// an open channel end cannot have an initialized counterparty
+	_, err = queryStep(path.EndpointB, types.INIT)
+	suite.Require().Error(err)
+}
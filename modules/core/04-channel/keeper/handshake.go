@@ -27,15 +27,38 @@ func (k Keeper) ChanOpenInit(
 	counterparty types.Counterparty,
 	version string,
 ) (string, *capabilitytypes.Capability, error) {
+	if err := k.validateChanOpenInit(ctx, order, connectionHops, portID, portCap); err != nil {
+		return "", nil, err
+	}
+
+	channelID := k.GenerateChannelIdentifier(ctx)
+
+	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
+	}
+
+	return channelID, capKey, nil
+}
+
+// validateChanOpenInit performs the checks of the OpenInit handshake step which precede
+// the generation of the channel identifier.
+func (k Keeper) validateChanOpenInit(
+	ctx sdk.Context,
+	order types.Order,
+	connectionHops []string,
+	portID string,
+	portCap *capabilitytypes.Capability,
+) error {
 	// connection hop length checked on msg.ValidateBasic()
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionHops[0])
 	if !found {
-		return "", nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
 	}
 
 	getVersions := connectionEnd.GetVersions()
 	if len(getVersions) != 1 {
-		return "", nil, sdkerrors.Wrapf(
+		return sdkerrors.Wrapf(
 			connectiontypes.ErrInvalidVersion,
 			"single version must be negotiated on connection before opening channel, got: %v",
 			getVersions,
@@ -43,7 +66,7 @@ func (k Keeper) ChanOpenInit(
 	}
 
 	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
-		return "", nil, sdkerrors.Wrapf(
+		return sdkerrors.Wrapf(
 			connectiontypes.ErrInvalidVersion,
 			"connection version %s does not support channel ordering: %s",
 			getVersions[0], order.String(),
@@ -51,17 +74,10 @@ func (k Keeper) ChanOpenInit(
 	}
 
 	if !k.portKeeper.Authenticate(ctx, portCap, portID) {
-		return "", nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "caller does not own port capability for port ID %s", portID)
-	}
-
-	channelID := k.GenerateChannelIdentifier(ctx)
-
-	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
-	if err != nil {
-		return "", nil, sdkerrors.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "caller does not own port capability for port ID %s", portID)
 	}
 
-	return channelID, capKey, nil
+	return nil
 }
 
 // WriteOpenInitChannel writes a channel which has successfully passed the OpenInit handshake step.
@@ -90,6 +106,9 @@ func (k Keeper) WriteOpenInitChannel(
 	}()
 
 	EmitChannelOpenInitEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, types.UNINITIALIZED, channel)
+
+	k.emitDuplicateChannelEvent(ctx, portID, channelID, channel)
 }
 
 // ChanOpenTry is called by a module to accept the first step of a channel opening
@@ -248,6 +267,9 @@ func (k Keeper) WriteOpenTryChannel(
 	}()
 
 	EmitChannelOpenTryEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousChannel.State, channel)
+
+	k.emitDuplicateChannelEvent(ctx, portID, channelID, channel)
 }
 
 // ChanOpenAck is called by the handshake-originating module to acknowledge the
@@ -324,18 +346,20 @@ func (k Keeper) WriteOpenAckChannel(
 		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanOpenAck step, channelID: %s, portID: %s", channelID, portID))
 	}
 
+	previousState := channel.State
 	channel.State = types.OPEN
 	channel.Version = counterpartyVersion
 	channel.Counterparty.ChannelId = counterpartyChannelID
 	k.SetChannel(ctx, portID, channelID, channel)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", "OPEN")
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", "OPEN")
 
 	defer func() {
 		telemetry.IncrCounter(1, "ibc", "channel", "open-ack")
 	}()
 
 	EmitChannelOpenAckEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
 }
 
 // ChanOpenConfirm is called by the counterparty module to close their end of the
@@ -407,15 +431,17 @@ func (k Keeper) WriteOpenConfirmChannel(
 		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanOpenConfirm step, channelID: %s, portID: %s", channelID, portID))
 	}
 
+	previousState := channel.State
 	channel.State = types.OPEN
 	k.SetChannel(ctx, portID, channelID, channel)
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "TRYOPEN", "new-state", "OPEN")
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", "OPEN")
 
 	defer func() {
 		telemetry.IncrCounter(1, "ibc", "channel", "open-confirm")
 	}()
 
 	EmitChannelOpenConfirmEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
 }
 
 // Closing Handshake
@@ -462,16 +488,19 @@ func (k Keeper) ChanCloseInit(
 		telemetry.IncrCounter(1, "ibc", "channel", "close-init")
 	}()
 
+	previousState := channel.State
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	EmitChannelCloseInitEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
 
 	return nil
 }
 
 // ChanCloseConfirm is called by the counterparty module to close their end of the
-// channel, since the other end has been closed.
+// channel, since the other end has been closed. The counterparty upgrade sequence
+// is the upgrade sequence of the closed counterparty channel end.
 func (k Keeper) ChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
@@ -479,6 +508,7 @@ func (k Keeper) ChanCloseConfirm(
 	chanCap *capabilitytypes.Capability,
 	proofInit []byte,
 	proofHeight exported.Height,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
 		return sdkerrors.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)")
@@ -512,6 +542,7 @@ func (k Keeper) ChanCloseConfirm(
 		types.CLOSED, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofInit,
@@ -527,10 +558,26 @@ func (k Keeper) ChanCloseConfirm(
 		telemetry.IncrCounter(1, "ibc", "channel", "close-confirm")
 	}()
 
+	previousState := channel.State
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	EmitChannelCloseConfirmEvent(ctx, portID, channelID, channel)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
 
 	return nil
 }
+
+// emitDuplicateChannelEvent emits an advisory event if the provided new channel uses the same
+// port, connection and counterparty port as existing OPEN channels, helping front-ends to converge
+// on canonical channels. The channel handshake is not affected.
+func (k Keeper) emitDuplicateChannelEvent(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	duplicates := k.GetDuplicateChannels(ctx, portID, channelID, channel)
+	if len(duplicates) == 0 {
+		return
+	}
+
+	k.Logger(ctx).Info("channel duplicates open channels", "port-id", portID, "channel-id", channelID, "duplicates", len(duplicates))
+
+	EmitDuplicateChannelEvent(ctx, portID, channelID, channel, duplicates)
+}
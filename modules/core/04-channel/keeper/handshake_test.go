@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
@@ -137,6 +138,76 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 	}
 }
 
+// TestDuplicateChannelEvent tests that an advisory event is emitted when a channel handshake
+// is started for a port, connection and counterparty port which already have an OPEN channel.
+func (suite *KeeperTestSuite) TestDuplicateChannelEvent() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	counterparty := types.NewCounterparty(ibctesting.MockPort, "")
+
+	// duplicateEvents returns the duplicate channel events emitted when writing a new channel
+	duplicateEvents := func(connectionID string) []abci.Event {
+		ctx := suite.chainA.GetContext()
+		channelKeeper.WriteOpenInitChannel(ctx, ibctesting.MockPort, "channel-100", types.UNORDERED, []string{connectionID}, counterparty, ibctesting.DefaultChannelVersion)
+
+		var events []abci.Event
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type == types.EventTypeDuplicateChannel {
+				events = append(events, event)
+			}
+		}
+
+		return events
+	}
+
+	events := duplicateEvents(path.EndpointA.ConnectionID)
+	suite.Require().Len(events, 1)
+
+	attributes := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+
+	suite.Require().Equal("channel-100", attributes[types.AttributeKeyChannelID])
+	suite.Require().Equal(path.EndpointA.ConnectionID, attributes[types.AttributeKeyConnectionID])
+	suite.Require().Equal(path.EndpointA.ChannelID, attributes[types.AttributeKeyDuplicateChannelIDs])
+
+	// channels on other connections are not duplicates
+	suite.Require().Empty(duplicateEvents("connection-100"))
+}
+
+func (suite *KeeperTestSuite) TestChanOpenInitWithReservedSequence() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+	portCap := suite.chainA.GetPortCapability(ibctesting.MockPort)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	ctx := suite.chainA.GetContext()
+	counterparty := types.NewCounterparty(ibctesting.MockPort, "")
+	connectionHops := []string{path.EndpointA.ConnectionID}
+
+	reserved := channelKeeper.ReserveChannelIdentifiers(ctx, 1)
+	suite.Require().Equal([]uint64{0}, reserved)
+
+	// generated channel identifiers skip the reserved sequences
+	channelID, _, err := channelKeeper.ChanOpenInit(ctx, types.UNORDERED, connectionHops, ibctesting.MockPort, portCap, counterparty, ibctesting.DefaultChannelVersion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FormatChannelIdentifier(1), channelID)
+
+	channelID, cap, err := channelKeeper.ChanOpenInitWithReservedSequence(ctx, reserved[0], types.UNORDERED, connectionHops, ibctesting.MockPort, portCap, counterparty, ibctesting.DefaultChannelVersion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FormatChannelIdentifier(0), channelID)
+	suite.Require().True(suite.chainA.App.GetScopedIBCKeeper().AuthenticateCapability(ctx, cap, host.ChannelCapabilityPath(ibctesting.MockPort, channelID)))
+	suite.Require().Empty(channelKeeper.GetReservedChannelSequences(ctx))
+
+	// a reserved sequence is only used once
+	_, _, err = channelKeeper.ChanOpenInitWithReservedSequence(ctx, reserved[0], types.UNORDERED, connectionHops, ibctesting.MockPort, portCap, counterparty, ibctesting.DefaultChannelVersion)
+	suite.Require().ErrorIs(err, types.ErrChannelSequenceNotReserved)
+}
+
 // TestChanOpenTry tests the OpenTry handshake call for channels. It uses message passing
 // to enter into the appropriate state and then calls ChanOpenTry directly. The channel
 // is being created on chainB. The port capability must be created on chainB before
@@ -798,7 +869,7 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 
 			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanCloseConfirm(
 				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID, channelCap,
-				proof, malleateHeight(proofHeight, heightDiff),
+				proof, malleateHeight(proofHeight, heightDiff), 0,
 			)
 
 			if tc.expPass {
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// RegisterInvariants registers the channel invariants with the crisis module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(host.ModuleName, "channel-acknowledgements", AcknowledgementsInvariant(k))
+	ir.RegisterRoute(host.ModuleName, "channel-sequences", SequencesInvariant(k))
+	ir.RegisterRoute(host.ModuleName, "channel-commitments", CommitmentsInvariant(k))
+}
+
+// AllInvariants runs all the channel invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			AcknowledgementsInvariant(k),
+			SequencesInvariant(k),
+			CommitmentsInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// AcknowledgementsInvariant checks that every packet acknowledgement was written for a
+// received packet. On UNORDERED channels a packet receipt must exist for the sequence of the
+// acknowledgement, on ORDERED channels the sequence must be lower than the next receive
+// sequence.
+func AcknowledgementsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IteratePacketAcknowledgement(ctx, func(portID, channelID string, sequence uint64, _ []byte) bool {
+			channel, found := k.GetChannel(ctx, portID, channelID)
+			if !found {
+				broken++
+				msg += fmt.Sprintf("\tacknowledgement (%s, %s, %d) is stored for a channel which does not exist\n", portID, channelID, sequence)
+				return false
+			}
+
+			switch channel.Ordering {
+			case types.UNORDERED:
+				if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); !found {
+					broken++
+					msg += fmt.Sprintf("\tacknowledgement (%s, %s, %d) is stored without a packet receipt\n", portID, channelID, sequence)
+				}
+			case types.ORDERED:
+				if nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID); sequence >= nextSequenceRecv {
+					broken++
+					msg += fmt.Sprintf("\tacknowledgement (%s, %s, %d) is stored for a packet not received, next receive sequence is %d\n", portID, channelID, sequence, nextSequenceRecv)
+				}
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			host.ModuleName, "channel-acknowledgements",
+			fmt.Sprintf("%d acknowledgements stored without receiving their packet\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// SequencesInvariant checks that the packet sequences of every channel are consistent. The
+// next send, receive and acknowledgement sequences start at 1 and only increase, so they
+// may not be zero, every packet commitment must have a sequence lower than the next send
+// sequence, and on ORDERED channels the next acknowledgement sequence may not exceed the
+// next send sequence.
+func SequencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+			// sequences are only set once the channel handshake is started on this chain
+			if channel.State == types.UNINITIALIZED {
+				return false
+			}
+
+			nextSequenceSend, _ := k.GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId)
+			nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, channel.PortId, channel.ChannelId)
+			nextSequenceAck, _ := k.GetNextSequenceAck(ctx, channel.PortId, channel.ChannelId)
+
+			if nextSequenceSend == 0 || nextSequenceRecv == 0 || nextSequenceAck == 0 {
+				broken++
+				msg += fmt.Sprintf("\tchannel (%s, %s) has a zero sequence: send %d, receive %d, acknowledgement %d\n",
+					channel.PortId, channel.ChannelId, nextSequenceSend, nextSequenceRecv, nextSequenceAck)
+			}
+
+			if channel.Ordering == types.ORDERED && nextSequenceAck > nextSequenceSend {
+				broken++
+				msg += fmt.Sprintf("\tchannel (%s, %s) has next acknowledgement sequence %d greater than next send sequence %d\n",
+					channel.PortId, channel.ChannelId, nextSequenceAck, nextSequenceSend)
+			}
+
+			return false
+		})
+
+		k.IteratePacketCommitment(ctx, func(portID, channelID string, sequence uint64, _ []byte) bool {
+			if nextSequenceSend, _ := k.GetNextSequenceSend(ctx, portID, channelID); sequence >= nextSequenceSend {
+				broken++
+				msg += fmt.Sprintf("\tpacket commitment (%s, %s, %d) is not lower than the next send sequence %d\n", portID, channelID, sequence, nextSequenceSend)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			host.ModuleName, "channel-sequences",
+			fmt.Sprintf("%d inconsistent packet sequences found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// CommitmentsInvariant checks that packet commitments are only stored for channels which
+// were opened. Packets may only be sent on OPEN channels, the commitments of a channel
+// which was closed afterwards remain stored until the packets are timed out.
+func CommitmentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IteratePacketCommitment(ctx, func(portID, channelID string, sequence uint64, _ []byte) bool {
+			channel, found := k.GetChannel(ctx, portID, channelID)
+			switch {
+			case !found:
+				broken++
+				msg += fmt.Sprintf("\tpacket commitment (%s, %s, %d) is stored for a channel which does not exist\n", portID, channelID, sequence)
+			case channel.State != types.OPEN && channel.State != types.FLUSHING && channel.State != types.CLOSED:
+				broken++
+				msg += fmt.Sprintf("\tpacket commitment (%s, %s, %d) is stored for a channel in state %s\n", portID, channelID, sequence, channel.State)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			host.ModuleName, "channel-commitments",
+			fmt.Sprintf("%d packet commitments stored for channels which were not opened\n%s", broken, msg),
+		), broken != 0
+	}
+}
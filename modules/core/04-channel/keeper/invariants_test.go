@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success", func() {}, false,
+		},
+		{
+			"acknowledgement without packet receipt", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("ack"))
+			}, true,
+		},
+		{
+			"acknowledgement with packet receipt", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("ack"))
+			}, false,
+		},
+		{
+			"acknowledgement of packet not received on ORDERED channel", func() {
+				channel := path.EndpointB.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointB.SetChannel(channel)
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("ack"))
+			}, true,
+		},
+		{
+			"acknowledgement of packet received on ORDERED channel", func() {
+				channel := path.EndpointB.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointB.SetChannel(channel)
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("ack"))
+			}, false,
+		},
+		{
+			"zero next sequence", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 0)
+			}, true,
+		},
+		{
+			"next acknowledgement sequence greater than next send sequence on ORDERED channel", func() {
+				channel := path.EndpointB.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointB.SetChannel(channel)
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+			}, true,
+		},
+		{
+			"packet commitment not lower than next send sequence", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("hash"))
+			}, true,
+		},
+		{
+			"packet commitment on CLOSED channel", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("hash"))
+				suite.Require().NoError(path.EndpointB.SetChannelClosed())
+			}, false,
+		},
+		{
+			"packet commitment on channel in INIT", func() {
+				channel := path.EndpointB.GetChannel()
+				channel.State = types.INIT
+				path.EndpointB.SetChannel(channel)
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1, []byte("hash"))
+			}, true,
+		},
+		{
+			"packet commitment for channel which does not exist", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, ibctesting.InvalidID, 1, []byte("hash"))
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			msg, broken := keeper.AllInvariants(suite.chainB.App.GetIBCKeeper().ChannelKeeper)(suite.chainB.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
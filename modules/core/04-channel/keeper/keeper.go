@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
@@ -22,6 +24,8 @@ import (
 
 var _ porttypes.ICS4Wrapper = Keeper{}
 
+var _ types.ChannelKeeper = Keeper{}
+
 // Keeper defines the IBC channel keeper
 type Keeper struct {
 	// implements gRPC QueryServer interface
@@ -33,6 +37,8 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     capabilitykeeper.ScopedKeeper
+
+	packetDataSchemas []types.PacketDataSchema
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -56,6 +62,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
+// StackLayerName implements the StackLayer interface of the port submodule, the channel keeper
+// being the last ICS4Wrapper of the middleware stacks.
+func (k Keeper) StackLayerName() string {
+	return host.ModuleName
+}
+
 // GenerateChannelIdentifier returns the next channel identifier.
 func (k Keeper) GenerateChannelIdentifier(ctx sdk.Context) string {
 	nextChannelSeq := k.GetNextChannelSequence(ctx)
@@ -194,9 +206,86 @@ func (k Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string, s
 	store.Set(host.PacketCommitmentKey(portID, channelID, sequence), commitmentHash)
 }
 
+// deletePacketCommitment deletes the commitment, the send time and the priority class of a sent
+// packet and records the current block height as the height at which the commitment was cleared.
 func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
+	k.setPacketHeight(ctx, host.PacketClearHeightKey(portID, channelID, sequence))
+	k.deletePacketSendTime(ctx, portID, channelID, sequence)
+	k.deletePacketPriority(ctx, portID, channelID, sequence)
+	k.deleteSendPacketEventData(ctx, portID, channelID, sequence)
+}
+
+// GetPacketClearHeight returns the block height at which the commitment of the sent packet
+// was cleared by its acknowledgement or timeout.
+func (k Keeper) GetPacketClearHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketClearHeightKey(portID, channelID, sequence))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setPacketRecvHeight records the current block height as the height at which the packet was
+// received.
+func (k Keeper) setPacketRecvHeight(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	k.setPacketHeight(ctx, host.PacketRecvHeightKey(portID, channelID, sequence))
+}
+
+// GetPacketRecvHeight returns the block height at which the packet was received.
+func (k Keeper) GetPacketRecvHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketRecvHeightKey(portID, channelID, sequence))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setPacketHeight stores the current block height under the provided receive or clear height
+// key and indexes the key by the current block height, so that it is pruned once the retention
+// period of PacketHeightRetention blocks has elapsed.
+func (k Keeper) setPacketHeight(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	height := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+	store.Set(key, height)
+
+	expiriesStore := prefix.NewStore(store, host.PacketHeightExpiriesPrefixKey())
+	expiriesStore.Set(append(height, key...), key)
+}
+
+// PrunePacketHeights deletes the packet receive and clear heights stored more than
+// PacketHeightRetention blocks ago, in the order in which they were stored and at most
+// PacketHeightPruneLimit of them. Packet already relayed events report a processed height of
+// zero once the height of the packet is pruned. It is called at the beginning of every block.
+func (k Keeper) PrunePacketHeights(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	if height <= types.PacketHeightRetention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	expiriesStore := prefix.NewStore(store, host.PacketHeightExpiriesPrefixKey())
+
+	// the heights stored at block heights up to and including the end height have expired
+	endHeight := height - types.PacketHeightRetention
+	iterator := expiriesStore.Iterator(nil, sdk.Uint64ToBigEndian(endHeight+1))
+
+	var expiryKeys, heightKeys [][]byte
+	for ; iterator.Valid() && len(expiryKeys) < types.PacketHeightPruneLimit; iterator.Next() {
+		expiryKeys = append(expiryKeys, iterator.Key())
+		heightKeys = append(heightKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range heightKeys {
+		store.Delete(key)
+		expiriesStore.Delete(expiryKeys[i])
+	}
 }
 
 // SetPacketAcknowledgement sets the packet ack hash to the store
@@ -383,6 +472,32 @@ func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChan
 	return channels
 }
 
+// GetDuplicateChannels returns the OPEN channels on the provided port which use the same
+// connection and counterparty port as the provided channel, excluding the channel itself.
+// Only the channels of the provided port are iterated.
+func (k Keeper) GetDuplicateChannels(ctx sdk.Context, portID, channelID string, channel types.Channel) []types.IdentifiedChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/%s/", host.KeyChannelEndPrefix, host.PortPath(portID))))
+	defer iterator.Close()
+
+	var duplicates []types.IdentifiedChannel
+	for ; iterator.Valid(); iterator.Next() {
+		var existing types.Channel
+		k.cdc.MustUnmarshal(iterator.Value(), &existing)
+
+		_, existingChannelID := host.MustParseChannelPath(string(iterator.Key()))
+		if existingChannelID == channelID || existing.State != types.OPEN ||
+			existing.ConnectionHops[0] != channel.ConnectionHops[0] ||
+			existing.Counterparty.PortId != channel.Counterparty.PortId {
+			continue
+		}
+
+		duplicates = append(duplicates, types.NewIdentifiedChannel(portID, existingChannelID, existing))
+	}
+
+	return duplicates
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -403,6 +518,17 @@ func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string)
 	return connection.ClientId, clientState, nil
 }
 
+// GetChannelClientStatus returns the client identifier and the status of the client
+// underlying the connection of the given channel.
+func (k Keeper) GetChannelClientStatus(ctx sdk.Context, portID, channelID string) (string, exported.Status, error) {
+	clientID, clientState, err := k.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", exported.Unknown, err
+	}
+
+	return clientID, clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc), nil
+}
+
 // GetConnection wraps the connection keeper's GetConnection function.
 func (k Keeper) GetConnection(ctx sdk.Context, connectionID string) (exported.ConnectionI, error) {
 	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// setPacketSendTime records the current block time as the time at which the packet was sent.
+func (k Keeper) setPacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketSendTimeKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano())))
+}
+
+// GetPacketSendTime returns the block time at which the packet was sent. The send time is only
+// stored until the packet is acknowledged or timed out.
+func (k Keeper) GetPacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketSendTimeKey(portID, channelID, sequence))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(sdk.BigEndianToUint64(bz))).UTC(), true
+}
+
+// deletePacketSendTime deletes the block time at which the packet was sent.
+func (k Keeper) deletePacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketSendTimeKey(portID, channelID, sequence))
+}
+
+// GetPacketLatency returns the latency statistics of the acknowledged packets sent on the given
+// channel.
+func (k Keeper) GetPacketLatency(ctx sdk.Context, portID, channelID string) types.PacketLatency {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketLatencyKey(portID, channelID))
+	if bz == nil {
+		return types.PacketLatency{}
+	}
+
+	var latency types.PacketLatency
+	k.cdc.MustUnmarshal(bz, &latency)
+	return latency
+}
+
+// SetPacketLatency stores the latency statistics of the acknowledged packets sent on the given
+// channel.
+func (k Keeper) SetPacketLatency(ctx sdk.Context, portID, channelID string, latency types.PacketLatency) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&latency)
+	store.Set(host.PacketLatencyKey(portID, channelID), bz)
+}
+
+// recordPacketLatency records the time elapsed between the blocks in which the acknowledged
+// packet was sent and acknowledged in the latency statistics of its channel, and reports it in
+// an event and the packet latency telemetry sample. Packets sent without a recorded send time
+// are ignored.
+func (k Keeper) recordPacketLatency(ctx sdk.Context, packet exported.PacketI) {
+	sendTime, found := k.GetPacketSendTime(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	latency := ctx.BlockTime().Sub(sendTime)
+	if latency < 0 {
+		latency = 0
+	}
+
+	stats := k.GetPacketLatency(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	stats.Record(latency)
+	k.SetPacketLatency(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), stats)
+
+	EmitPacketLatencyEvent(ctx, packet, latency)
+
+	metrics.AddSampleWithLabels(
+		[]string{"ibc", "packet", "latency"},
+		float32(latency.Milliseconds()),
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelSourcePort, packet.GetSourcePort()),
+			telemetry.NewLabel(types.LabelSourceChannel, packet.GetSourceChannel()),
+			telemetry.NewLabel(types.LabelDestinationPort, packet.GetDestPort()),
+			telemetry.NewLabel(types.LabelDestinationChannel, packet.GetDestChannel()),
+		},
+	)
+}
@@ -17,12 +17,20 @@ import (
 
 // SendPacket is called by a module in order to send an IBC packet on a channel
 // end owned by the calling module to the corresponding module on the counterparty
-// chain.
+// chain. The priority class set in the context with WithPacketPriority is emitted in
+// the send packet event and stored along with the packet commitment, so that relayers
+// can identify the packets to relay first with the PrioritizedPackets query. It does
+// not affect the processing of the packet.
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
 	channelCap *capabilitytypes.Capability,
 	packet exported.PacketI,
 ) error {
+	priority := types.GetPacketPriority(ctx)
+	if err := priority.Validate(); err != nil {
+		return err
+	}
+
 	if err := packet.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "packet failed basic validation")
 	}
@@ -39,6 +47,21 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	// channel ends stop sending packets once they start flushing for an upgrade
+	if channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel is being upgraded (got %s)", channel.State.String(),
+		)
+	}
+
+	if k.IsChannelQuarantined(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return sdkerrors.Wrapf(
+			types.ErrChannelQuarantined,
+			"cannot send packets on a quarantined channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -75,35 +98,47 @@ func (k Keeper) SendPacket(
 
 	// check if packet is timed out on the receiving chain
 	latestHeight := clientState.GetLatestHeight()
+	if connectionEnd.GetClientID() == exported.Localhost {
+		// the receiving chain of a local channel is the running chain
+		latestHeight = k.clientKeeper.GetHostHeight(ctx)
+	}
+
 	timeoutHeight := packet.GetTimeoutHeight()
-	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
+	if k.timeoutHeightReached(ctx, clientState.ClientType(), latestHeight, timeoutHeight) {
 		return sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
 		)
 	}
 
-	clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
-	if err != nil {
-		return err
-	}
-
-	// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
-	// A future change should move this function to be a ClientState callback.
-	if clientType != exported.Solomachine {
-		latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+	// NOTE: a zero latest timestamp never times out the packet.
+	var latestTimestamp uint64
+	if connectionEnd.GetClientID() == exported.Localhost {
+		// the localhost client does not store consensus states
+		latestTimestamp = k.clientKeeper.GetHostTimestamp(ctx)
+	} else {
+		clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
 		if err != nil {
 			return err
 		}
 
-		if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
-			return sdkerrors.Wrapf(
-				types.ErrPacketTimeout,
-				"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
-			)
+		// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
+		// A future change should move this function to be a ClientState callback.
+		if clientType != exported.Solomachine {
+			latestTimestamp, err = k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
+	if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
+		)
+	}
+
 	nextSequenceSend, found := k.GetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
 		return sdkerrors.Wrapf(
@@ -124,8 +159,11 @@ func (k Keeper) SendPacket(
 	nextSequenceSend++
 	k.SetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceSend)
 	k.SetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment)
+	k.setPacketSendTime(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.setPacketPriority(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), priority)
+	k.setSendPacketEventData(ctx, packet)
 
-	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
+	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight, priority)
 
 	k.Logger(ctx).Info(
 		"packet sent",
@@ -153,10 +191,10 @@ func (k Keeper) RecvPacket(
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state should be OPEN, FLUSHING or FLUSHCOMPLETE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -184,6 +222,17 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
+	// the counterparty channel end does not send packets once it starts flushing for an upgrade
+	if channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE {
+		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if found && packet.GetSequence() >= counterpartyUpgrade.NextSequenceSend {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidPacket,
+				"packet sequence ≥ counterparty next sequence send at the start of flushing (%d ≥ %d)", packet.GetSequence(), counterpartyUpgrade.NextSequenceSend,
+			)
+		}
+	}
+
 	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
 	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
 	// connection and channel must both be open
@@ -200,7 +249,7 @@ func (k Keeper) RecvPacket(
 	}
 
 	// check if packet timeouted by comparing it with the latest height of the chain
-	selfHeight := clienttypes.GetSelfHeight(ctx)
+	selfHeight := k.clientKeeper.GetHostHeight(ctx)
 	timeoutHeight := packet.GetTimeoutHeight()
 	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
 		return sdkerrors.Wrapf(
@@ -210,10 +259,11 @@ func (k Keeper) RecvPacket(
 	}
 
 	// check if packet timeouted by comparing it with the latest timestamp of the chain
-	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+	selfTimestamp := k.clientKeeper.GetHostTimestamp(ctx)
+	if packet.GetTimeoutTimestamp() != 0 && selfTimestamp >= packet.GetTimeoutTimestamp() {
 		return sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
-			"block timestamp >= packet timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
+			"block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(selfTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
 		)
 	}
 
@@ -234,6 +284,7 @@ func (k Keeper) RecvPacket(
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
 			EmitRecvPacketEvent(ctx, packet, channel)
+			k.emitPacketAlreadyRelayedEvent(ctx, packet, channel, types.EventTypeRecvPacket)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -258,6 +309,7 @@ func (k Keeper) RecvPacket(
 
 		if packet.GetSequence() < nextSequenceRecv {
 			EmitRecvPacketEvent(ctx, packet, channel)
+			k.emitPacketAlreadyRelayedEvent(ctx, packet, channel, types.EventTypeRecvPacket)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -281,6 +333,8 @@ func (k Keeper) RecvPacket(
 
 	}
 
+	k.setPacketRecvHeight(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
 	// log that a packet has been received & executed
 	k.Logger(ctx).Info(
 		"packet received",
@@ -319,10 +373,10 @@ func (k Keeper) WriteAcknowledgement(
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state should be OPEN, FLUSHING or FLUSHCOMPLETE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -356,6 +410,8 @@ func (k Keeper) WriteAcknowledgement(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CommitAcknowledgement(bz),
 	)
+	k.setWriteAcknowledgementEventData(ctx, packet, bz)
+	k.clearPendingAsyncAcknowledgement(ctx, packet)
 
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
@@ -394,10 +450,10 @@ func (k Keeper) AcknowledgePacket(
 		)
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state should be OPEN or FLUSHING (got %s)", channel.State.String(),
 		)
 	}
 
@@ -441,6 +497,7 @@ func (k Keeper) AcknowledgePacket(
 
 	if len(commitment) == 0 {
 		EmitAcknowledgePacketEvent(ctx, packet, channel)
+		k.emitPacketAlreadyRelayedEvent(ctx, packet, channel, types.EventTypeAcknowledgePacket)
 		// This error indicates that the acknowledgement has already been relayed
 		// or there is a misconfigured relayer attempting to prove an acknowledgement
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -488,8 +545,11 @@ func (k Keeper) AcknowledgePacket(
 
 	}
 
+	k.recordPacketLatency(ctx, packet)
+
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.handleFlushState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// setSendPacketEventData stores the event data of a sent packet until the packet is
+// acknowledged or timed out. Only the commitment of the packet is kept in state otherwise,
+// from which its send packet event cannot be reconstructed.
+func (k Keeper) setSendPacketEventData(ctx sdk.Context, packet exported.PacketI) {
+	store := ctx.KVStore(k.storeKey)
+	data := types.NewPacketEventData(packet, nil, clienttypes.GetSelfHeight(ctx))
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(host.SendPacketEventKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), bz)
+}
+
+// GetSendPacketEventData returns the event data stored for a sent packet which has not been
+// acknowledged or timed out yet.
+func (k Keeper) GetSendPacketEventData(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketEventData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.SendPacketEventKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketEventData{}, false
+	}
+
+	var data types.PacketEventData
+	k.cdc.MustUnmarshal(bz, &data)
+	return data, true
+}
+
+// deleteSendPacketEventData deletes the event data of a sent packet.
+func (k Keeper) deleteSendPacketEventData(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.SendPacketEventKey(portID, channelID, sequence))
+}
+
+// setWriteAcknowledgementEventData stores the event data of a written acknowledgement and
+// indexes it by the current block height, so that it is pruned once the retention period of
+// WriteAcknowledgementEventRetention blocks has elapsed.
+func (k Keeper) setWriteAcknowledgementEventData(ctx sdk.Context, packet exported.PacketI, acknowledgement []byte) {
+	store := ctx.KVStore(k.storeKey)
+	data := types.NewPacketEventData(packet, acknowledgement, clienttypes.GetSelfHeight(ctx))
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(host.WriteAcknowledgementEventKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), bz)
+
+	expiriesStore := prefix.NewStore(store, host.WriteAcknowledgementEventExpiriesPrefixKey())
+	packetID := types.NewPacketState(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), nil)
+	expiriesStore.Set(
+		writeAcknowledgementEventExpiryKey(uint64(ctx.BlockHeight()), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		k.cdc.MustMarshal(&packetID),
+	)
+}
+
+// PruneWriteAcknowledgementEventData deletes the event data of the acknowledgements written
+// more than WriteAcknowledgementEventRetention blocks ago, in the order in which they were
+// written and at most WriteAcknowledgementEventPruneLimit of them. It is called at the
+// beginning of every block.
+func (k Keeper) PruneWriteAcknowledgementEventData(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	if height <= types.WriteAcknowledgementEventRetention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	expiriesStore := prefix.NewStore(store, host.WriteAcknowledgementEventExpiriesPrefixKey())
+
+	// the event data stored at heights up to and including the end height has expired
+	endHeight := height - types.WriteAcknowledgementEventRetention
+	iterator := expiriesStore.Iterator(nil, sdk.Uint64ToBigEndian(endHeight+1))
+
+	var (
+		expiryKeys [][]byte
+		packetIDs  []types.PacketState
+	)
+	for ; iterator.Valid() && len(expiryKeys) < types.WriteAcknowledgementEventPruneLimit; iterator.Next() {
+		var packetID types.PacketState
+		k.cdc.MustUnmarshal(iterator.Value(), &packetID)
+
+		expiryKeys = append(expiryKeys, iterator.Key())
+		packetIDs = append(packetIDs, packetID)
+	}
+	iterator.Close()
+
+	for i, packetID := range packetIDs {
+		store.Delete(host.WriteAcknowledgementEventKey(packetID.PortId, packetID.ChannelId, packetID.Sequence))
+		expiriesStore.Delete(expiryKeys[i])
+	}
+}
+
+// writeAcknowledgementEventExpiryKey returns the key of the event data of an acknowledgement in
+// the index of the write acknowledgement event data. The height at which the event data was
+// stored orders the index by age.
+func writeAcknowledgementEventExpiryKey(height uint64, portID, channelID string, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), host.PacketAcknowledgementKey(portID, channelID, sequence)...)
+}
+
+// GetWriteAcknowledgementEventData returns the event data stored for the acknowledgement
+// written for a received packet.
+func (k Keeper) GetWriteAcknowledgementEventData(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketEventData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.WriteAcknowledgementEventKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketEventData{}, false
+	}
+
+	var data types.PacketEventData
+	k.cdc.MustUnmarshal(bz, &data)
+	return data, true
+}
+
+// GetSendPacketEvent reconstructs the send packet event of a pending packet sent on the
+// provided channel from its event data, commitment and priority class.
+func (k Keeper) GetSendPacketEvent(ctx sdk.Context, channel types.Channel, portID, channelID string, sequence uint64) (types.SendPacketEvent, bool) {
+	data, found := k.GetSendPacketEventData(ctx, portID, channelID, sequence)
+	if !found {
+		return types.SendPacketEvent{}, false
+	}
+
+	return types.NewSendPacketEvent(
+		data.Packet, k.GetPacketCommitment(ctx, portID, channelID, sequence), channel.Ordering,
+		channel.ConnectionHops[0], k.GetPacketPriority(ctx, portID, channelID, sequence),
+		clienttypes.NewHeight(data.Height.RevisionNumber, data.Height.RevisionHeight+1),
+	), true
+}
+
+// GetWriteAcknowledgementEvent reconstructs the write acknowledgement event of a packet
+// received on the provided channel from its event data and acknowledgement commitment.
+func (k Keeper) GetWriteAcknowledgementEvent(ctx sdk.Context, channel types.Channel, portID, channelID string, sequence uint64) (types.WriteAcknowledgementEvent, bool) {
+	data, found := k.GetWriteAcknowledgementEventData(ctx, portID, channelID, sequence)
+	if !found {
+		return types.WriteAcknowledgementEvent{}, false
+	}
+
+	ackCommitment, _ := k.GetPacketAcknowledgement(ctx, portID, channelID, sequence)
+
+	return types.NewWriteAcknowledgementEvent(
+		data.Packet, data.Acknowledgement, ackCommitment, channel.ConnectionHops[0],
+		clienttypes.NewHeight(data.Height.RevisionNumber, data.Height.RevisionHeight+1),
+	), true
+}
@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -32,6 +35,24 @@ var (
 	channelIDB = "channelidB"
 )
 
+// eventAttribute returns the value of the first attribute with the given key in
+// the first event of the given type.
+func eventAttribute(events sdk.Events, eventType, key string) string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				return string(attr.Value)
+			}
+		}
+	}
+
+	return ""
+}
+
 // TestSendPacket tests SendPacket from chainA to chainB
 func (suite *KeeperTestSuite) TestSendPacket() {
 	var (
@@ -166,6 +187,26 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clientState.GetLatestHeight().(clienttypes.Height), disabledTimeoutTimestamp)
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, false},
+		{"timeout height passed for monotonic height client", func() {
+			suite.coordinator.Setup(path)
+			// swap client with solomachine
+			solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinesingle", "testing", 1)
+			path.EndpointA.ClientID = clienttypes.FormatClientIdentifier(exported.Solomachine, 10)
+			path.EndpointA.SetClientState(solomachine.ClientState())
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = path.EndpointA.ClientID
+			path.EndpointA.SetConnection(connection)
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.MonotonicHeightClients = []string{exported.Solomachine}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			// the revision number of the timeout height is ignored
+			latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+			timeoutHeight := clienttypes.NewHeight(latestHeight.GetRevisionNumber()+1, latestHeight.GetRevisionHeight())
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"timeout timestamp passed", func() {
 			suite.coordinator.Setup(path)
 			// use latest time on client state
@@ -211,10 +252,15 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 
 			tc.malleate()
 
-			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(suite.chainA.GetContext(), channelCap, packet)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, packet)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the commitment can be proven at the height following the current block
+				expProofHeight := clienttypes.GetSelfHeight(ctx).Increment().String()
+				suite.Require().Equal(expProofHeight, eventAttribute(ctx.EventManager().Events(), types.EventTypeSendPacket, types.AttributeKeyProofHeight))
 			} else {
 				suite.Require().Error(err)
 			}
@@ -404,28 +450,18 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 		}, false},
 		{"next receive sequence is not found", func() {
 			expError = types.ErrSequenceReceiveNotFound
-			suite.coordinator.SetupConnections(path)
-
-			path.EndpointA.ChannelID = ibctesting.FirstChannelID
-			path.EndpointB.ChannelID = ibctesting.FirstChannelID
-
-			// manually creating channel prevents next recv sequence from being set
-			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannel(
-				suite.chainB.GetContext(),
-				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
-				types.NewChannel(types.OPEN, types.ORDERED, types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointB.ConnectionID}, path.EndpointB.ChannelConfig.Version),
-			)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
 
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
 
-			// manually set packet commitment
-			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), types.CommitPacket(suite.chainA.App.AppCodec(), packet))
-			suite.chainB.CreateChannelCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
-
+			// remove the next sequence receive after the blocks are committed so the
+			// channel invariants are not asserted on the inconsistent state
+			store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(host.StoreKey))
+			store.Delete(host.NextSequenceRecvKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
-
-			path.EndpointA.UpdateClient()
-			path.EndpointB.UpdateClient()
 		}, false},
 		{"receipt already stored", func() {
 			expError = types.ErrNoOpMsg
@@ -490,6 +526,60 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 
 }
 
+// TestRecvPacketHostTimeOracle tests that the timeout of a received packet is evaluated against
+// the host time oracle of the client keeper, which cannot move the host time backwards.
+func (suite *KeeperTestSuite) TestRecvPacketHostTimeOracle() {
+	testCases := []struct {
+		msg              string
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	}{
+		{"timeout height passed", clienttypes.NewHeight(0, 100), disabledTimeoutTimestamp},
+		{"timeout timestamp passed", disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, tc.timeoutHeight, tc.timeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			// the packet has not timed out according to the block header
+			ctx := suite.chainB.GetContext()
+			cacheCtx, _ := ctx.CacheContext()
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(cacheCtx, channelCap, packet, proof, proofHeight)
+			suite.Require().NoError(err)
+
+			// the packet has timed out according to the host time oracle
+			clientKeeper := &suite.chainB.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.SetHostTimeOracle(ibcmock.HostTimeOracle{
+				Height:    clienttypes.NewHeight(0, 100),
+				Timestamp: uint64(ctx.BlockTime().Add(time.Hour).UnixNano()),
+			})
+
+			cacheCtx, _ = ctx.CacheContext()
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(cacheCtx, channelCap, packet, proof, proofHeight)
+			suite.Require().True(errors.Is(err, types.ErrPacketTimeout))
+
+			// an oracle lagging behind the block header does not extend the timeout of the packet
+			clientKeeper.SetHostTimeOracle(ibcmock.HostTimeOracle{Height: clienttypes.NewHeight(0, 1), Timestamp: 1})
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(ctx, channelCap, packet, proof, proofHeight)
+			suite.Require().True(errors.Is(err, types.ErrPacketTimeout))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	var (
 		path       *ibctesting.Path
@@ -575,10 +665,14 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 
 			tc.malleate()
 
-			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(suite.chainB.GetContext(), channelCap, packet, ack)
+			ctx := suite.chainB.GetContext()
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ack)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				expProofHeight := clienttypes.GetSelfHeight(ctx).Increment().String()
+				suite.Require().Equal(expProofHeight, eventAttribute(ctx.EventManager().Events(), types.EventTypeWriteAck, types.AttributeKeyProofHeight))
 			} else {
 				suite.Require().Error(err)
 			}
@@ -586,6 +680,48 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	}
 }
 
+// TestWriteAsyncAcknowledgement tests that packets received without an acknowledgement are
+// recorded as awaiting an asynchronous acknowledgement, which can only be written once.
+func (suite *KeeperTestSuite) TestWriteAsyncAcknowledgement() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+	asyncPacket := types.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	syncPacket := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	for _, packet := range []types.Packet{asyncPacket, syncPacket} {
+		suite.Require().NoError(path.EndpointA.SendPacket(packet))
+		suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	}
+
+	ctx := suite.chainB.GetContext()
+	pending, found := channelKeeper.GetPendingAsyncAcknowledgement(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, asyncPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(asyncPacket, pending.Packet)
+	suite.Require().False(channelKeeper.HasPendingAsyncAcknowledgement(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, syncPacket.GetSequence()))
+
+	res, err := channelKeeper.PendingAsyncAcknowledgements(sdk.WrapSDKContext(ctx), &types.QueryPendingAsyncAcknowledgementsRequest{
+		PortId:    path.EndpointB.ChannelConfig.PortID,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PendingAsyncAcknowledgement{pending}, res.Acknowledgements)
+
+	// the acknowledgement of a packet acknowledged synchronously cannot be written asynchronously
+	err = channelKeeper.WriteAsyncAcknowledgement(ctx, channelCap, syncPacket, ibcmock.MockAcknowledgement)
+	suite.Require().ErrorIs(err, types.ErrAsyncAcknowledgementNotPending)
+
+	suite.Require().NoError(channelKeeper.WriteAsyncAcknowledgement(ctx, channelCap, asyncPacket, ibcmock.MockAcknowledgement))
+	suite.Require().True(channelKeeper.HasPacketAcknowledgement(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, asyncPacket.GetSequence()))
+	suite.Require().Empty(channelKeeper.GetAllPendingAsyncAcknowledgements(ctx))
+
+	// the acknowledgement is only written once
+	err = channelKeeper.WriteAsyncAcknowledgement(ctx, channelCap, asyncPacket, ibcmock.MockAcknowledgement)
+	suite.Require().ErrorIs(err, types.ErrAsyncAcknowledgementNotPending)
+}
+
 // TestAcknowledgePacket tests the call AcknowledgePacket on chainA.
 func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 	var (
@@ -787,32 +923,22 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		}, false},
 		{"next ack sequence not found", func() {
 			expError = types.ErrSequenceAckNotFound
-			suite.coordinator.SetupConnections(path)
-
-			path.EndpointA.ChannelID = ibctesting.FirstChannelID
-			path.EndpointB.ChannelID = ibctesting.FirstChannelID
-
-			// manually creating channel prevents next sequence acknowledgement from being set
-			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(
-				suite.chainA.GetContext(),
-				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
-				types.NewChannel(types.OPEN, types.ORDERED, types.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), []string{path.EndpointA.ConnectionID}, path.EndpointA.ChannelConfig.Version),
-			)
-
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
-			// manually set packet commitment
-			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), types.CommitPacket(suite.chainA.App.AppCodec(), packet))
+			// create packet commitment
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
 
-			// manually set packet acknowledgement and capability
-			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence(), types.CommitAcknowledgement(ack.Acknowledgement()))
+			// create packet acknowledgement
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
 
-			suite.chainA.CreateChannelCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			// remove the next sequence acknowledgement after the blocks are committed so the
+			// channel invariants are not asserted on the inconsistent state
+			store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+			store.Delete(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
-
-			suite.coordinator.CommitBlock(path.EndpointA.Chain, path.EndpointB.Chain)
-
-			path.EndpointA.UpdateClient()
-			path.EndpointB.UpdateClient()
 		}, false},
 		{"next ack sequence mismatch ORDERED", func() {
 			expError = types.ErrPacketSequenceOutOfOrder
@@ -870,3 +996,269 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+// TestPacketAlreadyRelayedEvent tests that an event including the original processing height is
+// emitted for receive and acknowledgement messages of packets which have already been relayed.
+func (suite *KeeperTestSuite) TestPacketAlreadyRelayedEvent() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+
+	// alreadyRelayedAttributes returns the attributes of the packet already relayed event
+	alreadyRelayedAttributes := func(ctx sdk.Context) map[string]string {
+		var attributes map[string]string
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type != types.EventTypePacketAlreadyRelayed {
+				continue
+			}
+
+			suite.Require().Nil(attributes, "packet already relayed event emitted twice")
+			attributes = make(map[string]string)
+			for _, attr := range event.Attributes {
+				attributes[string(attr.Key)] = string(attr.Value)
+			}
+		}
+
+		suite.Require().NotNil(attributes, "packet already relayed event not emitted")
+		return attributes
+	}
+
+	recvHeight, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketRecvHeight(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	channelCap := suite.chainB.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+
+	ctx := suite.chainB.GetContext()
+	err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(ctx, channelCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	attributes := alreadyRelayedAttributes(ctx)
+	suite.Require().Equal(types.EventTypeRecvPacket, attributes[types.AttributeKeyRelayedEventType])
+	suite.Require().Equal(fmt.Sprintf("%d", recvHeight), attributes[types.AttributeKeyProcessedHeight])
+	suite.Require().Equal(path.EndpointB.ChannelID, attributes[types.AttributeKeyDstChannel])
+
+	clearHeight, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketClearHeight(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().NotZero(clearHeight)
+
+	proof, proofHeight = path.EndpointB.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	channelCap = suite.chainA.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+
+	ctx = suite.chainA.GetContext()
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePacket(ctx, channelCap, packet, ibcmock.MockAcknowledgement.Acknowledgement(), proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	attributes = alreadyRelayedAttributes(ctx)
+	suite.Require().Equal(types.EventTypeAcknowledgePacket, attributes[types.AttributeKeyRelayedEventType])
+	suite.Require().Equal(fmt.Sprintf("%d", clearHeight), attributes[types.AttributeKeyProcessedHeight])
+	suite.Require().Equal(path.EndpointA.ChannelID, attributes[types.AttributeKeySrcChannel])
+
+	// the heights are kept until the retention period has elapsed
+	ctx = suite.chainA.GetContext()
+	ctx = ctx.WithBlockHeight(int64(clearHeight + types.PacketHeightRetention - 1))
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketHeights(ctx)
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketClearHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	ctx = ctx.WithBlockHeight(int64(clearHeight + types.PacketHeightRetention))
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketHeights(ctx)
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketClearHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	ctx = suite.chainB.GetContext()
+	ctx = ctx.WithBlockHeight(int64(recvHeight + types.PacketHeightRetention))
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.PrunePacketHeights(ctx)
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketRecvHeight(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// TestPacketLatency tests that the time elapsed between the sending and the acknowledgement of a
+// packet is recorded in the latency statistics of its channel.
+func (suite *KeeperTestSuite) TestPacketLatency() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+	_, found := channelKeeper.GetPacketSendTime(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	suite.Require().Equal(types.PacketLatency{}, channelKeeper.GetPacketLatency(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel()))
+
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+
+	_, found = channelKeeper.GetPacketSendTime(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	latency := channelKeeper.GetPacketLatency(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+	suite.Require().Equal(uint64(1), latency.Count)
+	suite.Require().NotZero(latency.TotalMs)
+	suite.Require().Equal(latency.TotalMs, latency.MaxMs)
+	suite.Require().Len(latency.BucketCounts, len(types.PacketLatencyBuckets)+1)
+
+	var counted uint64
+	for _, count := range latency.BucketCounts {
+		counted += count
+	}
+	suite.Require().Equal(uint64(1), counted)
+
+	res, err := channelKeeper.PacketLatency(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryPacketLatencyRequest{PortId: packet.GetSourcePort(), ChannelId: packet.GetSourceChannel()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(latency, res.Latency)
+	suite.Require().Equal(types.PacketLatencyBucketBoundsMs(), res.BucketBoundsMs)
+}
+
+func (suite *KeeperTestSuite) TestSendPacketPriority() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	priorities := []types.PacketPriority{types.PRIORITY_DEFAULT, types.PRIORITY_HIGH, types.PRIORITY_CRITICAL, types.PRIORITY_HIGH}
+	packets := make([]types.Packet, len(priorities))
+	for i, priority := range priorities {
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+		ctx := types.WithPacketPriority(suite.chainA.GetContext(), priority)
+		suite.Require().NoError(channelKeeper.SendPacket(ctx, channelCap, packets[i]))
+
+		// the priority class is emitted in the send packet event
+		var emitted bool
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeSendPacket {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyPacketPriority {
+					suite.Require().Equal(priority.String(), string(attr.Value))
+					emitted = true
+				}
+			}
+		}
+		suite.Require().True(emitted)
+
+		suite.Require().Equal(priority, channelKeeper.GetPacketPriority(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, uint64(i+1)))
+	}
+
+	// an undefined priority class is rejected
+	packet := types.NewPacket(ibctesting.MockPacketData, 5, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err := channelKeeper.SendPacket(types.WithPacketPriority(suite.chainA.GetContext(), types.PacketPriority(3)), channelCap, packet)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+
+	// packets sent with the default priority class are not prioritized
+	expPackets := []types.PrioritizedPacket{
+		types.NewPrioritizedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3, types.PRIORITY_CRITICAL),
+		types.NewPrioritizedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2, types.PRIORITY_HIGH),
+		types.NewPrioritizedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 4, types.PRIORITY_HIGH),
+	}
+	suite.Require().Equal(expPackets, channelKeeper.GetPrioritizedPackets(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	// the priority class is deleted along with the commitment of an acknowledged packet
+	suite.Require().NoError(path.EndpointB.RecvPacket(packets[2]))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[2], ibcmock.MockAcknowledgement.Acknowledgement()))
+
+	suite.Require().Equal(types.PRIORITY_DEFAULT, channelKeeper.GetPacketPriority(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3))
+	suite.Require().Equal(expPackets[1:], channelKeeper.GetPrioritizedPackets(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	res, err := channelKeeper.PrioritizedPackets(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryPrioritizedPacketsRequest{
+		PortId:     path.EndpointA.ChannelConfig.PortID,
+		ChannelId:  path.EndpointA.ChannelID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPackets[1:2], res.Packets)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestPacketEvents() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packets := make([]types.Packet, 3)
+	proofHeights := make([]clienttypes.Height, 3)
+	for i := range packets {
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+		// the commitment is proven at the height following the block in which the packet is sent
+		proofHeights[i] = clienttypes.GetSelfHeight(suite.chainA.GetContext())
+		proofHeights[i].RevisionHeight++
+
+		suite.Require().NoError(path.EndpointA.SendPacket(packets[i]))
+		suite.Require().NoError(path.EndpointB.RecvPacket(packets[i]))
+	}
+
+	ack := ibcmock.MockAcknowledgement.Acknowledgement()
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[0], ack))
+
+	// the send packet event data is deleted along with the commitment of an acknowledged packet
+	_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetSendPacketEventData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+
+	sendRes, err := suite.chainA.QueryServer.SendPacketEvents(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QuerySendPacketEventsRequest{
+		PortId:        path.EndpointA.ChannelConfig.PortID,
+		ChannelId:     path.EndpointA.ChannelID,
+		StartSequence: 1,
+		EndSequence:   10,
+	})
+	suite.Require().NoError(err)
+
+	expSendEvents := []types.SendPacketEvent{
+		types.NewSendPacketEvent(packets[1], types.CommitPacket(suite.chainA.App.AppCodec(), packets[1]), types.UNORDERED, path.EndpointA.ConnectionID, types.PRIORITY_DEFAULT, proofHeights[1]),
+		types.NewSendPacketEvent(packets[2], types.CommitPacket(suite.chainA.App.AppCodec(), packets[2]), types.UNORDERED, path.EndpointA.ConnectionID, types.PRIORITY_DEFAULT, proofHeights[2]),
+	}
+	suite.Require().Equal(expSendEvents, sendRes.Events)
+
+	// the write acknowledgement event data is kept after the packet is acknowledged until it expires
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.PruneWriteAcknowledgementEventData(suite.chainB.GetContext())
+	ackRes, err := suite.chainB.QueryServer.WriteAcknowledgementEvents(sdk.WrapSDKContext(suite.chainB.GetContext()), &types.QueryWriteAcknowledgementEventsRequest{
+		PortId:        path.EndpointB.ChannelConfig.PortID,
+		ChannelId:     path.EndpointB.ChannelID,
+		StartSequence: 1,
+		EndSequence:   10,
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(ackRes.Events, 2)
+	suite.Require().Equal(uint64(3), ackRes.Pagination.Total)
+	suite.Require().NotNil(ackRes.Pagination.NextKey)
+
+	for i, event := range ackRes.Events {
+		suite.Require().Equal(packets[i], event.Packet)
+		suite.Require().Equal(ack, event.Acknowledgement)
+		suite.Require().Equal(types.CommitAcknowledgement(ack), event.AcknowledgementCommitment)
+		suite.Require().Equal(path.EndpointB.ConnectionID, event.ConnectionId)
+		suite.Require().True(event.ProofHeight.GT(clienttypes.ZeroHeight()))
+	}
+
+	// the write acknowledgement event data is pruned once the retention period has elapsed
+	ctx := suite.chainB.GetContext()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.WriteAcknowledgementEventRetention))
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.PruneWriteAcknowledgementEventData(ctx)
+	for _, packet := range packets {
+		_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetWriteAcknowledgementEventData(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence())
+		suite.Require().False(found)
+	}
+
+	// the channel must exist
+	_, err = suite.chainA.QueryServer.SendPacketEvents(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QuerySendPacketEventsRequest{
+		PortId:        path.EndpointA.ChannelConfig.PortID,
+		ChannelId:     "channel-100",
+		StartSequence: 1,
+		EndSequence:   10,
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// GetPacketPriority returns the priority class attached to a sent packet. The priority class
+// is only stored for the packets sent with a priority class other than the default one, until
+// they are acknowledged or timed out.
+func (k Keeper) GetPacketPriority(ctx sdk.Context, portID, channelID string, sequence uint64) types.PacketPriority {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketPriorityKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PRIORITY_DEFAULT
+	}
+
+	return types.PacketPriority(sdk.BigEndianToUint64(bz))
+}
+
+// setPacketPriority stores the priority class of a sent packet and indexes the packet in the
+// prioritized packets of its channel. Nothing is stored for the default priority class.
+func (k Keeper) setPacketPriority(ctx sdk.Context, portID, channelID string, sequence uint64, priority types.PacketPriority) {
+	if priority == types.PRIORITY_DEFAULT {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(uint64(priority))
+	store.Set(host.PacketPriorityKey(portID, channelID, sequence), bz)
+
+	prioritizedStore := prefix.NewStore(store, host.PrioritizedPacketsPrefixKey(portID, channelID))
+	prioritizedStore.Set(prioritizedPacketKey(priority, sequence), bz)
+}
+
+// deletePacketPriority deletes the priority class of a sent packet along with its entry in the
+// prioritized packets of its channel.
+func (k Keeper) deletePacketPriority(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	priority := k.GetPacketPriority(ctx, portID, channelID, sequence)
+	if priority == types.PRIORITY_DEFAULT {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketPriorityKey(portID, channelID, sequence))
+
+	prioritizedStore := prefix.NewStore(store, host.PrioritizedPacketsPrefixKey(portID, channelID))
+	prioritizedStore.Delete(prioritizedPacketKey(priority, sequence))
+}
+
+// GetPrioritizedPackets returns the pending packets of a channel sent with a priority class
+// other than the default one, ordered by descending priority class and ascending sequence.
+func (k Keeper) GetPrioritizedPackets(ctx sdk.Context, portID, channelID string) []types.PrioritizedPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.PrioritizedPacketsPrefixKey(portID, channelID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var packets []types.PrioritizedPacket
+	for ; iterator.Valid(); iterator.Next() {
+		priority, sequence, err := parsePrioritizedPacketKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		packets = append(packets, types.NewPrioritizedPacket(portID, channelID, sequence, priority))
+	}
+
+	return packets
+}
+
+// prioritizedPacketKey returns the key of a packet in the prioritized packets of its channel.
+// The priority class is stored as its complement to order the packets by descending priority
+// class, followed by the sequence to order the packets of a class by ascending sequence.
+func prioritizedPacketKey(priority types.PacketPriority, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(math.MaxInt32-uint64(priority)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// parsePrioritizedPacketKey returns the priority class and the sequence of a packet from its
+// key in the prioritized packets of its channel.
+func parsePrioritizedPacketKey(key []byte) (types.PacketPriority, uint64, error) {
+	if len(key) != 16 {
+		return 0, 0, fmt.Errorf("invalid prioritized packet key length, expected 16 bytes, got %d", len(key))
+	}
+
+	priority := types.PacketPriority(math.MaxInt32 - sdk.BigEndianToUint64(key[:8]))
+	return priority, sdk.BigEndianToUint64(key[8:]), nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// QuarantineChannel places a channel in quarantine. Packets sent on a quarantined channel are
+// rejected and the packets it receives are acknowledged with a retryable error by core IBC,
+// without being passed to the application. The channel end is not modified, so that its
+// handshake state is preserved and the in-flight packets may still be acknowledged or timed
+// out. The signer must be authorized by the caller.
+func (k Keeper) QuarantineChannel(ctx sdk.Context, portID, channelID, signer string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.CLOSED {
+		return sdkerrors.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	if k.IsChannelQuarantined(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelQuarantined, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	quarantined := types.NewQuarantinedChannel(portID, channelID, signer, clienttypes.GetSelfHeight(ctx))
+	k.SetQuarantinedChannel(ctx, quarantined)
+
+	k.Logger(ctx).Info("channel placed in quarantine", "port-id", portID, "channel-id", channelID, "signer", signer)
+
+	EmitChannelQuarantinedEvent(ctx, quarantined)
+	return nil
+}
+
+// ReleaseChannel releases a channel from quarantine, resuming the sending and receiving of
+// its packets.
+func (k Keeper) ReleaseChannel(ctx sdk.Context, portID, channelID string) error {
+	if !k.IsChannelQuarantined(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelNotQuarantined, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.QuarantinedChannelKey(portID, channelID))
+
+	k.Logger(ctx).Info("channel released from quarantine", "port-id", portID, "channel-id", channelID)
+
+	EmitChannelReleasedEvent(ctx, portID, channelID)
+	return nil
+}
+
+// IsChannelQuarantined returns true if the channel is placed in quarantine.
+func (k Keeper) IsChannelQuarantined(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.QuarantinedChannelKey(portID, channelID))
+}
+
+// GetQuarantinedChannel returns the quarantine of the channel, if the channel is placed in
+// quarantine.
+func (k Keeper) GetQuarantinedChannel(ctx sdk.Context, portID, channelID string) (types.QuarantinedChannel, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.QuarantinedChannelKey(portID, channelID))
+	if bz == nil {
+		return types.QuarantinedChannel{}, false
+	}
+
+	var quarantined types.QuarantinedChannel
+	k.cdc.MustUnmarshal(bz, &quarantined)
+	return quarantined, true
+}
+
+// SetQuarantinedChannel stores the provided quarantined channel under its port and channel
+// identifiers.
+func (k Keeper) SetQuarantinedChannel(ctx sdk.Context, quarantined types.QuarantinedChannel) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&quarantined)
+	store.Set(host.QuarantinedChannelKey(quarantined.PortId, quarantined.ChannelId), bz)
+}
+
+// IterateQuarantinedChannels provides an iterator over all quarantined channels. For each
+// quarantined channel, cb will be called. If the cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateQuarantinedChannels(ctx sdk.Context, cb func(quarantined types.QuarantinedChannel) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyQuarantinedChannels))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var quarantined types.QuarantinedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &quarantined)
+
+		if cb(quarantined) {
+			break
+		}
+	}
+}
+
+// GetAllQuarantinedChannels returns all quarantined channels.
+func (k Keeper) GetAllQuarantinedChannels(ctx sdk.Context) (quarantined []types.QuarantinedChannel) {
+	k.IterateQuarantinedChannels(ctx, func(qc types.QuarantinedChannel) bool {
+		quarantined = append(quarantined, qc)
+		return false
+	})
+	return quarantined
+}
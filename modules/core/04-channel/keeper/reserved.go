@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ReserveChannelIdentifiers reserves the given number of channel identifier sequences for
+// an upcoming upgrade and returns them. The reserved sequences are skipped by the generation
+// of channel identifiers, so the channels opened by the upgrade handler with
+// ChanOpenInitWithReservedSequence do not collide with channels opened by users in the
+// meantime. It should be called ahead of the upgrade height, for instance in the upgrade
+// handler of a previous upgrade.
+func (k Keeper) ReserveChannelIdentifiers(ctx sdk.Context, count uint64) []uint64 {
+	sequences := make([]uint64, count)
+	for i := range sequences {
+		sequence := k.GetNextChannelSequence(ctx)
+		k.SetNextChannelSequence(ctx, sequence+1)
+
+		k.SetReservedChannelSequence(ctx, sequence)
+		sequences[i] = sequence
+	}
+
+	return sequences
+}
+
+// ChanOpenInitWithReservedSequence performs the OpenInit handshake step as ChanOpenInit,
+// using the channel identifier formatted from the given reserved sequence instead of a
+// generated one. The reservation of the sequence is released. As for ChanOpenInit, the
+// caller is responsible for calling the OnChanOpenInit callback of the application and
+// writing the channel with WriteOpenInitChannel.
+func (k Keeper) ChanOpenInitWithReservedSequence(
+	ctx sdk.Context,
+	sequence uint64,
+	order types.Order,
+	connectionHops []string,
+	portID string,
+	portCap *capabilitytypes.Capability,
+	counterparty types.Counterparty,
+	version string,
+) (string, *capabilitytypes.Capability, error) {
+	if err := k.validateChanOpenInit(ctx, order, connectionHops, portID, portCap); err != nil {
+		return "", nil, err
+	}
+
+	if !k.IsReservedChannelSequence(ctx, sequence) {
+		return "", nil, sdkerrors.Wrapf(types.ErrChannelSequenceNotReserved, "sequence %d", sequence)
+	}
+
+	k.deleteReservedChannelSequence(ctx, sequence)
+
+	channelID := types.FormatChannelIdentifier(sequence)
+
+	capKey, err := k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(err, "could not create channel capability for port ID %s and channel ID %s", portID, channelID)
+	}
+
+	return channelID, capKey, nil
+}
+
+// SetReservedChannelSequence marks the given channel identifier sequence as reserved.
+func (k Keeper) SetReservedChannelSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ReservedChannelSequenceKey(sequence), []byte{byte(1)})
+}
+
+// IsReservedChannelSequence returns true if the given channel identifier sequence is reserved.
+func (k Keeper) IsReservedChannelSequence(ctx sdk.Context, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ReservedChannelSequenceKey(sequence))
+}
+
+// deleteReservedChannelSequence releases the reservation of the given channel identifier sequence.
+func (k Keeper) deleteReservedChannelSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ReservedChannelSequenceKey(sequence))
+}
+
+// GetReservedChannelSequences returns all the reserved channel identifier sequences in
+// ascending order.
+func (k Keeper) GetReservedChannelSequences(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyReservedChanSequences+"/"))
+	defer iterator.Close()
+
+	var sequences []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		// key is reservedChannelSequences/{sequence}
+		sequence, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), host.KeyReservedChanSequences+"/"), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	// keys are ordered lexicographically
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+	return sequences
+}
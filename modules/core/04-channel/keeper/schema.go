@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// SetPacketDataSchemas sets the packet data schemas of the applications wired into the
+// chain, which are returned by the PacketDataSchema queries. The method panics if a schema
+// is invalid or if several schemas are registered for the same port prefix and version.
+func (k *Keeper) SetPacketDataSchemas(schemas []types.PacketDataSchema) {
+	seen := make(map[string]bool)
+	for _, schema := range schemas {
+		if err := schema.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		key := fmt.Sprintf("%s/%s", schema.PortPrefix, schema.Version)
+		if seen[key] {
+			panic(fmt.Errorf("duplicate packet data schema for port prefix %s and version %s", schema.PortPrefix, schema.Version))
+		}
+		seen[key] = true
+	}
+
+	k.packetDataSchemas = schemas
+}
+
+// GetPacketDataSchemas returns the registered packet data schemas.
+func (k Keeper) GetPacketDataSchemas() []types.PacketDataSchema {
+	return k.packetDataSchemas
+}
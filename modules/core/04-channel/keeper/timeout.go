@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -65,8 +66,13 @@ func (k Keeper) TimeoutPacket(
 		return err
 	}
 
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connectionEnd.GetClientID())
+	}
+
 	timeoutHeight := packet.GetTimeoutHeight()
-	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
+	if !k.timeoutHeightReached(ctx, clientState.ClientType(), proofHeight, timeoutHeight) &&
 		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
 		return sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
 	}
@@ -75,6 +81,7 @@ func (k Keeper) TimeoutPacket(
 
 	if len(commitment) == 0 {
 		EmitTimeoutPacketEvent(ctx, packet, channel)
+		k.emitPacketAlreadyRelayedEvent(ctx, packet, channel, types.EventTypeTimeoutPacket)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -82,10 +89,10 @@ func (k Keeper) TimeoutPacket(
 		return types.ErrNoOpMsg
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state should be OPEN or FLUSHING (got %s)", channel.State.String(),
 		)
 	}
 
@@ -153,8 +160,14 @@ func (k Keeper) TimeoutExecuted(
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if channel.Ordering == types.ORDERED {
+		previousState := channel.State
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+		EmitChannelStateTransitionEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), previousState, channel)
+		// an upgrade of the closed channel can no longer be completed
+		k.deleteUpgradeInfo(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	} else {
+		k.handleFlushState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
 	k.Logger(ctx).Info(
@@ -174,7 +187,9 @@ func (k Keeper) TimeoutExecuted(
 
 // TimeoutOnClose is called by a module in order to prove that the channel to
 // which an unreceived packet was addressed has been closed, so the packet will
-// never be received (even if the timeoutHeight has not yet been reached).
+// never be received (even if the timeoutHeight has not yet been reached). The
+// counterparty upgrade sequence is the upgrade sequence of the closed counterparty
+// channel end.
 func (k Keeper) TimeoutOnClose(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -183,6 +198,7 @@ func (k Keeper) TimeoutOnClose(
 	proofClosed []byte,
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -220,6 +236,7 @@ func (k Keeper) TimeoutOnClose(
 
 	if len(commitment) == 0 {
 		EmitTimeoutPacketEvent(ctx, packet, channel)
+		k.emitPacketAlreadyRelayedEvent(ctx, packet, channel, types.EventTypeTimeoutPacketOnClose)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -240,6 +257,7 @@ func (k Keeper) TimeoutOnClose(
 	expectedChannel := types.NewChannel(
 		types.CLOSED, channel.Ordering, counterparty, counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
 	// check that the opposing channel end has closed
 	if err := k.connectionKeeper.VerifyChannelState(
@@ -279,3 +297,18 @@ func (k Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// timeoutHeightReached returns true if the given counterparty height has reached the non-zero
+// timeout height of a packet. The heights of clients of a type with monotonic heights are a single
+// counter, so their revision numbers are ignored and only the revision heights are compared.
+func (k Keeper) timeoutHeightReached(ctx sdk.Context, clientType string, height, timeoutHeight exported.Height) bool {
+	if timeoutHeight.IsZero() {
+		return false
+	}
+
+	if k.clientKeeper.IsMonotonicHeightClient(ctx, clientType) {
+		return height.GetRevisionHeight() >= timeoutHeight.GetRevisionHeight()
+	}
+
+	return height.GTE(timeoutHeight)
+}
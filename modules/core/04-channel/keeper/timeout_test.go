@@ -47,6 +47,31 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			// need to update chainA's client representing chainB to prove missing ack
 			path.EndpointA.UpdateClient()
 		}, true},
+		{"revision number not ignored for tendermint client with monotonic height clients set", func() {
+			expError = types.ErrPacketTimeout
+			ordered = false
+
+			suite.coordinator.Setup(path)
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.MonotonicHeightClients = []string{exported.Solomachine}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			timeoutHeight := clienttypes.NewHeight(1, clienttypes.GetSelfHeight(suite.chainB.GetContext()).GetRevisionHeight())
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+		}, false},
+		{"timeout height with greater revision number not reached", func() {
+			expError = types.ErrPacketTimeout
+			ordered = false
+
+			suite.coordinator.Setup(path)
+			timeoutHeight := clienttypes.NewHeight(1, clienttypes.GetSelfHeight(suite.chainB.GetContext()).GetRevisionHeight())
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			path.EndpointA.UpdateClient()
+		}, false},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -421,7 +446,7 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 				proof, _ = suite.chainB.QueryProof(unorderedPacketKey)
 			}
 
-			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv)
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv, 0)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
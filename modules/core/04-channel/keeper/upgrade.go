@@ -0,0 +1,948 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Upgrade Handshake
+//
+// This section defines the set of functions required to upgrade the parameters of an OPEN
+// channel, i.e. its version, ordering and connection hops. Both channel ends must initialize
+// the upgrade with ChanUpgradeInit before the upgrade is accepted by ChanUpgradeTry. Once the
+// upgrade is accepted the channel ends stop sending packets and flush their in-flight packets
+// with the current channel parameters before the upgrade is opened. An upgrade which fails
+// after the proofs of the counterparty have been verified is aborted by writing an error
+// receipt, which allows the counterparty channel end to cancel its side of the upgrade.
+
+// ChanUpgradeInit is called by a module to initiate the upgrade of an OPEN channel with the
+// proposed upgrade fields. The upgrade is returned with the fields proposed by core IBC, the
+// version may be changed by the application before it is written with WriteUpgradeInitChannel.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgradeFields types.UpgradeFields,
+) (types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Upgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if err := upgradeFields.ValidateBasic(); err != nil {
+		return types.Upgrade{}, sdkerrors.Wrap(err, "proposed upgrade fields are invalid")
+	}
+
+	if !upgradeFields.IsUpgradeOf(channel) {
+		return types.Upgrade{}, sdkerrors.Wrap(types.ErrInvalidUpgrade, "proposed upgrade fields are identical to the current channel parameters")
+	}
+
+	// the packets received on an ORDERED channel have no packet receipts, so they could be
+	// received again after an upgrade to an UNORDERED channel
+	if upgradeFields.Ordering != channel.Ordering {
+		return types.Upgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelOrdering,
+			"cannot upgrade channel ordering from %s to %s", channel.Ordering, upgradeFields.Ordering,
+		)
+	}
+
+	if _, err := k.getOpenConnection(ctx, upgradeFields.ConnectionHops[0]); err != nil {
+		return types.Upgrade{}, sdkerrors.Wrap(err, "invalid proposed connection hop")
+	}
+
+	return types.NewUpgrade(upgradeFields, types.Timeout{}, 0), nil
+}
+
+// WriteUpgradeInitChannel increments the upgrade sequence of the channel and writes the upgrade
+// with the version returned by the application. An upgrade initialized previously is superseded
+// and an error receipt is written for it. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgrade types.Upgrade,
+	upgradeVersion string,
+) (types.Channel, types.Upgrade) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeInit step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	if _, found := k.GetUpgrade(ctx, portID, channelID); found {
+		k.setUpgradeErrorReceipt(ctx, portID, channelID, types.NewErrorReceipt(channel.UpgradeSequence, types.ErrInvalidUpgrade))
+	}
+
+	channel.UpgradeSequence++
+	upgrade.Fields.Version = upgradeVersion
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel upgrade initialized", "port-id", portID, "channel-id", channelID, "upgrade-sequence", channel.UpgradeSequence)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeInit, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeTry is called by a module to accept the upgrade initialized by the counterparty
+// channel end. The upgrade must also have been initialized on this channel end. The proofs
+// of the OPEN counterparty channel end at the counterparty upgrade sequence and of the upgrade
+// proposed by the counterparty are verified. The upgrade proposed on this channel end is
+// returned, an UpgradeError is returned if the upgrade proposed by the counterparty is
+// incompatible.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgradeFields types.UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) (types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Upgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return types.Upgrade{}, sdkerrors.Wrapf(types.ErrUpgradeNotFound, "upgrade must be initialized before it is accepted, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return types.Upgrade{}, err
+	}
+
+	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.OPEN, channel.Ordering, counterparty,
+		counterpartyHops, channel.Version,
+	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	// the fields of the counterparty upgrade are stored as proposed until the counterparty
+	// channel end starts flushing
+	expectedUpgrade := types.NewUpgrade(counterpartyUpgradeFields, types.Timeout{}, 0)
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connectionEnd, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		&expectedUpgrade,
+	); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	// a counterparty which is behind must cancel its upgrade with the error receipt of the
+	// current upgrade sequence before initializing a new upgrade
+	if counterpartyUpgradeSequence < channel.UpgradeSequence {
+		return types.Upgrade{}, types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"counterparty upgrade sequence < current upgrade sequence (%d < %d)", counterpartyUpgradeSequence, channel.UpgradeSequence,
+		))
+	}
+
+	if err := k.checkCounterpartyUpgradeFields(ctx, upgrade.Fields, counterpartyUpgradeFields); err != nil {
+		return types.Upgrade{}, types.NewUpgradeError(counterpartyUpgradeSequence, err)
+	}
+
+	return upgrade, nil
+}
+
+// WriteUpgradeTryChannel sets the upgrade sequence of the channel to the counterparty upgrade
+// sequence and writes the upgrade with the version returned by the application. The channel
+// end starts flushing its in-flight packets. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTryChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgrade types.Upgrade,
+	upgradeVersion string,
+	counterpartyUpgradeSequence uint64,
+) (types.Channel, types.Upgrade) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTry step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	previousState := channel.State
+	channel.UpgradeSequence = counterpartyUpgradeSequence
+	upgrade.Fields.Version = upgradeVersion
+	channel, upgrade = k.startFlushing(ctx, portID, channelID, channel, upgrade)
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", channel.State.String())
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeTry, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeAck is called by a module to acknowledge the upgrade accepted by the counterparty
+// channel end. The proofs of the FLUSHING counterparty channel end and of its upgrade are
+// verified. The channel end may already be FLUSHING if both channel ends accepted the upgrade
+// of the other. An UpgradeError is returned if the counterparty upgrade is incompatible or has
+// timed out.
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state should be OPEN or FLUSHING (got %s)", channel.State.String(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, found := k.GetCounterpartyUpgrade(ctx, portID, channelID); found {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "counterparty upgrade has already been acknowledged")
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyCounterpartyUpgrade(
+		ctx, portID, channelID, channel, connectionEnd, types.FLUSHING,
+		counterpartyUpgrade, proofChannel, proofUpgrade, proofHeight,
+	); err != nil {
+		return err
+	}
+
+	if err := k.checkCounterpartyUpgradeFields(ctx, upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
+		return types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	// both channel ends negotiated a version when accepting the upgrade of the other
+	if channel.State == types.FLUSHING && upgrade.Fields.Version != counterpartyUpgrade.Fields.Version {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"negotiated versions do not match (%s ≠ %s)", upgrade.Fields.Version, counterpartyUpgrade.Fields.Version,
+		))
+	}
+
+	if k.upgradeTimeoutElapsed(ctx, counterpartyUpgrade) {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrap(types.ErrUpgradeTimeout, "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckChannel adopts the version of the counterparty upgrade and stores the
+// counterparty upgrade. The channel end starts flushing its in-flight packets if it is OPEN and
+// completes flushing immediately if there are none. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeAckChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+) (types.Channel, types.Upgrade) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	previousState := channel.State
+	if channel.State == types.OPEN {
+		channel, upgrade = k.startFlushing(ctx, portID, channelID, channel, upgrade)
+	}
+
+	upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel.State = types.FLUSHCOMPLETE
+	}
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", channel.State.String())
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeAck, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeConfirm is called by a module to confirm that the counterparty channel end
+// acknowledged the upgrade. The proofs of the FLUSHING or FLUSHCOMPLETE counterparty channel
+// end and of its upgrade are verified. An UpgradeError is returned if the counterparty upgrade
+// has timed out.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHING {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not FLUSHING (got %s)", channel.State.String(),
+		)
+	}
+
+	if !(counterpartyChannelState == types.FLUSHING || counterpartyChannelState == types.FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidCounterparty,
+			"counterparty channel state should be FLUSHING or FLUSHCOMPLETE (got %s)", counterpartyChannelState.String(),
+		)
+	}
+
+	if _, found := k.GetUpgrade(ctx, portID, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, found := k.GetCounterpartyUpgrade(ctx, portID, channelID); found {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "counterparty upgrade has already been confirmed")
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyCounterpartyUpgrade(
+		ctx, portID, channelID, channel, connectionEnd, counterpartyChannelState,
+		counterpartyUpgrade, proofChannel, proofUpgrade, proofHeight,
+	); err != nil {
+		return err
+	}
+
+	if k.upgradeTimeoutElapsed(ctx, counterpartyUpgrade) {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrap(types.ErrUpgradeTimeout, "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmChannel stores the counterparty upgrade and completes flushing if there
+// are no in-flight packets left. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+) types.Channel {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		previousState := channel.State
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+		EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
+	}
+
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	k.Logger(ctx).Info("channel upgrade confirmed", "port-id", portID, "channel-id", channelID, "state", channel.State.String())
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeConfirm, portID, channelID, channel, upgrade)
+
+	return channel
+}
+
+// ChanUpgradeOpen is called by a module to open the upgraded channel once both channel ends
+// completed flushing. The counterparty channel end is either proven to be FLUSHCOMPLETE with
+// the current channel parameters or to be OPEN with the upgraded channel parameters.
+func (k Keeper) ChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgradeSequence uint64,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHCOMPLETE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not FLUSHCOMPLETE (got %s)", channel.State.String(),
+		)
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "counterparty upgrade not found, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+
+	var expectedChannel types.Channel
+	switch counterpartyChannelState {
+	case types.FLUSHCOMPLETE:
+		counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+		expectedChannel = types.NewChannel(
+			types.FLUSHCOMPLETE, channel.Ordering, counterparty,
+			counterpartyHops, channel.Version,
+		)
+		expectedChannel.UpgradeSequence = channel.UpgradeSequence
+	case types.OPEN:
+		// the counterparty may have initialized a new upgrade after opening this one
+		if counterpartyUpgradeSequence < channel.UpgradeSequence {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidUpgradeSequence,
+				"counterparty upgrade sequence < current upgrade sequence (%d < %d)", counterpartyUpgradeSequence, channel.UpgradeSequence,
+			)
+		}
+
+		expectedChannel = types.NewChannel(
+			types.OPEN, counterpartyUpgrade.Fields.Ordering, counterparty,
+			counterpartyUpgrade.Fields.ConnectionHops, counterpartyUpgrade.Fields.Version,
+		)
+		expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
+	default:
+		return sdkerrors.Wrapf(
+			types.ErrInvalidCounterparty,
+			"counterparty channel state should be FLUSHCOMPLETE or OPEN (got %s)", counterpartyChannelState.String(),
+		)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeOpenChannel opens the channel with the upgraded channel parameters and deletes
+// the upgrade and the counterparty upgrade. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeOpenChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) types.Channel {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeOpen step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when updating channel state in successful ChanUpgradeOpen step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	previousState := channel.State
+	channel.State = types.OPEN
+	channel.Ordering = upgrade.Fields.Ordering
+	channel.ConnectionHops = upgrade.Fields.ConnectionHops
+	channel.Version = upgrade.Fields.Version
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-open")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeOpen, portID, channelID, channel, upgrade)
+
+	return channel
+}
+
+// ChanUpgradeTimeout is called by a module to abort an upgrade whose timeout has elapsed on the
+// counterparty chain. The proof of the counterparty channel end at a height at or past the
+// upgrade timeout is verified. The upgrade cannot be timed out if the counterparty channel end
+// may have opened it.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannel types.Channel,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !(channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state should be FLUSHING or FLUSHCOMPLETE (got %s)", channel.State.String(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if !upgrade.Timeout.Elapsed(proofHeight, proofTimestamp) {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeoutNotReached,
+			"proof height (%s) and timestamp (%d) have not reached the upgrade timeout height (%s) or timestamp (%d)",
+			proofHeight, proofTimestamp, upgrade.Timeout.Height, upgrade.Timeout.Timestamp,
+		)
+	}
+
+	if counterpartyChannel.Counterparty != types.NewCounterparty(portID, channelID) {
+		return sdkerrors.Wrap(types.ErrInvalidCounterparty, "counterparty channel end does not belong to the channel")
+	}
+
+	switch {
+	case counterpartyChannel.State == types.FLUSHCOMPLETE:
+		return sdkerrors.Wrap(types.ErrInvalidCounterparty, "counterparty channel end has completed flushing")
+	case counterpartyChannel.State == types.OPEN && channel.State == types.FLUSHCOMPLETE &&
+		counterpartyChannel.UpgradeSequence == channel.UpgradeSequence:
+		return sdkerrors.Wrap(types.ErrInvalidCounterparty, "counterparty channel end may have opened the upgrade")
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutChannel aborts the timed-out upgrade. An event is emitted for the handshake
+// step.
+func (k Keeper) WriteUpgradeTimeoutChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) types.Channel {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTimeout step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	upgrade, _ := k.GetUpgrade(ctx, portID, channelID)
+	channel = k.AbortUpgrade(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrUpgradeTimeout))
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-timeout")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeTimeout, portID, channelID, channel, upgrade)
+
+	return channel
+}
+
+// ChanUpgradeCancel is called by a module to cancel an upgrade aborted by the counterparty
+// channel end. The proof of the error receipt written by the counterparty for the current or a
+// later upgrade sequence is verified.
+func (k Keeper) ChanUpgradeCancel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	errorReceipt types.ErrorReceipt,
+	proofErrorReceipt []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, found := k.GetUpgrade(ctx, portID, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if errorReceipt.Sequence < channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence,
+			"error receipt sequence < current upgrade sequence (%d < %d)", errorReceipt.Sequence, channel.UpgradeSequence,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgradeError(
+		ctx, connectionEnd, proofHeight, proofErrorReceipt,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		&errorReceipt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelChannel aborts the upgrade cancelled with the error receipt of the provided
+// sequence, the upgrade sequence of the channel is set to the sequence of the error receipt. An
+// event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeCancelChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	sequence uint64,
+) types.Channel {
+	upgrade, _ := k.GetUpgrade(ctx, portID, channelID)
+	channel := k.AbortUpgrade(ctx, portID, channelID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-cancel")
+	}()
+
+	EmitChannelUpgradeEvent(ctx, types.EventTypeChannelUpgradeCancel, portID, channelID, channel, upgrade)
+
+	return channel
+}
+
+// AbortUpgrade restores the channel to OPEN with its current channel parameters, deletes the
+// upgrade and the counterparty upgrade and writes the error receipt of the provided upgrade
+// error. The upgrade sequence of the channel is raised to the sequence of the upgrade error.
+func (k Keeper) AbortUpgrade(ctx sdk.Context, portID, channelID string, upgradeErr *types.UpgradeError) types.Channel {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when aborting upgrade, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	errorReceipt := upgradeErr.GetErrorReceipt()
+	if errorReceipt.Sequence > channel.UpgradeSequence {
+		channel.UpgradeSequence = errorReceipt.Sequence
+	}
+
+	previousState := channel.State
+	channel.State = types.OPEN
+
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+	k.setUpgradeErrorReceipt(ctx, portID, channelID, errorReceipt)
+	EmitChannelStateTransitionEvent(ctx, portID, channelID, previousState, channel)
+
+	k.Logger(ctx).Info("channel upgrade aborted", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "upgrade-sequence", errorReceipt.Sequence, "error", upgradeErr.Error())
+
+	EmitChannelUpgradeErrorEvent(ctx, portID, channelID, errorReceipt)
+
+	return channel
+}
+
+// GetUpgrade returns the upgrade proposed for the provided channel.
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetUpgrade sets the upgrade proposed for the provided channel.
+func (k Keeper) SetUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelUpgradeKey(portID, channelID), bz)
+}
+
+// GetCounterpartyUpgrade returns the upgrade of the counterparty channel end of the provided
+// channel.
+func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetCounterpartyUpgrade sets the upgrade of the counterparty channel end of the provided
+// channel.
+func (k Keeper) SetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelCounterpartyUpgradeKey(portID, channelID), bz)
+}
+
+// GetUpgradeErrorReceipt returns the error receipt of the last aborted upgrade of the provided
+// channel.
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeErrorKey(portID, channelID))
+	if bz == nil {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+	return errorReceipt, true
+}
+
+// setUpgradeErrorReceipt sets the error receipt of the last aborted upgrade of the provided
+// channel.
+func (k Keeper) setUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ChannelUpgradeErrorKey(portID, channelID), bz)
+}
+
+// deleteUpgradeInfo deletes the upgrade and the counterparty upgrade of the provided channel.
+func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelUpgradeKey(portID, channelID))
+	store.Delete(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+}
+
+// HasInflightPackets returns true if there are packet commitments stored for the provided
+// channel, i.e. packets sent on the channel which have neither been acknowledged nor timed out.
+func (k Keeper) HasInflightPackets(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketCommitmentPrefixPath(portID, channelID)+"/"))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// handleFlushState is called once a packet commitment of the provided channel is deleted. A
+// FLUSHING channel end whose counterparty upgrade is known completes flushing once there are no
+// in-flight packets left. The upgrade is aborted if the counterparty upgrade has timed out.
+func (k Keeper) handleFlushState(ctx sdk.Context, portID, channelID string) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found || channel.State != types.FLUSHING {
+		return
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	if k.upgradeTimeoutElapsed(ctx, counterpartyUpgrade) {
+		k.AbortUpgrade(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrUpgradeTimeout))
+		return
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+		EmitChannelStateTransitionEvent(ctx, portID, channelID, types.FLUSHING, channel)
+
+		k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "FLUSHING", "new-state", "FLUSHCOMPLETE")
+	}
+}
+
+// startFlushing moves the channel to FLUSHING and sets the timeout of the upgrade and the next
+// sequence send at which the channel end stopped sending packets.
+func (k Keeper) startFlushing(ctx sdk.Context, portID, channelID string, channel types.Channel, upgrade types.Upgrade) (types.Channel, types.Upgrade) {
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find next sequence send when flushing channel, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel.State = types.FLUSHING
+	upgrade.Timeout = types.NewTimeout(
+		clienttypes.ZeroHeight(),
+		k.clientKeeper.GetHostTimestamp(ctx)+uint64(types.DefaultUpgradeTimeoutPeriod),
+	)
+	upgrade.NextSequenceSend = nextSequenceSend
+
+	return channel, upgrade
+}
+
+// upgradeTimeoutElapsed returns true if the timeout of the provided counterparty upgrade has
+// elapsed on this chain.
+func (k Keeper) upgradeTimeoutElapsed(ctx sdk.Context, counterpartyUpgrade types.Upgrade) bool {
+	return counterpartyUpgrade.Timeout.Elapsed(k.clientKeeper.GetHostHeight(ctx), k.clientKeeper.GetHostTimestamp(ctx))
+}
+
+// verifyCounterpartyUpgrade verifies the proofs of the counterparty channel end in the provided
+// state with the current channel parameters and of the counterparty upgrade.
+func (k Keeper) verifyCounterpartyUpgrade(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	channel types.Channel,
+	connectionEnd connectiontypes.ConnectionEnd,
+	counterpartyChannelState types.State,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight exported.Height,
+) error {
+	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		counterpartyChannelState, channel.Ordering, counterparty,
+		counterpartyHops, channel.Version,
+	)
+	expectedChannel.UpgradeSequence = channel.UpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connectionEnd, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		&counterpartyUpgrade,
+	)
+}
+
+// checkCounterpartyUpgradeFields returns an error if the upgrade fields proposed by the
+// counterparty are incompatible with the upgrade fields proposed on this channel end.
+func (k Keeper) checkCounterpartyUpgradeFields(ctx sdk.Context, upgradeFields, counterpartyUpgradeFields types.UpgradeFields) error {
+	if upgradeFields.Ordering != counterpartyUpgradeFields.Ordering {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"proposed orderings do not match (%s ≠ %s)", upgradeFields.Ordering, counterpartyUpgradeFields.Ordering,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgradeFields.ConnectionHops[0])
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid proposed connection hop")
+	}
+
+	if counterpartyHop := connectionEnd.GetCounterparty().GetConnectionID(); counterpartyHop != counterpartyUpgradeFields.ConnectionHops[0] {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"proposed connection hops do not match (%s ≠ %s)", counterpartyHop, counterpartyUpgradeFields.ConnectionHops[0],
+		)
+	}
+
+	return nil
+}
+
+// getOpenConnection returns the connection end of the provided connection if it is OPEN.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	return connectionEnd, nil
+}
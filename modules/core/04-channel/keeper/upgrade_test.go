@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var upgradeVersion = ibcmock.Version + "-v2"
+
+// upgradeInit initializes the upgrade of the channel of the provided endpoint.
+func (suite *KeeperTestSuite) upgradeInit(endpoint *ibctesting.Endpoint, fields types.UpgradeFields) {
+	channelKeeper := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper
+	ctx := endpoint.Chain.GetContext()
+
+	upgrade, err := channelKeeper.ChanUpgradeInit(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID, fields)
+	suite.Require().NoError(err)
+
+	channelKeeper.WriteUpgradeInitChannel(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID, upgrade, upgrade.Fields.Version)
+}
+
+// commitAndQueryUpgradeProofs commits the state of the chain of the provided endpoint, updates
+// the client on the counterparty chain and returns the proofs of the channel and the upgrade.
+func (suite *KeeperTestSuite) commitAndQueryUpgradeProofs(endpoint *ibctesting.Endpoint) ([]byte, []byte, clienttypes.Height) {
+	suite.coordinator.CommitBlock(endpoint.Chain)
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+
+	proofChannel, proofHeight := endpoint.Chain.QueryProof(host.ChannelKey(endpoint.ChannelConfig.PortID, endpoint.ChannelID))
+	proofUpgrade, _ := endpoint.Chain.QueryProof(host.ChannelUpgradeKey(endpoint.ChannelConfig.PortID, endpoint.ChannelID))
+
+	return proofChannel, proofUpgrade, proofHeight
+}
+
+// TestChanUpgradeHandshake upgrades the version of an OPEN channel with a full upgrade handshake.
+func (suite *KeeperTestSuite) TestChanUpgradeHandshake() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	portA, channelA := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	portB, channelB := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	keeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	keeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	suite.upgradeInit(path.EndpointA, types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointA.ConnectionID}, upgradeVersion))
+	suite.upgradeInit(path.EndpointB, types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointB.ConnectionID}, upgradeVersion))
+
+	// chainB accepts the upgrade of chainA and starts flushing
+	upgradeA, found := keeperA.GetUpgrade(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().True(found)
+	proofChannel, proofUpgrade, proofHeight := suite.commitAndQueryUpgradeProofs(path.EndpointA)
+
+	upgrade, err := keeperB.ChanUpgradeTry(suite.chainB.GetContext(), portB, channelB, upgradeA.Fields, 1, proofChannel, proofUpgrade, proofHeight)
+	suite.Require().NoError(err)
+
+	channel, upgradeB := keeperB.WriteUpgradeTryChannel(suite.chainB.GetContext(), portB, channelB, upgrade, upgradeVersion, 1)
+	suite.Require().Equal(types.FLUSHING, channel.State)
+	suite.Require().True(upgradeB.Timeout.IsValid())
+
+	// packets cannot be sent while flushing
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, portB, channelB, portA, channelA, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().Error(path.EndpointB.SendPacket(packet))
+
+	// chainA acknowledges the upgrade and completes flushing immediately
+	proofChannel, proofUpgrade, proofHeight = suite.commitAndQueryUpgradeProofs(path.EndpointB)
+
+	err = keeperA.ChanUpgradeAck(suite.chainA.GetContext(), portA, channelA, upgradeB, proofChannel, proofUpgrade, proofHeight)
+	suite.Require().NoError(err)
+
+	channel, upgradeA = keeperA.WriteUpgradeAckChannel(suite.chainA.GetContext(), portA, channelA, upgradeB)
+	suite.Require().Equal(types.FLUSHCOMPLETE, channel.State)
+
+	// chainB confirms the upgrade and opens the upgraded channel
+	proofChannel, proofUpgrade, proofHeight = suite.commitAndQueryUpgradeProofs(path.EndpointA)
+
+	err = keeperB.ChanUpgradeConfirm(suite.chainB.GetContext(), portB, channelB, types.FLUSHCOMPLETE, upgradeA, proofChannel, proofUpgrade, proofHeight)
+	suite.Require().NoError(err)
+
+	channel = keeperB.WriteUpgradeConfirmChannel(suite.chainB.GetContext(), portB, channelB, upgradeA)
+	suite.Require().Equal(types.FLUSHCOMPLETE, channel.State)
+
+	channel = keeperB.WriteUpgradeOpenChannel(suite.chainB.GetContext(), portB, channelB)
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(upgradeVersion, channel.Version)
+
+	// chainA opens the upgraded channel once chainB has opened it
+	proofChannel, _, proofHeight = suite.commitAndQueryUpgradeProofs(path.EndpointB)
+
+	err = keeperA.ChanUpgradeOpen(suite.chainA.GetContext(), portA, channelA, types.OPEN, 1, proofChannel, proofHeight)
+	suite.Require().NoError(err)
+
+	channel = keeperA.WriteUpgradeOpenChannel(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(upgradeVersion, channel.Version)
+	suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+	_, found = keeperA.GetUpgrade(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().False(found)
+	_, found = keeperA.GetCounterpartyUpgrade(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().False(found)
+}
+
+// TestChanUpgradeInitOrdering checks that the ordering of a channel cannot be upgraded.
+func (suite *KeeperTestSuite) TestChanUpgradeInitOrdering() {
+	for _, ordering := range []types.Order{types.ORDERED, types.UNORDERED} {
+		suite.SetupTest()
+
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		if ordering == types.ORDERED {
+			path.SetChannelOrdered()
+		}
+		suite.coordinator.Setup(path)
+
+		upgradedOrdering := types.ORDERED
+		if ordering == types.ORDERED {
+			upgradedOrdering = types.UNORDERED
+		}
+
+		fields := types.NewUpgradeFields(upgradedOrdering, []string{path.EndpointA.ConnectionID}, ibcmock.Version)
+		_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, fields)
+		suite.Require().ErrorIs(err, types.ErrInvalidChannelOrdering)
+	}
+}
+
+// TestChanUpgradeAbortAndCancel aborts an upgrade whose upgrade fields are incompatible and
+// cancels it on the counterparty channel end with the error receipt.
+func (suite *KeeperTestSuite) TestChanUpgradeAbortAndCancel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrdered()
+	suite.coordinator.Setup(path)
+
+	portA, channelA := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	portB, channelB := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	keeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	keeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	suite.upgradeInit(path.EndpointA, types.NewUpgradeFields(types.ORDERED, []string{path.EndpointA.ConnectionID}, upgradeVersion))
+	suite.upgradeInit(path.EndpointB, types.NewUpgradeFields(types.ORDERED, []string{path.EndpointB.ConnectionID}, upgradeVersion))
+
+	// the ordering of a channel cannot be upgraded, the upgrade of chainB is made incompatible
+	// by proposing a different ordering directly in its store
+	upgradeB, found := keeperB.GetUpgrade(suite.chainB.GetContext(), portB, channelB)
+	suite.Require().True(found)
+	upgradeB.Fields.Ordering = types.UNORDERED
+	keeperB.SetUpgrade(suite.chainB.GetContext(), portB, channelB, upgradeB)
+
+	upgradeA, found := keeperA.GetUpgrade(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().True(found)
+	proofChannel, proofUpgrade, proofHeight := suite.commitAndQueryUpgradeProofs(path.EndpointA)
+
+	_, err := keeperB.ChanUpgradeTry(suite.chainB.GetContext(), portB, channelB, upgradeA.Fields, 1, proofChannel, proofUpgrade, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrInvalidUpgrade)
+
+	upgradeErr, ok := err.(*types.UpgradeError)
+	suite.Require().True(ok)
+
+	channel := keeperB.AbortUpgrade(suite.chainB.GetContext(), portB, channelB, upgradeErr)
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(types.ORDERED, channel.Ordering)
+
+	errorReceipt, found := keeperB.GetUpgradeErrorReceipt(suite.chainB.GetContext(), portB, channelB)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+
+	// an error receipt for an earlier upgrade sequence cannot cancel the upgrade
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	proofErrorReceipt, proofHeight := suite.chainB.QueryProof(host.ChannelUpgradeErrorKey(portB, channelB))
+
+	staleReceipt := types.ErrorReceipt{Sequence: 0, Message: errorReceipt.Message}
+	err = keeperA.ChanUpgradeCancel(suite.chainA.GetContext(), portA, channelA, staleReceipt, proofErrorReceipt, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrInvalidUpgradeSequence)
+
+	err = keeperA.ChanUpgradeCancel(suite.chainA.GetContext(), portA, channelA, errorReceipt, proofErrorReceipt, proofHeight)
+	suite.Require().NoError(err)
+
+	channel = keeperA.WriteUpgradeCancelChannel(suite.chainA.GetContext(), portA, channelA, errorReceipt.Sequence)
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(types.ORDERED, channel.Ordering)
+	suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+	_, found = keeperA.GetUpgrade(suite.chainA.GetContext(), portA, channelA)
+	suite.Require().False(found)
+}
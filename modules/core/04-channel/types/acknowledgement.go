@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -30,6 +31,19 @@ func NewErrorAcknowledgement(err string) Acknowledgement {
 	}
 }
 
+// retryableErrorPrefix prefixes the error of the acknowledgements of the packets which were
+// not processed by the receiving application and may be sent again.
+const retryableErrorPrefix = "retryable: "
+
+// NewRetryableErrorAcknowledgement returns an error acknowledgement signalling that the packet
+// was not processed by the receiving application and may be sent again later. Only the ABCI
+// codespace and code of the error are included since error messages are not guaranteed to be
+// deterministic.
+func NewRetryableErrorAcknowledgement(err error) Acknowledgement {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return NewErrorAcknowledgement(fmt.Sprintf("%scodespace: %s, code: %d", retryableErrorPrefix, codespace, code))
+}
+
 // ValidateBasic performs a basic validation of the acknowledgement
 func (ack Acknowledgement) ValidateBasic() error {
 	switch resp := ack.Response.(type) {
@@ -55,6 +69,13 @@ func (ack Acknowledgement) Success() bool {
 	return reflect.TypeOf(ack.Response) == reflect.TypeOf(((*Acknowledgement_Result)(nil)))
 }
 
+// Retryable returns true if the acknowledgement is an error acknowledgement of a packet which
+// was not processed by the receiving application and may be sent again.
+func (ack Acknowledgement) Retryable() bool {
+	resp, ok := ack.Response.(*Acknowledgement_Error)
+	return ok && strings.HasPrefix(resp.Error, retryableErrorPrefix)
+}
+
 // Acknowledgement implements the Acknowledgement interface. It returns the
 // acknowledgement serialised using JSON.
 func (ack Acknowledgement) Acknowledgement() []byte {
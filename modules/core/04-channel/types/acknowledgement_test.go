@@ -68,3 +68,62 @@ func (suite TypesTestSuite) TestAcknowledgement() {
 		})
 	}
 }
+
+// tests the retryable error acknowledgements
+func (suite TypesTestSuite) TestRetryableAcknowledgement() {
+	ack := types.NewRetryableErrorAcknowledgement(types.ErrChannelQuarantined)
+	suite.Require().NoError(ack.ValidateBasic())
+	suite.Require().False(ack.Success())
+	suite.Require().True(ack.Retryable())
+
+	// only the codespace and code of the error are included
+	suite.Require().Equal(types.NewErrorAcknowledgement("retryable: codespace: channel, code: 39"), ack)
+
+	suite.Require().False(types.NewErrorAcknowledgement("error").Retryable())
+	suite.Require().False(types.NewResultAcknowledgement([]byte("retryable: success")).Retryable())
+}
+
+// tests aggregated acknowledgement ValidateBasic and JSON round trip
+func (suite TypesTestSuite) TestAggregatedAcknowledgement() {
+	testCases := []struct {
+		name    string
+		ack     types.AggregatedAcknowledgement
+		expPass bool
+	}{
+		{
+			"valid aggregated ack",
+			types.NewAggregatedAcknowledgement([]types.Acknowledgement{types.NewResultAcknowledgement([]byte("success")), types.NewErrorAcknowledgement("error")}),
+			true,
+		},
+		{
+			"empty aggregated ack",
+			types.NewAggregatedAcknowledgement(nil),
+			false,
+		},
+		{
+			"invalid inner ack",
+			types.NewAggregatedAcknowledgement([]types.Acknowledgement{types.NewResultAcknowledgement([]byte{})}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := tc.ack.ValidateBasic()
+			suite.Require().True(tc.ack.Success())
+
+			_, unmarshalErr := types.UnmarshalAggregatedAcknowledgement(tc.ack.Acknowledgement())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NoError(unmarshalErr)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Error(unmarshalErr)
+			}
+		})
+	}
+}
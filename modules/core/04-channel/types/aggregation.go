@@ -0,0 +1,130 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Acknowledgement = AggregatedAcknowledgement{}
+
+// NewAggregatedPacketData returns a new instance of AggregatedPacketData.
+func NewAggregatedPacketData(payloads [][]byte) AggregatedPacketData {
+	return AggregatedPacketData{
+		Payloads: payloads,
+	}
+}
+
+// ValidateBasic performs a basic validation of the aggregated packet data. It
+// must contain at least one payload and none of the payloads may be empty.
+func (data AggregatedPacketData) ValidateBasic() error {
+	if len(data.Payloads) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAggregatedPacket, "aggregated packet must contain at least one payload")
+	}
+
+	for i, payload := range data.Payloads {
+		if len(payload) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAggregatedPacket, "payload %d cannot be empty", i)
+		}
+	}
+
+	return nil
+}
+
+// GetBytes returns the aggregated packet data serialised using JSON.
+func (data AggregatedPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&data))
+}
+
+// UnmarshalAggregatedPacketData decodes and validates the packet data of an
+// aggregated packet.
+func UnmarshalAggregatedPacketData(bz []byte) (AggregatedPacketData, error) {
+	var data AggregatedPacketData
+	if err := SubModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return AggregatedPacketData{}, sdkerrors.Wrapf(ErrInvalidAggregatedPacket, "cannot unmarshal aggregated packet data: %s", err.Error())
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return AggregatedPacketData{}, err
+	}
+
+	return data, nil
+}
+
+// NewAggregatedAcknowledgement returns a new instance of AggregatedAcknowledgement.
+func NewAggregatedAcknowledgement(acks []Acknowledgement) AggregatedAcknowledgement {
+	return AggregatedAcknowledgement{
+		Acknowledgements: acks,
+	}
+}
+
+// ValidateBasic performs a basic validation of every acknowledgement contained
+// in the envelope.
+func (ack AggregatedAcknowledgement) ValidateBasic() error {
+	if len(ack.Acknowledgements) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAcknowledgement, "aggregated acknowledgement must contain at least one acknowledgement")
+	}
+
+	for i, a := range ack.Acknowledgements {
+		if err := a.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "acknowledgement %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Success implements the Acknowledgement interface. The envelope is always
+// considered successful so that it is written to state. The result of each
+// payload is reported by the acknowledgement at the same index and state
+// changes of failed payloads are discarded individually by
+// ProcessAggregatedPacketData.
+func (ack AggregatedAcknowledgement) Success() bool {
+	return true
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the
+// acknowledgement envelope serialised using JSON.
+func (ack AggregatedAcknowledgement) Acknowledgement() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&ack))
+}
+
+// UnmarshalAggregatedAcknowledgement decodes and validates an acknowledgement
+// envelope written for an aggregated packet.
+func UnmarshalAggregatedAcknowledgement(bz []byte) (AggregatedAcknowledgement, error) {
+	var ack AggregatedAcknowledgement
+	if err := SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return AggregatedAcknowledgement{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal aggregated acknowledgement: %s", err.Error())
+	}
+
+	if err := ack.ValidateBasic(); err != nil {
+		return AggregatedAcknowledgement{}, err
+	}
+
+	return ack, nil
+}
+
+// ProcessAggregatedPacketData executes the provided callback for every payload of
+// an aggregated packet on the receiving chain. Each payload is executed against
+// a cached context whose state changes are only written if the returned
+// acknowledgement is successful. The returned envelope contains the
+// acknowledgement of each payload in payload order.
+func ProcessAggregatedPacketData(
+	ctx sdk.Context, data AggregatedPacketData, onRecvPayload func(ctx sdk.Context, payload []byte) Acknowledgement,
+) AggregatedAcknowledgement {
+	acks := make([]Acknowledgement, len(data.Payloads))
+	for i, payload := range data.Payloads {
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		ack := onRecvPayload(cacheCtx, payload)
+		if ack.Success() {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		acks[i] = ack
+	}
+
+	return NewAggregatedAcknowledgement(acks)
+}
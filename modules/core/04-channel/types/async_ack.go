@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// NewPendingAsyncAcknowledgement creates a new PendingAsyncAcknowledgement instance.
+func NewPendingAsyncAcknowledgement(packet Packet, receivedHeight clienttypes.Height) PendingAsyncAcknowledgement {
+	return PendingAsyncAcknowledgement{
+		Packet:         packet,
+		ReceivedHeight: receivedHeight,
+	}
+}
+
+// Validate performs basic validation of the pending asynchronous acknowledgement returning an
+// error upon any failure.
+func (pa PendingAsyncAcknowledgement) Validate() error {
+	if err := pa.Packet.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid packet")
+	}
+	if pa.ReceivedHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "received height cannot be zero")
+	}
+	return nil
+}
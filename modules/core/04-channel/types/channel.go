@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -105,13 +106,14 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
-		State:          ch.State,
-		Ordering:       ch.Ordering,
-		Counterparty:   ch.Counterparty,
-		ConnectionHops: ch.ConnectionHops,
-		Version:        ch.Version,
-		PortId:         portID,
-		ChannelId:      channelID,
+		State:           ch.State,
+		Ordering:        ch.Ordering,
+		Counterparty:    ch.Counterparty,
+		ConnectionHops:  ch.ConnectionHops,
+		Version:         ch.Version,
+		PortId:          portID,
+		ChannelId:       channelID,
+		UpgradeSequence: ch.UpgradeSequence,
 	}
 }
 
@@ -126,3 +128,32 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
 	return channel.ValidateBasic()
 }
+
+// NextHandshakeStep returns the type URL of the next message of the handshake of a channel
+// whose channel end is in the provided state and whose counterparty channel end is in the
+// provided counterparty state. The returned bool is true if the message must be submitted to
+// the counterparty chain with proofs of the channel end, and false if it must be submitted to
+// the chain of the channel end with proofs of the counterparty channel end. An empty type URL
+// is returned if the handshake is complete. Every handshake message advances only one of the
+// channel ends, hence both states are required to determine the next message.
+func NextHandshakeStep(state, counterpartyState State) (string, bool, error) {
+	switch {
+	case state == INIT && counterpartyState == UNINITIALIZED:
+		return sdk.MsgTypeURL(&MsgChannelOpenTry{}), true, nil
+	case state == INIT && counterpartyState == TRYOPEN:
+		return sdk.MsgTypeURL(&MsgChannelOpenAck{}), false, nil
+	case state == TRYOPEN && counterpartyState == INIT:
+		return sdk.MsgTypeURL(&MsgChannelOpenAck{}), true, nil
+	case state == TRYOPEN && counterpartyState == OPEN:
+		return sdk.MsgTypeURL(&MsgChannelOpenConfirm{}), false, nil
+	case state == OPEN && counterpartyState == TRYOPEN:
+		return sdk.MsgTypeURL(&MsgChannelOpenConfirm{}), true, nil
+	case state == OPEN && counterpartyState == OPEN:
+		return "", false, nil
+	default:
+		return "", false, sdkerrors.Wrapf(
+			ErrInvalidChannelState,
+			"no handshake step for channel state %s and counterparty channel state %s", state, counterpartyState,
+		)
+	}
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -57,3 +58,43 @@ func TestCounterpartyValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestNextHandshakeStep(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		state                   types.State
+		counterpartyState       types.State
+		expMsg                  sdk.Msg
+		expSubmitToCounterparty bool
+		expPass                 bool
+	}{
+		{"try on counterparty", types.INIT, types.UNINITIALIZED, &types.MsgChannelOpenTry{}, true, true},
+		{"ack on self", types.INIT, types.TRYOPEN, &types.MsgChannelOpenAck{}, false, true},
+		{"ack on counterparty", types.TRYOPEN, types.INIT, &types.MsgChannelOpenAck{}, true, true},
+		{"confirm on self", types.TRYOPEN, types.OPEN, &types.MsgChannelOpenConfirm{}, false, true},
+		{"confirm on counterparty", types.OPEN, types.TRYOPEN, &types.MsgChannelOpenConfirm{}, true, true},
+		{"handshake complete", types.OPEN, types.OPEN, nil, false, true},
+		{"crossing hellos", types.INIT, types.INIT, nil, false, false},
+		{"counterparty ahead of the handshake", types.INIT, types.OPEN, nil, false, false},
+		{"channel closed", types.CLOSED, types.OPEN, nil, false, false},
+		{"counterparty closed", types.OPEN, types.CLOSED, nil, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msgTypeURL, submitToCounterparty, err := types.NextHandshakeStep(tc.state, tc.counterpartyState)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expSubmitToCounterparty, submitToCounterparty, tc.name)
+		if tc.expMsg == nil {
+			require.Empty(t, msgTypeURL, tc.name)
+		} else {
+			require.Equal(t, sdk.MsgTypeURL(tc.expMsg), msgTypeURL, tc.name)
+		}
+	}
+}
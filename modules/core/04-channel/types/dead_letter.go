@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// NewDeadLetterPacket creates a new DeadLetterPacket instance.
+func NewDeadLetterPacket(packet Packet, reason string, recordedHeight clienttypes.Height) DeadLetterPacket {
+	return DeadLetterPacket{
+		Packet:         packet,
+		Reason:         reason,
+		RecordedHeight: recordedHeight,
+	}
+}
+
+// Validate performs basic validation of the dead-letter packet returning an error upon any
+// failure.
+func (dlp DeadLetterPacket) Validate() error {
+	if err := dlp.Packet.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid dead-letter packet")
+	}
+	if dlp.Reason == "" {
+		return sdkerrors.Wrap(ErrInvalidDeadLetterPacket, "reason cannot be empty")
+	}
+	return nil
+}
+
+// DeadLetterReason returns the reason recorded for a packet whose application callback failed
+// with the provided error. Only the ABCI codespace and code of the error are recorded since
+// error messages are not guaranteed to be deterministic.
+func DeadLetterReason(err error) string {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return fmt.Sprintf("codespace: %s, code: %d", codespace, code)
+}
@@ -38,4 +38,32 @@ var (
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+
+	// packet aggregation errors
+	ErrInvalidAggregatedPacket = sdkerrors.Register(SubModuleName, 25, "invalid aggregated packet")
+
+	ErrInvalidPacketDataSchema = sdkerrors.Register(SubModuleName, 26, "invalid packet data schema")
+
+	// dead-letter store errors
+	ErrDeadLetterPacketNotFound = sdkerrors.Register(SubModuleName, 27, "dead-letter packet not found")
+	ErrInvalidDeadLetterPacket  = sdkerrors.Register(SubModuleName, 28, "invalid dead-letter packet")
+
+	ErrChannelSequenceNotReserved = sdkerrors.Register(SubModuleName, 29, "channel identifier sequence is not reserved")
+
+	// channel upgrade errors
+	ErrUpgradeNotFound             = sdkerrors.Register(SubModuleName, 30, "channel upgrade not found")
+	ErrInvalidUpgrade              = sdkerrors.Register(SubModuleName, 31, "invalid channel upgrade")
+	ErrInvalidUpgradeSequence      = sdkerrors.Register(SubModuleName, 32, "invalid channel upgrade sequence")
+	ErrUpgradeTimeout              = sdkerrors.Register(SubModuleName, 33, "channel upgrade timed out")
+	ErrUpgradeTimeoutNotReached    = sdkerrors.Register(SubModuleName, 34, "channel upgrade timeout has not been reached")
+	ErrUpgradeErrorReceiptNotFound = sdkerrors.Register(SubModuleName, 35, "channel upgrade error receipt not found")
+	ErrInvalidUpgradeErrorReceipt  = sdkerrors.Register(SubModuleName, 36, "invalid channel upgrade error receipt")
+	ErrUpgradeUnsupported          = sdkerrors.Register(SubModuleName, 37, "channel upgrade not supported by the application")
+	ErrUpgradeInProgress           = sdkerrors.Register(SubModuleName, 38, "channel upgrade in progress")
+
+	// channel quarantine errors
+	ErrChannelQuarantined    = sdkerrors.Register(SubModuleName, 39, "channel is quarantined")
+	ErrChannelNotQuarantined = sdkerrors.Register(SubModuleName, 40, "channel is not quarantined")
+
+	ErrAsyncAcknowledgementNotPending = sdkerrors.Register(SubModuleName, 41, "packet is not awaiting an asynchronous acknowledgement")
 )
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// FormatPacketID returns the composite packet identifier {sourcePort}/{sourceChannel}/{sequence}
+// emitted in the packet_id attribute of packet events.
+func FormatPacketID(sourcePort, sourceChannel string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%d", sourcePort, sourceChannel, sequence)
+}
+
+// FormatPortChannelID returns the composite channel identifier {portID}/{channelID} emitted in
+// the packet_src_port_channel and packet_dst_port_channel attributes of packet events.
+func FormatPortChannelID(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", portID, channelID)
+}
+
+// ChannelPacketEventQuery returns the Tendermint event query matching the transactions which emit
+// the given packet event for packets of the provided channel on the queried chain. The channel is
+// the source channel of send_packet, acknowledge_packet and timeout_packet events, and the
+// destination channel of recv_packet and write_acknowledgement events. The query may be used to
+// subscribe to the events of a single channel over the Tendermint websocket.
+func ChannelPacketEventQuery(eventType, portID, channelID string) (string, error) {
+	attributeKey, err := localPortChannelAttributeKey(eventType)
+	if err != nil {
+		return "", err
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return "", err
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return "", err
+	}
+
+	return txEventQuery(eventType, attributeKey, FormatPortChannelID(portID, channelID)), nil
+}
+
+// PacketEventQuery returns the Tendermint event query matching the transactions which emit the
+// given packet event for the packet with the provided source port, source channel and sequence.
+// The packet identifier is identical on both chains, the query therefore matches the events of
+// the packet on the source chain as well as on the destination chain.
+func PacketEventQuery(eventType, sourcePort, sourceChannel string, sequence uint64) (string, error) {
+	if _, err := localPortChannelAttributeKey(eventType); err != nil {
+		return "", err
+	}
+
+	if err := host.PortIdentifierValidator(sourcePort); err != nil {
+		return "", err
+	}
+
+	if err := host.ChannelIdentifierValidator(sourceChannel); err != nil {
+		return "", err
+	}
+
+	if sequence == 0 {
+		return "", sdkerrors.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+
+	return txEventQuery(eventType, AttributeKeyPacketID, FormatPacketID(sourcePort, sourceChannel, sequence)), nil
+}
+
+// localPortChannelAttributeKey returns the key of the composite channel identifier attribute of the
+// channel on the chain emitting the given packet event.
+func localPortChannelAttributeKey(eventType string) (string, error) {
+	switch eventType {
+	case EventTypeSendPacket, EventTypeAcknowledgePacket, EventTypeTimeoutPacket:
+		return AttributeKeySrcPortChannel, nil
+	case EventTypeRecvPacket, EventTypeWriteAck:
+		return AttributeKeyDstPortChannel, nil
+	default:
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a packet event type", eventType)
+	}
+}
+
+// txEventQuery returns the Tendermint event query matching the transactions which emit an event of
+// the given type with the given attribute value.
+func txEventQuery(eventType, attributeKey, attributeValue string) string {
+	return fmt.Sprintf("%s='%s' AND %s.%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx, eventType, attributeKey, attributeValue)
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestChannelPacketEventQuery() {
+	testCases := []struct {
+		name      string
+		eventType string
+		portID    string
+		channelID string
+		expQuery  string
+		expPass   bool
+	}{
+		{"send packet", types.EventTypeSendPacket, portid, chanid, "tm.event='Tx' AND send_packet.packet_src_port_channel='testportid/channel-0'", true},
+		{"acknowledge packet", types.EventTypeAcknowledgePacket, portid, chanid, "tm.event='Tx' AND acknowledge_packet.packet_src_port_channel='testportid/channel-0'", true},
+		{"timeout packet", types.EventTypeTimeoutPacket, portid, chanid, "tm.event='Tx' AND timeout_packet.packet_src_port_channel='testportid/channel-0'", true},
+		{"recv packet", types.EventTypeRecvPacket, portid, chanid, "tm.event='Tx' AND recv_packet.packet_dst_port_channel='testportid/channel-0'", true},
+		{"write acknowledgement", types.EventTypeWriteAck, portid, chanid, "tm.event='Tx' AND write_acknowledgement.packet_dst_port_channel='testportid/channel-0'", true},
+		{"not a packet event", types.EventTypeChannelOpenInit, portid, chanid, "", false},
+		{"invalid port identifier", types.EventTypeSendPacket, invalidPort, chanid, "", false},
+		{"invalid channel identifier", types.EventTypeSendPacket, portid, "", "", false},
+	}
+
+	for _, tc := range testCases {
+		q, err := types.ChannelPacketEventQuery(tc.eventType, tc.portID, tc.channelID)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expQuery, q, tc.name)
+
+			_, err = query.New(q)
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestPacketEventQuery() {
+	testCases := []struct {
+		name      string
+		eventType string
+		portID    string
+		channelID string
+		sequence  uint64
+		expQuery  string
+		expPass   bool
+	}{
+		{"send packet", types.EventTypeSendPacket, portid, chanid, 1, "tm.event='Tx' AND send_packet.packet_id='testportid/channel-0/1'", true},
+		{"recv packet", types.EventTypeRecvPacket, portid, chanid, 10, "tm.event='Tx' AND recv_packet.packet_id='testportid/channel-0/10'", true},
+		{"not a packet event", types.EventTypeChannelOpenInit, portid, chanid, 1, "", false},
+		{"invalid port identifier", types.EventTypeSendPacket, "", chanid, 1, "", false},
+		{"invalid channel identifier", types.EventTypeSendPacket, portid, "channel'", 1, "", false},
+		{"zero sequence", types.EventTypeSendPacket, portid, chanid, 0, "", false},
+	}
+
+	for _, tc := range testCases {
+		q, err := types.PacketEventQuery(tc.eventType, tc.portID, tc.channelID, tc.sequence)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expQuery, q, tc.name)
+
+			_, err = query.New(q)
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -37,6 +37,15 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+	AttributeKeyProofHeight      = "packet_proof_height"
+
+	// AttributeKeyPacketID is the composite packet identifier {sourcePort}/{sourceChannel}/{sequence},
+	// identical in the events of both chains
+	AttributeKeyPacketID = "packet_id"
+	// AttributeKeySrcPortChannel is the composite channel identifier {sourcePort}/{sourceChannel}
+	AttributeKeySrcPortChannel = "packet_src_port_channel"
+	// AttributeKeyDstPortChannel is the composite channel identifier {destinationPort}/{destinationChannel}
+	AttributeKeyDstPortChannel = "packet_dst_port_channel"
 )
 
 // IBC channel events vars
@@ -48,5 +57,77 @@ var (
 	EventTypeChannelCloseInit    = "channel_close_init"
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 
+	// EventTypeDuplicateChannel is an advisory event emitted when a channel handshake is
+	// started for a port, connection and counterparty port which already have OPEN channels
+	EventTypeDuplicateChannel = "duplicate_channel"
+	// AttributeKeyDuplicateChannelIDs is the comma separated list of the identifiers of the
+	// OPEN channels duplicated by the new channel
+	AttributeKeyDuplicateChannelIDs = "duplicate_channel_ids"
+
+	// EventTypeDeadLetterPacket is emitted when a packet whose application callback failed is
+	// recorded in the dead-letter store
+	EventTypeDeadLetterPacket = "dead_letter_packet"
+	// EventTypeReclaimPacket is emitted when a dead-letter packet is reclaimed by its application
+	EventTypeReclaimPacket = "reclaim_packet"
+	// AttributeKeyDeadLetterReason is the reason recorded for a dead-letter packet
+	AttributeKeyDeadLetterReason = "dead_letter_reason"
+
+	// EventTypeCompensatePacket is emitted when the compensation of a packet which failed on the
+	// counterparty chain or timed out is executed by its sending application
+	EventTypeCompensatePacket = "compensate_packet"
+
+	// EventTypePacketAlreadyRelayed is emitted when a receive, acknowledgement or timeout message
+	// is a no-op because the packet has already been relayed
+	EventTypePacketAlreadyRelayed = "packet_already_relayed"
+	// AttributeKeyRelayedEventType is the event type of the redundant packet message
+	AttributeKeyRelayedEventType = "packet_event_type"
+	// AttributeKeyProcessedHeight is the block height at which the packet was originally received,
+	// or at which its commitment was cleared by an acknowledgement or timeout
+	AttributeKeyProcessedHeight = "packet_processed_height"
+
+	// EventTypePacketLatency is emitted when a packet is acknowledged, with the time elapsed
+	// since the block in which the packet was sent
+	EventTypePacketLatency = "packet_latency"
+	// AttributeKeyPacketLatency is the latency of the acknowledged packet in milliseconds
+	AttributeKeyPacketLatency = "packet_latency_ms"
+
+	// AttributeKeyPacketPriority is the priority class attached to a sent packet by the sending
+	// application
+	AttributeKeyPacketPriority = "packet_priority"
+
+	// EventTypeChannelQuarantined is emitted when a channel is placed in quarantine
+	EventTypeChannelQuarantined = "channel_quarantined"
+	// EventTypeChannelReleased is emitted when a channel is released from quarantine
+	EventTypeChannelReleased = "channel_released"
+
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeOpen    = "channel_upgrade_open"
+	EventTypeChannelUpgradeTimeout = "channel_upgrade_timeout"
+	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancel"
+	// EventTypeChannelUpgradeError is emitted when an upgrade is aborted and its error receipt written
+	EventTypeChannelUpgradeError = "channel_upgrade_error"
+
+	AttributeKeyUpgradeSequence       = "upgrade_sequence"
+	AttributeKeyUpgradeVersion        = "upgrade_version"
+	AttributeKeyUpgradeOrdering       = "upgrade_ordering"
+	AttributeKeyUpgradeConnectionHops = "upgrade_connection_hops"
+	AttributeKeyUpgradeTimeoutHeight  = "upgrade_timeout_height"
+	// AttributeKeyUpgradeTimeoutTimestamp is the upgrade timeout timestamp in nanoseconds
+	AttributeKeyUpgradeTimeoutTimestamp = "upgrade_timeout_timestamp"
+	AttributeKeyUpgradeErrorReceipt     = "upgrade_error_receipt"
+	AttributeKeyChannelState            = "channel_state"
+
+	// EventTypeChannelStateTransition is emitted on every change of the state of a channel end,
+	// with the metadata of the channel
+	EventTypeChannelStateTransition  = "channel_state_transition"
+	AttributeKeyPreviousChannelState = "previous_channel_state"
+	AttributeKeyOrdering             = "channel_ordering"
+	AttributeKeyVersion              = "channel_version"
+	// AttributeKeyConnectionHops is the comma separated list of the connection hops of the channel
+	AttributeKeyConnectionHops = "connection_hops"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
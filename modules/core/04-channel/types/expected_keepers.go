@@ -1,9 +1,11 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -13,6 +15,9 @@ type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	IsMonotonicHeightClient(ctx sdk.Context, clientType string) bool
+	GetHostHeight(ctx sdk.Context) clienttypes.Height
+	GetHostTimestamp(ctx sdk.Context) uint64
 }
 
 // ConnectionKeeper expected account IBC connection keeper
@@ -32,6 +37,24 @@ type ConnectionKeeper interface {
 		channelID string,
 		channel exported.ChannelI,
 	) error
+	VerifyChannelUpgrade(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade codec.ProtoMarshaler,
+	) error
+	VerifyChannelUpgradeError(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt codec.ProtoMarshaler,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -68,6 +68,7 @@ func DefaultGenesisState() GenesisState {
 		RecvSequences:       []PacketSequence{},
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
+		DeadLetterPackets:   []DeadLetterPacket{},
 	}
 }
 
@@ -77,6 +78,7 @@ func (gs GenesisState) Validate() error {
 	// keep track of the max sequence to ensure it is less than
 	// the next sequence used in creating connection identifers.
 	var maxSequence uint64 = 0
+	usedSequences := make(map[uint64]bool)
 
 	for i, channel := range gs.Channels {
 		sequence, err := ParseChannelSequence(channel.ChannelId)
@@ -87,6 +89,7 @@ func (gs GenesisState) Validate() error {
 		if sequence > maxSequence {
 			maxSequence = sequence
 		}
+		usedSequences[sequence] = true
 
 		if err := channel.ValidateBasic(); err != nil {
 			return fmt.Errorf("invalid channel %v channel index %d: %w", channel, i, err)
@@ -97,6 +100,22 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("next channel sequence %d must be greater than maximum sequence used in channel identifier %d", gs.NextChannelSequence, maxSequence)
 	}
 
+	reservedSequences := make(map[uint64]bool, len(gs.ReservedChannelSequences))
+	for _, sequence := range gs.ReservedChannelSequences {
+		if sequence >= gs.NextChannelSequence {
+			return fmt.Errorf("reserved channel sequence %d must be less than the next channel sequence %d", sequence, gs.NextChannelSequence)
+		}
+
+		if usedSequences[sequence] {
+			return fmt.Errorf("reserved channel sequence %d is used by a channel", sequence)
+		}
+
+		if reservedSequences[sequence] {
+			return fmt.Errorf("duplicate reserved channel sequence %d", sequence)
+		}
+		reservedSequences[sequence] = true
+	}
+
 	for i, ack := range gs.Acknowledgements {
 		if err := ack.Validate(); err != nil {
 			return fmt.Errorf("invalid acknowledgement %v ack index %d: %w", ack, i, err)
@@ -139,6 +158,31 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, dlp := range gs.DeadLetterPackets {
+		if err := dlp.Validate(); err != nil {
+			return fmt.Errorf("invalid dead-letter packet %v index %d: %w", dlp, i, err)
+		}
+	}
+
+	for i, qc := range gs.QuarantinedChannels {
+		if err := qc.Validate(); err != nil {
+			return fmt.Errorf("invalid quarantined channel %v index %d: %w", qc, i, err)
+		}
+	}
+
+	for i, pa := range gs.PendingAsyncAcknowledgements {
+		if err := pa.Validate(); err != nil {
+			return fmt.Errorf("invalid pending asynchronous acknowledgement %v index %d: %w", pa, i, err)
+		}
+	}
+
+	// the compensation data of a packet may be empty
+	for i, pc := range gs.PacketCompensations {
+		if err := validateGenFields(pc.PortId, pc.ChannelId, pc.Sequence); err != nil {
+			return fmt.Errorf("invalid packet compensation %v index %d: %w", pc, i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,16 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	// packets kept in the dead-letter store
+	DeadLetterPackets []DeadLetterPacket `protobuf:"bytes,9,rep,name=dead_letter_packets,json=deadLetterPackets,proto3" json:"dead_letter_packets" yaml:"dead_letter_packets"`
+	// channel identifier sequences reserved for an upcoming upgrade
+	ReservedChannelSequences []uint64 `protobuf:"varint,10,rep,packed,name=reserved_channel_sequences,json=reservedChannelSequences,proto3" json:"reserved_channel_sequences,omitempty" yaml:"reserved_channel_sequences"`
+	// channels placed in quarantine
+	QuarantinedChannels []QuarantinedChannel `protobuf:"bytes,11,rep,name=quarantined_channels,json=quarantinedChannels,proto3" json:"quarantined_channels" yaml:"quarantined_channels"`
+	// packets whose acknowledgement is still to be written asynchronously
+	PendingAsyncAcknowledgements []PendingAsyncAcknowledgement `protobuf:"bytes,12,rep,name=pending_async_acknowledgements,json=pendingAsyncAcknowledgements,proto3" json:"pending_async_acknowledgements" yaml:"pending_async_acknowledgements"`
+	// compensation data of sent packets which are neither acknowledged nor timed out yet
+	PacketCompensations []PacketState `protobuf:"bytes,13,rep,name=packet_compensations,json=packetCompensations,proto3" json:"packet_compensations" yaml:"packet_compensations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +135,41 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetDeadLetterPackets() []DeadLetterPacket {
+	if m != nil {
+		return m.DeadLetterPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReservedChannelSequences() []uint64 {
+	if m != nil {
+		return m.ReservedChannelSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedChannels() []QuarantinedChannel {
+	if m != nil {
+		return m.QuarantinedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAsyncAcknowledgements() []PendingAsyncAcknowledgement {
+	if m != nil {
+		return m.PendingAsyncAcknowledgements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketCompensations() []PacketState {
+	if m != nil {
+		return m.PacketCompensations
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,39 +240,52 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x69, 0x2f, 0x70, 0x34, 0x07, 0xc8, 0x0d, 0x6d, 0x39, 0x21, 0xa1, 0xc8,
-	0x5e, 0x4f, 0xdd, 0x55, 0x62, 0x6f, 0xf0, 0x6e, 0x02, 0x7d, 0x0a, 0x78, 0xac, 0x1e, 0x7b, 0xe4,
-	0x64, 0xa1, 0xe4, 0x0d, 0x72, 0xe4, 0x84, 0xfc, 0x37, 0x89, 0x1a, 0x21, 0xca, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x1e, 0xb5, 0x29, 0x8f, 0xc1, 0xa6, 0xd7, 0x6e, 0x14,
-	0xc1, 0xc8, 0x9e, 0x9e, 0xd9, 0x01, 0x44, 0x20, 0x98, 0xb0, 0xc6, 0x31, 0x97, 0x1c, 0x1f, 0x31,
-	0x8f, 0x5a, 0x69, 0xc4, 0x2a, 0x22, 0xd6, 0xf4, 0xac, 0x7b, 0x1c, 0xf0, 0x80, 0x67, 0x7d, 0x3b,
-	0xfd, 0xca, 0xa3, 0xdd, 0x8d, 0xb4, 0x72, 0x2a, 0x8b, 0x98, 0xf3, 0x1d, 0x74, 0xf0, 0x2e, 0xe7,
-	0x5f, 0x4a, 0x57, 0x02, 0xfe, 0x8c, 0x9a, 0x45, 0x42, 0xa8, 0x8a, 0xb1, 0xdd, 0xdb, 0x7f, 0xf5,
-	0xdc, 0xda, 0x60, 0xb4, 0xfa, 0x3e, 0x44, 0x92, 0x5d, 0x31, 0xf0, 0xdf, 0xe6, 0x45, 0xe7, 0xf1,
-	0x6d, 0xa2, 0xd7, 0x7e, 0x27, 0xfa, 0xe1, 0xbd, 0x16, 0xa9, 0x90, 0x98, 0xa0, 0x47, 0x2e, 0x1d,
-	0x46, 0xfc, 0xeb, 0x08, 0xfc, 0x00, 0x42, 0x88, 0xa4, 0x50, 0xb7, 0x32, 0x8d, 0xb1, 0x51, 0xf3,
-	0xc1, 0xa5, 0x43, 0x90, 0xd9, 0x6a, 0x4e, 0x3d, 0x15, 0x90, 0x7b, 0xf3, 0xf8, 0x3d, 0xda, 0xa7,
-	0x3c, 0x0c, 0x99, 0xcc, 0x71, 0xdb, 0x0f, 0xc2, 0xad, 0x8e, 0x62, 0x07, 0x35, 0x63, 0xa0, 0xc0,
-	0xc6, 0x52, 0xa8, 0xf5, 0x07, 0x61, 0xaa, 0x39, 0xcc, 0x50, 0x5b, 0x40, 0xe4, 0x0f, 0x04, 0x7c,
-	0x99, 0x40, 0x44, 0x41, 0xa8, 0x3b, 0x19, 0xe9, 0xd9, 0xdf, 0x48, 0x45, 0xd6, 0x79, 0x92, 0xc2,
-	0x16, 0x89, 0xde, 0xb9, 0x71, 0xc3, 0xd1, 0x1b, 0x73, 0x1d, 0x64, 0x92, 0x56, 0x5a, 0x28, 0xc3,
-	0x99, 0x2a, 0x06, 0x3a, 0x5d, 0x51, 0x35, 0xfe, 0x5b, 0xb5, 0x0e, 0x32, 0x49, 0x2b, 0x2d, 0x2c,
-	0x55, 0x57, 0xa8, 0xe5, 0xd2, 0xe1, 0x8a, 0x69, 0xf7, 0xdf, 0x4d, 0x27, 0x85, 0xe9, 0x38, 0x37,
-	0xad, 0x71, 0x4c, 0x72, 0xe0, 0xd2, 0xe1, 0xd2, 0xf3, 0x11, 0x75, 0x22, 0xf8, 0x26, 0x07, 0x05,
-	0xad, 0x0a, 0xaa, 0x4d, 0x43, 0xe9, 0xd5, 0x1d, 0x63, 0x91, 0xe8, 0x27, 0x39, 0x66, 0x63, 0xcc,
-	0x24, 0x47, 0x69, 0xbd, 0xf8, 0xef, 0x4a, 0xac, 0xf9, 0x5d, 0x41, 0xed, 0xf5, 0xa5, 0xf0, 0x0b,
-	0xb4, 0x3b, 0xe6, 0xb1, 0x1c, 0x30, 0x5f, 0x55, 0x0c, 0xa5, 0xb7, 0xe7, 0xe0, 0x45, 0xa2, 0xb7,
-	0x73, 0x74, 0xd1, 0x30, 0x49, 0x23, 0xfd, 0xea, 0xfb, 0xf8, 0x02, 0xa1, 0xd2, 0xc4, 0x7c, 0x75,
-	0x2b, 0xcb, 0x77, 0x16, 0x89, 0x7e, 0x98, 0xe7, 0x97, 0x3d, 0x93, 0xec, 0x15, 0x8f, 0xbe, 0x8f,
-	0xbb, 0xa8, 0x59, 0xad, 0xbf, 0x9d, 0xae, 0x4f, 0xaa, 0xb7, 0x73, 0x79, 0x3b, 0xd3, 0x94, 0xbb,
-	0x99, 0xa6, 0xfc, 0x9a, 0x69, 0xca, 0x8f, 0xb9, 0x56, 0xbb, 0x9b, 0x6b, 0xb5, 0x9f, 0x73, 0xad,
-	0xf6, 0xe9, 0x75, 0xc0, 0xe4, 0xf5, 0xc4, 0xb3, 0x28, 0x0f, 0x6d, 0xca, 0x45, 0xc8, 0x85, 0xcd,
-	0x3c, 0x7a, 0x1a, 0x70, 0x7b, 0x7a, 0x6e, 0x87, 0xdc, 0x9f, 0x8c, 0x40, 0xe4, 0x47, 0xfd, 0xf2,
-	0xe2, 0xb4, 0xbc, 0x6b, 0x79, 0x33, 0x06, 0xe1, 0x35, 0xb2, 0x9b, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x29, 0xa1, 0xc4, 0xd2, 0x46, 0x04, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x92, 0x85, 0x30, 0x10, 0xb4, 0x38, 0x41, 0xf2, 0x66, 0xd9, 0x24, 0x18, 0xb1,
+	0x1b, 0x69, 0x45, 0x0c, 0x0b, 0x97, 0xdd, 0x1b, 0x66, 0xa5, 0x5d, 0xa4, 0x1e, 0x5a, 0xd3, 0x53,
+	0xa5, 0xca, 0x72, 0x66, 0x1e, 0x61, 0x94, 0x78, 0xc6, 0x78, 0x26, 0x29, 0x39, 0xf5, 0x23, 0xb4,
+	0x5f, 0xa2, 0xdf, 0x85, 0x23, 0xc7, 0x9e, 0xa2, 0x0a, 0xa4, 0x7e, 0x80, 0x1c, 0x7b, 0xaa, 0xec,
+	0xb1, 0x13, 0x42, 0x0c, 0x82, 0xde, 0xe2, 0x37, 0xff, 0xff, 0xef, 0xff, 0x5e, 0xec, 0xa7, 0x41,
+	0x5b, 0xb4, 0x8d, 0x2d, 0xcc, 0x43, 0xb0, 0xf0, 0xb9, 0xc7, 0x18, 0xf4, 0xac, 0xc1, 0xbe, 0xd5,
+	0x01, 0x06, 0x82, 0x8a, 0x56, 0x10, 0x72, 0xc9, 0xf5, 0x32, 0x6d, 0xe3, 0x56, 0x24, 0x69, 0x25,
+	0x92, 0xd6, 0x60, 0xbf, 0x5a, 0xe9, 0xf0, 0x0e, 0x8f, 0xcf, 0xad, 0xe8, 0x97, 0x92, 0x56, 0x33,
+	0x69, 0xa9, 0x2b, 0x96, 0x98, 0x5f, 0x11, 0x5a, 0xfd, 0x4f, 0xf1, 0x4f, 0xa5, 0x27, 0x41, 0x7f,
+	0x8b, 0x8a, 0x89, 0x42, 0x18, 0x5a, 0x23, 0xdf, 0x5c, 0xf9, 0xeb, 0xf7, 0x56, 0x46, 0x62, 0xeb,
+	0x84, 0x00, 0x93, 0xf4, 0x8c, 0x02, 0x39, 0x56, 0x45, 0xfb, 0x97, 0xab, 0x51, 0x3d, 0xf7, 0x6d,
+	0x54, 0x5f, 0x9f, 0x3b, 0x72, 0x26, 0x48, 0xdd, 0x41, 0x3f, 0x7b, 0xb8, 0xcb, 0xf8, 0xbb, 0x1e,
+	0x90, 0x0e, 0xf8, 0xc0, 0xa4, 0x30, 0x16, 0xe2, 0x98, 0x46, 0x66, 0xcc, 0x4b, 0x0f, 0x77, 0x41,
+	0xc6, 0xad, 0xd9, 0x85, 0x28, 0xc0, 0x99, 0xf3, 0xeb, 0xff, 0xa3, 0x15, 0xcc, 0x7d, 0x9f, 0x4a,
+	0x85, 0xcb, 0x3f, 0x0b, 0x77, 0xd7, 0xaa, 0xdb, 0xa8, 0x18, 0x02, 0x06, 0x1a, 0x48, 0x61, 0x14,
+	0x9e, 0x85, 0x99, 0xf8, 0x74, 0x8a, 0xd6, 0x04, 0x30, 0xe2, 0x0a, 0xb8, 0xe8, 0x03, 0xc3, 0x20,
+	0x8c, 0x9f, 0x62, 0xd2, 0xf6, 0x63, 0xa4, 0x44, 0x6b, 0xff, 0x16, 0xc1, 0xc6, 0xa3, 0xfa, 0xc6,
+	0xd0, 0xf3, 0x7b, 0xff, 0x98, 0xb3, 0x20, 0xd3, 0x29, 0x45, 0x85, 0x54, 0x1c, 0x47, 0x85, 0x80,
+	0x07, 0x77, 0xa2, 0x16, 0x7f, 0x38, 0x6a, 0x16, 0x64, 0x3a, 0xa5, 0xa8, 0x30, 0x8d, 0x3a, 0x43,
+	0x25, 0x0f, 0x77, 0xef, 0x24, 0x2d, 0x3d, 0x3d, 0x69, 0x33, 0x49, 0xaa, 0xa8, 0xa4, 0x19, 0x8e,
+	0xe9, 0xac, 0x7a, 0xb8, 0x3b, 0xcd, 0x79, 0x8d, 0x36, 0x18, 0x5c, 0x4a, 0x37, 0xa1, 0x4d, 0x84,
+	0x46, 0xb1, 0xa1, 0x35, 0x0b, 0x76, 0x63, 0x3c, 0xaa, 0x6f, 0x2a, 0x4c, 0xa6, 0xcc, 0x74, 0xca,
+	0x51, 0x3d, 0xf9, 0xee, 0x52, 0xac, 0x3e, 0x44, 0x65, 0x02, 0x1e, 0x71, 0x7b, 0x20, 0x25, 0x84,
+	0x6e, 0x10, 0xf7, 0x27, 0x8c, 0xe5, 0x78, 0x86, 0x9d, 0xcc, 0x19, 0xfe, 0x05, 0x8f, 0xbc, 0x88,
+	0xe5, 0x6a, 0x1a, 0xdb, 0x4c, 0xa6, 0xa8, 0xaa, 0xf8, 0x0c, 0x9e, 0xe9, 0xac, 0x93, 0x7b, 0x2e,
+	0xa1, 0x63, 0x54, 0x0d, 0x41, 0x40, 0x38, 0x00, 0x32, 0xd7, 0xad, 0x30, 0x50, 0x23, 0xdf, 0x2c,
+	0xd8, 0x3b, 0xe3, 0x51, 0x7d, 0x2b, 0x7d, 0x0d, 0x0f, 0x69, 0x4d, 0xc7, 0x48, 0x0f, 0xef, 0x8d,
+	0x27, 0xf4, 0xf7, 0xa8, 0x72, 0xd1, 0xf7, 0x42, 0x8f, 0x49, 0xca, 0xa6, 0x5e, 0x61, 0xac, 0xc4,
+	0x03, 0xfe, 0x91, 0x39, 0xe0, 0xab, 0xa9, 0x21, 0xdd, 0xe0, 0xed, 0x64, 0xc4, 0x5f, 0x55, 0x2f,
+	0x59, 0x48, 0xd3, 0x29, 0x5f, 0xcc, 0x19, 0x85, 0xfe, 0x49, 0x43, 0xb5, 0x00, 0x18, 0xa1, 0xac,
+	0xe3, 0x7a, 0x62, 0xc8, 0xb0, 0x3b, 0xb7, 0xe5, 0xab, 0x71, 0x2f, 0x7b, 0xd9, 0x1f, 0x8c, 0xb2,
+	0x1e, 0x45, 0xce, 0xa3, 0x59, 0xa3, 0xbd, 0x9b, 0x34, 0xb5, 0xa3, 0x9a, 0x7a, 0x3c, 0xc5, 0x74,
+	0x36, 0x83, 0x87, 0x59, 0x42, 0xbf, 0x44, 0x15, 0xf5, 0xb2, 0x5c, 0xcc, 0xfd, 0x00, 0x98, 0xf0,
+	0x24, 0xe5, 0x4c, 0x18, 0xa5, 0x27, 0x2e, 0xfb, 0xbd, 0x7f, 0x28, 0x8b, 0x65, 0x3a, 0x65, 0x55,
+	0x3e, 0x9e, 0xa9, 0x7e, 0xd0, 0xd0, 0xda, 0xec, 0x5e, 0xe8, 0x7f, 0xa2, 0xa5, 0x80, 0x87, 0xd2,
+	0xa5, 0xc4, 0xd0, 0x1a, 0x5a, 0x73, 0xd9, 0xd6, 0xc7, 0xa3, 0xfa, 0x5a, 0x42, 0x56, 0x07, 0xa6,
+	0xb3, 0x18, 0xfd, 0x3a, 0x21, 0xfa, 0x21, 0x42, 0xe9, 0x27, 0x41, 0x89, 0xb1, 0x10, 0xeb, 0x37,
+	0xc6, 0xa3, 0xfa, 0xba, 0xd2, 0x4f, 0xcf, 0x4c, 0x67, 0x39, 0x79, 0x38, 0x21, 0x7a, 0x15, 0x15,
+	0x27, 0x1b, 0x94, 0x8f, 0x36, 0xc8, 0x99, 0x3c, 0xdb, 0xa7, 0x57, 0x37, 0x35, 0xed, 0xfa, 0xa6,
+	0xa6, 0x7d, 0xb9, 0xa9, 0x69, 0x1f, 0x6f, 0x6b, 0xb9, 0xeb, 0xdb, 0x5a, 0xee, 0xf3, 0x6d, 0x2d,
+	0xf7, 0xe6, 0xef, 0x0e, 0x95, 0xe7, 0xfd, 0x76, 0x0b, 0x73, 0xdf, 0xc2, 0x5c, 0xf8, 0x5c, 0x58,
+	0xb4, 0x8d, 0x77, 0x3b, 0xdc, 0x1a, 0x1c, 0x58, 0x3e, 0x27, 0xfd, 0x1e, 0x08, 0x75, 0xaf, 0xec,
+	0x1d, 0xee, 0xa6, 0x57, 0x8b, 0x1c, 0x06, 0x20, 0xda, 0x8b, 0xf1, 0xb5, 0x72, 0xf0, 0x7d, 0x00,
+	0xf0, 0xc4, 0x74, 0xf6, 0xc9, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +308,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketCompensations) > 0 {
+		for iNdEx := len(m.PacketCompensations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketCompensations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingAsyncAcknowledgements) > 0 {
+		for iNdEx := len(m.PendingAsyncAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAsyncAcknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.QuarantinedChannels) > 0 {
+		for iNdEx := len(m.QuarantinedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ReservedChannelSequences) > 0 {
+		dAtA2 := make([]byte, len(m.ReservedChannelSequences)*10)
+		var j1 int
+		for _, num := range m.ReservedChannelSequences {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DeadLetterPackets) > 0 {
+		for iNdEx := len(m.DeadLetterPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadLetterPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -460,6 +592,37 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	if len(m.DeadLetterPackets) > 0 {
+		for _, e := range m.DeadLetterPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReservedChannelSequences) > 0 {
+		l = 0
+		for _, e := range m.ReservedChannelSequences {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.QuarantinedChannels) > 0 {
+		for _, e := range m.QuarantinedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAsyncAcknowledgements) > 0 {
+		for _, e := range m.PendingAsyncAcknowledgements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketCompensations) > 0 {
+		for _, e := range m.PacketCompensations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -775,6 +938,218 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetterPackets = append(m.DeadLetterPackets, DeadLetterPacket{})
+			if err := m.DeadLetterPackets[len(m.DeadLetterPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReservedChannelSequences = append(m.ReservedChannelSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReservedChannelSequences) == 0 {
+					m.ReservedChannelSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReservedChannelSequences = append(m.ReservedChannelSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedChannelSequences", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedChannels = append(m.QuarantinedChannels, QuarantinedChannel{})
+			if err := m.QuarantinedChannels[len(m.QuarantinedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAsyncAcknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAsyncAcknowledgements = append(m.PendingAsyncAcknowledgements, PendingAsyncAcknowledgement{})
+			if err := m.PendingAsyncAcknowledgements[len(m.PendingAsyncAcknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCompensations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketCompensations = append(m.PacketCompensations, PacketState{})
+			if err := m.PacketCompensations[len(m.PacketCompensations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,8 +3,10 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
@@ -128,6 +130,90 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid dead-letter packet",
+			genState: types.GenesisState{
+				DeadLetterPackets: []types.DeadLetterPacket{
+					types.NewDeadLetterPacket(
+						types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+						"codespace: bank, code: 5", clienttypes.NewHeight(0, 5),
+					),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid dead-letter packet",
+			genState: types.GenesisState{
+				DeadLetterPackets: []types.DeadLetterPacket{
+					types.NewDeadLetterPacket(
+						types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+						"", clienttypes.NewHeight(0, 5),
+					),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid quarantined channel",
+			genState: types.GenesisState{
+				QuarantinedChannels: []types.QuarantinedChannel{
+					types.NewQuarantinedChannel(testPort1, testChannel1, sdk.AccAddress("signer").String(), clienttypes.NewHeight(0, 5)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid quarantined channel signer",
+			genState: types.GenesisState{
+				QuarantinedChannels: []types.QuarantinedChannel{
+					types.NewQuarantinedChannel(testPort1, testChannel1, "signer", clienttypes.NewHeight(0, 5)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pending asynchronous acknowledgement",
+			genState: types.GenesisState{
+				PendingAsyncAcknowledgements: []types.PendingAsyncAcknowledgement{
+					types.NewPendingAsyncAcknowledgement(
+						types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+						clienttypes.NewHeight(0, 5),
+					),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid pending asynchronous acknowledgement received height",
+			genState: types.GenesisState{
+				PendingAsyncAcknowledgements: []types.PendingAsyncAcknowledgement{
+					types.NewPendingAsyncAcknowledgement(
+						types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+						clienttypes.ZeroHeight(),
+					),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid packet compensation with empty data",
+			genState: types.GenesisState{
+				PacketCompensations: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 1, nil),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid packet compensation sequence",
+			genState: types.GenesisState{
+				PacketCompensations: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 0, []byte("compensation")),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid ack seq",
 			genState: types.GenesisState{
@@ -211,6 +297,45 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid reserved channel sequences",
+			genState: types.GenesisState{
+				NextChannelSequence:      3,
+				ReservedChannelSequences: []uint64{0, 2},
+			},
+			expPass: true,
+		},
+		{
+			name: "reserved channel sequence is not less than the next channel sequence",
+			genState: types.GenesisState{
+				NextChannelSequence:      1,
+				ReservedChannelSequences: []uint64{1},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate reserved channel sequence",
+			genState: types.GenesisState{
+				NextChannelSequence:      3,
+				ReservedChannelSequences: []uint64{1, 1},
+			},
+			expPass: false,
+		},
+		{
+			name: "reserved channel sequence is used by a channel",
+			genState: types.GenesisState{
+				Channels: []types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort2, testChannel2, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty1, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				NextChannelSequence:      2,
+				ReservedChannelSequences: []uint64{1},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ReadOnlyChannelKeeper defines the read-only methods of the IBC channel keeper. External modules
+// which only inspect channels and packets, such as rate limiters or analytics modules, may depend
+// on this interface so that they can be wired without access to the methods sending packets or
+// mutating the channel state.
+type ReadOnlyChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (Channel, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error)
+	GetChannelClientStatus(ctx sdk.Context, portID, channelID string) (string, exported.Status, error)
+	GetChannelConnection(ctx sdk.Context, portID, channelID string) (string, exported.ConnectionI, error)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+	GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool)
+	GetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool)
+	IterateChannels(ctx sdk.Context, cb func(IdentifiedChannel) bool)
+}
+
+// ChannelKeeper defines the methods of the IBC channel keeper sending packets and mutating the
+// channel state in addition to the read-only methods.
+type ChannelKeeper interface {
+	ReadOnlyChannelKeeper
+
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI) error
+	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
+	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+}
+
+// AsyncAckManager defines the methods of the IBC channel keeper used by applications which
+// acknowledge received packets asynchronously. A packet for which the application returns no
+// acknowledgement from its OnRecvPacket callback is recorded as awaiting an acknowledgement,
+// which must then be written exactly once with WriteAsyncAcknowledgement.
+type AsyncAckManager interface {
+	WriteAsyncAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
+	HasPendingAsyncAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) bool
+	GetPendingAsyncAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) (PendingAsyncAcknowledgement, bool)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+)
+
+// PacketLatencyBuckets are the upper bounds of the latency buckets of the packet latency
+// statistics of a channel, in increasing order.
+var PacketLatencyBuckets = []time.Duration{
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+// PacketLatencyBucketBoundsMs returns the upper bounds of the packet latency buckets in
+// milliseconds.
+func PacketLatencyBucketBoundsMs() []uint64 {
+	bounds := make([]uint64, len(PacketLatencyBuckets))
+	for i, bucket := range PacketLatencyBuckets {
+		bounds[i] = uint64(bucket.Milliseconds())
+	}
+	return bounds
+}
+
+// Record adds the latency of an acknowledged packet to the latency statistics. Negative
+// latencies are recorded as zero.
+func (pl *PacketLatency) Record(latency time.Duration) {
+	if latency < 0 {
+		latency = 0
+	}
+
+	if len(pl.BucketCounts) != len(PacketLatencyBuckets)+1 {
+		bucketCounts := make([]uint64, len(PacketLatencyBuckets)+1)
+		copy(bucketCounts, pl.BucketCounts)
+		pl.BucketCounts = bucketCounts
+	}
+
+	latencyMs := uint64(latency.Milliseconds())
+	pl.Count++
+	pl.TotalMs += latencyMs
+	if latencyMs > pl.MaxMs {
+		pl.MaxMs = latencyMs
+	}
+
+	bucket := len(PacketLatencyBuckets)
+	for i, upperBound := range PacketLatencyBuckets {
+		if latency <= upperBound {
+			bucket = i
+			break
+		}
+	}
+	pl.BucketCounts[bucket]++
+}
+
+// MeanMs returns the mean latency of the acknowledged packets in milliseconds, or zero if no
+// packet was acknowledged.
+func (pl PacketLatency) MeanMs() uint64 {
+	if pl.Count == 0 {
+		return 0
+	}
+	return pl.TotalMs / pl.Count
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestPacketLatencyRecord(t *testing.T) {
+	var latency types.PacketLatency
+
+	latency.Record(10 * time.Second)
+	latency.Record(30 * time.Second)
+	latency.Record(2 * time.Minute)
+	latency.Record(48 * time.Hour)
+	latency.Record(-time.Second)
+
+	require.Equal(t, uint64(5), latency.Count)
+	require.Equal(t, uint64((10*time.Second + 30*time.Second + 2*time.Minute + 48*time.Hour).Milliseconds()), latency.TotalMs)
+	require.Equal(t, uint64((48 * time.Hour).Milliseconds()), latency.MaxMs)
+	require.Equal(t, latency.TotalMs/5, latency.MeanMs())
+	require.Equal(t, []uint64{3, 0, 1, 0, 0, 0, 0, 1}, latency.BucketCounts)
+
+	require.Zero(t, types.PacketLatency{}.MeanMs())
+	require.Len(t, types.PacketLatencyBucketBoundsMs(), len(types.PacketLatencyBuckets))
+	require.Equal(t, uint64(30000), types.PacketLatencyBucketBoundsMs()[0])
+}
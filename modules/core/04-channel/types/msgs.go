@@ -493,3 +493,481 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgReclaimPacket{}
+
+// NewMsgReclaimPacket constructs a new MsgReclaimPacket
+// nolint:interfacer
+func NewMsgReclaimPacket(portID, channelID string, sequence uint64, signer string) *MsgReclaimPacket {
+	return &MsgReclaimPacket{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReclaimPacket) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.Sequence == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReclaimPacket) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgQuarantineChannel{}
+
+// NewMsgQuarantineChannel constructs a new MsgQuarantineChannel
+// nolint:interfacer
+func NewMsgQuarantineChannel(portID, channelID string, signer string) *MsgQuarantineChannel {
+	return &MsgQuarantineChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgQuarantineChannel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgQuarantineChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgReleaseChannel{}
+
+// NewMsgReleaseChannel constructs a new MsgReleaseChannel
+// nolint:interfacer
+func NewMsgReleaseChannel(portID, channelID string, signer string) *MsgReleaseChannel {
+	return &MsgReleaseChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReleaseChannel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReleaseChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeInit{}
+
+// NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
+// nolint:interfacer
+func NewMsgChannelUpgradeInit(portID, channelID string, upgradeFields UpgradeFields, signer string) *MsgChannelUpgradeInit {
+	return &MsgChannelUpgradeInit{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fields:    upgradeFields,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeInit) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.Fields.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeInit) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTry{}
+
+// NewMsgChannelUpgradeTry constructs a new MsgChannelUpgradeTry
+// nolint:interfacer
+func NewMsgChannelUpgradeTry(
+	portID, channelID string, counterpartyUpgradeFields UpgradeFields, counterpartyUpgradeSequence uint64,
+	proofChannel, proofUpgrade []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeTry {
+	return &MsgChannelUpgradeTry{
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		CounterpartyUpgradeFields:   counterpartyUpgradeFields,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofChannel:                proofChannel,
+		ProofUpgrade:                proofUpgrade,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeSequence, "counterparty upgrade sequence cannot be 0")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyUpgradeFields.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTry) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeAck{}
+
+// NewMsgChannelUpgradeAck constructs a new MsgChannelUpgradeAck
+// nolint:interfacer
+func NewMsgChannelUpgradeAck(
+	portID, channelID string, counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeAck {
+	return &MsgChannelUpgradeAck{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyUpgrade: counterpartyUpgrade,
+		ProofChannel:        proofChannel,
+		ProofUpgrade:        proofUpgrade,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	// the counterparty channel end sets the upgrade timeout once it starts flushing
+	if !msg.CounterpartyUpgrade.Timeout.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "counterparty upgrade timeout must be set")
+	}
+	return msg.CounterpartyUpgrade.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeAck) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeConfirm{}
+
+// NewMsgChannelUpgradeConfirm constructs a new MsgChannelUpgradeConfirm
+// nolint:interfacer
+func NewMsgChannelUpgradeConfirm(
+	portID, channelID string, counterpartyChannelState State, counterpartyUpgrade Upgrade,
+	proofChannel, proofUpgrade []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeConfirm {
+	return &MsgChannelUpgradeConfirm{
+		PortId:                   portID,
+		ChannelId:                channelID,
+		CounterpartyChannelState: counterpartyChannelState,
+		CounterpartyUpgrade:      counterpartyUpgrade,
+		ProofChannel:             proofChannel,
+		ProofUpgrade:             proofUpgrade,
+		ProofHeight:              proofHeight,
+		Signer:                   signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if !(msg.CounterpartyChannelState == FLUSHING || msg.CounterpartyChannelState == FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(ErrInvalidChannelState,
+			"counterparty channel state must be FLUSHING or FLUSHCOMPLETE in MsgChannelUpgradeConfirm, got: %s",
+			msg.CounterpartyChannelState,
+		)
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	// the counterparty channel end sets the upgrade timeout once it starts flushing
+	if !msg.CounterpartyUpgrade.Timeout.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "counterparty upgrade timeout must be set")
+	}
+	return msg.CounterpartyUpgrade.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeOpen{}
+
+// NewMsgChannelUpgradeOpen constructs a new MsgChannelUpgradeOpen
+// nolint:interfacer
+func NewMsgChannelUpgradeOpen(
+	portID, channelID string, counterpartyChannelState State, counterpartyUpgradeSequence uint64,
+	proofChannel []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeOpen {
+	return &MsgChannelUpgradeOpen{
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		CounterpartyChannelState:    counterpartyChannelState,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofChannel:                proofChannel,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if !(msg.CounterpartyChannelState == FLUSHCOMPLETE || msg.CounterpartyChannelState == OPEN) {
+		return sdkerrors.Wrapf(ErrInvalidChannelState,
+			"counterparty channel state must be FLUSHCOMPLETE or OPEN in MsgChannelUpgradeOpen, got: %s",
+			msg.CounterpartyChannelState,
+		)
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeSequence, "counterparty upgrade sequence cannot be 0")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTimeout{}
+
+// NewMsgChannelUpgradeTimeout constructs a new MsgChannelUpgradeTimeout
+// nolint:interfacer
+func NewMsgChannelUpgradeTimeout(
+	portID, channelID string, counterpartyChannel Channel,
+	proofChannel []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeTimeout {
+	return &MsgChannelUpgradeTimeout{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyChannel.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeCancel{}
+
+// NewMsgChannelUpgradeCancel constructs a new MsgChannelUpgradeCancel
+// nolint:interfacer
+func NewMsgChannelUpgradeCancel(
+	portID, channelID string, errorReceipt ErrorReceipt,
+	proofErrorReceipt []byte, proofHeight clienttypes.Height, signer string,
+) *MsgChannelUpgradeCancel {
+	return &MsgChannelUpgradeCancel{
+		PortId:            portID,
+		ChannelId:         channelID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: proofErrorReceipt,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofErrorReceipt) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.ErrorReceipt.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
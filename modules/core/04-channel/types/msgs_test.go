@@ -410,6 +410,34 @@ func (suite *TypesTestSuite) TestMsgTimeoutOnCloseValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgReclaimPacketValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReclaimPacket
+		expPass bool
+	}{
+		{"success", types.NewMsgReclaimPacket(portid, chanid, 1, addr), true},
+		{"port id contains non-alpha", types.NewMsgReclaimPacket(invalidPort, chanid, 1, addr), false},
+		{"channel id contains non-alpha", types.NewMsgReclaimPacket(portid, invalidChannel, 1, addr), false},
+		{"seq 0", types.NewMsgReclaimPacket(portid, chanid, 0, addr), false},
+		{"missing signer address", types.NewMsgReclaimPacket(portid, chanid, 1, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 	testCases := []struct {
 		name    string
@@ -438,3 +466,182 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
+	fields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeInit
+		expPass bool
+	}{
+		{"success", types.NewMsgChannelUpgradeInit(portid, chanid, fields, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeInit(invalidPort, chanid, fields, addr), false},
+		{"invalid channel identifier", types.NewMsgChannelUpgradeInit(portid, invalidChannel, fields, addr), false},
+		{"invalid upgrade fields", types.NewMsgChannelUpgradeInit(portid, chanid, types.NewUpgradeFields(types.NONE, connHops, version), addr), false},
+		{"missing signer address", types.NewMsgChannelUpgradeInit(portid, chanid, fields, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	fields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTry
+		expPass bool
+	}{
+		{"success", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 1, suite.proof, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeTry(invalidPort, chanid, fields, 1, suite.proof, suite.proof, height, addr), false},
+		{"invalid channel identifier", types.NewMsgChannelUpgradeTry(portid, invalidChannel, fields, 1, suite.proof, suite.proof, height, addr), false},
+		{"counterparty upgrade sequence 0", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 0, suite.proof, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 1, emptyProof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 1, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 1, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"invalid counterparty upgrade fields", types.NewMsgChannelUpgradeTry(portid, chanid, types.NewUpgradeFields(types.UNORDERED, connHops, ""), 1, suite.proof, suite.proof, height, addr), false},
+		{"missing signer address", types.NewMsgChannelUpgradeTry(portid, chanid, fields, 1, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeAckValidateBasic() {
+	fields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+	upgrade := types.NewUpgrade(fields, types.NewTimeout(disabledTimeout, timeoutTimestamp), 1)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeAck
+		expPass bool
+	}{
+		{"success", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeAck(invalidPort, chanid, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, emptyProof, height, addr), false},
+		{"counterparty upgrade timeout not set", types.NewMsgChannelUpgradeAck(portid, chanid, types.NewUpgrade(fields, types.Timeout{}, 1), suite.proof, suite.proof, height, addr), false},
+		{"missing signer address", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	errorReceipt := types.NewErrorReceipt(1, types.ErrInvalidUpgrade)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeCancel
+		expPass bool
+	}{
+		{"success", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, addr), true},
+		{"invalid channel identifier", types.NewMsgChannelUpgradeCancel(portid, invalidChannel, errorReceipt, suite.proof, height, addr), false},
+		{"invalid error receipt", types.NewMsgChannelUpgradeCancel(portid, chanid, types.ErrorReceipt{}, suite.proof, height, addr), false},
+		{"empty error receipt proof", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, emptyProof, height, addr), false},
+		{"missing signer address", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgQuarantineChannelValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgQuarantineChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgQuarantineChannel(portid, chanid, addr), true},
+		{"port id contains non-alpha", types.NewMsgQuarantineChannel(invalidPort, chanid, addr), false},
+		{"channel id contains non-alpha", types.NewMsgQuarantineChannel(portid, invalidChannel, addr), false},
+		{"missing signer address", types.NewMsgQuarantineChannel(portid, chanid, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgReleaseChannelValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReleaseChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgReleaseChannel(portid, chanid, addr), true},
+		{"port id contains non-alpha", types.NewMsgReleaseChannel(invalidPort, chanid, addr), false},
+		{"channel id contains non-alpha", types.NewMsgReleaseChannel(portid, invalidChannel, addr), false},
+		{"missing signer address", types.NewMsgReleaseChannel(portid, chanid, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
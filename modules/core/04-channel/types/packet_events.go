@@ -0,0 +1,73 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+const (
+	// WriteAcknowledgementEventRetention is the number of blocks during which the event data of a
+	// written acknowledgement is kept in state, leaving relayers ample time to relay the
+	// acknowledgement before it is pruned.
+	WriteAcknowledgementEventRetention uint64 = 100_000
+
+	// WriteAcknowledgementEventPruneLimit is the maximum number of expired write acknowledgement
+	// event data pruned at the beginning of a block.
+	WriteAcknowledgementEventPruneLimit = 500
+
+	// PacketHeightRetention is the number of blocks during which the height at which a packet
+	// was received, or at which its commitment was cleared, is kept in state to be reported by
+	// the packet already relayed events.
+	PacketHeightRetention uint64 = 100_000
+
+	// PacketHeightPruneLimit is the maximum number of expired packet receive and clear heights
+	// pruned at the beginning of a block.
+	PacketHeightPruneLimit = 500
+)
+
+// NewPacketEventData creates a new PacketEventData instance. The acknowledgement must be
+// empty for the event data of a sent packet.
+func NewPacketEventData(packet exported.PacketI, acknowledgement []byte, height clienttypes.Height) PacketEventData {
+	timeoutHeight := packet.GetTimeoutHeight()
+
+	return PacketEventData{
+		Packet: NewPacket(
+			packet.GetData(), packet.GetSequence(),
+			packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(),
+			clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), timeoutHeight.GetRevisionHeight()),
+			packet.GetTimeoutTimestamp(),
+		),
+		Acknowledgement: acknowledgement,
+		Height:          height,
+	}
+}
+
+// NewSendPacketEvent creates a new SendPacketEvent instance.
+func NewSendPacketEvent(
+	packet Packet, commitment []byte, ordering Order, connectionID string,
+	priority PacketPriority, proofHeight clienttypes.Height,
+) SendPacketEvent {
+	return SendPacketEvent{
+		Packet:          packet,
+		Commitment:      commitment,
+		ChannelOrdering: ordering,
+		ConnectionId:    connectionID,
+		Priority:        priority,
+		ProofHeight:     proofHeight,
+	}
+}
+
+// NewWriteAcknowledgementEvent creates a new WriteAcknowledgementEvent instance.
+func NewWriteAcknowledgementEvent(
+	packet Packet, acknowledgement, acknowledgementCommitment []byte, connectionID string,
+	proofHeight clienttypes.Height,
+) WriteAcknowledgementEvent {
+	return WriteAcknowledgementEvent{
+		Packet:                    packet,
+		Acknowledgement:           acknowledgement,
+		AcknowledgementCommitment: acknowledgementCommitment,
+		ConnectionId:              connectionID,
+		ProofHeight:               proofHeight,
+	}
+}
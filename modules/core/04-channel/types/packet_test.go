@@ -51,3 +51,25 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestAggregatedPacketData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    types.AggregatedPacketData
+		expPass bool
+	}{
+		{"valid aggregated packet data", types.NewAggregatedPacketData([][]byte{[]byte("a"), []byte("b")}), true},
+		{"no payloads", types.NewAggregatedPacketData(nil), false},
+		{"empty payload", types.NewAggregatedPacketData([][]byte{[]byte("a"), {}}), false},
+	}
+
+	for _, tc := range testCases {
+		data, err := types.UnmarshalAggregatedPacketData(tc.data.GetBytes())
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.data, data, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
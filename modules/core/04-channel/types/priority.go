@@ -0,0 +1,47 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packetPriorityKey is the context key under which the priority class of the sent packets is stored
+type packetPriorityKey struct{}
+
+// Validate returns an error if the priority class is not defined.
+func (p PacketPriority) Validate() error {
+	if _, ok := PacketPriority_name[int32(p)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "invalid packet priority %d", p)
+	}
+
+	return nil
+}
+
+// NewPrioritizedPacket creates a new PrioritizedPacket instance.
+func NewPrioritizedPacket(portID, channelID string, sequence uint64, priority PacketPriority) PrioritizedPacket {
+	return PrioritizedPacket{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Priority:  priority,
+	}
+}
+
+// WithPacketPriority returns a context in which the packets sent with SendPacket are attached the
+// given priority class. As the context is passed along by the middleware of an application, the
+// priority class reaches the core channel keeper whichever middleware the packet is sent through.
+func WithPacketPriority(ctx sdk.Context, priority PacketPriority) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), packetPriorityKey{}, priority))
+}
+
+// GetPacketPriority returns the priority class set in the context, or PRIORITY_DEFAULT if none
+// is set.
+func GetPacketPriority(ctx sdk.Context) PacketPriority {
+	if priority, ok := ctx.Context().Value(packetPriorityKey{}).(PacketPriority); ok {
+		return priority
+	}
+
+	return PRIORITY_DEFAULT
+}
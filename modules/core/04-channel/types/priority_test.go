@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestPacketPriorityValidate(t *testing.T) {
+	require.NoError(t, types.PRIORITY_DEFAULT.Validate())
+	require.NoError(t, types.PRIORITY_HIGH.Validate())
+	require.NoError(t, types.PRIORITY_CRITICAL.Validate())
+	require.Error(t, types.PacketPriority(3).Validate())
+	require.Error(t, types.PacketPriority(-1).Validate())
+}
+
+func TestContextPacketPriority(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.Equal(t, types.PRIORITY_DEFAULT, types.GetPacketPriority(ctx))
+
+	ctx = types.WithPacketPriority(ctx, types.PRIORITY_CRITICAL)
+	require.Equal(t, types.PRIORITY_CRITICAL, types.GetPacketPriority(ctx))
+}
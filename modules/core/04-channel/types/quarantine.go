@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewQuarantinedChannel creates a new QuarantinedChannel instance.
+func NewQuarantinedChannel(portID, channelID, signer string, height clienttypes.Height) QuarantinedChannel {
+	return QuarantinedChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+		Height:    height,
+	}
+}
+
+// Validate performs basic validation of the quarantined channel returning an error upon any
+// failure.
+func (qc QuarantinedChannel) Validate() error {
+	if err := host.PortIdentifierValidator(qc.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(qc.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if _, err := sdk.AccAddressFromBech32(qc.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPacketDataSchema creates a new PacketDataSchema instance.
+func NewPacketDataSchema(portPrefix, version, schema string) PacketDataSchema {
+	return PacketDataSchema{
+		PortPrefix: portPrefix,
+		Version:    version,
+		Schema:     schema,
+	}
+}
+
+// ValidateBasic performs a basic validation of the packet data schema fields.
+func (s PacketDataSchema) ValidateBasic() error {
+	if strings.TrimSpace(s.PortPrefix) == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketDataSchema, "port prefix cannot be blank")
+	}
+
+	if strings.TrimSpace(s.Schema) == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketDataSchema, "schema cannot be blank")
+	}
+
+	return nil
+}
+
+// Matches returns true if the port identifier starts with the port prefix of the schema and
+// the schema version is empty or equal to the channel version.
+func (s PacketDataSchema) Matches(portID, version string) bool {
+	return strings.HasPrefix(portID, s.PortPrefix) && (s.Version == "" || s.Version == version)
+}
+
+// MatchPacketDataSchema returns the schema matching the provided port identifier and channel
+// version. If several schemas match, the schema with the longest port prefix is returned and
+// schemas with a version take precedence over schemas matching any version.
+func MatchPacketDataSchema(schemas []PacketDataSchema, portID, version string) (PacketDataSchema, bool) {
+	var (
+		match PacketDataSchema
+		found bool
+	)
+
+	for _, schema := range schemas {
+		if !schema.Matches(portID, version) {
+			continue
+		}
+
+		if !found || len(schema.PortPrefix) > len(match.PortPrefix) ||
+			(len(schema.PortPrefix) == len(match.PortPrefix) && match.Version == "") {
+			match, found = schema, true
+		}
+	}
+
+	return match, found
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestPacketDataSchemaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		schema  types.PacketDataSchema
+		expPass bool
+	}{
+		{"valid schema", types.NewPacketDataSchema("transfer", "ics20-1", "/ibc.applications.transfer.v2.FungibleTokenPacketData"), true},
+		{"valid schema matching any version", types.NewPacketDataSchema("icacontroller-", "", "/ibc.applications.interchain_accounts.v1.InterchainAccountPacketData"), true},
+		{"blank port prefix", types.NewPacketDataSchema(" ", "ics20-1", "/ibc.applications.transfer.v2.FungibleTokenPacketData"), false},
+		{"blank schema", types.NewPacketDataSchema("transfer", "ics20-1", ""), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.schema.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMatchPacketDataSchema(t *testing.T) {
+	transfer := types.NewPacketDataSchema("transfer", "ics20-1", "transfer")
+	anyVersion := types.NewPacketDataSchema("ica", "", "any version")
+	controller := types.NewPacketDataSchema("icacontroller-", "", "controller")
+	controllerVersion := types.NewPacketDataSchema("icacontroller-", "ics27-1", "controller version")
+	schemas := []types.PacketDataSchema{transfer, anyVersion, controller, controllerVersion}
+
+	testCases := []struct {
+		name      string
+		portID    string
+		version   string
+		expSchema types.PacketDataSchema
+		expFound  bool
+	}{
+		{"exact port and version", "transfer", "ics20-1", transfer, true},
+		{"version mismatch", "transfer", "ics20-2", types.PacketDataSchema{}, false},
+		{"unknown port", "mock", "ics20-1", types.PacketDataSchema{}, false},
+		{"any version", "icahost", "metadata", anyVersion, true},
+		{"longest port prefix", "icacontroller-owner", "metadata", controller, true},
+		{"version takes precedence over any version", "icacontroller-owner", "ics27-1", controllerVersion, true},
+	}
+
+	for _, tc := range testCases {
+		schema, found := types.MatchPacketDataSchema(schemas, tc.portID, tc.version)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expSchema, schema, tc.name)
+	}
+}
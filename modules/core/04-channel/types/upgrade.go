@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// DefaultUpgradeTimeoutPeriod is the period, relative to the block time at which a channel end
+// starts flushing its in-flight packets, after which the counterparty channel end aborts the
+// upgrade if it has not been completed.
+const DefaultUpgradeTimeoutPeriod = 10 * time.Minute
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(fields UpgradeFields, timeout Timeout, nextSequenceSend uint64) Upgrade {
+	return Upgrade{
+		Fields:           fields,
+		Timeout:          timeout,
+		NextSequenceSend: nextSequenceSend,
+	}
+}
+
+// ValidateBasic performs a basic validation of the proposed upgrade fields. The timeout and the
+// next sequence send are only set once the channel end starts flushing and are not validated.
+func (u Upgrade) ValidateBasic() error {
+	return u.Fields.ValidateBasic()
+}
+
+// NewUpgradeFields creates a new UpgradeFields instance.
+func NewUpgradeFields(ordering Order, connectionHops []string, version string) UpgradeFields {
+	return UpgradeFields{
+		Ordering:       ordering,
+		ConnectionHops: connectionHops,
+		Version:        version,
+	}
+}
+
+// ValidateBasic performs a basic validation of the upgrade fields.
+func (uf UpgradeFields) ValidateBasic() error {
+	if !(uf.Ordering == ORDERED || uf.Ordering == UNORDERED) {
+		return sdkerrors.Wrap(ErrInvalidChannelOrdering, uf.Ordering.String())
+	}
+	if len(uf.ConnectionHops) != 1 {
+		return sdkerrors.Wrap(
+			ErrTooManyConnectionHops,
+			"current IBC version only supports one connection hop",
+		)
+	}
+	if err := host.ConnectionIdentifierValidator(uf.ConnectionHops[0]); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	}
+	if strings.TrimSpace(uf.Version) == "" {
+		return sdkerrors.Wrap(ErrInvalidChannelVersion, "version cannot be empty")
+	}
+	return nil
+}
+
+// IsUpgradeOf returns true if the upgrade fields differ from the fields of the provided channel.
+func (uf UpgradeFields) IsUpgradeOf(channel Channel) bool {
+	return uf.Ordering != channel.Ordering ||
+		uf.Version != channel.Version ||
+		strings.Join(uf.ConnectionHops, "/") != strings.Join(channel.ConnectionHops, "/")
+}
+
+// NewTimeout creates a new Timeout instance.
+func NewTimeout(height clienttypes.Height, timestamp uint64) Timeout {
+	return Timeout{
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
+
+// IsValid returns true if either the height or the timestamp of the timeout is set.
+func (t Timeout) IsValid() bool {
+	return !t.Height.IsZero() || t.Timestamp != 0
+}
+
+// Elapsed returns true if the provided height or timestamp is at or past the timeout. Unset
+// timeout fields never elapse.
+func (t Timeout) Elapsed(height exported.Height, timestamp uint64) bool {
+	if !t.Height.IsZero() && height.GTE(t.Height) {
+		return true
+	}
+	return t.Timestamp != 0 && timestamp >= t.Timestamp
+}
+
+// NewErrorReceipt creates a new ErrorReceipt for the upgrade of the provided sequence which was
+// aborted with the provided error. Only the ABCI codespace and code of the error are recorded
+// since error messages are not guaranteed to be deterministic.
+func NewErrorReceipt(sequence uint64, err error) ErrorReceipt {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return ErrorReceipt{
+		Sequence: sequence,
+		Message:  fmt.Sprintf("codespace: %s, code: %d", codespace, code),
+	}
+}
+
+// ValidateBasic performs a basic validation of the error receipt.
+func (er ErrorReceipt) ValidateBasic() error {
+	if er.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeErrorReceipt, "sequence cannot be 0")
+	}
+	if strings.TrimSpace(er.Message) == "" {
+		return sdkerrors.Wrap(ErrInvalidUpgradeErrorReceipt, "message cannot be empty")
+	}
+	return nil
+}
+
+// UpgradeError is returned by the upgrade handshake steps which abort the upgrade. The upgrade
+// is aborted by writing an error receipt for the upgrade sequence of the error, which allows the
+// counterparty channel end to cancel its side of the upgrade.
+type UpgradeError struct {
+	sequence uint64
+	err      error
+}
+
+// NewUpgradeError creates a new UpgradeError aborting the upgrade of the provided sequence.
+func NewUpgradeError(sequence uint64, err error) *UpgradeError {
+	return &UpgradeError{
+		sequence: sequence,
+		err:      err,
+	}
+}
+
+// Error implements the error interface.
+func (e *UpgradeError) Error() string {
+	return fmt.Sprintf("upgrade %d aborted: %s", e.sequence, e.err)
+}
+
+// Unwrap returns the error which aborted the upgrade.
+func (e *UpgradeError) Unwrap() error {
+	return e.err
+}
+
+// Cause returns the error which aborted the upgrade. It allows the ABCI code of the error to be
+// determined by the SDK error handling.
+func (e *UpgradeError) Cause() error {
+	return e.err
+}
+
+// GetErrorReceipt returns the error receipt recording the aborted upgrade.
+func (e *UpgradeError) GetErrorReceipt() ErrorReceipt {
+	return NewErrorReceipt(e.sequence, e.err)
+}
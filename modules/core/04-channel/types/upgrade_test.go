@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestUpgradeFieldsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fields  types.UpgradeFields
+		expPass bool
+	}{
+		{"valid upgrade fields", types.NewUpgradeFields(types.UNORDERED, connHops, version), true},
+		{"invalid ordering", types.NewUpgradeFields(types.NONE, connHops, version), false},
+		{"too many connection hops", types.NewUpgradeFields(types.UNORDERED, invalidConnHops, version), false},
+		{"invalid connection hop", types.NewUpgradeFields(types.UNORDERED, invalidShortConnHops, version), false},
+		{"empty version", types.NewUpgradeFields(types.UNORDERED, connHops, "  "), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.fields.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpgradeFieldsIsUpgradeOf(t *testing.T) {
+	counterparty := types.NewCounterparty(cpportid, cpchanid)
+	channel := types.NewChannel(types.OPEN, types.ORDERED, counterparty, connHops, version)
+
+	require.False(t, types.NewUpgradeFields(types.ORDERED, connHops, version).IsUpgradeOf(channel))
+	require.True(t, types.NewUpgradeFields(types.UNORDERED, connHops, version).IsUpgradeOf(channel))
+	require.True(t, types.NewUpgradeFields(types.ORDERED, []string{"connection-1"}, version).IsUpgradeOf(channel))
+	require.True(t, types.NewUpgradeFields(types.ORDERED, connHops, "2.0").IsUpgradeOf(channel))
+}
+
+func TestTimeoutElapsed(t *testing.T) {
+	testCases := []struct {
+		name       string
+		timeout    types.Timeout
+		height     clienttypes.Height
+		timestamp  uint64
+		expElapsed bool
+	}{
+		{"height not reached", types.NewTimeout(timeoutHeight, 0), height, timeoutTimestamp, false},
+		{"height reached", types.NewTimeout(timeoutHeight, 0), timeoutHeight, 0, true},
+		{"timestamp not reached", types.NewTimeout(disabledTimeout, timeoutTimestamp), timeoutHeight, timeoutTimestamp - 1, false},
+		{"timestamp reached", types.NewTimeout(disabledTimeout, timeoutTimestamp), height, timeoutTimestamp, true},
+		{"unset timeout never elapses", types.NewTimeout(disabledTimeout, 0), timeoutHeight, timeoutTimestamp, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expElapsed, tc.timeout.Elapsed(tc.height, tc.timestamp), tc.name)
+	}
+
+	require.False(t, types.NewTimeout(disabledTimeout, 0).IsValid())
+	require.True(t, types.NewTimeout(timeoutHeight, 0).IsValid())
+}
+
+func TestUpgradeErrorReceipt(t *testing.T) {
+	upgradeErr := types.NewUpgradeError(2, types.ErrUpgradeTimeout)
+	require.ErrorIs(t, upgradeErr, types.ErrUpgradeTimeout)
+
+	errorReceipt := upgradeErr.GetErrorReceipt()
+	require.Equal(t, uint64(2), errorReceipt.Sequence)
+	require.NoError(t, errorReceipt.ValidateBasic())
+
+	// the receipt does not depend on the error message
+	wrappedErr := types.NewUpgradeError(2, sdkerrors.Wrap(types.ErrUpgradeTimeout, "counterparty upgrade timeout elapsed"))
+	require.Equal(t, errorReceipt, wrappedErr.GetErrorReceipt())
+
+	require.Error(t, types.ErrorReceipt{Sequence: 0, Message: errorReceipt.Message}.ValidateBasic())
+	require.Error(t, types.ErrorReceipt{Sequence: 1, Message: ""}.ValidateBasic())
+}
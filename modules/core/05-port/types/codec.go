@@ -0,0 +1,180 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PacketData defines the fields of the packet data of an application which middleware act
+// upon, independently of how the application encodes its packet data. The denomination and
+// amount are only set for the packet data of applications transferring fungible tokens.
+type PacketData struct {
+	Sender   string
+	Receiver string
+	Memo     string
+	Denom    string
+	Amount   string
+}
+
+// PacketDataCodec decodes the packet data of an application into the fields used by middleware.
+type PacketDataCodec interface {
+	DecodePacketData(bz []byte) (PacketData, error)
+}
+
+// PacketDataCodecFunc is a function implementing the PacketDataCodec interface. It may be used
+// to register encodings which are not provided by this package, e.g. borsh.
+type PacketDataCodecFunc func(bz []byte) (PacketData, error)
+
+// DecodePacketData implements the PacketDataCodec interface.
+func (f PacketDataCodecFunc) DecodePacketData(bz []byte) (PacketData, error) {
+	return f(bz)
+}
+
+// JSONPacketDataCodec decodes packet data encoded in JSON with the sender, receiver and memo
+// under the "sender", "receiver" and "memo" keys, as done by the ICS-20 and ICS-721 applications.
+// The denomination and amount of fungible tokens are decoded from the "denom" and "amount" keys
+// when they hold strings, as done by the ICS-20 application.
+type JSONPacketDataCodec struct{}
+
+// DecodePacketData implements the PacketDataCodec interface.
+func (JSONPacketDataCodec) DecodePacketData(bz []byte) (PacketData, error) {
+	var data struct {
+		Sender   string          `json:"sender"`
+		Receiver string          `json:"receiver"`
+		Memo     string          `json:"memo"`
+		Denom    json.RawMessage `json:"denom"`
+		Amount   json.RawMessage `json:"amount"`
+	}
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return PacketData{}, sdkerrors.Wrapf(ErrInvalidPacketData, "cannot unmarshal JSON packet data: %s", err)
+	}
+
+	return PacketData{
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+		Denom:    jsonString(data.Denom),
+		Amount:   jsonString(data.Amount),
+	}, nil
+}
+
+// jsonString returns the string held by the given JSON value, or an empty string if the value
+// is not a string.
+func jsonString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// ProtoPacketData defines the protobuf message of the packet data of an application. The getters
+// are generated for messages with sender, receiver and memo string fields.
+type ProtoPacketData interface {
+	codec.ProtoMarshaler
+
+	GetSender() string
+	GetReceiver() string
+	GetMemo() string
+}
+
+// ProtoTokenPacketData defines the protobuf message of the packet data of an application
+// transferring fungible tokens. The getters are generated for messages with denom and amount
+// string fields.
+type ProtoTokenPacketData interface {
+	ProtoPacketData
+
+	GetDenom() string
+	GetAmount() string
+}
+
+// ProtoPacketDataCodec decodes packet data encoded as a binary protobuf message. The denomination
+// and amount are decoded from messages implementing ProtoTokenPacketData.
+type ProtoPacketDataCodec struct {
+	newPacketData func() ProtoPacketData
+}
+
+// NewProtoPacketDataCodec creates a new ProtoPacketDataCodec given a function returning an empty
+// message of the packet data of the application.
+func NewProtoPacketDataCodec(newPacketData func() ProtoPacketData) ProtoPacketDataCodec {
+	if newPacketData == nil {
+		panic("packet data constructor cannot be nil")
+	}
+
+	return ProtoPacketDataCodec{
+		newPacketData: newPacketData,
+	}
+}
+
+// DecodePacketData implements the PacketDataCodec interface.
+func (c ProtoPacketDataCodec) DecodePacketData(bz []byte) (PacketData, error) {
+	data := c.newPacketData()
+	if err := data.Unmarshal(bz); err != nil {
+		return PacketData{}, sdkerrors.Wrapf(ErrInvalidPacketData, "cannot unmarshal protobuf packet data: %s", err)
+	}
+
+	packetData := PacketData{
+		Sender:   data.GetSender(),
+		Receiver: data.GetReceiver(),
+		Memo:     data.GetMemo(),
+	}
+	if tokenData, ok := data.(ProtoTokenPacketData); ok {
+		packetData.Denom = tokenData.GetDenom()
+		packetData.Amount = tokenData.GetAmount()
+	}
+
+	return packetData, nil
+}
+
+// PacketDataCodecs maps channel versions to the codecs decoding the packet data sent over the
+// channels of that version. It allows a middleware to sit above applications encoding their
+// packet data differently, including the applications of non-Cosmos counterparties. The packet
+// data of channels whose version has no registered codec is decoded with the JSONPacketDataCodec.
+type PacketDataCodecs struct {
+	codecs map[string]PacketDataCodec
+}
+
+// NewPacketDataCodecs creates a new PacketDataCodecs instance without any registered codec.
+func NewPacketDataCodecs() *PacketDataCodecs {
+	return &PacketDataCodecs{
+		codecs: make(map[string]PacketDataCodec),
+	}
+}
+
+// Register registers the codec decoding the packet data of the channels of the given version.
+// It panics if the version is empty or a codec is already registered for it.
+func (c *PacketDataCodecs) Register(version string, codec PacketDataCodec) *PacketDataCodecs {
+	if version == "" {
+		panic("packet data codec version cannot be empty")
+	}
+
+	if codec == nil {
+		panic(fmt.Sprintf("packet data codec for version %s cannot be nil", version))
+	}
+
+	if _, found := c.codecs[version]; found {
+		panic(fmt.Sprintf("packet data codec already registered for version %s", version))
+	}
+
+	c.codecs[version] = codec
+	return c
+}
+
+// GetCodec returns the codec registered for the given channel version, or the
+// JSONPacketDataCodec if none is registered.
+func (c *PacketDataCodecs) GetCodec(version string) PacketDataCodec {
+	if codec, found := c.codecs[version]; found {
+		return codec
+	}
+
+	return JSONPacketDataCodec{}
+}
+
+// DecodePacketData decodes the packet data sent over a channel of the given version.
+func (c *PacketDataCodecs) DecodePacketData(version string, bz []byte) (PacketData, error) {
+	return c.GetCodec(version).DecodePacketData(bz)
+}
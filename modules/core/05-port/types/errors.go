@@ -6,8 +6,10 @@ import (
 
 // IBC port sentinel errors
 var (
-	ErrPortExists   = sdkerrors.Register(SubModuleName, 2, "port is already binded")
-	ErrPortNotFound = sdkerrors.Register(SubModuleName, 3, "port not found")
-	ErrInvalidPort  = sdkerrors.Register(SubModuleName, 4, "invalid port")
-	ErrInvalidRoute = sdkerrors.Register(SubModuleName, 5, "route not found")
+	ErrPortExists             = sdkerrors.Register(SubModuleName, 2, "port is already binded")
+	ErrPortNotFound           = sdkerrors.Register(SubModuleName, 3, "port not found")
+	ErrInvalidPort            = sdkerrors.Register(SubModuleName, 4, "invalid port")
+	ErrInvalidRoute           = sdkerrors.Register(SubModuleName, 5, "route not found")
+	ErrInvalidPacketData      = sdkerrors.Register(SubModuleName, 6, "invalid packet data")
+	ErrInvalidMiddlewareStack = sdkerrors.Register(SubModuleName, 7, "invalid middleware stack")
 )
@@ -97,6 +97,122 @@ type IBCModule interface {
 		packet channeltypes.Packet,
 		relayer sdk.AccAddress,
 	) error
+
+	// OnClientFrozen is called when the client underlying the channel is frozen due to
+	// misbehaviour. Packets can no longer be sent or received on the channel until the
+	// client is recovered, applications may use the callback to pause their send paths.
+	// The callback cannot abort the freezing of the client.
+	OnClientFrozen(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+}
+
+// DeadLetterModule defines an optional interface for IBC applications which opt in to the
+// dead-letter store. If the OnTimeoutPacket callback of such an application fails, the packet
+// is recorded in the dead-letter store, together with the reason of the failure, instead of
+// failing the timeout. The application may later reclaim the packet, for example to refund the
+// sender, through the OnReclaimPacket callback which is called on a MsgReclaimPacket.
+// Middleware implement the interface to forward the callbacks and must only report that they
+// opt in if their underlying application does, see GetDeadLetterModule.
+type DeadLetterModule interface {
+	IBCModule
+
+	// IsDeadLetterModule returns true if the module opts in to the dead-letter store.
+	IsDeadLetterModule() bool
+
+	// OnReclaimPacket is called when the signer reclaims a packet recorded in the dead-letter
+	// store. The packet is removed from the dead-letter store only if the callback succeeds.
+	OnReclaimPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		reason string,
+		signer sdk.AccAddress,
+	) error
+}
+
+// GetDeadLetterModule returns the provided module as a DeadLetterModule if it opts in to the
+// dead-letter store.
+func GetDeadLetterModule(module IBCModule) (DeadLetterModule, bool) {
+	deadLetterModule, ok := module.(DeadLetterModule)
+	if !ok || !deadLetterModule.IsDeadLetterModule() {
+		return nil, false
+	}
+
+	return deadLetterModule, true
+}
+
+// CompensatingModule defines an optional interface for IBC applications which send packets with
+// the SendPacketWithCompensation helper of the channel keeper. When such a packet is acknowledged
+// with an error acknowledgement or times out, the OnCompensatePacket callback is called, after the
+// OnAcknowledgementPacket or OnTimeoutPacket callback, with the compensation data returned by the
+// local state change executed alongside the packet. The compensation data is discarded once the
+// packet is acknowledged successfully.
+type CompensatingModule interface {
+	IBCModule
+
+	// OnCompensatePacket must revert the local state change executed when the packet was sent,
+	// for example by refunding or unlocking the funds escrowed for the packet. An error fails
+	// the acknowledgement or timeout of the packet.
+	OnCompensatePacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		compensation []byte,
+		relayer sdk.AccAddress,
+	) error
+}
+
+// UpgradableModule defines an optional interface for IBC applications whose channels may be
+// upgraded with the channel upgrade handshake. The upgrade of a channel of an application which
+// does not implement the interface is rejected. The in-flight packets of the channel are
+// flushed with the current channel parameters before the upgrade is opened.
+type UpgradableModule interface {
+	IBCModule
+
+	// OnChanUpgradeInit will verify the proposed upgrade parameters and may return an error to
+	// reject the upgrade. It must return the version proposed to the counterparty.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) (string, error)
+
+	// OnChanUpgradeTry will verify the upgrade parameters along with the counterparty-proposed
+	// version. If the counterparty-proposed version is not compatible with the versions supported
+	// by the module, the callback must return an error which aborts the upgrade. Otherwise the
+	// callback must return the version of the upgraded channel.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (string, error)
+
+	// OnChanUpgradeAck will error if the counterparty-selected version is not compatible with
+	// the versions supported by the module, which aborts the upgrade.
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID,
+		counterpartyVersion string,
+	) error
+
+	// OnChanUpgradeOpen is called once the channel is opened with the upgraded parameters. The
+	// callback cannot abort the upgrade.
+	OnChanUpgradeOpen(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	)
 }
 
 // ICS4Wrapper implements the ICS4 interfaces that IBC applications use to send packets and acknolwedgements.
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// PacketSender defines the part of the ICS4Wrapper used by IBC applications to send packets.
+type PacketSender interface {
+	SendPacket(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		packet exported.PacketI,
+	) error
+}
+
+// StackLayer defines an optional interface for the IBC modules and ICS4Wrappers of a middleware
+// stack which name themselves in the resolved middleware stack of a channel. The layers which
+// do not implement the interface are named after their type.
+type StackLayer interface {
+	// StackLayerName returns the name of the layer, usually the name of its module.
+	StackLayerName() string
+}
+
+// ApplicationWrapper defines an optional interface for middleware which exposes the underlying
+// application it passes the callbacks of core IBC to.
+type ApplicationWrapper interface {
+	UnderlyingApplication() IBCModule
+}
+
+// PacketSenderWrapper defines an optional interface for middleware which exposes the underlying
+// ICS4Wrapper it passes the packets sent by the application to.
+type PacketSenderWrapper interface {
+	UnderlyingICS4Wrapper() PacketSender
+}
+
+// PacketSendingModule defines an optional interface for IBC applications which exposes the
+// ICS4Wrapper they send packets with.
+type PacketSendingModule interface {
+	GetICS4Wrapper() PacketSender
+}
+
+// GetStackLayerName returns the name of a layer of a middleware stack.
+func GetStackLayerName(layer interface{}) string {
+	if stackLayer, ok := layer.(StackLayer); ok {
+		return stackLayer.StackLayerName()
+	}
+
+	return fmt.Sprintf("%T", layer)
+}
+
+// ResolveReceiveStack returns the IBC modules processing the callbacks of core IBC, in order
+// from the given route of a port to the base application. The stack is unwrapped until a layer
+// does not implement the ApplicationWrapper interface.
+func ResolveReceiveStack(route IBCModule) []IBCModule {
+	stack := []IBCModule{route}
+	for {
+		wrapper, ok := stack[len(stack)-1].(ApplicationWrapper)
+		if !ok {
+			return stack
+		}
+
+		stack = append(stack, wrapper.UnderlyingApplication())
+	}
+}
+
+// ResolveSendStack returns the ICS4Wrappers processing the packets sent by the application of
+// the given route of a port, in order from the application to core IBC. The first ICS4Wrapper is
+// the one of the deepest IBC module implementing the PacketSendingModule interface, and the
+// stack is unwrapped until an ICS4Wrapper does not implement the PacketSenderWrapper interface.
+// It returns nil if no IBC module of the stack sends packets.
+func ResolveSendStack(route IBCModule) []PacketSender {
+	receiveStack := ResolveReceiveStack(route)
+
+	var stack []PacketSender
+	for i := len(receiveStack) - 1; i >= 0; i-- {
+		if sendingModule, ok := receiveStack[i].(PacketSendingModule); ok {
+			stack = append(stack, sendingModule.GetICS4Wrapper())
+			break
+		}
+	}
+
+	if len(stack) == 0 {
+		return nil
+	}
+
+	for {
+		wrapper, ok := stack[len(stack)-1].(PacketSenderWrapper)
+		if !ok {
+			return stack
+		}
+
+		stack = append(stack, wrapper.UnderlyingICS4Wrapper())
+	}
+}
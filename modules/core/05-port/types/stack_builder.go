@@ -0,0 +1,164 @@
+package types
+
+import (
+	"reflect"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MiddlewareConstructor creates a middleware wrapping the given underlying application.
+type MiddlewareConstructor func(app IBCModule) IBCModule
+
+// MiddlewareStack defines both directions of a middleware stack built by a
+// MiddlewareStackBuilder.
+type MiddlewareStack struct {
+	// IBCModule is the top of the stack, processing the callbacks of core IBC first. It must
+	// be added to the IBC router as the route of the port of the base application.
+	IBCModule IBCModule
+	// ICS4Wrapper is the bottom of the stack, processing the packets sent by the base
+	// application first. It must be the ICS4Wrapper the base application was created with.
+	ICS4Wrapper PacketSender
+}
+
+type middlewareLayer struct {
+	constructor MiddlewareConstructor
+	ics4Wrapper PacketSender
+}
+
+// MiddlewareStackBuilder composes the IBC modules and ICS4Wrappers of a middleware stack on top
+// of a base application, from the innermost middleware to the outermost one, and validates that
+// both directions of the stack go through the middleware in mirrored orders: the packets sent
+// by the base application go through the ICS4Wrappers of the middleware from the innermost to
+// the outermost one and then to core IBC.
+type MiddlewareStackBuilder struct {
+	ics4Wrapper PacketSender
+	app         IBCModule
+	layers      []middlewareLayer
+}
+
+// NewMiddlewareStackBuilder creates a new MiddlewareStackBuilder given the ICS4Wrapper of core
+// IBC, usually the channel keeper, and the base application of the stack.
+func NewMiddlewareStackBuilder(ics4Wrapper PacketSender, app IBCModule) *MiddlewareStackBuilder {
+	return &MiddlewareStackBuilder{
+		ics4Wrapper: ics4Wrapper,
+		app:         app,
+	}
+}
+
+// Wrap adds a middleware on top of the stack, created by the given constructor with the current
+// top of the stack as its underlying application. The ICS4Wrapper is the one of the middleware,
+// nil if the middleware does not wrap the ICS4Wrapper of the application below it.
+func (b *MiddlewareStackBuilder) Wrap(constructor MiddlewareConstructor, ics4Wrapper PacketSender) *MiddlewareStackBuilder {
+	b.layers = append(b.layers, middlewareLayer{
+		constructor: constructor,
+		ics4Wrapper: ics4Wrapper,
+	})
+	return b
+}
+
+// Build creates the middleware stack. It returns an error if the stack is misordered:
+//   - the base application must send packets with the ICS4Wrapper of the innermost middleware
+//     wrapping the ICS4Wrapper, or with the one of core IBC if there is none,
+//   - the ICS4Wrapper of each middleware must pass the packets to the ICS4Wrapper of the next
+//     middleware, and the one of the outermost middleware to core IBC,
+//   - each middleware must wrap the layer below it.
+//
+// The checks only apply to the layers exposing their underlying layers with the
+// PacketSendingModule, PacketSenderWrapper and ApplicationWrapper interfaces. The layers are
+// compared by identity, the base application, the middleware and the ICS4Wrappers must be
+// pointers.
+func (b *MiddlewareStackBuilder) Build() (MiddlewareStack, error) {
+	if b.ics4Wrapper == nil {
+		return MiddlewareStack{}, sdkerrors.Wrap(ErrInvalidMiddlewareStack, "core IBC ICS4Wrapper cannot be nil")
+	}
+
+	if b.app == nil {
+		return MiddlewareStack{}, sdkerrors.Wrap(ErrInvalidMiddlewareStack, "base application cannot be nil")
+	}
+
+	if err := validateLayer(b.app); err != nil {
+		return MiddlewareStack{}, err
+	}
+
+	// the packets sent by the base application go through the ICS4Wrappers from the innermost
+	// middleware to the outermost one and then to core IBC
+	var sendStack []PacketSender
+	for _, layer := range b.layers {
+		if layer.ics4Wrapper != nil {
+			sendStack = append(sendStack, layer.ics4Wrapper)
+		}
+	}
+	sendStack = append(sendStack, b.ics4Wrapper)
+
+	for _, ics4Wrapper := range sendStack {
+		if err := validateLayer(ics4Wrapper); err != nil {
+			return MiddlewareStack{}, err
+		}
+	}
+
+	if sendingModule, ok := b.app.(PacketSendingModule); ok {
+		if ics4Wrapper := sendingModule.GetICS4Wrapper(); ics4Wrapper != sendStack[0] {
+			return MiddlewareStack{}, sdkerrors.Wrapf(
+				ErrInvalidMiddlewareStack, "base application %s sends packets with %s, expected %s",
+				GetStackLayerName(b.app), GetStackLayerName(ics4Wrapper), GetStackLayerName(sendStack[0]),
+			)
+		}
+	}
+
+	for i, ics4Wrapper := range sendStack[:len(sendStack)-1] {
+		wrapper, ok := ics4Wrapper.(PacketSenderWrapper)
+		if !ok {
+			continue
+		}
+
+		if underlying := wrapper.UnderlyingICS4Wrapper(); underlying != sendStack[i+1] {
+			return MiddlewareStack{}, sdkerrors.Wrapf(
+				ErrInvalidMiddlewareStack, "ICS4Wrapper %s passes packets to %s, expected %s",
+				GetStackLayerName(ics4Wrapper), GetStackLayerName(underlying), GetStackLayerName(sendStack[i+1]),
+			)
+		}
+	}
+
+	top := b.app
+	for i, layer := range b.layers {
+		if layer.constructor == nil {
+			return MiddlewareStack{}, sdkerrors.Wrapf(ErrInvalidMiddlewareStack, "middleware constructor cannot be nil at index %d", i)
+		}
+
+		middleware := layer.constructor(top)
+		if middleware == nil {
+			return MiddlewareStack{}, sdkerrors.Wrapf(ErrInvalidMiddlewareStack, "middleware cannot be nil at index %d", i)
+		}
+
+		if err := validateLayer(middleware); err != nil {
+			return MiddlewareStack{}, err
+		}
+
+		if wrapper, ok := middleware.(ApplicationWrapper); ok {
+			if underlying := wrapper.UnderlyingApplication(); underlying != top {
+				return MiddlewareStack{}, sdkerrors.Wrapf(
+					ErrInvalidMiddlewareStack, "middleware %s wraps %s, expected %s",
+					GetStackLayerName(middleware), GetStackLayerName(underlying), GetStackLayerName(top),
+				)
+			}
+		}
+
+		top = middleware
+	}
+
+	return MiddlewareStack{
+		IBCModule:   top,
+		ICS4Wrapper: sendStack[0],
+	}, nil
+}
+
+// validateLayer returns an error if the given layer of a middleware stack is not a pointer. The
+// layers are compared by identity, so that the copies of a layer passed by value, such as two
+// keepers of the same type, cannot be mistaken for one another.
+func validateLayer(layer interface{}) error {
+	if reflect.TypeOf(layer).Kind() != reflect.Ptr {
+		return sdkerrors.Wrapf(ErrInvalidMiddlewareStack, "layer %s must be a pointer", GetStackLayerName(layer))
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// application is a base application sending packets with an ICS4Wrapper
+type application struct {
+	types.IBCModule
+
+	ics4Wrapper types.PacketSender
+}
+
+func (a application) GetICS4Wrapper() types.PacketSender {
+	return a.ics4Wrapper
+}
+
+// middleware is a middleware wrapping an underlying application
+type middleware struct {
+	types.IBCModule
+
+	name string
+	app  types.IBCModule
+}
+
+func (m middleware) StackLayerName() string {
+	return m.name
+}
+
+func (m middleware) UnderlyingApplication() types.IBCModule {
+	return m.app
+}
+
+// ics4Wrapper is the ICS4Wrapper of a middleware, or of core IBC if it has no underlying ICS4Wrapper
+type ics4Wrapper struct {
+	name       string
+	underlying types.PacketSender
+}
+
+func (w *ics4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI) error {
+	return nil
+}
+
+func (w *ics4Wrapper) StackLayerName() string {
+	return w.name
+}
+
+func (w *ics4Wrapper) UnderlyingICS4Wrapper() types.PacketSender {
+	return w.underlying
+}
+
+func TestMiddlewareStackBuilder(t *testing.T) {
+	var (
+		core, ics4WrapperA, ics4WrapperB *ics4Wrapper
+		app                              *application
+		builder                          *types.MiddlewareStackBuilder
+	)
+
+	wrap := func(name string) types.MiddlewareConstructor {
+		return func(app types.IBCModule) types.IBCModule {
+			return &middleware{name: name, app: app}
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: middleware without ICS4Wrapper", func() {
+				app.ics4Wrapper = ics4WrapperB
+				builder = types.NewMiddlewareStackBuilder(core, app).
+					Wrap(wrap("a"), nil).
+					Wrap(wrap("b"), ics4WrapperB)
+			}, true,
+		},
+		{
+			"success: no middleware", func() {
+				app.ics4Wrapper = core
+				builder = types.NewMiddlewareStackBuilder(core, app)
+			}, true,
+		},
+		{
+			"nil core ICS4Wrapper", func() {
+				builder = types.NewMiddlewareStackBuilder(nil, app)
+			}, false,
+		},
+		{
+			"nil base application", func() {
+				builder = types.NewMiddlewareStackBuilder(core, nil)
+			}, false,
+		},
+		{
+			"base application sends packets to core IBC", func() {
+				app.ics4Wrapper = core
+				builder = types.NewMiddlewareStackBuilder(core, app).
+					Wrap(wrap("a"), ics4WrapperA).
+					Wrap(wrap("b"), ics4WrapperB)
+			}, false,
+		},
+		{
+			"middleware are misordered", func() {
+				builder = types.NewMiddlewareStackBuilder(core, app).
+					Wrap(wrap("b"), ics4WrapperB).
+					Wrap(wrap("a"), ics4WrapperA)
+			}, false,
+		},
+		{
+			"ICS4Wrapper skips a middleware", func() {
+				ics4WrapperA.underlying = core
+			}, false,
+		},
+		{
+			"nil middleware constructor", func() {
+				builder.Wrap(nil, nil)
+			}, false,
+		},
+		{
+			"nil middleware", func() {
+				builder.Wrap(func(types.IBCModule) types.IBCModule { return nil }, nil)
+			}, false,
+		},
+		{
+			"middleware does not wrap the layer below it", func() {
+				builder.Wrap(func(types.IBCModule) types.IBCModule { return &middleware{name: "c", app: app} }, nil)
+			}, false,
+		},
+		{
+			"middleware is not a pointer", func() {
+				builder.Wrap(func(app types.IBCModule) types.IBCModule { return middleware{name: "c", app: app} }, nil)
+			}, false,
+		},
+		{
+			"base application is not a pointer", func() {
+				builder = types.NewMiddlewareStackBuilder(core, *app).
+					Wrap(wrap("a"), ics4WrapperA).
+					Wrap(wrap("b"), ics4WrapperB)
+			}, false,
+		},
+		{
+			"middleware wraps a copy of the layer below it", func() {
+				builder.Wrap(func(app types.IBCModule) types.IBCModule {
+					underlying := *app.(*middleware)
+					return &middleware{name: "c", app: &underlying}
+				}, nil)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the base application sends packets through the ICS4Wrapper of middleware a, which
+			// passes them to the one of middleware b, which passes them to core IBC
+			core = &ics4Wrapper{name: "core"}
+			ics4WrapperB = &ics4Wrapper{name: "b", underlying: core}
+			ics4WrapperA = &ics4Wrapper{name: "a", underlying: ics4WrapperB}
+			app = &application{ics4Wrapper: ics4WrapperA}
+
+			builder = types.NewMiddlewareStackBuilder(core, app).
+				Wrap(wrap("a"), ics4WrapperA).
+				Wrap(wrap("b"), ics4WrapperB)
+
+			tc.malleate()
+
+			stack, err := builder.Build()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, app.ics4Wrapper, stack.ICS4Wrapper)
+
+				// the top of the stack resolves down to the base application
+				receiveStack := types.ResolveReceiveStack(stack.IBCModule)
+				require.Equal(t, app, receiveStack[len(receiveStack)-1])
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, types.ErrInvalidMiddlewareStack)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	ics23 "github.com/confio/ics23/go"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateProofSpecs validates the proof specs used to verify merkle proofs, one per store
+// of the proven path, starting with the innermost store. The specs of chains using other
+// stores than the IAVL and Tendermint merkle trees of the SDK, e.g. sparse merkle trees, must
+// use hash and length operations supported by ICS-23, so that their proofs may be verified.
+func ValidateProofSpecs(specs []*ics23.ProofSpec) error {
+	if len(specs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "proof specs cannot be empty")
+	}
+
+	for i, spec := range specs {
+		if err := ValidateProofSpec(spec); err != nil {
+			return sdkerrors.Wrapf(err, "proof spec at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProofSpec validates a proof spec used to verify the merkle proofs of a store.
+func ValidateProofSpec(spec *ics23.ProofSpec) error {
+	if spec == nil {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "proof spec cannot be nil")
+	}
+
+	if spec.MinDepth < 0 || spec.MaxDepth < 0 {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "depths cannot be negative, min depth: %d, max depth: %d", spec.MinDepth, spec.MaxDepth)
+	}
+
+	if spec.MaxDepth > 0 && spec.MinDepth > spec.MaxDepth {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "min depth (%d) cannot be greater than max depth (%d)", spec.MinDepth, spec.MaxDepth)
+	}
+
+	leafSpec := spec.LeafSpec
+	if leafSpec == nil {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "leaf spec cannot be nil")
+	}
+
+	if !isSupportedHashOp(leafSpec.Hash) {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported leaf hash operation %s", leafSpec.Hash)
+	}
+
+	if leafSpec.PrehashKey != ics23.HashOp_NO_HASH && !isSupportedHashOp(leafSpec.PrehashKey) {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported leaf key prehash operation %s", leafSpec.PrehashKey)
+	}
+
+	if leafSpec.PrehashValue != ics23.HashOp_NO_HASH && !isSupportedHashOp(leafSpec.PrehashValue) {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported leaf value prehash operation %s", leafSpec.PrehashValue)
+	}
+
+	if !isSupportedLengthOp(leafSpec.Length) {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported leaf length operation %s", leafSpec.Length)
+	}
+
+	innerSpec := spec.InnerSpec
+	if innerSpec == nil {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "inner spec cannot be nil")
+	}
+
+	if !isSupportedHashOp(innerSpec.Hash) {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported inner hash operation %s", innerSpec.Hash)
+	}
+
+	if innerSpec.ChildSize <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "inner child size must be positive, got %d", innerSpec.ChildSize)
+	}
+
+	if innerSpec.MinPrefixLength < 0 || innerSpec.MinPrefixLength > innerSpec.MaxPrefixLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidProofSpec, "invalid inner prefix lengths, min prefix length: %d, max prefix length: %d",
+			innerSpec.MinPrefixLength, innerSpec.MaxPrefixLength,
+		)
+	}
+
+	// the child order must be a permutation of the children indices
+	if len(innerSpec.ChildOrder) < 2 {
+		return sdkerrors.Wrapf(ErrInvalidProofSpec, "inner child order must have at least 2 children, got %d", len(innerSpec.ChildOrder))
+	}
+
+	seen := make([]bool, len(innerSpec.ChildOrder))
+	for _, child := range innerSpec.ChildOrder {
+		if child < 0 || int(child) >= len(innerSpec.ChildOrder) || seen[child] {
+			return sdkerrors.Wrapf(ErrInvalidProofSpec, "inner child order %v is not a permutation of the children", innerSpec.ChildOrder)
+		}
+		seen[child] = true
+	}
+
+	return nil
+}
+
+// ValidateHashOps validates the hash operations which the proof specs of light clients may be
+// restricted to. The hash operations must be implemented by ICS-23.
+func ValidateHashOps(hashOps []ics23.HashOp) error {
+	if len(hashOps) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "hash operations cannot be empty")
+	}
+
+	for _, hashOp := range hashOps {
+		if !isSupportedHashOp(hashOp) {
+			return sdkerrors.Wrapf(ErrInvalidProofSpec, "unsupported hash operation %s", hashOp)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProofSpecsHashOps validates that the leaf, prehash and inner hash operations of the
+// given proof specs are among the given hash operations.
+func ValidateProofSpecsHashOps(specs []*ics23.ProofSpec, hashOps []ics23.HashOp) error {
+	for i, spec := range specs {
+		if spec == nil || spec.LeafSpec == nil || spec.InnerSpec == nil {
+			return sdkerrors.Wrapf(ErrInvalidProofSpec, "incomplete proof spec at index %d", i)
+		}
+
+		specHashOps := []ics23.HashOp{spec.LeafSpec.Hash, spec.InnerSpec.Hash}
+		if spec.LeafSpec.PrehashKey != ics23.HashOp_NO_HASH {
+			specHashOps = append(specHashOps, spec.LeafSpec.PrehashKey)
+		}
+		if spec.LeafSpec.PrehashValue != ics23.HashOp_NO_HASH {
+			specHashOps = append(specHashOps, spec.LeafSpec.PrehashValue)
+		}
+
+		for _, specHashOp := range specHashOps {
+			if !containsHashOp(hashOps, specHashOp) {
+				return sdkerrors.Wrapf(ErrInvalidProofSpec, "hash operation %s of proof spec at index %d is not allowed", specHashOp, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// containsHashOp returns true if the hash operation is in the given hash operations.
+func containsHashOp(hashOps []ics23.HashOp, hashOp ics23.HashOp) bool {
+	for _, op := range hashOps {
+		if op == hashOp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSupportedHashOp returns true if the hash operation is implemented by ICS-23. The KECCAK
+// hash operation is defined but not implemented.
+func isSupportedHashOp(hashOp ics23.HashOp) bool {
+	switch hashOp {
+	case ics23.HashOp_SHA256, ics23.HashOp_SHA512, ics23.HashOp_SHA512_256,
+		ics23.HashOp_RIPEMD160, ics23.HashOp_BITCOIN:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSupportedLengthOp returns true if the length operation is implemented by ICS-23.
+func isSupportedLengthOp(lengthOp ics23.LengthOp) bool {
+	switch lengthOp {
+	case ics23.LengthOp_NO_PREFIX, ics23.LengthOp_VAR_PROTO, ics23.LengthOp_FIXED32_LITTLE,
+		ics23.LengthOp_REQUIRE_32_BYTES, ics23.LengthOp_REQUIRE_64_BYTES:
+		return true
+	default:
+		return false
+	}
+}
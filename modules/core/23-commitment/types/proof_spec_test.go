@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+)
+
+func TestValidateProofSpecs(t *testing.T) {
+	var spec *ics23.ProofSpec
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"iavl spec", func() {}, true},
+		{"sparse merkle tree spec", func() { spec = ics23.SmtSpec }, true},
+		{"nil spec", func() { spec = nil }, false},
+		{"negative depth", func() { spec.MinDepth = -1 }, false},
+		{"min depth greater than max depth", func() { spec.MinDepth, spec.MaxDepth = 2, 1 }, false},
+		{"nil leaf spec", func() { spec.LeafSpec = nil }, false},
+		{"leaf without hash", func() { spec.LeafSpec.Hash = ics23.HashOp_NO_HASH }, false},
+		{"unimplemented leaf hash", func() { spec.LeafSpec.Hash = ics23.HashOp_KECCAK }, false},
+		{"unimplemented key prehash", func() { spec.LeafSpec.PrehashKey = ics23.HashOp_KECCAK }, false},
+		{"unimplemented length operation", func() { spec.LeafSpec.Length = ics23.LengthOp_VAR_RLP }, false},
+		{"nil inner spec", func() { spec.InnerSpec = nil }, false},
+		{"unimplemented inner hash", func() { spec.InnerSpec.Hash = ics23.HashOp_KECCAK }, false},
+		{"zero child size", func() { spec.InnerSpec.ChildSize = 0 }, false},
+		{"min prefix length greater than max prefix length", func() { spec.InnerSpec.MinPrefixLength = 13 }, false},
+		{"single child", func() { spec.InnerSpec.ChildOrder = []int32{0} }, false},
+		{"child order is not a permutation", func() { spec.InnerSpec.ChildOrder = []int32{0, 0} }, false},
+		{"child order out of range", func() { spec.InnerSpec.ChildOrder = []int32{0, 2} }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// copy the IAVL spec so that the malleate functions do not modify it
+			leafSpec, innerSpec := *ics23.IavlSpec.LeafSpec, *ics23.IavlSpec.InnerSpec
+			spec = &ics23.ProofSpec{LeafSpec: &leafSpec, InnerSpec: &innerSpec}
+
+			tc.malleate()
+
+			err := types.ValidateProofSpecs([]*ics23.ProofSpec{spec, ics23.TendermintSpec})
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, types.ValidateProofSpecs(nil))
+	require.NoError(t, types.ValidateProofSpecs(types.GetSDKSpecs()))
+}
+
+func TestValidateProofSpecsHashOps(t *testing.T) {
+	require.NoError(t, types.ValidateHashOps([]ics23.HashOp{ics23.HashOp_SHA256}))
+	require.Error(t, types.ValidateHashOps(nil))
+	require.Error(t, types.ValidateHashOps([]ics23.HashOp{ics23.HashOp_SHA256, ics23.HashOp_KECCAK}))
+
+	// the IAVL and tendermint specs only use SHA-256
+	require.NoError(t, types.ValidateProofSpecsHashOps(types.GetSDKSpecs(), []ics23.HashOp{ics23.HashOp_SHA256}))
+	require.Error(t, types.ValidateProofSpecsHashOps(types.GetSDKSpecs(), []ics23.HashOp{ics23.HashOp_SHA512}))
+
+	// prehash operations must be allowed as well
+	leafSpec, innerSpec := *ics23.IavlSpec.LeafSpec, *ics23.IavlSpec.InnerSpec
+	leafSpec.PrehashKey = ics23.HashOp_SHA512
+	spec := &ics23.ProofSpec{LeafSpec: &leafSpec, InnerSpec: &innerSpec}
+	require.Error(t, types.ValidateProofSpecsHashOps([]*ics23.ProofSpec{spec}, []ics23.HashOp{ics23.HashOp_SHA256}))
+	require.NoError(t, types.ValidateProofSpecsHashOps([]*ics23.ProofSpec{spec}, []ics23.HashOp{ics23.HashOp_SHA256, ics23.HashOp_SHA512}))
+
+	require.Error(t, types.ValidateProofSpecsHashOps([]*ics23.ProofSpec{nil}, []ics23.HashOp{ics23.HashOp_SHA256}))
+}
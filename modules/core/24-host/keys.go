@@ -41,6 +41,29 @@ const (
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
+	KeyAggregatedPayloads      = "aggregatedPayloads"
+	KeyDeadLetterPrefix        = "deadLetters"
+	KeyPacketRecvHeightPrefix  = "recvHeights"
+	KeyPacketClearHeightPrefix = "clearHeights"
+	KeyPacketHeightExpiries    = "packetHeightExpiries"
+	KeyPacketSendTimePrefix    = "sendTimes"
+	KeyPacketLatencyPrefix     = "packetLatencies"
+	KeyPacketPriorityPrefix    = "packetPriorities"
+	KeyPrioritizedPackets      = "prioritizedPackets"
+	KeySendPacketEventPrefix   = "sendPacketEvents"
+	KeyWriteAckEventPrefix     = "writeAckEvents"
+	KeyWriteAckEventExpiries   = "writeAckEventExpiries"
+	KeyQuarantinedChannels     = "quarantinedChannels"
+	KeyPendingAsyncAckPrefix   = "pendingAsyncAcks"
+	KeyPacketCompensations     = "packetCompensations"
+	KeyQueuedClientUpdates     = "queuedClientUpdates"
+	KeyReservedClientSequences = "reservedClientSequences"
+	KeyReservedChanSequences   = "reservedChannelSequences"
+	KeyArchivedClients         = "archivedClients"
+	KeyChannelUpgradePrefix    = "channelUpgrades"
+	KeyUpgradePrefix           = "upgrades"
+	KeyUpgradeErrorPrefix      = "upgradeError"
+	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -218,6 +241,264 @@ func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// PacketRecvHeightPath defines the store path under which the height at which a packet
+// was received is stored
+func PacketRecvHeightPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketRecvHeightPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketRecvHeightKey returns the store key under which the height at which a packet
+// was received is stored
+func PacketRecvHeightKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketRecvHeightPath(portID, channelID, sequence))
+}
+
+// PacketClearHeightPath defines the store path under which the height at which the
+// commitment of a sent packet was cleared by its acknowledgement or timeout is stored
+func PacketClearHeightPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketClearHeightPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketClearHeightKey returns the store key under which the height at which the
+// commitment of a sent packet was cleared by its acknowledgement or timeout is stored
+func PacketClearHeightKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketClearHeightPath(portID, channelID, sequence))
+}
+
+// PacketHeightExpiriesPrefixKey returns the store key prefix of the index of the receive
+// and clear heights of packets by the height at which they were stored
+func PacketHeightExpiriesPrefixKey() []byte {
+	return []byte(KeyPacketHeightExpiries + "/")
+}
+
+// PacketSendTimePath defines the store path under which the block time at which a
+// packet was sent is stored until the packet is acknowledged or timed out
+func PacketSendTimePath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketSendTimePrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketSendTimeKey returns the store key under which the block time at which a
+// packet was sent is stored until the packet is acknowledged or timed out
+func PacketSendTimeKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketSendTimePath(portID, channelID, sequence))
+}
+
+// PacketLatencyPath defines the store path under which the latency statistics of
+// the acknowledged packets of a channel are stored
+func PacketLatencyPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPacketLatencyPrefix, channelPath(portID, channelID))
+}
+
+// PacketLatencyKey returns the store key under which the latency statistics of
+// the acknowledged packets of a channel are stored
+func PacketLatencyKey(portID, channelID string) []byte {
+	return []byte(PacketLatencyPath(portID, channelID))
+}
+
+// PacketPriorityPath defines the store path under which the priority class of a
+// packet is stored until the packet is acknowledged or timed out
+func PacketPriorityPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketPriorityPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketPriorityKey returns the store key under which the priority class of a
+// packet is stored until the packet is acknowledged or timed out
+func PacketPriorityKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketPriorityPath(portID, channelID, sequence))
+}
+
+// PrioritizedPacketsPrefixKey returns the store key prefix of the index of the
+// pending packets of a channel sent with a priority class
+func PrioritizedPacketsPrefixKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPrioritizedPackets, channelPath(portID, channelID)))
+}
+
+// SendPacketEventPath defines the store path under which the event data of a sent
+// packet is stored until the packet is acknowledged or timed out
+func SendPacketEventPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeySendPacketEventPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// SendPacketEventKey returns the store key under which the event data of a sent
+// packet is stored until the packet is acknowledged or timed out
+func SendPacketEventKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(SendPacketEventPath(portID, channelID, sequence))
+}
+
+// WriteAcknowledgementEventPath defines the store path under which the event data
+// of a written acknowledgement is stored
+func WriteAcknowledgementEventPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyWriteAckEventPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// WriteAcknowledgementEventKey returns the store key under which the event data
+// of a written acknowledgement is stored
+func WriteAcknowledgementEventKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(WriteAcknowledgementEventPath(portID, channelID, sequence))
+}
+
+// QuarantinedChannelPath defines the store path under which a channel placed in
+// quarantine is stored until it is released
+func QuarantinedChannelPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyQuarantinedChannels, channelPath(portID, channelID))
+}
+
+// QuarantinedChannelKey returns the store key under which a channel placed in
+// quarantine is stored until it is released
+func QuarantinedChannelKey(portID, channelID string) []byte {
+	return []byte(QuarantinedChannelPath(portID, channelID))
+}
+
+// PendingAsyncAcknowledgementPath defines the store path under which a received packet
+// is stored until its acknowledgement is written asynchronously
+func PendingAsyncAcknowledgementPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPendingAsyncAckPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PendingAsyncAcknowledgementKey returns the store key under which a received packet
+// is stored until its acknowledgement is written asynchronously
+func PendingAsyncAcknowledgementKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PendingAsyncAcknowledgementPath(portID, channelID, sequence))
+}
+
+// PendingAsyncAcknowledgementsPrefixKey returns the store key prefix of the received
+// packets of a channel awaiting an asynchronous acknowledgement
+func PendingAsyncAcknowledgementsPrefixKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPendingAsyncAckPrefix, channelPath(portID, channelID)))
+}
+
+// PacketCompensationPath defines the store path under which the compensation data of a
+// packet sent with a compensation is stored until the packet is acknowledged or timed out
+func PacketCompensationPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketCompensations, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketCompensationKey returns the store key under which the compensation data of a
+// packet sent with a compensation is stored
+func PacketCompensationKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketCompensationPath(portID, channelID, sequence))
+}
+
+// WriteAcknowledgementEventExpiriesPrefixKey returns the store key prefix of the index
+// of the event data of written acknowledgements by the height at which it was stored
+func WriteAcknowledgementEventExpiriesPrefixKey() []byte {
+	return []byte(KeyWriteAckEventExpiries + "/")
+}
+
+// QueuedClientUpdatesPath defines the store path under which the client updates
+// queued during the current block for a particular client are stored
+func QueuedClientUpdatesPath(clientID string) string {
+	return fmt.Sprintf("%s/%s", KeyQueuedClientUpdates, clientID)
+}
+
+// QueuedClientUpdatesKey returns the store key under which the client updates
+// queued during the current block for a particular client are stored
+func QueuedClientUpdatesKey(clientID string) []byte {
+	return []byte(QueuedClientUpdatesPath(clientID))
+}
+
+// ReservedClientSequencePath defines the store path under which a client identifier
+// sequence reserved for an upcoming upgrade is stored
+func ReservedClientSequencePath(sequence uint64) string {
+	return fmt.Sprintf("%s/%d", KeyReservedClientSequences, sequence)
+}
+
+// ReservedClientSequenceKey returns the store key under which a client identifier
+// sequence reserved for an upcoming upgrade is stored
+func ReservedClientSequenceKey(sequence uint64) []byte {
+	return []byte(ReservedClientSequencePath(sequence))
+}
+
+// ArchivedClientPath defines the store path under which the archival of a particular
+// client is recorded
+func ArchivedClientPath(clientID string) string {
+	return fmt.Sprintf("%s/%s", KeyArchivedClients, clientID)
+}
+
+// ArchivedClientKey returns the store key under which the archival of a particular
+// client is recorded
+func ArchivedClientKey(clientID string) []byte {
+	return []byte(ArchivedClientPath(clientID))
+}
+
+// ReservedChannelSequencePath defines the store path under which a channel identifier
+// sequence reserved for an upcoming upgrade is stored
+func ReservedChannelSequencePath(sequence uint64) string {
+	return fmt.Sprintf("%s/%d", KeyReservedChanSequences, sequence)
+}
+
+// ReservedChannelSequenceKey returns the store key under which a channel identifier
+// sequence reserved for an upcoming upgrade is stored
+func ReservedChannelSequenceKey(sequence uint64) []byte {
+	return []byte(ReservedChannelSequencePath(sequence))
+}
+
+// AggregatedPayloadsPath defines the store path under which application payloads
+// buffered for aggregation into a single packet are stored
+func AggregatedPayloadsPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyAggregatedPayloads, channelPath(portID, channelID))
+}
+
+// AggregatedPayloadsKey returns the store key under which the buffered payloads
+// of a particular channel are stored
+func AggregatedPayloadsKey(portID, channelID string) []byte {
+	return []byte(AggregatedPayloadsPath(portID, channelID))
+}
+
+// DeadLetterPacketPath defines the store path under which a packet kept in the
+// dead-letter store is stored
+func DeadLetterPacketPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", DeadLetterPacketPrefixPath(portID, channelID), sequence)
+}
+
+// DeadLetterPacketKey returns the store key under which a packet kept in the
+// dead-letter store is stored
+func DeadLetterPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(DeadLetterPacketPath(portID, channelID, sequence))
+}
+
+// DeadLetterPacketPrefixPath defines the prefix of the store paths under which the
+// packets of a channel kept in the dead-letter store are stored
+func DeadLetterPacketPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyDeadLetterPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
+// ChannelUpgradePath defines the path under which the upgrade proposed for a
+// channel is stored
+func ChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeKey returns the store key under which the upgrade proposed for a
+// channel is stored
+func ChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradePath(portID, channelID))
+}
+
+// ChannelUpgradeErrorPath defines the path under which the error receipt of the
+// last aborted upgrade of a channel is stored
+func ChannelUpgradeErrorPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradeErrorPrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeErrorKey returns the store key under which the error receipt of
+// the last aborted upgrade of a channel is stored
+func ChannelUpgradeErrorKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradeErrorPath(portID, channelID))
+}
+
+// ChannelCounterpartyUpgradePath defines the path under which the upgrade of the
+// counterparty channel end is stored while the channel is flushing
+func ChannelCounterpartyUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
+}
+
+// ChannelCounterpartyUpgradeKey returns the store key under which the upgrade of
+// the counterparty channel end is stored while the channel is flushing
+func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelCounterpartyUpgradePath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
@@ -52,7 +52,7 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
-			case *clienttypes.MsgUpdateClient:
+			case *clienttypes.MsgUpdateClient, *clienttypes.MsgBatchUpdateClient:
 				// do nothing here, as we want to avoid updating clients if it is batched with only redundant messages
 
 			default:
@@ -5,13 +5,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 
+	icacli "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/client/cli"
+	transfercli "github.com/cosmos/ibc-go/v3/modules/apps/transfer/client/cli"
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
-// GetTxCmd returns the transaction commands for this module
+// GetTxCmd returns the transaction commands for this module. The commands are grouped in the
+// client, channel, transfer and ica sub-namespaces.
 func GetTxCmd() *cobra.Command {
 	ibcTxCmd := &cobra.Command{
 		Use:                        host.ModuleName,
@@ -24,12 +27,15 @@ func GetTxCmd() *cobra.Command {
 	ibcTxCmd.AddCommand(
 		ibcclient.GetTxCmd(),
 		channel.GetTxCmd(),
+		transfercli.NewTxCmd(),
+		icacli.GetTxCmd(),
 	)
 
 	return ibcTxCmd
 }
 
-// GetQueryCmd returns the cli query commands for this module
+// GetQueryCmd returns the cli query commands for this module. The commands are grouped in the
+// client, connection, channel, transfer and ica sub-namespaces.
 func GetQueryCmd() *cobra.Command {
 	// Group ibc queries under a subcommand
 	ibcQueryCmd := &cobra.Command{
@@ -44,6 +50,9 @@ func GetQueryCmd() *cobra.Command {
 		ibcclient.GetQueryCmd(),
 		connection.GetQueryCmd(),
 		channel.GetQueryCmd(),
+		transfercli.GetQueryCmd(),
+		icacli.GetQueryCmd(),
+		GetCmdExportState(),
 	)
 
 	return ibcQueryCmd
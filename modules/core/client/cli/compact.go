@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/client"
+)
+
+// GetCmdCompactStores defines the offline command to report the size of and compact the IBC
+// stores of the application database of a node. The core IBC store is always reported, the
+// stores of the IBC applications are reported for the provided decoders.
+func GetCmdCompactStores(defaultNodeHome string, decoders ...ibcclient.StoreDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact-ibc-stores",
+		Short: "Report the size of and compact the IBC stores of the application database",
+		Long: `Report the size of the IBC store prefixes (clients, connections, channels, packet commitments,
+acknowledgements and receipts) and of the store prefixes of the IBC applications registered by the
+application (such as denomination traces) at the latest version of the application database and compact the database ranges of the IBC stores, reporting their on disk size before and after
+compaction. The node must be stopped before running this command. Only goleveldb databases are supported.`,
+		Example: fmt.Sprintf("%s compact-ibc-stores --home [node-home]", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			report, err := ibcclient.CompactStores(db, decoders...)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
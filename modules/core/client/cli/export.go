@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// exportPageLimit is the page size used to query the paginated escrow balances.
+const exportPageLimit = 100
+
+// StateExport is a structured document of the IBC state of a chain at a given height.
+// Entries are encoded using the proto JSON encoding of their types so that the
+// document may be decoded against the IBC proto definitions.
+type StateExport struct {
+	// Height at which the state was queried
+	Height int64 `json:"height"`
+	// Clients are the identified client states
+	Clients []json.RawMessage `json:"clients"`
+	// Connections are the identified connection ends
+	Connections []json.RawMessage `json:"connections"`
+	// Channels are the identified channel ends
+	Channels []json.RawMessage `json:"channels"`
+	// PendingPackets are the packet commitments of sent packets which have not been
+	// acknowledged or timed out yet
+	PendingPackets []json.RawMessage `json:"pending_packets"`
+	// EscrowBalances are the non-empty balances of the escrow accounts of all channels
+	EscrowBalances []EscrowBalance `json:"escrow_balances"`
+}
+
+// EscrowBalance is the balance of the escrow account of a channel.
+type EscrowBalance struct {
+	PortID    string    `json:"port_id"`
+	ChannelID string    `json:"channel_id"`
+	Address   string    `json:"address"`
+	Balance   sdk.Coins `json:"balance"`
+}
+
+// EscrowAddressFunc returns the address of the account escrowing the tokens sent over
+// the given channel. It is provided by the application owning the escrow accounts.
+type EscrowAddressFunc func(portID, channelID string) sdk.AccAddress
+
+// ExportState queries all clients, connections, channels and pending packets of the chain
+// at the height set in the client context and returns them as a StateExport. The balances
+// of the escrow accounts returned by escrowAddress are added to the export, unless
+// escrowAddress is nil. Queries with a client context height of 0 are performed at the
+// latest height, which is then used for all queries so that the export is consistent.
+func ExportState(ctx context.Context, clientCtx client.Context, escrowAddress EscrowAddressFunc) (*StateExport, error) {
+	if clientCtx.Height == 0 {
+		height, err := rpc.GetChainHeight(clientCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+	}
+
+	res, err := coretypes.NewStateQueryClient(clientCtx).ExportState(ctx, &coretypes.QueryExportStateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	export := &StateExport{
+		Height: res.Height,
+	}
+
+	for i := range res.Clients {
+		bz, err := clientCtx.Codec.MarshalJSON(&res.Clients[i])
+		if err != nil {
+			return nil, err
+		}
+
+		export.Clients = append(export.Clients, bz)
+	}
+
+	for i := range res.Connections {
+		bz, err := clientCtx.Codec.MarshalJSON(&res.Connections[i])
+		if err != nil {
+			return nil, err
+		}
+
+		export.Connections = append(export.Connections, bz)
+	}
+
+	for i := range res.Channels {
+		bz, err := clientCtx.Codec.MarshalJSON(&res.Channels[i])
+		if err != nil {
+			return nil, err
+		}
+
+		export.Channels = append(export.Channels, bz)
+	}
+
+	for i := range res.PendingPackets {
+		bz, err := clientCtx.Codec.MarshalJSON(&res.PendingPackets[i])
+		if err != nil {
+			return nil, err
+		}
+
+		export.PendingPackets = append(export.PendingPackets, bz)
+	}
+
+	if escrowAddress == nil {
+		return export, nil
+	}
+
+	bankQueryClient := banktypes.NewQueryClient(clientCtx)
+	for _, channel := range res.Channels {
+		address := escrowAddress(channel.PortId, channel.ChannelId)
+		balance := sdk.NewCoins()
+		if err := paginate(func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+			res, err := bankQueryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+				Address:    address.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			balance = balance.Add(res.Balances...)
+			return res.Pagination, nil
+		}); err != nil {
+			return nil, err
+		}
+
+		if !balance.IsZero() {
+			export.EscrowBalances = append(export.EscrowBalances, EscrowBalance{
+				PortID:    channel.PortId,
+				ChannelID: channel.ChannelId,
+				Address:   address.String(),
+				Balance:   balance,
+			})
+		}
+	}
+
+	return export, nil
+}
+
+// paginate calls the provided query function for every page until no next key is returned.
+func paginate(queryPage func(pageReq *query.PageRequest) (*query.PageResponse, error)) error {
+	pageReq := &query.PageRequest{Limit: exportPageLimit}
+	for {
+		pageRes, err := queryPage(pageReq)
+		if err != nil {
+			return err
+		}
+
+		if pageRes == nil || len(pageRes.NextKey) == 0 {
+			return nil
+		}
+
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: exportPageLimit}
+	}
+}
+
+// GetCmdExportState defines the command to export all clients, connections, channels,
+// pending packets and escrow balances of the chain into a single JSON document.
+func GetCmdExportState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state",
+		Short: "Export the IBC state of the chain for auditing",
+		Long: `Export all clients, connections, channels, pending packets and ICS20 escrow balances
+of the chain into a single JSON document. All queries are performed at the height provided
+with the --height flag, or at the latest height if no height is provided.`,
+		Example: fmt.Sprintf("%s query %s export-state --height 1000", version.AppName, host.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			export, err := ExportState(cmd.Context(), clientCtx, transfertypes.GetEscrowAddress)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// otherPrefix is the name under which keys not matching any known prefix are reported.
+const otherPrefix = "other"
+
+// StorePrefix is a named key prefix of a store.
+type StorePrefix struct {
+	Name   string
+	Prefix []byte
+}
+
+// StoreDecoder describes the key layout of a store of the application database. Keys of the
+// store not matching any of its prefixes are reported under the "other" prefix. Applications
+// provide a StoreDecoder for every IBC application store they want reported next to the core
+// IBC store.
+type StoreDecoder struct {
+	// Store is the name of the store in the application database
+	Store string
+	// Prefixes are the key prefixes reported for the store, in the order they are reported
+	Prefixes []StorePrefix
+}
+
+// IBCStoreDecoder returns the StoreDecoder of the core IBC store.
+func IBCStoreDecoder() StoreDecoder {
+	return StoreDecoder{
+		Store: host.StoreKey,
+		Prefixes: []StorePrefix{
+			{"clients", []byte(fmt.Sprintf("%s/", host.KeyClientStorePrefix))},
+			{host.KeyConnectionPrefix, []byte(fmt.Sprintf("%s/", host.KeyConnectionPrefix))},
+			{host.KeyChannelEndPrefix, []byte(fmt.Sprintf("%s/", host.KeyChannelEndPrefix))},
+			{host.KeyPacketCommitmentPrefix, []byte(fmt.Sprintf("%s/", host.KeyPacketCommitmentPrefix))},
+			{host.KeyPacketAckPrefix, []byte(fmt.Sprintf("%s/", host.KeyPacketAckPrefix))},
+			{host.KeyPacketReceiptPrefix, []byte(fmt.Sprintf("%s/", host.KeyPacketReceiptPrefix))},
+		},
+	}
+}
+
+// PrefixSize is the logical size of the keys and values stored under a prefix of an IBC
+// store at the latest version.
+type PrefixSize struct {
+	Store  string `json:"store"`
+	Prefix string `json:"prefix"`
+	Keys   uint64 `json:"keys"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// StoreDiskSize is the approximate on disk size of all versions of an IBC store before
+// and after compaction.
+type StoreDiskSize struct {
+	Store  string `json:"store"`
+	Before int64  `json:"before"`
+	After  int64  `json:"after"`
+}
+
+// CompactionReport is the result of compacting the IBC stores of an application database.
+type CompactionReport struct {
+	// Height is the latest version of the application database
+	Height int64 `json:"height"`
+	// Prefixes are the logical sizes of the IBC store prefixes at the latest version
+	Prefixes []PrefixSize `json:"prefixes"`
+	// Stores are the on disk sizes of the IBC stores
+	Stores []StoreDiskSize `json:"stores"`
+}
+
+// CompactStores reports the size of every prefix of the core IBC store and of the stores of
+// the provided decoders at the latest version of the provided application database and
+// compacts the database ranges of these stores. Only goleveldb databases are supported. The
+// database must not be in use by a running node.
+func CompactStores(db dbm.DB, decoders ...StoreDecoder) (*CompactionReport, error) {
+	levelDB, ok := db.(*dbm.GoLevelDB)
+	if !ok {
+		return nil, fmt.Errorf("compaction is only supported for %s databases, got %T", dbm.GoLevelDBBackend, db)
+	}
+
+	decoders = append([]StoreDecoder{IBCStoreDecoder()}, decoders...)
+
+	rs := rootmulti.NewStore(db)
+	storeKeys := make(map[string]storetypes.StoreKey)
+	for _, decoder := range decoders {
+		if _, ok := storeKeys[decoder.Store]; ok {
+			return nil, fmt.Errorf("duplicate store decoder for store %s", decoder.Store)
+		}
+
+		storeKeys[decoder.Store] = storetypes.NewKVStoreKey(decoder.Store)
+		rs.MountStoreWithDB(storeKeys[decoder.Store], storetypes.StoreTypeIAVL, nil)
+	}
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	report := &CompactionReport{
+		Height: rs.LastCommitID().Version,
+	}
+
+	for _, decoder := range decoders {
+		report.Prefixes = append(report.Prefixes, prefixSizes(decoder, rs.GetCommitKVStore(storeKeys[decoder.Store]))...)
+	}
+
+	for _, decoder := range decoders {
+		// the nodes of all versions of a store are stored under its rootmulti store prefix
+		dbRange := util.BytesPrefix([]byte(fmt.Sprintf("s/k:%s/", decoder.Store)))
+
+		before, err := levelDB.DB().SizeOf([]util.Range{*dbRange})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := levelDB.DB().CompactRange(*dbRange); err != nil {
+			return nil, err
+		}
+
+		after, err := levelDB.DB().SizeOf([]util.Range{*dbRange})
+		if err != nil {
+			return nil, err
+		}
+
+		report.Stores = append(report.Stores, StoreDiskSize{
+			Store:  decoder.Store,
+			Before: before.Sum(),
+			After:  after.Sum(),
+		})
+	}
+
+	return report, nil
+}
+
+// prefixSizes returns the size of every prefix of the provided decoder in the provided store,
+// followed by the size of all remaining keys.
+func prefixSizes(decoder StoreDecoder, store sdk.KVStore) []PrefixSize {
+	prefixes := decoder.Prefixes
+
+	sizes := make([]PrefixSize, len(prefixes)+1)
+	for i, prefix := range prefixes {
+		sizes[i] = PrefixSize{Store: decoder.Store, Prefix: prefix.Name}
+	}
+	sizes[len(prefixes)] = PrefixSize{Store: decoder.Store, Prefix: otherPrefix}
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		i := len(prefixes)
+		for j, prefix := range prefixes {
+			if bytes.HasPrefix(iterator.Key(), prefix.Prefix) {
+				i = j
+				break
+			}
+		}
+
+		sizes[i].Keys++
+		sizes[i].Bytes += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+
+	return sizes
+}
@@ -0,0 +1,76 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/client"
+)
+
+func TestCompactStores(t *testing.T) {
+	_, err := ibcclient.CompactStores(dbm.NewMemDB())
+	require.Error(t, err, "only goleveldb databases are supported")
+
+	db, err := dbm.NewGoLevelDB("application", t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	ibcKey := storetypes.NewKVStoreKey(host.StoreKey)
+	transferKey := storetypes.NewKVStoreKey(transfertypes.StoreKey)
+	bankKey := storetypes.NewKVStoreKey("bank")
+
+	rs := rootmulti.NewStore(db)
+	rs.MountStoreWithDB(ibcKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(transferKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	ibcStore := rs.GetKVStore(ibcKey)
+	ibcStore.Set(host.FullClientStateKey("07-tendermint-0"), []byte("client"))
+	ibcStore.Set(host.PacketCommitmentKey("transfer", "channel-0", 1), []byte("commitment"))
+	ibcStore.Set(host.PacketCommitmentKey("transfer", "channel-0", 2), []byte("commitment"))
+	ibcStore.Set([]byte(clienttypes.KeyNextClientSequence), []byte("1"))
+	rs.GetKVStore(transferKey).Set(append(transfertypes.DenomTraceKey, []byte("hash")...), []byte("trace"))
+	rs.GetKVStore(bankKey).Set([]byte("balance"), []byte("1000"))
+	rs.Commit()
+
+	// pruned commitments are not reported at the latest version
+	ibcStore.Delete(host.PacketCommitmentKey("transfer", "channel-0", 2))
+	rs.Commit()
+
+	transferDecoder := ibcclient.StoreDecoder{
+		Store:    transfertypes.StoreKey,
+		Prefixes: []ibcclient.StorePrefix{{Name: "traces", Prefix: transfertypes.DenomTraceKey}},
+	}
+
+	_, err = ibcclient.CompactStores(db, transferDecoder, transferDecoder)
+	require.Error(t, err, "duplicate store decoder")
+
+	report, err := ibcclient.CompactStores(db, transferDecoder)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), report.Height)
+
+	sizes := make(map[string]ibcclient.PrefixSize)
+	for _, size := range report.Prefixes {
+		sizes[size.Store+"/"+size.Prefix] = size
+	}
+
+	require.Equal(t, uint64(1), sizes["ibc/clients"].Keys)
+	require.Equal(t, uint64(1), sizes["ibc/commitments"].Keys)
+	require.Equal(t, uint64(len(host.PacketCommitmentKey("transfer", "channel-0", 1))+len("commitment")), sizes["ibc/commitments"].Bytes)
+	require.Equal(t, uint64(0), sizes["ibc/acks"].Keys)
+	require.Equal(t, uint64(1), sizes["ibc/other"].Keys)
+	require.Equal(t, uint64(1), sizes["transfer/traces"].Keys)
+	require.Equal(t, uint64(0), sizes["transfer/other"].Keys)
+
+	require.Len(t, report.Stores, 2)
+	require.Equal(t, host.StoreKey, report.Stores[0].Store)
+	require.Equal(t, transfertypes.StoreKey, report.Stores[1].Store)
+}
@@ -22,6 +22,15 @@ import (
 // at the lastest state available.
 // Issue: https://github.com/cosmos/cosmos-sdk/issues/6567
 func QueryTendermintProof(clientCtx client.Context, key []byte) ([]byte, []byte, clienttypes.Height, error) {
+	return QueryTendermintProofForStore(clientCtx, host.StoreKey, key)
+}
+
+// QueryTendermintProofForStore performs an ABCI query with the given key against
+// the store registered under the provided store key. It follows the same height
+// semantics as QueryTendermintProof and is used to retrieve proofs for values
+// committed outside of the IBC store, such as the upgraded client and consensus
+// states stored by the upgrade module.
+func QueryTendermintProofForStore(clientCtx client.Context, storeKey string, key []byte) ([]byte, []byte, clienttypes.Height, error) {
 	height := clientCtx.Height
 
 	// ABCI queries at heights 1, 2 or less than or equal to 0 are not supported.
@@ -39,7 +48,7 @@ func QueryTendermintProof(clientCtx client.Context, key []byte) ([]byte, []byte,
 	}
 
 	req := abci.RequestQuery{
-		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Path:   fmt.Sprintf("store/%s/key", storeKey),
 		Height: height,
 		Data:   key,
 		Prove:  true,
@@ -3,6 +3,7 @@ package exported
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -19,6 +20,9 @@ const (
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
+	// Wasm is used to indicate that the light client is implemented by a Wasm contract.
+	Wasm string = "08-wasm"
+
 	// Localhost is the client type for a localhost client. It is also used as the clientID
 	// for the localhost client.
 	Localhost string = "09-localhost"
@@ -32,10 +36,25 @@ const (
 	// Expired is a status type of a client. An expired client is not allowed to be used.
 	Expired Status = "Expired"
 
+	// Archived is a status type of a client. An archived client is not allowed to be updated
+	// or used for new connections, but its consensus states remain stored.
+	Archived Status = "Archived"
+
 	// Unknown indicates there was an error in determining the status of a client.
 	Unknown Status = "Unknown"
 )
 
+// HostTimeOracle defines the source of the current height and timestamp of the host chain used
+// to evaluate packet timeouts and connection delay periods. Chains timestamping blocks with a
+// median time or producing fast blocks may provide an oracle other than the block header. The
+// height and timestamp of the oracle are only used if they are ahead of the block header.
+type HostTimeOracle interface {
+	// GetHostHeight returns the current height of the host chain.
+	GetHostHeight(ctx sdk.Context) Height
+	// GetHostTimestamp returns the current timestamp of the host chain in nanoseconds.
+	GetHostTimestamp(ctx sdk.Context) uint64
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -178,6 +197,73 @@ type ClientState interface {
 	) error
 }
 
+// UpgradePlanVerifier is an optional interface of client states which can verify the upgrade
+// plan scheduled by the counterparty chain before the counterparty chain halts for the upgrade.
+type UpgradePlanVerifier interface {
+	// VerifyUpgradePlan verifies proofs of the upgrade plan stored in the upgrade store of the
+	// counterparty chain and of the upgraded client committed to for the plan at the provided
+	// height.
+	VerifyUpgradePlan(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		plan upgradetypes.Plan,
+		upgradedClient ClientState,
+		proofPlan,
+		proofUpgradedClient []byte,
+	) error
+}
+
+// ChannelUpgradeVerifier is an optional interface of client states which can verify the proofs
+// of the channel upgrade handshake. Channels may only be upgraded on connections whose client
+// state implements it.
+type ChannelUpgradeVerifier interface {
+	// VerifyChannelUpgrade verifies a proof of the upgrade proposed for the specified channel
+	// end, under the specified port, stored on the target machine.
+	VerifyChannelUpgrade(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade codec.ProtoMarshaler,
+	) error
+	// VerifyChannelUpgradeError verifies a proof of the error receipt of the last aborted
+	// upgrade of the specified channel end, under the specified port, stored on the target
+	// machine.
+	VerifyChannelUpgradeError(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt codec.ProtoMarshaler,
+	) error
+}
+
+// UpdateGasEstimator is an optional interface of client states which can estimate the gas of
+// updating the client, allowing relayers to set their gas limits from on-chain data.
+type UpdateGasEstimator interface {
+	// EstimateUpdateGas estimates the gas of a transaction with a single MsgUpdateClient
+	// updating the client with a header of a counterparty chain with the provided validator
+	// set size. It returns the estimated gas and the number of signatures verified.
+	EstimateUpdateGas(validatorSetSize uint64) (gas uint64, signaturesVerified uint64, err error)
+}
+
+// HeaderPrechecker is an optional interface of client states which can cheaply check a header
+// before it is queued to update the client at the end of the block. Headers failing the check
+// are rejected when they are submitted and never take up a slot of the queue.
+type HeaderPrechecker interface {
+	// PrecheckHeader performs the validation of the header which does not require verifying
+	// its signatures, such as checking the chain ID of the header and the existence of the
+	// trusted consensus state.
+	PrecheckHeader(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, header Header) error
+}
+
 // ConsensusState is the state of the consensus process
 type ConsensusState interface {
 	proto.Message
@@ -2,10 +2,13 @@ package ibc
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	client "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/core/types"
 )
@@ -16,6 +19,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, createLocalhost bool, gs *typ
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc exported genesis.
@@ -24,5 +28,43 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		ClientGenesis:     client.ExportGenesis(ctx, k.ClientKeeper),
 		ConnectionGenesis: connection.ExportGenesis(ctx, k.ConnectionKeeper),
 		ChannelGenesis:    channel.ExportGenesis(ctx, k.ChannelKeeper),
+		Params:            k.GetParams(ctx),
 	}
 }
+
+// ValidateInitGenesisOrder validates the order in which the modules of an application are
+// initialized at genesis: the capability module must be initialized before core IBC, which must
+// be initialized before the given IBC applications binding ports.
+func ValidateInitGenesisOrder(order []string, apps ...string) error {
+	indexes := make(map[string]int, len(order))
+	for i, module := range order {
+		indexes[module] = i
+	}
+
+	capabilityIndex, found := indexes[capabilitytypes.ModuleName]
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesisOrder, "%s module is not initialized", capabilitytypes.ModuleName)
+	}
+
+	ibcIndex, found := indexes[host.ModuleName]
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesisOrder, "%s module is not initialized", host.ModuleName)
+	}
+
+	if capabilityIndex > ibcIndex {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesisOrder, "%s module must be initialized before the %s module", capabilitytypes.ModuleName, host.ModuleName)
+	}
+
+	for _, app := range apps {
+		appIndex, found := indexes[app]
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidGenesisOrder, "IBC application %s is not initialized", app)
+		}
+
+		if appIndex < ibcIndex {
+			return sdkerrors.Wrapf(types.ErrInvalidGenesisOrder, "%s module must be initialized before the IBC application %s", host.ModuleName, app)
+		}
+	}
+
+	return nil
+}
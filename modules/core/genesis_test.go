@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -13,6 +14,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/core/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
@@ -211,6 +213,16 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid params",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis:    channeltypes.DefaultGenesisState(),
+				Params:            types.NewParams([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, false, nil),
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -371,3 +383,27 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 		})
 	}
 }
+
+func (suite *IBCTestSuite) TestValidateInitGenesisOrder() {
+	testCases := []struct {
+		name    string
+		order   []string
+		expPass bool
+	}{
+		{"valid order", []string{capabilitytypes.ModuleName, "bank", host.ModuleName, "transfer"}, true},
+		{"capability module is not initialized", []string{host.ModuleName, "transfer"}, false},
+		{"core IBC is not initialized", []string{capabilitytypes.ModuleName, "transfer"}, false},
+		{"IBC application is not initialized", []string{capabilitytypes.ModuleName, host.ModuleName}, false},
+		{"core IBC initialized before the capability module", []string{host.ModuleName, capabilitytypes.ModuleName, "transfer"}, false},
+		{"IBC application initialized before core IBC", []string{capabilitytypes.ModuleName, "transfer", host.ModuleName}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ibc.ValidateInitGenesisOrder(tc.order, "transfer")
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, types.ErrInvalidGenesisOrder, tc.name)
+		}
+	}
+}
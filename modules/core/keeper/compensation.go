@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// compensatePacket resolves the compensation data stored for a packet sent with the
+// SendPacketWithCompensation helper of the channel keeper, if any. The compensation data is
+// deleted and, if the packet failed, passed to the OnCompensatePacket callback of the sending
+// application. No-op for packets sent without a compensation.
+func (k Keeper) compensatePacket(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, failed bool, relayer sdk.AccAddress) error {
+	compensation, found := k.ChannelKeeper.GetPacketCompensation(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.ChannelKeeper.DeletePacketCompensation(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !failed {
+		return nil
+	}
+
+	compensatingModule, ok := cbs.(porttypes.CompensatingModule)
+	if !ok {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "application of port %s does not support packet compensations", packet.SourcePort)
+	}
+
+	if err := compensatingModule.OnCompensatePacket(ctx, packet, compensation, relayer); err != nil {
+		return err
+	}
+
+	channelkeeper.EmitCompensatePacketEvent(ctx, packet)
+	return nil
+}
+
+// isErrorAcknowledgement returns true if the acknowledgement is an ICS-04 error
+// acknowledgement. Acknowledgements which cannot be decoded as ICS-04 acknowledgements
+// are treated as successful, as only the receiving application may interpret them.
+func isErrorAcknowledgement(acknowledgement []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return false
+	}
+
+	return !ack.Success()
+}
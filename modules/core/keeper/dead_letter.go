@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// onTimeoutPacket performs the OnTimeoutPacket application callback. If the application
+// opted in to the dead-letter store with the DeadLetterModule interface, the
+// callback is executed on a cached context and a failing callback records the packet in the
+// dead-letter store instead of failing the timeout. The state changes of a failed callback
+// are discarded. It returns true if the packet was recorded in the dead-letter store.
+func (k Keeper) onTimeoutPacket(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, relayer sdk.AccAddress) (deadLettered bool, err error) {
+	if _, ok := porttypes.GetDeadLetterModule(cbs); !ok {
+		return false, cbs.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := cbs.OnTimeoutPacket(cacheCtx, packet, relayer); err != nil {
+		deadLetter := channeltypes.NewDeadLetterPacket(packet, channeltypes.DeadLetterReason(err), clienttypes.GetSelfHeight(ctx))
+		k.ChannelKeeper.SetDeadLetterPacket(ctx, deadLetter)
+
+		channelkeeper.EmitDeadLetterPacketEvent(ctx, deadLetter)
+		return true, nil
+	}
+
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return false, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetClientGasMultipliers sets the per client type gas multipliers applied to the gas
+// consumed by MsgUpdateClient and MsgBatchUpdateClient. A multiplier greater than one adds a surcharge, a multiplier
+// less than one refunds part of the consumed gas. Client types without a multiplier are
+// charged the gas consumed. The method panics if a multiplier is negative.
+func (k *Keeper) SetClientGasMultipliers(multipliers map[string]sdk.Dec) {
+	for clientType, multiplier := range multipliers {
+		if multiplier.IsNil() || multiplier.IsNegative() {
+			panic(fmt.Errorf("gas multiplier for client type %s cannot be negative: %s", clientType, multiplier))
+		}
+	}
+
+	k.clientGasMultipliers = multipliers
+}
+
+// adjustClientGas adjusts the gas consumed by a client update with the gas multiplier of
+// the provided client type, if any, by consuming the surcharge or refunding the discount.
+func (k Keeper) adjustClientGas(ctx sdk.Context, clientType string, gasConsumed sdk.Gas) {
+	multiplier, ok := k.clientGasMultipliers[clientType]
+	if !ok {
+		return
+	}
+
+	adjusted := multiplier.MulInt64(int64(gasConsumed)).TruncateInt().Uint64()
+	switch {
+	case adjusted > gasConsumed:
+		ctx.GasMeter().ConsumeGas(adjusted-gasConsumed, fmt.Sprintf("%s client update surcharge", clientType))
+	case adjusted < gasConsumed:
+		ctx.GasMeter().RefundGas(gasConsumed-adjusted, fmt.Sprintf("%s client update discount", clientType))
+	}
+}
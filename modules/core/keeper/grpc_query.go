@@ -3,9 +3,17 @@ package keeper
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
 // ClientState implements the IBC QueryServer interface
@@ -23,11 +31,21 @@ func (q Keeper) ConsensusState(c context.Context, req *clienttypes.QueryConsensu
 	return q.ClientKeeper.ConsensusState(c, req)
 }
 
+// TrustedConsensusState implements the IBC QueryServer interface
+func (q Keeper) TrustedConsensusState(c context.Context, req *clienttypes.QueryTrustedConsensusStateRequest) (*clienttypes.QueryTrustedConsensusStateResponse, error) {
+	return q.ClientKeeper.TrustedConsensusState(c, req)
+}
+
 // ConsensusStates implements the IBC QueryServer interface
 func (q Keeper) ConsensusStates(c context.Context, req *clienttypes.QueryConsensusStatesRequest) (*clienttypes.QueryConsensusStatesResponse, error) {
 	return q.ClientKeeper.ConsensusStates(c, req)
 }
 
+// FrozenClientEvidence implements the IBC QueryServer interface
+func (q Keeper) FrozenClientEvidence(c context.Context, req *clienttypes.QueryFrozenClientEvidenceRequest) (*clienttypes.QueryFrozenClientEvidenceResponse, error) {
+	return q.ClientKeeper.FrozenClientEvidence(c, req)
+}
+
 // ClientStatus implements the IBC QueryServer interface
 func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
 	return q.ClientKeeper.ClientStatus(c, req)
@@ -43,6 +61,16 @@ func (q Keeper) UpgradedClientState(c context.Context, req *clienttypes.QueryUpg
 	return q.ClientKeeper.UpgradedClientState(c, req)
 }
 
+// VerifyUpgradePlan implements the IBC QueryServer interface
+func (q Keeper) VerifyUpgradePlan(c context.Context, req *clienttypes.QueryVerifyUpgradePlanRequest) (*clienttypes.QueryVerifyUpgradePlanResponse, error) {
+	return q.ClientKeeper.VerifyUpgradePlan(c, req)
+}
+
+// EstimateUpdateClientGas implements the IBC QueryServer interface
+func (q Keeper) EstimateUpdateClientGas(c context.Context, req *clienttypes.QueryEstimateUpdateClientGasRequest) (*clienttypes.QueryEstimateUpdateClientGasResponse, error) {
+	return q.ClientKeeper.EstimateUpdateClientGas(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (q Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return q.ConnectionKeeper.Connection(c, req)
@@ -68,6 +96,11 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *connectiontypes
 	return q.ConnectionKeeper.ConnectionConsensusState(c, req)
 }
 
+// ConnectionHandshakeStep implements the IBC QueryServer interface
+func (q Keeper) ConnectionHandshakeStep(c context.Context, req *connectiontypes.QueryConnectionHandshakeStepRequest) (*connectiontypes.QueryConnectionHandshakeStepResponse, error) {
+	return q.ConnectionKeeper.ConnectionHandshakeStep(c, req)
+}
+
 // Channel implements the IBC QueryServer interface
 func (q Keeper) Channel(c context.Context, req *channeltypes.QueryChannelRequest) (*channeltypes.QueryChannelResponse, error) {
 	return q.ChannelKeeper.Channel(c, req)
@@ -83,6 +116,26 @@ func (q Keeper) ConnectionChannels(c context.Context, req *channeltypes.QueryCon
 	return q.ChannelKeeper.ConnectionChannels(c, req)
 }
 
+// PacketDataSchemas implements the IBC QueryServer interface
+func (q Keeper) PacketDataSchemas(c context.Context, req *channeltypes.QueryPacketDataSchemasRequest) (*channeltypes.QueryPacketDataSchemasResponse, error) {
+	return q.ChannelKeeper.PacketDataSchemas(c, req)
+}
+
+// PacketDataSchema implements the IBC QueryServer interface
+func (q Keeper) PacketDataSchema(c context.Context, req *channeltypes.QueryPacketDataSchemaRequest) (*channeltypes.QueryPacketDataSchemaResponse, error) {
+	return q.ChannelKeeper.PacketDataSchema(c, req)
+}
+
+// ChannelsByClient implements the IBC QueryServer interface
+func (q Keeper) ChannelsByClient(c context.Context, req *channeltypes.QueryChannelsByClientRequest) (*channeltypes.QueryChannelsByClientResponse, error) {
+	return q.ChannelKeeper.ChannelsByClient(c, req)
+}
+
+// DuplicateChannels implements the IBC QueryServer interface
+func (q Keeper) DuplicateChannels(c context.Context, req *channeltypes.QueryDuplicateChannelsRequest) (*channeltypes.QueryDuplicateChannelsResponse, error) {
+	return q.ChannelKeeper.DuplicateChannels(c, req)
+}
+
 // ChannelClientState implements the IBC QueryServer interface
 func (q Keeper) ChannelClientState(c context.Context, req *channeltypes.QueryChannelClientStateRequest) (*channeltypes.QueryChannelClientStateResponse, error) {
 	return q.ChannelKeeper.ChannelClientState(c, req)
@@ -103,6 +156,11 @@ func (q Keeper) PacketCommitments(c context.Context, req *channeltypes.QueryPack
 	return q.ChannelKeeper.PacketCommitments(c, req)
 }
 
+// PrioritizedPackets implements the IBC QueryServer interface
+func (q Keeper) PrioritizedPackets(c context.Context, req *channeltypes.QueryPrioritizedPacketsRequest) (*channeltypes.QueryPrioritizedPacketsResponse, error) {
+	return q.ChannelKeeper.PrioritizedPackets(c, req)
+}
+
 // PacketReceipt implements the IBC QueryServer interface
 func (q Keeper) PacketReceipt(c context.Context, req *channeltypes.QueryPacketReceiptRequest) (*channeltypes.QueryPacketReceiptResponse, error) {
 	return q.ChannelKeeper.PacketReceipt(c, req)
@@ -118,17 +176,159 @@ func (q Keeper) PacketAcknowledgements(c context.Context, req *channeltypes.Quer
 	return q.ChannelKeeper.PacketAcknowledgements(c, req)
 }
 
+// PacketAcknowledgementsByRange implements the IBC QueryServer interface
+func (q Keeper) PacketAcknowledgementsByRange(c context.Context, req *channeltypes.QueryPacketAcknowledgementsByRangeRequest) (*channeltypes.QueryPacketAcknowledgementsByRangeResponse, error) {
+	return q.ChannelKeeper.PacketAcknowledgementsByRange(c, req)
+}
+
 // UnreceivedPackets implements the IBC QueryServer interface
 func (q Keeper) UnreceivedPackets(c context.Context, req *channeltypes.QueryUnreceivedPacketsRequest) (*channeltypes.QueryUnreceivedPacketsResponse, error) {
 	return q.ChannelKeeper.UnreceivedPackets(c, req)
 }
 
+// UnreceivedPacketsByRange implements the IBC QueryServer interface
+func (q Keeper) UnreceivedPacketsByRange(c context.Context, req *channeltypes.QueryUnreceivedPacketsByRangeRequest) (*channeltypes.QueryUnreceivedPacketsByRangeResponse, error) {
+	return q.ChannelKeeper.UnreceivedPacketsByRange(c, req)
+}
+
 // UnreceivedAcks implements the IBC QueryServer interface
 func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnreceivedAcksRequest) (*channeltypes.QueryUnreceivedAcksResponse, error) {
 	return q.ChannelKeeper.UnreceivedAcks(c, req)
 }
 
+// SendPacketEvents implements the IBC QueryServer interface
+func (q Keeper) SendPacketEvents(c context.Context, req *channeltypes.QuerySendPacketEventsRequest) (*channeltypes.QuerySendPacketEventsResponse, error) {
+	return q.ChannelKeeper.SendPacketEvents(c, req)
+}
+
+// WriteAcknowledgementEvents implements the IBC QueryServer interface
+func (q Keeper) WriteAcknowledgementEvents(c context.Context, req *channeltypes.QueryWriteAcknowledgementEventsRequest) (*channeltypes.QueryWriteAcknowledgementEventsResponse, error) {
+	return q.ChannelKeeper.WriteAcknowledgementEvents(c, req)
+}
+
 // NextSequenceReceive implements the IBC QueryServer interface
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// DeadLetterPacket implements the IBC QueryServer interface
+func (q Keeper) DeadLetterPacket(c context.Context, req *channeltypes.QueryDeadLetterPacketRequest) (*channeltypes.QueryDeadLetterPacketResponse, error) {
+	return q.ChannelKeeper.DeadLetterPacket(c, req)
+}
+
+// DeadLetterPackets implements the IBC QueryServer interface
+func (q Keeper) DeadLetterPackets(c context.Context, req *channeltypes.QueryDeadLetterPacketsRequest) (*channeltypes.QueryDeadLetterPacketsResponse, error) {
+	return q.ChannelKeeper.DeadLetterPackets(c, req)
+}
+
+// PacketLatency implements the IBC QueryServer interface
+func (q Keeper) PacketLatency(c context.Context, req *channeltypes.QueryPacketLatencyRequest) (*channeltypes.QueryPacketLatencyResponse, error) {
+	return q.ChannelKeeper.PacketLatency(c, req)
+}
+
+// ChannelHandshakeStep implements the IBC QueryServer interface
+func (q Keeper) ChannelHandshakeStep(c context.Context, req *channeltypes.QueryChannelHandshakeStepRequest) (*channeltypes.QueryChannelHandshakeStepResponse, error) {
+	return q.ChannelKeeper.ChannelHandshakeStep(c, req)
+}
+
+// QuarantinedChannels implements the IBC QueryServer interface
+func (q Keeper) QuarantinedChannels(c context.Context, req *channeltypes.QueryQuarantinedChannelsRequest) (*channeltypes.QueryQuarantinedChannelsResponse, error) {
+	return q.ChannelKeeper.QuarantinedChannels(c, req)
+}
+
+// PendingAsyncAcknowledgements implements the IBC QueryServer interface
+func (q Keeper) PendingAsyncAcknowledgements(c context.Context, req *channeltypes.QueryPendingAsyncAcknowledgementsRequest) (*channeltypes.QueryPendingAsyncAcknowledgementsResponse, error) {
+	return q.ChannelKeeper.PendingAsyncAcknowledgements(c, req)
+}
+
+// Upgrade implements the IBC QueryServer interface
+func (q Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeRequest) (*channeltypes.QueryUpgradeResponse, error) {
+	return q.ChannelKeeper.Upgrade(c, req)
+}
+
+// UpgradeError implements the IBC QueryServer interface
+func (q Keeper) UpgradeError(c context.Context, req *channeltypes.QueryUpgradeErrorRequest) (*channeltypes.QueryUpgradeErrorResponse, error) {
+	return q.ChannelKeeper.UpgradeError(c, req)
+}
+
+// MiddlewareStack implements the IBC QueryServer interface. The layers of the stack are resolved
+// from the route of the module owning the channel, see porttypes.ResolveReceiveStack and
+// porttypes.ResolveSendStack.
+func (q Keeper) MiddlewareStack(c context.Context, req *channeltypes.QueryMiddlewareStackRequest) (*channeltypes.QueryMiddlewareStackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.ChannelKeeper.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	module, _, err := q.ChannelKeeper.LookupModuleByChannel(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if q.Router == nil {
+		return nil, status.Error(codes.Unavailable, "IBC router is not set")
+	}
+
+	cbs, ok := q.Router.GetRoute(module)
+	if !ok {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module).Error(),
+		)
+	}
+
+	// only the base application takes part in the version negotiation, the middleware wrapping
+	// it pass the channel version through unchanged
+	receiveStack := porttypes.ResolveReceiveStack(cbs)
+	receiveLayers := make([]channeltypes.MiddlewareLayer, len(receiveStack))
+	for i, layer := range receiveStack {
+		receiveLayers[i] = channeltypes.MiddlewareLayer{Name: porttypes.GetStackLayerName(layer)}
+	}
+	receiveLayers[len(receiveLayers)-1].Version = channel.Version
+
+	sendStack := porttypes.ResolveSendStack(cbs)
+	sendLayers := make([]channeltypes.MiddlewareLayer, len(sendStack))
+	for i, layer := range sendStack {
+		sendLayers[i] = channeltypes.MiddlewareLayer{Name: porttypes.GetStackLayerName(layer)}
+	}
+
+	return &channeltypes.QueryMiddlewareStackResponse{
+		Route:         module,
+		ReceiveLayers: receiveLayers,
+		SendLayers:    sendLayers,
+	}, nil
+}
+
+// ExportState implements the IBC StateQueryServer interface. All state is read from the
+// same store version so that the returned export is consistent.
+func (q Keeper) ExportState(c context.Context, req *coretypes.QueryExportStateRequest) (*coretypes.QueryExportStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &coretypes.QueryExportStateResponse{
+		Clients:        q.ClientKeeper.GetAllGenesisClients(ctx),
+		Connections:    q.ConnectionKeeper.GetAllConnections(ctx),
+		Channels:       q.ChannelKeeper.GetAllChannels(ctx),
+		PendingPackets: q.ChannelKeeper.GetAllPacketCommitments(ctx),
+		Height:         ctx.BlockHeight(),
+	}, nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	packetforwardtypes "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	ratelimitingtypes "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestQueryMiddlewareStack() {
+	var (
+		req    *channeltypes.QueryMiddlewareStackRequest
+		expRes *channeltypes.QueryMiddlewareStackResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &channeltypes.QueryMiddlewareStackRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &channeltypes.QueryMiddlewareStackRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"success: transfer stack",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointA.ChannelConfig.Version = transfertypes.Version
+				path.EndpointB.ChannelConfig.Version = transfertypes.Version
+				suite.coordinator.Setup(path)
+
+				req = &channeltypes.QueryMiddlewareStackRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+
+				expRes = &channeltypes.QueryMiddlewareStackResponse{
+					Route: transfertypes.ModuleName,
+					ReceiveLayers: []channeltypes.MiddlewareLayer{
+						{Name: packetforwardtypes.ModuleName},
+						{Name: ratelimitingtypes.ModuleName},
+						{Name: transfertypes.ModuleName, Version: transfertypes.Version},
+					},
+					SendLayers: []channeltypes.MiddlewareLayer{
+						{Name: ratelimitingtypes.ModuleName},
+						{Name: host.ModuleName},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: layers without name and send stack",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				req = &channeltypes.QueryMiddlewareStackRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+
+				expRes = &channeltypes.QueryMiddlewareStackResponse{
+					Route: ibcmock.ModuleName,
+					ReceiveLayers: []channeltypes.MiddlewareLayer{
+						{Name: "mock.IBCModule", Version: ibcmock.Version},
+					},
+					SendLayers: []channeltypes.MiddlewareLayer{},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.App.GetIBCKeeper().MiddlewareStack(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExportState() {
+	var req *coretypes.QueryExportStateRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &coretypes.QueryExportStateRequest{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.App.GetIBCKeeper().ExportState(sdk.WrapSDKContext(ctx), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(ctx.BlockHeight(), res.Height)
+
+				clientState := path.EndpointA.GetClientState()
+				suite.Require().Contains(res.Clients, clienttypes.NewIdentifiedClientState(path.EndpointA.ClientID, clientState))
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Contains(res.Connections, connectiontypes.NewIdentifiedConnection(path.EndpointA.ConnectionID, connection))
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal([]channeltypes.IdentifiedChannel{
+					channeltypes.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel),
+				}, res.Channels)
+
+				commitment := channeltypes.CommitPacket(suite.chainA.App.AppCodec(), packet)
+				suite.Require().Equal([]channeltypes.PacketState{
+					channeltypes.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), commitment),
+				}, res.PendingPackets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
@@ -23,13 +27,22 @@ type Keeper struct {
 	// implements gRPC QueryServer interface
 	types.QueryServer
 
-	cdc codec.BinaryCodec
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
 
 	ClientKeeper     clientkeeper.Keeper
 	ConnectionKeeper connectionkeeper.Keeper
 	ChannelKeeper    channelkeeper.Keeper
 	PortKeeper       portkeeper.Keeper
 	Router           *porttypes.Router
+
+	clientGasMultipliers map[string]sdk.Dec
+	clientHooks          clienttypes.ClientHooks
+	relayerHooks         types.RelayerHooks
+
+	// the address allowed to sign the messages of the IBC authority, the gov module account
+	// by default
+	authority string
 }
 
 // NewKeeper creates a new ibc Keeper
@@ -43,6 +56,7 @@ func NewKeeper(
 	if !paramSpace.HasKeyTable() {
 		keyTable := clienttypes.ParamKeyTable()
 		keyTable.RegisterParamSet(&connectiontypes.Params{})
+		keyTable.RegisterParamSet(&types.Params{})
 		paramSpace = paramSpace.WithKeyTable(keyTable)
 	}
 
@@ -53,10 +67,12 @@ func NewKeeper(
 
 	return &Keeper{
 		cdc:              cdc,
+		paramSpace:       paramSpace,
 		ClientKeeper:     clientKeeper,
 		ConnectionKeeper: connectionKeeper,
 		ChannelKeeper:    channelKeeper,
 		PortKeeper:       portKeeper,
+		authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
 
@@ -65,6 +81,48 @@ func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
 }
 
+// SetClientHooks sets the client hooks which are called on the client events processed by
+// the IBC message server. The method panics if the hooks have already been set.
+func (k *Keeper) SetClientHooks(hooks clienttypes.ClientHooks) *Keeper {
+	if k.clientHooks != nil {
+		panic("cannot set client hooks twice")
+	}
+
+	k.clientHooks = hooks
+	return k
+}
+
+// SetRelayerHooks sets the relayer hooks which are called on the relaying messages processed
+// by the IBC message server. The gas consumed by the hooks on client updates is added to the
+// client update gas estimates. The method panics if the hooks have already been set.
+func (k *Keeper) SetRelayerHooks(hooks types.RelayerHooks) *Keeper {
+	if k.relayerHooks != nil {
+		panic("cannot set relayer hooks twice")
+	}
+
+	k.relayerHooks = hooks
+	k.ClientKeeper.SetUpdateGasOverhead(hooks.ClientUpdateGas())
+	return k
+}
+
+// SetAuthority sets the address allowed to sign the messages of the IBC authority, such as
+// MsgIBCSoftwareUpgrade, which defaults to the gov module account. As the gov module of the
+// Cosmos SDK v0.45 cannot execute messages, the messages of the IBC authority may only be
+// used once chains set an authority able to sign transactions.
+func (k *Keeper) SetAuthority(authority string) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
+	k.authority = authority
+	return k
+}
+
+// GetAuthority returns the address allowed to sign the messages of the IBC authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetRouter sets the Router in IBC Keeper and seals it. The method panics if
 // there is an existing router that's already sealed.
 func (k *Keeper) SetRouter(rtr *porttypes.Router) {
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// OpenLocalChannel opens a channel between two ports bound on the running chain over the
+// localhost client. The four steps of the channel handshake are executed at once through the
+// regular message handlers, so both modules receive the same callbacks as for a channel to
+// a counterparty chain. The localhost client and its connection are created if they do not
+// exist, which requires the localhost client type to be allowed. The identifiers of the
+// channel ends on the given port and on the counterparty port are returned.
+func (k Keeper) OpenLocalChannel(
+	ctx sdk.Context, order channeltypes.Order, portID, counterpartyPortID, version, signer string,
+) (string, string, error) {
+	connectionID, err := k.localConnection(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	connectionHops := []string{connectionID}
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+
+	initRes, err := k.ChannelOpenInit(sdk.WrapSDKContext(ctx), channeltypes.NewMsgChannelOpenInit(
+		portID, version, order, connectionHops, counterpartyPortID, signer,
+	))
+	if err != nil {
+		return "", "", err
+	}
+	channelID := initRes.ChannelId
+
+	channel, _ := k.ChannelKeeper.GetChannel(ctx, portID, channelID)
+
+	// the channel identifier is not returned by the open try handler
+	counterpartyChannelID := channeltypes.FormatChannelIdentifier(k.ChannelKeeper.GetNextChannelSequence(ctx))
+	if _, err := k.ChannelOpenTry(sdk.WrapSDKContext(ctx), channeltypes.NewMsgChannelOpenTry(
+		counterpartyPortID, "", channel.Version, order, connectionHops, portID, channelID, channel.Version, nil, proofHeight, signer,
+	)); err != nil {
+		return "", "", err
+	}
+
+	counterpartyChannel, _ := k.ChannelKeeper.GetChannel(ctx, counterpartyPortID, counterpartyChannelID)
+
+	if _, err := k.ChannelOpenAck(sdk.WrapSDKContext(ctx), channeltypes.NewMsgChannelOpenAck(
+		portID, channelID, counterpartyChannelID, counterpartyChannel.Version, nil, proofHeight, signer,
+	)); err != nil {
+		return "", "", err
+	}
+
+	if _, err := k.ChannelOpenConfirm(sdk.WrapSDKContext(ctx), channeltypes.NewMsgChannelOpenConfirm(
+		counterpartyPortID, counterpartyChannelID, nil, proofHeight, signer,
+	)); err != nil {
+		return "", "", err
+	}
+
+	return channelID, counterpartyChannelID, nil
+}
+
+// RelayLocalPacket delivers a packet sent over a local channel to its destination module
+// through the regular receive packet handler. If the destination module writes an
+// acknowledgement synchronously, it is relayed back to the sending module at once. Packets
+// written asynchronously are acknowledged with RelayLocalAcknowledgement.
+func (k Keeper) RelayLocalPacket(ctx sdk.Context, packet channeltypes.Packet, signer string) error {
+	if err := k.checkLocalChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
+	// the acknowledgement is only returned through the write acknowledgement event
+	eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+	if _, err := k.RecvPacket(sdk.WrapSDKContext(eventCtx), channeltypes.NewMsgRecvPacket(
+		packet, nil, clienttypes.GetSelfHeight(ctx), signer,
+	)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(eventCtx.EventManager().Events())
+
+	ack, found := writtenAcknowledgement(eventCtx.EventManager().Events(), packet)
+	if !found {
+		return nil
+	}
+
+	return k.RelayLocalAcknowledgement(ctx, packet, ack, signer)
+}
+
+// RelayLocalAcknowledgement delivers the acknowledgement written for a packet sent over a
+// local channel to the sending module through the regular acknowledgement handler.
+func (k Keeper) RelayLocalAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, signer string) error {
+	if err := k.checkLocalChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return err
+	}
+
+	_, err := k.Acknowledgement(sdk.WrapSDKContext(ctx), channeltypes.NewMsgAcknowledgement(
+		packet, acknowledgement, nil, clienttypes.GetSelfHeight(ctx), signer,
+	))
+	return err
+}
+
+// localConnection returns the identifier of the open connection of the localhost client to
+// itself, creating the localhost client and the connection if they do not exist.
+func (k Keeper) localConnection(ctx sdk.Context) (string, error) {
+	if _, found := k.ClientKeeper.GetClientState(ctx, exported.Localhost); !found {
+		if err := k.ClientKeeper.CreateLocalhostClient(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	connectionPaths, _ := k.ConnectionKeeper.GetClientConnectionPaths(ctx, exported.Localhost)
+	for _, connectionID := range connectionPaths {
+		connection, found := k.ConnectionKeeper.GetConnection(ctx, connectionID)
+		if found && connection.State == connectiontypes.OPEN && connection.Counterparty.ConnectionId == connectionID {
+			return connectionID, nil
+		}
+	}
+
+	// the localhost client verifies the state of the running chain, the connection is its
+	// own counterparty and is opened without a handshake
+	connectionID := k.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	prefix := commitmenttypes.NewMerklePrefix(k.ConnectionKeeper.GetCommitmentPrefix().Bytes())
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, exported.Localhost,
+		connectiontypes.NewCounterparty(exported.Localhost, connectionID, prefix),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+	)
+
+	k.ConnectionKeeper.SetConnection(ctx, connectionID, connection)
+	k.ConnectionKeeper.SetClientConnectionPaths(ctx, exported.Localhost, append(connectionPaths, connectionID))
+
+	return connectionID, nil
+}
+
+// checkLocalChannel returns an error if the given channel does not use the localhost client.
+func (k Keeper) checkLocalChannel(ctx sdk.Context, portID, channelID string) error {
+	clientID, _, err := k.ChannelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if clientID != exported.Localhost {
+		return sdkerrors.Wrapf(coretypes.ErrNotLocalChannel, "channel (%s, %s) uses client %s", portID, channelID, clientID)
+	}
+
+	return nil
+}
+
+// writtenAcknowledgement returns the acknowledgement of the given packet from the write
+// acknowledgement events.
+func writtenAcknowledgement(events sdk.Events, packet channeltypes.Packet) ([]byte, bool) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		if attributes[channeltypes.AttributeKeyDstPort] != packet.GetDestPort() ||
+			attributes[channeltypes.AttributeKeyDstChannel] != packet.GetDestChannel() ||
+			attributes[channeltypes.AttributeKeySequence] != strconv.FormatUint(packet.GetSequence(), 10) {
+			continue
+		}
+
+		ack, err := hex.DecodeString(attributes[channeltypes.AttributeKeyAckHex])
+		if err != nil {
+			return nil, false
+		}
+
+		return ack, true
+	}
+
+	return nil, false
+}
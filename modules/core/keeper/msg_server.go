@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	metrics "github.com/armon/go-metrics"
 
@@ -11,8 +12,10 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
@@ -24,6 +27,10 @@ var _ channeltypes.MsgServer = Keeper{}
 func (k Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateClient) (*clienttypes.MsgCreateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	clientState, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -45,22 +52,112 @@ func (k Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateCl
 func (k Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the gas multiplier of the client type applies to all the gas consumed by the handler
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	header, err := clienttypes.UnpackHeader(msg.Header)
 	if err != nil {
 		return nil, err
 	}
 
+	// the updates of clients with batched updates are applied at the end of the block
+	if k.ClientKeeper.IsBatchedUpdateClient(ctx, msg.ClientId) {
+		if err = k.ClientKeeper.QueueClientUpdate(ctx, msg.ClientId, header, msg.Signer); err != nil {
+			return nil, err
+		}
+
+		return &clienttypes.MsgUpdateClientResponse{}, nil
+	}
+
 	if err = k.ClientKeeper.UpdateClient(ctx, msg.ClientId, header); err != nil {
 		return nil, err
 	}
 
+	// the client state is known to exist after a successful update
+	clientState, _ := k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+
+	// the client must have been active to be updated, a frozen client is frozen by the header
+	if clientState.Status(ctx, k.ClientKeeper.ClientStore(ctx, msg.ClientId), k.cdc) == exported.Frozen {
+		if err := k.afterClientFrozen(ctx, msg.ClientId, msg.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.afterClientUpdated(ctx, msg.ClientId, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.adjustClientGas(ctx, clientState.ClientType(), ctx.GasMeter().GasConsumed()-gasBefore)
+
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
+// BatchUpdateClient defines a rpc handler method for MsgBatchUpdateClient.
+func (k Keeper) BatchUpdateClient(goCtx context.Context, msg *clienttypes.MsgBatchUpdateClient) (*clienttypes.MsgBatchUpdateClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the gas multiplier of the client type applies to all the gas consumed by the handler
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	headers := make([]exported.Header, len(msg.Headers))
+	for i, anyHeader := range msg.Headers {
+		header, err := clienttypes.UnpackHeader(anyHeader)
+		if err != nil {
+			return nil, err
+		}
+		headers[i] = header
+	}
+
+	// the updates of clients with batched updates are applied at the end of the block
+	if k.ClientKeeper.IsBatchedUpdateClient(ctx, msg.ClientId) {
+		for _, header := range headers {
+			if err := k.ClientKeeper.QueueClientUpdate(ctx, msg.ClientId, header, msg.Signer); err != nil {
+				return nil, err
+			}
+		}
+
+		return &clienttypes.MsgBatchUpdateClientResponse{}, nil
+	}
+
+	if err := k.ClientKeeper.BatchUpdateClient(ctx, msg.ClientId, headers); err != nil {
+		return nil, err
+	}
+
+	// the client state is known to exist after a successful update
+	clientState, _ := k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+
+	// the client must have been active to be updated, a frozen client is frozen by one of the headers
+	if clientState.Status(ctx, k.ClientKeeper.ClientStore(ctx, msg.ClientId), k.cdc) == exported.Frozen {
+		if err := k.afterClientFrozen(ctx, msg.ClientId, msg.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.afterClientUpdated(ctx, msg.ClientId, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.adjustClientGas(ctx, clientState.ClientType(), ctx.GasMeter().GasConsumed()-gasBefore)
+
+	return &clienttypes.MsgBatchUpdateClientResponse{}, nil
+}
+
 // UpgradeClient defines a rpc handler method for MsgUpgradeClient.
 func (k Keeper) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgradeClient) (*clienttypes.MsgUpgradeClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	upgradedClient, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -82,6 +179,10 @@ func (k Keeper) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgrade
 func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSubmitMisbehaviour) (*clienttypes.MsgSubmitMisbehaviourResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	misbehaviour, err := clienttypes.UnpackMisbehaviour(msg.Misbehaviour)
 	if err != nil {
 		return nil, err
@@ -91,13 +192,130 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 		return nil, sdkerrors.Wrap(err, "failed to process misbehaviour for IBC client")
 	}
 
+	if err := k.afterClientFrozen(ctx, misbehaviour.GetClientID(), msg.Signer); err != nil {
+		return nil, err
+	}
+
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// RecoverClient defines a rpc handler method for MsgRecoverClient.
+func (k Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecoverClient) (*clienttypes.MsgRecoverClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the substitute only needs to match the parameters of the subject, which any account can do
+	// with a client of its own, so recoveries are restricted to the IBC authority by default
+	if err := k.checkAuthorityMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ClientKeeper.RecoverClient(ctx, msg.SubjectClientId, msg.SubstituteClientId); err != nil {
+		return nil, sdkerrors.Wrap(err, "client recovery failed")
+	}
+
+	return &clienttypes.MsgRecoverClientResponse{}, nil
+}
+
+// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
+func (k Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	upgradedClientState, err := clienttypes.UnpackClientState(msg.UpgradedClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ClientKeeper.ScheduleIBCSoftwareUpgrade(ctx, msg.Plan, upgradedClientState); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to schedule upgrade")
+	}
+
+	return &clienttypes.MsgIBCSoftwareUpgradeResponse{}, nil
+}
+
+// afterClientFrozen notifies the applications of all channels built on top of the frozen
+// client and calls the AfterClientFrozen client hook, if set, with the submitter of the
+// misbehaviour which froze the client.
+func (k Keeper) afterClientFrozen(ctx sdk.Context, clientID, signer string) error {
+	k.notifyClientFrozen(ctx, clientID)
+
+	if k.clientHooks == nil {
+		return nil
+	}
+
+	submitter, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid misbehaviour submitter %s: %s", signer, err)
+	}
+
+	k.clientHooks.AfterClientFrozen(ctx, clientID, submitter)
+	return nil
+}
+
+// afterClientUpdated calls the AfterClientUpdated relayer hook, if set, with the relayer
+// which updated the client.
+func (k Keeper) afterClientUpdated(ctx sdk.Context, clientID, signer string) error {
+	if k.relayerHooks == nil {
+		return nil
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid client update relayer %s: %s", signer, err)
+	}
+
+	k.relayerHooks.AfterClientUpdated(ctx, clientID, relayer)
+	return nil
+}
+
+// afterPacketRelayed calls the AfterPacketRelayed relayer hook, if set.
+func (k Keeper) afterPacketRelayed(ctx sdk.Context, msg sdk.Msg, relayer sdk.AccAddress, redundant bool) {
+	if k.relayerHooks == nil {
+		return
+	}
+
+	k.relayerHooks.AfterPacketRelayed(ctx, msg, relayer, redundant)
+}
+
+// notifyClientFrozen calls the OnClientFrozen callback of the application of every channel,
+// which is not closed, whose connection is built on top of the frozen client.
+func (k Keeper) notifyClientFrozen(ctx sdk.Context, clientID string) {
+	k.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.State == channeltypes.CLOSED {
+			return false
+		}
+
+		connection, found := k.ConnectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found || connection.GetClientID() != clientID {
+			return false
+		}
+
+		module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, channel.PortId, channel.ChannelId)
+		if err != nil {
+			return false
+		}
+
+		cbs, ok := k.Router.GetRoute(module)
+		if !ok {
+			return false
+		}
+
+		cbs.OnClientFrozen(ctx, channel.PortId, channel.ChannelId)
+		return false
+	})
+}
+
 // ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	if _, err := k.ConnectionKeeper.ConnOpenInit(ctx, msg.ClientId, msg.Counterparty, msg.Version, msg.DelayPeriod); err != nil {
 		return nil, sdkerrors.Wrap(err, "connection handshake open init failed")
 	}
@@ -109,6 +327,10 @@ func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.M
 func (k Keeper) ConnectionOpenTry(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenTry) (*connectiontypes.MsgConnectionOpenTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	targetClient, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -128,6 +350,11 @@ func (k Keeper) ConnectionOpenTry(goCtx context.Context, msg *connectiontypes.Ms
 // ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
 func (k Keeper) ConnectionOpenAck(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenAck) (*connectiontypes.MsgConnectionOpenAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	targetClient, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -148,6 +375,10 @@ func (k Keeper) ConnectionOpenAck(goCtx context.Context, msg *connectiontypes.Ms
 func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenConfirm) (*connectiontypes.MsgConnectionOpenConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	if err := k.ConnectionKeeper.ConnOpenConfirm(
 		ctx, msg.ConnectionId, msg.ProofAck, msg.ProofHeight,
 	); err != nil {
@@ -163,6 +394,14 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChannelOpenInit) (*channeltypes.MsgChannelOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkChannelOpenAllowed(ctx, msg.PortId, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by port capability
 	module, portCap, err := k.PortKeeper.LookupModuleByPort(ctx, msg.PortId)
 	if err != nil {
@@ -203,6 +442,14 @@ func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChan
 func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChannelOpenTry) (*channeltypes.MsgChannelOpenTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkChannelOpenAllowed(ctx, msg.PortId, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by port capability
 	module, portCap, err := k.PortKeeper.LookupModuleByPort(ctx, msg.PortId)
 	if err != nil {
@@ -241,6 +488,10 @@ func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChann
 func (k Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChannelOpenAck) (*channeltypes.MsgChannelOpenAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
@@ -277,6 +528,10 @@ func (k Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChann
 func (k Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.MsgChannelOpenConfirm) (*channeltypes.MsgChannelOpenConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
@@ -308,6 +563,11 @@ func (k Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.MsgC
 // ChannelCloseInit defines a rpc handler method for MsgChannelCloseInit.
 func (k Keeper) ChannelCloseInit(goCtx context.Context, msg *channeltypes.MsgChannelCloseInit) (*channeltypes.MsgChannelCloseInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
@@ -336,6 +596,10 @@ func (k Keeper) ChannelCloseInit(goCtx context.Context, msg *channeltypes.MsgCha
 func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.MsgChannelCloseConfirm) (*channeltypes.MsgChannelCloseConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
@@ -352,7 +616,7 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 		return nil, sdkerrors.Wrap(err, "channel close confirm callback failed")
 	}
 
-	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight)
+	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake close confirm failed")
 	}
@@ -364,6 +628,10 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
@@ -385,41 +653,49 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	//
 	// If the packet was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
-	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.RecvPacket(cacheCtx, cap, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
-
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn, err := k.verifyPacketProof(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel, msg.ProofHeight, func(cacheCtx sdk.Context, proofHeight exported.Height) error {
+		return k.ChannelKeeper.RecvPacket(cacheCtx, cap, msg.Packet, msg.ProofCommitment, proofHeight)
+	})
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
+		k.afterPacketRelayed(ctx, msg, relayer, true)
 		return &channeltypes.MsgRecvPacketResponse{}, nil // no-op
 	default:
 		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
 
-	// Perform application logic callback
-	//
-	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	// Events from callback are emitted regardless of acknowledgement success
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-	if ack == nil || ack.Success() {
-		// write application state changes for asynchronous and successful acknowledgements
-		writeFn()
+	var ack exported.Acknowledgement
+	if k.ChannelKeeper.IsChannelQuarantined(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel) {
+		// packets received on a quarantined channel are not passed to the application, they are
+		// acknowledged with a retryable error so that they may be sent again once it is released
+		ack = channeltypes.NewRetryableErrorAcknowledgement(channeltypes.ErrChannelQuarantined)
+	} else {
+		// Perform application logic callback
+		//
+		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+		cacheCtx, writeFn := ctx.CacheContext()
+		ack = cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		// Events from callback are emitted regardless of acknowledgement success
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if ack == nil || ack.Success() {
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+		}
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
-	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
-	// acknowledgement is nil.
+	// NOTE: IBC applications modules may call the WriteAsyncAcknowledgement asynchronously if the
+	// acknowledgement is nil, the packet is recorded as awaiting an acknowledgement.
 	if ack != nil {
 		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, msg.Packet, ack); err != nil {
 			return nil, err
 		}
+	} else {
+		k.ChannelKeeper.RecordAsyncAcknowledgement(ctx, msg.Packet)
 	}
 
 	defer func() {
@@ -435,6 +711,8 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		)
 	}()
 
+	k.afterPacketRelayed(ctx, msg, relayer, false)
+
 	return &channeltypes.MsgRecvPacketResponse{}, nil
 }
 
@@ -442,6 +720,10 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*channeltypes.MsgTimeoutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
@@ -463,27 +745,33 @@ func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*c
 	//
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
-	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutPacket(cacheCtx, msg.Packet, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
-
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn, err := k.verifyPacketProof(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight, func(cacheCtx sdk.Context, proofHeight exported.Height) error {
+		return k.ChannelKeeper.TimeoutPacket(cacheCtx, msg.Packet, msg.ProofUnreceived, proofHeight, msg.NextSequenceRecv)
+	})
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
+		k.afterPacketRelayed(ctx, msg, relayer, true)
 		return &channeltypes.MsgTimeoutResponse{}, nil // no-op
 	default:
 		return nil, sdkerrors.Wrap(err, "timeout packet verification failed")
 	}
 
 	// Perform application logic callback
-	err = cbs.OnTimeoutPacket(ctx, msg.Packet, relayer)
+	deadLettered, err := k.onTimeoutPacket(ctx, cbs, msg.Packet, relayer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "timeout packet callback failed")
 	}
 
+	// the compensation of a dead-lettered packet is deferred until the packet is reclaimed
+	if !deadLettered {
+		if err := k.compensatePacket(ctx, cbs, msg.Packet, true, relayer); err != nil {
+			return nil, sdkerrors.Wrap(err, "compensate packet callback failed")
+		}
+	}
+
 	// Delete packet commitment
 	if err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
 		return nil, err
@@ -503,6 +791,8 @@ func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*c
 		)
 	}()
 
+	k.afterPacketRelayed(ctx, msg, relayer, false)
+
 	return &channeltypes.MsgTimeoutResponse{}, nil
 }
 
@@ -510,6 +800,10 @@ func (k Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*c
 func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeoutOnClose) (*channeltypes.MsgTimeoutOnCloseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
@@ -531,16 +825,15 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	//
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
-	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
-
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn, err := k.verifyPacketProof(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight, func(cacheCtx sdk.Context, proofHeight exported.Height) error {
+		return k.ChannelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, proofHeight, msg.NextSequenceRecv, msg.CounterpartyUpgradeSequence)
+	})
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
+		k.afterPacketRelayed(ctx, msg, relayer, true)
 		return &channeltypes.MsgTimeoutOnCloseResponse{}, nil // no-op
 	default:
 		return nil, sdkerrors.Wrap(err, "timeout on close packet verification failed")
@@ -550,11 +843,18 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	//
 	// NOTE: MsgTimeout and MsgTimeoutOnClose use the same "OnTimeoutPacket"
 	// application logic callback.
-	err = cbs.OnTimeoutPacket(ctx, msg.Packet, relayer)
+	deadLettered, err := k.onTimeoutPacket(ctx, cbs, msg.Packet, relayer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "timeout packet callback failed")
 	}
 
+	// the compensation of a dead-lettered packet is deferred until the packet is reclaimed
+	if !deadLettered {
+		if err := k.compensatePacket(ctx, cbs, msg.Packet, true, relayer); err != nil {
+			return nil, sdkerrors.Wrap(err, "compensate packet callback failed")
+		}
+	}
+
 	// Delete packet commitment
 	if err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet); err != nil {
 		return nil, err
@@ -574,6 +874,8 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 		)
 	}()
 
+	k.afterPacketRelayed(ctx, msg, relayer, false)
+
 	return &channeltypes.MsgTimeoutOnCloseResponse{}, nil
 }
 
@@ -581,6 +883,10 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAcknowledgement) (*channeltypes.MsgAcknowledgementResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
@@ -602,16 +908,15 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	//
 	// If the acknowledgement was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
-	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.AcknowledgePacket(cacheCtx, cap, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
-
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn, err := k.verifyPacketProof(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight, func(cacheCtx sdk.Context, proofHeight exported.Height) error {
+		return k.ChannelKeeper.AcknowledgePacket(cacheCtx, cap, msg.Packet, msg.Acknowledgement, msg.ProofAcked, proofHeight)
+	})
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
+		k.afterPacketRelayed(ctx, msg, relayer, true)
 		return &channeltypes.MsgAcknowledgementResponse{}, nil // no-op
 	default:
 		return nil, sdkerrors.Wrap(err, "acknowledge packet verification failed")
@@ -623,6 +928,10 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 		return nil, sdkerrors.Wrap(err, "acknowledge packet callback failed")
 	}
 
+	if err := k.compensatePacket(ctx, cbs, msg.Packet, isErrorAcknowledgement(msg.Acknowledgement), relayer); err != nil {
+		return nil, sdkerrors.Wrap(err, "compensate packet callback failed")
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
@@ -636,5 +945,348 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 		)
 	}()
 
+	k.afterPacketRelayed(ctx, msg, relayer, false)
+
 	return &channeltypes.MsgAcknowledgementResponse{}, nil
 }
+
+// ReclaimPacket defines a rpc handler method for MsgReclaimPacket.
+func (k Keeper) ReclaimPacket(goCtx context.Context, msg *channeltypes.MsgReclaimPacket) (*channeltypes.MsgReclaimPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	deadLetter, found := k.ChannelKeeper.GetDeadLetterPacket(ctx, msg.PortId, msg.ChannelId, msg.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrDeadLetterPacketNotFound, "port ID (%s) channel ID (%s) sequence (%d)", msg.PortId, msg.ChannelId, msg.Sequence)
+	}
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	deadLetterModule, ok := porttypes.GetDeadLetterModule(cbs)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "module %s does not support reclaiming dead-letter packets", module)
+	}
+
+	// Perform application logic callback
+	if err := deadLetterModule.OnReclaimPacket(ctx, deadLetter.Packet, deadLetter.Reason, signer); err != nil {
+		return nil, sdkerrors.Wrap(err, "reclaim packet callback failed")
+	}
+
+	if err := k.compensatePacket(ctx, cbs, deadLetter.Packet, true, signer); err != nil {
+		return nil, sdkerrors.Wrap(err, "compensate packet callback failed")
+	}
+
+	k.ChannelKeeper.DeleteDeadLetterPacket(ctx, msg.PortId, msg.ChannelId, msg.Sequence)
+	channelkeeper.EmitReclaimPacketEvent(ctx, deadLetter.Packet)
+
+	return &channeltypes.MsgReclaimPacketResponse{}, nil
+}
+
+// QuarantineChannel defines a rpc handler method for MsgQuarantineChannel.
+func (k Keeper) QuarantineChannel(goCtx context.Context, msg *channeltypes.MsgQuarantineChannel) (*channeltypes.MsgQuarantineChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCircuitBreaker(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.QuarantineChannel(ctx, msg.PortId, msg.ChannelId, msg.Signer); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel quarantine failed")
+	}
+
+	return &channeltypes.MsgQuarantineChannelResponse{}, nil
+}
+
+// ReleaseChannel defines a rpc handler method for MsgReleaseChannel.
+func (k Keeper) ReleaseChannel(goCtx context.Context, msg *channeltypes.MsgReleaseChannel) (*channeltypes.MsgReleaseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCircuitBreaker(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ReleaseChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel release failed")
+	}
+
+	return &channeltypes.MsgReleaseChannelResponse{}, nil
+}
+
+// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit. Channel upgrades
+// are permissioned, the message must be restricted to the authorized signers by the
+// RestrictedMsgs param.
+func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if _, ok := k.GetParams(ctx).GetMsgRestriction(msgTypeURL); !ok {
+		return nil, sdkerrors.Wrapf(coretypes.ErrMsgRestricted, "%s must be restricted to authorized signers by the %s param", msgTypeURL, coretypes.KeyRestrictedMsgs)
+	}
+
+	upgradableModule, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Fields)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade init failed")
+	}
+
+	upgradeVersion, err := upgradableModule.OnChanUpgradeInit(
+		ctx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade init callback failed")
+	}
+
+	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
+
+	return &channeltypes.MsgChannelUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: channel.UpgradeSequence,
+	}, nil
+}
+
+// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	upgradableModule, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade, err := k.ChannelKeeper.ChanUpgradeTry(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence,
+		msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight,
+	)
+	if err != nil {
+		if k.abortUpgrade(ctx, msg.PortId, msg.ChannelId, err) {
+			return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+		}
+		return nil, sdkerrors.Wrap(err, "channel upgrade try failed")
+	}
+
+	// Cache context so that we may discard state changes from callback if the upgrade is aborted.
+	cacheCtx, writeFn := ctx.CacheContext()
+	upgradeVersion, err := upgradableModule.OnChanUpgradeTry(
+		cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, msg.CounterpartyUpgradeFields.Version,
+	)
+	if err != nil {
+		k.ChannelKeeper.AbortUpgrade(ctx, msg.PortId, msg.ChannelId, channeltypes.NewUpgradeError(msg.CounterpartyUpgradeSequence, err))
+		return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	writeFn()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	channel, upgrade := k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion, msg.CounterpartyUpgradeSequence)
+
+	return &channeltypes.MsgChannelUpgradeTryResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: channel.UpgradeSequence,
+		Result:          channeltypes.SUCCESS,
+	}, nil
+}
+
+// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
+func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	upgradableModule, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeAck(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade,
+		msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight,
+	); err != nil {
+		if k.abortUpgrade(ctx, msg.PortId, msg.ChannelId, err) {
+			return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+		}
+		return nil, sdkerrors.Wrap(err, "channel upgrade ack failed")
+	}
+
+	// Cache context so that we may discard state changes from callback if the upgrade is aborted.
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := upgradableModule.OnChanUpgradeAck(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade.Fields.Version); err != nil {
+		channel, _ := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+		k.ChannelKeeper.AbortUpgrade(ctx, msg.PortId, msg.ChannelId, channeltypes.NewUpgradeError(channel.UpgradeSequence, err))
+		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	writeFn()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm. The upgrade
+// is opened immediately if both channel ends have completed flushing.
+func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	upgradableModule, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeConfirm(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgrade,
+		msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight,
+	); err != nil {
+		if k.abortUpgrade(ctx, msg.PortId, msg.ChannelId, err) {
+			return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.FAILURE}, nil
+		}
+		return nil, sdkerrors.Wrap(err, "channel upgrade confirm failed")
+	}
+
+	channel := k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	if channel.State == channeltypes.FLUSHCOMPLETE && msg.CounterpartyChannelState == channeltypes.FLUSHCOMPLETE {
+		channel = k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+		upgradableModule.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+	}
+
+	return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeOpen defines a rpc handler method for MsgChannelUpgradeOpen.
+func (k Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeOpen) (*channeltypes.MsgChannelUpgradeOpenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	upgradableModule, err := k.getUpgradableModule(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeOpen(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgradeSequence,
+		msg.ProofChannel, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade open failed")
+	}
+
+	channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+	upgradableModule.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+
+	return &channeltypes.MsgChannelUpgradeOpenResponse{}, nil
+}
+
+// ChannelUpgradeTimeout defines a rpc handler method for MsgChannelUpgradeTimeout.
+func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeTimeout(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade timeout failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
+}
+
+// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
+func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkMsgAllowed(ctx, msg, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeCancel(
+		ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade cancel failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt.Sequence)
+
+	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
+}
+
+// getUpgradableModule returns the application routed to by the provided channel if it supports
+// channel upgrades.
+func (k Keeper) getUpgradableModule(ctx sdk.Context, portID, channelID string) (porttypes.UpgradableModule, error) {
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	upgradableModule, ok := cbs.(porttypes.UpgradableModule)
+	if !ok {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrUpgradeUnsupported, "module %s does not support channel upgrades", module)
+	}
+
+	return upgradableModule, nil
+}
+
+// abortUpgrade aborts the upgrade of the provided channel and returns true if the provided error
+// is an UpgradeError. The error receipt of the upgrade is written and the handshake message
+// succeeds with a FAILURE result, allowing the counterparty to cancel its side of the upgrade.
+func (k Keeper) abortUpgrade(ctx sdk.Context, portID, channelID string, err error) bool {
+	var upgradeErr *channeltypes.UpgradeError
+	if !errors.As(err, &upgradeErr) {
+		return false
+	}
+
+	k.ChannelKeeper.AbortUpgrade(ctx, portID, channelID, upgradeErr)
+	return true
+}
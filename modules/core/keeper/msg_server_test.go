@@ -3,17 +3,24 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/suite"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
 )
@@ -501,6 +508,199 @@ func (suite *KeeperTestSuite) TestHandleTimeoutPacket() {
 	}
 }
 
+// tests that the IBC handler records a packet in the dead-letter store if the timeout
+// callback of the application fails and that the application can reclaim the packet.
+func (suite *KeeperTestSuite) TestHandleTimeoutDeadLetterPacket() {
+	suite.SetupTest()
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	path.EndpointA.UpdateClient()
+
+	// the state changes of the failed callback must be discarded
+	capName := ibcmock.GetMockTimeoutCanaryCapabilityName(packet)
+	mockApp := suite.chainA.GetSimApp().IBCMockModule.IBCApp
+	mockApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+		_, err := mockApp.ScopedKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+
+		return sdkerrors.ErrInsufficientFunds
+	}
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+	_, err = keeper.Keeper.Timeout(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(has)
+
+	_, found := mockApp.ScopedKeeper.GetCapability(suite.chainA.GetContext(), capName)
+	suite.Require().False(found)
+
+	deadLetter, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetDeadLetterPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, deadLetter.Packet)
+	suite.Require().Equal(channeltypes.DeadLetterReason(sdkerrors.ErrInsufficientFunds), deadLetter.Reason)
+	suite.Require().Equal(clienttypes.GetSelfHeight(suite.chainA.GetContext()), deadLetter.RecordedHeight)
+
+	signer := suite.chainA.SenderAccount.GetAddress()
+	reclaimMsg := channeltypes.NewMsgReclaimPacket(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), signer.String())
+
+	// a failed reclaim keeps the packet in the dead-letter store
+	mockApp.OnReclaimPacket = func(ctx sdk.Context, packet channeltypes.Packet, reason string, signer sdk.AccAddress) error {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	_, err = keeper.Keeper.ReclaimPacket(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), reclaimMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetDeadLetterPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	var reclaimed bool
+	mockApp.OnReclaimPacket = func(ctx sdk.Context, reclaimedPacket channeltypes.Packet, reason string, reclaimSigner sdk.AccAddress) error {
+		suite.Require().Equal(packet, reclaimedPacket)
+		suite.Require().Equal(deadLetter.Reason, reason)
+		suite.Require().Equal(signer, reclaimSigner)
+
+		reclaimed = true
+		return nil
+	}
+
+	_, err = keeper.Keeper.ReclaimPacket(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), reclaimMsg)
+	suite.Require().NoError(err)
+	suite.Require().True(reclaimed)
+
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetDeadLetterPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// a packet can only be reclaimed once
+	_, err = keeper.Keeper.ReclaimPacket(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), reclaimMsg)
+	suite.Require().ErrorIs(err, channeltypes.ErrDeadLetterPacketNotFound)
+}
+
+// tests that the IBC handler executes the compensation of a packet sent with a compensation
+// when the packet is acknowledged with an error acknowledgement, times out or is reclaimed from
+// the dead-letter store, and discards it when the packet is acknowledged successfully.
+func (suite *KeeperTestSuite) TestHandlePacketCompensation() {
+	suite.SetupTest()
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	mockApp := suite.chainA.GetSimApp().IBCMockModule.IBCApp
+
+	var compensated []uint64
+	mockApp.OnCompensatePacket = func(ctx sdk.Context, packet channeltypes.Packet, compensation []byte, relayer sdk.AccAddress) error {
+		suite.Require().Equal(sdk.Uint64ToBigEndian(packet.GetSequence()), compensation)
+
+		compensated = append(compensated, packet.GetSequence())
+		return nil
+	}
+
+	sendPacket := func(packet channeltypes.Packet) {
+		err := channelKeeper.SendPacketWithCompensation(suite.chainA.GetContext(), channelKeeper, channelCap, packet, func(ctx sdk.Context) ([]byte, error) {
+			return sdk.Uint64ToBigEndian(packet.GetSequence()), nil
+		})
+		suite.Require().NoError(err)
+
+		suite.coordinator.CommitBlock(suite.chainA)
+		suite.Require().NoError(path.EndpointB.UpdateClient())
+	}
+
+	// the local state change is discarded if the packet cannot be sent
+	capName := ibcmock.GetMockTimeoutCanaryCapabilityName(channeltypes.Packet{Sequence: 2})
+	invalidPacket := channeltypes.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err := channelKeeper.SendPacketWithCompensation(suite.chainA.GetContext(), channelKeeper, channelCap, invalidPacket, func(ctx sdk.Context) ([]byte, error) {
+		_, err := mockApp.ScopedKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+
+		return []byte("compensation"), nil
+	})
+	suite.Require().ErrorIs(err, channeltypes.ErrInvalidPacket)
+
+	_, found := mockApp.ScopedKeeper.GetCapability(suite.chainA.GetContext(), capName)
+	suite.Require().False(found)
+
+	// the packet is not sent if the local state change fails
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err = channelKeeper.SendPacketWithCompensation(suite.chainA.GetContext(), channelKeeper, channelCap, packet, func(ctx sdk.Context) ([]byte, error) {
+		return nil, sdkerrors.ErrInsufficientFunds
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	has := channelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(has)
+
+	// the compensation of a successfully acknowledged packet is discarded
+	sendPacket(packet)
+
+	compensation, found := channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(1), compensation)
+
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+	suite.Require().Empty(compensated)
+
+	_, found = channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the compensation of a packet acknowledged with an error acknowledgement is executed
+	packet = channeltypes.NewPacket(ibcmock.MockFailPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	sendPacket(packet)
+
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockFailAcknowledgement.Acknowledgement()))
+	suite.Require().Equal([]uint64{2}, compensated)
+
+	_, found = channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the compensation of a timed out packet is executed
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+	sendPacket(packet)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+	suite.Require().Equal([]uint64{2, 3}, compensated)
+
+	_, found = channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the compensation of a dead-lettered packet is executed when the packet is reclaimed
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, 4, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+	sendPacket(packet)
+
+	mockApp.OnTimeoutPacket = func(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+		return sdkerrors.ErrInsufficientFunds
+	}
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+	suite.Require().Equal([]uint64{2, 3}, compensated)
+
+	_, found = channelKeeper.GetDeadLetterPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	_, found = channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	reclaimMsg := channeltypes.NewMsgReclaimPacket(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), suite.chainA.SenderAccount.GetAddress().String())
+	_, err = keeper.Keeper.ReclaimPacket(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), reclaimMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 3, 4}, compensated)
+
+	_, found = channelKeeper.GetPacketCompensation(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
 // tests the IBC handler timing out a packet via channel closure on ordered
 // and unordered channels. It verifies that the deletion of a packet
 // commitment occurs. It tests high level properties like ordering and basic
@@ -771,3 +971,766 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateClientGasMultiplier() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	ibcKeeper := suite.chainA.GetSimApp().IBCKeeper
+	ctx := suite.chainA.GetContext()
+
+	// updateGas returns the gas consumed by the update client handler
+	updateGas := func() sdk.Gas {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		_, err := ibcKeeper.UpdateClient(sdk.WrapSDKContext(cacheCtx), msg)
+		suite.Require().NoError(err)
+
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+
+	baseGas := updateGas()
+
+	ibcKeeper.SetClientGasMultipliers(map[string]sdk.Dec{exported.Tendermint: sdk.NewDec(2)})
+	suite.Require().Equal(2*baseGas, updateGas())
+
+	ibcKeeper.SetClientGasMultipliers(map[string]sdk.Dec{exported.Tendermint: sdk.NewDecWithPrec(5, 1)})
+	suite.Require().Equal(baseGas/2, updateGas())
+
+	// multipliers of other client types are not applied
+	ibcKeeper.SetClientGasMultipliers(map[string]sdk.Dec{exported.Solomachine: sdk.NewDec(2)})
+	suite.Require().Equal(baseGas, updateGas())
+
+	suite.Require().Panics(func() {
+		ibcKeeper.SetClientGasMultipliers(map[string]sdk.Dec{exported.Tendermint: sdk.NewDec(-1)})
+	})
+
+	ibcKeeper.SetClientGasMultipliers(nil)
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientNotifiesFrozenClient() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// store a closed channel on the same connection
+	closedChannel := path.EndpointA.GetChannel()
+	closedChannel.State = channeltypes.CLOSED
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, "channel-100", closedChannel)
+
+	cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(ibcmock.ModuleName)
+	suite.Require().True(ok)
+
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	var notified []string
+	cbs.(ibcmock.IBCModule).IBCApp.OnClientFrozen = func(ctx sdk.Context, portID, channelID string) {
+		notified = append(notified, host.ChannelPath(portID, channelID))
+	}
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	// a valid update does not notify applications
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	_, err = suite.chainA.GetSimApp().IBCKeeper.UpdateClient(sdk.WrapSDKContext(cacheCtx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(notified)
+
+	// store a conflicting consensus state at the header height to freeze the client
+	conflictConsState := header.ConsensusState()
+	conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), conflictConsState)
+
+	_, err = suite.chainA.GetSimApp().IBCKeeper.UpdateClient(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	// only the open channel is notified
+	suite.Require().Equal([]string{host.ChannelPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)}, notified)
+}
+
+func (suite *KeeperTestSuite) TestBatchedUpdateClient() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	clientID := path.EndpointA.ClientID
+
+	params := app.IBCKeeper.ClientKeeper.GetParams(ctx)
+	params.BatchedUpdateClients = []string{clientID}
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, params)
+
+	var headers []*ibctmtypes.Header
+	for i := 0; i < 3; i++ {
+		suite.coordinator.CommitBlock(suite.chainB)
+
+		header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+		suite.Require().NoError(err)
+		headers = append(headers, header)
+	}
+
+	// headers failing the checks performed on submission are not queued
+	trustedHeight := headers[2].TrustedHeight
+	headers[2].TrustedHeight = trustedHeight.Increment().(clienttypes.Height)
+
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, headers[2], suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+	suite.Require().Empty(app.IBCKeeper.ClientKeeper.GetQueuedClientUpdates(ctx, clientID).Updates)
+
+	// the highest header is invalid as its commit is not signed by the validators
+	headers[2].TrustedHeight = trustedHeight
+	for i := range headers[2].Commit.Signatures {
+		headers[2].Commit.Signatures[i].Signature = make([]byte, len(headers[2].Commit.Signatures[i].Signature))
+	}
+
+	for _, header := range []*ibctmtypes.Header{headers[0], headers[2], headers[1]} {
+		signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		msg, err := clienttypes.NewMsgUpdateClient(clientID, header, signer)
+		suite.Require().NoError(err)
+
+		_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	// queued updates are not applied until the end of the block
+	suite.Require().Len(app.IBCKeeper.ClientKeeper.GetQueuedClientUpdates(ctx, clientID).Updates, 3)
+	for _, header := range headers {
+		suite.Require().False(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, header.GetHeight()))
+	}
+
+	app.IBCKeeper.ApplyQueuedClientUpdates(ctx)
+
+	// only the highest valid header is applied
+	suite.Require().False(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[0].GetHeight()))
+	suite.Require().True(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[1].GetHeight()))
+	suite.Require().False(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[2].GetHeight()))
+	suite.Require().Empty(app.IBCKeeper.ClientKeeper.GetQueuedUpdateClientIDs(ctx))
+
+	// at most MaxQueuedClientUpdatesPerSigner updates may be queued by a signer for a client
+	msg, err = clienttypes.NewMsgUpdateClient(clientID, headers[2], suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	for i := 0; i < clienttypes.MaxQueuedClientUpdatesPerSigner; i++ {
+		_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientUpdateQueueFull)
+
+	// at most MaxQueuedClientUpdates updates may be queued for a client
+	for i := clienttypes.MaxQueuedClientUpdatesPerSigner; i < clienttypes.MaxQueuedClientUpdates; i++ {
+		msg.Signer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	msg.Signer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	_, err = app.IBCKeeper.UpdateClient(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientUpdateQueueFull)
+}
+
+func (suite *KeeperTestSuite) TestBatchUpdateClient() {
+	for _, batched := range []bool{false, true} {
+		suite.SetupTest()
+
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupClients(path)
+
+		app := suite.chainA.GetSimApp()
+		ctx := suite.chainA.GetContext()
+		clientID := path.EndpointA.ClientID
+
+		if batched {
+			params := app.IBCKeeper.ClientKeeper.GetParams(ctx)
+			params.BatchedUpdateClients = []string{clientID}
+			app.IBCKeeper.ClientKeeper.SetParams(ctx, params)
+		}
+
+		var headers []exported.Header
+		for i := 0; i < 2; i++ {
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+			suite.Require().NoError(err)
+			headers = append(headers, header)
+		}
+
+		msg, err := clienttypes.NewMsgBatchUpdateClient(clientID, headers, suite.chainA.SenderAccount.GetAddress().String())
+		suite.Require().NoError(err)
+
+		_, err = app.IBCKeeper.BatchUpdateClient(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+
+		if batched {
+			// the headers are queued and only the highest one is applied at the end of the block
+			suite.Require().Len(app.IBCKeeper.ClientKeeper.GetQueuedClientUpdates(ctx, clientID).Updates, 2)
+			app.IBCKeeper.ApplyQueuedClientUpdates(ctx)
+
+			suite.Require().False(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[0].GetHeight()))
+		} else {
+			suite.Require().True(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[0].GetHeight()))
+		}
+		suite.Require().True(app.IBCKeeper.ClientKeeper.HasClientConsensusState(ctx, clientID, headers[1].GetHeight()))
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecoverClient() {
+	var (
+		signer string
+		params types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: IBC authority", func() {}, nil,
+		},
+		{
+			"success: signer allowed by the RestrictedMsgs param", func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+				params = types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(sdk.MsgTypeURL(&clienttypes.MsgRecoverClient{}), signer)}, nil, false, nil)
+			}, nil,
+		},
+		{
+			"signer is not the IBC authority", func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+			}, types.ErrMsgRestricted,
+		},
+		{
+			"signer not allowed by the RestrictedMsgs param", func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+				params = types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(sdk.MsgTypeURL(&clienttypes.MsgRecoverClient{}), suite.chainB.SenderAccount.GetAddress().String())}, nil, false, nil)
+			}, types.ErrMsgRestricted,
+		},
+		{
+			"message is disabled", func() {
+				params = types.NewParams([]string{sdk.MsgTypeURL(&clienttypes.MsgRecoverClient{})}, nil, nil, false, nil)
+			}, types.ErrMsgDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			suite.Require().NoError(substitutePath.EndpointA.UpdateClient())
+
+			app := suite.chainA.GetSimApp()
+			ctx := suite.chainA.GetContext()
+			subject := subjectPath.EndpointA.ClientID
+			substitute := substitutePath.EndpointA.ClientID
+
+			// freeze the subject client, both clients allow updates after misbehaviour
+			substituteClientState := suite.chainA.GetClientState(substitute).(*ibctmtypes.ClientState)
+			substituteClientState.AllowUpdateAfterMisbehaviour = true
+			app.IBCKeeper.ClientKeeper.SetClientState(ctx, substitute, substituteClientState)
+
+			clientState := suite.chainA.GetClientState(subject).(*ibctmtypes.ClientState)
+			clientState.AllowUpdateAfterMisbehaviour = true
+			clientState.FrozenHeight = clientState.LatestHeight
+			app.IBCKeeper.ClientKeeper.SetClientState(ctx, subject, clientState)
+
+			signer = app.IBCKeeper.GetAuthority()
+			params = types.DefaultParams()
+
+			tc.malleate()
+
+			app.IBCKeeper.SetParams(ctx, params)
+
+			msg := clienttypes.NewMsgRecoverClient(subject, substitute, signer)
+			_, err := app.IBCKeeper.RecoverClient(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			clientState = suite.chainA.GetClientState(subject).(*ibctmtypes.ClientState)
+			suite.Require().Equal(exported.Active, app.IBCKeeper.ClientKeeper.GetClientStatus(ctx, clientState, subject))
+			suite.Require().Equal(suite.chainA.GetClientState(substitute).GetLatestHeight(), clientState.GetLatestHeight())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+	plan := upgradetypes.Plan{
+		Name:   "upgrade IBC clients",
+		Height: 1000,
+	}
+	upgradedClientState := suite.chainA.GetClientState(path.EndpointA.ClientID)
+
+	// the gov module account is the default authority
+	suite.Require().Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.IBCKeeper.GetAuthority())
+
+	msg, err := clienttypes.NewMsgIBCSoftwareUpgrade(suite.chainA.SenderAccount.GetAddress().String(), plan, upgradedClientState)
+	suite.Require().NoError(err)
+
+	_, err = app.IBCKeeper.IBCSoftwareUpgrade(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	suite.Require().False(found)
+
+	app.IBCKeeper.SetAuthority(suite.chainA.SenderAccount.GetAddress().String())
+
+	_, err = app.IBCKeeper.IBCSoftwareUpgrade(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	storedPlan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(plan, storedPlan)
+
+	bz, found := app.UpgradeKeeper.GetUpgradedClient(ctx, plan.Height)
+	suite.Require().True(found)
+	clientState, err := clienttypes.UnmarshalClientState(app.AppCodec(), bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(upgradedClientState.ZeroCustomFields(), clientState)
+}
+
+func (suite *KeeperTestSuite) TestQuarantineChannel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ibcKeeper := suite.chainB.App.GetIBCKeeper()
+	circuitBreaker := suite.chainB.SenderAccount.GetAddress().String()
+	ibcKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(nil, nil, nil, false, []string{circuitBreaker}))
+
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	channel := path.EndpointB.GetChannel()
+
+	// only the IBC authority and the circuit breakers may place channels in quarantine
+	_, err := ibcKeeper.QuarantineChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), channeltypes.NewMsgQuarantineChannel(portID, channelID, sdk.AccAddress("other").String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = ibcKeeper.QuarantineChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), channeltypes.NewMsgQuarantineChannel(portID, channelID, circuitBreaker))
+	suite.Require().NoError(err)
+
+	_, err = ibcKeeper.QuarantineChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), channeltypes.NewMsgQuarantineChannel(portID, channelID, circuitBreaker))
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelQuarantined)
+
+	res, err := ibcKeeper.QuarantinedChannels(sdk.WrapSDKContext(suite.chainB.GetContext()), &channeltypes.QueryQuarantinedChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(circuitBreaker, res.Channels[0].Signer)
+
+	// packets cannot be sent on a quarantined channel
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, portID, channelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	suite.Require().ErrorIs(path.EndpointB.SendPacket(packet), channeltypes.ErrChannelQuarantined)
+
+	// packets received on a quarantined channel are acknowledged with a retryable error
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, portID, channelID, timeoutHeight, 0)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+	ack := channeltypes.NewRetryableErrorAcknowledgement(channeltypes.ErrChannelQuarantined)
+	ackCommitment, found := ibcKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), portID, channelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), ackCommitment)
+
+	// the channel end is not modified
+	suite.Require().Equal(channel, path.EndpointB.GetChannel())
+
+	// the IBC authority may release the channel
+	_, err = ibcKeeper.ReleaseChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), channeltypes.NewMsgReleaseChannel(portID, channelID, ibcKeeper.GetAuthority()))
+	suite.Require().NoError(err)
+
+	_, err = ibcKeeper.ReleaseChannel(sdk.WrapSDKContext(suite.chainB.GetContext()), channeltypes.NewMsgReleaseChannel(portID, channelID, circuitBreaker))
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelNotQuarantined)
+
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, portID, channelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.EndpointB.SendPacket(packet))
+}
+
+func (suite *KeeperTestSuite) TestMsgAllowed() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	otherSigner := suite.chainB.SenderAccount.GetAddress().String()
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	updateMsg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, signer)
+	suite.Require().NoError(err)
+
+	openInitMsg := channeltypes.NewMsgChannelOpenInit(ibctesting.MockPort, ibcmock.Version, channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID}, ibctesting.MockPort, signer)
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{"all messages allowed", types.DefaultParams(), nil},
+		{"message disabled", types.NewParams([]string{sdk.MsgTypeURL(updateMsg), sdk.MsgTypeURL(openInitMsg)}, nil, nil, false, nil), types.ErrMsgDisabled},
+		{"signer allowed", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(sdk.MsgTypeURL(updateMsg), signer), types.NewMsgRestriction(sdk.MsgTypeURL(openInitMsg), otherSigner, signer)}, nil, false, nil), nil},
+		{"signer not allowed", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(sdk.MsgTypeURL(updateMsg), otherSigner), types.NewMsgRestriction(sdk.MsgTypeURL(openInitMsg), otherSigner)}, nil, false, nil), types.ErrMsgRestricted},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			ibcKeeper.SetParams(ctx, tc.params)
+			suite.Require().Equal(tc.params, ibcKeeper.GetParams(ctx))
+
+			_, err := ibcKeeper.UpdateClient(sdk.WrapSDKContext(ctx), updateMsg)
+			suite.Require().ErrorIs(err, tc.expErr)
+
+			_, err = ibcKeeper.ChannelOpenInit(sdk.WrapSDKContext(ctx), openInitMsg)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelOpenRestricted() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	otherSigner := suite.chainB.SenderAccount.GetAddress().String()
+
+	openInitMsg := channeltypes.NewMsgChannelOpenInit(ibctesting.MockPort, ibcmock.Version, channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID}, ibctesting.MockPort, signer)
+	openTryMsg := channeltypes.NewMsgChannelOpenTry(ibctesting.MockPort, "", ibcmock.Version, channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID}, ibctesting.MockPort, ibctesting.FirstChannelID, ibcmock.Version, []byte("proof"), clienttypes.NewHeight(0, 1), signer)
+
+	testCases := []struct {
+		name         string
+		restrictions []types.ChannelOpenRestriction
+		expPass      bool
+	}{
+		{"no restrictions", nil, true},
+		{"other port restricted", []types.ChannelOpenRestriction{types.NewChannelOpenRestriction(ibctesting.TransferPort, otherSigner)}, true},
+		{"signer allowed", []types.ChannelOpenRestriction{types.NewChannelOpenRestriction(ibctesting.MockPort, otherSigner, signer)}, true},
+		{"signer not allowed", []types.ChannelOpenRestriction{types.NewChannelOpenRestriction(ibctesting.MockPort, otherSigner)}, false},
+		{"no signers allowed", []types.ChannelOpenRestriction{types.NewChannelOpenRestriction(ibctesting.MockPort)}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			ibcKeeper.SetParams(ctx, types.NewParams(nil, nil, tc.restrictions, false, nil))
+
+			_, err := ibcKeeper.ChannelOpenInit(sdk.WrapSDKContext(ctx), openInitMsg)
+			_, tryErr := ibcKeeper.ChannelOpenTry(sdk.WrapSDKContext(ctx), openTryMsg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				// the try message is allowed and fails on proof verification
+				suite.Require().Error(tryErr)
+				suite.Require().NotErrorIs(tryErr, types.ErrChannelOpenRestricted)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrChannelOpenRestricted)
+				suite.Require().ErrorIs(tryErr, types.ErrChannelOpenRestricted)
+			}
+		})
+	}
+}
+
+// tests that the channel upgrade init message is rejected unless it is restricted to authorized
+// signers.
+func (suite *KeeperTestSuite) TestChannelUpgradeInitRestricted() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	otherSigner := suite.chainB.SenderAccount.GetAddress().String()
+
+	fields := channeltypes.NewUpgradeFields(channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID}, ibcmock.Version+"-v2")
+	upgradeInitMsg := channeltypes.NewMsgChannelUpgradeInit(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, fields, signer)
+	msgTypeURL := sdk.MsgTypeURL(upgradeInitMsg)
+
+	testCases := []struct {
+		name         string
+		restrictions []types.MsgRestriction
+		expErr       error
+	}{
+		{"not restricted", nil, types.ErrMsgRestricted},
+		{"signer allowed", []types.MsgRestriction{types.NewMsgRestriction(msgTypeURL, signer)}, nil},
+		{"signer not allowed", []types.MsgRestriction{types.NewMsgRestriction(msgTypeURL, otherSigner)}, types.ErrMsgRestricted},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			ibcKeeper.SetParams(ctx, types.NewParams(nil, tc.restrictions, nil, false, nil))
+
+			res, err := ibcKeeper.ChannelUpgradeInit(sdk.WrapSDKContext(ctx), upgradeInitMsg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+				suite.Require().Equal(fields, res.Upgrade.Fields)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// tests that a packet whose proof height has no consensus state stored on the client is rejected
+// unless the proof height fallback is enabled and the proof verifies against a later consensus state.
+func (suite *KeeperTestSuite) TestRecvPacketProofHeightFallback() {
+	testCases := []struct {
+		name     string
+		fallback bool
+		sameRoot bool
+		expPass  bool
+		expErr   error
+	}{
+		{"fallback disabled", false, true, false, types.ErrProofHeightNotFound},
+		{"fallback to consensus state with the same root", true, true, true, nil},
+		{"fallback to consensus state with a different root", true, false, false, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+			// update the client past the proof height and remove the consensus state at the proof height
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			clientKeeper := suite.chainB.App.GetIBCKeeper().ClientKeeper
+			ctx := suite.chainB.GetContext()
+			fallbackHeight := path.EndpointB.GetClientState().GetLatestHeight()
+
+			if tc.sameRoot {
+				consensusState := path.EndpointB.GetConsensusState(proofHeight)
+				clientKeeper.SetClientConsensusState(ctx, path.EndpointB.ClientID, fallbackHeight, consensusState)
+			}
+			clientKeeper.ClientStore(ctx, path.EndpointB.ClientID).Delete(host.ConsensusStateKey(proofHeight))
+
+			ibcKeeper := suite.chainB.App.GetIBCKeeper()
+			ibcKeeper.SetParams(ctx, types.NewParams(nil, nil, nil, tc.fallback, nil))
+
+			msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			_, err = ibcKeeper.RecvPacket(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				_, found := ibcKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+			} else if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().NotErrorIs(err, types.ErrProofHeightNotFound)
+			}
+		})
+	}
+}
+
+// tests that two modules on the same chain open a channel and exchange packets over the
+// localhost client through the regular application callbacks.
+func (suite *KeeperTestSuite) TestLocalChannel() {
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	ctx := suite.chainA.GetContext()
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+
+	// the localhost client is not allowed by default
+	_, _, err := ibcKeeper.OpenLocalChannel(ctx, channeltypes.UNORDERED, ibctesting.MockPort, ibctesting.MockPort, ibcmock.Version, signer)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClientType)
+
+	params := ibcKeeper.ClientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	ibcKeeper.ClientKeeper.SetParams(ctx, params)
+
+	channelID, counterpartyChannelID, err := ibcKeeper.OpenLocalChannel(ctx, channeltypes.UNORDERED, ibctesting.MockPort, ibctesting.MockPort, ibcmock.Version, signer)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(channelID, counterpartyChannelID)
+
+	for _, id := range []string{channelID, counterpartyChannelID} {
+		channel, found := ibcKeeper.ChannelKeeper.GetChannel(ctx, ibctesting.MockPort, id)
+		suite.Require().True(found)
+		suite.Require().Equal(channeltypes.OPEN, channel.State)
+	}
+
+	// the local connection is reused
+	secondChannelID, _, err := ibcKeeper.OpenLocalChannel(ctx, channeltypes.ORDERED, ibctesting.MockPort, ibctesting.MockPort, ibcmock.Version, signer)
+	suite.Require().NoError(err)
+	connections, _ := ibcKeeper.ConnectionKeeper.GetClientConnectionPaths(ctx, exported.Localhost)
+	suite.Require().Len(connections, 1)
+
+	// packets are received and acknowledged synchronously
+	for _, id := range []string{channelID, secondChannelID} {
+		channel, _ := ibcKeeper.ChannelKeeper.GetChannel(ctx, ibctesting.MockPort, id)
+		packet := channeltypes.NewPacket(ibcmock.MockPacketData, 1, ibctesting.MockPort, id, ibctesting.MockPort, channel.Counterparty.ChannelId, timeoutHeight, 0)
+
+		channelCap := suite.chainA.GetChannelCapability(ibctesting.MockPort, id)
+		suite.Require().NoError(ibcKeeper.ChannelKeeper.SendPacket(ctx, channelCap, packet))
+		suite.Require().NoError(ibcKeeper.RelayLocalPacket(ctx, packet, signer))
+
+		_, found := ibcKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Nil(ibcKeeper.ChannelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	// packets of channels to other chains are not relayed locally
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := channeltypes.NewPacket(ibcmock.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+	err = ibcKeeper.RelayLocalPacket(suite.chainA.GetContext(), packet, signer)
+	suite.Require().ErrorIs(err, types.ErrNotLocalChannel)
+}
+
+// tests that two modules on the same chain open a connection and a channel over the localhost
+// client through the standard handshake messages and exchange packets with sentinel proofs.
+func (suite *KeeperTestSuite) TestLocalhostHandshake() {
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	ctx := suite.chainA.GetContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	proof := localhosttypes.SentinelProof
+
+	// the localhost client is not allowed by default
+	suite.Require().ErrorIs(ibcKeeper.ClientKeeper.CreateLocalhostClient(ctx), clienttypes.ErrInvalidClientType)
+
+	params := ibcKeeper.ClientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	ibcKeeper.ClientKeeper.SetParams(ctx, params)
+
+	suite.Require().NoError(ibcKeeper.ClientKeeper.CreateLocalhostClient(ctx))
+	suite.Require().ErrorIs(ibcKeeper.ClientKeeper.CreateLocalhostClient(ctx), clienttypes.ErrClientExists)
+
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, exported.Localhost)
+	suite.Require().True(found)
+	proofHeight := clientState.GetLatestHeight().(clienttypes.Height)
+	prefix := commitmenttypes.NewMerklePrefix(ibcKeeper.ConnectionKeeper.GetCommitmentPrefix().Bytes())
+
+	// connection handshake
+	initMsg := connectiontypes.NewMsgConnectionOpenInit(exported.Localhost, exported.Localhost, prefix, nil, 0, signer)
+	suite.Require().NoError(initMsg.ValidateBasic())
+	connectionID := connectiontypes.FormatConnectionIdentifier(ibcKeeper.ConnectionKeeper.GetNextConnectionSequence(ctx))
+	_, err := ibcKeeper.ConnectionOpenInit(goCtx, initMsg)
+	suite.Require().NoError(err)
+
+	tryMsg := connectiontypes.NewMsgConnectionOpenTry(
+		"", exported.Localhost, connectionID, exported.Localhost, clientState, prefix,
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+		proof, proof, proof, proofHeight, proofHeight, signer,
+	)
+	suite.Require().NoError(tryMsg.ValidateBasic())
+	// the client state must be the stored localhost client at its latest height
+	invalidTryMsg := *tryMsg
+	invalidTryMsg.ConsensusHeight = proofHeight.Increment().(clienttypes.Height)
+	_, err = ibcKeeper.ConnectionOpenTry(goCtx, &invalidTryMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidHeight)
+
+	invalidTryMsg = *tryMsg
+	invalidTryMsg.ClientState, err = clienttypes.PackClientState(localhosttypes.NewClientState("other-chain", proofHeight))
+	suite.Require().NoError(err)
+	_, err = ibcKeeper.ConnectionOpenTry(goCtx, &invalidTryMsg)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidClient)
+
+	counterpartyConnectionID := connectiontypes.FormatConnectionIdentifier(ibcKeeper.ConnectionKeeper.GetNextConnectionSequence(ctx))
+	_, err = ibcKeeper.ConnectionOpenTry(goCtx, tryMsg)
+	suite.Require().NoError(err)
+
+	counterpartyConnection, _ := ibcKeeper.ConnectionKeeper.GetConnection(ctx, counterpartyConnectionID)
+	ackMsg := connectiontypes.NewMsgConnectionOpenAck(
+		connectionID, counterpartyConnectionID, clientState, proof, proof, proof, proofHeight, proofHeight,
+		counterpartyConnection.Versions[0], signer,
+	)
+	suite.Require().NoError(ackMsg.ValidateBasic())
+	_, err = ibcKeeper.ConnectionOpenAck(goCtx, ackMsg)
+	suite.Require().NoError(err)
+
+	confirmMsg := connectiontypes.NewMsgConnectionOpenConfirm(counterpartyConnectionID, proof, proofHeight, signer)
+	suite.Require().NoError(confirmMsg.ValidateBasic())
+	_, err = ibcKeeper.ConnectionOpenConfirm(goCtx, confirmMsg)
+	suite.Require().NoError(err)
+
+	for _, id := range []string{connectionID, counterpartyConnectionID} {
+		connection, found := ibcKeeper.ConnectionKeeper.GetConnection(ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(connectiontypes.OPEN, connection.State)
+	}
+
+	// channel handshake
+	chanInitRes, err := ibcKeeper.ChannelOpenInit(goCtx, channeltypes.NewMsgChannelOpenInit(
+		ibctesting.MockPort, ibcmock.Version, channeltypes.UNORDERED, []string{connectionID}, ibctesting.MockPort, signer,
+	))
+	suite.Require().NoError(err)
+	channelID := chanInitRes.ChannelId
+
+	chanTryMsg := channeltypes.NewMsgChannelOpenTry(
+		ibctesting.MockPort, "", ibcmock.Version, channeltypes.UNORDERED, []string{counterpartyConnectionID},
+		ibctesting.MockPort, channelID, ibcmock.Version, proof, proofHeight, signer,
+	)
+	suite.Require().NoError(chanTryMsg.ValidateBasic())
+	counterpartyChannelID := channeltypes.FormatChannelIdentifier(ibcKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+	_, err = ibcKeeper.ChannelOpenTry(goCtx, chanTryMsg)
+	suite.Require().NoError(err)
+
+	_, err = ibcKeeper.ChannelOpenAck(goCtx, channeltypes.NewMsgChannelOpenAck(
+		ibctesting.MockPort, channelID, counterpartyChannelID, ibcmock.Version, proof, proofHeight, signer,
+	))
+	suite.Require().NoError(err)
+
+	_, err = ibcKeeper.ChannelOpenConfirm(goCtx, channeltypes.NewMsgChannelOpenConfirm(
+		ibctesting.MockPort, counterpartyChannelID, proof, proofHeight, signer,
+	))
+	suite.Require().NoError(err)
+
+	// packets are relayed with sentinel proofs
+	packet := channeltypes.NewPacket(ibcmock.MockPacketData, 1, ibctesting.MockPort, channelID, ibctesting.MockPort, counterpartyChannelID, timeoutHeight, 0)
+	suite.Require().NoError(ibcKeeper.ChannelKeeper.SendPacket(ctx, suite.chainA.GetChannelCapability(ibctesting.MockPort, channelID), packet))
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, signer)
+	suite.Require().NoError(recvMsg.ValidateBasic())
+	_, err = ibcKeeper.RecvPacket(goCtx, recvMsg)
+	suite.Require().NoError(err)
+
+	_, err = ibcKeeper.Acknowledgement(goCtx, channeltypes.NewMsgAcknowledgement(packet, ibcmock.MockAcknowledgement.Acknowledgement(), proof, proofHeight, signer))
+	suite.Require().NoError(err)
+	suite.Require().Nil(ibcKeeper.ChannelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	// the counterparty of a connection over the localhost client must be the localhost client
+	_, err = ibcKeeper.ConnectionOpenInit(goCtx, connectiontypes.NewMsgConnectionOpenInit(exported.Localhost, "07-tendermint-0", prefix, nil, 0, signer))
+	suite.Require().ErrorIs(err, connectiontypes.ErrInvalidCounterparty)
+
+	_, err = ibcKeeper.ConnectionOpenTry(goCtx, connectiontypes.NewMsgConnectionOpenTry(
+		"", exported.Localhost, connectionID, "07-tendermint-0", clientState, prefix,
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+		proof, proof, proof, proofHeight, proofHeight, signer,
+	))
+	suite.Require().ErrorIs(err, connectiontypes.ErrInvalidCounterparty)
+}
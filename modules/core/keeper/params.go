@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// GetDisabledMsgs retrieves the type URLs of the disabled core messages from the paramstore
+func (k Keeper) GetDisabledMsgs(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyDisabledMsgs, &res)
+	return res
+}
+
+// GetRestrictedMsgs retrieves the signer restrictions of the core messages from the paramstore
+func (k Keeper) GetRestrictedMsgs(ctx sdk.Context) []types.MsgRestriction {
+	var res []types.MsgRestriction
+	k.paramSpace.GetIfExists(ctx, types.KeyRestrictedMsgs, &res)
+	return res
+}
+
+// GetChannelOpenRestrictions retrieves the channel opening restrictions of the ports from the paramstore
+func (k Keeper) GetChannelOpenRestrictions(ctx sdk.Context) []types.ChannelOpenRestriction {
+	var res []types.ChannelOpenRestriction
+	k.paramSpace.GetIfExists(ctx, types.KeyChannelOpenRestrictions, &res)
+	return res
+}
+
+// GetProofHeightFallback retrieves the proof height fallback boolean from the paramstore
+func (k Keeper) GetProofHeightFallback(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyProofHeightFallback, &res)
+	return res
+}
+
+// GetCircuitBreakers retrieves the addresses of the circuit breakers from the paramstore
+func (k Keeper) GetCircuitBreakers(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyCircuitBreakers, &res)
+	return res
+}
+
+// GetParams returns the total set of core IBC message handler parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetDisabledMsgs(ctx), k.GetRestrictedMsgs(ctx), k.GetChannelOpenRestrictions(ctx), k.GetProofHeightFallback(ctx), k.GetCircuitBreakers(ctx))
+}
+
+// SetParams sets the total set of core IBC message handler parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// checkMsgAllowed returns an error if the provided core message is disabled or restricted to
+// signers which do not include the signer of the message. The signer is compared as provided
+// rather than using GetSigners, as messages routed by modules may be signed by a module name.
+func (k Keeper) checkMsgAllowed(ctx sdk.Context, msg sdk.Msg, signer string) error {
+	params := k.GetParams(ctx)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	if params.IsMsgDisabled(msgTypeURL) {
+		return sdkerrors.Wrapf(types.ErrMsgDisabled, "%s is disabled by the %s param", msgTypeURL, types.KeyDisabledMsgs)
+	}
+
+	if restriction, ok := params.GetMsgRestriction(msgTypeURL); ok && !restriction.IsSignerAllowed(signer) {
+		return sdkerrors.Wrapf(types.ErrMsgRestricted, "%s is not allowed to sign %s by the %s param", signer, msgTypeURL, types.KeyRestrictedMsgs)
+	}
+
+	return nil
+}
+
+// checkAuthorityMsgAllowed returns an error if the provided core message, which is restricted to
+// the IBC authority by default, is disabled or signed by neither the IBC authority nor one of the
+// signers allowed for the message by the RestrictedMsgs param, with which chains opt in to other
+// signers.
+func (k Keeper) checkAuthorityMsgAllowed(ctx sdk.Context, msg sdk.Msg, signer string) error {
+	params := k.GetParams(ctx)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	if params.IsMsgDisabled(msgTypeURL) {
+		return sdkerrors.Wrapf(types.ErrMsgDisabled, "%s is disabled by the %s param", msgTypeURL, types.KeyDisabledMsgs)
+	}
+
+	if signer == k.authority {
+		return nil
+	}
+
+	if restriction, ok := params.GetMsgRestriction(msgTypeURL); ok && restriction.IsSignerAllowed(signer) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrMsgRestricted, "%s is neither the IBC authority nor allowed to sign %s by the %s param", signer, msgTypeURL, types.KeyRestrictedMsgs)
+}
+
+// checkChannelOpenAllowed returns an error if opening channels on the provided port is restricted
+// to signers which do not include the provided signer.
+func (k Keeper) checkChannelOpenAllowed(ctx sdk.Context, portID, signer string) error {
+	restriction, ok := k.GetParams(ctx).GetChannelOpenRestriction(portID)
+	if ok && !restriction.IsSignerAllowed(signer) {
+		return sdkerrors.Wrapf(types.ErrChannelOpenRestricted, "%s is not allowed to open channels on port %s by the %s param", signer, portID, types.KeyChannelOpenRestrictions)
+	}
+
+	return nil
+}
+
+// checkCircuitBreaker returns an error if the provided signer is neither the IBC authority nor one
+// of the circuit breakers allowed to place channels in quarantine.
+func (k Keeper) checkCircuitBreaker(ctx sdk.Context, signer string) error {
+	if signer == k.authority || k.GetParams(ctx).IsCircuitBreaker(signer) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the IBC authority nor a circuit breaker of the %s param", signer, types.KeyCircuitBreakers)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// verifyPacketProof runs the verification of a packet message relayed on the given channel against
+// the proof height of the message in a cached context, whose events are emitted on the provided
+// context, and returns the function writing the cached state changes.
+//
+// If the client of the channel stores no consensus state at the proof height, the verification
+// fails with ErrProofHeightNotFound, unless the ProofHeightFallback param is enabled in which case
+// the verification is retried against the lowest later height at which the client stores a
+// consensus state. The proof then only verifies if the commitment root of the counterparty did not
+// change between both heights.
+func (k Keeper) verifyPacketProof(
+	ctx sdk.Context, portID, channelID string, proofHeight exported.Height,
+	verify func(ctx sdk.Context, proofHeight exported.Height) error,
+) (writeFn func(), err error) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	err = verify(cacheCtx, proofHeight)
+
+	if errors.Is(err, clienttypes.ErrConsensusStateNotFound) {
+		var fallbackHeight exported.Height
+		fallbackHeight, err = k.getFallbackProofHeight(ctx, portID, channelID, proofHeight)
+		if err == nil {
+			cacheCtx, writeFn = ctx.CacheContext()
+			err = verify(cacheCtx, fallbackHeight)
+		}
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return writeFn, err
+}
+
+// getFallbackProofHeight returns the lowest height larger than the proof height at which the client
+// of the given channel stores a consensus state. An ErrProofHeightNotFound error is returned if the
+// ProofHeightFallback param is disabled or the client stores no such consensus state.
+func (k Keeper) getFallbackProofHeight(ctx sdk.Context, portID, channelID string, proofHeight exported.Height) (exported.Height, error) {
+	clientID, _, err := k.ChannelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetProofHeightFallback(ctx) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrProofHeightNotFound,
+			"client %s stores no consensus state at proof height %s, update the client to the proof height or enable the %s param",
+			clientID, proofHeight, types.KeyProofHeightFallback,
+		)
+	}
+
+	fallbackHeight, found := k.ClientKeeper.GetNextClientConsensusStateHeight(ctx, clientID, proofHeight)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProofHeightNotFound, "client %s stores no consensus state at or after proof height %s", clientID, proofHeight)
+	}
+
+	k.ClientKeeper.Logger(ctx).Debug("verifying proof at fallback height", "client-id", clientID, "proof-height", proofHeight.String(), "fallback-height", fallbackHeight.String())
+
+	return fallbackHeight, nil
+}
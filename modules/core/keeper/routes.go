@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+// ValidatePortRoutes checks that every port bound to a module, as listed by the capability keeper,
+// is owned by a single module which has a route in the IBC router. It is meant to be called at the
+// end of InitChain so that a mis-wired application fails at startup instead of on the first
+// packet sent or received on the port.
+func (k Keeper) ValidatePortRoutes(ctx sdk.Context, capabilityKeeper types.CapabilityKeeper) error {
+	if k.Router == nil {
+		return sdkerrors.Wrap(porttypes.ErrInvalidRoute, "IBC router is not set")
+	}
+
+	prefix := host.KeyPortPrefix + "/"
+	for index := uint64(1); index < capabilityKeeper.GetLatestIndex(ctx); index++ {
+		capabilityOwners, found := capabilityKeeper.GetOwners(ctx, index)
+		if !found {
+			continue
+		}
+
+		var (
+			portID  string
+			modules []string
+		)
+		for _, owner := range capabilityOwners.Owners {
+			if owner.Module == host.ModuleName && strings.HasPrefix(owner.Name, prefix) {
+				portID = strings.TrimPrefix(owner.Name, prefix)
+				continue
+			}
+
+			modules = append(modules, owner.Module)
+		}
+
+		// not a port capability
+		if portID == "" {
+			continue
+		}
+
+		if len(modules) != 1 {
+			return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "port %s must be bound to a single module, owners: %v", portID, modules)
+		}
+
+		if !k.Router.HasRoute(modules[0]) {
+			return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "port %s is bound to module %s which has no route in the IBC router", portID, modules[0])
+		}
+	}
+
+	return nil
+}
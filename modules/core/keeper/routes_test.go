@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatePortRoutes() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+
+	// the ports bound at genesis are routed
+	suite.Require().NoError(app.IBCKeeper.ValidatePortRoutes(ctx, app.CapabilityKeeper))
+
+	// the router has no route to the modules the ports are bound to
+	keeper := *app.IBCKeeper
+	keeper.Router = porttypes.NewRouter()
+	suite.Require().ErrorIs(keeper.ValidatePortRoutes(ctx, app.CapabilityKeeper), porttypes.ErrInvalidRoute)
+
+	// the router is not set
+	keeper.Router = nil
+	suite.Require().ErrorIs(keeper.ValidatePortRoutes(ctx, app.CapabilityKeeper), porttypes.ErrInvalidRoute)
+
+	// a port is bound without being claimed by a module
+	app.IBCKeeper.PortKeeper.BindPort(ctx, "unclaimed")
+	suite.Require().ErrorIs(app.IBCKeeper.ValidatePortRoutes(ctx, app.CapabilityKeeper), porttypes.ErrInvalidRoute)
+}
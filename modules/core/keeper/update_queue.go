@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ApplyQueuedClientUpdates applies the updates queued during the block for every client with
+// batched updates and clears the queues. It is called at the end of every block.
+func (k Keeper) ApplyQueuedClientUpdates(ctx sdk.Context) {
+	for _, clientID := range k.ClientKeeper.GetQueuedUpdateClientIDs(ctx) {
+		queue := k.ClientKeeper.GetQueuedClientUpdates(ctx, clientID)
+		k.ClientKeeper.DeleteQueuedClientUpdates(ctx, clientID)
+
+		k.applyQueuedClientUpdates(ctx, clientID, queue.Updates)
+	}
+}
+
+// applyQueuedClientUpdates attempts the queued updates of the given client starting from the
+// highest header, updates queued first taking precedence on equal heights, and applies the
+// first valid update. The state changes of a failed update are discarded. The signer of the
+// applied update is reported to the relayer hooks, and as the submitter of the misbehaviour
+// if the applied header freezes the client.
+func (k Keeper) applyQueuedClientUpdates(ctx sdk.Context, clientID string, updates []clienttypes.QueuedClientUpdate) {
+	type queuedHeader struct {
+		header exported.Header
+		signer string
+	}
+
+	var headers []queuedHeader
+	for _, update := range updates {
+		header, err := clienttypes.UnpackHeader(update.Header)
+		if err != nil {
+			continue
+		}
+		headers = append(headers, queuedHeader{header: header, signer: update.Signer})
+	}
+
+	sort.SliceStable(headers, func(i, j int) bool {
+		return headers[j].header.GetHeight().LT(headers[i].header.GetHeight())
+	})
+
+	for _, queued := range headers {
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.ClientKeeper.UpdateClient(cacheCtx, clientID, queued.header); err != nil {
+			k.ClientKeeper.Logger(ctx).Debug("queued client update failed", "client-id", clientID, "height", queued.header.GetHeight().String(), "error", err.Error())
+			continue
+		}
+
+		writeFn()
+
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		// the client state is known to exist after a successful update
+		clientState, _ := k.ClientKeeper.GetClientState(ctx, clientID)
+		if clientState.Status(ctx, k.ClientKeeper.ClientStore(ctx, clientID), k.cdc) == exported.Frozen {
+			if err := k.afterClientFrozen(ctx, clientID, queued.signer); err != nil {
+				k.ClientKeeper.Logger(ctx).Error("failed to report frozen client submitter", "client-id", clientID, "error", err.Error())
+			}
+		}
+
+		if err := k.afterClientUpdated(ctx, clientID, queued.signer); err != nil {
+			k.ClientKeeper.Logger(ctx).Error("failed to report client update relayer", "client-id", clientID, "error", err.Error())
+		}
+
+		return
+	}
+}
@@ -21,6 +21,7 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/client/cli"
@@ -72,6 +73,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	clienttypes.RegisterQueryHandlerClient(context.Background(), mux, clienttypes.NewQueryClient(clientCtx))
 	connectiontypes.RegisterQueryHandlerClient(context.Background(), mux, connectiontypes.NewQueryClient(clientCtx))
 	channeltypes.RegisterQueryHandlerClient(context.Background(), mux, channeltypes.NewQueryClient(clientCtx))
+	types.RegisterStateQueryHandlerClient(context.Background(), mux, types.NewStateQueryClient(clientCtx))
 }
 
 // GetTxCmd returns the root tx command for the ibc module.
@@ -112,7 +114,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants registers the ibc module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO:
+	channelkeeper.RegisterInvariants(ir, am.keeper.ChannelKeeper)
 }
 
 // Route returns the message routing key for the ibc module.
@@ -165,11 +167,14 @@ func (AppModule) ConsensusVersion() uint64 { return 2 }
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	ibcclient.BeginBlocker(ctx, am.keeper.ClientKeeper)
+	am.keeper.ChannelKeeper.PruneWriteAcknowledgementEventData(ctx)
+	am.keeper.ChannelKeeper.PrunePacketHeights(ctx)
 }
 
-// EndBlock returns the end blocker for the ibc module. It returns no validator
-// updates.
+// EndBlock returns the end blocker for the ibc module. It applies the client updates
+// queued during the block and returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ApplyQueuedClientUpdates(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,193 @@
+/*
+Package pagination implements the pagination of the IBC list queries. It extends the SDK
+pagination with consistent count totals and next keys for filtered queries.
+*/
+package pagination
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MaxSequenceRange is the maximum number of sequences of a range paginated by PaginateSequences.
+// It bounds the number of sequences checked by a single page request.
+const MaxSequenceRange uint64 = 100_000
+
+// Paginate paginates the entries of the provided prefix store in the same way as the
+// SDK query.Paginate function. The total number of entries is counted for key and offset
+// based page requests alike.
+func Paginate(
+	prefixStore sdk.KVStore,
+	pageRequest *query.PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*query.PageResponse, error) {
+	return FilteredPaginate(prefixStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if err := onResult(key, value); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+}
+
+// FilteredPaginate paginates the entries of the provided prefix store in the same way as
+// the SDK query.FilteredPaginate function. onResult must only append an entry to the
+// results if accumulate is true and return whether the entry matches the filter of the
+// request. The total number of matching entries is counted separately for all page requests
+// which request a count total or supply no limit, by calling onResult with accumulate set
+// to false for every entry of the store. The SDK does not count the total of key based page
+// requests and, while counting the total of offset based page requests, overwrites the
+// next key of the page with the key of the last entry not matching the filter.
+func FilteredPaginate(
+	prefixStore sdk.KVStore,
+	pageRequest *query.PageRequest,
+	onResult func(key []byte, value []byte, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	// the SDK counts the total whenever no limit is supplied
+	countTotal := pageRequest.CountTotal || pageRequest.Limit == 0
+
+	pageReq := *pageRequest
+	pageReq.CountTotal = false
+	if pageReq.Limit == 0 {
+		pageReq.Limit = query.DefaultLimit
+	}
+
+	pageRes, err := query.FilteredPaginate(prefixStore, &pageReq, onResult)
+	if err != nil {
+		return nil, err
+	}
+
+	if !countTotal {
+		return pageRes, nil
+	}
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var total uint64
+	for ; iterator.Valid(); iterator.Next() {
+		hit, err := onResult(iterator.Key(), iterator.Value(), false)
+		if err != nil {
+			return nil, err
+		}
+
+		if hit {
+			total++
+		}
+	}
+
+	pageRes.Total = total
+	return pageRes, nil
+}
+
+// PaginateSequences paginates the sequences of the inclusive range from start to end in
+// ascending order. onResult follows the semantics of FilteredPaginate: it must only append
+// a sequence to the results if accumulate is true and return whether the sequence matches
+// the filter of the request. The next key of a page is the big endian encoding of the next
+// matching sequence. Reverse page requests are not supported.
+func PaginateSequences(
+	start, end uint64,
+	pageRequest *query.PageRequest,
+	onResult func(sequence uint64, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	if start == 0 {
+		return nil, fmt.Errorf("start sequence cannot be 0")
+	}
+
+	if end < start {
+		return nil, fmt.Errorf("end sequence %d cannot be less than start sequence %d", end, start)
+	}
+
+	if end-start >= MaxSequenceRange {
+		return nil, fmt.Errorf("sequence range [%d, %d] exceeds the maximum range of %d sequences", start, end, MaxSequenceRange)
+	}
+
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	if pageRequest.Reverse {
+		return nil, fmt.Errorf("reverse pagination is not supported for sequence ranges")
+	}
+
+	if len(pageRequest.Key) != 0 && pageRequest.Offset > 0 {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	// the page starts at the sequence encoded by the key, if any
+	first := start
+	if len(pageRequest.Key) != 0 {
+		if len(pageRequest.Key) != 8 {
+			return nil, fmt.Errorf("invalid pagination key, expected 8 bytes, got %d", len(pageRequest.Key))
+		}
+
+		first = sdk.BigEndianToUint64(pageRequest.Key)
+		if first < start || first > end {
+			return nil, fmt.Errorf("pagination key sequence %d is not within the range [%d, %d]", first, start, end)
+		}
+	}
+
+	// the SDK counts the total whenever no limit is supplied
+	countTotal := pageRequest.CountTotal || pageRequest.Limit == 0
+
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the sequences before the page are only checked to count the total
+	from := first
+	if countTotal {
+		from = start
+	}
+
+	var (
+		numHits uint64
+		total   uint64
+		nextKey []byte
+	)
+	for sequence := from; ; sequence++ {
+		inPage := sequence >= first && nextKey == nil
+
+		// the sequences after the page are only checked to count the total
+		if inPage || countTotal {
+			accumulate := inPage && numHits >= pageRequest.Offset && numHits < pageRequest.Offset+limit
+
+			hit, err := onResult(sequence, accumulate)
+			if err != nil {
+				return nil, err
+			}
+
+			if hit {
+				total++
+
+				if inPage {
+					if numHits == pageRequest.Offset+limit {
+						nextKey = sdk.Uint64ToBigEndian(sequence)
+					}
+
+					numHits++
+				}
+			}
+		}
+
+		if sequence == end || (nextKey != nil && !countTotal) {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return pageRes, nil
+}
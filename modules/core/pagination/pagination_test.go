@@ -0,0 +1,159 @@
+package pagination_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+)
+
+func TestFilteredPaginate(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 10; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte{byte(i)})
+	}
+
+	// only even values match the filter
+	paginate := func(pageReq *query.PageRequest) ([]byte, *query.PageResponse) {
+		var values []byte
+		pageRes, err := pagination.FilteredPaginate(store, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+			if value[0]%2 != 0 {
+				return false, nil
+			}
+
+			if accumulate {
+				values = append(values, value[0])
+			}
+
+			return true, nil
+		})
+		require.NoError(t, err)
+
+		return values, pageRes
+	}
+
+	values, pageRes := paginate(&query.PageRequest{Limit: 2, CountTotal: true})
+	require.Equal(t, []byte{0, 2}, values)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	// the next key is the key of the next matching entry
+	require.Equal(t, []byte("key4"), pageRes.NextKey)
+
+	values, pageRes = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 2, CountTotal: true})
+	require.Equal(t, []byte{4, 6}, values)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	values, pageRes = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.Equal(t, []byte{8}, values)
+	require.Equal(t, uint64(0), pageRes.Total)
+	require.Nil(t, pageRes.NextKey)
+
+	values, pageRes = paginate(&query.PageRequest{Offset: 1, Limit: 2, Reverse: true})
+	require.Equal(t, []byte{6, 4}, values)
+	require.Equal(t, uint64(0), pageRes.Total)
+
+	// the total is counted if no limit is supplied
+	values, pageRes = paginate(nil)
+	require.Equal(t, []byte{0, 2, 4, 6, 8}, values)
+	require.Equal(t, uint64(5), pageRes.Total)
+}
+
+func TestPaginate(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 3; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte{byte(i)})
+	}
+
+	var values []byte
+	pageRes, err := pagination.Paginate(store, &query.PageRequest{Key: []byte("key1"), Limit: 1, CountTotal: true}, func(_, value []byte) error {
+		values = append(values, value[0])
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, values)
+	require.Equal(t, []byte("key2"), pageRes.NextKey)
+	require.Equal(t, uint64(3), pageRes.Total)
+}
+
+func TestPaginateSequences(t *testing.T) {
+	// only odd sequences match the filter
+	paginate := func(start, end uint64, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+		var sequences []uint64
+		pageRes, err := pagination.PaginateSequences(start, end, pageReq, func(sequence uint64, accumulate bool) (bool, error) {
+			if sequence%2 == 0 {
+				return false, nil
+			}
+
+			if accumulate {
+				sequences = append(sequences, sequence)
+			}
+
+			return true, nil
+		})
+
+		return sequences, pageRes, err
+	}
+
+	sequences, pageRes, err := paginate(1, 10, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, sequences)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	// the next key is the sequence of the next matching entry
+	require.Equal(t, sdk.Uint64ToBigEndian(5), pageRes.NextKey)
+
+	sequences, pageRes, err = paginate(1, 10, &query.PageRequest{Key: pageRes.NextKey, Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 7}, sequences)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	sequences, pageRes, err = paginate(1, 10, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{9}, sequences)
+	require.Equal(t, uint64(0), pageRes.Total)
+	require.Nil(t, pageRes.NextKey)
+
+	sequences, pageRes, err = paginate(4, 20, &query.PageRequest{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 9}, sequences)
+	require.Equal(t, sdk.Uint64ToBigEndian(11), pageRes.NextKey)
+
+	// the total is counted if no limit is supplied
+	sequences, pageRes, err = paginate(1, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5, 7, 9}, sequences)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	// the range may end at the maximum sequence
+	sequences, _, err = paginate(math.MaxUint64-1, math.MaxUint64, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{math.MaxUint64}, sequences)
+
+	_, _, err = paginate(0, 10, nil)
+	require.Error(t, err)
+
+	_, _, err = paginate(10, 9, nil)
+	require.Error(t, err)
+
+	_, _, err = paginate(1, pagination.MaxSequenceRange+1, nil)
+	require.Error(t, err)
+
+	_, _, err = paginate(1, 10, &query.PageRequest{Reverse: true})
+	require.Error(t, err)
+
+	_, _, err = paginate(1, 10, &query.PageRequest{Key: sdk.Uint64ToBigEndian(5), Offset: 1})
+	require.Error(t, err)
+
+	_, _, err = paginate(1, 10, &query.PageRequest{Key: []byte("key")})
+	require.Error(t, err)
+
+	_, _, err = paginate(1, 10, &query.PageRequest{Key: sdk.Uint64ToBigEndian(11)})
+	require.Error(t, err)
+}
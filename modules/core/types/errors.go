@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// IBC core sentinel errors
+var (
+	ErrMsgDisabled           = sdkerrors.Register(host.ModuleName, 2, "message disabled")
+	ErrMsgRestricted         = sdkerrors.Register(host.ModuleName, 3, "message signer not allowed")
+	ErrChannelOpenRestricted = sdkerrors.Register(host.ModuleName, 4, "channel opening signer not allowed")
+	ErrNotLocalChannel       = sdkerrors.Register(host.ModuleName, 5, "channel does not use the localhost client")
+	ErrProofHeightNotFound   = sdkerrors.Register(host.ModuleName, 6, "no consensus state stored at proof height")
+	ErrInvalidGenesisOrder   = sdkerrors.Register(host.ModuleName, 7, "invalid genesis initialization order")
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// CapabilityKeeper defines the expected capability keeper used to list the capabilities of the
+// ports bound at genesis
+type CapabilityKeeper interface {
+	GetLatestIndex(ctx sdk.Context) uint64
+	GetOwners(ctx sdk.Context, index uint64) (capabilitytypes.CapabilityOwners, bool)
+}
@@ -16,6 +16,7 @@ func DefaultGenesisState() *GenesisState {
 		ClientGenesis:     clienttypes.DefaultGenesisState(),
 		ConnectionGenesis: connectiontypes.DefaultGenesisState(),
 		ChannelGenesis:    channeltypes.DefaultGenesisState(),
+		Params:            DefaultParams(),
 	}
 }
 
@@ -35,5 +36,9 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.ChannelGenesis.Validate()
+	if err := gs.ChannelGenesis.Validate(); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
 }
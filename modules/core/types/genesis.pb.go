@@ -34,6 +34,8 @@ type GenesisState struct {
 	ConnectionGenesis types1.GenesisState `protobuf:"bytes,2,opt,name=connection_genesis,json=connectionGenesis,proto3" json:"connection_genesis" yaml:"connection_genesis"`
 	// ICS004 - Channel genesis state
 	ChannelGenesis types2.GenesisState `protobuf:"bytes,3,opt,name=channel_genesis,json=channelGenesis,proto3" json:"channel_genesis" yaml:"channel_genesis"`
+	// core message handler parameters
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +92,13 @@ func (m *GenesisState) GetChannelGenesis() types2.GenesisState {
 	return types2.GenesisState{}
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.types.v1.GenesisState")
 }
@@ -97,28 +106,30 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/types/v1/genesis.proto", fileDescriptor_b9a49c5663e6fc59) }
 
 var fileDescriptor_b9a49c5663e6fc59 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0x93, 0x5e, 0xe9, 0x0e, 0x01, 0x8a, 0x1a, 0x01, 0x82, 0x4a, 0xb8, 0x6d, 0xd4, 0x81,
-	0x05, 0x5b, 0xa5, 0x1b, 0x63, 0x17, 0x98, 0xc3, 0xc6, 0x82, 0x12, 0x63, 0x52, 0xa3, 0xc4, 0xa7,
-	0xaa, 0xdd, 0x48, 0x7d, 0x0b, 0x1e, 0xab, 0x63, 0x47, 0xc4, 0x50, 0xa1, 0xe4, 0x0d, 0x78, 0x02,
-	0xd4, 0xd8, 0x24, 0xa9, 0xbc, 0x45, 0xff, 0xf9, 0xce, 0xff, 0x1d, 0x25, 0xf1, 0x06, 0x3c, 0xa6,
-	0x84, 0xc2, 0x92, 0x11, 0xb5, 0x5e, 0x30, 0x49, 0xf2, 0x09, 0x49, 0x98, 0x60, 0x92, 0x4b, 0xbc,
-	0x58, 0x82, 0x02, 0xbf, 0xc7, 0x63, 0x8a, 0xf7, 0x00, 0xae, 0x00, 0x9c, 0x4f, 0xfa, 0x67, 0x09,
-	0x24, 0x50, 0x4d, 0xc9, 0xfe, 0x49, 0x83, 0xfd, 0x61, 0xdd, 0x44, 0x53, 0xce, 0x84, 0xb2, 0xaa,
-	0xfa, 0xe3, 0x86, 0x00, 0x21, 0x18, 0x55, 0x1c, 0x84, 0x4d, 0x8d, 0x1a, 0x6a, 0x1e, 0x09, 0xc1,
-	0x52, 0x0b, 0x09, 0xbe, 0x3a, 0xde, 0xf1, 0x83, 0x4e, 0x9e, 0x54, 0xa4, 0x98, 0xff, 0xe6, 0x75,
-	0xb5, 0xf4, 0xc5, 0x80, 0x97, 0xee, 0xd0, 0xbd, 0x39, 0xba, 0x1b, 0xe2, 0xfa, 0x7a, 0x3d, 0xc7,
-	0xf9, 0x04, 0xb7, 0x37, 0x67, 0xd7, 0x9b, 0xdd, 0xc0, 0xf9, 0xd9, 0x0d, 0xce, 0xd7, 0x51, 0x96,
-	0xde, 0x07, 0x87, 0x2d, 0x41, 0x78, 0xa2, 0x03, 0xb3, 0xe2, 0xe7, 0x9e, 0xdf, 0x9c, 0x5e, 0xbb,
-	0x3a, 0x95, 0x6b, 0xdc, 0x72, 0xd5, 0x8c, 0xe5, 0x1b, 0x19, 0xdf, 0x95, 0xf1, 0x59, 0x6d, 0x41,
-	0xd8, 0x6b, 0xc2, 0x3f, 0xef, 0xbb, 0x77, 0x6a, 0x5e, 0x46, 0x2d, 0xfd, 0x57, 0x49, 0x47, 0x2d,
-	0xa9, 0x06, 0x2c, 0x23, 0x32, 0xc6, 0x0b, 0x63, 0x3c, 0xec, 0x09, 0xc2, 0xae, 0x49, 0xcc, 0xd2,
-	0xec, 0x71, 0x53, 0x20, 0x77, 0x5b, 0x20, 0xf7, 0xbb, 0x40, 0xee, 0x47, 0x89, 0x9c, 0x6d, 0x89,
-	0x9c, 0xcf, 0x12, 0x39, 0xcf, 0x38, 0xe1, 0x6a, 0xbe, 0x8a, 0x31, 0x85, 0x8c, 0x50, 0x90, 0x19,
-	0x48, 0xc2, 0x63, 0x7a, 0x9b, 0x00, 0xc9, 0xa7, 0x24, 0x83, 0xd7, 0x55, 0xca, 0x64, 0xeb, 0x5f,
-	0x8a, 0xff, 0x57, 0x5f, 0x6b, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x81, 0x4d, 0x5d, 0x1d, 0x64,
-	0x02, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x4f, 0xf2, 0x30,
+	0x18, 0xc7, 0xb7, 0x17, 0xc2, 0x61, 0xaf, 0x62, 0x58, 0xd4, 0x00, 0x89, 0x05, 0x16, 0x0e, 0x5e,
+	0x6c, 0x83, 0x1c, 0x4c, 0x3c, 0x72, 0xd1, 0xa3, 0x99, 0x37, 0x2f, 0x66, 0xab, 0x75, 0xd4, 0x6c,
+	0x2d, 0xa1, 0x65, 0x09, 0xdf, 0xc2, 0xc4, 0x2f, 0xc5, 0x91, 0xa3, 0x27, 0x62, 0xe0, 0x1b, 0xf8,
+	0x09, 0x0c, 0x6d, 0xd9, 0x46, 0x7a, 0x5b, 0x9e, 0xe7, 0xf7, 0xfc, 0x7f, 0x6d, 0x9f, 0x79, 0x3d,
+	0x1a, 0x63, 0x84, 0xf9, 0x9c, 0x20, 0xb9, 0x9c, 0x11, 0x81, 0xf2, 0x11, 0x4a, 0x08, 0x23, 0x82,
+	0x0a, 0x38, 0x9b, 0x73, 0xc9, 0xfd, 0x16, 0x8d, 0x31, 0xdc, 0x03, 0x50, 0x01, 0x30, 0x1f, 0x75,
+	0xcf, 0x13, 0x9e, 0x70, 0xd5, 0x45, 0xfb, 0x2f, 0x0d, 0x76, 0xfb, 0x45, 0x12, 0x4e, 0x29, 0x61,
+	0xd2, 0x8a, 0xea, 0x0e, 0x4b, 0x82, 0x33, 0x46, 0xb0, 0xa4, 0x9c, 0xd9, 0xd4, 0xa0, 0xa4, 0xa6,
+	0x11, 0x63, 0x24, 0xb5, 0x11, 0x60, 0x1f, 0x7a, 0x16, 0xcd, 0xa3, 0xcc, 0xf4, 0x83, 0xaf, 0x9a,
+	0x77, 0xf2, 0xa0, 0x27, 0x9e, 0x65, 0x24, 0x89, 0xff, 0xee, 0x35, 0xf5, 0xa1, 0x5e, 0x4d, 0x50,
+	0xdb, 0xed, 0xbb, 0xd7, 0xff, 0x6f, 0xfb, 0xb0, 0xb8, 0x9d, 0xee, 0xc3, 0x7c, 0x04, 0xab, 0x93,
+	0x93, 0xab, 0xd5, 0xa6, 0xe7, 0xfc, 0x6e, 0x7a, 0x17, 0xcb, 0x28, 0x4b, 0xef, 0x83, 0xe3, 0x94,
+	0x20, 0x3c, 0xd5, 0x05, 0x33, 0xe2, 0xe7, 0x9e, 0x5f, 0x5e, 0xad, 0x70, 0xfd, 0x53, 0xae, 0x61,
+	0xc5, 0x55, 0x30, 0x96, 0x6f, 0x60, 0x7c, 0x1d, 0xe3, 0xb3, 0xd2, 0x82, 0xb0, 0x55, 0x16, 0x0f,
+	0xde, 0x0f, 0xef, 0xcc, 0x3c, 0x56, 0x21, 0xad, 0x29, 0xe9, 0xa0, 0x22, 0xd5, 0x80, 0x65, 0x04,
+	0xc6, 0x78, 0x69, 0x8c, 0xc7, 0x39, 0x41, 0xd8, 0x34, 0x95, 0x83, 0xeb, 0xce, 0x6b, 0xe8, 0xc7,
+	0x6e, 0xd7, 0x95, 0xa2, 0x03, 0xad, 0x3f, 0x04, 0x3e, 0x29, 0x60, 0x52, 0xdf, 0x47, 0x87, 0x06,
+	0x9f, 0x3c, 0xae, 0xb6, 0xc0, 0x5d, 0x6f, 0x81, 0xfb, 0xb3, 0x05, 0xee, 0xe7, 0x0e, 0x38, 0xeb,
+	0x1d, 0x70, 0xbe, 0x77, 0xc0, 0x79, 0x81, 0x09, 0x95, 0xd3, 0x45, 0x0c, 0x31, 0xcf, 0x10, 0xe6,
+	0x22, 0xe3, 0x02, 0xd1, 0x18, 0xdf, 0x24, 0x1c, 0xe5, 0x63, 0x94, 0xf1, 0xb7, 0x45, 0x4a, 0x44,
+	0x65, 0xdf, 0x71, 0x43, 0xad, 0x79, 0xfc, 0x37, 0x00, 0xe8, 0x76, 0x41, 0xa7, 0xbd, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +152,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.ChannelGenesis.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -197,6 +218,8 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ChannelGenesis.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -334,6 +357,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RelayerHooks defines the interface used by modules to be notified of the relaying
+// messages processed by the IBC message server.
+type RelayerHooks interface {
+	// AfterClientUpdated is called after a MsgUpdateClient or MsgBatchUpdateClient submitted
+	// by the provided relayer has been applied. The updates of clients with batched updates
+	// are reported at the end of the block, for the relayer of the queued update applied.
+	AfterClientUpdated(ctx sdk.Context, clientID string, relayer sdk.AccAddress)
+
+	// ClientUpdateGas returns the gas consumed by AfterClientUpdated, which is added to the
+	// client update gas estimates.
+	ClientUpdateGas() sdk.Gas
+
+	// AfterPacketRelayed is called after a MsgRecvPacket, MsgAcknowledgement, MsgTimeout or
+	// MsgTimeoutOnClose submitted by the provided relayer has been processed. Messages relaying
+	// packets which were already relayed are no-ops and are reported as redundant.
+	AfterPacketRelayed(ctx sdk.Context, msg sdk.Msg, relayer sdk.AccAddress, redundant bool)
+}
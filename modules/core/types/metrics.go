@@ -9,4 +9,5 @@ const (
 	LabelTimeoutType        = "timeout_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
+	LabelReason             = "reason"
 )
@@ -0,0 +1,290 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// coreMsgTypeURLPrefix is the type URL prefix of all core IBC messages.
+const coreMsgTypeURLPrefix = "/ibc.core."
+
+// moduleNameRegex matches the module names used as the signer of the core messages routed by
+// modules, e.g. the interchain accounts controller.
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+var (
+	// KeyDisabledMsgs is store's key for DisabledMsgs parameter
+	KeyDisabledMsgs = []byte("DisabledMsgs")
+	// KeyRestrictedMsgs is store's key for RestrictedMsgs parameter
+	KeyRestrictedMsgs = []byte("RestrictedMsgs")
+	// KeyChannelOpenRestrictions is store's key for ChannelOpenRestrictions parameter
+	KeyChannelOpenRestrictions = []byte("ChannelOpenRestrictions")
+	// KeyProofHeightFallback is store's key for ProofHeightFallback parameter
+	KeyProofHeightFallback = []byte("ProofHeightFallback")
+	// KeyCircuitBreakers is store's key for CircuitBreakers parameter
+	KeyCircuitBreakers = []byte("CircuitBreakers")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new parameter configuration for the core IBC message handlers
+func NewParams(disabledMsgs []string, restrictedMsgs []MsgRestriction, channelOpenRestrictions []ChannelOpenRestriction, proofHeightFallback bool, circuitBreakers []string) Params {
+	return Params{
+		DisabledMsgs:            disabledMsgs,
+		RestrictedMsgs:          restrictedMsgs,
+		ChannelOpenRestrictions: channelOpenRestrictions,
+		ProofHeightFallback:     proofHeightFallback,
+		CircuitBreakers:         circuitBreakers,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the core IBC message handlers,
+// enabling all core messages for all signers, allowing channels to be opened on all ports and
+// disabling the proof height fallback, without any circuit breaker.
+func DefaultParams() Params {
+	return NewParams(nil, nil, nil, false, nil)
+}
+
+// NewMsgRestriction creates a new MsgRestriction instance
+func NewMsgRestriction(msgTypeURL string, allowedSigners ...string) MsgRestriction {
+	return MsgRestriction{
+		MsgTypeUrl:     msgTypeURL,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// NewChannelOpenRestriction creates a new ChannelOpenRestriction instance
+func NewChannelOpenRestriction(portID string, allowedSigners ...string) ChannelOpenRestriction {
+	return ChannelOpenRestriction{
+		PortId:         portID,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// Validate ensures every disabled and restricted message is a core IBC message listed at most once,
+// every message restriction allows at least one valid signer, every restricted port is a valid
+// port identifier listed at most once and every circuit breaker is a valid address listed at most
+// once.
+func (p Params) Validate() error {
+	if err := validateDisabledMsgs(p.DisabledMsgs); err != nil {
+		return err
+	}
+
+	if err := validateRestrictedMsgs(p.RestrictedMsgs); err != nil {
+		return err
+	}
+
+	if err := validateChannelOpenRestrictions(p.ChannelOpenRestrictions); err != nil {
+		return err
+	}
+
+	return validateCircuitBreakers(p.CircuitBreakers)
+}
+
+// IsMsgDisabled returns true if the provided message type URL is disabled.
+func (p Params) IsMsgDisabled(msgTypeURL string) bool {
+	for _, disabled := range p.DisabledMsgs {
+		if disabled == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetMsgRestriction returns the restriction of the provided message type URL, if any.
+func (p Params) GetMsgRestriction(msgTypeURL string) (MsgRestriction, bool) {
+	for _, restriction := range p.RestrictedMsgs {
+		if restriction.MsgTypeUrl == msgTypeURL {
+			return restriction, true
+		}
+	}
+
+	return MsgRestriction{}, false
+}
+
+// GetChannelOpenRestriction returns the channel opening restriction of the provided port, if any.
+func (p Params) GetChannelOpenRestriction(portID string) (ChannelOpenRestriction, bool) {
+	for _, restriction := range p.ChannelOpenRestrictions {
+		if restriction.PortId == portID {
+			return restriction, true
+		}
+	}
+
+	return ChannelOpenRestriction{}, false
+}
+
+// IsCircuitBreaker returns true if the provided signer is a circuit breaker.
+func (p Params) IsCircuitBreaker(signer string) bool {
+	return containsSigner(p.CircuitBreakers, signer)
+}
+
+// IsSignerAllowed returns true if the provided signer is allowed to sign the restricted message.
+func (r MsgRestriction) IsSignerAllowed(signer string) bool {
+	return containsSigner(r.AllowedSigners, signer)
+}
+
+// IsSignerAllowed returns true if the provided signer is allowed to open channels on the
+// restricted port.
+func (r ChannelOpenRestriction) IsSignerAllowed(signer string) bool {
+	return containsSigner(r.AllowedSigners, signer)
+}
+
+func containsSigner(allowedSigners []string, signer string) bool {
+	for _, allowed := range allowedSigners {
+		if allowed == signer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDisabledMsgs, &p.DisabledMsgs, validateDisabledMsgs),
+		paramtypes.NewParamSetPair(KeyRestrictedMsgs, &p.RestrictedMsgs, validateRestrictedMsgs),
+		paramtypes.NewParamSetPair(KeyChannelOpenRestrictions, &p.ChannelOpenRestrictions, validateChannelOpenRestrictions),
+		paramtypes.NewParamSetPair(KeyProofHeightFallback, &p.ProofHeightFallback, validateProofHeightFallback),
+		paramtypes.NewParamSetPair(KeyCircuitBreakers, &p.CircuitBreakers, validateCircuitBreakers),
+	}
+}
+
+func validateDisabledMsgs(i interface{}) error {
+	disabledMsgs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range disabledMsgs {
+		if err := validateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+
+		if seen[msgTypeURL] {
+			return fmt.Errorf("message %s is disabled more than once", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+func validateRestrictedMsgs(i interface{}) error {
+	restrictedMsgs, ok := i.([]MsgRestriction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, restriction := range restrictedMsgs {
+		if err := validateMsgTypeURL(restriction.MsgTypeUrl); err != nil {
+			return err
+		}
+
+		if seen[restriction.MsgTypeUrl] {
+			return fmt.Errorf("message %s is restricted more than once", restriction.MsgTypeUrl)
+		}
+		seen[restriction.MsgTypeUrl] = true
+
+		if len(restriction.AllowedSigners) == 0 {
+			return fmt.Errorf("restriction of message %s must allow at least one signer", restriction.MsgTypeUrl)
+		}
+
+		for _, signer := range restriction.AllowedSigners {
+			if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+				return fmt.Errorf("invalid signer %s allowed for message %s: %w", signer, restriction.MsgTypeUrl, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateChannelOpenRestrictions(i interface{}) error {
+	restrictions, ok := i.([]ChannelOpenRestriction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, restriction := range restrictions {
+		if err := host.PortIdentifierValidator(restriction.PortId); err != nil {
+			return err
+		}
+
+		if seen[restriction.PortId] {
+			return fmt.Errorf("channel opening on port %s is restricted more than once", restriction.PortId)
+		}
+		seen[restriction.PortId] = true
+
+		for _, signer := range restriction.AllowedSigners {
+			if err := validateSignerOrModule(signer); err != nil {
+				return fmt.Errorf("invalid signer allowed to open channels on port %s: %w", restriction.PortId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCircuitBreakers(i interface{}) error {
+	circuitBreakers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, circuitBreaker := range circuitBreakers {
+		if _, err := sdk.AccAddressFromBech32(circuitBreaker); err != nil {
+			return fmt.Errorf("invalid circuit breaker %s: %w", circuitBreaker, err)
+		}
+
+		if seen[circuitBreaker] {
+			return fmt.Errorf("circuit breaker %s is listed more than once", circuitBreaker)
+		}
+		seen[circuitBreaker] = true
+	}
+
+	return nil
+}
+
+func validateProofHeightFallback(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateSignerOrModule ensures the provided signer is either a bech32 account address or a
+// module name. Signers with the account address prefix must be valid addresses.
+func validateSignerOrModule(signer string) error {
+	_, err := sdk.AccAddressFromBech32(signer)
+	if err == nil {
+		return nil
+	}
+
+	if strings.HasPrefix(signer, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") || !moduleNameRegex.MatchString(signer) {
+		return fmt.Errorf("%s is neither a valid address nor a module name: %w", signer, err)
+	}
+
+	return nil
+}
+
+func validateMsgTypeURL(msgTypeURL string) error {
+	if !strings.HasPrefix(msgTypeURL, coreMsgTypeURLPrefix) {
+		return fmt.Errorf("message %s is not a core IBC message, expected type URL prefix %s", msgTypeURL, coreMsgTypeURLPrefix)
+	}
+
+	return nil
+}
@@ -0,0 +1,1032 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/types/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of core IBC message handler parameters.
+type Params struct {
+	// disabled_msgs are the type URLs of the core messages rejected by the IBC message server,
+	// e.g. "/ibc.core.client.v1.MsgSubmitMisbehaviour".
+	DisabledMsgs []string `protobuf:"bytes,1,rep,name=disabled_msgs,json=disabledMsgs,proto3" json:"disabled_msgs,omitempty" yaml:"disabled_msgs"`
+	// restricted_msgs restrict the signers of core messages. Core messages without a restriction
+	// may be signed by any account.
+	RestrictedMsgs []MsgRestriction `protobuf:"bytes,2,rep,name=restricted_msgs,json=restrictedMsgs,proto3" json:"restricted_msgs" yaml:"restricted_msgs"`
+	// channel_open_restrictions restrict the signers of the MsgChannelOpenInit and MsgChannelOpenTry
+	// messages opening channels on a port. Channels on ports without a restriction may be opened by
+	// any account.
+	ChannelOpenRestrictions []ChannelOpenRestriction `protobuf:"bytes,3,rep,name=channel_open_restrictions,json=channelOpenRestrictions,proto3" json:"channel_open_restrictions" yaml:"channel_open_restrictions"`
+	// proof_height_fallback enables packet messages whose proof height has no consensus state stored
+	// on the client to be verified against the lowest later consensus state of the client. The proof
+	// only verifies if the commitment root of the counterparty did not change between both heights.
+	ProofHeightFallback bool `protobuf:"varint,4,opt,name=proof_height_fallback,json=proofHeightFallback,proto3" json:"proof_height_fallback,omitempty" yaml:"proof_height_fallback"`
+	// circuit_breakers are the bech32 addresses of the accounts allowed to place channels in
+	// quarantine and release them during an incident, in addition to the IBC authority.
+	CircuitBreakers []string `protobuf:"bytes,5,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty" yaml:"circuit_breakers"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2b942ef605afb5f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDisabledMsgs() []string {
+	if m != nil {
+		return m.DisabledMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetRestrictedMsgs() []MsgRestriction {
+	if m != nil {
+		return m.RestrictedMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetChannelOpenRestrictions() []ChannelOpenRestriction {
+	if m != nil {
+		return m.ChannelOpenRestrictions
+	}
+	return nil
+}
+
+func (m *Params) GetProofHeightFallback() bool {
+	if m != nil {
+		return m.ProofHeightFallback
+	}
+	return false
+}
+
+func (m *Params) GetCircuitBreakers() []string {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+// MsgRestriction defines the accounts allowed to sign a core message.
+type MsgRestriction struct {
+	// type URL of the restricted core message, e.g. "/ibc.core.channel.v1.MsgChannelOpenInit"
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// bech32 addresses of the accounts allowed to sign the message
+	AllowedSigners []string `protobuf:"bytes,2,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty" yaml:"allowed_signers"`
+}
+
+func (m *MsgRestriction) Reset()         { *m = MsgRestriction{} }
+func (m *MsgRestriction) String() string { return proto.CompactTextString(m) }
+func (*MsgRestriction) ProtoMessage()    {}
+func (*MsgRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2b942ef605afb5f, []int{1}
+}
+func (m *MsgRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestriction.Merge(m, src)
+}
+func (m *MsgRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestriction proto.InternalMessageInfo
+
+func (m *MsgRestriction) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgRestriction) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// ChannelOpenRestriction defines the accounts allowed to open channels on a port.
+type ChannelOpenRestriction struct {
+	// identifier of the restricted port
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// bech32 addresses of the accounts allowed to sign the MsgChannelOpenInit and MsgChannelOpenTry
+	// messages of the port. If empty, channels on the port may only be opened by modules calling the
+	// channel keeper directly.
+	AllowedSigners []string `protobuf:"bytes,2,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty" yaml:"allowed_signers"`
+}
+
+func (m *ChannelOpenRestriction) Reset()         { *m = ChannelOpenRestriction{} }
+func (m *ChannelOpenRestriction) String() string { return proto.CompactTextString(m) }
+func (*ChannelOpenRestriction) ProtoMessage()    {}
+func (*ChannelOpenRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2b942ef605afb5f, []int{2}
+}
+func (m *ChannelOpenRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelOpenRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelOpenRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelOpenRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOpenRestriction.Merge(m, src)
+}
+func (m *ChannelOpenRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelOpenRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOpenRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOpenRestriction proto.InternalMessageInfo
+
+func (m *ChannelOpenRestriction) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelOpenRestriction) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.core.types.v1.Params")
+	proto.RegisterType((*MsgRestriction)(nil), "ibc.core.types.v1.MsgRestriction")
+	proto.RegisterType((*ChannelOpenRestriction)(nil), "ibc.core.types.v1.ChannelOpenRestriction")
+}
+
+func init() { proto.RegisterFile("ibc/core/types/v1/params.proto", fileDescriptor_e2b942ef605afb5f) }
+
+var fileDescriptor_e2b942ef605afb5f = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xad, 0xff, 0xfd, 0x53, 0xa8, 0x19, 0x2d, 0x64, 0x63, 0x0d, 0x03, 0x25, 0xc1, 0xa7, 0x22,
+	0x44, 0xa2, 0xb1, 0x13, 0x48, 0x5c, 0x32, 0x69, 0x1a, 0x87, 0x09, 0x14, 0xc6, 0x85, 0x8b, 0x95,
+	0x38, 0x9e, 0x6b, 0xe6, 0xc4, 0x91, 0x9d, 0x16, 0xf5, 0x2b, 0x70, 0x61, 0x1f, 0x6b, 0xc7, 0x1d,
+	0x39, 0x45, 0xa8, 0xfd, 0x06, 0xf9, 0x04, 0xa8, 0x49, 0xaa, 0x76, 0xa5, 0xdc, 0xb8, 0xd9, 0xef,
+	0x3d, 0xbf, 0xf7, 0xd3, 0xef, 0xc9, 0xd0, 0xe2, 0x11, 0xf1, 0x88, 0x54, 0xd4, 0xcb, 0xa7, 0x19,
+	0xd5, 0xde, 0xe4, 0xd0, 0xcb, 0x42, 0x15, 0x26, 0xda, 0xcd, 0x94, 0xcc, 0xa5, 0xf1, 0x88, 0x47,
+	0xc4, 0x5d, 0xf0, 0x6e, 0xc5, 0xbb, 0x93, 0xc3, 0x83, 0x3d, 0x26, 0x99, 0xac, 0x58, 0x6f, 0x71,
+	0xaa, 0x85, 0x68, 0xde, 0x86, 0x9d, 0x8f, 0xd5, 0x4b, 0xe3, 0x1d, 0x7c, 0x10, 0x73, 0x1d, 0x46,
+	0x82, 0xc6, 0x38, 0xd1, 0x4c, 0x9b, 0xc0, 0x69, 0x0f, 0xbb, 0xbe, 0x59, 0x16, 0xf6, 0xde, 0x34,
+	0x4c, 0xc4, 0x5b, 0x74, 0x8b, 0x46, 0xc1, 0xce, 0xf2, 0x7e, 0xa6, 0x99, 0x36, 0xbe, 0xc2, 0xbe,
+	0xa2, 0x3a, 0x57, 0x9c, 0xe4, 0x4b, 0x83, 0xff, 0x9c, 0xf6, 0xf0, 0xfe, 0xeb, 0xe7, 0xee, 0x1f,
+	0xc3, 0xb8, 0x67, 0x9a, 0x05, 0x8d, 0x98, 0xcb, 0xd4, 0xb7, 0xae, 0x0b, 0xbb, 0x55, 0x16, 0xf6,
+	0x7e, 0x9d, 0xb3, 0xe1, 0x83, 0x82, 0xde, 0x0a, 0xa9, 0xb2, 0x7e, 0x00, 0xf8, 0x84, 0x8c, 0xc2,
+	0x34, 0xa5, 0x02, 0xcb, 0x8c, 0xa6, 0x58, 0xad, 0xcc, 0xb4, 0xd9, 0xae, 0x62, 0x5f, 0x6c, 0x89,
+	0x3d, 0xae, 0xdf, 0x7c, 0xc8, 0x68, 0xba, 0x1e, 0x3f, 0x6c, 0xe2, 0x9d, 0x3a, 0xfe, 0xaf, 0xce,
+	0x28, 0x18, 0x90, 0xad, 0x0e, 0xda, 0x38, 0x87, 0x8f, 0x33, 0x25, 0xe5, 0x05, 0x1e, 0x51, 0xce,
+	0x46, 0x39, 0xbe, 0x08, 0x85, 0x88, 0x42, 0x72, 0x69, 0xfe, 0xef, 0x80, 0xe1, 0x3d, 0xdf, 0x29,
+	0x0b, 0xfb, 0x59, 0xed, 0xbe, 0x55, 0x86, 0x82, 0xdd, 0x0a, 0x3f, 0xad, 0xe0, 0x93, 0x06, 0x35,
+	0x4e, 0xe0, 0x43, 0xc2, 0x15, 0x19, 0xf3, 0x1c, 0x47, 0x8a, 0x86, 0x97, 0x54, 0x69, 0xf3, 0x4e,
+	0xd5, 0xca, 0xd3, 0xb2, 0xb0, 0x07, 0xcd, 0xb8, 0x1b, 0x0a, 0x14, 0xf4, 0x1b, 0xc8, 0x5f, 0x22,
+	0x57, 0x00, 0xf6, 0x6e, 0xaf, 0xdc, 0x78, 0x03, 0x77, 0x12, 0xcd, 0xf0, 0x62, 0x35, 0x78, 0xac,
+	0x84, 0x09, 0x1c, 0x30, 0xec, 0xfa, 0x83, 0xb2, 0xb0, 0x77, 0x6b, 0xdb, 0x75, 0x16, 0x05, 0x30,
+	0xd1, 0xec, 0x7c, 0x9a, 0xd1, 0xcf, 0x4a, 0x18, 0xc7, 0xb0, 0x1f, 0x0a, 0x21, 0xbf, 0xd1, 0x18,
+	0x6b, 0xce, 0x52, 0xaa, 0xea, 0xa6, 0xbb, 0xfe, 0xc1, 0xaa, 0xc2, 0x0d, 0x01, 0x0a, 0x7a, 0x0d,
+	0xf2, 0xa9, 0x01, 0xbe, 0x03, 0xb8, 0xbf, 0xbd, 0x0e, 0xe3, 0x25, 0xbc, 0x9b, 0x49, 0x95, 0x63,
+	0x1e, 0x37, 0x53, 0x19, 0x65, 0x61, 0xf7, 0x9a, 0xed, 0xd5, 0x04, 0x0a, 0x3a, 0x8b, 0xd3, 0xfb,
+	0xf8, 0x9f, 0x0c, 0xe3, 0x9f, 0x5e, 0xcf, 0x2c, 0x70, 0x33, 0xb3, 0xc0, 0xaf, 0x99, 0x05, 0xae,
+	0xe6, 0x56, 0xeb, 0x66, 0x6e, 0xb5, 0x7e, 0xce, 0xad, 0xd6, 0x17, 0x97, 0xf1, 0x7c, 0x34, 0x8e,
+	0x5c, 0x22, 0x13, 0x8f, 0x48, 0x9d, 0x48, 0xed, 0xf1, 0x88, 0xbc, 0x62, 0xd2, 0x9b, 0x1c, 0x79,
+	0x89, 0x8c, 0xc7, 0x82, 0xea, 0xb5, 0x8f, 0x18, 0x75, 0xaa, 0x6f, 0x75, 0xf4, 0x7b, 0x00, 0x66,
+	0x94, 0x10, 0xf5, 0xa1, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakers[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ProofHeightFallback {
+		i--
+		if m.ProofHeightFallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelOpenRestrictions) > 0 {
+		for iNdEx := len(m.ChannelOpenRestrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelOpenRestrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RestrictedMsgs) > 0 {
+		for iNdEx := len(m.RestrictedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestrictedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DisabledMsgs) > 0 {
+		for iNdEx := len(m.DisabledMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgs[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DisabledMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelOpenRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelOpenRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelOpenRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledMsgs) > 0 {
+		for _, s := range m.DisabledMsgs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RestrictedMsgs) > 0 {
+		for _, e := range m.RestrictedMsgs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ChannelOpenRestrictions) > 0 {
+		for _, e := range m.ChannelOpenRestrictions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ProofHeightFallback {
+		n += 2
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, s := range m.CircuitBreakers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelOpenRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgs = append(m.DisabledMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestrictedMsgs = append(m.RestrictedMsgs, MsgRestriction{})
+			if err := m.RestrictedMsgs[len(m.RestrictedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOpenRestrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOpenRestrictions = append(m.ChannelOpenRestrictions, ChannelOpenRestriction{})
+			if err := m.ChannelOpenRestrictions[len(m.ChannelOpenRestrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeightFallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProofHeightFallback = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelOpenRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOpenRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOpenRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+var (
+	msgSubmitMisbehaviour = sdk.MsgTypeURL(&clienttypes.MsgSubmitMisbehaviour{})
+	msgChannelOpenInit    = sdk.MsgTypeURL(&channeltypes.MsgChannelOpenInit{})
+	signer                = sdk.AccAddress("signer").String()
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"disabled and restricted messages", types.NewParams([]string{msgSubmitMisbehaviour}, []types.MsgRestriction{types.NewMsgRestriction(msgChannelOpenInit, signer)}, nil, false, nil), true},
+		{"disabled non core message", types.NewParams([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, false, nil), false},
+		{"duplicate disabled message", types.NewParams([]string{msgSubmitMisbehaviour, msgSubmitMisbehaviour}, nil, nil, false, nil), false},
+		{"restricted non core message", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction("/cosmos.bank.v1beta1.MsgSend", signer)}, nil, false, nil), false},
+		{"duplicate restricted message", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(msgChannelOpenInit, signer), types.NewMsgRestriction(msgChannelOpenInit, signer)}, nil, false, nil), false},
+		{"restriction without signers", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(msgChannelOpenInit)}, nil, false, nil), false},
+		{"restriction with invalid signer", types.NewParams(nil, []types.MsgRestriction{types.NewMsgRestriction(msgChannelOpenInit, "signer")}, nil, false, nil), false},
+		{"channel open restrictions", types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("transfer", signer, "interchainaccounts"), types.NewChannelOpenRestriction("mock")}, false, nil), true},
+		{"channel open restriction with invalid port", types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("(port)", signer)}, false, nil), false},
+		{"duplicate channel open restriction", types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("transfer"), types.NewChannelOpenRestriction("transfer", signer)}, false, nil), false},
+		{"channel open restriction with invalid address", types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("transfer", signer[:len(signer)-1])}, false, nil), false},
+		{"channel open restriction with invalid module name", types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("transfer", "Module Name")}, false, nil), false},
+		{"circuit breakers", types.NewParams(nil, nil, nil, false, []string{signer, sdk.AccAddress("other").String()}), true},
+		{"circuit breaker with invalid address", types.NewParams(nil, nil, nil, false, []string{"signer"}), false},
+		{"duplicate circuit breaker", types.NewParams(nil, nil, nil, false, []string{signer, signer}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsMsgRules(t *testing.T) {
+	params := types.NewParams([]string{msgSubmitMisbehaviour}, []types.MsgRestriction{types.NewMsgRestriction(msgChannelOpenInit, signer)}, nil, false, nil)
+
+	require.True(t, params.IsMsgDisabled(msgSubmitMisbehaviour))
+	require.False(t, params.IsMsgDisabled(msgChannelOpenInit))
+
+	restriction, ok := params.GetMsgRestriction(msgChannelOpenInit)
+	require.True(t, ok)
+	require.True(t, restriction.IsSignerAllowed(signer))
+	require.False(t, restriction.IsSignerAllowed(sdk.AccAddress("other").String()))
+
+	_, ok = params.GetMsgRestriction(msgSubmitMisbehaviour)
+	require.False(t, ok)
+
+	params = types.NewParams(nil, nil, []types.ChannelOpenRestriction{types.NewChannelOpenRestriction("transfer", signer)}, false, nil)
+
+	channelOpenRestriction, ok := params.GetChannelOpenRestriction("transfer")
+	require.True(t, ok)
+	require.True(t, channelOpenRestriction.IsSignerAllowed(signer))
+	require.False(t, channelOpenRestriction.IsSignerAllowed(sdk.AccAddress("other").String()))
+
+	_, ok = params.GetChannelOpenRestriction("mock")
+	require.False(t, ok)
+
+	params = types.NewParams(nil, nil, nil, false, []string{signer})
+
+	require.True(t, params.IsCircuitBreaker(signer))
+	require.False(t, params.IsCircuitBreaker(sdk.AccAddress("other").String()))
+}
@@ -16,6 +16,7 @@ type QueryServer interface {
 	clienttypes.QueryServer
 	connectiontypes.QueryServer
 	channeltypes.QueryServer
+	StateQueryServer
 }
 
 // RegisterQueryService registers each individual IBC submodule query service
@@ -23,4 +24,5 @@ func RegisterQueryService(server grpc.Server, queryService QueryServer) {
 	client.RegisterQueryService(server, queryService)
 	connection.RegisterQueryService(server, queryService)
 	channel.RegisterQueryService(server, queryService)
+	RegisterStateQueryServer(server, queryService)
 }
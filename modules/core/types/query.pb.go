@@ -0,0 +1,789 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/types/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	types1 "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	types2 "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryExportStateRequest is the request type for the Query/ExportState RPC
+// method
+type QueryExportStateRequest struct {
+}
+
+func (m *QueryExportStateRequest) Reset()         { *m = QueryExportStateRequest{} }
+func (m *QueryExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExportStateRequest) ProtoMessage()    {}
+func (*QueryExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{0}
+}
+func (m *QueryExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportStateRequest.Merge(m, src)
+}
+func (m *QueryExportStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExportStateRequest proto.InternalMessageInfo
+
+// QueryExportStateResponse is the response type for the Query/ExportState RPC
+// method
+type QueryExportStateResponse struct {
+	// client states with their client identifiers
+	Clients []types.IdentifiedClientState `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients"`
+	// connection ends with their connection identifiers
+	Connections []types1.IdentifiedConnection `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections"`
+	// channel ends with their port and channel identifiers
+	Channels []types2.IdentifiedChannel `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels"`
+	// commitments of the sent packets which have not been acknowledged or timed
+	// out yet
+	PendingPackets []types2.PacketState `protobuf:"bytes,4,rep,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets" yaml:"pending_packets"`
+	// block height at which the state was queried
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryExportStateResponse) Reset()         { *m = QueryExportStateResponse{} }
+func (m *QueryExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExportStateResponse) ProtoMessage()    {}
+func (*QueryExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{1}
+}
+func (m *QueryExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportStateResponse.Merge(m, src)
+}
+func (m *QueryExportStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExportStateResponse proto.InternalMessageInfo
+
+func (m *QueryExportStateResponse) GetClients() []types.IdentifiedClientState {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+func (m *QueryExportStateResponse) GetConnections() []types1.IdentifiedConnection {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+func (m *QueryExportStateResponse) GetChannels() []types2.IdentifiedChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryExportStateResponse) GetPendingPackets() []types2.PacketState {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return nil
+}
+
+func (m *QueryExportStateResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryExportStateRequest)(nil), "ibc.core.types.v1.QueryExportStateRequest")
+	proto.RegisterType((*QueryExportStateResponse)(nil), "ibc.core.types.v1.QueryExportStateResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/types/v1/query.proto", fileDescriptor_8cc0ad6869acad8f) }
+
+var fileDescriptor_8cc0ad6869acad8f = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x75, 0x0c, 0xe4, 0x4a, 0x20, 0x2c, 0x34, 0xb2, 0x02, 0x69, 0x97, 0x03, 0x8c,
+	0x5f, 0xb6, 0xba, 0xdd, 0x38, 0x82, 0x90, 0xb6, 0x1b, 0x14, 0x4e, 0x5c, 0xa6, 0x34, 0x7d, 0xb8,
+	0x16, 0xa9, 0x5f, 0x56, 0xbb, 0x11, 0xbd, 0x72, 0x82, 0x1b, 0x12, 0x17, 0xfe, 0xa4, 0x1d, 0x27,
+	0x71, 0xe1, 0x34, 0xa1, 0x96, 0xbf, 0x80, 0xbf, 0x00, 0xc5, 0x36, 0x49, 0xd8, 0x40, 0xda, 0xcd,
+	0x79, 0xdf, 0xef, 0xfb, 0xd8, 0x5f, 0xbd, 0x17, 0x72, 0x47, 0x8e, 0x52, 0x9e, 0xe2, 0x0c, 0xb8,
+	0x59, 0xe4, 0xa0, 0x79, 0x31, 0xe0, 0x47, 0x73, 0x98, 0x2d, 0x58, 0x3e, 0x43, 0x83, 0xf4, 0xba,
+	0x1c, 0xa5, 0xac, 0x94, 0x99, 0x95, 0x59, 0x31, 0xe8, 0xde, 0x10, 0x28, 0xd0, 0xaa, 0xbc, 0x3c,
+	0x39, 0x63, 0xf7, 0xb6, 0x40, 0x14, 0x19, 0xf0, 0x24, 0x97, 0x3c, 0x51, 0x0a, 0x4d, 0x62, 0x24,
+	0x2a, 0xed, 0xd5, 0x5e, 0x75, 0x4b, 0x9a, 0x49, 0x50, 0xa6, 0xbc, 0xc6, 0x9d, 0xbc, 0xe1, 0x5e,
+	0x6d, 0x40, 0xa5, 0x20, 0x2d, 0x9b, 0xad, 0xa9, 0xfa, 0xf2, 0xc6, 0xed, 0xda, 0x38, 0x49, 0x94,
+	0x82, 0xcc, 0xba, 0xdc, 0xd1, 0x59, 0xe2, 0x2d, 0x72, 0xf3, 0x65, 0x19, 0xe1, 0xf9, 0xfb, 0x1c,
+	0x67, 0xe6, 0x95, 0x49, 0x0c, 0x0c, 0xe1, 0x68, 0x0e, 0xda, 0xc4, 0x1f, 0xdb, 0x24, 0x3c, 0xaf,
+	0xe9, 0x1c, 0x95, 0x06, 0x7a, 0x40, 0x2e, 0xbb, 0x37, 0xe9, 0x30, 0xe8, 0xb7, 0x77, 0x3a, 0xbb,
+	0xf7, 0x59, 0x95, 0xde, 0x3f, 0xb6, 0x18, 0xb0, 0x83, 0x31, 0x28, 0x23, 0xdf, 0x4a, 0x18, 0x3f,
+	0xb3, 0x35, 0xcb, 0x78, 0xba, 0x7e, 0x7c, 0xda, 0x6b, 0x0d, 0xff, 0xf4, 0xd3, 0xd7, 0xa4, 0x53,
+	0xbf, 0x5c, 0x87, 0x6b, 0x16, 0xf7, 0xa8, 0x81, 0xab, 0x63, 0xfd, 0x8d, 0xac, 0xea, 0x9e, 0xd8,
+	0xc4, 0xd0, 0x7d, 0x72, 0xc5, 0x27, 0xd5, 0x61, 0xdb, 0x22, 0xef, 0x36, 0x90, 0x4e, 0x39, 0xc3,
+	0x73, 0x45, 0x0f, 0xab, 0xba, 0xa9, 0x24, 0xd7, 0x72, 0x50, 0x63, 0xa9, 0xc4, 0x61, 0x9e, 0xa4,
+	0xef, 0xc0, 0xe8, 0x70, 0xdd, 0x02, 0xfb, 0xff, 0x04, 0xbe, 0xb0, 0x1e, 0x97, 0x34, 0x2a, 0x51,
+	0xbf, 0x4e, 0x7b, 0x9b, 0x8b, 0x64, 0x9a, 0x3d, 0x89, 0xcf, 0x60, 0xe2, 0xe1, 0x55, 0x5f, 0x71,
+	0x3d, 0x9a, 0x6e, 0x92, 0x8d, 0x09, 0x48, 0x31, 0x31, 0xe1, 0xa5, 0x7e, 0xb0, 0xd3, 0x1e, 0xfa,
+	0xaf, 0xdd, 0xaf, 0x01, 0x21, 0x96, 0x68, 0xe7, 0x41, 0x3f, 0x05, 0xa4, 0xd3, 0x18, 0x0a, 0x7d,
+	0xc0, 0xce, 0x6d, 0x1e, 0xfb, 0xcf, 0x54, 0xbb, 0x0f, 0x2f, 0xe4, 0x75, 0x53, 0x8e, 0xb7, 0x3f,
+	0x7c, 0xfb, 0xf9, 0x65, 0xed, 0x16, 0xdd, 0xe2, 0xd5, 0x26, 0x15, 0x03, 0x0e, 0xd6, 0x79, 0xa8,
+	0x6d, 0xc4, 0xfd, 0xe3, 0x65, 0x14, 0x9c, 0x2c, 0xa3, 0xe0, 0xc7, 0x32, 0x0a, 0x3e, 0xaf, 0xa2,
+	0xd6, 0xc9, 0x2a, 0x6a, 0x7d, 0x5f, 0x45, 0xad, 0x37, 0x4c, 0x48, 0x33, 0x99, 0x8f, 0x58, 0x8a,
+	0x53, 0x9e, 0xa2, 0x9e, 0xa2, 0x2e, 0x29, 0x8f, 0x05, 0xf2, 0x62, 0x8f, 0x4f, 0x71, 0x3c, 0xcf,
+	0x40, 0x37, 0xfe, 0xa6, 0xd1, 0x86, 0xdd, 0xc8, 0xbd, 0xdf, 0x03, 0x00, 0x47, 0x4e, 0xb1, 0x6b,
+	0x66, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateQueryClient is the client API for StateQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateQueryClient interface {
+	// ExportState queries all clients, connections, channels and pending packets
+	// of the chain at a single height.
+	ExportState(ctx context.Context, in *QueryExportStateRequest, opts ...grpc.CallOption) (*QueryExportStateResponse, error)
+}
+
+type stateQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateQueryClient(cc grpc1.ClientConn) StateQueryClient {
+	return &stateQueryClient{cc}
+}
+
+func (c *stateQueryClient) ExportState(ctx context.Context, in *QueryExportStateRequest, opts ...grpc.CallOption) (*QueryExportStateResponse, error) {
+	out := new(QueryExportStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.types.v1.StateQuery/ExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateQueryServer is the server API for StateQuery service.
+type StateQueryServer interface {
+	// ExportState queries all clients, connections, channels and pending packets
+	// of the chain at a single height.
+	ExportState(context.Context, *QueryExportStateRequest) (*QueryExportStateResponse, error)
+}
+
+// UnimplementedStateQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedStateQueryServer struct {
+}
+
+func (*UnimplementedStateQueryServer) ExportState(ctx context.Context, req *QueryExportStateRequest) (*QueryExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+
+func RegisterStateQueryServer(s grpc1.Server, srv StateQueryServer) {
+	s.RegisterService(&_StateQuery_serviceDesc, srv)
+}
+
+func _StateQuery_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateQueryServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.types.v1.StateQuery/ExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateQueryServer).ExportState(ctx, req.(*QueryExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StateQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.types.v1.StateQuery",
+	HandlerType: (*StateQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportState",
+			Handler:    _StateQuery_ExportState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/types/v1/query.proto",
+}
+
+func (m *QueryExportStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PendingPackets) > 0 {
+		for iNdEx := len(m.PendingPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExportStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExportStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for _, e := range m.PendingPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExportStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExportStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExportStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExportStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExportStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExportStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, types.IdentifiedClientState{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, types1.IdentifiedConnection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, types2.IdentifiedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPackets = append(m.PendingPackets, types2.PacketState{})
+			if err := m.PendingPackets[len(m.PendingPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -24,6 +24,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*exported.Header)(nil),
 		&Header{},
+		&BatchHeader{},
 	)
 	registry.RegisterImplementations(
 		(*exported.Misbehaviour)(nil),
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"bytes"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateCommitteePublicKey validates the public key of a committee of solo machine operators.
+// A committee is represented by a threshold multisig public key. The threshold must be reached
+// by at least one and at most all of the committee keys, the committee keys must be unique and
+// may not be committees themselves. Public keys which are not multisig public keys are valid.
+func ValidateCommitteePublicKey(publicKey cryptotypes.PubKey) error {
+	committee, ok := publicKey.(multisig.PubKey)
+	if !ok {
+		return nil
+	}
+
+	pubKeys := committee.GetPubKeys()
+	threshold := committee.GetThreshold()
+	if threshold == 0 || threshold > uint(len(pubKeys)) {
+		return sdkerrors.Wrapf(ErrInvalidCommittee, "threshold %d must be between 1 and the number of committee keys %d", threshold, len(pubKeys))
+	}
+
+	for i, pubKey := range pubKeys {
+		if pubKey == nil || len(pubKey.Bytes()) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidCommittee, "committee key %d cannot be empty", i)
+		}
+
+		if _, ok := pubKey.(multisig.PubKey); ok {
+			return sdkerrors.Wrapf(ErrInvalidCommittee, "committee key %d cannot be a multisig public key", i)
+		}
+
+		for j := 0; j < i; j++ {
+			if bytes.Equal(pubKeys[j].Bytes(), pubKey.Bytes()) {
+				return sdkerrors.Wrapf(ErrInvalidCommittee, "committee keys %d and %d are duplicates", j, i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -57,5 +57,9 @@ func (cs ConsensusState) ValidateBasic() error {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
 	}
 
+	if err := ValidateCommitteePublicKey(publicKey); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+	}
+
 	return nil
 }
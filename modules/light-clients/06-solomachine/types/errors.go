@@ -15,4 +15,5 @@ var (
 	ErrSignatureVerificationFailed = sdkerrors.Register(SubModuleName, 5, "signature verification failed")
 	ErrInvalidProof                = sdkerrors.Register(SubModuleName, 6, "invalid solo machine proof")
 	ErrInvalidDataType             = sdkerrors.Register(SubModuleName, 7, "invalid data type")
+	ErrInvalidCommittee            = sdkerrors.Register(SubModuleName, 8, "invalid committee public key")
 )
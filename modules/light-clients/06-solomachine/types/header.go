@@ -10,7 +10,13 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-var _ exported.Header = &Header{}
+var (
+	_ exported.Header = &Header{}
+	_ exported.Header = &BatchHeader{}
+)
+
+// MaxBatchHeaders is the maximum number of headers of a batch header.
+const MaxBatchHeaders = 100
 
 // ClientType defines that the Header is a Solo Machine.
 func (Header) ClientType() string {
@@ -64,5 +70,64 @@ func (h Header) ValidateBasic() error {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "new public key cannot be empty")
 	}
 
+	// the new public key may migrate the solo machine to a committee of operators
+	if err := ValidateCommitteePublicKey(newPublicKey); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+	}
+
+	return nil
+}
+
+// ClientType defines that the BatchHeader is a Solo Machine.
+func (BatchHeader) ClientType() string {
+	return exported.Solomachine
+}
+
+// GetHeight returns the sequence of the last header of the batch as the height.
+// Revision number is always 0 for a solo-machine
+func (bh BatchHeader) GetHeight() exported.Height {
+	if len(bh.Headers) == 0 {
+		return clienttypes.NewHeight(0, 0)
+	}
+
+	return bh.Headers[len(bh.Headers)-1].GetHeight()
+}
+
+// ValidateBasic ensures that the batch contains between one and MaxBatchHeaders valid
+// headers of consecutive sequences and non-decreasing timestamps.
+func (bh BatchHeader) ValidateBasic() error {
+	if len(bh.Headers) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "batch header cannot be empty")
+	}
+
+	if len(bh.Headers) > MaxBatchHeaders {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "batch header cannot contain more than %d headers, got %d", MaxBatchHeaders, len(bh.Headers))
+	}
+
+	for i, header := range bh.Headers {
+		if err := header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid header %d", i)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		previous := bh.Headers[i-1]
+		if header.Sequence != previous.Sequence+1 {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader,
+				"header %d sequence is not consecutive to the previous header sequence (%d != %d)", i, header.Sequence, previous.Sequence+1,
+			)
+		}
+
+		if header.Timestamp < previous.Timestamp {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader,
+				"header %d timestamp is less than the previous header timestamp (%d < %d)", i, header.Timestamp, previous.Timestamp,
+			)
+		}
+	}
+
 	return nil
 }
@@ -1,6 +1,10 @@
 package types_test
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -12,6 +16,15 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 
 		header := solomachine.CreateHeader()
 
+		_, pubKeys, _ := ibctesting.GenerateKeys(suite.T(), 2)
+		duplicateCommittee, err := codectypes.NewAnyWithValue(kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubKeys[0], pubKeys[0]}))
+		suite.Require().NoError(err)
+
+		committee := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+		committee.Threshold = 3
+		thresholdCommittee, err := codectypes.NewAnyWithValue(committee)
+		suite.Require().NoError(err)
+
 		cases := []struct {
 			name    string
 			header  *types.Header
@@ -77,6 +90,28 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				},
 				false,
 			},
+			{
+				"committee keys are duplicates",
+				&types.Header{
+					Sequence:       header.Sequence,
+					Timestamp:      header.Timestamp,
+					Signature:      header.Signature,
+					NewPublicKey:   duplicateCommittee,
+					NewDiversifier: header.NewDiversifier,
+				},
+				false,
+			},
+			{
+				"committee threshold is greater than the number of keys",
+				&types.Header{
+					Sequence:       header.Sequence,
+					Timestamp:      header.Timestamp,
+					Signature:      header.Signature,
+					NewPublicKey:   thresholdCommittee,
+					NewDiversifier: header.NewDiversifier,
+				},
+				false,
+			},
 		}
 
 		suite.Require().Equal(exported.Solomachine, header.ClientType())
@@ -96,3 +131,86 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestBatchHeaderValidateBasic() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		var batchHeader *types.BatchHeader
+
+		cases := []struct {
+			name     string
+			malleate func()
+			expPass  bool
+		}{
+			{
+				"valid batch header",
+				func() {},
+				true,
+			},
+			{
+				"valid batch header of a single header",
+				func() {
+					batchHeader.Headers = batchHeader.Headers[:1]
+				},
+				true,
+			},
+			{
+				"batch header is empty",
+				func() {
+					batchHeader.Headers = nil
+				},
+				false,
+			},
+			{
+				"batch header contains too many headers",
+				func() {
+					for len(batchHeader.Headers) <= types.MaxBatchHeaders {
+						batchHeader.Headers = append(batchHeader.Headers, batchHeader.Headers[0])
+					}
+				},
+				false,
+			},
+			{
+				"header is invalid",
+				func() {
+					batchHeader.Headers[1].Signature = nil
+				},
+				false,
+			},
+			{
+				"header sequences are not consecutive",
+				func() {
+					batchHeader.Headers[2].Sequence++
+				},
+				false,
+			},
+			{
+				"header timestamp is less than the previous header timestamp",
+				func() {
+					batchHeader.Headers[1].Timestamp = batchHeader.Headers[0].Timestamp - 1
+				},
+				false,
+			},
+		}
+
+		for _, tc := range cases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				batchHeader = solomachine.CreateBatchHeader(3)
+				suite.Require().Equal(exported.Solomachine, batchHeader.ClientType())
+				suite.Require().Equal(batchHeader.Headers[2].GetHeight(), batchHeader.GetHeight())
+
+				tc.malleate()
+
+				err := batchHeader.ValidateBasic()
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
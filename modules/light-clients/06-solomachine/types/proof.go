@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -67,17 +68,17 @@ func MisbehaviourSignBytes(
 	return cdc.Marshal(signBytes)
 }
 
-// HeaderSignBytes returns the sign bytes for verification of misbehaviour.
+// HeaderSignBytes returns the sign bytes for verification of the header.
 func HeaderSignBytes(
 	cdc codec.BinaryCodec,
 	header *Header,
 ) ([]byte, error) {
-	data := &HeaderData{
-		NewPubKey:      header.NewPublicKey,
-		NewDiversifier: header.NewDiversifier,
+	newPublicKey, err := header.GetPubKey()
+	if err != nil {
+		return nil, err
 	}
 
-	dataBz, err := cdc.Marshal(data)
+	dataBz, err := HeaderDataBytes(cdc, newPublicKey, header.NewDiversifier)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +94,57 @@ func HeaderSignBytes(
 	return cdc.Marshal(signBytes)
 }
 
+// PublicKeyRotationSignBytes returns the sign bytes of a header rotating the solo machine
+// to the new public key and diversifier at the given sequence and timestamp. They are equal
+// to the HeaderSignBytes of the resulting header, which allows the current keys, such as
+// hardware wallets, to sign the rotation to a single key or a committee before the header
+// is constructed.
+func PublicKeyRotationSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	newPublicKey cryptotypes.PubKey,
+	newDiversifier string,
+) ([]byte, error) {
+	dataBz, err := HeaderDataBytes(cdc, newPublicKey, newDiversifier)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: newDiversifier,
+		DataType:    HEADER,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// HeaderDataBytes returns the header data bytes used in constructing SignBytes.
+func HeaderDataBytes(
+	cdc codec.BinaryCodec,
+	newPublicKey cryptotypes.PubKey,
+	newDiversifier string,
+) ([]byte, error) {
+	publicKey, err := codectypes.NewAnyWithValue(newPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &HeaderData{
+		NewPubKey:      publicKey,
+		NewDiversifier: newDiversifier,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
 // ClientStateSignBytes returns the sign bytes for verification of the
 // client state.
 func ClientStateSignBytes(
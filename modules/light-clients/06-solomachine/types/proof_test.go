@@ -68,6 +68,27 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 	}
 }
 
+func (suite *SoloMachineTestSuite) TestPublicKeyRotationSignBytes() {
+	cdc := suite.chainA.App.AppCodec()
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		header := solomachine.CreateCommitteeHeader(2, 3)
+
+		expBz, err := types.HeaderSignBytes(cdc, header)
+		suite.Require().NoError(err)
+
+		// the sign bytes of the rotation to the new committee match the header sign bytes
+		bz, err := types.PublicKeyRotationSignBytes(cdc, header.Sequence, header.Timestamp, solomachine.PublicKey, header.NewDiversifier)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expBz, bz)
+
+		// nil public key
+		bz, err = types.PublicKeyRotationSignBytes(cdc, header.Sequence, header.Timestamp, nil, header.NewDiversifier)
+		suite.Require().Error(err)
+		suite.Require().Nil(bz)
+	}
+}
+
 func (suite *SoloMachineTestSuite) TestClientStateSignBytes() {
 	cdc := suite.chainA.App.AppCodec()
 
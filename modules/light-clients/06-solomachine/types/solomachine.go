@@ -28,6 +28,17 @@ func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(h.NewPublicKey, new(cryptotypes.PubKey))
 }
 
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (bh BatchHeader) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, header := range bh.Headers {
+		if err := header.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey))
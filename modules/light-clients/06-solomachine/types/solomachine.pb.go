@@ -222,6 +222,47 @@ func (m *Header) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Header proto.InternalMessageInfo
 
+// BatchHeader defines an ordered list of solo machine headers of consecutive
+// sequences, which advances the client over multiple sequences in a single
+// update. Each header must be signed by the public key set by the previous
+// header.
+type BatchHeader struct {
+	Headers []Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers"`
+}
+
+func (m *BatchHeader) Reset()         { *m = BatchHeader{} }
+func (m *BatchHeader) String() string { return proto.CompactTextString(m) }
+func (*BatchHeader) ProtoMessage()    {}
+func (*BatchHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{3}
+}
+func (m *BatchHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchHeader.Merge(m, src)
+}
+func (m *BatchHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchHeader proto.InternalMessageInfo
+
 // Misbehaviour defines misbehaviour for a solo machine which consists
 // of a sequence and two signatures over different messages at that sequence.
 type Misbehaviour struct {
@@ -235,7 +276,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{3}
+	return fileDescriptor_141333b361aae010, []int{4}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +318,7 @@ func (m *SignatureAndData) Reset()         { *m = SignatureAndData{} }
 func (m *SignatureAndData) String() string { return proto.CompactTextString(m) }
 func (*SignatureAndData) ProtoMessage()    {}
 func (*SignatureAndData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{4}
+	return fileDescriptor_141333b361aae010, []int{5}
 }
 func (m *SignatureAndData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +358,7 @@ func (m *TimestampedSignatureData) Reset()         { *m = TimestampedSignatureDa
 func (m *TimestampedSignatureData) String() string { return proto.CompactTextString(m) }
 func (*TimestampedSignatureData) ProtoMessage()    {}
 func (*TimestampedSignatureData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{5}
+	return fileDescriptor_141333b361aae010, []int{6}
 }
 func (m *TimestampedSignatureData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +402,7 @@ func (m *SignBytes) Reset()         { *m = SignBytes{} }
 func (m *SignBytes) String() string { return proto.CompactTextString(m) }
 func (*SignBytes) ProtoMessage()    {}
 func (*SignBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{6}
+	return fileDescriptor_141333b361aae010, []int{7}
 }
 func (m *SignBytes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +443,7 @@ func (m *HeaderData) Reset()         { *m = HeaderData{} }
 func (m *HeaderData) String() string { return proto.CompactTextString(m) }
 func (*HeaderData) ProtoMessage()    {}
 func (*HeaderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{7}
+	return fileDescriptor_141333b361aae010, []int{8}
 }
 func (m *HeaderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +482,7 @@ func (m *ClientStateData) Reset()         { *m = ClientStateData{} }
 func (m *ClientStateData) String() string { return proto.CompactTextString(m) }
 func (*ClientStateData) ProtoMessage()    {}
 func (*ClientStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{8}
+	return fileDescriptor_141333b361aae010, []int{9}
 }
 func (m *ClientStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +522,7 @@ func (m *ConsensusStateData) Reset()         { *m = ConsensusStateData{} }
 func (m *ConsensusStateData) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStateData) ProtoMessage()    {}
 func (*ConsensusStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{9}
+	return fileDescriptor_141333b361aae010, []int{10}
 }
 func (m *ConsensusStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +562,7 @@ func (m *ConnectionStateData) Reset()         { *m = ConnectionStateData{} }
 func (m *ConnectionStateData) String() string { return proto.CompactTextString(m) }
 func (*ConnectionStateData) ProtoMessage()    {}
 func (*ConnectionStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{10}
+	return fileDescriptor_141333b361aae010, []int{11}
 }
 func (m *ConnectionStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +602,7 @@ func (m *ChannelStateData) Reset()         { *m = ChannelStateData{} }
 func (m *ChannelStateData) String() string { return proto.CompactTextString(m) }
 func (*ChannelStateData) ProtoMessage()    {}
 func (*ChannelStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{11}
+	return fileDescriptor_141333b361aae010, []int{12}
 }
 func (m *ChannelStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +642,7 @@ func (m *PacketCommitmentData) Reset()         { *m = PacketCommitmentData{} }
 func (m *PacketCommitmentData) String() string { return proto.CompactTextString(m) }
 func (*PacketCommitmentData) ProtoMessage()    {}
 func (*PacketCommitmentData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{12}
+	return fileDescriptor_141333b361aae010, []int{13}
 }
 func (m *PacketCommitmentData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +696,7 @@ func (m *PacketAcknowledgementData) Reset()         { *m = PacketAcknowledgement
 func (m *PacketAcknowledgementData) String() string { return proto.CompactTextString(m) }
 func (*PacketAcknowledgementData) ProtoMessage()    {}
 func (*PacketAcknowledgementData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{13}
+	return fileDescriptor_141333b361aae010, []int{14}
 }
 func (m *PacketAcknowledgementData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +749,7 @@ func (m *PacketReceiptAbsenceData) Reset()         { *m = PacketReceiptAbsenceDa
 func (m *PacketReceiptAbsenceData) String() string { return proto.CompactTextString(m) }
 func (*PacketReceiptAbsenceData) ProtoMessage()    {}
 func (*PacketReceiptAbsenceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{14}
+	return fileDescriptor_141333b361aae010, []int{15}
 }
 func (m *PacketReceiptAbsenceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +796,7 @@ func (m *NextSequenceRecvData) Reset()         { *m = NextSequenceRecvData{} }
 func (m *NextSequenceRecvData) String() string { return proto.CompactTextString(m) }
 func (*NextSequenceRecvData) ProtoMessage()    {}
 func (*NextSequenceRecvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{15}
+	return fileDescriptor_141333b361aae010, []int{16}
 }
 func (m *NextSequenceRecvData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,6 +844,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v2.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v2.ConsensusState")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.solomachine.v2.Header")
+	proto.RegisterType((*BatchHeader)(nil), "ibc.lightclients.solomachine.v2.BatchHeader")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.solomachine.v2.Misbehaviour")
 	proto.RegisterType((*SignatureAndData)(nil), "ibc.lightclients.solomachine.v2.SignatureAndData")
 	proto.RegisterType((*TimestampedSignatureData)(nil), "ibc.lightclients.solomachine.v2.TimestampedSignatureData")
@@ -823,93 +865,95 @@ func init() {
 }
 
 var fileDescriptor_141333b361aae010 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0xa7, 0xe9, 0xf5, 0x32, 0xb9, 0xde, 0x05, 0x37, 0x6d, 0x73, 0x6e, 0x95, 0x18, 0x23,
-	0xca, 0x81, 0x68, 0xc2, 0x5d, 0x45, 0x85, 0x2a, 0x04, 0x38, 0x8e, 0x4b, 0xd3, 0xde, 0xf9, 0x82,
-	0xe3, 0x03, 0x5a, 0x21, 0x19, 0xc7, 0xd9, 0x4b, 0xac, 0x26, 0xde, 0x34, 0x76, 0x92, 0x06, 0x09,
-	0x09, 0xf1, 0x54, 0x22, 0x1e, 0xf8, 0x02, 0x91, 0x10, 0x88, 0xcf, 0xc1, 0x1b, 0xf0, 0xd8, 0x47,
-	0x9e, 0x02, 0x6a, 0xbf, 0x41, 0x3e, 0x01, 0xb2, 0x77, 0x13, 0xdb, 0xb9, 0x5e, 0x4e, 0xfc, 0x7b,
-	0xdb, 0x9d, 0xdf, 0xcc, 0x6f, 0x66, 0x67, 0xc6, 0xb3, 0x6b, 0xd8, 0xb1, 0x6a, 0x66, 0xa1, 0x65,
-	0x35, 0x9a, 0xae, 0xd9, 0xb2, 0x90, 0xed, 0x3a, 0x05, 0x07, 0xb7, 0x70, 0xdb, 0x30, 0x9b, 0x96,
-	0x8d, 0x0a, 0xfd, 0xdd, 0xf0, 0x36, 0xdf, 0xe9, 0x62, 0x17, 0xb3, 0x39, 0xab, 0x66, 0xe6, 0xc3,
-	0x26, 0xf9, 0xb0, 0x4e, 0x7f, 0x97, 0x7b, 0xcd, 0xe3, 0x34, 0x71, 0x17, 0x15, 0x4c, 0x6c, 0xdb,
-	0xc8, 0x74, 0x2d, 0x6c, 0x17, 0xfa, 0x3b, 0xa1, 0x1d, 0x61, 0xe2, 0x5e, 0x0e, 0x14, 0x9b, 0x86,
-	0x6d, 0xa3, 0x96, 0xaf, 0x45, 0x96, 0x54, 0x25, 0xdd, 0xc0, 0x0d, 0xec, 0x2f, 0x0b, 0xde, 0x8a,
-	0x4a, 0xb7, 0x1a, 0x18, 0x37, 0x5a, 0xa8, 0xe0, 0xef, 0x6a, 0xbd, 0xa3, 0x82, 0x61, 0x0f, 0x09,
-	0x24, 0xfc, 0x1c, 0x83, 0xa4, 0xe4, 0xc7, 0x55, 0x75, 0x0d, 0x17, 0xb1, 0x1c, 0xac, 0x39, 0xe8,
-	0x51, 0x0f, 0xd9, 0x26, 0xca, 0x30, 0x3c, 0xb3, 0x1d, 0x57, 0xe7, 0x7b, 0x76, 0x07, 0x12, 0x96,
-	0xa3, 0x1f, 0x75, 0xf1, 0x17, 0xc8, 0xce, 0xc4, 0x78, 0x66, 0x7b, 0xad, 0x98, 0x9e, 0x4e, 0x72,
-	0xa9, 0xa1, 0xd1, 0x6e, 0xdd, 0x12, 0xe6, 0x90, 0xa0, 0xae, 0x59, 0xce, 0x6d, 0x7f, 0xc9, 0xba,
-	0xb0, 0x69, 0x62, 0xdb, 0x41, 0xb6, 0xd3, 0x73, 0x74, 0xc7, 0xf3, 0x90, 0x39, 0xc3, 0x33, 0xdb,
-	0xc9, 0xdd, 0x42, 0xfe, 0x94, 0xb4, 0xe4, 0xa5, 0x99, 0x9d, 0x1f, 0x58, 0x91, 0x9b, 0x4e, 0x72,
-	0x97, 0x88, 0xa7, 0x05, 0x46, 0x41, 0xdd, 0x30, 0x23, 0xba, 0x2c, 0x82, 0x2b, 0x46, 0xab, 0x85,
-	0x07, 0x7a, 0xaf, 0x53, 0x37, 0x5c, 0xa4, 0x1b, 0x47, 0x2e, 0xea, 0xea, 0x9d, 0x2e, 0xee, 0x60,
-	0xc7, 0x68, 0x65, 0xe2, 0x7e, 0xe8, 0xd7, 0xa6, 0x93, 0x9c, 0x40, 0x08, 0x97, 0x28, 0x0b, 0x6a,
-	0xc6, 0x47, 0x0f, 0x7d, 0x50, 0xf4, 0xb0, 0x0a, 0x85, 0x6e, 0xc5, 0x9f, 0x7c, 0x9f, 0x5b, 0x11,
-	0x7e, 0x60, 0x60, 0x23, 0x1a, 0x2b, 0x7b, 0x17, 0xa0, 0xd3, 0xab, 0xb5, 0x2c, 0x53, 0x7f, 0x88,
-	0x86, 0x7e, 0x1a, 0x93, 0xbb, 0xe9, 0x3c, 0x29, 0x42, 0x7e, 0x56, 0x84, 0xbc, 0x68, 0x0f, 0x8b,
-	0x17, 0xa7, 0x93, 0xdc, 0x4b, 0x24, 0x88, 0xc0, 0x42, 0x50, 0x13, 0x64, 0x73, 0x0f, 0x0d, 0x59,
-	0x1e, 0x92, 0x75, 0xab, 0x8f, 0xba, 0x8e, 0x75, 0x64, 0xa1, 0xae, 0x9f, 0xf6, 0x84, 0x1a, 0x16,
-	0xb1, 0x57, 0x21, 0xe1, 0x5a, 0x6d, 0xe4, 0xb8, 0x46, 0xbb, 0xe3, 0x67, 0x37, 0xae, 0x06, 0x02,
-	0x1a, 0xe4, 0xd7, 0x31, 0x58, 0xbd, 0x83, 0x8c, 0x3a, 0xea, 0x2e, 0xad, 0x70, 0x84, 0x2a, 0xb6,
-	0x40, 0xe5, 0xa1, 0x8e, 0xd5, 0xb0, 0x0d, 0xb7, 0xd7, 0x25, 0x65, 0x5c, 0x57, 0x03, 0x01, 0x7b,
-	0x08, 0x1b, 0x36, 0x1a, 0xe8, 0xa1, 0x83, 0xc7, 0x97, 0x1c, 0x7c, 0x6b, 0x3a, 0xc9, 0x5d, 0x24,
-	0x07, 0x8f, 0x5a, 0x09, 0xea, 0xba, 0x8d, 0x06, 0x95, 0xf9, 0xf9, 0x25, 0xd8, 0xf4, 0x14, 0xc2,
-	0x39, 0x38, 0xeb, 0xe5, 0x20, 0xdc, 0x10, 0x0b, 0x0a, 0x82, 0xea, 0x45, 0x52, 0x0a, 0x04, 0x34,
-	0x09, 0xbf, 0xc6, 0x60, 0x7d, 0xdf, 0x72, 0x6a, 0xa8, 0x69, 0xf4, 0x2d, 0xdc, 0xeb, 0x7a, 0x0d,
-	0x4d, 0x9a, 0x4f, 0xb7, 0xea, 0x7e, 0x2e, 0x12, 0xe1, 0x86, 0x9e, 0x43, 0x82, 0xba, 0x46, 0xd6,
-	0xe5, 0x7a, 0x24, 0x7b, 0xb1, 0x85, 0xec, 0x75, 0xe0, 0xfc, 0x3c, 0x1d, 0x3a, 0xb6, 0x67, 0xad,
-	0xbe, 0x73, 0x6a, 0xab, 0x57, 0x67, 0x56, 0xa2, 0x5d, 0x2f, 0x19, 0xae, 0x51, 0xcc, 0x4c, 0x27,
-	0xb9, 0x34, 0x89, 0x22, 0xc2, 0x28, 0xa8, 0xeb, 0xf3, 0xfd, 0x81, 0xbd, 0xe0, 0xd1, 0x1d, 0x60,
-	0x9a, 0xf2, 0xff, 0xca, 0xa3, 0x3b, 0xc0, 0x61, 0x8f, 0xda, 0x00, 0xd3, 0x4c, 0xfe, 0xc2, 0x40,
-	0x6a, 0x91, 0x22, 0xda, 0x1e, 0xcc, 0x62, 0x7b, 0x7c, 0x06, 0x89, 0xba, 0xe1, 0x1a, 0xba, 0x3b,
-	0xec, 0x90, 0xcc, 0x6d, 0xec, 0xbe, 0x7e, 0x6a, 0x98, 0x1e, 0xaf, 0x36, 0xec, 0xa0, 0x70, 0x59,
-	0xe6, 0x2c, 0x82, 0xba, 0x56, 0xa7, 0x38, 0xcb, 0x42, 0xdc, 0x5b, 0xd3, 0xae, 0xf4, 0xd7, 0xd1,
-	0x66, 0x8e, 0xbf, 0xf8, 0xbb, 0xf8, 0x8a, 0x81, 0x8c, 0x36, 0x93, 0xa1, 0xfa, 0xfc, 0x4c, 0xfe,
-	0x81, 0x3e, 0x80, 0x8d, 0x20, 0x17, 0x3e, 0xbd, 0x7f, 0xaa, 0x70, 0xef, 0x46, 0x71, 0x41, 0x0d,
-	0xca, 0x51, 0x3a, 0x16, 0x42, 0xec, 0xc5, 0x21, 0xfc, 0xc1, 0x40, 0xc2, 0xf3, 0x5b, 0x1c, 0xba,
-	0xc8, 0xf9, 0x17, 0x5f, 0xe7, 0xc2, 0xa0, 0x38, 0x73, 0x7c, 0x50, 0x44, 0x4a, 0x10, 0xff, 0xbf,
-	0x4a, 0x70, 0x36, 0x28, 0x01, 0x3d, 0xe1, 0x4f, 0x0c, 0x00, 0x19, 0x3e, 0x7e, 0x52, 0xf6, 0x20,
-	0x49, 0x3f, 0xf9, 0x53, 0xc7, 0xe3, 0xa5, 0xe9, 0x24, 0xc7, 0x46, 0xa6, 0x04, 0x9d, 0x8f, 0x64,
-	0x44, 0x9c, 0x30, 0x1f, 0x62, 0xff, 0x70, 0x3e, 0x7c, 0x09, 0x9b, 0xa1, 0xab, 0xd0, 0x8f, 0x95,
-	0x85, 0x78, 0xc7, 0x70, 0x9b, 0xb4, 0x9d, 0xfd, 0x35, 0x5b, 0x81, 0x75, 0x3a, 0x1a, 0xc8, 0x85,
-	0x16, 0x5b, 0x72, 0x80, 0xcb, 0xd3, 0x49, 0xee, 0x42, 0x64, 0x9c, 0xd0, 0x2b, 0x2b, 0x69, 0x06,
-	0x9e, 0xa8, 0xfb, 0x6f, 0x18, 0x60, 0xa3, 0x17, 0xc9, 0x89, 0x21, 0xdc, 0x3f, 0x7e, 0xad, 0x2e,
-	0x8b, 0xe2, 0x6f, 0xdc, 0x9d, 0x34, 0x96, 0x3e, 0x5c, 0x90, 0xe6, 0xcf, 0x8f, 0xe5, 0xb1, 0xc8,
-	0x00, 0xc1, 0x4b, 0x85, 0x86, 0xf1, 0xaa, 0xdf, 0x56, 0xde, 0x53, 0x25, 0x1f, 0x7a, 0xc5, 0xf4,
-	0x77, 0xf2, 0x01, 0xa9, 0x6c, 0xd7, 0xd5, 0x90, 0x21, 0xf5, 0x5b, 0x87, 0x94, 0x44, 0x1e, 0x34,
-	0xcb, 0x9d, 0xde, 0x84, 0x73, 0xf4, 0xe1, 0x43, 0x3d, 0x5e, 0x0d, 0x79, 0xa4, 0x2f, 0x22, 0xcf,
-	0x1d, 0x59, 0xaa, 0x33, 0x65, 0xea, 0xe5, 0x2e, 0xa4, 0x2b, 0x86, 0xf9, 0x10, 0xb9, 0x12, 0x6e,
-	0xb7, 0x2d, 0xb7, 0x8d, 0x6c, 0xf7, 0x44, 0x4f, 0x59, 0xef, 0x78, 0x33, 0x2d, 0xdf, 0xd9, 0xba,
-	0x1a, 0x92, 0x08, 0xf7, 0x61, 0x8b, 0x70, 0x89, 0xe6, 0x43, 0x1b, 0x0f, 0x5a, 0xa8, 0xde, 0x40,
-	0x4b, 0x09, 0xb7, 0x61, 0xd3, 0x88, 0xaa, 0x52, 0xd6, 0x45, 0xb1, 0x90, 0x87, 0x0c, 0xa1, 0x56,
-	0x91, 0x89, 0xac, 0x8e, 0x2b, 0xd6, 0x1c, 0x6f, 0x0e, 0x9c, 0xc4, 0x2c, 0x34, 0x21, 0xad, 0xa0,
-	0xc7, 0x6e, 0x95, 0xce, 0x0b, 0x15, 0x99, 0xfd, 0x13, 0xa3, 0x78, 0x17, 0xce, 0xdb, 0xe8, 0xb1,
-	0xab, 0x3b, 0xe8, 0x91, 0xde, 0x45, 0x66, 0x9f, 0xcc, 0x93, 0xf0, 0x35, 0x10, 0x81, 0x05, 0x35,
-	0x69, 0x13, 0x6a, 0x8f, 0xf5, 0x8d, 0x6f, 0xe3, 0xb0, 0x36, 0x1b, 0x0c, 0xec, 0x3b, 0xf0, 0x4a,
-	0x49, 0xd4, 0x44, 0x5d, 0xbb, 0x5f, 0x91, 0xf5, 0x43, 0xa5, 0xac, 0x94, 0xb5, 0xb2, 0xb8, 0x57,
-	0x7e, 0x20, 0x97, 0xf4, 0x43, 0xa5, 0x5a, 0x91, 0xa5, 0xf2, 0xed, 0xb2, 0x5c, 0x4a, 0xad, 0x70,
-	0x9b, 0xa3, 0x31, 0x9f, 0x0c, 0x89, 0xd8, 0x6b, 0x70, 0x29, 0xb0, 0x94, 0xf6, 0xca, 0xb2, 0xa2,
-	0xe9, 0x55, 0x4d, 0xd4, 0xe4, 0x14, 0xc3, 0xc1, 0x68, 0xcc, 0xaf, 0x12, 0x19, 0xfb, 0x26, 0x6c,
-	0x85, 0xf4, 0x0e, 0x94, 0xaa, 0xac, 0x54, 0x0f, 0xab, 0x54, 0x35, 0xc6, 0x9d, 0x1f, 0x8d, 0xf9,
-	0xc4, 0x5c, 0xcc, 0xe6, 0x81, 0x8b, 0x68, 0x2b, 0xb2, 0xa4, 0x95, 0x0f, 0x14, 0xaa, 0x7e, 0x86,
-	0xdb, 0x18, 0x8d, 0x79, 0x08, 0xe4, 0xec, 0x36, 0x5c, 0x0e, 0xe9, 0xdf, 0x11, 0x15, 0x45, 0xde,
-	0xa3, 0xca, 0x71, 0x2e, 0x39, 0x1a, 0xf3, 0xe7, 0xa8, 0x90, 0x7d, 0x1b, 0xae, 0x04, 0x9a, 0x15,
-	0x51, 0xba, 0x27, 0x6b, 0xba, 0x74, 0xb0, 0xbf, 0x5f, 0xd6, 0xf6, 0x65, 0x45, 0x4b, 0x9d, 0xe5,
-	0xd2, 0xa3, 0x31, 0x9f, 0x22, 0x40, 0x20, 0x67, 0xdf, 0x07, 0xfe, 0x98, 0x99, 0x28, 0xdd, 0x53,
-	0x0e, 0x3e, 0xd9, 0x93, 0x4b, 0x1f, 0xca, 0xbe, 0xed, 0x2a, 0xb7, 0x35, 0x1a, 0xf3, 0x17, 0x09,
-	0xba, 0x00, 0xb2, 0xef, 0xbd, 0x80, 0x40, 0x95, 0x25, 0xb9, 0x5c, 0xd1, 0x74, 0xb1, 0x58, 0x95,
-	0x15, 0x49, 0x4e, 0x9d, 0xe3, 0x32, 0xa3, 0x31, 0x9f, 0x26, 0x28, 0x05, 0x29, 0xc6, 0xde, 0x84,
-	0xab, 0x81, 0xbd, 0x22, 0x7f, 0xaa, 0xe9, 0x55, 0xf9, 0xa3, 0x43, 0x0f, 0xf2, 0x68, 0x3e, 0x4e,
-	0xad, 0x91, 0xc0, 0x3d, 0x64, 0x06, 0x78, 0x72, 0x96, 0x87, 0x54, 0x60, 0x77, 0x47, 0x16, 0x4b,
-	0xb2, 0x9a, 0x4a, 0x90, 0xca, 0x90, 0x1d, 0x17, 0x7f, 0xf2, 0x63, 0x76, 0xa5, 0xf8, 0xf9, 0x6f,
-	0xcf, 0xb2, 0xcc, 0xd3, 0x67, 0x59, 0xe6, 0xcf, 0x67, 0x59, 0xe6, 0xbb, 0xe7, 0xd9, 0x95, 0xa7,
-	0xcf, 0xb3, 0x2b, 0xbf, 0x3f, 0xcf, 0xae, 0x3c, 0xb8, 0xdd, 0xb0, 0xdc, 0x66, 0xaf, 0x96, 0x37,
-	0x71, 0xbb, 0x60, 0x62, 0xa7, 0x8d, 0x9d, 0x82, 0x55, 0x33, 0xaf, 0x37, 0x70, 0xa1, 0x7f, 0xa3,
-	0xd0, 0xc6, 0xf5, 0x5e, 0x0b, 0x39, 0xe4, 0x7f, 0xea, 0xfa, 0xec, 0x87, 0xea, 0xad, 0x9b, 0xd7,
-	0xc3, 0xff, 0x54, 0xde, 0x35, 0xe3, 0xd4, 0x56, 0xfd, 0x79, 0x76, 0xe3, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0xd4, 0x6c, 0xfb, 0x80, 0x0d, 0x00, 0x00,
+	// 1401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x5e, 0x67, 0xdd, 0xdd, 0xcd, 0x64, 0x7f, 0x82, 0x9b, 0xb6, 0x59, 0xb7, 0x4a, 0x8c, 0x11,
+	0xed, 0x82, 0xa8, 0xc3, 0x6e, 0x45, 0x85, 0x2a, 0x04, 0x38, 0x8e, 0xdb, 0xa6, 0xdd, 0xf5, 0x06,
+	0xc7, 0x0b, 0xb4, 0xaa, 0x64, 0x1c, 0x7b, 0x36, 0xb1, 0x9a, 0x78, 0xd2, 0xd8, 0x49, 0x1a, 0x24,
+	0x24, 0xc4, 0x55, 0x89, 0xb8, 0xe0, 0x05, 0x22, 0x21, 0x10, 0xcf, 0xc1, 0x1d, 0xf4, 0xb2, 0x97,
+	0x5c, 0x05, 0xd4, 0xbe, 0x41, 0x9e, 0x00, 0xd9, 0x33, 0x89, 0xed, 0xb4, 0x9b, 0x15, 0x7f, 0x77,
+	0x33, 0xe7, 0x3b, 0xe7, 0x3b, 0x7f, 0x33, 0x67, 0x6c, 0xb0, 0x6b, 0xd7, 0xcc, 0x42, 0xd3, 0xae,
+	0x37, 0x3c, 0xb3, 0x69, 0x43, 0xc7, 0x73, 0x0b, 0x2e, 0x6a, 0xa2, 0x96, 0x61, 0x36, 0x6c, 0x07,
+	0x16, 0x7a, 0x7b, 0xd1, 0xad, 0xd0, 0xee, 0x20, 0x0f, 0x31, 0x79, 0xbb, 0x66, 0x0a, 0x51, 0x13,
+	0x21, 0xaa, 0xd3, 0xdb, 0x63, 0xaf, 0xf8, 0x9c, 0x26, 0xea, 0xc0, 0x82, 0x89, 0x1c, 0x07, 0x9a,
+	0x9e, 0x8d, 0x9c, 0x42, 0x6f, 0x37, 0xb2, 0xc3, 0x4c, 0xec, 0xeb, 0xa1, 0x62, 0xc3, 0x70, 0x1c,
+	0xd8, 0x0c, 0xb4, 0xf0, 0x92, 0xa8, 0x64, 0xea, 0xa8, 0x8e, 0x82, 0x65, 0xc1, 0x5f, 0x11, 0xe9,
+	0x76, 0x1d, 0xa1, 0x7a, 0x13, 0x16, 0x82, 0x5d, 0xad, 0x7b, 0x5c, 0x30, 0x9c, 0x01, 0x86, 0xf8,
+	0x5f, 0x12, 0x20, 0x25, 0x05, 0x71, 0x55, 0x3d, 0xc3, 0x83, 0x0c, 0x0b, 0xd6, 0x5c, 0xf8, 0xa8,
+	0x0b, 0x1d, 0x13, 0x66, 0x29, 0x8e, 0xda, 0xa1, 0xd5, 0xd9, 0x9e, 0xd9, 0x05, 0x49, 0xdb, 0xd5,
+	0x8f, 0x3b, 0xe8, 0x4b, 0xe8, 0x64, 0x13, 0x1c, 0xb5, 0xb3, 0x56, 0xcc, 0x4c, 0xc6, 0xf9, 0xf4,
+	0xc0, 0x68, 0x35, 0x6f, 0xf0, 0x33, 0x88, 0x57, 0xd7, 0x6c, 0xf7, 0x66, 0xb0, 0x64, 0x3c, 0xb0,
+	0x65, 0x22, 0xc7, 0x85, 0x8e, 0xdb, 0x75, 0x75, 0xd7, 0xf7, 0x90, 0x5d, 0xe6, 0xa8, 0x9d, 0xd4,
+	0x5e, 0x41, 0x38, 0xa5, 0x2c, 0x82, 0x34, 0xb5, 0x0b, 0x02, 0x2b, 0xb2, 0x93, 0x71, 0xfe, 0x3c,
+	0xf6, 0x34, 0xc7, 0xc8, 0xab, 0x9b, 0x66, 0x4c, 0x97, 0x81, 0xe0, 0xa2, 0xd1, 0x6c, 0xa2, 0xbe,
+	0xde, 0x6d, 0x5b, 0x86, 0x07, 0x75, 0xe3, 0xd8, 0x83, 0x1d, 0xbd, 0xdd, 0x41, 0x6d, 0xe4, 0x1a,
+	0xcd, 0x2c, 0x1d, 0x84, 0x7e, 0x79, 0x32, 0xce, 0xf3, 0x98, 0x70, 0x81, 0x32, 0xaf, 0x66, 0x03,
+	0xf4, 0x28, 0x00, 0x45, 0x1f, 0xab, 0x10, 0xe8, 0x06, 0xfd, 0xe4, 0x87, 0xfc, 0x12, 0xff, 0x23,
+	0x05, 0x36, 0xe3, 0xb1, 0x32, 0x77, 0x00, 0x68, 0x77, 0x6b, 0x4d, 0xdb, 0xd4, 0x1f, 0xc2, 0x41,
+	0x50, 0xc6, 0xd4, 0x5e, 0x46, 0xc0, 0x4d, 0x10, 0xa6, 0x4d, 0x10, 0x44, 0x67, 0x50, 0x3c, 0x37,
+	0x19, 0xe7, 0x5f, 0xc3, 0x41, 0x84, 0x16, 0xbc, 0x9a, 0xc4, 0x9b, 0xbb, 0x70, 0xc0, 0x70, 0x20,
+	0x65, 0xd9, 0x3d, 0xd8, 0x71, 0xed, 0x63, 0x1b, 0x76, 0x82, 0xb2, 0x27, 0xd5, 0xa8, 0x88, 0xb9,
+	0x04, 0x92, 0x9e, 0xdd, 0x82, 0xae, 0x67, 0xb4, 0xda, 0x41, 0x75, 0x69, 0x35, 0x14, 0x90, 0x20,
+	0xbf, 0x49, 0x80, 0x95, 0xdb, 0xd0, 0xb0, 0x60, 0x67, 0x61, 0x87, 0x63, 0x54, 0x89, 0x39, 0x2a,
+	0x1f, 0x75, 0xed, 0xba, 0x63, 0x78, 0xdd, 0x0e, 0x6e, 0xe3, 0xba, 0x1a, 0x0a, 0x98, 0x23, 0xb0,
+	0xe9, 0xc0, 0xbe, 0x1e, 0x49, 0x9c, 0x5e, 0x90, 0xf8, 0xf6, 0x64, 0x9c, 0x3f, 0x87, 0x13, 0x8f,
+	0x5b, 0xf1, 0xea, 0xba, 0x03, 0xfb, 0x95, 0x59, 0xfe, 0x12, 0xd8, 0xf2, 0x15, 0xa2, 0x35, 0x38,
+	0xe3, 0xd7, 0x20, 0x7a, 0x20, 0xe6, 0x14, 0x78, 0xd5, 0x8f, 0xa4, 0x14, 0x0a, 0x48, 0x11, 0x1e,
+	0x80, 0x54, 0xd1, 0xf0, 0xcc, 0x06, 0x29, 0xc4, 0x2d, 0xb0, 0xda, 0x08, 0x56, 0x6e, 0x96, 0xe2,
+	0x96, 0x77, 0x52, 0x7b, 0x57, 0x4e, 0x3d, 0x93, 0xd8, 0xb2, 0x48, 0x3f, 0x1d, 0xe7, 0x97, 0xd4,
+	0xa9, 0x35, 0x61, 0xff, 0x2d, 0x01, 0xd6, 0x0f, 0x6c, 0xb7, 0x06, 0x1b, 0x46, 0xcf, 0x46, 0xdd,
+	0x8e, 0x7f, 0x5d, 0x30, 0x8d, 0x6e, 0x5b, 0x41, 0xa5, 0x93, 0xd1, 0xeb, 0x32, 0x83, 0x78, 0x75,
+	0x0d, 0xaf, 0xcb, 0x56, 0xac, 0x37, 0x89, 0xb9, 0xde, 0xb4, 0xc1, 0xc6, 0xac, 0xd8, 0x3a, 0x72,
+	0xa6, 0x17, 0x69, 0xf7, 0xd4, 0xa0, 0xab, 0x53, 0x2b, 0xd1, 0xb1, 0x4a, 0x86, 0x67, 0x14, 0xb3,
+	0x93, 0x71, 0x3e, 0x83, 0xa3, 0x88, 0x31, 0xf2, 0xea, 0xfa, 0x6c, 0x7f, 0xe8, 0xcc, 0x79, 0xf4,
+	0xfa, 0x28, 0x4b, 0xff, 0xa7, 0x1e, 0xbd, 0x3e, 0x8a, 0x7a, 0xd4, 0xfa, 0x88, 0x54, 0xf2, 0x57,
+	0x0a, 0xa4, 0xe7, 0x29, 0xe2, 0x87, 0x8f, 0x9a, 0x3f, 0x7c, 0x0f, 0x40, 0xd2, 0x32, 0x3c, 0x43,
+	0xf7, 0x06, 0x6d, 0x5c, 0xb9, 0xcd, 0xbd, 0xb7, 0x4e, 0x0d, 0xd3, 0xe7, 0xd5, 0x06, 0x6d, 0x18,
+	0x6d, 0xcb, 0x8c, 0x85, 0x57, 0xd7, 0x2c, 0x82, 0x33, 0x0c, 0xa0, 0xfd, 0x35, 0x39, 0xf3, 0xb4,
+	0x45, 0xe2, 0x09, 0xaf, 0x0a, 0xfd, 0xea, 0x5b, 0xf7, 0x35, 0x05, 0xb2, 0xda, 0x54, 0x06, 0xad,
+	0x59, 0x4e, 0x41, 0x42, 0x1f, 0x83, 0xcd, 0xb0, 0x16, 0x01, 0x7d, 0x90, 0x55, 0xf4, 0x66, 0xc4,
+	0x71, 0x5e, 0xdd, 0x70, 0x63, 0x0c, 0x0b, 0x6f, 0x2b, 0x09, 0xe1, 0x0f, 0x0a, 0x24, 0x7d, 0xbf,
+	0xc5, 0x81, 0x07, 0xdd, 0x7f, 0x71, 0xf7, 0xe7, 0xc6, 0xd0, 0xf2, 0xcb, 0x63, 0x28, 0xd6, 0x02,
+	0xfa, 0xff, 0x6a, 0xc1, 0x99, 0xb0, 0x05, 0x24, 0xc3, 0x9f, 0x29, 0x00, 0xf0, 0xbd, 0x0c, 0x8a,
+	0xb2, 0x0f, 0x52, 0x64, 0xa0, 0x9c, 0x3a, 0x7c, 0xcf, 0x4f, 0xc6, 0x79, 0x26, 0x36, 0x83, 0xc8,
+	0xf4, 0xc5, 0x03, 0xe8, 0x84, 0xe9, 0x93, 0xf8, 0x87, 0xd3, 0xe7, 0x2b, 0xb0, 0x15, 0x79, 0x68,
+	0x83, 0x58, 0x19, 0x40, 0xb7, 0x0d, 0xaf, 0x41, 0x8e, 0x73, 0xb0, 0x66, 0x2a, 0x60, 0x9d, 0x8c,
+	0x06, 0xfc, 0x5c, 0x26, 0x16, 0x24, 0x70, 0x61, 0x32, 0xce, 0x9f, 0x8d, 0x8d, 0x13, 0xf2, 0x20,
+	0xa6, 0xcc, 0xd0, 0x13, 0x71, 0xff, 0x2d, 0x05, 0x98, 0xf8, 0x33, 0x75, 0x62, 0x08, 0xf7, 0x5e,
+	0x7e, 0xb4, 0x17, 0x45, 0xf1, 0x37, 0x5e, 0x66, 0x12, 0x4b, 0x0f, 0x9c, 0x95, 0x66, 0x1f, 0x37,
+	0x8b, 0x63, 0x91, 0x01, 0x08, 0xbf, 0x83, 0x48, 0x18, 0x6f, 0x06, 0xc7, 0xca, 0xff, 0x10, 0x12,
+	0x42, 0x4c, 0xe8, 0xed, 0x0a, 0x21, 0xa9, 0xec, 0x58, 0x6a, 0xc4, 0x90, 0xf8, 0xb5, 0x40, 0x5a,
+	0xc2, 0x9f, 0x4b, 0x8b, 0x9d, 0x5e, 0x07, 0xab, 0xe4, 0xb3, 0x8a, 0x78, 0xbc, 0x14, 0xf1, 0x88,
+	0x81, 0xc0, 0x1d, 0x5e, 0xaa, 0x53, 0x65, 0xe2, 0xe5, 0x0e, 0xc8, 0x54, 0x0c, 0xf3, 0x21, 0xf4,
+	0x24, 0xd4, 0x6a, 0xd9, 0x5e, 0x0b, 0x3a, 0xde, 0x89, 0x9e, 0x72, 0x7e, 0x7a, 0x53, 0xad, 0xc0,
+	0xd9, 0xba, 0x1a, 0x91, 0xf0, 0xf7, 0xc0, 0x36, 0xe6, 0x12, 0xcd, 0x87, 0x0e, 0xea, 0x37, 0xa1,
+	0x55, 0x87, 0x0b, 0x09, 0x77, 0xc0, 0x96, 0x11, 0x57, 0x25, 0xac, 0xf3, 0x62, 0x5e, 0x00, 0x59,
+	0x4c, 0xad, 0x42, 0x13, 0xda, 0x6d, 0x4f, 0xac, 0xb9, 0xfe, 0x1c, 0x38, 0x89, 0x99, 0x6f, 0x80,
+	0x8c, 0x02, 0x1f, 0x7b, 0x55, 0x32, 0x2f, 0x54, 0x68, 0xf6, 0x4e, 0x8c, 0xe2, 0x03, 0xb0, 0xe1,
+	0xc0, 0xc7, 0x9e, 0xee, 0xc2, 0x47, 0x7a, 0x07, 0x9a, 0x3d, 0x3c, 0x4f, 0xa2, 0xcf, 0x40, 0x0c,
+	0xe6, 0xd5, 0x94, 0x83, 0xa9, 0x7d, 0xd6, 0xb7, 0xbf, 0xa3, 0xc1, 0xda, 0x74, 0x30, 0x30, 0xef,
+	0x83, 0x37, 0x4a, 0xa2, 0x26, 0xea, 0xda, 0xbd, 0x8a, 0xac, 0x1f, 0x29, 0x65, 0xa5, 0xac, 0x95,
+	0xc5, 0xfd, 0xf2, 0x7d, 0xb9, 0xa4, 0x1f, 0x29, 0xd5, 0x8a, 0x2c, 0x95, 0x6f, 0x96, 0xe5, 0x52,
+	0x7a, 0x89, 0xdd, 0x1a, 0x8e, 0xb8, 0x54, 0x44, 0xc4, 0x5c, 0x06, 0xe7, 0x43, 0x4b, 0x69, 0xbf,
+	0x2c, 0x2b, 0x9a, 0x5e, 0xd5, 0x44, 0x4d, 0x4e, 0x53, 0x2c, 0x18, 0x8e, 0xb8, 0x15, 0x2c, 0x63,
+	0xde, 0x01, 0xdb, 0x11, 0xbd, 0x43, 0xa5, 0x2a, 0x2b, 0xd5, 0xa3, 0x2a, 0x51, 0x4d, 0xb0, 0x1b,
+	0xc3, 0x11, 0x97, 0x9c, 0x89, 0x19, 0x01, 0xb0, 0x31, 0x6d, 0x45, 0x96, 0xb4, 0xf2, 0xa1, 0x42,
+	0xd4, 0x97, 0xd9, 0xcd, 0xe1, 0x88, 0x03, 0xa1, 0x9c, 0xd9, 0x01, 0x17, 0x22, 0xfa, 0xb7, 0x45,
+	0x45, 0x91, 0xf7, 0x89, 0x32, 0xcd, 0xa6, 0x86, 0x23, 0x6e, 0x95, 0x08, 0x99, 0xf7, 0xc0, 0xc5,
+	0x50, 0xb3, 0x22, 0x4a, 0x77, 0x65, 0x4d, 0x97, 0x0e, 0x0f, 0x0e, 0xca, 0xda, 0x81, 0xac, 0x68,
+	0xe9, 0x33, 0x6c, 0x66, 0x38, 0xe2, 0xd2, 0x18, 0x08, 0xe5, 0xcc, 0x47, 0x80, 0x7b, 0xc9, 0x4c,
+	0x94, 0xee, 0x2a, 0x87, 0x9f, 0xed, 0xcb, 0xa5, 0x5b, 0x72, 0x60, 0xbb, 0xc2, 0x6e, 0x0f, 0x47,
+	0xdc, 0x39, 0x8c, 0xce, 0x81, 0xcc, 0x87, 0xaf, 0x20, 0x50, 0x65, 0x49, 0x2e, 0x57, 0x34, 0x5d,
+	0x2c, 0x56, 0x65, 0x45, 0x92, 0xd3, 0xab, 0x6c, 0x76, 0x38, 0xe2, 0x32, 0x18, 0x25, 0x20, 0xc1,
+	0x98, 0xeb, 0xe0, 0x52, 0x68, 0xaf, 0xc8, 0x9f, 0x6b, 0x7a, 0x55, 0xfe, 0xe4, 0xc8, 0x87, 0x7c,
+	0x9a, 0x4f, 0xd3, 0x6b, 0x38, 0x70, 0x1f, 0x99, 0x02, 0xbe, 0x9c, 0xe1, 0x40, 0x3a, 0xb4, 0xbb,
+	0x2d, 0x8b, 0x25, 0x59, 0x4d, 0x27, 0x71, 0x67, 0xf0, 0x8e, 0xa5, 0x9f, 0xfc, 0x94, 0x5b, 0x2a,
+	0x7e, 0xf1, 0xf4, 0x79, 0x8e, 0x7a, 0xf6, 0x3c, 0x47, 0xfd, 0xf9, 0x3c, 0x47, 0x7d, 0xff, 0x22,
+	0xb7, 0xf4, 0xec, 0x45, 0x6e, 0xe9, 0xf7, 0x17, 0xb9, 0xa5, 0xfb, 0x37, 0xeb, 0xb6, 0xd7, 0xe8,
+	0xd6, 0x04, 0x13, 0xb5, 0x0a, 0x26, 0x72, 0x5b, 0xc8, 0x2d, 0xd8, 0x35, 0xf3, 0x6a, 0x1d, 0x15,
+	0x7a, 0xd7, 0x0a, 0x2d, 0x64, 0x75, 0x9b, 0xd0, 0xc5, 0x7f, 0x6b, 0x57, 0xa7, 0xbf, 0x6b, 0xef,
+	0x5e, 0xbf, 0x1a, 0xfd, 0x63, 0xf3, 0x9f, 0x19, 0xb7, 0xb6, 0x12, 0xcc, 0xb3, 0x6b, 0x7f, 0x0d,
+	0x00, 0x63, 0xbb, 0xfd, 0xcf, 0xde, 0x0d, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -1078,6 +1122,43 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSolomachine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1700,6 +1781,21 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
+func (m *BatchHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovSolomachine(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Misbehaviour) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2415,6 +2511,90 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BatchHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
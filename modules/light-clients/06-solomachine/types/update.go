@@ -10,27 +10,50 @@ import (
 )
 
 // CheckHeaderAndUpdateState checks if the provided header is valid and updates
-// the consensus state if appropriate. It returns an error if:
-// - the header provided is not parseable to a solo machine header
+// the consensus state if appropriate. A batch header is applied header by header,
+// each header being checked against the client state updated by the previous one.
+// It returns an error if:
+// - the header provided is not parseable to a solo machine header or batch header
 // - the header sequence does not match the current sequence
 // - the header timestamp is less than the consensus state timestamp
 // - the currently registered public key did not provide the update signature
+//
+// The new public key, which may be a committee public key, is validated by the
+// ValidateBasic of the header.
 func (cs ClientState) CheckHeaderAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
 	header exported.Header,
 ) (exported.ClientState, exported.ConsensusState, error) {
-	smHeader, ok := header.(*Header)
-	if !ok {
+	var headers []Header
+	switch smHeader := header.(type) {
+	case *Header:
+		headers = []Header{*smHeader}
+	case *BatchHeader:
+		if len(smHeader.Headers) == 0 {
+			return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "batch header cannot be empty")
+		}
+		headers = smHeader.Headers
+	default:
 		return nil, nil, sdkerrors.Wrapf(
-			clienttypes.ErrInvalidHeader, "header type %T, expected  %T", header, &Header{},
+			clienttypes.ErrInvalidHeader, "header type %T, expected  %T or %T", header, &Header{}, &BatchHeader{},
 		)
 	}
 
-	if err := checkHeader(cdc, &cs, smHeader); err != nil {
-		return nil, nil, err
+	var (
+		clientState    = &cs
+		consensusState *ConsensusState
+	)
+	for i := range headers {
+		if err := checkHeader(cdc, clientState, &headers[i]); err != nil {
+			if len(headers) > 1 {
+				return nil, nil, sdkerrors.Wrapf(err, "invalid header %d of batch header", i)
+			}
+			return nil, nil, err
+		}
+
+		clientState, consensusState = update(clientState, &headers[i])
 	}
 
-	clientState, consensusState := update(&cs, smHeader)
 	return clientState, consensusState, nil
 }
 
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -32,6 +33,14 @@ func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
 				},
 				true,
 			},
+			{
+				"successful migration to a committee",
+				func() {
+					clientState = solomachine.ClientState()
+					header = solomachine.CreateCommitteeHeader(2, 3)
+				},
+				true,
+			},
 			{
 				"wrong client state type",
 				func() {
@@ -180,3 +189,148 @@ func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateStateCommitteeMigration() {
+	solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+
+	// the single key signs over the committee
+	clientState := exported.ClientState(solomachine.ClientState())
+	clientState, _, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, solomachine.CreateCommitteeHeader(2, 3))
+	suite.Require().NoError(err)
+
+	publicKey, err := clientState.(*types.ClientState).ConsensusState.GetPubKey()
+	suite.Require().NoError(err)
+	suite.Require().IsType(&kmultisig.LegacyAminoPubKey{}, publicKey)
+	suite.Require().Equal(uint32(2), publicKey.(*kmultisig.LegacyAminoPubKey).Threshold)
+
+	// the single key can no longer update the client
+	singleKey := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+	singleKey.Sequence = solomachine.Sequence
+	_, _, err = clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, singleKey.CreateHeader())
+	suite.Require().Error(err)
+
+	// the committee signs the subsequent updates
+	_, _, err = clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, solomachine.CreateHeader())
+	suite.Require().NoError(err)
+}
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateStateCommitteeThresholdChange() {
+	solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+
+	clientState := exported.ClientState(solomachine.ClientState())
+	clientState, _, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, solomachine.CreateCommitteeHeader(2, 3))
+	suite.Require().NoError(err)
+
+	// the committee raises and lowers its threshold, then rotates to a new committee
+	for _, createHeader := range []func() *types.Header{
+		func() *types.Header { return solomachine.CreateCommitteeThresholdHeader(3) },
+		func() *types.Header { return solomachine.CreateCommitteeThresholdHeader(1) },
+		func() *types.Header { return solomachine.CreateCommitteeHeader(3, 4) },
+	} {
+		clientState, _, err = clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, createHeader())
+		suite.Require().NoError(err)
+
+		publicKey, err := clientState.(*types.ClientState).ConsensusState.GetPubKey()
+		suite.Require().NoError(err)
+		suite.Require().True(publicKey.Equals(solomachine.PublicKey))
+	}
+	suite.Require().Equal(uint32(3), solomachine.PublicKey.(*kmultisig.LegacyAminoPubKey).Threshold)
+	suite.Require().Len(solomachine.PublicKeys, 4)
+}
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateStateBatchHeader() {
+	var (
+		clientState exported.ClientState
+		header      exported.Header
+	)
+
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		testCases := []struct {
+			name    string
+			setup   func()
+			expPass bool
+		}{
+			{
+				"successful batch update",
+				func() {
+					clientState = solomachine.ClientState()
+					header = solomachine.CreateBatchHeader(3)
+				},
+				true,
+			},
+			{
+				"successful batch update of a single header",
+				func() {
+					clientState = solomachine.ClientState()
+					header = solomachine.CreateBatchHeader(1)
+				},
+				true,
+			},
+			{
+				"batch header is empty",
+				func() {
+					clientState = solomachine.ClientState()
+					header = &types.BatchHeader{}
+				},
+				false,
+			},
+			{
+				"first header sequence does not match the client state sequence",
+				func() {
+					solomachine.Sequence++
+					clientState = solomachine.ClientState()
+					clientState.(*types.ClientState).Sequence--
+					header = solomachine.CreateBatchHeader(3)
+				},
+				false,
+			},
+			{
+				"header sequences are not consecutive",
+				func() {
+					clientState = solomachine.ClientState()
+					batchHeader := solomachine.CreateBatchHeader(3)
+					batchHeader.Headers = append(batchHeader.Headers[:1], batchHeader.Headers[2])
+					header = batchHeader
+				},
+				false,
+			},
+			{
+				"header is not signed by the public key of the previous header",
+				func() {
+					clientState = solomachine.ClientState()
+					batchHeader := solomachine.CreateBatchHeader(3)
+					batchHeader.Headers[1].Signature = batchHeader.Headers[0].Signature
+					header = batchHeader
+				},
+				false,
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				tc.setup()
+
+				clientState, consensusState, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, header)
+
+				if tc.expPass {
+					batchHeader := header.(*types.BatchHeader)
+					lastHeader := batchHeader.Headers[len(batchHeader.Headers)-1]
+
+					suite.Require().NoError(err)
+					suite.Require().Equal(lastHeader.NewPublicKey, clientState.(*types.ClientState).ConsensusState.PublicKey)
+					suite.Require().Equal(lastHeader.Sequence+1, clientState.(*types.ClientState).Sequence)
+					suite.Require().Equal(solomachine.Sequence, clientState.(*types.ClientState).Sequence)
+					suite.Require().Equal(consensusState, clientState.(*types.ClientState).ConsensusState)
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Nil(clientState)
+					suite.Require().Nil(consensusState)
+				}
+			})
+		}
+	}
+}
@@ -19,7 +19,13 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState            = (*ClientState)(nil)
+	_ exported.UpgradePlanVerifier    = (*ClientState)(nil)
+	_ exported.ChannelUpgradeVerifier = (*ClientState)(nil)
+	_ exported.UpdateGasEstimator     = (*ClientState)(nil)
+	_ exported.HeaderPrechecker       = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -143,10 +149,10 @@ func (cs ClientState) Validate() error {
 	if cs.ProofSpecs == nil {
 		return sdkerrors.Wrap(ErrInvalidProofSpecs, "proof specs cannot be nil for tm client")
 	}
-	for i, spec := range cs.ProofSpecs {
-		if spec == nil {
-			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "proof spec cannot be nil at index: %d", i)
-		}
+	// the proof specs may differ from the SDK ones for chains with other stores, e.g. sparse
+	// merkle trees, but must be supported by ICS-23
+	if err := commitmenttypes.ValidateProofSpecs(cs.ProofSpecs); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProofSpecs, err.Error())
 	}
 	// UpgradePath may be empty, but if it isn't, each key must be non-empty
 	for i, k := range cs.UpgradePath {
@@ -509,6 +515,66 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the upgrade proposed for the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade codec.ProtoMarshaler,
+) error {
+	return cs.verifyChannelUpgradeState(store, cdc, height, prefix, proof, host.ChannelUpgradePath(portID, channelID), upgrade)
+}
+
+// VerifyChannelUpgradeError verifies a proof of the error receipt of the last aborted
+// upgrade of the specified channel end, under the specified port, stored on the target
+// machine.
+func (cs ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt codec.ProtoMarshaler,
+) error {
+	return cs.verifyChannelUpgradeState(store, cdc, height, prefix, proof, host.ChannelUpgradeErrorPath(portID, channelID), errorReceipt)
+}
+
+// verifyChannelUpgradeState verifies a proof of the provided channel upgrade state stored
+// under the provided path on the target machine.
+func (cs ClientState) verifyChannelUpgradeState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	upgradePath string,
+	value codec.ProtoMarshaler,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(upgradePath))
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz)
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
@@ -517,7 +583,7 @@ func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exp
 	if !ok {
 		return sdkerrors.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height: %s", proofHeight)
 	}
-	currentTimestamp := uint64(ctx.BlockTime().UnixNano())
+	currentTimestamp := clienttypes.GetHostTimestamp(ctx)
 	validTime := processedTime + delayTimePeriod
 	// NOTE: delay time period is inclusive, so if currentTimestamp is validTime, then we return no error
 	if currentTimestamp < validTime {
@@ -529,7 +595,7 @@ func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exp
 	if !ok {
 		return sdkerrors.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
 	}
-	currentHeight := clienttypes.GetSelfHeight(ctx)
+	currentHeight := clienttypes.GetHostHeight(ctx)
 	validHeight := clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()+delayBlockPeriod)
 	// NOTE: delay block period is inclusive, so if currentHeight is validHeight, then we return no error
 	if currentHeight.LT(validHeight) {
@@ -153,6 +153,19 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: types.NewClientState(chainID, types.DefaultTrustLevel, ubdPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.TendermintSpec, nil}, upgradePath, false, false),
 			expPass:     false,
 		},
+		{
+			name:        "sparse merkle tree proof specs",
+			clientState: types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.SmtSpec, ics23.TendermintSpec}, upgradePath, false, false),
+			expPass:     true,
+		},
+		{
+			name: "proof specs with unsupported hash operation",
+			clientState: types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{
+				{LeafSpec: ics23.IavlSpec.LeafSpec, InnerSpec: &ics23.InnerSpec{ChildOrder: []int32{0, 1}, ChildSize: 32, Hash: ics23.HashOp_KECCAK}},
+				ics23.TendermintSpec,
+			}, upgradePath, false, false),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -373,6 +386,11 @@ func (suite *TendermintTestSuite) TestVerifyChannelState() {
 				proof = invalidProof
 			}, false,
 		},
+		{
+			"proof verification failed with the proof specs of the client state", func() {
+				clientState.ProofSpecs = []*ics23.ProofSpec{ics23.SmtSpec, ics23.TendermintSpec}
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"math/bits"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// UpdateBaseGas is the estimated gas of a transaction with a single MsgUpdateClient signed
+	// by a single account, excluding the gas charged for the validators of the header.
+	UpdateBaseGas uint64 = 100_000
+
+	// UpdateGasPerValidator is the estimated gas charged per validator of the counterparty
+	// chain when updating a client. A header carries every validator twice, in the validator
+	// set and the trusted validator set, along with its commit signature, which amounts to
+	// about 230 bytes charged at the default auth module cost of 10 gas per transaction byte.
+	UpdateGasPerValidator uint64 = 2_300
+)
+
+// EstimateUpdateGas estimates the gas of a transaction updating the client with a header of a
+// counterparty chain with the provided validator set size. Signature verification is not
+// metered, so the gas only depends on the size of the header. The number of signatures
+// verified for a non-adjacent header is returned along with the gas, which depends on the
+// trust level of the client and assumes validators of equal voting power.
+func (cs ClientState) EstimateUpdateGas(validatorSetSize uint64) (uint64, uint64, error) {
+	if validatorSetSize == 0 || validatorSetSize > tmtypes.MaxVotesCount {
+		return 0, 0, sdkerrors.Wrapf(ErrInvalidValidatorSet, "validator set size must be between 1 and %d, got %d", tmtypes.MaxVotesCount, validatorSetSize)
+	}
+
+	gas := UpdateBaseGas + validatorSetSize*UpdateGasPerValidator
+
+	// the header commit must be signed by more than the trust level of the trusted validators
+	// and more than 2/3 of the new validators. The trust level is at most 1 so the division
+	// cannot overflow.
+	hi, lo := bits.Mul64(validatorSetSize, cs.TrustLevel.Numerator)
+	trustedSignatures, _ := bits.Div64(hi, lo, cs.TrustLevel.Denominator)
+	trustedSignatures++
+	if trustedSignatures > validatorSetSize {
+		trustedSignatures = validatorSetSize
+	}
+
+	signatures := trustedSignatures + validatorSetSize*2/3 + 1
+
+	return gas, signatures, nil
+}
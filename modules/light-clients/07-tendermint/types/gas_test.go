@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmmath "github.com/tendermint/tendermint/libs/math"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *TendermintTestSuite) TestEstimateUpdateGas() {
+	clientState := ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+
+	testCases := []struct {
+		validatorSetSize      uint64
+		expGas                uint64
+		expSignaturesVerified uint64
+	}{
+		{1, ibctmtypes.UpdateBaseGas + ibctmtypes.UpdateGasPerValidator, 2},
+		{4, ibctmtypes.UpdateBaseGas + 4*ibctmtypes.UpdateGasPerValidator, 2 + 3},
+		{150, ibctmtypes.UpdateBaseGas + 150*ibctmtypes.UpdateGasPerValidator, 51 + 101},
+	}
+
+	for _, tc := range testCases {
+		gas, signaturesVerified, err := clientState.EstimateUpdateGas(tc.validatorSetSize)
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expGas, gas)
+		suite.Require().Equal(tc.expSignaturesVerified, signaturesVerified)
+	}
+
+	// a higher trust level requires more signatures of the trusted validators
+	clientState.TrustLevel = ibctmtypes.NewFractionFromTm(tmmath.Fraction{Numerator: 2, Denominator: 3})
+	_, signaturesVerified, err := clientState.EstimateUpdateGas(150)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(101+101), signaturesVerified)
+
+	_, _, err = clientState.EstimateUpdateGas(0)
+	suite.Require().ErrorIs(err, ibctmtypes.ErrInvalidValidatorSet)
+
+	_, _, err = clientState.EstimateUpdateGas(tmtypes.MaxVotesCount + 1)
+	suite.Require().ErrorIs(err, ibctmtypes.ErrInvalidValidatorSet)
+}
+
+// TestEstimateUpdateGasCoversUpdate tests that the estimate covers the gas used by a transaction
+// updating a client of a testing chain.
+func (suite *TendermintTestSuite) TestEstimateUpdateGasCoversUpdate() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	// the estimate of the client keeper includes the gas of the relayer hooks of the testing app
+	gas, _, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.EstimateClientUpdateGas(suite.chainA.GetContext(), path.EndpointA.ClientID, uint64(suite.chainB.Vals.Size()))
+	suite.Require().NoError(err)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	suite.coordinator.UpdateTimeForChain(suite.chainA)
+	gasInfo, _, err := simapp.SignAndDeliver(
+		suite.T(), suite.chainA.TxConfig, suite.chainA.App.GetBaseApp(), suite.chainA.GetContext().BlockHeader(), []sdk.Msg{msg},
+		suite.chainA.ChainID, []uint64{suite.chainA.SenderAccount.GetAccountNumber()}, []uint64{suite.chainA.SenderAccount.GetSequence()},
+		true, true, suite.chainA.SenderPrivKey,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().GreaterOrEqual(gas, gasInfo.GasUsed)
+}
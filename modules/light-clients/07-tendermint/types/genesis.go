@@ -7,14 +7,17 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-// ExportMetadata exports all the consensus metadata in the client store so they can be included in clients genesis
-// and imported by a ClientKeeper
+// ExportMetadata exports all the consensus metadata in the client store, and the misbehaviour evidence of a frozen
+// client, so they can be included in clients genesis and imported by a ClientKeeper
 func (cs ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetadata {
 	gm := make([]exported.GenesisMetadata, 0)
 	IterateConsensusMetadata(store, func(key, val []byte) bool {
 		gm = append(gm, clienttypes.NewGenesisMetadata(key, val))
 		return false
 	})
+	if bz := store.Get(KeyFrozenEvidence); bz != nil {
+		gm = append(gm, clienttypes.NewGenesisMetadata(KeyFrozenEvidence, bz))
+	}
 	if len(gm) == 0 {
 		return nil
 	}
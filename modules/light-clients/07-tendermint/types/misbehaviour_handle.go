@@ -92,6 +92,7 @@ func (cs ClientState) CheckMisbehaviourAndUpdateState(
 	}
 
 	cs.FrozenHeight = FrozenHeight
+	setFrozenEvidence(ctx, clientStore, cdc, FrozenEvidence{Misbehaviour: tmMisbehaviour})
 
 	return &cs, nil
 }
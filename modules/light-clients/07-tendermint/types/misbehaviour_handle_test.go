@@ -410,20 +410,29 @@ func (suite *TendermintTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(ctx, clientID, tc.height2, tc.consensusState2)
 			}
 
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID) // pass in clientID prefixed clientStore
 			clientState, err := tc.clientState.CheckMisbehaviourAndUpdateState(
 				ctx,
 				suite.chainA.App.AppCodec(),
-				suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID), // pass in clientID prefixed clientStore
+				clientStore,
 				tc.misbehaviour,
 			)
 
+			evidence, found := types.GetFrozenEvidence(clientStore, suite.chainA.App.AppCodec())
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
 				suite.Require().NotNil(clientState, "valid test case %d failed: %s", i, tc.name)
 				suite.Require().True(!clientState.(*types.ClientState).FrozenHeight.IsZero(), "valid test case %d failed: %s", i, tc.name)
+
+				// the misbehaviour is stored as evidence of the freezing
+				suite.Require().True(found, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(suite.chainA.App.AppCodec().MustMarshal(tc.misbehaviour.(*types.Misbehaviour)), suite.chainA.App.AppCodec().MustMarshal(evidence.Misbehaviour), "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(clienttypes.GetSelfHeight(ctx), evidence.FrozenHeight, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().True(tc.timestamp.Equal(evidence.FrozenTime), "valid test case %d failed: %s", i, tc.name)
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
 				suite.Require().Nil(clientState, "invalid test case %d passed: %s", i, tc.name)
+				suite.Require().False(found, "invalid test case %d passed: %s", i, tc.name)
 			}
 		})
 	}
@@ -48,6 +48,7 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 
 		// unfreeze the client
 		cs.FrozenHeight = clienttypes.ZeroHeight()
+		deleteFrozenEvidence(subjectClientStore)
 
 	case exported.Expired:
 		if !cs.AllowUpdateAfterExpiry {
@@ -231,6 +231,7 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 			// apply freezing or expiry as determined by the test case
 			if tc.FreezeClient {
 				subjectClientState.FrozenHeight = frozenHeight
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID).Set(types.KeyFrozenEvidence, []byte("evidence"))
 			}
 			if tc.ExpireClient {
 				// expire subject client
@@ -299,6 +300,9 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 				suite.Require().Equal(expectedIterationKey, subjectIterationKey)
 
 				suite.Require().Equal(newChainID, updatedClient.(*types.ClientState).ChainId)
+
+				// the misbehaviour evidence is deleted when the client is unfrozen
+				suite.Require().False(subjectClientStore.Has(types.KeyFrozenEvidence))
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(updatedClient)
@@ -41,6 +41,8 @@ var (
 	KeyProcessedHeight = []byte("/processedHeight")
 	// KeyIteration stores the key mapping to consensus state key for efficient iteration
 	KeyIteration = []byte("/iterationKey")
+	// KeyFrozenEvidence stores the misbehaviour evidence of a frozen client
+	KeyFrozenEvidence = []byte("/frozenEvidence")
 )
 
 // SetConsensusState stores the consensus state at the given height.
@@ -265,6 +267,22 @@ func GetNextConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, heigh
 	return getTmConsensusState(clientStore, cdc, csKey)
 }
 
+// GetNextConsensusStateHeight returns the lowest height larger than the given height at which a
+// consensus state is stored.
+func GetNextConsensusStateHeight(clientStore sdk.KVStore, height exported.Height) (exported.Height, bool) {
+	iterator := clientStore.Iterator(IterationKey(height), sdk.PrefixEndBytes([]byte(KeyIterateConsensusStatePrefix)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		nextHeight := GetHeightFromIterationKey(iterator.Key())
+		if nextHeight.GT(height) {
+			return nextHeight, true
+		}
+	}
+
+	return nil, false
+}
+
 // GetPreviousConsensusState returns the highest consensus state that is lower than the given height.
 // The Iterator returns a storetypes.Iterator which iterates from the end (exclusive) to start (inclusive).
 // Thus to get previous consensus state we call iterator.Value() immediately.
@@ -282,6 +300,28 @@ func GetPreviousConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, h
 	return getTmConsensusState(clientStore, cdc, csKey)
 }
 
+// GetTrustedConsensusState returns the highest consensus state that is lower than the given
+// height along with its height, provided that the consensus state has not expired. The returned
+// consensus state may be used as the trusted consensus state of a header at the given height.
+func GetTrustedConsensusState(
+	ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec,
+	clientState *ClientState, height exported.Height,
+) (exported.Height, *ConsensusState, bool) {
+	iterator := clientStore.ReverseIterator([]byte(KeyIterateConsensusStatePrefix), IterationKey(height))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil, false
+	}
+
+	consState, found := getTmConsensusState(clientStore, cdc, iterator.Value())
+	if !found || clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		return nil, nil, false
+	}
+
+	return GetHeightFromIterationKey(iterator.Key()), consState, true
+}
+
 // PruneAllExpiredConsensusStates iterates over all consensus states for a given
 // client store. If a consensus state is expired, it is deleted and its metadata
 // is deleted.
@@ -318,6 +358,53 @@ func PruneAllExpiredConsensusStates(
 	return nil
 }
 
+// PruneExpiredConsensusStates deletes the expired consensus states of a client store along
+// with their metadata in ascending height order, up to the provided limit, and returns the
+// number of consensus states deleted. The iteration stops at the first consensus state which
+// has not expired, as consensus state timestamps increase with their height. The consensus
+// state at the latest height of the client is never deleted.
+func PruneExpiredConsensusStates(
+	ctx sdk.Context, clientStore sdk.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit int,
+) (int, error) {
+	var (
+		heights []exported.Height
+		err     error
+	)
+
+	pruneCb := func(height exported.Height) bool {
+		if len(heights) >= limit || height.GTE(clientState.GetLatestHeight()) {
+			return true
+		}
+
+		var consState *ConsensusState
+		consState, err = GetConsensusState(clientStore, cdc, height)
+		// this error should never occur
+		if err != nil {
+			return true
+		}
+
+		if !clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return len(heights), nil
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
@@ -344,12 +431,12 @@ func bigEndianHeightBytes(height exported.Height) []byte {
 	return heightBytes
 }
 
-// setConsensusMetadata sets context time as processed time and set context height as processed height
-// as this is internal tendermint light client logic.
+// setConsensusMetadata sets the host timestamp as processed time and the host height as processed
+// height as this is internal tendermint light client logic.
 // client state and consensus state will be set by client keeper
 // set iteration key to provide ability for efficient ordered iteration of consensus states.
 func setConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore, height exported.Height) {
-	setConsensusMetadataWithValues(clientStore, height, clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()))
+	setConsensusMetadataWithValues(clientStore, height, clienttypes.GetHostHeight(ctx), clienttypes.GetHostTimestamp(ctx))
 }
 
 // setConsensusMetadataWithValues sets the consensus metadata with the provided values
@@ -369,3 +456,29 @@ func deleteConsensusMetadata(clientStore sdk.KVStore, height exported.Height) {
 	deleteProcessedHeight(clientStore, height)
 	deleteIterationKey(clientStore, height)
 }
+
+// setFrozenEvidence stores the evidence of the misbehaviour which froze the client together with
+// the height and block time of the chain at which it was frozen. The evidence of a previous
+// freezing is replaced.
+func setFrozenEvidence(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec, evidence FrozenEvidence) {
+	evidence.FrozenHeight = clienttypes.GetSelfHeight(ctx)
+	evidence.FrozenTime = ctx.BlockTime()
+	clientStore.Set(KeyFrozenEvidence, cdc.MustMarshal(&evidence))
+}
+
+// deleteFrozenEvidence deletes the misbehaviour evidence of a client which is unfrozen.
+func deleteFrozenEvidence(clientStore sdk.KVStore) {
+	clientStore.Delete(KeyFrozenEvidence)
+}
+
+// GetFrozenEvidence returns the misbehaviour evidence stored when the client was last frozen.
+func GetFrozenEvidence(clientStore sdk.KVStore, cdc codec.BinaryCodec) (FrozenEvidence, bool) {
+	bz := clientStore.Get(KeyFrozenEvidence)
+	if bz == nil {
+		return FrozenEvidence{}, false
+	}
+
+	var evidence FrozenEvidence
+	cdc.MustUnmarshal(bz, &evidence)
+	return evidence, true
+}
@@ -192,3 +192,50 @@ func (suite *TendermintTestSuite) TestGetNeighboringConsensusStates() {
 	suite.Require().Nil(nextCs49, "next consensus state exists after highest consensus state")
 	suite.Require().False(ok)
 }
+
+func (suite *TendermintTestSuite) TestPruneExpiredConsensusStates() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	// the initial consensus state and the consensus states of both updates expire
+	var expiredHeights []exported.Height
+	expiredHeights = append(expiredHeights, path.EndpointA.GetClientState().GetLatestHeight())
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		expiredHeights = append(expiredHeights, path.EndpointA.GetClientState().GetLatestHeight())
+	}
+
+	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
+
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	clientState := path.EndpointA.GetClientState().(*types.ClientState)
+
+	// the expired consensus states are pruned in ascending height order up to the limit
+	pruned, err := types.PruneExpiredConsensusStates(ctx, clientStore, suite.chainA.Codec, clientState, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, pruned)
+
+	for i, height := range expiredHeights {
+		_, err := types.GetConsensusState(clientStore, suite.chainA.Codec, height)
+		suite.Require().Equal(i >= 2, err == nil)
+		suite.Require().Equal(i >= 2, types.GetIterationKey(clientStore, height) != nil)
+		_, ok := types.GetProcessedTime(clientStore, height)
+		suite.Require().Equal(i >= 2, ok)
+	}
+
+	pruned, err = types.PruneExpiredConsensusStates(ctx, clientStore, suite.chainA.Codec, clientState, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, pruned)
+
+	// the consensus state which has not expired is never pruned
+	pruned, err = types.PruneExpiredConsensusStates(ctx, clientStore, suite.chainA.Codec, clientState, 2)
+	suite.Require().NoError(err)
+	suite.Require().Zero(pruned)
+
+	_, err = types.GetConsensusState(clientStore, suite.chainA.Codec, latestHeight)
+	suite.Require().NoError(err)
+}
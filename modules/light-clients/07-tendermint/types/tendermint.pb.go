@@ -184,6 +184,92 @@ func (m *Misbehaviour) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
 
+// FrozenEvidence defines the misbehaviour evidence stored in the client store
+// when a tendermint client is frozen. The misbehaviour is set if it was
+// submitted with a MsgSubmitMisbehaviour. If the misbehaviour was detected while
+// updating the client, the header and the stored consensus state it conflicts
+// with are set instead.
+type FrozenEvidence struct {
+	Misbehaviour *Misbehaviour `protobuf:"bytes,1,opt,name=misbehaviour,proto3" json:"misbehaviour,omitempty"`
+	// height of the chain at which the client was frozen
+	FrozenHeight types.Height `protobuf:"bytes,2,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height" yaml:"frozen_height"`
+	// block time at which the client was frozen
+	FrozenTime time.Time `protobuf:"bytes,3,opt,name=frozen_time,json=frozenTime,proto3,stdtime" json:"frozen_time" yaml:"frozen_time"`
+	// header which conflicts with a stored consensus state
+	Header *Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	// stored consensus state at the height of the header, or the previous or next
+	// consensus state whose timestamp is not monotonic with the header
+	ConflictingConsensusState *ConsensusState `protobuf:"bytes,5,opt,name=conflicting_consensus_state,json=conflictingConsensusState,proto3" json:"conflicting_consensus_state,omitempty" yaml:"conflicting_consensus_state"`
+}
+
+func (m *FrozenEvidence) Reset()         { *m = FrozenEvidence{} }
+func (m *FrozenEvidence) String() string { return proto.CompactTextString(m) }
+func (*FrozenEvidence) ProtoMessage()    {}
+func (*FrozenEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{3}
+}
+func (m *FrozenEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenEvidence.Merge(m, src)
+}
+func (m *FrozenEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenEvidence proto.InternalMessageInfo
+
+func (m *FrozenEvidence) GetMisbehaviour() *Misbehaviour {
+	if m != nil {
+		return m.Misbehaviour
+	}
+	return nil
+}
+
+func (m *FrozenEvidence) GetFrozenHeight() types.Height {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return types.Height{}
+}
+
+func (m *FrozenEvidence) GetFrozenTime() time.Time {
+	if m != nil {
+		return m.FrozenTime
+	}
+	return time.Time{}
+}
+
+func (m *FrozenEvidence) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *FrozenEvidence) GetConflictingConsensusState() *ConsensusState {
+	if m != nil {
+		return m.ConflictingConsensusState
+	}
+	return nil
+}
+
 // Header defines the Tendermint client consensus Header.
 // It encapsulates all the information necessary to update from a trusted
 // Tendermint ConsensusState. The inclusion of TrustedHeight and
@@ -207,7 +293,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{3}
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +354,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +401,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.tendermint.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
+	proto.RegisterType((*FrozenEvidence)(nil), "ibc.lightclients.tendermint.v1.FrozenEvidence")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
@@ -324,75 +411,82 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x6e, 0xda, 0xb2, 0x4d, 0x26, 0xe9, 0x76, 0x31, 0xa5, 0x9b, 0x96, 0x6e, 0x1c, 0x19, 0xb4,
-	0x44, 0x48, 0xb5, 0x49, 0x8a, 0x84, 0x54, 0x71, 0xc1, 0xdd, 0x45, 0x2d, 0x62, 0xa5, 0xca, 0xe5,
-	0x87, 0x84, 0x84, 0xcc, 0xc4, 0x9e, 0x24, 0xa3, 0xb5, 0x3d, 0xc6, 0x33, 0x09, 0x2d, 0x7f, 0x01,
-	0x1c, 0x90, 0xf6, 0x88, 0x38, 0x71, 0xe0, 0x8f, 0xd9, 0x63, 0x8f, 0x9c, 0x0c, 0x6a, 0x2f, 0x9c,
-	0x73, 0xe4, 0x84, 0xe6, 0x87, 0xed, 0x69, 0xb6, 0x4b, 0xb5, 0x5c, 0xa2, 0x79, 0xef, 0x7d, 0xef,
-	0xfb, 0x32, 0x6f, 0xde, 0xbc, 0x31, 0x70, 0xf0, 0x30, 0x70, 0x22, 0x3c, 0x9e, 0xb0, 0x20, 0xc2,
-	0x28, 0x61, 0xd4, 0x61, 0x28, 0x09, 0x51, 0x16, 0xe3, 0x84, 0x39, 0xb3, 0xbe, 0x66, 0xd9, 0x69,
-	0x46, 0x18, 0x31, 0x3a, 0x78, 0x18, 0xd8, 0x7a, 0x82, 0xad, 0x41, 0x66, 0xfd, 0x9d, 0xae, 0x96,
-	0xcf, 0xce, 0x53, 0x44, 0x9d, 0x19, 0x8c, 0x70, 0x08, 0x19, 0xc9, 0x24, 0xc3, 0xce, 0xee, 0x0b,
-	0x08, 0xf1, 0xab, 0xa2, 0xad, 0x34, 0x23, 0x64, 0x54, 0x58, 0x9d, 0x31, 0x21, 0xe3, 0x08, 0x39,
-	0xc2, 0x1a, 0x4e, 0x47, 0x4e, 0x38, 0xcd, 0x20, 0xc3, 0x24, 0x51, 0x71, 0x73, 0x31, 0xce, 0x70,
-	0x8c, 0x28, 0x83, 0x71, 0x5a, 0x00, 0xf8, 0xfe, 0x02, 0x92, 0x21, 0x47, 0xfe, 0x5d, 0xbe, 0x27,
-	0xb9, 0x52, 0x80, 0x77, 0x2b, 0x00, 0x89, 0x63, 0xcc, 0xe2, 0x02, 0x54, 0x5a, 0x0a, 0xb8, 0x39,
-	0x26, 0x63, 0x22, 0x96, 0x0e, 0x5f, 0x49, 0xaf, 0xf5, 0xf7, 0x1a, 0x68, 0x1e, 0x0a, 0xbe, 0x53,
-	0x06, 0x19, 0x32, 0xb6, 0x41, 0x3d, 0x98, 0x40, 0x9c, 0xf8, 0x38, 0x6c, 0xd7, 0xba, 0xb5, 0x5e,
-	0xc3, 0x5b, 0x13, 0xf6, 0x71, 0x68, 0x20, 0xd0, 0x64, 0xd9, 0x94, 0x32, 0x3f, 0x42, 0x33, 0x14,
-	0xb5, 0x97, 0xbb, 0xb5, 0x5e, 0x73, 0xd0, 0xb3, 0xff, 0xbb, 0x9e, 0xf6, 0x27, 0x19, 0x0c, 0xf8,
-	0x86, 0xdd, 0x9d, 0xe7, 0xb9, 0xb9, 0x34, 0xcf, 0x4d, 0xe3, 0x1c, 0xc6, 0xd1, 0x81, 0xa5, 0x51,
-	0x59, 0x1e, 0x10, 0xd6, 0x67, 0xdc, 0x30, 0x46, 0x60, 0x43, 0x58, 0x38, 0x19, 0xfb, 0x29, 0xca,
-	0x30, 0x09, 0xdb, 0x2b, 0x42, 0x6a, 0xdb, 0x96, 0xc5, 0xb2, 0x8b, 0x62, 0xd9, 0x8f, 0x54, 0x31,
-	0x5d, 0x4b, 0x71, 0x6f, 0x69, 0xdc, 0x55, 0xbe, 0xf5, 0xcb, 0x9f, 0x66, 0xcd, 0xbb, 0x5b, 0x78,
-	0x4f, 0x84, 0xd3, 0xc0, 0xe0, 0xde, 0x34, 0x19, 0x92, 0x24, 0xd4, 0x84, 0x56, 0x6f, 0x13, 0x7a,
-	0x5b, 0x09, 0xdd, 0x97, 0x42, 0x8b, 0x04, 0x52, 0x69, 0xa3, 0x74, 0x2b, 0x29, 0x04, 0x36, 0x62,
-	0x78, 0xe6, 0x07, 0x11, 0x09, 0x9e, 0xfa, 0x61, 0x86, 0x47, 0xac, 0xfd, 0xda, 0x2b, 0x6e, 0x69,
-	0x21, 0x5f, 0x0a, 0xad, 0xc7, 0xf0, 0xec, 0x90, 0x3b, 0x1f, 0x71, 0x9f, 0xf1, 0x0d, 0x58, 0x1f,
-	0x65, 0xe4, 0x07, 0x94, 0xf8, 0x13, 0xc4, 0x0f, 0xa4, 0x7d, 0x47, 0x88, 0xec, 0x88, 0x23, 0xe2,
-	0x2d, 0x62, 0xab, 0xce, 0x99, 0xf5, 0xed, 0x23, 0x81, 0x70, 0x77, 0x95, 0xca, 0xa6, 0x54, 0xb9,
-	0x96, 0x6e, 0x79, 0x2d, 0x69, 0x4b, 0x2c, 0xa7, 0x8f, 0x20, 0x43, 0x94, 0x15, 0xf4, 0x6b, 0xaf,
-	0x4a, 0x7f, 0x2d, 0xdd, 0xf2, 0x5a, 0xd2, 0x56, 0xf4, 0xc7, 0xa0, 0x29, 0xae, 0x8e, 0x4f, 0x53,
-	0x14, 0xd0, 0x76, 0xbd, 0xbb, 0xd2, 0x6b, 0x0e, 0xee, 0xd9, 0x38, 0xa0, 0x83, 0x7d, 0xfb, 0x84,
-	0x47, 0x4e, 0x53, 0x14, 0xb8, 0x5b, 0x55, 0x0b, 0x69, 0x70, 0xcb, 0x03, 0x69, 0x01, 0xa1, 0xc6,
-	0x01, 0x68, 0x4d, 0xd3, 0x71, 0x06, 0x43, 0xe4, 0xa7, 0x90, 0x4d, 0xda, 0x8d, 0xee, 0x4a, 0xaf,
-	0xe1, 0xde, 0x9f, 0xe7, 0xe6, 0x1b, 0xea, 0xdc, 0xb4, 0xa8, 0xe5, 0x35, 0x95, 0x79, 0x02, 0xd9,
-	0xc4, 0xf0, 0xc1, 0x36, 0x8c, 0x22, 0xf2, 0xbd, 0x3f, 0x4d, 0x43, 0xc8, 0x90, 0x0f, 0x47, 0x0c,
-	0x65, 0x3e, 0x3a, 0x4b, 0x71, 0x76, 0xde, 0x06, 0xdd, 0x5a, 0xaf, 0xee, 0xbe, 0x33, 0xcf, 0xcd,
-	0xae, 0x24, 0x7a, 0x29, 0xd4, 0xf2, 0xb6, 0x44, 0xec, 0x0b, 0x11, 0xfa, 0x98, 0x47, 0x1e, 0x8b,
-	0x80, 0xf1, 0x1d, 0x30, 0x6f, 0xc8, 0x8a, 0x31, 0x1d, 0xa2, 0x09, 0x9c, 0x61, 0x32, 0xcd, 0xda,
-	0x4d, 0x21, 0xf3, 0xde, 0x3c, 0x37, 0x1f, 0xbe, 0x54, 0x46, 0x4f, 0xb0, 0xbc, 0xdd, 0x45, 0xb1,
-	0x27, 0x5a, 0xf8, 0x60, 0xf5, 0xc7, 0xdf, 0xcc, 0x25, 0xeb, 0xf7, 0x65, 0x70, 0xf7, 0x90, 0x24,
-	0x14, 0x25, 0x74, 0x4a, 0xe5, 0x6d, 0x77, 0x41, 0xa3, 0x1c, 0x38, 0xe2, 0xba, 0xf3, 0xe3, 0x5c,
-	0x6c, 0xc9, 0xcf, 0x0b, 0x84, 0x5b, 0xe7, 0xc7, 0xf9, 0x8c, 0x77, 0x5e, 0x95, 0x66, 0x7c, 0x04,
-	0x56, 0x33, 0x42, 0x98, 0x9a, 0x07, 0x96, 0xd6, 0x0d, 0xd5, 0x04, 0x9a, 0xf5, 0xed, 0x27, 0x28,
-	0x7b, 0x1a, 0x21, 0x8f, 0x10, 0xe6, 0xae, 0x72, 0x1a, 0x4f, 0x64, 0x19, 0x3f, 0xd5, 0xc0, 0x66,
-	0x82, 0xce, 0x98, 0x5f, 0x4e, 0x59, 0xea, 0x4f, 0x20, 0x9d, 0x88, 0x3b, 0xdf, 0x72, 0xbf, 0x9a,
-	0xe7, 0xe6, 0x5b, 0xb2, 0x06, 0x37, 0xa1, 0xac, 0x7f, 0x72, 0xf3, 0x83, 0x31, 0x66, 0x93, 0xe9,
-	0x90, 0xcb, 0xe9, 0xb3, 0x5f, 0x5b, 0x46, 0x78, 0x48, 0x9d, 0xe1, 0x39, 0x43, 0xd4, 0x3e, 0x42,
-	0x67, 0x2e, 0x5f, 0x78, 0x06, 0xa7, 0xfb, 0xb2, 0x64, 0x3b, 0x82, 0x74, 0xa2, 0xca, 0xf4, 0xf3,
-	0x32, 0x68, 0xe9, 0xd5, 0x33, 0xfa, 0xa0, 0x21, 0x1b, 0xbb, 0x9c, 0x89, 0xee, 0xe6, 0x3c, 0x37,
-	0xef, 0xc9, 0xbf, 0x55, 0x86, 0x2c, 0xaf, 0x2e, 0xd7, 0xc7, 0xa1, 0x01, 0x41, 0x7d, 0x82, 0x60,
-	0x88, 0x32, 0xbf, 0xaf, 0xea, 0xf2, 0xf0, 0xb6, 0x39, 0x79, 0x24, 0xf0, 0x6e, 0xe7, 0x32, 0x37,
-	0xd7, 0xe4, 0xba, 0x3f, 0xcf, 0xcd, 0x0d, 0x29, 0x52, 0x90, 0x59, 0xde, 0x9a, 0x5c, 0xf6, 0x35,
-	0x89, 0x81, 0x9a, 0x8f, 0xff, 0x43, 0x62, 0xf0, 0x82, 0xc4, 0xa0, 0x94, 0x18, 0xa8, 0x7a, 0xfc,
-	0xba, 0x02, 0xee, 0x48, 0xb4, 0x01, 0xc1, 0x3a, 0xc5, 0xe3, 0x04, 0x85, 0xbe, 0x84, 0xa8, 0x96,
-	0xe9, 0xe8, 0x3a, 0xf2, 0x2d, 0x3c, 0x15, 0x30, 0x25, 0xb8, 0x7b, 0x91, 0x9b, 0xb5, 0x6a, 0x0a,
-	0x5c, 0xa3, 0xb0, 0xbc, 0x16, 0xd5, 0xb0, 0x7c, 0xc8, 0x94, 0x67, 0xec, 0x53, 0x54, 0xb4, 0xd5,
-	0x0d, 0x12, 0xe5, 0xe1, 0x9d, 0x22, 0xe6, 0xb6, 0x2b, 0xfa, 0x6b, 0xe9, 0x96, 0xd7, 0x9a, 0x69,
-	0x38, 0xe3, 0x5b, 0x20, 0x9f, 0x01, 0xa1, 0x2f, 0x86, 0xd8, 0xca, 0xad, 0x43, 0xec, 0x81, 0x1a,
-	0x62, 0x6f, 0x6a, 0x8f, 0x4b, 0x99, 0x6f, 0x79, 0xeb, 0xca, 0xa1, 0xc6, 0x58, 0x04, 0x8c, 0x02,
-	0x51, 0x35, 0xab, 0x7a, 0x58, 0x6e, 0xdb, 0xc5, 0x83, 0x79, 0x6e, 0x6e, 0x5f, 0x57, 0xa9, 0x38,
-	0x2c, 0xef, 0x75, 0xe5, 0xac, 0xda, 0xd6, 0xfa, 0x14, 0xd4, 0x8b, 0x07, 0xd6, 0xd8, 0x05, 0x8d,
-	0x64, 0x1a, 0xa3, 0x8c, 0x47, 0xc4, 0xc9, 0xac, 0x7a, 0x95, 0xc3, 0xe8, 0x82, 0x66, 0x88, 0x12,
-	0x12, 0xe3, 0x44, 0xc4, 0x97, 0x45, 0x5c, 0x77, 0xb9, 0xfe, 0xf3, 0xcb, 0x4e, 0xed, 0xe2, 0xb2,
-	0x53, 0xfb, 0xeb, 0xb2, 0x53, 0x7b, 0x76, 0xd5, 0x59, 0xba, 0xb8, 0xea, 0x2c, 0xfd, 0x71, 0xd5,
-	0x59, 0xfa, 0xfa, 0xb1, 0x76, 0xc5, 0x02, 0x42, 0x63, 0x42, 0xf9, 0x67, 0xd7, 0xde, 0x98, 0x38,
-	0xb3, 0x7d, 0x27, 0x26, 0xe1, 0x34, 0x42, 0x54, 0x7e, 0x84, 0xed, 0x15, 0x5f, 0x61, 0xef, 0x7f,
-	0xb8, 0xb7, 0xf8, 0x99, 0x34, 0xbc, 0x23, 0x46, 0xca, 0xfe, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x2b, 0x98, 0x08, 0xb4, 0x09, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x26, 0x71, 0xc6, 0x4e, 0x53, 0x96, 0xd0, 0x6e, 0xd2, 0xd4, 0x6b, 0x0d, 0xa8,
+	0x44, 0x88, 0xee, 0x62, 0x17, 0x09, 0xa9, 0x42, 0x48, 0x6c, 0x3f, 0x94, 0x22, 0x2a, 0x45, 0x1b,
+	0x28, 0x12, 0x08, 0x2d, 0xeb, 0xdd, 0xb1, 0x3d, 0xea, 0xee, 0xce, 0xb2, 0x33, 0x36, 0x09, 0xbf,
+	0x00, 0x0e, 0x48, 0x3d, 0x21, 0xc4, 0x89, 0x03, 0x3f, 0xa6, 0xc7, 0x1e, 0x39, 0x2d, 0x28, 0xbd,
+	0x70, 0xf6, 0x05, 0x89, 0x13, 0x9a, 0x8f, 0xb5, 0xc7, 0x69, 0x8b, 0x09, 0xe2, 0x62, 0xcd, 0xfb,
+	0xf5, 0x3c, 0x3b, 0xef, 0x3c, 0xf3, 0x7a, 0x80, 0x8b, 0xfb, 0x91, 0x9b, 0xe0, 0xe1, 0x88, 0x45,
+	0x09, 0x46, 0x19, 0xa3, 0x2e, 0x43, 0x59, 0x8c, 0x8a, 0x14, 0x67, 0xcc, 0x9d, 0x74, 0x35, 0xcb,
+	0xc9, 0x0b, 0xc2, 0x88, 0xd9, 0xc6, 0xfd, 0xc8, 0xd1, 0x0b, 0x1c, 0x2d, 0x65, 0xd2, 0xdd, 0xed,
+	0x68, 0xf5, 0xec, 0x24, 0x47, 0xd4, 0x9d, 0x84, 0x09, 0x8e, 0x43, 0x46, 0x0a, 0x89, 0xb0, 0xbb,
+	0xf7, 0x4c, 0x86, 0xf8, 0x55, 0xd1, 0x56, 0x5e, 0x10, 0x32, 0xa8, 0xac, 0xf6, 0x90, 0x90, 0x61,
+	0x82, 0x5c, 0x61, 0xf5, 0xc7, 0x03, 0x37, 0x1e, 0x17, 0x21, 0xc3, 0x24, 0x53, 0x71, 0xfb, 0x6c,
+	0x9c, 0xe1, 0x14, 0x51, 0x16, 0xa6, 0x79, 0x95, 0xc0, 0xf7, 0x17, 0x91, 0x02, 0xb9, 0xf2, 0x73,
+	0xf9, 0x9e, 0xe4, 0x4a, 0x25, 0xbc, 0x31, 0x4f, 0x20, 0x69, 0x8a, 0x59, 0x5a, 0x25, 0xcd, 0x2c,
+	0x95, 0xb8, 0x3d, 0x24, 0x43, 0x22, 0x96, 0x2e, 0x5f, 0x49, 0x2f, 0xfc, 0x63, 0x1d, 0x34, 0x6f,
+	0x09, 0xbc, 0x23, 0x16, 0x32, 0x64, 0xee, 0x80, 0x46, 0x34, 0x0a, 0x71, 0x16, 0xe0, 0xd8, 0x32,
+	0x3a, 0xc6, 0xfe, 0x86, 0xbf, 0x2e, 0xec, 0x7b, 0xb1, 0x89, 0x40, 0x93, 0x15, 0x63, 0xca, 0x82,
+	0x04, 0x4d, 0x50, 0x62, 0xad, 0x76, 0x8c, 0xfd, 0x66, 0x6f, 0xdf, 0xf9, 0xe7, 0x7e, 0x3a, 0x77,
+	0x8b, 0x30, 0xe2, 0x1b, 0xf6, 0x76, 0x1f, 0x97, 0xf6, 0xca, 0xb4, 0xb4, 0xcd, 0x93, 0x30, 0x4d,
+	0x6e, 0x42, 0x0d, 0x0a, 0xfa, 0x40, 0x58, 0x1f, 0x71, 0xc3, 0x1c, 0x80, 0x2d, 0x61, 0xe1, 0x6c,
+	0x18, 0xe4, 0xa8, 0xc0, 0x24, 0xb6, 0x6a, 0x82, 0x6a, 0xc7, 0x91, 0xcd, 0x72, 0xaa, 0x66, 0x39,
+	0xb7, 0x55, 0x33, 0x3d, 0xa8, 0xb0, 0x2f, 0x69, 0xd8, 0xf3, 0x7a, 0xf8, 0xe3, 0x6f, 0xb6, 0xe1,
+	0x5f, 0xa8, 0xbc, 0x87, 0xc2, 0x69, 0x62, 0x70, 0x71, 0x9c, 0xf5, 0x49, 0x16, 0x6b, 0x44, 0xf5,
+	0x65, 0x44, 0xaf, 0x29, 0xa2, 0xcb, 0x92, 0xe8, 0x2c, 0x80, 0x64, 0xda, 0x9a, 0xb9, 0x15, 0x15,
+	0x02, 0x5b, 0x69, 0x78, 0x1c, 0x44, 0x09, 0x89, 0x1e, 0x06, 0x71, 0x81, 0x07, 0xcc, 0x7a, 0xe9,
+	0x9c, 0x5b, 0x3a, 0x53, 0x2f, 0x89, 0x36, 0xd3, 0xf0, 0xf8, 0x16, 0x77, 0xde, 0xe6, 0x3e, 0xf3,
+	0x0b, 0xb0, 0x39, 0x28, 0xc8, 0x37, 0x28, 0x0b, 0x46, 0x88, 0x1f, 0x88, 0xb5, 0x26, 0x48, 0x76,
+	0xc5, 0x11, 0x71, 0x89, 0x38, 0x4a, 0x39, 0x93, 0xae, 0x73, 0x20, 0x32, 0xbc, 0x3d, 0xc5, 0xb2,
+	0x2d, 0x59, 0x16, 0xca, 0xa1, 0xdf, 0x92, 0xb6, 0xcc, 0xe5, 0xf0, 0x49, 0xc8, 0x10, 0x65, 0x15,
+	0xfc, 0xfa, 0x79, 0xe1, 0x17, 0xca, 0xa1, 0xdf, 0x92, 0xb6, 0x82, 0xbf, 0x07, 0x9a, 0xe2, 0xea,
+	0x04, 0x34, 0x47, 0x11, 0xb5, 0x1a, 0x9d, 0xda, 0x7e, 0xb3, 0x77, 0xd1, 0xc1, 0x11, 0xed, 0xdd,
+	0x70, 0x0e, 0x79, 0xe4, 0x28, 0x47, 0x91, 0x77, 0x69, 0x2e, 0x21, 0x2d, 0x1d, 0xfa, 0x20, 0xaf,
+	0x52, 0xa8, 0x79, 0x13, 0xb4, 0xc6, 0xf9, 0xb0, 0x08, 0x63, 0x14, 0xe4, 0x21, 0x1b, 0x59, 0x1b,
+	0x9d, 0xda, 0xfe, 0x86, 0x77, 0x79, 0x5a, 0xda, 0xaf, 0xa8, 0x73, 0xd3, 0xa2, 0xd0, 0x6f, 0x2a,
+	0xf3, 0x30, 0x64, 0x23, 0x33, 0x00, 0x3b, 0x61, 0x92, 0x90, 0xaf, 0x83, 0x71, 0x1e, 0x87, 0x0c,
+	0x05, 0xe1, 0x80, 0xa1, 0x22, 0x40, 0xc7, 0x39, 0x2e, 0x4e, 0x2c, 0xd0, 0x31, 0xf6, 0x1b, 0xde,
+	0xeb, 0xd3, 0xd2, 0xee, 0x48, 0xa0, 0x17, 0xa6, 0x42, 0xff, 0x92, 0x88, 0x7d, 0x22, 0x42, 0x1f,
+	0xf0, 0xc8, 0x1d, 0x11, 0x30, 0xbf, 0x02, 0xf6, 0x73, 0xaa, 0x52, 0x4c, 0xfb, 0x68, 0x14, 0x4e,
+	0x30, 0x19, 0x17, 0x56, 0x53, 0xd0, 0xbc, 0x39, 0x2d, 0xed, 0x6b, 0x2f, 0xa4, 0xd1, 0x0b, 0xa0,
+	0xbf, 0x77, 0x96, 0xec, 0xbe, 0x16, 0xbe, 0x59, 0xff, 0xf6, 0x67, 0x7b, 0x05, 0xfe, 0xb2, 0x0a,
+	0x2e, 0xdc, 0x22, 0x19, 0x45, 0x19, 0x1d, 0x53, 0x79, 0xdb, 0x3d, 0xb0, 0x31, 0x1b, 0x38, 0x96,
+	0xa1, 0x8e, 0xf3, 0xac, 0x24, 0x3f, 0xae, 0x32, 0xbc, 0x06, 0x3f, 0xce, 0x47, 0x5c, 0x79, 0xf3,
+	0x32, 0xf3, 0x3d, 0x50, 0x2f, 0x08, 0x61, 0x6a, 0x1e, 0x40, 0x4d, 0x0d, 0xf3, 0x09, 0x34, 0xe9,
+	0x3a, 0xf7, 0x51, 0xf1, 0x30, 0x41, 0x3e, 0x21, 0xcc, 0xab, 0x73, 0x18, 0x5f, 0x54, 0x99, 0xdf,
+	0x19, 0x60, 0x3b, 0x43, 0xc7, 0x2c, 0x98, 0x4d, 0x59, 0x1a, 0x8c, 0x42, 0x3a, 0x12, 0x77, 0xbe,
+	0xe5, 0x7d, 0x3a, 0x2d, 0xed, 0x2b, 0xb2, 0x07, 0xcf, 0xcb, 0x82, 0x7f, 0x95, 0xf6, 0x3b, 0x43,
+	0xcc, 0x46, 0xe3, 0x3e, 0xa7, 0xd3, 0x67, 0xbf, 0xb6, 0x4c, 0x70, 0x9f, 0xba, 0xfd, 0x13, 0x86,
+	0xa8, 0x73, 0x80, 0x8e, 0x3d, 0xbe, 0xf0, 0x4d, 0x0e, 0xf7, 0x60, 0x86, 0x76, 0x10, 0xd2, 0x91,
+	0x6a, 0xd3, 0xf7, 0xab, 0xa0, 0xa5, 0x77, 0xcf, 0xec, 0x82, 0x0d, 0x29, 0xec, 0xd9, 0x4c, 0xf4,
+	0xb6, 0xa7, 0xa5, 0x7d, 0x51, 0x7e, 0xd6, 0x2c, 0x04, 0xfd, 0x86, 0x5c, 0xdf, 0x8b, 0xcd, 0x10,
+	0x34, 0x46, 0x28, 0x8c, 0x51, 0x11, 0x74, 0x55, 0x5f, 0xae, 0x2d, 0x9b, 0x93, 0x07, 0x22, 0xdf,
+	0x6b, 0x9f, 0x96, 0xf6, 0xba, 0x5c, 0x77, 0xa7, 0xa5, 0xbd, 0x25, 0x49, 0x2a, 0x30, 0xe8, 0xaf,
+	0xcb, 0x65, 0x57, 0xa3, 0xe8, 0x59, 0xb5, 0xff, 0x4a, 0xd1, 0x7b, 0x86, 0xa2, 0x37, 0xa3, 0xe8,
+	0xa9, 0x7e, 0xfc, 0x59, 0x03, 0x17, 0xee, 0x8a, 0x39, 0x70, 0x67, 0x82, 0x63, 0x94, 0x45, 0xc8,
+	0x3c, 0x04, 0xad, 0x05, 0xbd, 0x4a, 0xe5, 0xbc, 0xb5, 0x8c, 0x5f, 0xef, 0xaa, 0xbf, 0x80, 0xf0,
+	0xec, 0xe8, 0x5a, 0xfd, 0x5f, 0x47, 0xd7, 0xe7, 0xa0, 0xa9, 0xe2, 0x5c, 0xb7, 0x56, 0x6d, 0xa9,
+	0xd2, 0xdb, 0x8b, 0x7f, 0x56, 0x5a, 0x31, 0x14, 0xfa, 0x07, 0xd2, 0xc3, 0x0b, 0xcc, 0xf7, 0xc1,
+	0x9a, 0xec, 0x98, 0x55, 0x3f, 0xcf, 0x39, 0xf8, 0xaa, 0xca, 0xfc, 0xc1, 0x00, 0x57, 0x22, 0x92,
+	0x0d, 0x12, 0x1c, 0x89, 0x3f, 0xad, 0xa8, 0xba, 0xa3, 0x01, 0xe5, 0x97, 0x54, 0xfd, 0x55, 0x38,
+	0xcb, 0x50, 0x17, 0xaf, 0xb6, 0x77, 0x6d, 0x5a, 0xda, 0x50, 0x49, 0xf4, 0xc5, 0xe0, 0xd0, 0xdf,
+	0xd1, 0xa2, 0x8b, 0x10, 0xf0, 0xa7, 0x1a, 0x58, 0x93, 0xdf, 0x6a, 0x86, 0x60, 0x93, 0xe2, 0x61,
+	0x86, 0xe2, 0x40, 0x6d, 0x55, 0x1e, 0x79, 0x5b, 0xff, 0x06, 0xf9, 0x0a, 0x3a, 0x12, 0x69, 0x4a,
+	0x6a, 0x7b, 0x4f, 0x4a, 0xdb, 0x98, 0x9f, 0xd1, 0x02, 0x04, 0xf4, 0x5b, 0x54, 0xcb, 0xe5, 0x12,
+	0x98, 0xdd, 0xee, 0x80, 0xa2, 0x4a, 0x02, 0xcf, 0xa1, 0x98, 0x5d, 0xdb, 0x23, 0xc4, 0x3c, 0x6b,
+	0x0e, 0xbf, 0x50, 0x0e, 0xfd, 0xd6, 0x44, 0xcb, 0x33, 0xbf, 0x04, 0xf2, 0x01, 0x20, 0xf8, 0x85,
+	0xc4, 0x6a, 0x4b, 0x25, 0x76, 0x55, 0xa9, 0xe0, 0x55, 0xed, 0x59, 0x31, 0xab, 0x87, 0xfe, 0xa6,
+	0x72, 0x28, 0x91, 0x25, 0xc0, 0xac, 0x32, 0xe6, 0x63, 0xca, 0xaa, 0xff, 0xab, 0x5d, 0x5c, 0x9d,
+	0x96, 0xf6, 0xce, 0x22, 0xcb, 0x1c, 0x03, 0xfa, 0x2f, 0x2b, 0xe7, 0x83, 0xb9, 0xef, 0x43, 0xd0,
+	0xa8, 0x9e, 0x56, 0xe6, 0x1e, 0xd8, 0xc8, 0xc6, 0x29, 0x2a, 0x78, 0x44, 0x9c, 0x4c, 0xdd, 0x9f,
+	0x3b, 0xcc, 0x0e, 0x68, 0xc6, 0x28, 0x23, 0x29, 0xce, 0x44, 0x7c, 0x55, 0xc4, 0x75, 0x97, 0x17,
+	0x3c, 0x3e, 0x6d, 0x1b, 0x4f, 0x4e, 0xdb, 0xc6, 0xef, 0xa7, 0x6d, 0xe3, 0xd1, 0xd3, 0xf6, 0xca,
+	0x93, 0xa7, 0xed, 0x95, 0x5f, 0x9f, 0xb6, 0x57, 0x3e, 0xbb, 0xa3, 0x0d, 0xd7, 0x88, 0xd0, 0x94,
+	0x50, 0xfe, 0xe0, 0xbe, 0x3e, 0x24, 0xee, 0xe4, 0x86, 0x9b, 0x92, 0x78, 0x9c, 0x20, 0x2a, 0x9f,
+	0xdf, 0xd7, 0xab, 0xf7, 0xf7, 0xdb, 0xef, 0x5e, 0x3f, 0xfb, 0x40, 0xee, 0xaf, 0x89, 0x2b, 0x76,
+	0xe3, 0xef, 0x01, 0x00, 0xb4, 0x2d, 0xc0, 0x9e, 0xae, 0x0b, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -624,6 +718,83 @@ func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrozenEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictingConsensusState != nil {
+		{
+			size, err := m.ConflictingConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FrozenTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTendermint(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTendermint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Misbehaviour != nil {
+		{
+			size, err := m.Misbehaviour.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -818,6 +989,31 @@ func (m *Misbehaviour) Size() (n int) {
 	return n
 }
 
+func (m *FrozenEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Misbehaviour != nil {
+		l = m.Misbehaviour.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FrozenTime)
+	n += 1 + l + sovTendermint(uint64(l))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	if m.ConflictingConsensusState != nil {
+		l = m.ConflictingConsensusState.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1552,6 +1748,230 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FrozenEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &Misbehaviour{}
+			}
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FrozenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingConsensusState == nil {
+				m.ConflictingConsensusState = &ConsensusState{}
+			}
+			if err := m.ConflictingConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -92,6 +92,7 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 	// Header is different from existing consensus state and also valid, so freeze the client and return
 	if conflictingHeader {
 		cs.FrozenHeight = FrozenHeight
+		setFrozenEvidence(ctx, clientStore, cdc, FrozenEvidence{Header: tmHeader, ConflictingConsensusState: prevConsState})
 		return &cs, consState, nil
 	}
 	// Check that consensus state timestamps are monotonic
@@ -101,12 +102,14 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 	// if previous consensus state is not before current consensus state, freeze the client and return.
 	if prevOk && !prevCons.Timestamp.Before(consState.Timestamp) {
 		cs.FrozenHeight = FrozenHeight
+		setFrozenEvidence(ctx, clientStore, cdc, FrozenEvidence{Header: tmHeader, ConflictingConsensusState: prevCons})
 		return &cs, consState, nil
 	}
 	// if next consensus state exists, check consensus state time is less than next consensus state time
 	// if next consensus state is not after current consensus state, freeze the client and return.
 	if nextOk && !nextCons.Timestamp.After(consState.Timestamp) {
 		cs.FrozenHeight = FrozenHeight
+		setFrozenEvidence(ctx, clientStore, cdc, FrozenEvidence{Header: tmHeader, ConflictingConsensusState: nextCons})
 		return &cs, consState, nil
 	}
 
@@ -142,6 +145,63 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 	return newClientState, consensusState, nil
 }
 
+// PrecheckHeader checks the fields of the header which can be validated without verifying its
+// commit before the header is queued to update the client. It returns an error if:
+// - the header is not a tendermint header or fails basic validation
+// - the header is for a different chain than the client
+// - header height is less than or equal to the trusted height or at a different revision
+// - the trusted consensus state does not exist or does not match the trusted validators
+func (cs ClientState) PrecheckHeader(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	header exported.Header,
+) error {
+	tmHeader, ok := header.(*Header)
+	if !ok {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "expected type %T, got %T", &Header{}, header,
+		)
+	}
+
+	if err := tmHeader.ValidateBasic(); err != nil {
+		return err
+	}
+
+	chainID := cs.GetChainID()
+	if clienttypes.IsRevisionFormat(chainID) {
+		chainID, _ = clienttypes.SetRevisionNumber(chainID, header.GetHeight().GetRevisionNumber())
+	}
+
+	if tmHeader.Header.ChainID != chainID {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "header chain ID %s does not match client chain ID %s", tmHeader.Header.ChainID, chainID,
+		)
+	}
+
+	if header.GetHeight().GetRevisionNumber() != tmHeader.TrustedHeight.RevisionNumber {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeaderHeight,
+			"header height revision %d does not match trusted header revision %d",
+			header.GetHeight().GetRevisionNumber(), tmHeader.TrustedHeight.RevisionNumber,
+		)
+	}
+
+	if header.GetHeight().LTE(tmHeader.TrustedHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header height ≤ consensus state height (%s ≤ %s)", header.GetHeight(), tmHeader.TrustedHeight,
+		)
+	}
+
+	trustedConsState, err := GetConsensusState(clientStore, cdc, tmHeader.TrustedHeight)
+	if err != nil {
+		return sdkerrors.Wrapf(
+			err, "could not get consensus state from clientstore at TrustedHeight: %s", tmHeader.TrustedHeight,
+		)
+	}
+
+	return checkTrustedHeader(tmHeader, trustedConsState)
+}
+
 // checkTrustedHeader checks that consensus state matches trusted fields of Header
 func checkTrustedHeader(header *Header, consState *ConsensusState) error {
 	tmTrustedValidators, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
@@ -454,3 +454,60 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	consKey = types.GetIterationKey(clientStore, expiredHeight)
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
 }
+
+func (suite *TendermintTestSuite) TestPrecheckHeader() {
+	var header *types.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"header chain ID does not match client chain ID", func() {
+				header.Header.ChainID = "otherchain"
+			}, false,
+		},
+		{
+			"trusted consensus state does not exist", func() {
+				header.TrustedHeight = header.TrustedHeight.Increment().(clienttypes.Height)
+			}, false,
+		},
+		{
+			"header height is not greater than trusted height", func() {
+				header.TrustedHeight = header.GetHeight().(clienttypes.Height)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			var err error
+			header, err = suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientState := path.EndpointA.GetClientState().(*types.ClientState)
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			err = clientState.PrecheckHeader(suite.chainA.GetContext(), suite.chainA.Codec, clientStore, header)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
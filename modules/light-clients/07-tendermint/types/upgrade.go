@@ -154,3 +154,87 @@ func constructUpgradeConsStateMerklePath(upgradePath []string, lastHeight export
 	consPath = append(consPath, appendedKey)
 	return commitmenttypes.NewMerklePath(consPath...)
 }
+
+// VerifyUpgradePlan verifies proofs of the upgrade plan scheduled by the counterparty chain and
+// of the upgraded client committed to for the plan, against the consensus state of the provided
+// height. The plan is stored under the plan key of the upgrade store and the upgraded client
+// under the upgrade path of the client at the plan height, which is where
+// VerifyUpgradeAndUpdateState verifies it once the counterparty chain halted for the upgrade.
+// Chains may use it to prepare a MsgUpgradeClient ahead of the upgrade of the counterparty.
+func (cs ClientState) VerifyUpgradePlan(
+	clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height,
+	plan upgradetypes.Plan, upgradedClient exported.ClientState,
+	proofPlan, proofUpgradedClient []byte,
+) error {
+	if len(cs.UpgradePath) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot verify upgrade plan, no upgrade path set")
+	}
+
+	if err := plan.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, err.Error())
+	}
+
+	if cs.GetLatestHeight().LT(height) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if _, ok := upgradedClient.(*ClientState); !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be Tendermint client. expected: %T got: %T",
+			&ClientState{}, upgradedClient)
+	}
+
+	if !upgradedClient.GetLatestHeight().GT(cs.GetLatestHeight()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "upgraded client height %s must be at greater than current client height %s",
+			upgradedClient.GetLatestHeight(), cs.GetLatestHeight())
+	}
+
+	// unmarshal proofs
+	var merkleProofPlan, merkleProofClient commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proofPlan, &merkleProofPlan); err != nil {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "could not unmarshal plan merkle proof: %v", err)
+	}
+	if err := cdc.Unmarshal(proofUpgradedClient, &merkleProofClient); err != nil {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "could not unmarshal client merkle proof: %v", err)
+	}
+
+	consState, err := GetConsensusState(clientStore, cdc, height)
+	if err != nil {
+		return sdkerrors.Wrap(err, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	// Verify plan proof
+	bz, err := cdc.Marshal(&plan)
+	if err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidUpgradeClient, "could not marshal upgrade plan: %v", err)
+	}
+	upgradePlanPath := constructUpgradePlanMerklePath(cs.UpgradePath)
+	if err := merkleProofPlan.VerifyMembership(cs.ProofSpecs, consState.GetRoot(), upgradePlanPath, bz); err != nil {
+		return sdkerrors.Wrapf(err, "upgrade plan proof failed. Path: %s", upgradePlanPath.Pretty())
+	}
+
+	// Verify client proof
+	bz, err = cdc.MarshalInterface(upgradedClient)
+	if err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "could not marshal client state: %v", err)
+	}
+	upgradeClientPath := constructUpgradeClientMerklePath(cs.UpgradePath, clienttypes.NewHeight(0, uint64(plan.Height)))
+	if err := merkleProofClient.VerifyMembership(cs.ProofSpecs, consState.GetRoot(), upgradeClientPath, bz); err != nil {
+		return sdkerrors.Wrapf(err, "client state proof failed. Path: %s", upgradeClientPath.Pretty())
+	}
+
+	return nil
+}
+
+// construct MerklePath for the upgrade plan from upgradePath. The first element of upgradePath
+// is the store key of the upgrade store, which stores the plan under its plan key.
+func constructUpgradePlanMerklePath(upgradePath []string) commitmenttypes.MerklePath {
+	// copy all elements from upgradePath except final element
+	planPath := make([]string, len(upgradePath)-1)
+	copy(planPath, upgradePath)
+
+	planPath = append(planPath, string(upgradetypes.PlanKey()))
+	return commitmenttypes.NewMerklePath(planPath...)
+}
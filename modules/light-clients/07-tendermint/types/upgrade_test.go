@@ -6,6 +6,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -480,3 +481,111 @@ func (suite *TendermintTestSuite) TestVerifyUpgrade() {
 		}
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyUpgradePlan() {
+	var (
+		plan                           upgradetypes.Plan
+		upgradedClient                 exported.ClientState
+		path                           *ibctesting.Path
+		proofPlan, proofUpgradedClient []byte
+		proofHeight                    exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"plan does not match the scheduled plan", func() {
+				plan.Name = "other upgrade"
+			}, false,
+		},
+		{
+			"plan height does not match the upgraded client height", func() {
+				plan.Height++
+			}, false,
+		},
+		{
+			"upgraded client does not match the committed client", func() {
+				upgradedClient = types.NewClientState("otherChainId-1", types.DefaultTrustLevel, trustingPeriod, ubdPeriod+trustingPeriod, maxClockDrift, newClientHeight, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+				upgradedClient = upgradedClient.ZeroCustomFields()
+			}, false,
+		},
+		{
+			"upgraded client height is not greater than the current client height", func() {
+				upgradedClient = types.NewClientState(newChainId, types.DefaultTrustLevel, trustingPeriod, ubdPeriod+trustingPeriod, maxClockDrift, clienttypes.NewHeight(0, 1), commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+			}, false,
+		},
+		{
+			"upgraded client is not a tendermint client", func() {
+				upgradedClient = &solomachinetypes.ClientState{}
+			}, false,
+		},
+		{
+			"invalid plan proof", func() {
+				proofPlan = proofUpgradedClient
+			}, false,
+		},
+		{
+			"proof height is greater than the client height", func() {
+				proofHeight = proofHeight.Increment()
+			}, false,
+		},
+		{
+			"consensus state not found at proof height", func() {
+				proofHeight = clienttypes.NewHeight(0, 1)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			upgradedClient = types.NewClientState(newChainId, types.DefaultTrustLevel, trustingPeriod, ubdPeriod+trustingPeriod, maxClockDrift, newClientHeight, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+			upgradedClient = upgradedClient.ZeroCustomFields()
+			upgradedClientBz, err := clienttypes.MarshalClientState(suite.chainA.App.AppCodec(), upgradedClient)
+			suite.Require().NoError(err)
+
+			// schedule the upgrade plan on chainB and commit to the upgraded client
+			plan = upgradetypes.Plan{
+				Name:   "upgrade IBC clients",
+				Height: suite.chainB.GetContext().BlockHeight() + 100,
+			}
+			err = suite.chainB.GetSimApp().UpgradeKeeper.ScheduleUpgrade(suite.chainB.GetContext(), plan)
+			suite.Require().NoError(err)
+			err = suite.chainB.GetSimApp().UpgradeKeeper.SetUpgradedClient(suite.chainB.GetContext(), plan.Height, upgradedClientBz)
+			suite.Require().NoError(err)
+
+			// commit upgrade store changes and update clients
+			suite.coordinator.CommitBlock(suite.chainB)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			cs := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			proofHeight = cs.GetLatestHeight()
+
+			proofPlan, _ = suite.chainB.QueryUpgradeProof(upgradetypes.PlanKey(), proofHeight.GetRevisionHeight())
+			proofUpgradedClient, _ = suite.chainB.QueryUpgradeProof(upgradetypes.UpgradedClientKey(plan.Height), proofHeight.GetRevisionHeight())
+
+			tc.malleate()
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			err = cs.(*types.ClientState).VerifyUpgradePlan(clientStore, suite.cdc, proofHeight, plan, upgradedClient, proofPlan, proofUpgradedClient)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
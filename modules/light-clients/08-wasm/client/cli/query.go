@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// GetCmdQueryWasmCode defines the command to query the code of a stored contract.
+func GetCmdQueryWasmCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code [code-id]",
+		Short:   "Query the wasm code of a light client contract",
+		Long:    "Query the wasm code of a light client contract by its hex encoded code id",
+		Example: fmt.Sprintf("%s query ibc-wasm code [code-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWasmCodeRequest{
+				CodeId: args[0],
+			}
+
+			res, err := queryClient.WasmCode(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCodeIds defines the command to query the code ids of all stored contracts.
+func GetCmdQueryCodeIds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code-ids",
+		Short:   "Query the code ids of all light client contracts",
+		Long:    "Query the hex encoded code ids of all light client contracts",
+		Example: fmt.Sprintf("%s query ibc-wasm code-ids", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCodeIdsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CodeIds(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "code ids")
+	return cmd
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/client/cli"
+)
+
+// PushNewWasmCodeProposalHandler is the push new wasm code proposal handler
+var PushNewWasmCodeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitPushNewWasmCodeProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-wasm",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC wasm proposals")
+		},
+	}
+}
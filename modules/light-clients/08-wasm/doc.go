@@ -0,0 +1,6 @@
+/*
+Package wasm implements a light client whose verification logic is executed by a
+Wasm contract. The contract code is stored through governance, which allows new
+counterparty client types to be added without a coordinated binary upgrade.
+*/
+package wasm
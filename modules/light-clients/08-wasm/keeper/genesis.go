@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// InitGenesis initializes the 08-wasm state. The contract code is compiled and stored
+// in the Wasm VM, as the VM cache of a new node does not contain it.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, code := range state.Codes {
+		codeID := types.CodeID(code)
+		if err := k.createCode(code, codeID); err != nil {
+			panic(err)
+		}
+
+		k.SetWasmCode(ctx, codeID, code)
+	}
+}
+
+// ExportGenesis exports the 08-wasm module's contract code into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	codes := [][]byte{}
+	k.IterateWasmCodes(ctx, func(_, code []byte) bool {
+		codes = append(codes, code)
+		return false
+	})
+
+	return &types.GenesisState{
+		Codes: codes,
+	}
+}
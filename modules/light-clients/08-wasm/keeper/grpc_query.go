@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/pagination"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// WasmCode implements the Query/WasmCode gRPC method
+func (q Keeper) WasmCode(c context.Context, req *types.QueryWasmCodeRequest) (*types.QueryWasmCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	codeID, err := hex.DecodeString(req.CodeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrInvalidCodeID, err.Error()).Error())
+	}
+
+	if err := types.ValidateCodeID(codeID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	code, found := q.GetWasmCode(ctx, codeID)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrapf(types.ErrWasmCodeNotFound, "code id %X", codeID).Error())
+	}
+
+	return &types.QueryWasmCodeResponse{
+		Code: code,
+	}, nil
+}
+
+// CodeIds implements the Query/CodeIds gRPC method
+func (q Keeper) CodeIds(c context.Context, req *types.QueryCodeIdsRequest) (*types.QueryCodeIdsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	codeIDs := []string{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKeyPrefix)
+
+	pageRes, err := pagination.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		codeIDs = append(codeIDs, fmt.Sprintf("%X", key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCodeIdsResponse{
+		CodeIds:    codeIDs,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// Keeper defines the 08-wasm keeper which stores the contract code of wasm light
+// clients.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	vm types.WasmEngine
+}
+
+// NewKeeper creates a new 08-wasm Keeper instance. The Wasm VM is used by all wasm
+// clients to execute their contracts, see DecorateClientStore.
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, vm types.WasmEngine) Keeper {
+	if vm == nil {
+		panic("the 08-wasm keeper requires a wasm vm")
+	}
+
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+		vm:       vm,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// DecorateClientStore attaches the Wasm VM of the keeper to the client store of a wasm
+// client. It must be set as the client store decorator of the wasm client type in the
+// IBC client keeper.
+func (k Keeper) DecorateClientStore(clientStore sdk.KVStore) sdk.KVStore {
+	return types.NewVMStore(clientStore, k.vm)
+}
+
+// LoadWasmCodes compiles and stores all contract code which is missing from the Wasm VM
+// cache. It must be called when the application starts, as the cache of the VM is only
+// filled when code is pushed or the genesis state is initialized.
+func (k Keeper) LoadWasmCodes(ctx sdk.Context) error {
+	var err error
+	k.IterateWasmCodes(ctx, func(codeID, code []byte) bool {
+		if _, getErr := k.vm.GetCode(codeID); getErr == nil {
+			return false
+		}
+
+		err = k.createCode(code, codeID)
+		return err != nil
+	})
+
+	return err
+}
+
+// PushNewWasmCode compiles and stores the given contract code in the Wasm VM and
+// stores the code under its code id. It returns the code id of the contract.
+func (k Keeper) PushNewWasmCode(ctx sdk.Context, code []byte) ([]byte, error) {
+	if err := types.ValidateWasmCode(code); err != nil {
+		return nil, err
+	}
+
+	codeID := types.CodeID(code)
+	if k.HasWasmCode(ctx, codeID) {
+		return nil, sdkerrors.Wrapf(types.ErrWasmCodeExists, "code id %X", codeID)
+	}
+
+	if err := k.createCode(code, codeID); err != nil {
+		return nil, err
+	}
+
+	k.SetWasmCode(ctx, codeID, code)
+
+	k.Logger(ctx).Info("wasm code stored", "code-id", fmt.Sprintf("%X", codeID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePushWasmCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%X", codeID)),
+		),
+	)
+
+	return codeID, nil
+}
+
+// createCode compiles and stores the contract code in the Wasm VM, checking that the
+// VM stored it under the expected code id.
+func (k Keeper) createCode(code, codeID []byte) error {
+	vmCodeID, err := k.vm.Create(code)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidCode, err.Error())
+	}
+
+	if !bytes.Equal(codeID, vmCodeID) {
+		return sdkerrors.Wrapf(types.ErrInvalidCodeID, "expected code id %X, got %X", codeID, vmCodeID)
+	}
+
+	return nil
+}
+
+// GetWasmCode returns the contract code stored under the given code id.
+func (k Keeper) GetWasmCode(ctx sdk.Context, codeID []byte) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	code := store.Get(types.KeyCode(codeID))
+	if code == nil {
+		return nil, false
+	}
+
+	return code, true
+}
+
+// HasWasmCode returns true if contract code is stored under the given code id.
+func (k Keeper) HasWasmCode(ctx sdk.Context, codeID []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyCode(codeID))
+}
+
+// SetWasmCode stores the contract code under the given code id.
+func (k Keeper) SetWasmCode(ctx sdk.Context, codeID, code []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyCode(codeID), code)
+}
+
+// IterateWasmCodes iterates over all contract code, calling the provided callback on
+// each code id and code until stop=true is returned.
+func (k Keeper) IterateWasmCodes(ctx sdk.Context, cb func(codeID, code []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key(), iterator.Value()) {
+			break
+		}
+	}
+}
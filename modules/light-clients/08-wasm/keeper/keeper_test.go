@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibctestingmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var wasmCode = []byte("mock light client contract")
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+
+	engine      *ibctestingmock.WasmEngine
+	keeper      keeper.Keeper
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	app := suite.chainA.GetSimApp()
+	suite.engine = ibctestingmock.NewWasmEngine()
+	suite.keeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), suite.engine)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestPushNewWasmCode() {
+	ctx := suite.chainA.GetContext()
+
+	codeID, err := suite.keeper.PushNewWasmCode(ctx, wasmCode)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CodeID(wasmCode), codeID)
+
+	code, found := suite.keeper.GetWasmCode(ctx, codeID)
+	suite.Require().True(found)
+	suite.Require().Equal(wasmCode, code)
+
+	// the code is compiled by the vm
+	code, err = suite.engine.GetCode(codeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmCode, code)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypePushWasmCode, events[len(events)-1].Type)
+
+	// the same code cannot be pushed twice
+	_, err = suite.keeper.PushNewWasmCode(ctx, wasmCode)
+	suite.Require().ErrorIs(err, types.ErrWasmCodeExists)
+
+	_, err = suite.keeper.PushNewWasmCode(ctx, nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidCode)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+	otherCode := []byte("other contract")
+
+	genesis := types.NewGenesisState([][]byte{wasmCode, otherCode})
+	suite.keeper.InitGenesis(ctx, *genesis)
+
+	for _, code := range genesis.Codes {
+		suite.Require().True(suite.keeper.HasWasmCode(ctx, types.CodeID(code)))
+
+		_, err := suite.engine.GetCode(types.CodeID(code))
+		suite.Require().NoError(err)
+	}
+
+	exported := suite.keeper.ExportGenesis(ctx)
+	suite.Require().ElementsMatch(genesis.Codes, exported.Codes)
+}
+
+func (suite *KeeperTestSuite) TestLoadWasmCodes() {
+	ctx := suite.chainA.GetContext()
+
+	codeID, err := suite.keeper.PushNewWasmCode(ctx, wasmCode)
+	suite.Require().NoError(err)
+
+	// a restarted node uses a vm without the stored code
+	suite.engine = ibctestingmock.NewWasmEngine()
+	suite.keeper = keeper.NewKeeper(suite.chainA.App.AppCodec(), suite.chainA.GetSimApp().GetKey(types.StoreKey), suite.engine)
+
+	_, err = suite.engine.GetCode(codeID)
+	suite.Require().Error(err)
+
+	err = suite.keeper.LoadWasmCodes(ctx)
+	suite.Require().NoError(err)
+
+	code, err := suite.engine.GetCode(codeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmCode, code)
+}
+
+func (suite *KeeperTestSuite) TestDecorateClientStore() {
+	clientStore := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+
+	_, err := types.GetVM(clientStore)
+	suite.Require().ErrorIs(err, types.ErrVMNotSet)
+
+	vm, err := types.GetVM(suite.keeper.DecorateClientStore(clientStore))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.engine, vm)
+}
+
+func (suite *KeeperTestSuite) TestQueryWasmCode() {
+	codeID, err := suite.keeper.PushNewWasmCode(suite.chainA.GetContext(), wasmCode)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.WasmCode(suite.chainA.GetContext().Context(), &types.QueryWasmCodeRequest{CodeId: fmt.Sprintf("%X", codeID)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmCode, res.Code)
+
+	_, err = suite.queryClient.WasmCode(suite.chainA.GetContext().Context(), &types.QueryWasmCodeRequest{CodeId: fmt.Sprintf("%X", types.CodeID([]byte("unknown")))})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.WasmCode(suite.chainA.GetContext().Context(), &types.QueryWasmCodeRequest{CodeId: "invalid"})
+	suite.Require().Error(err)
+
+	idsRes, err := suite.queryClient.CodeIds(suite.chainA.GetContext().Context(), &types.QueryCodeIdsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{fmt.Sprintf("%X", codeID)}, idsRes.CodeIds)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+const (
+	// SupportedFeatures are the capabilities required by contracts which the Wasm VM
+	// supports.
+	SupportedFeatures = "iterator"
+
+	// ContractMemoryLimit is the memory limit in MiB of each contract execution.
+	ContractMemoryLimit uint32 = 32
+
+	// costHumanizeAddress is the VM gas cost of converting an address to bech32.
+	costHumanizeAddress = 5 * types.GasMultiplier
+	// costCanonicalizeAddress is the VM gas cost of converting a bech32 address to bytes.
+	costCanonicalizeAddress = 4 * types.GasMultiplier
+)
+
+// deserializationCost is the VM gas cost per byte of deserializing contract responses.
+var deserializationCost = wasmvmtypes.UFraction{Numerator: types.GasMultiplier, Denominator: 1}
+
+var _ types.WasmEngine = (*wasmEngine)(nil)
+
+// wasmEngine executes the contracts of wasm clients with the CosmWasm VM.
+type wasmEngine struct {
+	vm *wasmvm.VM
+}
+
+// NewWasmEngine creates a CosmWasm VM which stores the compiled contracts in the given
+// directory and caches up to memoryCacheSize MiB of them in memory.
+func NewWasmEngine(dataDir string, memoryCacheSize uint32) (types.WasmEngine, error) {
+	vm, err := wasmvm.NewVM(dataDir, SupportedFeatures, ContractMemoryLimit, false, memoryCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wasmEngine{vm: vm}, nil
+}
+
+// Create implements the WasmEngine interface.
+func (e *wasmEngine) Create(code []byte) ([]byte, error) {
+	return e.vm.Create(code)
+}
+
+// GetCode implements the WasmEngine interface.
+func (e *wasmEngine) GetCode(codeID []byte) ([]byte, error) {
+	return e.vm.GetCode(codeID)
+}
+
+// Execute implements the WasmEngine interface.
+func (e *wasmEngine) Execute(
+	codeID []byte,
+	env wasmvmtypes.Env,
+	info wasmvmtypes.MessageInfo,
+	msg []byte,
+	store sdk.KVStore,
+	gasMeter sdk.GasMeter,
+	gasLimit uint64,
+) (*wasmvmtypes.Response, uint64, error) {
+	return e.vm.Execute(codeID, env, info, msg, store, goAPI, noQuerier{gasMeter}, gasMeter, gasLimit, deserializationCost)
+}
+
+// Query implements the WasmEngine interface.
+func (e *wasmEngine) Query(
+	codeID []byte,
+	env wasmvmtypes.Env,
+	msg []byte,
+	store sdk.KVStore,
+	gasMeter sdk.GasMeter,
+	gasLimit uint64,
+) ([]byte, uint64, error) {
+	return e.vm.Query(codeID, env, msg, store, goAPI, noQuerier{gasMeter}, gasMeter, gasLimit, deserializationCost)
+}
+
+// goAPI converts between the canonical and bech32 representations of account addresses
+// for contracts.
+var goAPI = wasmvm.GoAPI{
+	HumanAddress: func(canonical []byte) (string, uint64, error) {
+		if err := sdk.VerifyAddressFormat(canonical); err != nil {
+			return "", costHumanizeAddress, err
+		}
+
+		return sdk.AccAddress(canonical).String(), costHumanizeAddress, nil
+	},
+	CanonicalAddress: func(human string) ([]byte, uint64, error) {
+		addr, err := sdk.AccAddressFromBech32(human)
+		return addr, costCanonicalizeAddress, err
+	},
+}
+
+// noQuerier rejects the chain queries of contracts, light clients only verify the state
+// of their counterparty.
+type noQuerier struct {
+	gasMeter sdk.GasMeter
+}
+
+// Query implements the wasmvm Querier interface.
+func (q noQuerier) Query(request wasmvmtypes.QueryRequest, _ uint64) ([]byte, error) {
+	return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("%s does not support chain queries", types.ModuleName)}
+}
+
+// GasConsumed implements the wasmvm Querier interface.
+func (q noQuerier) GasConsumed() uint64 {
+	return q.gasMeter.GasConsumed()
+}
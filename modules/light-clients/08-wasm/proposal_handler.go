@@ -0,0 +1,24 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// NewWasmProposalHandler defines the 08-wasm proposal handler
+func NewWasmProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PushNewWasmCodeProposal:
+			_, err := k.PushNewWasmCode(ctx, c.Code)
+			return err
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized 08-wasm proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,566 @@
+package types
+
+import (
+	"bytes"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(data, codeID []byte, latestHeight clienttypes.Height) *ClientState {
+	return &ClientState{
+		Data:         data,
+		CodeId:       codeID,
+		LatestHeight: latestHeight,
+	}
+}
+
+// ClientType returns Wasm.
+func (cs ClientState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetLatestHeight returns the latest height the client was updated to.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return cs.LatestHeight
+}
+
+// Validate performs a basic validation of the client state fields. The contract data
+// is validated by the contract when the client is initialized.
+func (cs ClientState) Validate() error {
+	if len(cs.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+	if err := ValidateCodeID(cs.CodeId); err != nil {
+		return err
+	}
+	if cs.LatestHeight.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "latest height cannot be zero")
+	}
+
+	return nil
+}
+
+// Status returns the status of the client as reported by the contract. The Unknown
+// status is returned if the contract call fails.
+func (cs ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+	var result StatusResult
+	msg := QueryMsg{Status: &StatusMsg{ClientState: &cs}}
+	if err := queryContract(contractEnv(ctx), ctx.GasMeter(), clientStore, cs.CodeId, msg, &result); err != nil {
+		return exported.Unknown
+	}
+
+	return result.Status
+}
+
+// ZeroCustomFields returns the client state committed to for upgrades. The contract
+// data is opaque to the chain, it is therefore committed to in full.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return &ClientState{
+		Data:         cs.Data,
+		CodeId:       cs.CodeId,
+		LatestHeight: cs.LatestHeight,
+	}
+}
+
+// Initialize checks that the initial consensus state is a wasm consensus state of the
+// same contract and lets the contract validate it and initialize the client store.
+func (cs ClientState) Initialize(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	if !bytes.Equal(cs.CodeId, consensusState.CodeId) {
+		return sdkerrors.Wrapf(ErrInvalidCodeID, "consensus state code id %X does not match client state code id %X",
+			consensusState.CodeId, cs.CodeId)
+	}
+
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return err
+	}
+
+	if _, err := engine.GetCode(cs.CodeId); err != nil {
+		return sdkerrors.Wrapf(ErrWasmCodeNotFound, "code id %X: %s", cs.CodeId, err)
+	}
+
+	msg := ExecuteMsg{Initialize: &InitializeMsg{ClientState: &cs, ConsensusState: consensusState}}
+	_, err = executeContract(ctx, clientStore, cs.CodeId, msg)
+	return err
+}
+
+// ExportMetadata returns the client metadata reported by the contract. It panics if
+// the contract call fails, as the exported genesis would otherwise be incomplete.
+func (cs ClientState) ExportMetadata(clientStore sdk.KVStore) []exported.GenesisMetadata {
+	var result ExportMetadataResult
+	msg := QueryMsg{ExportMetadata: &ExportMetadataMsg{ClientState: &cs}}
+	if err := queryContract(wasmvmtypes.Env{}, sdk.NewInfiniteGasMeter(), clientStore, cs.CodeId, msg, &result); err != nil {
+		panic(err)
+	}
+
+	if len(result.GenesisMetadata) == 0 {
+		return nil
+	}
+
+	genesisMetadata := make([]exported.GenesisMetadata, len(result.GenesisMetadata))
+	for i, metadata := range result.GenesisMetadata {
+		genesisMetadata[i] = metadata
+	}
+
+	return genesisMetadata
+}
+
+// CheckHeaderAndUpdateState lets the contract verify the header and returns the client
+// state and the consensus state updated by the contract. The returned client state of
+// a header constituting misbehaviour is frozen by the contract.
+func (cs ClientState) CheckHeaderAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	wasmHeader, ok := header.(*Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "expected type %T, got %T", &Header{}, header,
+		)
+	}
+
+	msg := ExecuteMsg{CheckHeaderAndUpdateState: &CheckHeaderAndUpdateStateMsg{ClientState: &cs, Header: wasmHeader}}
+	result, err := executeContract(ctx, clientStore, cs.CodeId, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := cs.validateNewClientState(result.NewClientState); err != nil {
+		return nil, nil, err
+	}
+	if err := cs.validateNewConsensusState(result.NewConsensusState); err != nil {
+		return nil, nil, err
+	}
+
+	return result.NewClientState, result.NewConsensusState, nil
+}
+
+// CheckMisbehaviourAndUpdateState lets the contract verify the misbehaviour and returns
+// the client state frozen by the contract.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+	wasmMisbehaviour, ok := misbehaviour.(*Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour, "expected type %T, got %T", &Misbehaviour{}, misbehaviour,
+		)
+	}
+
+	msg := ExecuteMsg{CheckMisbehaviourAndUpdateState: &CheckMisbehaviourAndUpdateStateMsg{ClientState: &cs, Misbehaviour: wasmMisbehaviour}}
+	result, err := executeContract(ctx, clientStore, cs.CodeId, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.validateNewClientState(result.NewClientState); err != nil {
+		return nil, err
+	}
+
+	return result.NewClientState, nil
+}
+
+// CheckSubstituteAndUpdateState copies the latest consensus state of the substitute
+// client of the same contract to the subject client store and returns the subject
+// client state recovered by the contract.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) (exported.ClientState, error) {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient,
+		)
+	}
+
+	if !bytes.Equal(cs.CodeId, substituteClientState.CodeId) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject and substitute clients are not implemented by the same contract")
+	}
+
+	height := substituteClientState.GetLatestHeight()
+	consensusState, err := GetConsensusState(substituteClientStore, cdc, height)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	SetConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	msg := ExecuteMsg{CheckSubstituteAndUpdateState: &CheckSubstituteAndUpdateStateMsg{
+		ClientState:              &cs,
+		SubstituteClientState:    substituteClientState,
+		SubstituteConsensusState: consensusState,
+	}}
+	result, err := executeContract(ctx, subjectClientStore, cs.CodeId, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.validateNewClientState(result.NewClientState); err != nil {
+		return nil, err
+	}
+
+	return result.NewClientState, nil
+}
+
+// VerifyUpgradeAndUpdateState lets the contract verify the proofs of the upgraded
+// client and consensus states and returns the client and consensus states upgraded by
+// the contract. The upgraded client may be implemented by a different contract.
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore,
+	upgradedClient exported.ClientState, upgradedConsState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte,
+) (exported.ClientState, exported.ConsensusState, error) {
+	wasmUpgradedClient, ok := upgradedClient.(*ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be wasm client. expected: %T got: %T",
+			&ClientState{}, upgradedClient)
+	}
+
+	wasmUpgradedConsState, ok := upgradedConsState.(*ConsensusState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "upgraded consensus state must be wasm consensus state. expected %T, got: %T",
+			&ConsensusState{}, upgradedConsState)
+	}
+
+	msg := ExecuteMsg{VerifyUpgradeAndUpdateState: &VerifyUpgradeAndUpdateStateMsg{
+		ClientState:                &cs,
+		UpgradeClientState:         wasmUpgradedClient,
+		UpgradeConsensusState:      wasmUpgradedConsState,
+		ProofUpgradeClient:         proofUpgradeClient,
+		ProofUpgradeConsensusState: proofUpgradeConsState,
+	}}
+	result, err := executeContract(ctx, clientStore, cs.CodeId, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if result.NewClientState == nil {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractReply, "new client state cannot be empty")
+	}
+	if err := result.NewClientState.Validate(); err != nil {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+	if result.NewConsensusState == nil {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractReply, "new consensus state cannot be empty")
+	}
+	if err := result.NewConsensusState.ValidateBasic(); err != nil {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+
+	return result.NewClientState, result.NewConsensusState, nil
+}
+
+// VerifyClientState verifies a proof of the client state of the running chain
+// stored on the target machine
+func (cs ClientState) VerifyClientState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	counterpartyClientIdentifier string,
+	proof []byte,
+	clientState exported.ClientState,
+) error {
+	if clientState == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "client state cannot be empty")
+	}
+
+	bz, err := cdc.MarshalInterface(clientState)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifyMembership(
+		wasmvmtypes.Env{}, sdk.NewInfiniteGasMeter(), store, height, 0, 0, prefix, proof,
+		host.FullClientStatePath(counterpartyClientIdentifier), bz,
+	)
+}
+
+// VerifyClientConsensusState verifies a proof of the consensus state of the
+// running chain stored on the target machine.
+func (cs ClientState) VerifyClientConsensusState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	counterpartyClientIdentifier string,
+	consensusHeight exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	consensusState exported.ConsensusState,
+) error {
+	if consensusState == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
+	}
+
+	bz, err := cdc.MarshalInterface(consensusState)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifyMembership(
+		wasmvmtypes.Env{}, sdk.NewInfiniteGasMeter(), store, height, 0, 0, prefix, proof,
+		host.FullConsensusStatePath(counterpartyClientIdentifier, consensusHeight), bz,
+	)
+}
+
+// VerifyConnectionState verifies a proof of the connection state of the
+// specified connection end stored on the target machine.
+func (cs ClientState) VerifyConnectionState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	connectionID string,
+	connectionEnd exported.ConnectionI,
+) error {
+	connection, ok := connectionEnd.(connectiontypes.ConnectionEnd)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
+	}
+
+	bz, err := cdc.Marshal(&connection)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifyMembership(
+		wasmvmtypes.Env{}, sdk.NewInfiniteGasMeter(), store, height, 0, 0, prefix, proof,
+		host.ConnectionPath(connectionID), bz,
+	)
+}
+
+// VerifyChannelState verifies a proof of the channel state of the specified
+// channel end, under the specified port, stored on the target machine.
+func (cs ClientState) VerifyChannelState(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	channel exported.ChannelI,
+) error {
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	bz, err := cdc.Marshal(&channelEnd)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifyMembership(
+		wasmvmtypes.Env{}, sdk.NewInfiniteGasMeter(), store, height, 0, 0, prefix, proof,
+		host.ChannelPath(portID, channelID), bz,
+	)
+}
+
+// VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
+// the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketCommitment(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	return cs.verifyMembership(
+		contractEnv(ctx), ctx.GasMeter(), store, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+		host.PacketCommitmentPath(portID, channelID, sequence), commitmentBytes,
+	)
+}
+
+// VerifyPacketAcknowledgement verifies a proof of an incoming packet
+// acknowledgement at the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketAcknowledgement(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	return cs.verifyMembership(
+		contractEnv(ctx), ctx.GasMeter(), store, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+		host.PacketAcknowledgementPath(portID, channelID, sequence), channeltypes.CommitAcknowledgement(acknowledgement),
+	)
+}
+
+// VerifyPacketReceiptAbsence verifies a proof of the absence of an
+// incoming packet receipt at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	return cs.verifyMembership(
+		contractEnv(ctx), ctx.GasMeter(), store, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+		host.PacketReceiptPath(portID, channelID, sequence), nil,
+	)
+}
+
+// VerifyNextSequenceRecv verifies a proof of the next sequence number to be
+// received of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceRecv(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	return cs.verifyMembership(
+		contractEnv(ctx), ctx.GasMeter(), store, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+		host.NextSequenceRecvPath(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv),
+	)
+}
+
+// verifyMembership performs the basic checks on the arguments that are shared between
+// the verification functions and lets the contract verify the proof of the value
+// stored under the prefixed path. The absence of the path is verified if the value is
+// nil. The contract is responsible for checking that the delay periods have passed.
+func (cs ClientState) verifyMembership(
+	env wasmvmtypes.Env,
+	gasMeter sdk.GasMeter,
+	store sdk.KVStore,
+	height exported.Height,
+	delayTimePeriod, delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	path string,
+	value []byte,
+) error {
+	if cs.GetLatestHeight().LT(height) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if prefix == nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+	}
+
+	if _, ok := prefix.(*commitmenttypes.MerklePrefix); !ok {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected *MerklePrefix", prefix)
+	}
+
+	if len(proof) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return err
+	}
+
+	proofHeight := clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+
+	var (
+		msg    QueryMsg
+		result EmptyResult
+	)
+	if value == nil {
+		msg.VerifyNonMembership = &VerifyNonMembershipMsg{
+			ClientState:      &cs,
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Path:             merklePath,
+		}
+	} else {
+		msg.VerifyMembership = &VerifyMembershipMsg{
+			ClientState:      &cs,
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			Path:             merklePath,
+			Value:            value,
+		}
+	}
+
+	return queryContract(env, gasMeter, store, cs.CodeId, msg, &result)
+}
+
+// validateNewClientState checks that the client state returned by the contract is a
+// valid client state of the same contract.
+func (cs ClientState) validateNewClientState(newClientState *ClientState) error {
+	if newClientState == nil {
+		return sdkerrors.Wrap(ErrInvalidContractReply, "new client state cannot be empty")
+	}
+	if err := newClientState.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+	if !bytes.Equal(cs.CodeId, newClientState.CodeId) {
+		return sdkerrors.Wrap(ErrInvalidContractReply, "contract cannot change the code id of the client")
+	}
+
+	return nil
+}
+
+// validateNewConsensusState checks that the consensus state returned by the contract is
+// a valid consensus state of the same contract.
+func (cs ClientState) validateNewConsensusState(newConsensusState *ConsensusState) error {
+	if newConsensusState == nil {
+		return sdkerrors.Wrap(ErrInvalidContractReply, "new consensus state cannot be empty")
+	}
+	if err := newConsensusState.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+	if !bytes.Equal(cs.CodeId, newConsensusState.CodeId) {
+		return sdkerrors.Wrap(ErrInvalidContractReply, "contract cannot change the code id of the consensus state")
+	}
+
+	return nil
+}
@@ -0,0 +1,411 @@
+package types_test
+
+import (
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *WasmTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		expPass     bool
+	}{
+		{
+			name:        "valid client",
+			clientState: types.NewClientState([]byte("data"), types.CodeID(wasmCode), height),
+			expPass:     true,
+		},
+		{
+			name:        "empty data",
+			clientState: types.NewClientState(nil, types.CodeID(wasmCode), height),
+			expPass:     false,
+		},
+		{
+			name:        "invalid code id",
+			clientState: types.NewClientState([]byte("data"), []byte("code id"), height),
+			expPass:     false,
+		},
+		{
+			name:        "zero latest height",
+			clientState: types.NewClientState([]byte("data"), types.CodeID(wasmCode), clienttypes.ZeroHeight()),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.clientState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *WasmTestSuite) TestStatus() {
+	clientState := suite.clientState()
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+
+	suite.engine.QueryFn = func(_ []byte, _ wasmvmtypes.Env, msg types.QueryMsg, _ sdk.KVStore) (interface{}, error) {
+		suite.Require().NotNil(msg.Status)
+		return types.StatusResult{Status: exported.Frozen}, nil
+	}
+	suite.Require().Equal(exported.Frozen, clientState.Status(ctx, clientStore, suite.chainA.Codec))
+
+	// contract errors are reported as unknown status
+	suite.engine.QueryFn = func([]byte, wasmvmtypes.Env, types.QueryMsg, sdk.KVStore) (interface{}, error) {
+		return nil, fmt.Errorf("contract failure")
+	}
+	suite.Require().Equal(exported.Unknown, clientState.Status(ctx, clientStore, suite.chainA.Codec))
+
+	// no contract is stored under the code id
+	clientState.CodeId = types.CodeID([]byte("unknown contract"))
+	suite.Require().Equal(exported.Unknown, clientState.Status(ctx, clientStore, suite.chainA.Codec))
+}
+
+func (suite *WasmTestSuite) TestInitialize() {
+	var (
+		clientState    *types.ClientState
+		consensusState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid consensus state type", func() {
+				consensusState = &ibctmtypes.ConsensusState{}
+			}, false,
+		},
+		{
+			"consensus state of a different contract", func() {
+				consensusState = types.NewConsensusState([]byte("data"), types.CodeID([]byte("other contract")), 1, []byte("root"))
+			}, false,
+		},
+		{
+			"contract code not found", func() {
+				codeID := types.CodeID([]byte("unknown contract"))
+				clientState.CodeId = codeID
+				consensusState = types.NewConsensusState([]byte("data"), codeID, 1, []byte("root"))
+			}, false,
+		},
+		{
+			"contract rejects the initial state", func() {
+				suite.engine.ExecuteFn = func([]byte, wasmvmtypes.Env, types.ExecuteMsg, sdk.KVStore) (*types.ContractResult, error) {
+					return nil, fmt.Errorf("invalid initial state")
+				}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientState = suite.clientState()
+			consensusState = suite.consensusState()
+
+			var initialized bool
+			suite.engine.ExecuteFn = func(codeID []byte, _ wasmvmtypes.Env, msg types.ExecuteMsg, store sdk.KVStore) (*types.ContractResult, error) {
+				suite.Require().NotNil(msg.Initialize)
+				suite.Require().Equal(clientState, msg.Initialize.ClientState)
+
+				store.Set([]byte("initialized"), []byte{1})
+				initialized = true
+				return &types.ContractResult{}, nil
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+			err := clientState.Initialize(ctx, suite.chainA.Codec, clientStore, consensusState)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(initialized)
+				suite.Require().True(clientStore.Has([]byte("initialized")))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestCheckHeaderAndUpdateState() {
+	var (
+		header *types.Header
+		result *types.ContractResult
+	)
+
+	newHeight := clienttypes.NewHeight(0, height.RevisionHeight+1)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"contract rejects the header", func() {
+				suite.engine.ExecuteFn = func([]byte, wasmvmtypes.Env, types.ExecuteMsg, sdk.KVStore) (*types.ContractResult, error) {
+					return nil, fmt.Errorf("invalid header")
+				}
+			}, false,
+		},
+		{
+			"contract returns no client state", func() {
+				result.NewClientState = nil
+			}, false,
+		},
+		{
+			"contract returns no consensus state", func() {
+				result.NewConsensusState = nil
+			}, false,
+		},
+		{
+			"contract changes the client code id", func() {
+				result.NewClientState.CodeId = types.CodeID([]byte("other contract"))
+			}, false,
+		},
+		{
+			"contract changes the consensus state code id", func() {
+				result.NewConsensusState.CodeId = types.CodeID([]byte("other contract"))
+			}, false,
+		},
+		{
+			"contract returns an invalid consensus state", func() {
+				result.NewConsensusState.Timestamp = 0
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientState := suite.clientState()
+			header = types.NewHeader([]byte("header"), newHeight)
+			result = &types.ContractResult{
+				NewClientState:    types.NewClientState([]byte("updated client state"), suite.codeID, newHeight),
+				NewConsensusState: suite.consensusState(),
+			}
+
+			suite.engine.ExecuteFn = func(_ []byte, _ wasmvmtypes.Env, msg types.ExecuteMsg, _ sdk.KVStore) (*types.ContractResult, error) {
+				suite.Require().NotNil(msg.CheckHeaderAndUpdateState)
+				suite.Require().Equal(header, msg.CheckHeaderAndUpdateState.Header)
+				return result, nil
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, suite.chainA.Codec, clientStore, header)
+
+			// the contract gas is charged regardless of the outcome
+			suite.Require().Greater(ctx.GasMeter().GasConsumed(), gasBefore)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(result.NewClientState, newClientState)
+				suite.Require().Equal(result.NewConsensusState, newConsensusState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestCheckSubstituteAndUpdateState() {
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	subjectClientStore := clientKeeper.ClientStore(ctx, "08-wasm-0")
+	substituteClientStore := clientKeeper.ClientStore(ctx, "08-wasm-1")
+
+	subjectClientState := suite.clientState()
+	substituteHeight := clienttypes.NewHeight(0, height.RevisionHeight+5)
+	substituteClientState := types.NewClientState([]byte("substitute client state"), suite.codeID, substituteHeight)
+	substituteConsensusState := suite.consensusState()
+	types.SetConsensusState(substituteClientStore, suite.chainA.Codec, substituteConsensusState, substituteHeight)
+
+	suite.engine.ExecuteFn = func(_ []byte, _ wasmvmtypes.Env, msg types.ExecuteMsg, _ sdk.KVStore) (*types.ContractResult, error) {
+		suite.Require().NotNil(msg.CheckSubstituteAndUpdateState)
+		suite.Require().Equal(substituteConsensusState, msg.CheckSubstituteAndUpdateState.SubstituteConsensusState)
+		return &types.ContractResult{NewClientState: substituteClientState}, nil
+	}
+
+	newClientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, suite.chainA.Codec, subjectClientStore, substituteClientStore, substituteClientState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(substituteClientState, newClientState)
+
+	// the latest consensus state of the substitute is copied to the subject
+	consensusState, err := types.GetConsensusState(subjectClientStore, suite.chainA.Codec, substituteHeight)
+	suite.Require().NoError(err)
+	suite.Require().Equal(substituteConsensusState, consensusState)
+
+	// the substitute must be implemented by the same contract
+	substituteClientState.CodeId = types.CodeID([]byte("other contract"))
+	_, err = subjectClientState.CheckSubstituteAndUpdateState(ctx, suite.chainA.Codec, subjectClientStore, substituteClientStore, substituteClientState)
+	suite.Require().Error(err)
+}
+
+func (suite *WasmTestSuite) TestVerifyPacketCommitment() {
+	var (
+		proofHeight exported.Height
+		prefix      exported.Prefix
+		proof       []byte
+	)
+
+	commitment := []byte("commitment")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"proof height is greater than the client height", func() {
+				proofHeight = height.Increment()
+			}, false,
+		},
+		{
+			"nil prefix", func() {
+				prefix = nil
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proof = nil
+			}, false,
+		},
+		{
+			"contract rejects the proof", func() {
+				suite.engine.QueryFn = func([]byte, wasmvmtypes.Env, types.QueryMsg, sdk.KVStore) (interface{}, error) {
+					return nil, fmt.Errorf("invalid proof")
+				}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientState := suite.clientState()
+			proofHeight = height
+			merklePrefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+			prefix = &merklePrefix
+			proof = []byte("proof")
+
+			suite.engine.QueryFn = func(_ []byte, _ wasmvmtypes.Env, msg types.QueryMsg, _ sdk.KVStore) (interface{}, error) {
+				suite.Require().NotNil(msg.VerifyMembership)
+				suite.Require().Nil(msg.VerifyNonMembership)
+				suite.Require().Equal(commitment, msg.VerifyMembership.Value)
+				suite.Require().Equal([]string{"ibc", "commitments/ports/transfer/channels/channel-0/sequences/1"}, msg.VerifyMembership.Path.KeyPath)
+				return types.EmptyResult{}, nil
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+
+			err := clientState.VerifyPacketCommitment(
+				ctx, clientStore, suite.chainA.Codec, proofHeight, 0, 0, prefix, proof,
+				ibctesting.TransferPort, ibctesting.FirstChannelID, 1, commitment,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestVerifyPacketReceiptAbsence() {
+	clientState := suite.clientState()
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+	suite.engine.QueryFn = func(_ []byte, _ wasmvmtypes.Env, msg types.QueryMsg, _ sdk.KVStore) (interface{}, error) {
+		suite.Require().Nil(msg.VerifyMembership)
+		suite.Require().NotNil(msg.VerifyNonMembership)
+		return types.EmptyResult{}, nil
+	}
+
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+
+	err := clientState.VerifyPacketReceiptAbsence(
+		ctx, clientStore, suite.chainA.Codec, height, 0, 0, &prefix, []byte("proof"),
+		ibctesting.TransferPort, ibctesting.FirstChannelID, 1,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *WasmTestSuite) TestCreateAndUpdateClient() {
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	params := clientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.Wasm)
+	clientKeeper.SetParams(ctx, params)
+
+	suite.engine.QueryFn = func(_ []byte, _ wasmvmtypes.Env, msg types.QueryMsg, _ sdk.KVStore) (interface{}, error) {
+		suite.Require().NotNil(msg.Status)
+		return types.StatusResult{Status: exported.Active}, nil
+	}
+
+	clientID, err := clientKeeper.CreateClient(ctx, suite.clientState(), suite.consensusState())
+	suite.Require().NoError(err)
+	suite.Require().Equal("08-wasm-0", clientID)
+
+	newHeight := clienttypes.NewHeight(0, height.RevisionHeight+1)
+	newClientState := types.NewClientState([]byte("updated client state"), suite.codeID, newHeight)
+	newConsensusState := suite.consensusState()
+	suite.engine.ExecuteFn = func([]byte, wasmvmtypes.Env, types.ExecuteMsg, sdk.KVStore) (*types.ContractResult, error) {
+		return &types.ContractResult{NewClientState: newClientState, NewConsensusState: newConsensusState}, nil
+	}
+
+	err = clientKeeper.UpdateClient(ctx, clientID, types.NewHeader([]byte("header"), newHeight))
+	suite.Require().NoError(err)
+
+	clientState, found := clientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(newClientState, clientState)
+
+	consensusState, found := clientKeeper.GetClientConsensusState(ctx, clientID, newHeight)
+	suite.Require().True(found)
+	suite.Require().Equal(newConsensusState, consensusState)
+}
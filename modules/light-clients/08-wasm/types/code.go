@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWasmCodeSize is the maximum size in bytes of the contract code of a light client.
+const MaxWasmCodeSize = 3 * 1024 * 1024
+
+// CodeID returns the code id of the given contract code, its sha256 hash.
+func CodeID(code []byte) []byte {
+	hash := sha256.Sum256(code)
+	return hash[:]
+}
+
+// ValidateCodeID checks that the code id is a sha256 hash.
+func ValidateCodeID(codeID []byte) error {
+	if len(codeID) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidCodeID, "expected %d bytes, got %d", sha256.Size, len(codeID))
+	}
+
+	return nil
+}
+
+// ValidateWasmCode checks that the contract code is neither empty nor larger than
+// MaxWasmCodeSize.
+func ValidateWasmCode(code []byte) error {
+	if len(code) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCode, "code cannot be empty")
+	}
+	if len(code) > MaxWasmCodeSize {
+		return sdkerrors.Wrapf(ErrInvalidCode, "code size %d exceeds maximum %d", len(code), MaxWasmCodeSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// RegisterLegacyAminoCodec registers the necessary 08-wasm interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&PushNewWasmCodeProposal{}, "cosmos-sdk/PushNewWasmCodeProposal", nil)
+}
+
+// RegisterInterfaces registers the 08-wasm concrete client-related implementations and
+// interfaces.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.ClientState)(nil),
+		&ClientState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ConsensusState)(nil),
+		&ConsensusState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Header)(nil),
+		&Header{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Misbehaviour)(nil),
+		&Misbehaviour{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&PushNewWasmCodeProposal{},
+	)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global 08-wasm module codec. Note, the codec should ONLY
+	// be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(data, codeID []byte, timestamp uint64, root []byte) *ConsensusState {
+	return &ConsensusState{
+		Data:      data,
+		CodeId:    codeID,
+		Timestamp: timestamp,
+		Root:      commitmenttypes.NewMerkleRoot(root),
+	}
+}
+
+// ClientType returns Wasm.
+func (ConsensusState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetRoot returns the commitment root of the consensus state.
+func (cs ConsensusState) GetRoot() exported.Root {
+	return cs.Root
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the wasm consensus state. The contract
+// data is validated by the contract when the consensus state is stored.
+func (cs ConsensusState) ValidateBasic() error {
+	if len(cs.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+	if err := ValidateCodeID(cs.CodeId); err != nil {
+		return err
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be zero Unix time")
+	}
+	if cs.Root.Empty() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "root cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ExecuteMsg is the JSON message of contract calls which may write to the client store.
+// Exactly one of the fields is set.
+type ExecuteMsg struct {
+	Initialize                      *InitializeMsg                      `json:"initialize,omitempty"`
+	CheckHeaderAndUpdateState       *CheckHeaderAndUpdateStateMsg       `json:"check_header_and_update_state,omitempty"`
+	CheckMisbehaviourAndUpdateState *CheckMisbehaviourAndUpdateStateMsg `json:"check_misbehaviour_and_update_state,omitempty"`
+	CheckSubstituteAndUpdateState   *CheckSubstituteAndUpdateStateMsg   `json:"check_substitute_and_update_state,omitempty"`
+	VerifyUpgradeAndUpdateState     *VerifyUpgradeAndUpdateStateMsg     `json:"verify_upgrade_and_update_state,omitempty"`
+}
+
+// QueryMsg is the JSON message of read-only contract calls. Exactly one of the fields
+// is set.
+type QueryMsg struct {
+	Status              *StatusMsg              `json:"status,omitempty"`
+	ExportMetadata      *ExportMetadataMsg      `json:"export_metadata,omitempty"`
+	VerifyMembership    *VerifyMembershipMsg    `json:"verify_membership,omitempty"`
+	VerifyNonMembership *VerifyNonMembershipMsg `json:"verify_non_membership,omitempty"`
+}
+
+// InitializeMsg asks the contract to validate the initial consensus state of a client
+// and to initialize its client store.
+type InitializeMsg struct {
+	ClientState    *ClientState    `json:"client_state"`
+	ConsensusState *ConsensusState `json:"consensus_state"`
+}
+
+// CheckHeaderAndUpdateStateMsg asks the contract to verify a header and to return the
+// updated client state and the consensus state of the header. A header which
+// constitutes misbehaviour must return a frozen client state.
+type CheckHeaderAndUpdateStateMsg struct {
+	ClientState *ClientState `json:"client_state"`
+	Header      *Header      `json:"header"`
+}
+
+// CheckMisbehaviourAndUpdateStateMsg asks the contract to verify misbehaviour and to
+// return the frozen client state.
+type CheckMisbehaviourAndUpdateStateMsg struct {
+	ClientState  *ClientState  `json:"client_state"`
+	Misbehaviour *Misbehaviour `json:"misbehaviour"`
+}
+
+// CheckSubstituteAndUpdateStateMsg asks the contract to return the client state of a
+// subject client recovered with the substitute client state. The latest consensus
+// state of the substitute is copied to the subject client store before the call.
+type CheckSubstituteAndUpdateStateMsg struct {
+	ClientState              *ClientState    `json:"client_state"`
+	SubstituteClientState    *ClientState    `json:"substitute_client_state"`
+	SubstituteConsensusState *ConsensusState `json:"substitute_consensus_state"`
+}
+
+// VerifyUpgradeAndUpdateStateMsg asks the contract to verify the proofs of the upgraded
+// client and consensus states committed to by the counterparty and to return the
+// upgraded client and consensus states.
+type VerifyUpgradeAndUpdateStateMsg struct {
+	ClientState                *ClientState    `json:"client_state"`
+	UpgradeClientState         *ClientState    `json:"upgrade_client_state"`
+	UpgradeConsensusState      *ConsensusState `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte          `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte          `json:"proof_upgrade_consensus_state"`
+}
+
+// StatusMsg asks the contract for the status of a client.
+type StatusMsg struct {
+	ClientState *ClientState `json:"client_state"`
+}
+
+// ExportMetadataMsg asks the contract for the metadata of a client to be exported in
+// genesis.
+type ExportMetadataMsg struct {
+	ClientState *ClientState `json:"client_state"`
+}
+
+// VerifyMembershipMsg asks the contract to verify the proof of a value stored under the
+// given path on the counterparty at the given height. The delay periods are zero for
+// connection handshake verifications.
+type VerifyMembershipMsg struct {
+	ClientState      *ClientState               `json:"client_state"`
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	Path             commitmenttypes.MerklePath `json:"path"`
+	Value            []byte                     `json:"value"`
+}
+
+// VerifyNonMembershipMsg asks the contract to verify the proof of absence of a value
+// under the given path on the counterparty at the given height.
+type VerifyNonMembershipMsg struct {
+	ClientState      *ClientState               `json:"client_state"`
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	Path             commitmenttypes.MerklePath `json:"path"`
+}
+
+// ContractResult is the JSON data of the response of execute calls.
+type ContractResult struct {
+	NewClientState    *ClientState    `json:"new_client_state,omitempty"`
+	NewConsensusState *ConsensusState `json:"new_consensus_state,omitempty"`
+}
+
+// StatusResult is the JSON reply of status queries.
+type StatusResult struct {
+	Status exported.Status `json:"status"`
+}
+
+// ExportMetadataResult is the JSON reply of export metadata queries.
+type ExportMetadataResult struct {
+	GenesisMetadata []clienttypes.GenesisMetadata `json:"genesis_metadata"`
+}
+
+// EmptyResult is the JSON reply of verification queries.
+type EmptyResult struct{}
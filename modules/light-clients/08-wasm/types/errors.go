@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// 08-wasm sentinel errors
+var (
+	ErrInvalidCode          = sdkerrors.Register(ModuleName, 2, "invalid wasm code")
+	ErrInvalidCodeID        = sdkerrors.Register(ModuleName, 3, "invalid wasm code id")
+	ErrWasmCodeExists       = sdkerrors.Register(ModuleName, 4, "wasm code already exists")
+	ErrWasmCodeNotFound     = sdkerrors.Register(ModuleName, 5, "wasm code not found")
+	ErrVMNotSet             = sdkerrors.Register(ModuleName, 6, "wasm vm is not set")
+	ErrContractCallFailed   = sdkerrors.Register(ModuleName, 7, "wasm contract call failed")
+	ErrInvalidContractReply = sdkerrors.Register(ModuleName, 8, "invalid wasm contract reply")
+	ErrInvalidData          = sdkerrors.Register(ModuleName, 9, "invalid wasm client data")
+)
@@ -0,0 +1,8 @@
+package types
+
+// 08-wasm events
+const (
+	EventTypePushWasmCode = "push_wasm_code"
+
+	AttributeKeyCodeID = "code_id"
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new 08-wasm GenesisState instance.
+func NewGenesisState(codes [][]byte) *GenesisState {
+	return &GenesisState{
+		Codes: codes,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without contract code.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Codes: [][]byte{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, code := range gs.Codes {
+		if err := ValidateWasmCode(code); err != nil {
+			return fmt.Errorf("invalid code %d: %w", i, err)
+		}
+
+		codeID := fmt.Sprintf("%X", CodeID(code))
+		if seen[codeID] {
+			return fmt.Errorf("duplicate code with code id %s", codeID)
+		}
+		seen[codeID] = true
+	}
+
+	return nil
+}
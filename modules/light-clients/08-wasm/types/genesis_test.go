@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState([][]byte{wasmCode, []byte("other contract")}),
+			true,
+		},
+		{
+			"empty code",
+			types.NewGenesisState([][]byte{wasmCode, {}}),
+			false,
+		},
+		{
+			"code too large",
+			types.NewGenesisState([][]byte{make([]byte, types.MaxWasmCodeSize+1)}),
+			false,
+		},
+		{
+			"duplicate code",
+			types.NewGenesisState([][]byte{wasmCode, wasmCode}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Header = (*Header)(nil)
+
+// NewHeader creates a new Header instance.
+func NewHeader(data []byte, height clienttypes.Height) *Header {
+	return &Header{
+		Data:   data,
+		Height: height,
+	}
+}
+
+// ClientType returns Wasm.
+func (Header) ClientType() string {
+	return exported.Wasm
+}
+
+// GetHeight returns the height of the consensus state the header updates the client to.
+func (h Header) GetHeight() exported.Height {
+	return h.Height
+}
+
+// ValidateBasic defines a basic validation for the wasm header. The contract data is
+// verified by the contract when the client is updated.
+func (h Header) ValidateBasic() error {
+	if len(h.Data) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "data cannot be empty")
+	}
+	if h.Height.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "height cannot be zero")
+	}
+
+	return nil
+}
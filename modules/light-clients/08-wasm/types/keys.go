@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the 08-wasm light client module name. It differs from the
+	// client type as governance routes may only contain alphanumeric characters.
+	ModuleName = "wasmclient"
+
+	// StoreKey is the store key string for the 08-wasm light client module
+	StoreKey = ModuleName
+
+	// RouterKey is the proposal route for the 08-wasm light client module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the 08-wasm light client module
+	QuerierRoute = ModuleName
+)
+
+// CodeKeyPrefix defines the key prefix to store contract code in store
+var CodeKeyPrefix = []byte{0x01}
+
+// KeyCode returns the store key of the contract code with the given code id
+func KeyCode(codeID []byte) []byte {
+	return append(append([]byte{}, CodeKeyPrefix...), codeID...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = (*Misbehaviour)(nil)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(clientID string, header1, header2 *Header) *Misbehaviour {
+	return &Misbehaviour{
+		ClientId: clientID,
+		Header1:  header1,
+		Header2:  header2,
+	}
+}
+
+// ClientType returns Wasm.
+func (Misbehaviour) ClientType() string {
+	return exported.Wasm
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// ValidateBasic implements Misbehaviour interface. The conflict of the headers is
+// verified by the contract.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+	if misbehaviour.Header1 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header1 cannot be nil")
+	}
+	if misbehaviour.Header2 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header2 cannot be nil")
+	}
+	if err := misbehaviour.Header1.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 1 failed validation")
+	}
+	if err := misbehaviour.Header2.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 2 failed validation")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypePushNewWasmCode defines the type for a PushNewWasmCodeProposal
+	ProposalTypePushNewWasmCode = "PushNewWasmCode"
+)
+
+var _ govtypes.Content = &PushNewWasmCodeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePushNewWasmCode)
+}
+
+// NewPushNewWasmCodeProposal creates a new push new wasm code proposal.
+func NewPushNewWasmCodeProposal(title, description string, code []byte) govtypes.Content {
+	return &PushNewWasmCodeProposal{
+		Title:       title,
+		Description: description,
+		Code:        code,
+	}
+}
+
+// GetTitle returns the title of a push new wasm code proposal.
+func (p *PushNewWasmCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a push new wasm code proposal.
+func (p *PushNewWasmCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a push new wasm code proposal.
+func (p *PushNewWasmCodeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a push new wasm code proposal.
+func (p *PushNewWasmCodeProposal) ProposalType() string { return ProposalTypePushNewWasmCode }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PushNewWasmCodeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateWasmCode(p.Code)
+}
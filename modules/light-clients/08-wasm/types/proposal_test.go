@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestPushNewWasmCodeProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"success", types.NewPushNewWasmCodeProposal(ibctesting.Title, ibctesting.Description, wasmCode), true},
+		{"invalid title", types.NewPushNewWasmCodeProposal("", ibctesting.Description, wasmCode), false},
+		{"empty code", types.NewPushNewWasmCodeProposal(ibctesting.Title, ibctesting.Description, nil), false},
+		{"code too large", types.NewPushNewWasmCodeProposal(ibctesting.Title, ibctesting.Description, make([]byte, types.MaxWasmCodeSize+1)), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
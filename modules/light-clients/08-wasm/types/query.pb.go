@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/wasm/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryWasmCodeRequest is the request type for the Query/WasmCode RPC method
+type QueryWasmCodeRequest struct {
+	// hex encoded code id of the contract
+	CodeId string `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *QueryWasmCodeRequest) Reset()         { *m = QueryWasmCodeRequest{} }
+func (m *QueryWasmCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmCodeRequest) ProtoMessage()    {}
+func (*QueryWasmCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{0}
+}
+func (m *QueryWasmCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmCodeRequest.Merge(m, src)
+}
+func (m *QueryWasmCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmCodeRequest proto.InternalMessageInfo
+
+func (m *QueryWasmCodeRequest) GetCodeId() string {
+	if m != nil {
+		return m.CodeId
+	}
+	return ""
+}
+
+// QueryWasmCodeResponse is the response type for the Query/WasmCode RPC method
+type QueryWasmCodeResponse struct {
+	// the Wasm byte code of the contract
+	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *QueryWasmCodeResponse) Reset()         { *m = QueryWasmCodeResponse{} }
+func (m *QueryWasmCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmCodeResponse) ProtoMessage()    {}
+func (*QueryWasmCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{1}
+}
+func (m *QueryWasmCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmCodeResponse.Merge(m, src)
+}
+func (m *QueryWasmCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmCodeResponse proto.InternalMessageInfo
+
+func (m *QueryWasmCodeResponse) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+// QueryCodeIdsRequest is the request type for the Query/CodeIds RPC method
+type QueryCodeIdsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCodeIdsRequest) Reset()         { *m = QueryCodeIdsRequest{} }
+func (m *QueryCodeIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeIdsRequest) ProtoMessage()    {}
+func (*QueryCodeIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{2}
+}
+func (m *QueryCodeIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeIdsRequest.Merge(m, src)
+}
+func (m *QueryCodeIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeIdsRequest proto.InternalMessageInfo
+
+func (m *QueryCodeIdsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCodeIdsResponse is the response type for the Query/CodeIds RPC method
+type QueryCodeIdsResponse struct {
+	// hex encoded code ids of the stored contracts
+	CodeIds []string `protobuf:"bytes,1,rep,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCodeIdsResponse) Reset()         { *m = QueryCodeIdsResponse{} }
+func (m *QueryCodeIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeIdsResponse) ProtoMessage()    {}
+func (*QueryCodeIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{3}
+}
+func (m *QueryCodeIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeIdsResponse.Merge(m, src)
+}
+func (m *QueryCodeIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeIdsResponse proto.InternalMessageInfo
+
+func (m *QueryCodeIdsResponse) GetCodeIds() []string {
+	if m != nil {
+		return m.CodeIds
+	}
+	return nil
+}
+
+func (m *QueryCodeIdsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryWasmCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryWasmCodeRequest")
+	proto.RegisterType((*QueryWasmCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryWasmCodeResponse")
+	proto.RegisterType((*QueryCodeIdsRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeIdsRequest")
+	proto.RegisterType((*QueryCodeIdsResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeIdsResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/query.proto", fileDescriptor_9e3718a8cb915777)
+}
+
+var fileDescriptor_9e3718a8cb915777 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0x59, 0xb5, 0xdb, 0x8e, 0x9e, 0xc6, 0x8a, 0x6b, 0x90, 0x50, 0x42, 0xd1, 0xda, 0x92,
+	0x79, 0xa6, 0xbd, 0x78, 0x13, 0x2c, 0x28, 0xde, 0x34, 0x17, 0x51, 0x10, 0x99, 0x24, 0x43, 0x3a,
+	0x90, 0x64, 0xd2, 0xbe, 0x49, 0xa4, 0x8a, 0x17, 0x3f, 0x81, 0xa0, 0x67, 0xbf, 0x8a, 0x57, 0x8f,
+	0x05, 0x2f, 0x1e, 0x65, 0xd7, 0x0f, 0x22, 0x99, 0x49, 0x70, 0x57, 0xec, 0x9f, 0x5b, 0xc2, 0xfc,
+	0xfe, 0xbd, 0xdf, 0x7b, 0x74, 0x53, 0x25, 0x29, 0x14, 0x2a, 0x3f, 0x30, 0x69, 0xa1, 0x64, 0x65,
+	0x10, 0xde, 0x0a, 0x2c, 0xa1, 0x8d, 0xe0, 0xb0, 0x91, 0x47, 0xc7, 0xbc, 0x3e, 0xd2, 0x46, 0xb3,
+	0xa9, 0x4a, 0x52, 0xbe, 0x88, 0xe2, 0x1d, 0x8a, 0xb7, 0x91, 0xb7, 0x9d, 0x6a, 0x2c, 0x35, 0x42,
+	0x22, 0x50, 0x3a, 0x0a, 0xb4, 0x51, 0x22, 0x8d, 0x88, 0xa0, 0x16, 0xb9, 0xaa, 0x84, 0x51, 0xba,
+	0x72, 0x2a, 0xde, 0xed, 0x5c, 0xeb, 0xbc, 0x90, 0x20, 0x6a, 0x05, 0xa2, 0xaa, 0xb4, 0xb1, 0x8f,
+	0xe8, 0x5e, 0x03, 0xa0, 0xeb, 0xcf, 0x3b, 0xfe, 0x0b, 0x81, 0xe5, 0xbe, 0xce, 0x64, 0x2c, 0x0f,
+	0x1b, 0x89, 0x86, 0xdd, 0xa4, 0x93, 0x54, 0x67, 0xf2, 0x8d, 0xca, 0xa6, 0x64, 0x83, 0x6c, 0xad,
+	0xc5, 0x2b, 0xdd, 0xef, 0xd3, 0x2c, 0xd8, 0xa1, 0x37, 0xfe, 0x21, 0x60, 0xad, 0x2b, 0x94, 0x8c,
+	0xd1, 0xcb, 0x1d, 0xc4, 0xc2, 0xaf, 0xc5, 0xf6, 0x3b, 0x78, 0x4d, 0xaf, 0x5b, 0xf0, 0xbe, 0xe5,
+	0xe2, 0x20, 0xfe, 0x98, 0xd2, 0xbf, 0x31, 0x2d, 0xe1, 0xea, 0xee, 0x1d, 0xee, 0x66, 0xe2, 0xdd,
+	0x4c, 0xdc, 0xd5, 0xd0, 0xcf, 0xc4, 0x9f, 0x89, 0x7c, 0x08, 0x16, 0x2f, 0x30, 0x83, 0x77, 0x74,
+	0x7d, 0x59, 0xbe, 0x8f, 0x72, 0x8b, 0xae, 0xf6, 0xe1, 0x71, 0x4a, 0x36, 0x2e, 0x6d, 0xad, 0xc5,
+	0x13, 0x97, 0x1e, 0xd9, 0x93, 0x25, 0xeb, 0xb1, 0xb5, 0xbe, 0x7b, 0xae, 0xb5, 0xd3, 0x5d, 0xf4,
+	0xde, 0xfd, 0x36, 0xa6, 0x57, 0xac, 0x39, 0xfb, 0x4a, 0xe8, 0xea, 0xd0, 0x06, 0xe3, 0xfc, 0xb4,
+	0xa5, 0xf1, 0xff, 0xf5, 0xec, 0xc1, 0x85, 0xf1, 0x2e, 0x43, 0x10, 0x7d, 0xfc, 0xf1, 0xfb, 0xf3,
+	0x78, 0x87, 0xdd, 0x83, 0x53, 0x6f, 0xa8, 0x9b, 0x15, 0xe1, 0x7d, 0x5f, 0xc1, 0x07, 0xf6, 0x85,
+	0xd0, 0x49, 0x5f, 0x11, 0x0b, 0xcf, 0xf1, 0x5b, 0xde, 0x94, 0xc7, 0x2f, 0x0a, 0xef, 0xd3, 0x6d,
+	0xdb, 0x74, 0x9b, 0x2c, 0x38, 0x3b, 0x5d, 0xb7, 0x99, 0x47, 0x2f, 0xbf, 0xcf, 0x7c, 0x72, 0x32,
+	0xf3, 0xc9, 0xaf, 0x99, 0x4f, 0x3e, 0xcd, 0xfd, 0xd1, 0xc9, 0xdc, 0x1f, 0xfd, 0x9c, 0xfb, 0xa3,
+	0x57, 0x0f, 0x73, 0x65, 0x0e, 0x9a, 0x84, 0xa7, 0xba, 0x84, 0xfe, 0xd2, 0x55, 0x92, 0x86, 0xb9,
+	0x86, 0x76, 0x0f, 0x4a, 0x9d, 0x35, 0x85, 0x44, 0x27, 0x1e, 0x0e, 0xea, 0xf7, 0x1f, 0x84, 0xd6,
+	0xc0, 0x1c, 0xd7, 0x12, 0x93, 0x15, 0x7b, 0xdc, 0x7b, 0x7f, 0x06, 0x00, 0xe5, 0x4c, 0xd9, 0xa7,
+	0x68, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// WasmCode queries the byte code of a stored contract.
+	WasmCode(ctx context.Context, in *QueryWasmCodeRequest, opts ...grpc.CallOption) (*QueryWasmCodeResponse, error)
+	// CodeIds queries the code ids of all stored contracts.
+	CodeIds(ctx context.Context, in *QueryCodeIdsRequest, opts ...grpc.CallOption) (*QueryCodeIdsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WasmCode(ctx context.Context, in *QueryWasmCodeRequest, opts ...grpc.CallOption) (*QueryWasmCodeResponse, error) {
+	out := new(QueryWasmCodeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/WasmCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CodeIds(ctx context.Context, in *QueryCodeIdsRequest, opts ...grpc.CallOption) (*QueryCodeIdsResponse, error) {
+	out := new(QueryCodeIdsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/CodeIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// WasmCode queries the byte code of a stored contract.
+	WasmCode(context.Context, *QueryWasmCodeRequest) (*QueryWasmCodeResponse, error)
+	// CodeIds queries the code ids of all stored contracts.
+	CodeIds(context.Context, *QueryCodeIdsRequest) (*QueryCodeIdsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WasmCode(ctx context.Context, req *QueryWasmCodeRequest) (*QueryWasmCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmCode not implemented")
+}
+func (*UnimplementedQueryServer) CodeIds(ctx context.Context, req *QueryCodeIdsRequest) (*QueryCodeIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeIds not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WasmCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWasmCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/WasmCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmCode(ctx, req.(*QueryWasmCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/CodeIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeIds(ctx, req.(*QueryCodeIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.wasm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WasmCode",
+			Handler:    _Query_WasmCode_Handler,
+		},
+		{
+			MethodName: "CodeIds",
+			Handler:    _Query_CodeIds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+}
+
+func (m *QueryWasmCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWasmCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWasmCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWasmCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeIds) > 0 {
+		for iNdEx := len(m.CodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeIds[iNdEx])
+			copy(dAtA[i:], m.CodeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWasmCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWasmCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIds) > 0 {
+		for _, s := range m.CodeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWasmCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWasmCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeIds = append(m.CodeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// GasMultiplier is the number of Wasm VM gas units charged per unit of SDK gas.
+	GasMultiplier uint64 = 140_000_000
+
+	// ContractGasLimit is the maximum amount of SDK gas consumed by a single contract call.
+	ContractGasLimit uint64 = 3_000_000
+)
+
+// WasmEngine defines the Wasm VM executing the contracts of 08-wasm clients. The
+// contract storage of a client is its client store.
+type WasmEngine interface {
+	// Create compiles and stores the given contract code and returns its code id, the
+	// sha256 hash of the code.
+	Create(code []byte) ([]byte, error)
+
+	// GetCode returns the contract code stored under the given code id.
+	GetCode(codeID []byte) ([]byte, error)
+
+	// Execute calls the contract with a message which may write to the contract
+	// storage. It returns the contract response and the amount of VM gas used.
+	Execute(
+		codeID []byte,
+		env wasmvmtypes.Env,
+		info wasmvmtypes.MessageInfo,
+		msg []byte,
+		store sdk.KVStore,
+		gasMeter sdk.GasMeter,
+		gasLimit uint64,
+	) (*wasmvmtypes.Response, uint64, error)
+
+	// Query calls the contract with a read-only message. It returns the contract
+	// response and the amount of VM gas used.
+	Query(
+		codeID []byte,
+		env wasmvmtypes.Env,
+		msg []byte,
+		store sdk.KVStore,
+		gasMeter sdk.GasMeter,
+		gasLimit uint64,
+	) ([]byte, uint64, error)
+}
+
+// vmStore is a client store of a wasm client carrying the Wasm VM which executes the
+// contract of the client.
+type vmStore struct {
+	sdk.KVStore
+
+	vm WasmEngine
+}
+
+// NewVMStore returns the client store of a wasm client carrying the given Wasm VM. The
+// 08-wasm keeper decorates the client stores of all wasm clients with its VM.
+func NewVMStore(clientStore sdk.KVStore, vm WasmEngine) sdk.KVStore {
+	return vmStore{
+		KVStore: clientStore,
+		vm:      vm,
+	}
+}
+
+// GetVM returns the Wasm VM carried by the client store of a wasm client.
+func GetVM(clientStore sdk.KVStore) (WasmEngine, error) {
+	store, ok := clientStore.(vmStore)
+	if !ok || store.vm == nil {
+		return nil, ErrVMNotSet
+	}
+
+	return store.vm, nil
+}
+
+// contractEnv returns the environment of contract calls executed in the given context.
+func contractEnv(ctx sdk.Context) wasmvmtypes.Env {
+	return wasmvmtypes.Env{
+		Block: wasmvmtypes.BlockInfo{
+			Height:  uint64(ctx.BlockHeight()),
+			Time:    uint64(ctx.BlockTime().UnixNano()),
+			ChainID: ctx.ChainID(),
+		},
+	}
+}
+
+// contractGasLimit returns the VM gas limit of a contract call, bounded by both the
+// remaining gas of the gas meter and ContractGasLimit.
+func contractGasLimit(gasMeter sdk.GasMeter) uint64 {
+	limit := ContractGasLimit
+	// a limit of zero denotes an infinite gas meter
+	if gasMeter.Limit() != 0 {
+		var remaining uint64
+		if !gasMeter.IsOutOfGas() {
+			remaining = gasMeter.Limit() - gasMeter.GasConsumed()
+		}
+
+		if remaining < limit {
+			limit = remaining
+		}
+	}
+
+	return limit * GasMultiplier
+}
+
+// consumeContractGas charges the VM gas used by a contract call to the gas meter.
+func consumeContractGas(gasMeter sdk.GasMeter, vmGasUsed uint64) {
+	gasMeter.ConsumeGas(vmGasUsed/GasMultiplier, "08-wasm contract call")
+}
+
+// executeContract calls the contract with the given execute message and returns the
+// contract result. The contract may write to the client store.
+func executeContract(ctx sdk.Context, clientStore sdk.KVStore, codeID []byte, msg ExecuteMsg) (*ContractResult, error) {
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal contract message")
+	}
+
+	resp, gasUsed, err := engine.Execute(codeID, contractEnv(ctx), wasmvmtypes.MessageInfo{}, bz, clientStore, ctx.GasMeter(), contractGasLimit(ctx.GasMeter()))
+	consumeContractGas(ctx.GasMeter(), gasUsed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrContractCallFailed, err.Error())
+	}
+
+	// light client contracts may not dispatch messages to the chain
+	if len(resp.Messages) != 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalidContractReply, "contract dispatched %d messages", len(resp.Messages))
+	}
+
+	var result ContractResult
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+
+	return &result, nil
+}
+
+// queryContract calls the contract with the given query message and unmarshals the
+// contract reply into the provided result. The contract may not write to the client
+// store.
+func queryContract(
+	env wasmvmtypes.Env, gasMeter sdk.GasMeter, clientStore sdk.KVStore, codeID []byte, msg QueryMsg, result interface{},
+) error {
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to marshal contract message")
+	}
+
+	reply, gasUsed, err := engine.Query(codeID, env, bz, clientStore, gasMeter, contractGasLimit(gasMeter))
+	consumeContractGas(gasMeter, gasUsed)
+	if err != nil {
+		return sdkerrors.Wrap(ErrContractCallFailed, err.Error())
+	}
+
+	if err := json.Unmarshal(reply, result); err != nil {
+		return sdkerrors.Wrap(ErrInvalidContractReply, err.Error())
+	}
+
+	return nil
+}
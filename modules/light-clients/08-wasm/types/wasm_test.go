@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibctestingmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var (
+	wasmCode = []byte("mock light client contract")
+	height   = clienttypes.NewHeight(0, 10)
+	clientID = clienttypes.FormatClientIdentifier(exported.Wasm, 0)
+)
+
+type WasmTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+
+	engine *ibctestingmock.WasmEngine
+	codeID []byte
+}
+
+func (suite *WasmTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.engine = ibctestingmock.NewWasmEngine()
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientStoreDecorator(exported.Wasm, func(clientStore sdk.KVStore) sdk.KVStore {
+		return types.NewVMStore(clientStore, suite.engine)
+	})
+
+	codeID, err := suite.engine.Create(wasmCode)
+	suite.Require().NoError(err)
+	suite.codeID = codeID
+}
+
+// clientState returns a valid wasm client state of the mock contract at height.
+func (suite *WasmTestSuite) clientState() *types.ClientState {
+	return types.NewClientState([]byte("client state"), suite.codeID, height)
+}
+
+// consensusState returns a valid wasm consensus state of the mock contract.
+func (suite *WasmTestSuite) consensusState() *types.ConsensusState {
+	return types.NewConsensusState([]byte("consensus state"), suite.codeID, uint64(suite.chainA.GetContext().BlockTime().UnixNano()), []byte("root"))
+}
+
+func TestWasmTestSuite(t *testing.T) {
+	suite.Run(t, new(WasmTestSuite))
+}
@@ -1,5 +1,8 @@
 /*
-Package localhost implements a concrete `ConsensusState`, `Header`,
-`Misbehaviour` and `Equivocation` types for the loop-back client.
+Package localhost implements the loop-back client, a sentinel client state stored under the
+09-localhost client identifier which verifies the state of the running chain. Connections,
+channels and packets between modules of the same chain go through the standard handshake and
+packet messages, whose proofs are read directly from the IBC store instead of being verified
+against a consensus state.
 */
 package localhost
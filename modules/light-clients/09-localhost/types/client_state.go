@@ -137,13 +137,37 @@ func (cs ClientState) VerifyClientState(
 	return nil
 }
 
-// VerifyClientConsensusState returns nil since a local host client does not store consensus
-// states.
+// VerifyClientConsensusState verifies that the counterparty client is the localhost client
+// stored locally at the given consensus height. Since a local host client does not store
+// consensus states, no consensus state may be provided.
 func (cs ClientState) VerifyClientConsensusState(
-	sdk.KVStore, codec.BinaryCodec,
-	exported.Height, string, exported.Height, exported.Prefix,
-	[]byte, exported.ConsensusState,
+	store sdk.KVStore, cdc codec.BinaryCodec,
+	_ exported.Height, counterpartyClientIdentifier string, consensusHeight exported.Height, _ exported.Prefix,
+	_ []byte, consensusState exported.ConsensusState,
 ) error {
+	if counterpartyClientIdentifier != exported.Localhost {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedClientConsensusStateVerification,
+			"counterparty client %s is not %s", counterpartyClientIdentifier, exported.Localhost)
+	}
+
+	if consensusState != nil {
+		return sdkerrors.Wrapf(ErrConsensusStatesNotStored, "unexpected consensus state %v", consensusState)
+	}
+
+	path := host.KeyClientState
+	bz := store.Get([]byte(path))
+	if bz == nil {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedClientConsensusStateVerification,
+			"not found for path: %s", path)
+	}
+
+	selfClient := clienttypes.MustUnmarshalClientState(cdc, bz)
+
+	if !selfClient.GetLatestHeight().EQ(consensusHeight) {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedClientConsensusStateVerification,
+			"consensus height ≠ stored client height: %s ≠ %s", consensusHeight, selfClient.GetLatestHeight())
+	}
+
 	return nil
 }
 
@@ -170,7 +194,17 @@ func (cs ClientState) VerifyConnectionState(
 		return err
 	}
 
-	if !reflect.DeepEqual(&prevConnection, connectionEnd) {
+	var expectedConnection connectiontypes.ConnectionEnd
+	switch conn := connectionEnd.(type) {
+	case connectiontypes.ConnectionEnd:
+		expectedConnection = conn
+	case *connectiontypes.ConnectionEnd:
+		expectedConnection = *conn
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
+	}
+
+	if !bytes.Equal(bz, cdc.MustMarshal(&expectedConnection)) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedConnectionStateVerification,
 			"connection end ≠ previous stored connection: \n%v\n≠\n%v", connectionEnd, prevConnection,
@@ -204,7 +238,17 @@ func (cs ClientState) VerifyChannelState(
 		return err
 	}
 
-	if !reflect.DeepEqual(&prevChannel, channel) {
+	var expectedChannel channeltypes.Channel
+	switch ch := channel.(type) {
+	case channeltypes.Channel:
+		expectedChannel = ch
+	case *channeltypes.Channel:
+		expectedChannel = *ch
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	if !bytes.Equal(bz, cdc.MustMarshal(&expectedChannel)) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedChannelStateVerification,
 			"channel end ≠ previous stored channel: \n%v\n≠\n%v", channel, prevChannel,
@@ -270,7 +314,8 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketAckVerification, "not found for path %s", path)
 	}
 
-	if !bytes.Equal(data, acknowledgement) {
+	// the acknowledgement commitment is stored
+	if !bytes.Equal(data, channeltypes.CommitAcknowledgement(acknowledgement)) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrFailedPacketAckVerification,
 			"ak bytes ≠ previous ack: \n%X\n≠\n%X", acknowledgement, data,
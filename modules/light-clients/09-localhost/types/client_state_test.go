@@ -155,10 +155,83 @@ func (suite *LocalhostTestSuite) TestVerifyClientState() {
 
 func (suite *LocalhostTestSuite) TestVerifyClientConsensusState() {
 	clientState := types.NewClientState("chainID", clientHeight)
-	err := clientState.VerifyClientConsensusState(
-		nil, nil, nil, "", nil, nil, nil, nil,
-	)
-	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		counterparty    string
+		consensusHeight exported.Height
+		consensusState  exported.ConsensusState
+		expPass         bool
+	}{
+		{
+			name: "proof verification success",
+			malleate: func() {
+				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
+				suite.store.Set(host.ClientStateKey(), bz)
+			},
+			counterparty:    exported.Localhost,
+			consensusHeight: clientHeight,
+			expPass:         true,
+		},
+		{
+			name: "proof verification failed: counterparty is not localhost",
+			malleate: func() {
+				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
+				suite.store.Set(host.ClientStateKey(), bz)
+			},
+			counterparty:    "07-tendermint-0",
+			consensusHeight: clientHeight,
+			expPass:         false,
+		},
+		{
+			name: "proof verification failed: consensus state provided",
+			malleate: func() {
+				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
+				suite.store.Set(host.ClientStateKey(), bz)
+			},
+			counterparty:    exported.Localhost,
+			consensusHeight: clientHeight,
+			consensusState:  &ibctmtypes.ConsensusState{},
+			expPass:         false,
+		},
+		{
+			name: "proof verification failed: consensus height is not the client height",
+			malleate: func() {
+				bz := clienttypes.MustMarshalClientState(suite.cdc, clientState)
+				suite.store.Set(host.ClientStateKey(), bz)
+			},
+			counterparty:    exported.Localhost,
+			consensusHeight: clientHeight.Increment(),
+			expPass:         false,
+		},
+		{
+			name:            "proof verification failed: client not stored",
+			malleate:        func() {},
+			counterparty:    exported.Localhost,
+			consensusHeight: clientHeight,
+			expPass:         false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := clientState.VerifyClientConsensusState(
+				suite.store, suite.cdc, clientHeight, tc.counterparty, tc.consensusHeight, nil, []byte{}, tc.consensusState,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
 }
 
 func (suite *LocalhostTestSuite) TestCheckHeaderAndUpdateState() {
@@ -193,7 +266,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 		name        string
 		clientState *types.ClientState
 		malleate    func()
-		connection  connectiontypes.ConnectionEnd
+		connection  exported.ConnectionI
 		expPass     bool
 	}{
 		{
@@ -204,6 +277,17 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 				suite.Require().NoError(err)
 				suite.store.Set(host.ConnectionKey(testConnectionID), bz)
 			},
+			connection: &conn1,
+			expPass:    true,
+		},
+		{
+			name:        "proof verification success: connection end value",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				bz, err := suite.cdc.Marshal(&conn1)
+				suite.Require().NoError(err)
+				suite.store.Set(host.ConnectionKey(testConnectionID), bz)
+			},
 			connection: conn1,
 			expPass:    true,
 		},
@@ -211,7 +295,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 			name:        "proof verification failed: connection not stored",
 			clientState: types.NewClientState("chainID", clientHeight),
 			malleate:    func() {},
-			connection:  conn1,
+			connection:  &conn1,
 			expPass:     false,
 		},
 		{
@@ -220,7 +304,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 			malleate: func() {
 				suite.store.Set(host.ConnectionKey(testConnectionID), []byte("connection"))
 			},
-			connection: conn1,
+			connection: &conn1,
 			expPass:    false,
 		},
 		{
@@ -231,7 +315,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 				suite.Require().NoError(err)
 				suite.store.Set(host.ConnectionKey(testConnectionID), bz)
 			},
-			connection: conn1,
+			connection: &conn1,
 			expPass:    false,
 		},
 	}
@@ -244,7 +328,7 @@ func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
 			tc.malleate()
 
 			err := tc.clientState.VerifyConnectionState(
-				suite.store, suite.cdc, clientHeight, nil, []byte{}, testConnectionID, &tc.connection,
+				suite.store, suite.cdc, clientHeight, nil, []byte{}, testConnectionID, tc.connection,
 			)
 
 			if tc.expPass {
@@ -402,7 +486,7 @@ func (suite *LocalhostTestSuite) TestVerifyPacketAcknowledgement() {
 			clientState: types.NewClientState("chainID", clientHeight),
 			malleate: func() {
 				suite.store.Set(
-					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), []byte("acknowledgement"),
+					host.PacketAcknowledgementKey(testPortID, testChannelID, testSequence), channeltypes.CommitAcknowledgement([]byte("acknowledgement")),
 				)
 			},
 			ack:     []byte("acknowledgement"),
@@ -4,3 +4,8 @@ const (
 	// SubModuleName for the localhost (loopback) client
 	SubModuleName = "localhost"
 )
+
+// SentinelProof is the proof submitted in the handshake and packet messages of connections
+// over the localhost client. The localhost client reads the proven state directly from the
+// IBC store, the proof is only required to be non-empty by the message validation.
+var SentinelProof = []byte{0x01}
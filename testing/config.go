@@ -3,8 +3,11 @@ package ibctesting
 import (
 	"time"
 
+	ics23 "github.com/confio/ics23/go"
+
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/ibc-go/v3/testing/mock"
@@ -19,6 +22,7 @@ type TendermintConfig struct {
 	TrustingPeriod               time.Duration
 	UnbondingPeriod              time.Duration
 	MaxClockDrift                time.Duration
+	ProofSpecs                   []*ics23.ProofSpec
 	AllowUpdateAfterExpiry       bool
 	AllowUpdateAfterMisbehaviour bool
 }
@@ -29,6 +33,7 @@ func NewTendermintConfig() *TendermintConfig {
 		TrustingPeriod:               TrustingPeriod,
 		UnbondingPeriod:              UnbondingPeriod,
 		MaxClockDrift:                MaxClockDrift,
+		ProofSpecs:                   commitmenttypes.GetSDKSpecs(),
 		AllowUpdateAfterExpiry:       false,
 		AllowUpdateAfterMisbehaviour: false,
 	}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.HostTimeOracle = HostTimeOracle{}
+
+// HostTimeOracle implements the HostTimeOracle interface by returning a fixed height and
+// timestamp. Only use it for testing.
+type HostTimeOracle struct {
+	Height    exported.Height
+	Timestamp uint64
+}
+
+// GetHostHeight implements the HostTimeOracle interface
+func (o HostTimeOracle) GetHostHeight(_ sdk.Context) exported.Height {
+	return o.Height
+}
+
+// GetHostTimestamp implements the HostTimeOracle interface
+func (o HostTimeOracle) GetHostTimestamp(_ sdk.Context) uint64 {
+	return o.Timestamp
+}
@@ -85,6 +85,60 @@ type MockIBCApp struct {
 		packet channeltypes.Packet,
 		relayer sdk.AccAddress,
 	) error
+
+	OnClientFrozen func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+
+	OnReclaimPacket func(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		reason string,
+		signer sdk.AccAddress,
+	) error
+
+	OnCompensatePacket func(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		compensation []byte,
+		relayer sdk.AccAddress,
+	) error
+
+	OnChanUpgradeInit func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) (string, error)
+
+	OnChanUpgradeTry func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (string, error)
+
+	OnChanUpgradeAck func(
+		ctx sdk.Context,
+		portID,
+		channelID,
+		counterpartyVersion string,
+	) error
+
+	OnChanUpgradeOpen func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	)
 }
 
 // NewMockIBCApp returns a MockIBCApp. An empty PortID indicates the mock app doesn't bind/claim ports.
@@ -154,6 +154,71 @@ func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet,
 	return nil
 }
 
+// OnClientFrozen implements the IBCModule interface.
+func (im IBCModule) OnClientFrozen(ctx sdk.Context, portID, channelID string) {
+	if im.IBCApp.OnClientFrozen != nil {
+		im.IBCApp.OnClientFrozen(ctx, portID, channelID)
+	}
+}
+
+// IsDeadLetterModule implements the DeadLetterModule interface. The mock module opts in to the
+// dead-letter store.
+func (im IBCModule) IsDeadLetterModule() bool {
+	return true
+}
+
+// OnReclaimPacket implements the DeadLetterModule interface.
+func (im IBCModule) OnReclaimPacket(ctx sdk.Context, packet channeltypes.Packet, reason string, signer sdk.AccAddress) error {
+	if im.IBCApp.OnReclaimPacket != nil {
+		return im.IBCApp.OnReclaimPacket(ctx, packet, reason, signer)
+	}
+
+	return nil
+}
+
+// OnCompensatePacket implements the CompensatingModule interface.
+func (im IBCModule) OnCompensatePacket(ctx sdk.Context, packet channeltypes.Packet, compensation []byte, relayer sdk.AccAddress) error {
+	if im.IBCApp.OnCompensatePacket != nil {
+		return im.IBCApp.OnCompensatePacket(ctx, packet, compensation, relayer)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+	if im.IBCApp.OnChanUpgradeInit != nil {
+		return im.IBCApp.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+	}
+
+	return version, nil
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, counterpartyVersion string) (string, error) {
+	if im.IBCApp.OnChanUpgradeTry != nil {
+		return im.IBCApp.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	if im.IBCApp.OnChanUpgradeAck != nil {
+		return im.IBCApp.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) {
+	if im.IBCApp.OnChanUpgradeOpen != nil {
+		im.IBCApp.OnChanUpgradeOpen(ctx, portID, channelID, order, connectionHops, version)
+	}
+}
+
 // GetMockRecvCanaryCapabilityName generates a capability name for testing OnRecvPacket functionality.
 func GetMockRecvCanaryCapabilityName(packet channeltypes.Packet) string {
 	return fmt.Sprintf("%s%s%s%s", MockRecvCanaryCapabilityName, packet.GetDestPort(), packet.GetDestChannel(), strconv.Itoa(int(packet.GetSequence())))
@@ -37,7 +37,12 @@ var (
 	MockTimeoutCanaryCapabilityName = "mock timeout canary capability name"
 )
 
-var _ porttypes.IBCModule = IBCModule{}
+var (
+	_ porttypes.IBCModule          = IBCModule{}
+	_ porttypes.DeadLetterModule   = IBCModule{}
+	_ porttypes.CompensatingModule = IBCModule{}
+	_ porttypes.UpgradableModule   = IBCModule{}
+)
 
 // Expected Interface
 // PortKeeper defines the expected IBC port keeper
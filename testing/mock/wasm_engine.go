@@ -0,0 +1,121 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// MockContractGas is the SDK gas consumed by each call of a mock contract.
+const MockContractGas uint64 = 1000
+
+var _ wasmtypes.WasmEngine = (*WasmEngine)(nil)
+
+// WasmEngine is a mock Wasm VM for 08-wasm clients. It stores contract code in memory
+// and dispatches contract calls to the ExecuteFn and QueryFn handlers.
+type WasmEngine struct {
+	codes map[string][]byte
+
+	// ExecuteFn handles the execute calls of all contracts. The returned result is
+	// the data of the contract response.
+	ExecuteFn func(codeID []byte, env wasmvmtypes.Env, msg wasmtypes.ExecuteMsg, store sdk.KVStore) (*wasmtypes.ContractResult, error)
+	// QueryFn handles the query calls of all contracts. The returned result is
+	// marshalled to JSON as the contract reply.
+	QueryFn func(codeID []byte, env wasmvmtypes.Env, msg wasmtypes.QueryMsg, store sdk.KVStore) (interface{}, error)
+}
+
+// NewWasmEngine returns a mock Wasm VM without contract code whose contracts reply
+// with empty results.
+func NewWasmEngine() *WasmEngine {
+	return &WasmEngine{
+		codes: make(map[string][]byte),
+		ExecuteFn: func([]byte, wasmvmtypes.Env, wasmtypes.ExecuteMsg, sdk.KVStore) (*wasmtypes.ContractResult, error) {
+			return &wasmtypes.ContractResult{}, nil
+		},
+		QueryFn: func([]byte, wasmvmtypes.Env, wasmtypes.QueryMsg, sdk.KVStore) (interface{}, error) {
+			return wasmtypes.EmptyResult{}, nil
+		},
+	}
+}
+
+// Create implements the WasmEngine interface.
+func (e *WasmEngine) Create(code []byte) ([]byte, error) {
+	codeID := wasmtypes.CodeID(code)
+	e.codes[string(codeID)] = code
+	return codeID, nil
+}
+
+// GetCode implements the WasmEngine interface.
+func (e *WasmEngine) GetCode(codeID []byte) ([]byte, error) {
+	code, ok := e.codes[string(codeID)]
+	if !ok {
+		return nil, fmt.Errorf("code id %X not found", codeID)
+	}
+
+	return code, nil
+}
+
+// Execute implements the WasmEngine interface.
+func (e *WasmEngine) Execute(
+	codeID []byte,
+	env wasmvmtypes.Env,
+	_ wasmvmtypes.MessageInfo,
+	msg []byte,
+	store sdk.KVStore,
+	_ sdk.GasMeter,
+	_ uint64,
+) (*wasmvmtypes.Response, uint64, error) {
+	gasUsed := MockContractGas * wasmtypes.GasMultiplier
+	if _, err := e.GetCode(codeID); err != nil {
+		return nil, gasUsed, err
+	}
+
+	var executeMsg wasmtypes.ExecuteMsg
+	if err := json.Unmarshal(msg, &executeMsg); err != nil {
+		return nil, gasUsed, err
+	}
+
+	result, err := e.ExecuteFn(codeID, env, executeMsg, store)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	return &wasmvmtypes.Response{Data: data}, gasUsed, nil
+}
+
+// Query implements the WasmEngine interface.
+func (e *WasmEngine) Query(
+	codeID []byte,
+	env wasmvmtypes.Env,
+	msg []byte,
+	store sdk.KVStore,
+	_ sdk.GasMeter,
+	_ uint64,
+) ([]byte, uint64, error) {
+	gasUsed := MockContractGas * wasmtypes.GasMultiplier
+	if _, err := e.GetCode(codeID); err != nil {
+		return nil, gasUsed, err
+	}
+
+	var queryMsg wasmtypes.QueryMsg
+	if err := json.Unmarshal(msg, &queryMsg); err != nil {
+		return nil, gasUsed, err
+	}
+
+	result, err := e.QueryFn(codeID, env, queryMsg, store)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	reply, err := json.Marshal(result)
+	return reply, gasUsed, err
+}
@@ -7,12 +7,14 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -89,17 +91,44 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibcbounty "github.com/cosmos/ibc-go/v3/modules/apps/bounty"
+	ibcbountyclient "github.com/cosmos/ibc-go/v3/modules/apps/bounty/client"
+	ibcbountykeeper "github.com/cosmos/ibc-go/v3/modules/apps/bounty/keeper"
+	ibcbountytypes "github.com/cosmos/ibc-go/v3/modules/apps/bounty/types"
+	interchainquery "github.com/cosmos/ibc-go/v3/modules/apps/interchain-query"
+	interchainquerykeeper "github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/keeper"
+	interchainquerytypes "github.com/cosmos/ibc-go/v3/modules/apps/interchain-query/types"
+	nfttransfer "github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer"
+	nfttransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+	packetforward "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward"
+	packetforwardkeeper "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	ratelimiting "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting"
+	ratelimitingkeeper "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/keeper"
+	ratelimitingtypes "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibcrelayer "github.com/cosmos/ibc-go/v3/modules/apps/relayer"
+	ibcrelayerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/relayer/keeper"
+	ibcrelayertypes "github.com/cosmos/ibc-go/v3/modules/apps/relayer/types"
 	transfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	ibctransferclient "github.com/cosmos/ibc-go/v3/modules/apps/transfer/client"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v3/modules/core"
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	ibcclientclient "github.com/cosmos/ibc-go/v3/modules/core/02-client/client"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	ibcwasm "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm"
+	ibcwasmclient "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/client"
+	ibcwasmkeeper "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	ibcwasmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
 	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/cosmos/ibc-go/v3/testing/simapp/nft"
 
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -128,7 +157,9 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.ArchiveClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcbountyclient.FundBountyProposalHandler, ibcwasmclient.PushNewWasmCodeProposalHandler,
+			ibctransferclient.DenomTraceCorrectionProposalHandler, ibctransferclient.CounterpartyModuleAccountsProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -138,8 +169,15 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
+		interchainquery.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		ratelimiting.AppModuleBasic{},
 		ibcmock.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		ibcbounty.AppModuleBasic{},
+		ibcrelayer.AppModuleBasic{},
+		ibcwasm.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
@@ -154,6 +192,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
+		ibcbountytypes.ModuleName:      nil,
 	}
 )
 
@@ -196,11 +235,21 @@ type SimApp struct {
 	ICAHostKeeper       icahostkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	NFTTransferKeeper   nfttransferkeeper.Keeper
+	NFTKeeper           nft.Keeper
+	ICQKeeper           interchainquerykeeper.Keeper
+	PacketForwardKeeper packetforwardkeeper.Keeper
+	RateLimitingKeeper  ratelimitingkeeper.Keeper
+	BountyKeeper        ibcbountykeeper.Keeper
+	RelayerKeeper       ibcrelayerkeeper.Keeper
+	WasmClientKeeper    ibcwasmkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper   capabilitykeeper.ScopedKeeper
+	ScopedICQKeeper           capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedIBCMockKeeper       capabilitykeeper.ScopedKeeper
@@ -210,6 +259,9 @@ type SimApp struct {
 	// these modules are never directly routed to by the IBC Router
 	ICAAuthModule ibcmock.IBCModule
 
+	// the mock module routed to by the IBC Router on the mock port, public for test purposes
+	IBCMockModule ibcmock.IBCModule
+
 	// the module manager
 	mm *module.Manager
 
@@ -250,7 +302,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, ibcbountytypes.StoreKey, ibcwasmtypes.StoreKey, nfttransfertypes.StoreKey, nft.StoreKey,
+		interchainquerytypes.StoreKey, packetforwardtypes.StoreKey, ratelimitingtypes.StoreKey, ibcrelayertypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -275,6 +328,8 @@ func NewSimApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
+	scopedICQKeeper := app.CapabilityKeeper.ScopeToModule(interchainquerytypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 
@@ -325,33 +380,117 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// Create the IBC misbehaviour bounty keeper and register its client hooks
+	app.BountyKeeper = ibcbountykeeper.NewKeeper(
+		appCodec, keys[ibcbountytypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+		app.IBCKeeper.ClientKeeper,
+	)
+	app.IBCKeeper.SetClientHooks(app.BountyKeeper.Hooks())
+
+	// Create the IBC relayer registry keeper and register its relayer hooks
+	app.RelayerKeeper = ibcrelayerkeeper.NewKeeper(appCodec, keys[ibcrelayertypes.StoreKey])
+	app.IBCKeeper.SetRelayerHooks(app.RelayerKeeper.Hooks())
+
+	// Create the 08-wasm keeper with the Wasm VM executing the light client contracts
+	wasmEngine, err := ibcwasmkeeper.NewWasmEngine(filepath.Join(homePath, "wasm"), 0)
+	if err != nil {
+		panic(err)
+	}
+	app.WasmClientKeeper = ibcwasmkeeper.NewKeeper(appCodec, keys[ibcwasmtypes.StoreKey], wasmEngine)
+	app.IBCKeeper.ClientKeeper.SetClientStoreDecorator(ibcexported.Wasm, app.WasmClientKeeper.DecorateClientStore)
+
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
+	// The packet data codecs decode the ICS-20 packet data of every transfer channel version for
+	// the middleware of the transfer stack
+	transferPacketDataCodecs := ibctransfertypes.RegisterPacketDataCodecs(porttypes.NewPacketDataCodecs())
+
+	// The rate limiting keeper accounts for the ICS-20 packets sent by the transfer keeper
+	app.RateLimitingKeeper = ratelimitingkeeper.NewKeeper(
+		appCodec, keys[ratelimitingtypes.StoreKey], app.GetSubspace(ratelimitingtypes.ModuleName),
+		&app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, transferPacketDataCodecs,
+	)
+
+	// Create Transfer Keepers
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		&app.RateLimitingKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, scopedTransferKeeper,
+	)
+
+	// The protocol exempt accounts of the transfer params are not limited by the rate limiting quotas
+	app.RateLimitingKeeper.SetExemptionKeeper(app.TransferKeeper)
+
+	// Create the NFT Transfer Keeper on top of the minimal NFT keeper of the SimApp
+	app.NFTKeeper = nft.NewKeeper(keys[nft.StoreKey])
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.NFTKeeper, scopedNFTTransferKeeper,
+	)
+
+	// The interchain queries received from controller chains are executed by the BaseApp
+	app.ICQKeeper = interchainquerykeeper.NewKeeper(
+		appCodec, keys[interchainquerytypes.StoreKey], app.GetSubspace(interchainquerytypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICQKeeper, app.BaseApp,
+	)
+
+	// The packet forward middleware forwards the received ICS-20 tokens with the transfer keeper
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec, keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, transferPacketDataCodecs,
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ibcbountytypes.RouterKey, ibcbounty.NewBountyProposalHandler(app.BountyKeeper)).
+		AddRoute(ibcwasmtypes.RouterKey, ibcwasm.NewWasmProposalHandler(app.WasmClientKeeper)).
+		AddRoute(ibctransfertypes.RouterKey, transfer.NewTransferProposalHandler(app.TransferKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
-	// Create Transfer Keepers
-	app.TransferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
-	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
 
+	// the rate limiting middleware wraps the transfer application and the packet forward middleware
+	// wraps the rate limiting middleware at the top of the transfer stack. The transfer keeper sends
+	// packets through the rate limiting keeper, which the stack builder checks at startup. The layers
+	// of the stack are given as pointers as the stack builder compares them by identity.
+	rateLimitingModule := ratelimiting.NewAppModule(app.RateLimitingKeeper)
+	packetForwardModule := packetforward.NewAppModule(app.PacketForwardKeeper)
+	transferStack, err := porttypes.NewMiddlewareStackBuilder(&app.IBCKeeper.ChannelKeeper, &transferIBCModule).
+		Wrap(func(underlying porttypes.IBCModule) porttypes.IBCModule {
+			rateLimitingIBCModule := ratelimiting.NewIBCModule(app.RateLimitingKeeper, underlying)
+			return &rateLimitingIBCModule
+		}, &app.RateLimitingKeeper).
+		Wrap(func(underlying porttypes.IBCModule) porttypes.IBCModule {
+			packetForwardIBCModule := packetforward.NewIBCModule(app.PacketForwardKeeper, underlying)
+			return &packetForwardIBCModule
+		}, nil).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+
+	nftTransferModule := nfttransfer.NewAppModule(app.NFTTransferKeeper)
+	nftTransferIBCModule := nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+
+	icqModule := interchainquery.NewAppModule(app.ICQKeeper)
+	icqIBCModule := interchainquery.NewIBCModule(app.ICQKeeper)
+
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// not replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(&app.IBCKeeper.PortKeeper)
 	mockIBCModule := ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp(ibcmock.ModuleName, scopedIBCMockKeeper))
+	app.IBCMockModule = mockIBCModule
 
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
@@ -363,7 +502,7 @@ func NewSimApp(
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
 	)
 
 	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
@@ -380,10 +519,22 @@ func NewSimApp(
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(ibcmock.ModuleName+icacontrollertypes.SubModuleName, icaControllerIBCModule). // ica with mock auth module stack route to ica (top level of middleware stack)
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferStack.IBCModule).
+		AddRoute(nfttransfertypes.ModuleName, nftTransferIBCModule).
+		AddRoute(interchainquerytypes.ModuleName, icqIBCModule).
 		AddRoute(ibcmock.ModuleName, mockIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
+	// register the packet data schemas of the IBC applications for indexers
+	app.IBCKeeper.ChannelKeeper.SetPacketDataSchemas([]channeltypes.PacketDataSchema{
+		channeltypes.NewPacketDataSchema(ibctransfertypes.PortID, ibctransfertypes.Version, "/"+proto.MessageName(&ibctransfertypes.FungibleTokenPacketData{})),
+		channeltypes.NewPacketDataSchema(ibctransfertypes.PortID, ibctransfertypes.VersionProtobuf, "/"+proto.MessageName(&ibctransfertypes.FungibleTokenPacketData{})),
+		channeltypes.NewPacketDataSchema(nfttransfertypes.PortID, nfttransfertypes.Version, "/"+proto.MessageName(&nfttransfertypes.NonFungibleTokenPacketData{})),
+		channeltypes.NewPacketDataSchema(interchainquerytypes.PortID, interchainquerytypes.Version, "/"+proto.MessageName(&interchainquerytypes.InterchainQueryPacketData{})),
+		channeltypes.NewPacketDataSchema(icatypes.PortPrefix, "", "/"+proto.MessageName(&icatypes.InterchainAccountPacketData{})),
+		channeltypes.NewPacketDataSchema(icatypes.PortID, "", "/"+proto.MessageName(&icatypes.InterchainAccountPacketData{})),
+	})
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -421,7 +572,14 @@ func NewSimApp(
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		transferModule,
+		nftTransferModule,
+		icqModule,
+		packetForwardModule,
+		rateLimitingModule,
 		icaModule,
+		ibcbounty.NewAppModule(app.BountyKeeper),
+		ibcrelayer.NewAppModule(app.RelayerKeeper),
+		ibcwasm.NewAppModule(app.WasmClientKeeper),
 		mockModule,
 	)
 
@@ -434,13 +592,13 @@ func NewSimApp(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcbountytypes.ModuleName, ibcrelayertypes.ModuleName, ibcwasmtypes.ModuleName, nfttransfertypes.ModuleName, interchainquerytypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
-		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName,
+		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcbountytypes.ModuleName, ibcrelayertypes.ModuleName, ibcwasmtypes.ModuleName, nfttransfertypes.ModuleName, interchainquerytypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -451,8 +609,8 @@ func NewSimApp(
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
-		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		ibcwasmtypes.ModuleName, ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
+		icatypes.ModuleName, ibcbountytypes.ModuleName, ibcrelayertypes.ModuleName, nfttransfertypes.ModuleName, interchainquerytypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -518,10 +676,18 @@ func NewSimApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// the Wasm VM cache does not contain the contract code stored before a restart
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmClientKeeper.LoadWasmCodes(ctx); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
+	app.ScopedICQKeeper = scopedICQKeeper
 	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
 
@@ -552,8 +718,25 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+
+	// the capability module must be initialized before core IBC and core IBC before the IBC
+	// applications binding their ports at genesis
+	if err := ibc.ValidateInitGenesisOrder(
+		app.mm.OrderInitGenesis,
+		ibctransfertypes.ModuleName, icatypes.ModuleName, nfttransfertypes.ModuleName, interchainquerytypes.ModuleName, ibcmock.ModuleName,
+	); err != nil {
+		panic(err)
+	}
+
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+
+	// every port bound at genesis must be routed to its module by the IBC router
+	if err := app.IBCKeeper.ValidatePortRoutes(ctx, app.CapabilityKeeper); err != nil {
+		panic(err)
+	}
+
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -727,6 +910,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(interchainquerytypes.ModuleName)
+	paramsKeeper.Subspace(ratelimitingtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -0,0 +1,177 @@
+package nft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nfttransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/nft-transfer/types"
+)
+
+// StoreKey is the store key string of the minimal non-fungible token module of the SimApp.
+const StoreKey = "simnft"
+
+var (
+	// ClassKey defines the key prefix under which the classes are stored
+	ClassKey = []byte{0x01}
+	// NFTKey defines the key prefix under which the tokens are stored
+	NFTKey = []byte{0x02}
+	// OwnerKey defines the key prefix under which the owners of the tokens are stored
+	OwnerKey = []byte{0x03}
+
+	// ErrClassNotFound is returned when a class does not exist
+	ErrClassNotFound = sdkerrors.Register(StoreKey, 2, "class not found")
+	// ErrNFTExists is returned when minting a token which already exists
+	ErrNFTExists = sdkerrors.Register(StoreKey, 3, "nft already exists")
+	// ErrNFTNotFound is returned when a token does not exist
+	ErrNFTNotFound = sdkerrors.Register(StoreKey, 4, "nft not found")
+)
+
+// Class is a non-fungible token class
+type Class struct {
+	Id   string `json:"id"`
+	Uri  string `json:"uri"`
+	Data string `json:"data"`
+}
+
+// GetId returns the class identifier
+func (c Class) GetId() string { return c.Id }
+
+// GetUri returns the class uri
+func (c Class) GetUri() string { return c.Uri }
+
+// GetData returns the class data
+func (c Class) GetData() string { return c.Data }
+
+// NFT is a non-fungible token
+type NFT struct {
+	ClassId string `json:"class_id"`
+	Id      string `json:"id"`
+	Uri     string `json:"uri"`
+	Data    string `json:"data"`
+}
+
+// GetClassId returns the identifier of the class of the token
+func (n NFT) GetClassId() string { return n.ClassId }
+
+// GetId returns the token identifier
+func (n NFT) GetId() string { return n.Id }
+
+// GetUri returns the token uri
+func (n NFT) GetUri() string { return n.Uri }
+
+// GetData returns the token data
+func (n NFT) GetData() string { return n.Data }
+
+var _ nfttransfertypes.NFTKeeper = Keeper{}
+
+// Keeper is a minimal non-fungible token keeper used to exercise the nft-transfer
+// application in the SimApp. Chains are expected to provide their own NFT module.
+type Keeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new NFT Keeper instance
+func NewKeeper(key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// tokenKey returns the key of a token. The class identifier is length prefixed as both
+// identifiers may contain slashes.
+func tokenKey(classID, tokenID string) []byte {
+	return []byte(fmt.Sprintf("%d/%s/%s", len(classID), classID, tokenID))
+}
+
+// SaveClass creates or updates a class.
+func (k Keeper) SaveClass(ctx sdk.Context, classID, classURI, classData string) error {
+	bz, err := json.Marshal(Class{Id: classID, Uri: classURI, Data: classData})
+	if err != nil {
+		return err
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), ClassKey).Set([]byte(classID), bz)
+	return nil
+}
+
+// GetClass returns the class with the given identifier.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (nfttransfertypes.Class, bool) {
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), ClassKey).Get([]byte(classID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var class Class
+	if err := json.Unmarshal(bz, &class); err != nil {
+		panic(err)
+	}
+	return class, true
+}
+
+// HasClass returns true if the class with the given identifier exists.
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), ClassKey).Has([]byte(classID))
+}
+
+// Mint creates a token of an existing class owned by the receiver.
+func (k Keeper) Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(ErrClassNotFound, classID)
+	}
+	if _, found := k.GetNFT(ctx, classID, tokenID); found {
+		return sdkerrors.Wrapf(ErrNFTExists, "class id (%s) token id (%s)", classID, tokenID)
+	}
+
+	bz, err := json.Marshal(NFT{ClassId: classID, Id: tokenID, Uri: tokenURI, Data: tokenData})
+	if err != nil {
+		return err
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), NFTKey).Set(tokenKey(classID, tokenID), bz)
+	prefix.NewStore(ctx.KVStore(k.storeKey), OwnerKey).Set(tokenKey(classID, tokenID), receiver)
+	return nil
+}
+
+// Transfer changes the owner of an existing token.
+func (k Keeper) Transfer(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	if _, found := k.GetNFT(ctx, classID, tokenID); !found {
+		return sdkerrors.Wrapf(ErrNFTNotFound, "class id (%s) token id (%s)", classID, tokenID)
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), OwnerKey).Set(tokenKey(classID, tokenID), receiver)
+	return nil
+}
+
+// Burn deletes an existing token.
+func (k Keeper) Burn(ctx sdk.Context, classID, tokenID string) error {
+	if _, found := k.GetNFT(ctx, classID, tokenID); !found {
+		return sdkerrors.Wrapf(ErrNFTNotFound, "class id (%s) token id (%s)", classID, tokenID)
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), NFTKey).Delete(tokenKey(classID, tokenID))
+	prefix.NewStore(ctx.KVStore(k.storeKey), OwnerKey).Delete(tokenKey(classID, tokenID))
+	return nil
+}
+
+// GetNFT returns the token with the given class and token identifiers.
+func (k Keeper) GetNFT(ctx sdk.Context, classID, tokenID string) (nfttransfertypes.NFT, bool) {
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), NFTKey).Get(tokenKey(classID, tokenID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var nft NFT
+	if err := json.Unmarshal(bz, &nft); err != nil {
+		panic(err)
+	}
+	return nft, true
+}
+
+// GetOwner returns the owner of the token, or nil if the token does not exist.
+func (k Keeper) GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), OwnerKey).Get(tokenKey(classID, tokenID))
+}
@@ -30,6 +30,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	transfercli "github.com/cosmos/ibc-go/v3/modules/apps/transfer/client/cli"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/client"
+	ibccli "github.com/cosmos/ibc-go/v3/modules/core/client/cli"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
 	"github.com/cosmos/ibc-go/v3/testing/simapp/params"
 )
@@ -148,10 +152,16 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.GenTxCmd(simapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(simapp.ModuleBasics),
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
+		transfercli.GetCmdExportDenomTraces(),
+		transfercli.GetCmdImportDenomTraces(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		config.Cmd(),
+		ibccli.GetCmdCompactStores(simapp.DefaultNodeHome, ibcclient.StoreDecoder{
+			Store:    transfertypes.StoreKey,
+			Prefixes: []ibcclient.StorePrefix{{Name: "traces", Prefix: transfertypes.DenomTraceKey}},
+		}),
 	)
 
 	a := appCreator{encodingConfig}
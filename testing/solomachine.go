@@ -82,6 +82,14 @@ func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.
 	return privKeys, pubKeys, pk
 }
 
+// GenerateCommitteeKeys generates a new set of n secp256k1 private keys and public keys
+// along with the multisig public key of the committee formed by the public keys, which
+// requires signatures of threshold keys.
+func GenerateCommitteeKeys(t *testing.T, threshold, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
+	privKeys, pubKeys, _ := GenerateKeys(t, n)
+	return privKeys, pubKeys, kmultisig.NewLegacyAminoPubKey(int(threshold), pubKeys)
+}
+
 // ClientState returns a new solo machine ClientState instance. Default usage does not allow update
 // after governance proposal
 func (solo *Solomachine) ClientState() *solomachinetypes.ClientState {
@@ -110,27 +118,16 @@ func (solo *Solomachine) GetHeight() exported.Height {
 func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 	// generate new private keys and signature for header
 	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+	return solo.createHeader(newPrivKeys, newPubKeys, newPubKey)
+}
 
+// createHeader creates the header updating the solo machine to the provided keys, signed by
+// the current keys.
+func (solo *Solomachine) createHeader(newPrivKeys []cryptotypes.PrivKey, newPubKeys []cryptotypes.PubKey, newPubKey cryptotypes.PubKey) *solomachinetypes.Header {
 	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
 	require.NoError(solo.t, err)
 
-	data := &solomachinetypes.HeaderData{
-		NewPubKey:      publicKey,
-		NewDiversifier: solo.Diversifier,
-	}
-
-	dataBz, err := solo.cdc.Marshal(data)
-	require.NoError(solo.t, err)
-
-	signBytes := &solomachinetypes.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		DataType:    solomachinetypes.HEADER,
-		Data:        dataBz,
-	}
-
-	bz, err := solo.cdc.Marshal(signBytes)
+	bz, err := solomachinetypes.PublicKeyRotationSignBytes(solo.cdc, solo.Sequence, solo.Time, newPubKey, solo.Diversifier)
 	require.NoError(solo.t, err)
 
 	sig := solo.GenerateSignature(bz)
@@ -152,6 +149,32 @@ func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 	return header
 }
 
+// CreateCommitteeHeader creates a solo machine header, signed by the current keys, which
+// migrates the solo machine to a committee of nKeys newly generated keys requiring signatures
+// of threshold keys.
+func (solo *Solomachine) CreateCommitteeHeader(threshold, nKeys uint64) *solomachinetypes.Header {
+	newPrivKeys, newPubKeys, newPubKey := GenerateCommitteeKeys(solo.t, threshold, nKeys)
+	return solo.createHeader(newPrivKeys, newPubKeys, newPubKey)
+}
+
+// CreateCommitteeThresholdHeader creates a solo machine header, signed by the current keys,
+// which changes the threshold of the committee formed by the current keys.
+func (solo *Solomachine) CreateCommitteeThresholdHeader(threshold uint64) *solomachinetypes.Header {
+	newPubKey := kmultisig.NewLegacyAminoPubKey(int(threshold), solo.PublicKeys)
+	return solo.createHeader(solo.PrivateKeys, solo.PublicKeys, newPubKey)
+}
+
+// CreateBatchHeader creates a solo machine batch header of n headers of consecutive sequences,
+// each generating new keys and signed by the keys generated by the previous header.
+func (solo *Solomachine) CreateBatchHeader(n int) *solomachinetypes.BatchHeader {
+	headers := make([]solomachinetypes.Header, n)
+	for i := range headers {
+		headers[i] = *solo.CreateHeader()
+	}
+
+	return &solomachinetypes.BatchHeader{Headers: headers}
+}
+
 // CreateMisbehaviour constructs testing misbehaviour for the solo machine client
 // by signing over two different data bytes at the same sequence.
 func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {